@@ -3,17 +3,60 @@ package main
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/mikelcalvo/erpnext-cli/internal/erp"
 )
 
 func main() {
+	// The command line as typed, global flags included, for the history
+	argv := append([]string{}, os.Args[1:]...)
+
+	// Output options may go anywhere on the command line
+	noColor, ascii, offline, local, noDefaults := false, false, false, false, false
+	lang := ""
+	rest := []string{os.Args[0]}
+	// flags are the global options given but --offline, for the commands
+	// queued offline to run with them
+	var flags []string
+	for _, arg := range os.Args[1:] {
+		switch {
+		case arg == "--no-color":
+			noColor = true
+		case arg == "--ascii":
+			ascii = true
+		case arg == "--offline":
+			offline = true
+		case arg == "--local":
+			local = true
+		case arg == "--no-defaults":
+			noDefaults = true
+		case len(arg) > 7 && arg[:7] == "--lang=":
+			lang = arg[7:]
+		default:
+			rest = append(rest, arg)
+			continue
+		}
+		if arg != "--offline" {
+			flags = append(flags, arg)
+		}
+	}
+	os.Args = rest
+	erp.ConfigureOutput(noColor, ascii)
+
+	// --lang applies from here on, so config errors are in its language too;
+	// ERP_LANG takes over once the config is loaded
+	if err := erp.SetLanguage(lang); err != nil {
+		fmt.Printf("%s%s: %s%s\n", erp.Red, erp.T("Error"), err, erp.Reset)
+		os.Exit(1)
+	}
+
 	// No arguments or "tui" command -> launch TUI
 	if len(os.Args) < 2 || os.Args[1] == "tui" {
 		// Check if config exists, if not launch setup wizard
 		if !erp.ConfigExists() {
 			if err := erp.RunSetupTUI(); err != nil {
-				fmt.Printf("%sError: %s%s\n", erp.Red, err, erp.Reset)
+				fmt.Printf("%s%s: %s%s\n", erp.Red, erp.T("Error"), err, erp.Reset)
 				os.Exit(1)
 			}
 			// After setup, check if config was created
@@ -25,12 +68,20 @@ func main() {
 
 		config, err := erp.LoadConfig()
 		if err != nil {
-			fmt.Printf("%sError: %s%s\n", erp.Red, err, erp.Reset)
+			fmt.Printf("%s%s: %s%s\n", erp.Red, erp.T("Error"), err, erp.Reset)
+			os.Exit(1)
+		}
+		// --lang wins over ERP_LANG in the config
+		if lang == "" {
+			lang = config.Language
+		}
+		if err := erp.SetLanguage(lang); err != nil {
+			fmt.Printf("%s%s: %s%s\n", erp.Red, erp.T("Error"), err, erp.Reset)
 			os.Exit(1)
 		}
 		client := erp.NewClient(config)
 		if err := erp.RunTUI(client); err != nil {
-			fmt.Printf("%sError: %s%s\n", erp.Red, err, erp.Reset)
+			fmt.Printf("%s%s: %s%s\n", erp.Red, erp.T("Error"), err, erp.Reset)
 			os.Exit(1)
 		}
 		os.Exit(0)
@@ -38,8 +89,17 @@ func main() {
 
 	cmd := os.Args[1]
 
-	// Help doesn't need config
+	// Help doesn't need config, but is shown in its language when there is one
 	if cmd == "help" || cmd == "-h" || cmd == "--help" {
+		if lang == "" {
+			if config, err := erp.LoadConfig(); err == nil {
+				lang = config.Language
+			}
+		}
+		if err := erp.SetLanguage(lang); err != nil {
+			fmt.Printf("%s%s: %s%s\n", erp.Red, erp.T("Error"), err, erp.Reset)
+			os.Exit(1)
+		}
 		printUsage()
 		os.Exit(0)
 	}
@@ -47,23 +107,82 @@ func main() {
 	// Version
 	if cmd == "version" || cmd == "-v" || cmd == "--version" {
 		fmt.Printf("ERPNext CLI v%s\n", erp.Version)
-		fmt.Printf("Created by %s in %s\n", erp.Author, erp.Year)
+		fmt.Printf(erp.T("Created by %s in %s")+"\n", erp.Author, erp.Year)
 		os.Exit(0)
 	}
 
 	// Load config
 	config, err := erp.LoadConfig()
 	if err != nil {
-		fmt.Printf("%sError: %s%s\n", erp.Red, err, erp.Reset)
+		fmt.Printf("%s%s: %s%s\n", erp.Red, erp.T("Error"), err, erp.Reset)
+		os.Exit(1)
+	}
+
+	// --lang wins over ERP_LANG in the config
+	if lang == "" {
+		lang = config.Language
+	}
+	if err := erp.SetLanguage(lang); err != nil {
+		fmt.Printf("%s%s: %s%s\n", erp.Red, erp.T("Error"), err, erp.Reset)
 		os.Exit(1)
 	}
 
+	// The command as typed, without the global flags
+	typed := os.Args[1:]
+
+	// Expand user-defined aliases (alias.<name> in .erp-config)
+	args, err := config.ExpandAlias(typed)
+	if err != nil {
+		fmt.Printf("%s%s: %s%s\n", erp.Red, erp.T("Error"), err, erp.Reset)
+		os.Exit(1)
+	}
+
+	// Append the default flags configured for the command (defaults.<command>)
+	if !noDefaults {
+		args, err = config.ExpandDefaults(args)
+		if err != nil {
+			fmt.Printf("%s%s: %s%s\n", erp.Red, erp.T("Error"), err, erp.Reset)
+			os.Exit(1)
+		}
+	}
+	cmd = args[0]
+
 	// Create client
 	client := erp.NewClient(config)
 
-	// Detect connection mode (except for ping/config which do it themselves)
-	if cmd != "ping" && cmd != "config" {
+	// Offline mode queues create/update commands for 'sync push'
+	if offline {
+		if err := client.QueueOffline(typed, flags, args); err != nil {
+			fmt.Printf("%s%s: %s%s\n", erp.Red, erp.T("Error"), err, erp.Reset)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// --set field=value and --naming-series fill fields of the documents
+	// written (clone has its own --set; scripts and manifests set their own)
+	if cmd != "clone" && cmd != "run" && cmd != "apply" {
+		args, err = client.ParseFieldSets(args)
+		if err != nil {
+			fmt.Printf("%s%s: %s%s\n", erp.Red, erp.T("Error"), err, erp.Reset)
+			os.Exit(1)
+		}
+	}
+
+	// Read from local snapshots instead of the server
+	client.Local = local
+
+	// Detect connection mode (except for ping/doctor/config which do it themselves,
+	// notes and aliases which are local only, scripts whose commands do it,
+	// and --local reads)
+	if cmd != "ping" && cmd != "doctor" && cmd != "config" && cmd != "note" && cmd != "alias" && cmd != "run" && cmd != "redo" && !local {
 		client.DetectConnection()
+
+		// Fail before changing anything when a permission is missing
+		if err := client.Preflight(args); err != nil {
+			fmt.Printf("%s%s: %s%s\n", erp.Red, erp.T("Error"), err, erp.Reset)
+			os.Exit(1)
+		}
 	}
 
 	// Route commands
@@ -71,74 +190,164 @@ func main() {
 	switch cmd {
 	case "ping":
 		cmdErr = client.CmdPing()
+	case "doctor":
+		cmdErr = client.CmdDoctor()
 	case "config":
-		cmdErr = client.CmdConfig()
+		cmdErr = client.CmdConfig(args[1:])
 	case "attr", "attribute":
-		cmdErr = client.CmdAttr(os.Args[2:])
+		cmdErr = client.CmdAttr(args[1:])
 	case "item":
-		cmdErr = client.CmdItem(os.Args[2:])
+		cmdErr = client.CmdItem(args[1:])
 	case "template":
-		cmdErr = client.CmdTemplate(os.Args[2:])
+		cmdErr = client.CmdTemplate(args[1:])
 	case "group":
-		cmdErr = client.CmdGroup(os.Args[2:])
+		cmdErr = client.CmdGroup(args[1:])
 	case "brand":
-		cmdErr = client.CmdBrand(os.Args[2:])
+		cmdErr = client.CmdBrand(args[1:])
+	case "bom":
+		cmdErr = client.CmdBom(args[1:])
+	case "wo":
+		cmdErr = client.CmdWO(args[1:])
+	case "bundle":
+		cmdErr = client.CmdBundle(args[1:])
 	case "variant":
-		cmdErr = client.CmdVariant(os.Args[2:])
+		cmdErr = client.CmdVariant(args[1:])
 	case "warehouse":
-		cmdErr = client.CmdWarehouse(os.Args[2:])
+		cmdErr = client.CmdWarehouse(args[1:])
 	case "stock":
-		cmdErr = client.CmdStock(os.Args[2:])
+		cmdErr = client.CmdStock(args[1:])
 	case "serial":
-		cmdErr = client.CmdSerial(os.Args[2:])
+		cmdErr = client.CmdSerial(args[1:])
 	case "supplier":
-		cmdErr = client.CmdSupplier(os.Args[2:])
+		cmdErr = client.CmdSupplier(args[1:])
+	case "blanket":
+		cmdErr = client.CmdBlanket(args[1:])
 	case "po":
-		cmdErr = client.CmdPO(os.Args[2:])
+		cmdErr = client.CmdPO(args[1:])
 	case "pi":
-		cmdErr = client.CmdPI(os.Args[2:])
+		cmdErr = client.CmdPI(args[1:])
 	case "customer":
-		cmdErr = client.CmdCustomer(os.Args[2:])
+		cmdErr = client.CmdCustomer(args[1:])
 	case "quotation":
-		cmdErr = client.CmdQuotation(os.Args[2:])
+		cmdErr = client.CmdQuotation(args[1:])
 	case "so":
-		cmdErr = client.CmdSO(os.Args[2:])
+		cmdErr = client.CmdSO(args[1:])
 	case "si":
-		cmdErr = client.CmdSI(os.Args[2:])
+		cmdErr = client.CmdSI(args[1:])
 	case "dn":
-		cmdErr = client.CmdDN(os.Args[2:])
+		cmdErr = client.CmdDN(args[1:])
+	case "subscription":
+		cmdErr = client.CmdSubscription(args[1:])
 	case "pr":
-		cmdErr = client.CmdPR(os.Args[2:])
+		cmdErr = client.CmdPR(args[1:])
+	case "qi":
+		cmdErr = client.CmdQI(args[1:])
+	case "lcv":
+		cmdErr = client.CmdLCV(args[1:])
+	case "asset":
+		cmdErr = client.CmdAsset(args[1:])
 	case "payment":
-		cmdErr = client.CmdPayment(os.Args[2:])
+		cmdErr = client.CmdPayment(args[1:])
+	case "je":
+		cmdErr = client.CmdJE(args[1:])
+	case "fiscal-year":
+		cmdErr = client.CmdFiscalYear(args[1:])
+	case "gl":
+		cmdErr = client.CmdGL(args[1:])
+	case "account":
+		cmdErr = client.CmdAccount(args[1:])
+	case "timesheet":
+		cmdErr = client.CmdTimesheet(args[1:])
+	case "employee":
+		cmdErr = client.CmdEmployee(args[1:])
+	case "expense":
+		cmdErr = client.CmdExpense(args[1:])
+	case "listen":
+		cmdErr = client.CmdListen(args[1:])
+	case "print":
+		cmdErr = client.CmdPrint(args[1:])
+	case "apply":
+		cmdErr = client.CmdApply(args[1:])
+	case "attach":
+		cmdErr = client.CmdAttach(args[1:])
+	case "attachments":
+		cmdErr = client.CmdAttachments(args[1:])
+	case "comment":
+		cmdErr = client.CmdComment(args[1:])
+	case "assign":
+		cmdErr = client.CmdAssign(args[1:])
+	case "history":
+		cmdErr = client.CmdHistory(args[1:])
+	case "clone":
+		cmdErr = client.CmdClone(args[1:])
+	case "bulk":
+		cmdErr = client.CmdBulk(args[1:])
+	case "flow":
+		cmdErr = client.CmdFlow(args[1:])
+	case "pricing-rule":
+		cmdErr = client.CmdPricingRule(args[1:])
+	case "coupon":
+		cmdErr = client.CmdCoupon(args[1:])
+	case "fx":
+		cmdErr = client.CmdFX(args[1:])
+	case "note":
+		cmdErr = client.CmdNote(args[1:])
+	case "alias":
+		cmdErr = client.CmdAlias(args[1:])
+	case "tutorial":
+		cmdErr = client.CmdTutorial(args[1:])
+	case "price":
+		cmdErr = client.CmdPrice(args[1:])
+	case "qreport":
+		cmdErr = client.CmdQReport(args[1:])
 	case "report", "dashboard":
-		cmdErr = client.CmdReport(os.Args[2:])
+		cmdErr = client.CmdReport(args[1:])
 	case "export":
-		cmdErr = client.CmdExport(os.Args[2:])
+		cmdErr = client.CmdExport(args[1:])
 	case "import":
-		cmdErr = client.CmdImport(os.Args[2:])
+		cmdErr = client.CmdImport(args[1:])
+	case "sync":
+		cmdErr = client.CmdSync(args[1:])
+	case "run":
+		cmdErr = client.CmdRun(args[1:])
+	case "redo":
+		cmdErr = client.CmdRedo(args[1:])
+	case "series":
+		cmdErr = client.CmdSeries(args[1:])
+	case "snapshot":
+		cmdErr = client.CmdSnapshot(args[1:])
 	default:
-		fmt.Printf("%sUnknown command: %s%s\n", erp.Red, cmd, erp.Reset)
+		fmt.Printf("%s%s%s\n", erp.Red, fmt.Sprintf(erp.T("Unknown command: %s"), cmd), erp.Reset)
 		printUsage()
 		os.Exit(1)
 	}
 
+	// Record the command for history and redo (the history itself, reruns
+	// and scripts record the commands they run)
+	if cmd != "history" && cmd != "redo" && cmd != "run" {
+		client.RecordCommand(argv, cmdErr)
+	}
+
 	if cmdErr != nil {
-		fmt.Printf("%sError: %s%s\n", erp.Red, cmdErr, erp.Reset)
+		fmt.Printf("%s%s: %s%s\n", erp.Red, erp.T("Error"), cmdErr, erp.Reset)
 		os.Exit(1)
 	}
 }
 
 func printUsage() {
-	fmt.Printf(`%sERPNext CLI%s - Created by Mikel Calvo in %s
+	usagef(`%sERPNext CLI%s - Created by Mikel Calvo in %s
 
 Usage: erp-cli <command> [subcommand] [args...]
 
 %sCommands:%s
 
   %sping%s                              Test connection and authentication
+  %sdoctor%s                            Diagnose DNS, TLS, latency (VPN vs internet), token, version
+                                      and permissions, with hints to fix each problem
   %sconfig%s                            Show current configuration
+  %sconfig set <key> <value>%s          Save a default (warehouse, selling-price-list, customer-group, ...)
   %sversion%s                           Show version information
+  %stutorial [--yes]%s                  Guided order-to-cash walkthrough (test sites)
 
 %sAttributes:%s
   %sattr list%s                         List all item attributes
@@ -155,10 +364,16 @@ Usage: erp-cli <command> [subcommand] [args...]
 %sItems:%s
   %sitem list [--templates]%s           List items (optionally only templates)
   %sitem get <code>%s                   Get item details
-  %sitem create <code> <name> <group>%s Create simple item
+  %sitem create <code> <name> [group]%s Create simple item (config defaults)
   %sitem add-attr <code> <attr1> [...]%s Add attributes to item/template
   %sitem set <code> <prop=val>%s        Update item properties
+                                      (price=N, barcodes+=X, suppliers-=X, --json-patch f)
   %sitem delete <code>%s                Delete an item
+  %sitem map-customer-code <code> <customer> <their_code|--remove>%s
+                                      Map a customer's part number to an item
+  %sitem assign-barcodes -f <file> [--dry-run]%s
+                                      Bulk-assign validated EAN/UPC barcodes
+  %sitem missing-barcodes%s             List items without barcodes
 
 %sTemplates:%s
   %stemplate create <code> <name> <group> <attr1> [...]%s
@@ -168,27 +383,67 @@ Usage: erp-cli <command> [subcommand] [args...]
   %svariant list <template>%s           List all variants of a template
   %svariant create <template> <code> <attr=val> [...]%s
                                       Create a variant from a template
+  %svariant generate <template> [--attr Name=v1,v2 ...] [--dry-run]%s
+                                      Create all missing variants of the attribute matrix
+  %svariant matrix <template> [--attr Name=v1,v2 ...]%s
+                                      Grid of which value combinations have a variant
 
 %sGroups & Brands:%s
   %sgroup list%s                        List item groups
   %sgroup create <name> [parent]%s      Create item group
+  %sgroup tree%s                        Show item group hierarchy
+  %sgroup rename <old> <new>%s          Rename an item group
+  %sgroup delete <name> [--cascade-check]%s
+                                      Delete group (check subgroups/items first)
   %sbrand list%s                        List brands
   %sbrand create <name>%s               Create a new brand
   %sbrand add-to-attr <name>%s          Create brand AND add to attribute
 
+%sProduct Bundles:%s
+  %sbundle list%s                       List product bundles
+  %sbundle get <parent>%s               Show bundle components
+  %sbundle create <parent> <child:qty> [...]%s
+                                      Create bundle (kit) from components
+  %sbundle delete <parent>%s            Delete a product bundle
+
+%sBills of Materials:%s
+  %sbom list [--item=X] [--all]%s       List active BOMs (--all adds inactive)
+  %sbom get <bom|item>%s                Show components, expanding sub-assemblies
+  %sbom create <item> <comp:qty[@rate]> [...] [--qty=N] [--submit]%s
+                                      Create BOM (qty = parent units made)
+  %sbom submit <bom>%s                  Submit a draft BOM
+
+%sManufacturing:%s
+  %swo list [--status=X] [--item=X]%s   List work orders
+  %swo get <name>%s                     Show work order with required items
+  %swo create <item> <qty> [--bom=X] [--wip-warehouse=X] [--fg-warehouse=X] [--submit]%s
+                                      Create work order (default BOM unless --bom)
+  %swo start <name> [--qty=N]%s         Transfer materials to WIP (Stock Entry)
+  %swo finish <name> [--qty=N]%s        Manufacture: consume materials, receive goods
+
 %sStock:%s
   %swarehouse list%s                    List all warehouses
+  %swarehouse tree%s                    Show warehouse hierarchy
+  %swarehouse create <name> [--parent=X] [--group]%s
+                                      Create warehouse (or group)
+  %swarehouse rename <old> <new>%s      Rename a warehouse
+  %swarehouse disable <name>%s          Disable a warehouse
   %sstock get <item> [warehouse]%s      Get current stock
-  %sstock receive <item> <qty> <wh> [--rate=X]%s
-                                      Receive stock (Material Receipt)
+  %sstock receive <item> <qty> [wh] [--rate=X]%s
+                                      Receive stock (Material Receipt; wh: ERP_DEFAULT_WAREHOUSE)
   %sstock transfer <item> <qty> <from> <to>%s
                                       Transfer stock between warehouses
-  %sstock issue <item> <qty> <wh>%s     Issue stock (Material Issue)
+  %sstock issue <item> <qty> [wh]%s     Issue stock (Material Issue)
+  %s[--posting-date=YYYY-MM-DD] [--posting-time=HH:MM]%s
+                                      Backdate stock, invoice, DN/PR and payment creation
 
 %sSerial Numbers:%s
   %sserial create <sn> <item>%s         Create a serial number
   %sserial list <item>%s                List serial numbers for an item
   %sserial get <sn>%s                   Get serial number details
+  %sserial find <fragment>%s            Find serial numbers containing text
+  %sserial history <sn>%s               Show movements and delivery customer
+  %sserial set-warranty <sn> <date>%s   Set the warranty expiry date
   %sserial create-batch <item> <prefix> <start> <count>%s
                                       Create multiple serial numbers
 
@@ -196,6 +451,13 @@ Usage: erp-cli <command> [subcommand] [args...]
   %ssupplier list%s                     List all suppliers
   %ssupplier get <name>%s               Get supplier details
   %ssupplier create <name>%s            Create a new supplier
+  %ssupplier set <name> <prop=value>...%s
+                                      Update fields (group, tax-id, currency, ...)
+  %ssupplier add-address <name> --line1=X --city=X --country=X%s
+                                      Add a linked address (--type, --zip, --primary)
+  %ssupplier add-contact <name> <first> [--email=X]%s
+                                      Add a linked contact (--last, --phone, --mobile)
+  %ssupplier addresses <name>%s         List linked addresses
   %ssupplier delete <name>%s            Delete a supplier
 
 %sPurchase Orders:%s
@@ -203,10 +465,22 @@ Usage: erp-cli <command> [subcommand] [args...]
                                       List purchase orders
   %spo get <name>%s                     Get PO details with items
   %spo create <supplier>%s              Create draft PO
+  %spo create <supplier> --blanket=X [--items=CODE:QTY,...]%s
+                                      Order from a blanket order at its rates
   %spo add-item <po> <item> <qty> [--rate=X]%s
                                       Add item to PO
   %spo submit <name>%s                  Submit PO
-  %spo cancel <name>%s                  Cancel PO
+  %spo cancel <name> [--cascade]%s      Cancel PO
+  %spo pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]%s
+                                      Download the printed PDF
+
+%sBlanket Orders:%s
+  %sblanket create <party> <item:qty@rate> [...] [--selling] [--to=YYYY-MM-DD] [--submit]%s
+                                      Agree quantities and rates for a period
+  %sblanket submit <name>%s             Submit a blanket order
+  %sblanket list [--party=X] [--selling|--purchasing]%s
+                                      List blanket orders and whether they are active
+  %sblanket get <name>%s                Items with ordered and agreed quantities
 
 %sPurchase Invoices:%s
   %spi list [--supplier=X] [--status=X]%s
@@ -214,12 +488,23 @@ Usage: erp-cli <command> [subcommand] [args...]
   %spi get <name>%s                     Get invoice details
   %spi create-from-po <po_name>%s       Create invoice from PO
   %spi submit <name>%s                  Submit invoice
-  %spi cancel <name>%s                  Cancel invoice
+  %spi cancel <name> [--cascade]%s      Cancel invoice
+  %spi pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]%s
+                                      Download the printed PDF
 
 %sCustomers:%s
   %scustomer list%s                     List all customers
   %scustomer get <name>%s               Get customer details
+  %scustomer balance <name>%s           Outstanding, unallocated payments and credit
   %scustomer create <name>%s            Create a new customer
+  %scustomer set <name> <prop=value>...%s
+                                      Update fields (group, tax-id, currency, ...)
+  %scustomer add-address <name> --line1=X --city=X --country=X%s
+                                      Add a linked address (--type, --zip, --primary)
+  %scustomer add-contact <name> <first> [--email=X]%s
+                                      Add a linked contact (--last, --phone, --mobile)
+  %scustomer addresses <name>%s         List linked addresses (ids for --billing-address
+                                      and --shipping-address of so, dn and si creation)
   %scustomer delete <name>%s            Delete a customer
 
 %sQuotations:%s
@@ -230,34 +515,67 @@ Usage: erp-cli <command> [subcommand] [args...]
   %squotation add-item <name> <item> <qty> [--rate=X]%s
                                       Add item to quotation
   %squotation submit <name>%s           Submit quotation
-  %squotation cancel <name>%s           Cancel quotation
+  %squotation cancel <name> [--cascade]%s
+                                      Cancel quotation
+  %squotation pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]%s
+                                      Download the printed PDF
+                                      quotation/so/po create and the create-from commands
+                                      take --terms=X (default: the company's terms),
+                                      --remarks="..." and --letterhead=X
 
 %sSales Orders:%s
   %sso list [--customer=X] [--status=X]%s
                                       List sales orders
   %sso get <name>%s                     Get SO details with items
-  %sso create <customer>%s              Create draft SO
+  %sso create <customer> [--sales-person=Name[:pct]] [--shipping-rule=X]%s
+                                      Create draft SO (with sales team, freight from the rule)
+  %sso create -i [customer]%s           Build an SO line by line (item lookup, running total)
+  %sso create <customer> --blanket=X [--items=CODE:QTY,...]%s
+                                      Order from a selling blanket order
   %sso create-from-quotation <name>%s   Create SO from quotation
   %sso add-item <so> <item> <qty> [--rate=X]%s
                                       Add item to SO
-  %sso submit <name>%s                  Submit SO
-  %sso cancel <name>%s                  Cancel SO
+  %sso submit <name> [--force]%s        Submit SO (checks customer credit limit)
+  %sso cancel <name> [--cascade]%s      Cancel SO
+  %sso pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]%s
+                                      Download the printed PDF
 
 %sSales Invoices:%s
   %ssi list [--customer=X] [--status=X]%s
                                       List sales invoices
   %ssi get <name>%s                     Get invoice details
-  %ssi create-from-so <so_name>%s       Create invoice from SO
+  %ssi create-from-so <so_name> [--sales-person=Name[:pct]] [--shipping-rule=X]%s
+                                      Create invoice from SO (SO's shipping rule by default)
   %ssi submit <name>%s                  Submit invoice
-  %ssi cancel <name>%s                  Cancel invoice
+                                      quotation/so/si submit email the customer contact
+                                      when ERP_NOTIFY_ON lists them (--notify/--no-notify)
+  %ssi cancel <name> [--cascade]%s      Cancel invoice
+  %ssi pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]%s
+                                      Download the printed PDF
+  %ssi recur <name> --frequency=Monthly [--end=YYYY-MM-DD] [--submit]%s
+                                      Repeat an invoice on a schedule (Auto Repeat)
+  %ssi recur list [--all]%s             Active repeat schedules
+
+%sSubscriptions:%s
+  %ssubscription plans%s                List subscription plans with price and interval
+  %ssubscription create <customer> --plan=X[:qty] [--start=YYYY-MM-DD] [--end=YYYY-MM-DD]%s
+                                      Bill a customer every period (invoices generated by ERPNext)
+  %ssubscription list [--customer=X] [--status=X]%s
+                                      List customer subscriptions
+  %ssubscription get <name>%s           Plans, current period and generated invoices
+  %ssubscription cancel <name> [--yes]%s
+                                      Stop invoicing a subscription
 
 %sDelivery Notes:%s
   %sdn list [--customer=X] [--status=X]%s
                                       List delivery notes
   %sdn get <name>%s                     Get delivery note details
-  %sdn create-from-so <so_name>%s       Create delivery note from SO
+  %sdn create-from-so <so_name> [--shipping-rule=X]%s
+                                      Create delivery note from SO (SO's shipping rule by default)
   %sdn submit <name>%s                  Submit delivery note
-  %sdn cancel <name>%s                  Cancel delivery note
+  %sdn cancel <name> [--cascade]%s      Cancel delivery note
+  %sdn pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]%s
+                                      Download the printed PDF
 
 %sPurchase Receipts:%s
   %spr list [--supplier=X] [--status=X]%s
@@ -265,7 +583,32 @@ Usage: erp-cli <command> [subcommand] [args...]
   %spr get <name>%s                     Get receipt details
   %spr create-from-po <po_name>%s       Create receipt from PO
   %spr submit <name>%s                  Submit receipt
-  %spr cancel <name>%s                  Cancel receipt
+  %spr cancel <name> [--cascade]%s      Cancel receipt
+  %spr pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]%s
+                                      Download the printed PDF
+
+%sQuality Inspections:%s
+  %sqi pending <pr_or_dn>%s             Items still needing an accepted inspection
+  %sqi create --ref=X --item=X --result=Accepted|Rejected [--readings=P:v,...]%s
+                                      Inspect an item and link it to the document
+  %sqi submit <name>%s                  Submit a draft inspection (--draft)
+  %sqi list [--ref=X] [--status=X]%s    List quality inspections
+  %sqi get <name>%s                     Get inspection details and readings
+
+%sLanded Costs:%s
+  %slcv create --pr <pr> --charge "Freight:250" [...] [--distribute=amount|qty]%s
+                                      Add charges to the valuation of received items
+  %slcv submit <name>%s                 Submit a landed cost voucher
+  %slcv list [--pr=X]%s                 List landed cost vouchers
+  %slcv get <name>%s                    Get voucher charges and their distribution
+
+%sFixed Assets:%s
+  %sasset create --pr=<receipt> [--location=X] [--grouped] [--submit]%s
+                                      Capitalize the fixed-asset items of a receipt
+  %sasset submit <name>%s               Submit (capitalize) a draft asset
+  %sasset list [--status=X] [--category=X] [--location=X]%s
+                                      List assets with cost and current value
+  %sasset get <name>%s                  Asset details and depreciation schedule
 
 %sPayments:%s
   %spayment list [--party=X] [--type=receive|pay] [--status=X]%s
@@ -277,19 +620,187 @@ Usage: erp-cli <command> [subcommand] [args...]
                                       Create payment for Purchase Invoice
   %spayment submit <name>%s             Submit payment
   %spayment cancel <name>%s             Cancel payment
+                                      quotation/so/po create take --currency=X and
+                                      --exchange-rate=N (else the Currency Exchange
+                                      rate); DN/SI/PI and payments follow the order's
+                                      or invoice's currency, --exchange-rate overrides
+  %spayment pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]%s
+                                      Download the printed PDF
+  %sfx list [--from=X] [--to=X] [--date=YYYY-MM-DD]%s
+                                      Currency Exchange rates (in force on --date)
+  %sfx set <from> <to> <rate> [--date=YYYY-MM-DD]%s
+                                      Record a rate (1 from = rate to)
+  %sfx sync [--currencies=EUR,GBP] [--date=YYYY-MM-DD]%s
+                                      Fetch rates from ERP_FX_PROVIDER
+
+%sAccounting:%s
+  %sje list [--status=X] [--account=X]%s
+                                      List journal entries
+  %sje get <name>%s                     Get journal entry details
+  %sje create --debit ACC:AMT --credit ACC:AMT [--party=X] [--remark=X] [--ref=X] [--posting-date=YYYY-MM-DD]%s
+                                      Create a balanced journal entry (flags repeatable)
+  %sje submit <name>%s                  Submit journal entry
+  %sje cancel <name>%s                  Cancel journal entry
+  %sgl <account> [--from=YYYY-MM-DD] [--to=YYYY-MM-DD] [--party=X]%s
+                                      General ledger with running balance
+  %saccount balance <account> [--date=YYYY-MM-DD] [--party=X]%s
+                                      Account balance on a date
+  %sfiscal-year list%s                  Fiscal years and the frozen period
+                                      --posting-date (invoices, DN/PR, payments, stock,
+                                      JE, expense, lcv) and --transaction-date (quotation,
+                                      so, po create) must fall in an open fiscal year
+                                      and after the frozen period
+  %sseries list <doctype>%s             Naming series of a doctype (pick one with --naming-series=X)
+
+%sTimesheets:%s
+  %stimesheet add <project> <hours> [--activity=X] [--date=YYYY-MM-DD] [--desc=X]%s
+                                      Log hours on this week's draft timesheet
+  %stimesheet week [--date=YYYY-MM-DD]%s
+                                      Show the week grid of logged hours
+  %stimesheet list%s                    List your timesheets
+  %stimesheet get <name>%s              Get timesheet details
+  %stimesheet submit [name]%s           Submit a timesheet (default: this week's draft)
+
+%sEmployees & Expenses:%s
+  %semployee list [--department=X] [--status=X]%s
+                                      List employees (default: active)
+  %semployee get <id>%s                 Get employee details
+  %semployee me%s                       Show the employee linked to your user
+  %sexpense types%s                     List expense claim types
+  %sexpense create <type> <amount> [--desc=X] [--date=YYYY-MM-DD] [--receipt=file]%s
+                                      Create an expense claim
+  %sexpense add <name> <type> <amount> [--desc=X] [--date=YYYY-MM-DD]%s
+                                      Add an expense to a draft claim
+  %sexpense attach <name> <file>...%s   Attach receipts to a claim
+  %sexpense list [--status=X]%s         List your expense claims
+  %sexpense get <name>%s                Get expense claim details
+  %sexpense submit <name>%s             Submit an approved claim
+
+%sAttachments:%s
+  %sattach <doctype> <name> <file>... [--public]%s
+                                      Upload files to any document (private by default)
+  %sattachments list <doctype> <name>%s
+                                      List files attached to a document
+  %sattachments get <doctype> <name> <file_name> [-o path]%s
+                                      Download an attachment
+
+%sDocuments (any doctype):%s
+  %scomment <doctype> <name> <text>%s   Add a comment to a document's timeline
+  %sassign <doctype> <name> <user> [--note=X] [--date=YYYY-MM-DD]%s
+                                      Assign a document to a user (ToDo)
+  %shistory <doctype> <name> [--limit=N]%s
+                                      Field-by-field change history (who, when, what)
+  %sclone <doctype> <name> [--set field=value ...]%s
+                                      Copy a document into a new draft (dates start today)
+  %sbulk <submit|cancel|delete> <doctype> [--filter k=v ...] [--yes]%s
+                                      Apply an action to every matching document concurrently
+  %sflow sell --customer=X --item CODE:QTY[:RATE] ... [--deliver] [--invoice] [--collect]%s
+                                      SO -> DN -> SI -> Payment in one go, rolled back on failure
+  %sflow buy --supplier=X --item CODE:QTY:RATE ... [--receive] [--bill] [--pay]%s
+                                      PO -> PR -> PI -> Payment in one go, rolled back on failure
+  %sprint <doctype> <name> [--width=N] [-o file] [--lp[=printer]]%s
+                                      Plain-text document for the terminal or a line printer
+  %slisten --event "Doctype:event" [--event ...] [--exec cmd] [--interval=30s]%s
+                                      Emit document events as JSON lines (polling, or a local
+                                      webhook listener with --webhook=URL [--port=N])
+
+%sNotes:%s
+  %snote <text>%s                       Save a quick note for the TUI Inbox
+  %snote list%s                         List notes with parsed hints
+  %snote delete <id>%s                  Delete a note
+
+%sAliases:%s
+  %salias%s                             List command aliases defined in .erp-config
+  %srun <script.erp|-> [--stop-on-error] [--log=FILE]%s
+                                      Run a script of commands ($LAST_NAME is the last document created)
+  %shistory [--limit=N] [--last]%s
+                                      Commands run and documents created (--last: last name)
+  %sredo [<#>] [--yes]%s                Run a command from the history again (default: last),
+                                      confirming those that change data unless --yes
 
 %sImport/Export:%s
   %sexport items -o <file>%s            Export items to CSV
   %sexport templates -o <file>%s        Export templates to CSV
   %sexport attributes -o <file>%s       Export attributes to CSV
   %sexport variants <tpl> -o <file>%s   Export variants to CSV
+  %sexport boms -o <file>%s             Export default BOMs (parent, component, qty, rate)
+  %sexport <type> -o <file> --xlsx%s    Write an Excel file instead of CSV
+  %s<doc> list [...] --xlsx[=file]%s    Export listed documents to Excel (header + items sheets)
+  %s<doc> get|list [...] --template=t%s Render through a Go template file or bundled template
+                                      (quote-letter, packing-note, list-csv)
+  %sexport doc <doctype> [--filter k=v] -o <file.jsonl>%s
+                                      Dump full documents (with child tables) as JSONL
+  %ssync changes --doctype=X [--since=<timestamp|cursor>] [--format=jsonl|csv] [-o file]%s
+                                      Documents created/modified since the saved cursor
+                                      (--limit=N, --full for child tables, --cursor=name)
+  %ssync cursors%s                      List saved sync cursors
+  %ssync reset <doctype|name>%s         Forget a sync cursor
+  %ssync push [--dry-run] [--force]%s   Run commands queued with --offline (conflicts are kept)
+  %ssync queue%s                        List queued commands
+  %ssync drop <id>%s                    Remove a queued command
+  %ssnapshot pull [doctype...] [--full]%s Mirror doctypes locally for --local (catalog by default)
+  %ssnapshot status%s                   Mirrored doctypes and their age
+  %ssnapshot drop [doctype...]%s        Delete local snapshots
   %simport items -f <file> [--dry-run]%s Import items from CSV
   %simport variants -f <file> [--dry-run]%s Import variants from CSV
+  %simport stock -f <file> [--receipt] [--dry-run]%s
+                                      Opening stock per warehouse (Stock Reconciliation)
+  %simport prices -f <file> [--dry-run]%s
+                                      Create/update Item Prices from CSV
+  %simport boms -f <file> [--dry-run]%s Create + submit BOMs, lower levels first
+  %simport doc -f <file.jsonl> [--upsert] [--dry-run]%s
+                                      Create (or with --upsert overwrite) dumped documents
+  %simport <type> -f <file> --resume%s  Skip rows done per <file>.results.csv, retry the rest
+  %simport <type> -f <file> --map <file.yaml>%s
+                                      Rename columns, fill defaults, trim/uppercase cells
+  %sapply -f <manifest.yaml> [--dry-run]%s
+                                      Create/update item groups, attributes, warehouses, price
+                                      lists and templates declared in a manifest
+
+%sPricing:%s
+  %spricing-rule list%s                 List selling pricing rules
+  %spricing-rule get <name>%s           Get pricing rule details
+  %spricing-rule create <title> --item=X|--group=X --discount=N|--rate=N%s
+                                      Create rule [--customer=X|--customer-group=X] [--min-qty=N]
+  %spricing-rule disable <name>%s       Disable a pricing rule
+  %sprice resolve <item> [--customer=X] [--qty=N]%s
+                                      Preview the effective rate for a customer
+  %scoupon list [--all]%s               Coupons still usable (every one with --all)
+  %scoupon create <code> --pricing-rule=X [--customer=X] [--max-use=N] [--to=YYYY-MM-DD]%s
+                                      Coupon for a coupon code based pricing rule
+                                      quotation/so create and si create-from-so take
+                                      --discount=N%%, --discount-amount=N and --coupon=CODE
 
 %sReports:%s
   %sreport%s                            Executive dashboard
   %sreport stock%s                      Detailed stock report
   %sreport purchases%s                  Detailed purchasing report
+  %sreport commissions [--month=YYYY-MM]%s
+                                      Invoiced totals per sales person
+  %sreport valuation-compare [--item X] [--threshold=N]%s
+                                      Compare FIFO vs booked stock valuation
+  %sreport snapshot%s                   Save today's dashboard metrics locally
+  %sreport diff --from YYYY-MM-DD [--to YYYY-MM-DD]%s
+                                      Compare two dashboard snapshots
+  %sreport ap [--supplier=X] [--as-of=YYYY-MM-DD] [--detail] [--csv[=file]]%s
+                                      Accounts payable aging per supplier
+  %sreport sales [--period=monthly|weekly] [--last=12]%s
+                                      Revenue trend, top customers and items
+  %sreport margin [--last=3]%s           Gross margin per month, item and customer
+  %sqreport <Report Name> [--filter key=value ...] [--csv[=file]] [--json]%s
+                                      Run an ERPNext query report
+  %sqreport --list [--module=X]%s        List the available query reports
+
+%sGlobal options:%s
+  %s--no-color%s                        No colors (also with NO_COLOR, TERM=dumb or piped output)
+  %s--ascii%s                           ASCII only: no box drawing, arrows or block characters
+  %s--offline%s                         Queue create/update commands for 'sync push' instead of running them
+  %s--local%s                           Answer list/get reads from local snapshots (see snapshot pull)
+  %s--no-defaults%s                     Ignore the default flags configured for the command
+  %s--lang=es%s                         Language of the output, errors and TUI: en, es (or ERP_LANG in the config)
+  %s--set field=value%s                 Set a field, e.g. a custom one, on the documents written (repeatable)
+  %s--set "Doctype:field=value"%s       The same on the documents of another doctype the command writes
+  %s--naming-series=X%s                 Name the documents created with series X (see series list)
 
 %sExamples:%s
   erp-cli ping
@@ -301,62 +812,195 @@ Usage: erp-cli <command> [subcommand] [args...]
 `,
 		erp.Blue, erp.Reset, erp.Year,
 		erp.Yellow, erp.Reset,
-		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
 		erp.Yellow, erp.Reset,
 		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
 		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
 		erp.Yellow, erp.Reset,
 		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
 		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset,
 		erp.Yellow, erp.Reset,
 		erp.Green, erp.Reset,
 		erp.Yellow, erp.Reset,
 		erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset,
 		erp.Yellow, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset,
 		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
 		erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Yellow, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Yellow, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Yellow, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
 		erp.Yellow, erp.Reset,
-		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
 		erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
 		erp.Yellow, erp.Reset,
 		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
 		erp.Yellow, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
 		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
 		erp.Yellow, erp.Reset,
-		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		// Blanket Orders
+		erp.Yellow, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
 		erp.Yellow, erp.Reset,
 		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
 		// Customers
 		erp.Yellow, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
 		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
 		// Quotations
 		erp.Yellow, erp.Reset,
 		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
 		erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
 		// Sales Orders
 		erp.Yellow, erp.Reset,
-		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
 		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
 		// Sales Invoices
 		erp.Yellow, erp.Reset,
 		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset,
+		// Subscriptions
+		erp.Yellow, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
 		// Delivery Notes
 		erp.Yellow, erp.Reset,
 		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
 		// Purchase Receipts
 		erp.Yellow, erp.Reset,
 		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		// Quality Inspections
+		erp.Yellow, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		// Landed Costs
+		erp.Yellow, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		// Fixed Assets
+		erp.Yellow, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
 		// Payments
 		erp.Yellow, erp.Reset,
 		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Yellow, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Yellow, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Yellow, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Yellow, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Yellow, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Yellow, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Yellow, erp.Reset, erp.Green, erp.Reset,
 		// Import/Export
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset,
 		erp.Yellow, erp.Reset,
 		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
 		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Yellow, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset,
 		// Reports
 		erp.Yellow, erp.Reset,
 		erp.Green, erp.Reset, erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset,
+		// Global options
+		erp.Yellow, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset,
 		// Examples
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset,
+		erp.Green, erp.Reset, erp.Green, erp.Reset,
 		erp.Yellow, erp.Reset,
 	)
 }
+
+// usagef prints the usage text with its headings and command descriptions
+// looked up in the message catalog, a line at a time; the command column
+// is left as typed
+func usagef(format string, args ...interface{}) {
+	if translated := translateUsage(format); translated != format {
+		fmt.Printf(translated, args...)
+		return
+	}
+	fmt.Printf(format, args...)
+}
+
+// translateUsage translates the lines of the usage text: "%sHeading:%s",
+// "  command    description", "             description continued" or
+// plain text
+func translateUsage(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		body := strings.TrimLeft(line, " ")
+		indent := line[:len(line)-len(body)]
+		switch {
+		case body == "":
+		case strings.HasPrefix(body, "%s") && strings.HasSuffix(body, "%s") && strings.Count(body, "%s") == 2:
+			lines[i] = indent + "%s" + erp.T(body[2:len(body)-2]) + "%s"
+		case strings.HasPrefix(body, "erp-cli "):
+			// examples
+		case len(indent) >= 2 && strings.Contains(body, "  "):
+			command, description, _ := strings.Cut(body, "  ")
+			gap := len(body) - len(command) - len(strings.TrimLeft(body[len(command):], " "))
+			lines[i] = indent + command + strings.Repeat(" ", gap) + erp.T(strings.TrimLeft(description, " "))
+		default:
+			lines[i] = indent + erp.T(body)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,129 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// docAddresses is the --billing-address and --shipping-address of so, dn
+// and si creation
+type docAddresses struct {
+	billing  string
+	shipping string
+}
+
+func parseAddressFlags(args []string) docAddresses {
+	a := docAddresses{}
+	for _, arg := range args {
+		switch {
+		case len(arg) > 18 && arg[:18] == "--billing-address=":
+			a.billing = arg[18:]
+		case len(arg) > 19 && arg[:19] == "--shipping-address=":
+			a.shipping = arg[19:]
+		}
+	}
+	return a
+}
+
+// addressFields are the link and display fields of the billing and
+// shipping addresses of a sales document
+var addressFields = [][2]string{
+	{"customer_address", "address_display"},
+	{"shipping_address_name", "shipping_address"},
+}
+
+// applyAddresses sets the billing and shipping addresses of a new sales
+// document once they are found among the customer's. ERPNext fills the
+// display text from the default address when it is missing, so it is set
+// along with the link.
+func (c *Client) applyAddresses(body map[string]interface{}, a docAddresses) error {
+	if a.billing == "" && a.shipping == "" {
+		return nil
+	}
+	customer := stringField(body, "customer")
+	addresses, err := c.fetchPartyAddresses("Customer", customer)
+	if err != nil {
+		return err
+	}
+	for i, name := range []string{a.billing, a.shipping} {
+		if name == "" {
+			continue
+		}
+		address := findAddress(addresses, name)
+		if address == nil {
+			return errorf("address %s is not one of %s's (see 'erp-cli customer addresses \"%s\"')", name, customer, customer)
+		}
+		body[addressFields[i][0]] = address["name"]
+		body[addressFields[i][1]] = c.addressDisplay(address)
+	}
+	return nil
+}
+
+// findAddress returns the address with the given name, ignoring case
+func findAddress(addresses []map[string]interface{}, name string) map[string]interface{} {
+	for _, address := range addresses {
+		if strings.EqualFold(stringField(address, "name"), name) {
+			return address
+		}
+	}
+	return nil
+}
+
+// addressDisplay renders an address with the site's address template, as
+// the web form shows it on documents, or on one line when that fails
+func (c *Client) addressDisplay(address map[string]interface{}) string {
+	result, err := c.CallMethod("GET", "frappe.contacts.doctype.address.address.get_address_display?address_dict="+url.QueryEscape(stringField(address, "name")), nil)
+	if err == nil {
+		if display, _ := result["message"].(string); display != "" {
+			return display
+		}
+	}
+	return formatAddress(address)
+}
+
+// copyOrderAddresses copies the addresses of the order a delivery or
+// invoice is made from, as ERPNext's own mapping does
+func copyOrderAddresses(body, order map[string]interface{}) {
+	for _, fields := range addressFields {
+		if name := stringField(order, fields[0]); name != "" {
+			body[fields[0]] = name
+			body[fields[1]] = order[fields[1]]
+		}
+	}
+}
+
+// printAddresses prints the addresses chosen for a new document
+func printAddresses(a docAddresses) {
+	if a.billing != "" {
+		printf("  Bill To: %s\n", a.billing)
+	}
+	if a.shipping != "" {
+		printf("  Ship To: %s\n", a.shipping)
+	}
+}
+
+// docAddressLines are the billing and shipping addresses of a sales
+// document, shared by the get commands and the TUI detail views
+func docAddressLines(data map[string]interface{}) []string {
+	var lines []string
+	for i, label := range []string{"Bill To", "Ship To"} {
+		name := stringField(data, addressFields[i][0])
+		if name == "" {
+			continue
+		}
+		line := fmt.Sprintf("  %s: %s", label, name)
+		if display := strings.ReplaceAll(htmlToText(stringField(data, addressFields[i][1])), "\n", ", "); display != "" {
+			line += " (" + display + ")"
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// printDocAddresses prints the address lines of a get command
+func printDocAddresses(data map[string]interface{}) {
+	for _, line := range docAddressLines(data) {
+		fmt.Println(line)
+	}
+}
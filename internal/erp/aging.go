@@ -0,0 +1,380 @@
+package erp
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// agingBuckets labels the due-date buckets; index 0 is not yet due
+var agingBuckets = []string{"Not Due", "1-30", "31-60", "61-90", "90+"}
+
+// agingBucket returns the bucket index for a number of days past due
+func agingBucket(daysOverdue int) int {
+	switch {
+	case daysOverdue <= 0:
+		return 0
+	case daysOverdue <= 30:
+		return 1
+	case daysOverdue <= 60:
+		return 2
+	case daysOverdue <= 90:
+		return 3
+	}
+	return 4
+}
+
+// agingInvoice is one outstanding invoice
+type agingInvoice struct {
+	name        string
+	party       string
+	postingDate string
+	dueDate     string
+	daysOverdue int
+	outstanding float64
+}
+
+// agingParty totals the outstanding invoices of one party per bucket
+type agingParty struct {
+	name     string
+	buckets  []float64
+	total    float64
+	invoices []agingInvoice
+}
+
+// agingOptions holds flags for the aging reports
+type agingOptions struct {
+	asOf   string // YYYY-MM-DD, default today
+	party  string
+	csv    string // Output file; empty for the terminal table
+	detail bool
+}
+
+// parseAgingOptions reads the aging flags; partyFlag is "supplier" or
+// "customer" and csvDefault names the file for a bare --csv
+func parseAgingOptions(args []string, partyFlag, csvDefault string) (agingOptions, error) {
+	opts := agingOptions{}
+	prefix := "--" + partyFlag + "="
+	for _, arg := range args {
+		if len(arg) > 8 && arg[:8] == "--as-of=" {
+			opts.asOf = arg[8:]
+		}
+		if len(arg) > len(prefix) && arg[:len(prefix)] == prefix {
+			opts.party = arg[len(prefix):]
+		}
+		if arg == "--csv" {
+			opts.csv = csvDefault
+		}
+		if len(arg) > 6 && arg[:6] == "--csv=" {
+			opts.csv = arg[6:]
+		}
+		if arg == "--detail" {
+			opts.detail = true
+		}
+	}
+	if opts.asOf != "" {
+		if _, err := time.Parse("2006-01-02", opts.asOf); err != nil {
+			return opts, errorf("invalid --as-of date (expected YYYY-MM-DD): %s", opts.asOf)
+		}
+	}
+	return opts, nil
+}
+
+// fetchAging loads the submitted invoices of doctype with an outstanding
+// amount and groups them per party, largest total first. For a past
+// --as-of date the outstanding amounts come from the payment ledger, since
+// an invoice's outstanding_amount is today's.
+func (c *Client) fetchAging(doctype, partyField string, opts agingOptions) ([]*agingParty, error) {
+	asOf, err := time.Parse("2006-01-02", opts.asOf)
+	if err != nil {
+		return nil, errorf("invalid date: %s", opts.asOf)
+	}
+
+	var invoices []agingInvoice
+	if opts.asOf < c.Today() {
+		invoices, err = c.ledgerOutstanding(doctype, opts)
+	} else {
+		invoices, err = c.currentOutstanding(doctype, partyField, opts)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	byParty := map[string]*agingParty{}
+	var parties []*agingParty
+	for _, inv := range invoices {
+		// Invoices without a due date are due on posting
+		due := inv.dueDate
+		if due == "" {
+			due = inv.postingDate
+		}
+		if d, err := time.Parse("2006-01-02", due); err == nil {
+			inv.daysOverdue = int(asOf.Sub(d).Hours() / 24)
+		}
+
+		p, ok := byParty[inv.party]
+		if !ok {
+			p = &agingParty{name: inv.party, buckets: make([]float64, len(agingBuckets))}
+			byParty[inv.party] = p
+			parties = append(parties, p)
+		}
+		p.buckets[agingBucket(inv.daysOverdue)] += inv.outstanding
+		p.total += inv.outstanding
+		p.invoices = append(p.invoices, inv)
+	}
+
+	sort.SliceStable(parties, func(i, j int) bool {
+		return parties[i].total > parties[j].total
+	})
+	return parties, nil
+}
+
+// currentOutstanding returns the invoices of doctype with an outstanding
+// amount today
+func (c *Client) currentOutstanding(doctype, partyField string, opts agingOptions) ([]agingInvoice, error) {
+	filters := [][]interface{}{
+		{"docstatus", "=", 1},
+		{"outstanding_amount", ">", 0},
+		{"posting_date", "<=", opts.asOf},
+	}
+	if opts.party != "" {
+		filters = append(filters, []interface{}{partyField, "=", opts.party})
+	}
+	encoded, err := encodeFilters(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("%s?limit_page_length=0&fields=[\"name\",\"%s\",\"posting_date\",\"due_date\",\"outstanding_amount\"]&order_by=due_date%%20asc&filters=%s",
+		url.PathEscape(doctype), partyField, encoded)
+	result, err := c.Request("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var invoices []agingInvoice
+	if data, ok := result["data"].([]interface{}); ok {
+		for _, item := range data {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			inv := agingInvoice{
+				name:        stringField(m, "name"),
+				party:       stringField(m, partyField),
+				postingDate: stringField(m, "posting_date"),
+				dueDate:     stringField(m, "due_date"),
+			}
+			inv.outstanding, _ = m["outstanding_amount"].(float64)
+			invoices = append(invoices, inv)
+		}
+	}
+	return invoices, nil
+}
+
+// ledgerOutstanding returns the invoices of doctype that were outstanding
+// on the --as-of date, summing the Payment Ledger Entries posted against
+// each invoice up to that date. The invoice's own entry gives its dates and
+// the sign of what is owed.
+func (c *Client) ledgerOutstanding(doctype string, opts agingOptions) ([]agingInvoice, error) {
+	if c.versionsKnown() && c.majorVersion("erpnext") < 14 {
+		return nil, errorf("--as-of a past date needs the payment ledger of ERPNext v14 or later (this site runs v%s)", c.versions["erpnext"])
+	}
+
+	filters := [][]interface{}{
+		{"against_voucher_type", "=", doctype},
+		{"posting_date", "<=", opts.asOf},
+		{"delinked", "=", 0},
+	}
+	if opts.party != "" {
+		filters = append(filters, []interface{}{"party", "=", opts.party})
+	}
+	encoded, err := encodeFilters(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.Request("GET", "Payment%20Ledger%20Entry?limit_page_length=0&fields=[\"voucher_no\",\"against_voucher_no\",\"party\",\"posting_date\",\"due_date\",\"amount\"]&order_by=posting_date%20asc&filters="+encoded, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := map[string]*agingInvoice{}
+	var names []string
+	sign := map[string]float64{}
+	if data, ok := result["data"].([]interface{}); ok {
+		for _, item := range data {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name := stringField(m, "against_voucher_no")
+			inv, ok := byName[name]
+			if !ok {
+				inv = &agingInvoice{name: name, party: stringField(m, "party")}
+				byName[name] = inv
+				names = append(names, name)
+			}
+			amount, _ := m["amount"].(float64)
+			inv.outstanding += amount
+			if stringField(m, "voucher_no") == name {
+				inv.postingDate = stringField(m, "posting_date")
+				inv.dueDate = stringField(m, "due_date")
+				sign[name] = 1
+				if amount < 0 {
+					sign[name] = -1
+				}
+			}
+		}
+	}
+
+	var invoices []agingInvoice
+	for _, name := range names {
+		inv := byName[name]
+		if sign[name] == 0 {
+			continue
+		}
+		inv.outstanding *= sign[name]
+		if inv.outstanding > 0.005 {
+			invoices = append(invoices, *inv)
+		}
+	}
+	sort.SliceStable(invoices, func(i, j int) bool { return invoices[i].dueDate < invoices[j].dueDate })
+	return invoices, nil
+}
+
+// printAging renders the per-party bucket table
+func (c *Client) printAging(title, partyLabel string, parties []*agingParty, opts agingOptions) {
+	fmt.Printf("%s══════════════════════════════════════════════════════════════%s\n", Cyan, Reset)
+	printf("%s  %s (as of %s)%s\n", Cyan, title, opts.asOf, Reset)
+	fmt.Printf("%s══════════════════════════════════════════════════════════════%s\n\n", Cyan, Reset)
+
+	if len(parties) == 0 {
+		printf("%sNo outstanding invoices%s\n", Yellow, Reset)
+		return
+	}
+
+	fmt.Printf("  %-28s", partyLabel)
+	for _, label := range agingBuckets {
+		fmt.Printf(" %12s", label)
+	}
+	fmt.Printf(" %13s\n", T("Total"))
+
+	totals := make([]float64, len(agingBuckets))
+	grandTotal := 0.0
+	for _, p := range parties {
+		name := p.name
+		if len(name) > 28 {
+			name = name[:25] + "..."
+		}
+		fmt.Printf("  %-28s", name)
+		for i, amount := range p.buckets {
+			totals[i] += amount
+			fmt.Printf(" %12s", c.agingAmount(amount))
+		}
+		grandTotal += p.total
+		fmt.Printf(" %13s\n", c.FormatCurrency(p.total))
+
+		if opts.detail {
+			for _, inv := range p.invoices {
+				overdue := T("not due")
+				color := Reset
+				if inv.daysOverdue > 0 {
+					overdue = tf("%d days overdue", inv.daysOverdue)
+					color = Red
+				}
+				printf("    %s  due %s  %s  %s%s%s\n",
+					inv.name, inv.dueDate, c.FormatCurrency(inv.outstanding), color, overdue, Reset)
+			}
+		}
+	}
+
+	fmt.Printf("  %-28s", T("Total"))
+	for _, amount := range totals {
+		fmt.Printf(" %12s", c.agingAmount(amount))
+	}
+	fmt.Printf(" %13s\n", c.FormatCurrency(grandTotal))
+
+	overdue := grandTotal - totals[0]
+	fmt.Println()
+	printf("  Outstanding: %s | %sOverdue: %s%s | %d %s\n",
+		c.FormatCurrency(grandTotal), Red, c.FormatCurrency(overdue), Reset, len(parties), partyLabel)
+}
+
+// agingAmount leaves empty buckets blank so the table stays readable
+func (c *Client) agingAmount(amount float64) string {
+	if amount == 0 {
+		return "-"
+	}
+	return c.FormatCurrency(amount)
+}
+
+// writeAgingCSV writes one row per outstanding invoice
+func writeAgingCSV(path, partyColumn string, parties []*agingParty) (int, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return 0, errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	header := []string{partyColumn, "invoice", "posting_date", "due_date", "days_overdue", "bucket", "outstanding_amount"}
+	if err := writer.Write(header); err != nil {
+		return 0, errorf("failed to write CSV header: %w", err)
+	}
+
+	count := 0
+	for _, p := range parties {
+		for _, inv := range p.invoices {
+			row := []string{
+				inv.party,
+				inv.name,
+				inv.postingDate,
+				inv.dueDate,
+				strconv.Itoa(inv.daysOverdue),
+				agingBuckets[agingBucket(inv.daysOverdue)],
+				strconv.FormatFloat(inv.outstanding, 'f', 2, 64),
+			}
+			if err := writer.Write(row); err != nil {
+				return count, errorf("failed to write CSV row: %w", err)
+			}
+			count++
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return count, errorf("failed to write CSV: %w", err)
+	}
+	return count, nil
+}
+
+// reportAP shows Accounts Payable aging from unpaid Purchase Invoices
+func (c *Client) reportAP(opts agingOptions) error {
+	printf("%sGenerating accounts payable aging...%s\n\n", Blue, Reset)
+
+	if opts.asOf == "" {
+		opts.asOf = c.Today()
+	}
+	parties, err := c.fetchAging("Purchase Invoice", "supplier", opts)
+	if err != nil {
+		return err
+	}
+
+	if opts.csv != "" {
+		count, err := writeAgingCSV(opts.csv, "supplier", parties)
+		if err != nil {
+			return err
+		}
+		printf("%s✓ Exported %d invoices to %s%s\n", Green, count, opts.csv, Reset)
+		return nil
+	}
+
+	c.printAging("ACCOUNTS PAYABLE AGING", "Suppliers", parties, opts)
+	printf("\n%sGenerated: %s%s\n", Cyan, c.Timestamp(), Reset)
+	return nil
+}
@@ -0,0 +1,197 @@
+package erp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// maxAliasDepth bounds alias-to-alias expansion
+const maxAliasDepth = 10
+
+// repeatableFlags are the flags a command takes more than once, whose
+// defaults add to those on the command line instead of giving way
+var repeatableFlags = map[string]bool{
+	"--filter": true, "--set": true, "--item": true, "--attr": true,
+	"--event": true, "--debit": true, "--credit": true, "--receipt": true,
+}
+
+// ExpandAlias replaces a leading alias in args with its command and default
+// arguments, e.g. with alias.sol="so list --status=Draft" the args
+// "sol --customer=Acme" become "so list --status=Draft --customer=Acme".
+// Aliases may point to other aliases; each name is expanded at most once.
+func (c *Config) ExpandAlias(args []string) ([]string, error) {
+	seen := map[string]bool{}
+	for depth := 0; len(args) > 0 && depth < maxAliasDepth; depth++ {
+		value, ok := c.Aliases[args[0]]
+		if !ok || seen[args[0]] {
+			return args, nil
+		}
+		seen[args[0]] = true
+
+		expanded, err := splitArgs(value)
+		if err != nil {
+			return nil, errorf("alias %s: %w", args[0], err)
+		}
+		if len(expanded) == 0 {
+			return nil, errorf("alias %s is empty", args[0])
+		}
+		args = append(expanded, args[1:]...)
+	}
+	return args, nil
+}
+
+// ExpandDefaults appends the default flags configured for a command, e.g.
+// with defaults.si.list="--status=Unpaid --limit=50" the args "si list
+// --limit=10" become "si list --limit=10 --status=Unpaid". Subcommand
+// defaults come before the command's (defaults.si); a flag already on the
+// command line, or added by a more specific default, is not repeated,
+// except repeatable ones (--filter, --set, ...), which are always appended.
+func (c *Config) ExpandDefaults(args []string) ([]string, error) {
+	if len(args) == 0 || len(c.CommandDefaults) == 0 {
+		return args, nil
+	}
+	keys := []string{args[0]}
+	if len(args) > 1 && !strings.HasPrefix(args[1], "-") {
+		keys = []string{args[0] + "." + args[1], args[0]}
+	}
+
+	given := map[string]bool{}
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "--") {
+			name, _, _ := strings.Cut(arg, "=")
+			given[name] = true
+		}
+	}
+	expanded := args
+	for _, key := range keys {
+		value, ok := c.CommandDefaults[key]
+		if !ok {
+			continue
+		}
+		flags, err := splitArgs(value)
+		if err != nil {
+			return nil, errorf("defaults.%s: %w", key, err)
+		}
+		for i := 0; i < len(flags); i++ {
+			flag := flags[i]
+			if !strings.HasPrefix(flag, "--") {
+				return nil, errorf("defaults.%s: %q is not a flag (use --flag or --flag=value)", key, flag)
+			}
+			name, _, hasValue := strings.Cut(flag, "=")
+			if repeatableFlags[name] {
+				expanded = append(expanded, flag)
+				// "--filter key=value" takes the next word as its value
+				if !hasValue && i+1 < len(flags) && !strings.HasPrefix(flags[i+1], "--") {
+					expanded = append(expanded, flags[i+1])
+					i++
+				}
+				continue
+			}
+			if given[name] {
+				continue
+			}
+			given[name] = true
+			expanded = append(expanded, flag)
+		}
+	}
+	return expanded, nil
+}
+
+// splitArgs splits an alias value into arguments like a shell would,
+// honouring single and double quotes and backslash escapes
+func splitArgs(s string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	inArg := false
+	var quote rune
+	escaped := false
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inArg = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, errorf("unterminated %c quote", quote)
+	}
+	if escaped {
+		return nil, errorf("trailing backslash")
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
+
+// CmdAlias lists the command aliases and default flags defined in the config
+func (c *Client) CmdAlias(args []string) error {
+	if len(c.Config.Aliases) == 0 && len(c.Config.CommandDefaults) == 0 {
+		printf("%sNo aliases defined%s\n", Yellow, Reset)
+		fmt.Println()
+		fmt.Println(T("Add them to .erp-config, one per line:"))
+		fmt.Println(T("  alias.rcv=\"stock receive\""))
+		fmt.Println(T("  alias.sol=\"so list --status='To Deliver and Bill'\""))
+		fmt.Println()
+		fmt.Println(T("Default flags per command work the same way (--no-defaults skips them):"))
+		fmt.Println(T("  defaults.si.list=\"--status=Unpaid --limit=50\""))
+		fmt.Println(T("A flag given on the command line replaces its default, except repeatable"))
+		fmt.Println(T("ones (--filter, --set, --item, ...), whose defaults are always added."))
+		return nil
+	}
+
+	if len(c.Config.Aliases) > 0 {
+		printAliasTable("Aliases", c.Config.Aliases)
+	}
+	if len(c.Config.CommandDefaults) > 0 {
+		if len(c.Config.Aliases) > 0 {
+			fmt.Println()
+		}
+		printAliasTable("Command defaults", c.Config.CommandDefaults)
+	}
+	return nil
+}
+
+// printAliasTable lists config entries by name, flagging values that do
+// not split into arguments
+func printAliasTable(title string, entries map[string]string) {
+	names := make([]string, 0, len(entries))
+	for name := range entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Printf("%s%s (%d):%s\n", Cyan, T(title), len(names), Reset)
+	for _, name := range names {
+		value := entries[name]
+		if _, err := splitArgs(value); err != nil {
+			printf("  %-12s %s %s(invalid: %s)%s\n", name, value, Red, err, Reset)
+			continue
+		}
+		fmt.Printf("  %-12s %s\n", name, value)
+	}
+}
@@ -0,0 +1,250 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	barWidth     = 30 // Characters of the longest bar
+	analyticsTop = 10 // Customers and items listed
+)
+
+// salesReportOptions holds flags for report sales
+type salesReportOptions struct {
+	weekly bool
+	last   int
+}
+
+func parseSalesReportOptions(args []string) (salesReportOptions, error) {
+	opts := salesReportOptions{last: 12}
+	for _, arg := range args {
+		if len(arg) > 9 && arg[:9] == "--period=" {
+			switch arg[9:] {
+			case "monthly":
+				opts.weekly = false
+			case "weekly":
+				opts.weekly = true
+			default:
+				return opts, errorf("invalid period: %s (use monthly or weekly)", arg[9:])
+			}
+		}
+		if len(arg) > 7 && arg[:7] == "--last=" {
+			n, err := strconv.Atoi(arg[7:])
+			if err != nil || n < 1 {
+				return opts, errorf("invalid --last: %s (expected a positive number)", arg[7:])
+			}
+			opts.last = n
+		}
+	}
+	return opts, nil
+}
+
+// salesPeriod is one bucket of the revenue trend
+type salesPeriod struct {
+	label    string
+	start    string // YYYY-MM-DD, inclusive
+	revenue  float64
+	invoices int
+}
+
+// rankedAmount is a name with a total, used for the top lists
+type rankedAmount struct {
+	name   string
+	amount float64
+}
+
+// salesPeriods returns the last n months or weeks up to today, oldest first
+func salesPeriods(today time.Time, opts salesReportOptions) []salesPeriod {
+	periods := make([]salesPeriod, opts.last)
+	if opts.weekly {
+		offset := (int(today.Weekday()) + 6) % 7
+		monday := today.AddDate(0, 0, -offset)
+		for i := range periods {
+			start := monday.AddDate(0, 0, -7*(opts.last-1-i))
+			_, week := start.ISOWeek()
+			periods[i] = salesPeriod{label: fmt.Sprintf("W%02d %s", week, start.Format("01-02")), start: start.Format("2006-01-02")}
+		}
+		return periods
+	}
+
+	first := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for i := range periods {
+		start := first.AddDate(0, -(opts.last - 1 - i), 0)
+		periods[i] = salesPeriod{label: start.Format("2006-01"), start: start.Format("2006-01-02")}
+	}
+	return periods
+}
+
+// periodIndex returns the period a posting date falls into, or -1
+func periodIndex(periods []salesPeriod, date string) int {
+	for i := len(periods) - 1; i >= 0; i-- {
+		if date >= periods[i].start {
+			return i
+		}
+	}
+	return -1
+}
+
+// bar draws value as a horizontal bar scaled against largest
+func bar(value, largest float64) string {
+	return scaledBar(value, largest, barWidth)
+}
+
+// scaledBar is bar with the longest bar width characters long
+func scaledBar(value, largest float64, width int) string {
+	if largest <= 0 || value <= 0 {
+		return ""
+	}
+	n := int(value / largest * float64(width))
+	if n == 0 {
+		return asciiText("▏")
+	}
+	return asciiText(strings.Repeat("█", n))
+}
+
+// topAmounts sorts totals descending and keeps the first n
+func topAmounts(totals map[string]float64, n int) []rankedAmount {
+	ranked := make([]rankedAmount, 0, len(totals))
+	for name, amount := range totals {
+		ranked = append(ranked, rankedAmount{name, amount})
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].amount != ranked[j].amount {
+			return ranked[i].amount > ranked[j].amount
+		}
+		return ranked[i].name < ranked[j].name
+	})
+	if len(ranked) > n {
+		ranked = ranked[:n]
+	}
+	return ranked
+}
+
+// reportSales aggregates submitted Sales Invoices into revenue per period,
+// top customers and top items. Amounts are net of taxes in company currency;
+// credit notes count as negative revenue.
+func (c *Client) reportSales(opts salesReportOptions) error {
+	today, err := time.Parse("2006-01-02", c.Today())
+	if err != nil {
+		return err
+	}
+	periods := salesPeriods(today, opts)
+	from, to := periods[0].start, today.Format("2006-01-02")
+
+	periodName := "months"
+	if opts.weekly {
+		periodName = "weeks"
+	}
+	printf("%sGenerating sales report for the last %d %s...%s\n\n", Blue, opts.last, periodName, Reset)
+
+	// Pre-fetch currency
+	c.GetCurrency()
+
+	filters, err := encodeFilters([][]interface{}{
+		{"docstatus", "=", 1},
+		{"posting_date", "between", []string{from, to}},
+	})
+	if err != nil {
+		return err
+	}
+
+	result, err := c.Request("GET", "Sales%20Invoice?limit_page_length=0&filters="+filters+"&fields=[\"name\",\"posting_date\",\"customer\",\"base_net_total\"]", nil)
+	if err != nil {
+		return err
+	}
+
+	customers := map[string]float64{}
+	total := 0.0
+	count := 0
+	if rows, ok := result["data"].([]interface{}); ok {
+		for _, row := range rows {
+			m, ok := row.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			amount, _ := m["base_net_total"].(float64)
+			i := periodIndex(periods, stringField(m, "posting_date"))
+			if i < 0 {
+				continue
+			}
+			periods[i].revenue += amount
+			periods[i].invoices++
+			customers[stringField(m, "customer")] += amount
+			total += amount
+			count++
+		}
+	}
+
+	if count == 0 {
+		printf("%sNo submitted sales invoices since %s%s\n", Yellow, from, Reset)
+		return nil
+	}
+
+	fields := url.QueryEscape("[\"`tabSales Invoice Item`.item_code\",\"`tabSales Invoice Item`.base_net_amount\"]")
+	result, err = c.Request("GET", "Sales%20Invoice?limit_page_length=0&filters="+filters+"&fields="+fields, nil)
+	if err != nil {
+		return err
+	}
+	items := map[string]float64{}
+	if rows, ok := result["data"].([]interface{}); ok {
+		for _, row := range rows {
+			if m, ok := row.(map[string]interface{}); ok {
+				amount, _ := m["base_net_amount"].(float64)
+				items[stringField(m, "item_code")] += amount
+			}
+		}
+	}
+
+	fmt.Printf("%s══════════════════════════════════════════════════════════════%s\n", Cyan, Reset)
+	printf("%s                       SALES REPORT                           %s\n", Cyan, Reset)
+	fmt.Printf("%s══════════════════════════════════════════════════════════════%s\n\n", Cyan, Reset)
+
+	printf("%sRevenue per %s (%s to %s):%s\n", Yellow, strings.TrimSuffix(periodName, "s"), from, to, Reset)
+	maxRevenue := 0.0
+	for _, p := range periods {
+		if p.revenue > maxRevenue {
+			maxRevenue = p.revenue
+		}
+	}
+	for _, p := range periods {
+		printf("  %-9s %14s %4d inv  %s%s%s\n",
+			p.label, c.FormatCurrency(p.revenue), p.invoices, Green, bar(p.revenue, maxRevenue), Reset)
+	}
+	printf("  %sTotal: %s from %d invoices | Average per %s: %s%s\n\n",
+		Cyan, c.FormatCurrency(total), count, strings.TrimSuffix(periodName, "s"),
+		c.FormatCurrency(total/float64(len(periods))), Reset)
+
+	c.printRanked("Top customers:", topAmounts(customers, analyticsTop), total)
+	fmt.Println()
+	c.printRanked("Top items:", topAmounts(items, analyticsTop), total)
+
+	printf("\n%sGenerated: %s%s\n", Cyan, c.Timestamp(), Reset)
+	return nil
+}
+
+// printRanked prints a numbered top list with its share of total and a bar
+func (c *Client) printRanked(title string, ranked []rankedAmount, total float64) {
+	fmt.Printf("%s%s%s\n", Yellow, T(title), Reset)
+	if len(ranked) == 0 {
+		fmt.Println(T("  None"))
+		return
+	}
+	largest := ranked[0].amount
+	for i, r := range ranked {
+		name := r.name
+		if len(name) > 28 {
+			name = name[:25] + "..."
+		}
+		share := 0.0
+		if total != 0 {
+			share = r.amount / total * 100
+		}
+		fmt.Printf("  %2d. %-28s %14s %5.1f%%  %s%s%s\n",
+			i+1, name, c.FormatCurrency(r.amount), share, Blue, bar(r.amount, largest), Reset)
+	}
+}
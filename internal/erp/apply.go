@@ -0,0 +1,534 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Declarative apply: a YAML manifest declares master data (item groups,
+// attributes, warehouses, price lists and templates) and apply creates what
+// is missing and updates the declared fields that differ. Fields the
+// manifest leaves out are not touched, child rows are only added, and
+// nothing is deleted, so a manifest can describe part of the catalog.
+//
+//	item_groups:
+//	  - name: CPUs
+//	    parent: Components
+//	attributes:
+//	  - name: Brand
+//	    values: [Intel, AMD]        # or "- Intel: INT" with an abbreviation
+//	warehouses:
+//	  - name: Showroom
+//	    parent: All Warehouses - AC
+//	price_lists:
+//	  - name: Retail EUR
+//	    currency: EUR
+//	    selling: true
+//	templates:
+//	  - item_code: CPU
+//	    item_group: CPUs
+//	    attributes: [Brand]
+
+// applySections are the manifest sections, in the order they are applied
+// so documents exist before others link to them
+var applySections = []string{"item_groups", "attributes", "warehouses", "price_lists", "templates"}
+
+// applyDoc is a document a manifest declares
+type applyDoc struct {
+	doctype  string
+	label    string                 // Name shown in the plan
+	filters  [][]interface{}        // Find the existing document
+	fields   map[string]interface{} // Declared fields, kept in sync
+	defaults map[string]interface{} // Only set when creating
+	tables   map[string]applyTable  // Declared child rows, added when missing
+}
+
+// applyTable is a declared child table, its rows told apart by key
+type applyTable struct {
+	key  string
+	rows []map[string]interface{}
+}
+
+// CmdApply reconciles the server with a manifest
+func (c *Client) CmdApply(args []string) error {
+	path, dryRun := "", false
+	for i, arg := range args {
+		if arg == "-f" && i+1 < len(args) {
+			path = args[i+1]
+		}
+		if len(arg) > 7 && arg[:7] == "--file=" {
+			path = arg[7:]
+		}
+		if arg == "--dry-run" {
+			dryRun = true
+		}
+	}
+	if path == "" {
+		fmt.Println(T("Usage: erp-cli apply -f <manifest.yaml> [--dry-run]"))
+		fmt.Println()
+		fmt.Println(T("Creates the documents a manifest declares and updates the declared fields"))
+		fmt.Println(T("that differ on the server. Undeclared fields and documents are left as"))
+		fmt.Println(T("they are; child rows (attribute values, template attributes) are added."))
+		fmt.Println(T("--dry-run prints the changes without making them."))
+		fmt.Println()
+		fmt.Println(T("Sections and keys:"))
+		fmt.Println(T("  item_groups:  name, parent, is_group"))
+		fmt.Println(T("  attributes:   name, values (list, \"Value\" or \"Value: ABBR\"), numeric,"))
+		fmt.Println(T("                from, to, increment"))
+		fmt.Println(T("  warehouses:   name, parent, is_group"))
+		fmt.Println(T("  price_lists:  name, currency, buying, selling, enabled"))
+		fmt.Println(T("  templates:    item_code, item_name, item_group, description, stock_uom,"))
+		fmt.Println(T("                brand, attributes (list)"))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli apply -f catalog.yaml --dry-run"))
+		fmt.Println(T("  erp-cli apply -f catalog.yaml"))
+		return nil
+	}
+
+	docs, err := c.loadManifest(path)
+	if err != nil {
+		return err
+	}
+	if len(docs) == 0 {
+		printf("%sNothing declared in %s%s\n", Yellow, path, Reset)
+		return nil
+	}
+	if dryRun {
+		printf("%s[DRY RUN] Comparing %d document(s) with the server...%s\n", Yellow, len(docs), Reset)
+	} else {
+		printf("%sApplying %d document(s)...%s\n", Blue, len(docs), Reset)
+	}
+
+	created, updated, unchanged, failed := 0, 0, 0, 0
+	for _, doc := range docs {
+		action, err := c.applyOne(doc, dryRun)
+		switch {
+		case err != nil:
+			fmt.Printf("  %s✗ %s %s: %s%s\n", Red, doc.doctype, doc.label, err, Reset)
+			failed++
+		case action == "create":
+			created++
+		case action == "update":
+			updated++
+		default:
+			unchanged++
+		}
+	}
+
+	fmt.Println()
+	if dryRun {
+		printf("%sTo create: %d, To update: %d, Unchanged: %d%s\n", Yellow, created, updated, unchanged, Reset)
+	} else {
+		printf("%sCreated: %d, Updated: %d, Unchanged: %d%s\n", Green, created, updated, unchanged, Reset)
+	}
+	if failed > 0 {
+		return errorf("%d document(s) failed", failed)
+	}
+	return nil
+}
+
+// applyOne creates or updates one declared document, or only prints the
+// plan with dryRun. It returns "create", "update" or "" when in sync.
+func (c *Client) applyOne(doc applyDoc, dryRun bool) (string, error) {
+	endpoint := url.PathEscape(doc.doctype)
+	filters, err := encodeFilters(doc.filters)
+	if err != nil {
+		return "", err
+	}
+	result, err := c.Request("GET", endpoint+"?fields=[\"name\"]&filters="+filters, nil)
+	if err != nil {
+		return "", err
+	}
+	found, _ := result["data"].([]interface{})
+
+	if len(found) == 0 {
+		fmt.Printf("  %s+ %s %s%s\n", Green, doc.doctype, doc.label, Reset)
+		body := map[string]interface{}{}
+		for field, value := range doc.defaults {
+			body[field] = value
+		}
+		for field, value := range doc.fields {
+			body[field] = value
+		}
+		for field, table := range doc.tables {
+			body[field] = table.rows
+		}
+		if dryRun {
+			return "create", nil
+		}
+		if _, err := c.Request("POST", endpoint, body); err != nil {
+			return "", err
+		}
+		return "create", nil
+	}
+
+	first, _ := found[0].(map[string]interface{})
+	name := stringField(first, "name")
+	result, err = c.Request("GET", endpoint+"/"+url.PathEscape(name), nil)
+	if err != nil {
+		return "", err
+	}
+	current, _ := result["data"].(map[string]interface{})
+
+	changes := map[string]interface{}{}
+	var lines []string
+	var fields []string
+	for field := range doc.fields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		value := doc.fields[field]
+		if applyValue(current[field]) != applyValue(value) {
+			changes[field] = value
+			lines = append(lines, fmt.Sprintf("%s: %q → %q", field, applyValue(current[field]), applyValue(value)))
+		}
+	}
+	for field, table := range doc.tables {
+		rows := docRows(current[field])
+		have := map[string]bool{}
+		for _, row := range rows {
+			have[applyValue(row[table.key])] = true
+		}
+		added := false
+		for _, row := range table.rows {
+			if key := applyValue(row[table.key]); !have[key] {
+				rows = append(rows, row)
+				lines = append(lines, fmt.Sprintf("%s: + %s", field, key))
+				added = true
+			}
+		}
+		if added {
+			changes[field] = rows
+		}
+	}
+	if len(changes) == 0 {
+		return "", nil
+	}
+
+	fmt.Printf("  %s~ %s %s%s\n", Yellow, doc.doctype, name, Reset)
+	for _, line := range lines {
+		fmt.Printf("      %s\n", line)
+	}
+	if dryRun {
+		return "update", nil
+	}
+	if _, err := c.Request("PUT", endpoint+"/"+url.PathEscape(name), changes); err != nil {
+		return "", err
+	}
+	return "update", nil
+}
+
+// applyValue renders a field value for comparison, so 1 and 1.0 match
+func applyValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(v)
+	case string:
+		return v
+	}
+	return fmt.Sprint(value)
+}
+
+// loadManifest reads a manifest into the documents it declares, in the
+// order they are applied
+func (c *Client) loadManifest(path string) ([]applyDoc, error) {
+	root, err := parseManifestFile(path)
+	if err != nil {
+		return nil, err
+	}
+	top, ok := root.(map[string]interface{})
+	if !ok && root != nil {
+		return nil, errorf("%s: expected sections (item_groups:, attributes:, ...)", path)
+	}
+	for section := range top {
+		known := false
+		for _, s := range applySections {
+			known = known || s == section
+		}
+		if !known {
+			return nil, errorf("%s: unknown section %q (expected %s)", path, section, strings.Join(applySections, ", "))
+		}
+	}
+
+	var docs []applyDoc
+	for _, section := range applySections {
+		entries, ok := top[section].([]interface{})
+		if top[section] != nil && !ok {
+			return nil, errorf("%s: %s must be a list", path, section)
+		}
+		for i, e := range entries {
+			entry, ok := e.(map[string]interface{})
+			if !ok {
+				return nil, errorf("%s[%d]: expected key: value pairs", section, i+1)
+			}
+			doc, err := c.manifestDoc(section, entry)
+			if err != nil {
+				return nil, fmt.Errorf("%s[%d]: %w", section, i+1, err)
+			}
+			docs = append(docs, doc)
+		}
+	}
+	return docs, nil
+}
+
+// manifestDoc turns a manifest entry into the document it declares
+func (c *Client) manifestDoc(section string, entry map[string]interface{}) (applyDoc, error) {
+	e := manifestEntry(entry)
+	doc := applyDoc{fields: map[string]interface{}{}, defaults: map[string]interface{}{}, tables: map[string]applyTable{}}
+
+	switch section {
+	case "item_groups":
+		if err := e.only("name", "parent", "is_group"); err != nil {
+			return doc, err
+		}
+		doc.doctype, doc.label = "Item Group", e.str("name")
+		doc.filters = [][]interface{}{{"name", "=", doc.label}}
+		doc.fields["item_group_name"] = doc.label
+		doc.defaults["parent_item_group"] = "All Item Groups"
+		e.copy(doc.fields, "parent", "parent_item_group")
+		if err := e.flag(doc.fields, "is_group", "is_group"); err != nil {
+			return doc, err
+		}
+
+	case "attributes":
+		if err := e.only("name", "values", "numeric", "from", "to", "increment"); err != nil {
+			return doc, err
+		}
+		doc.doctype, doc.label = "Item Attribute", e.str("name")
+		doc.filters = [][]interface{}{{"name", "=", doc.label}}
+		doc.fields["attribute_name"] = doc.label
+		if err := e.flag(doc.fields, "numeric", "numeric_values"); err != nil {
+			return doc, err
+		}
+		for key, field := range map[string]string{"from": "from_range", "to": "to_range", "increment": "increment"} {
+			if err := e.number(doc.fields, key, field); err != nil {
+				return doc, err
+			}
+		}
+		if values, ok := entry["values"]; ok {
+			list, ok := values.([]interface{})
+			if !ok {
+				return doc, errorf("values must be a list")
+			}
+			table := applyTable{key: "attribute_value"}
+			for _, v := range list {
+				value, abbr := "", ""
+				if m, ok := v.(map[string]interface{}); ok {
+					for k, a := range m {
+						value = k
+						abbr, _ = manifestString(a)
+					}
+				} else {
+					value, _ = manifestString(v)
+				}
+				if value == "" {
+					return doc, errorf("invalid value in %s", e.str("name"))
+				}
+				if abbr == "" {
+					abbr = valueAbbr(value)
+				}
+				table.rows = append(table.rows, map[string]interface{}{"attribute_value": value, "abbr": abbr})
+			}
+			doc.tables["item_attribute_values"] = table
+		}
+
+	case "warehouses":
+		if err := e.only("name", "parent", "is_group"); err != nil {
+			return doc, err
+		}
+		company, err := c.GetCompany()
+		if err != nil {
+			return doc, err
+		}
+		doc.doctype, doc.label = "Warehouse", e.str("name")
+		doc.filters = [][]interface{}{{"warehouse_name", "=", doc.label}, {"company", "=", company}}
+		doc.fields["warehouse_name"] = doc.label
+		doc.defaults["company"] = company
+		e.copy(doc.fields, "parent", "parent_warehouse")
+		if err := e.flag(doc.fields, "is_group", "is_group"); err != nil {
+			return doc, err
+		}
+
+	case "price_lists":
+		if err := e.only("name", "currency", "buying", "selling", "enabled"); err != nil {
+			return doc, err
+		}
+		doc.doctype, doc.label = "Price List", e.str("name")
+		doc.filters = [][]interface{}{{"name", "=", doc.label}}
+		doc.fields["price_list_name"] = doc.label
+		e.copy(doc.fields, "currency", "currency")
+		for _, key := range []string{"buying", "selling", "enabled"} {
+			if err := e.flag(doc.fields, key, key); err != nil {
+				return doc, err
+			}
+		}
+
+	case "templates":
+		if err := e.only("item_code", "item_name", "item_group", "description", "stock_uom", "brand", "attributes"); err != nil {
+			return doc, err
+		}
+		doc.doctype, doc.label = "Item", e.str("item_code")
+		if doc.label == "" {
+			return doc, errorf("item_code is required")
+		}
+		doc.filters = [][]interface{}{{"name", "=", doc.label}}
+		doc.defaults = c.newItemBody()
+		doc.defaults["item_code"] = doc.label
+		doc.defaults["item_name"] = doc.label
+		doc.fields["has_variants"] = 1
+		for _, key := range []string{"item_name", "item_group", "description", "stock_uom", "brand"} {
+			e.copy(doc.fields, key, key)
+		}
+		if attrs, ok := entry["attributes"]; ok {
+			list, ok := attrs.([]interface{})
+			if !ok || len(list) == 0 {
+				return doc, errorf("attributes must be a list")
+			}
+			table := applyTable{key: "attribute"}
+			for _, a := range list {
+				name, ok := manifestString(a)
+				if !ok || name == "" {
+					return doc, errorf("invalid attribute in %s", doc.label)
+				}
+				table.rows = append(table.rows, map[string]interface{}{"attribute": name})
+			}
+			doc.tables["attributes"] = table
+		}
+	}
+
+	if doc.label == "" {
+		return doc, errorf("name is required")
+	}
+	return doc, nil
+}
+
+// manifestEntry is one entry of a manifest section
+type manifestEntry map[string]interface{}
+
+// only fails for keys other than those given, so typos do not go unnoticed
+func (e manifestEntry) only(keys ...string) error {
+	for key := range e {
+		known := false
+		for _, k := range keys {
+			known = known || k == key
+		}
+		if !known {
+			return errorf("unknown key %q (expected %s)", key, strings.Join(keys, ", "))
+		}
+	}
+	return nil
+}
+
+// str returns a scalar value, empty when missing
+func (e manifestEntry) str(key string) string {
+	s, _ := manifestString(e[key])
+	return s
+}
+
+// copy sets a declared scalar on the document
+func (e manifestEntry) copy(fields map[string]interface{}, key, field string) {
+	if value, ok := manifestString(e[key]); ok {
+		fields[field] = value
+	}
+}
+
+// manifestString returns a scalar as written, so a name YAML would read as
+// a number or a boolean (007, 1.10, yes) keeps its text
+func manifestString(value interface{}) (string, bool) {
+	node, ok := value.(*yaml.Node)
+	if !ok {
+		return "", false
+	}
+	return node.Value, true
+}
+
+// flag sets a declared true/false value as 1 or 0
+func (e manifestEntry) flag(fields map[string]interface{}, key, field string) error {
+	value, ok := e[key]
+	if !ok {
+		return nil
+	}
+	text, _ := manifestString(value)
+	switch strings.ToLower(text) {
+	case "true", "yes", "1":
+		fields[field] = 1
+	case "false", "no", "0":
+		fields[field] = 0
+	default:
+		return errorf("%s must be true or false, got %q", key, text)
+	}
+	return nil
+}
+
+// number sets a declared numeric value
+func (e manifestEntry) number(fields map[string]interface{}, key, field string) error {
+	value, ok := e[key]
+	if !ok {
+		return nil
+	}
+	// A number is a plain int or float scalar; YAML reads 0x1F and 1e3 too
+	node, ok := value.(*yaml.Node)
+	var n float64
+	if !ok || (node.Tag != "!!int" && node.Tag != "!!float") || node.Decode(&n) != nil {
+		text, _ := manifestString(value)
+		return errorf("%s must be a number, got %q", key, text)
+	}
+	fields[field] = n
+	return nil
+}
+
+// parseManifestFile reads a YAML manifest into maps and lists whose
+// scalars are their *yaml.Node, so their text is kept as written
+func parseManifestFile(path string) (interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errorf("failed to open manifest: %w", err)
+	}
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return manifestValue(&root), nil
+}
+
+// manifestValue converts a YAML node: mappings to map[string]interface{},
+// sequences to []interface{}, null to nil and other scalars to their node
+func manifestValue(node *yaml.Node) interface{} {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil
+		}
+		return manifestValue(node.Content[0])
+	case yaml.AliasNode:
+		return manifestValue(node.Alias)
+	case yaml.MappingNode:
+		values := map[string]interface{}{}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			values[node.Content[i].Value] = manifestValue(node.Content[i+1])
+		}
+		return values
+	case yaml.SequenceNode:
+		items := make([]interface{}, len(node.Content))
+		for i, item := range node.Content {
+			items[i] = manifestValue(item)
+		}
+		return items
+	}
+	if node.Tag == "!!null" {
+		return nil
+	}
+	return node
+}
@@ -0,0 +1,69 @@
+package erp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestManifestKeepsScalarText(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "manifest.yaml")
+	manifest := `attributes:
+  - name: Size
+    values: [01, 02]
+  - name: Speed
+    numeric: yes
+    from: 0x1F
+    to: 1e3
+templates:
+  - item_code: 007
+    item_name: 1.10
+    attributes: [Size]
+`
+	if err := os.WriteFile(path, []byte(manifest), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	c := NewClient(&Config{})
+	docs, err := c.loadManifest(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(docs) != 3 {
+		t.Fatalf("got %d documents, want 3", len(docs))
+	}
+
+	var values []string
+	for _, row := range docs[0].tables["item_attribute_values"].rows {
+		values = append(values, row["attribute_value"].(string))
+	}
+	if len(values) != 2 || values[0] != "01" || values[1] != "02" {
+		t.Errorf("attribute values: got %v, want [01 02]", values)
+	}
+
+	if got := docs[1].fields["from_range"]; got != float64(31) {
+		t.Errorf("from: got %v, want 31", got)
+	}
+	if got := docs[1].fields["to_range"]; got != float64(1000) {
+		t.Errorf("to: got %v, want 1000", got)
+	}
+
+	template := docs[2]
+	if template.label != "007" {
+		t.Errorf("item_code: got %q, want 007", template.label)
+	}
+	if got := template.fields["item_name"]; got != "1.10" {
+		t.Errorf("item_name: got %v, want 1.10", got)
+	}
+}
+
+func TestManifestNumberNeedsNumber(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "manifest.yaml")
+	manifest := "attributes:\n  - name: Speed\n    from: \"10\"\n"
+	if err := os.WriteFile(path, []byte(manifest), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewClient(&Config{}).loadManifest(path); err == nil {
+		t.Error("a quoted from: was taken as a number")
+	}
+}
@@ -0,0 +1,447 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// assetCreateOptions holds flags for asset create
+type assetCreateOptions struct {
+	receipt   string
+	location  string // Needed when there is more than one Location
+	available string // Available-for-use date, defaults to the receipt date
+	grouped   bool   // One asset per receipt row instead of one per unit
+	submit    bool
+}
+
+// CmdAsset handles fixed Asset commands
+func (c *Client) CmdAsset(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli asset <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, create, submit"))
+		fmt.Println()
+		fmt.Println(T("create capitalizes the fixed-asset items of a submitted Purchase Receipt:"))
+		fmt.Println(T("one asset per unit (or per row with --grouped), depreciated as their"))
+		fmt.Println(T("Asset Category says. Rows that already have assets are skipped."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli asset create --pr=PREC-00001 --location=\"Head Office\""))
+		fmt.Println(T("  erp-cli asset create --pr=PREC-00001 --grouped --available=2026-07-01 --submit"))
+		fmt.Println(T("  erp-cli asset submit ACC-ASS-2026-00001"))
+		fmt.Println(T("  erp-cli asset list --category=\"IT Equipment\""))
+		fmt.Println(T("  erp-cli asset get ACC-ASS-2026-00001        (with the depreciation schedule)"))
+		return nil
+	}
+
+	switch args[0] {
+	case "list":
+		var filters [][]interface{}
+		for _, arg := range args[1:] {
+			if len(arg) > 9 && arg[:9] == "--status=" {
+				filters = append(filters, []interface{}{"status", "=", arg[9:]})
+			}
+			if len(arg) > 11 && arg[:11] == "--category=" {
+				filters = append(filters, []interface{}{"asset_category", "=", arg[11:]})
+			}
+			if len(arg) > 11 && arg[:11] == "--location=" {
+				filters = append(filters, []interface{}{"location", "=", arg[11:]})
+			}
+		}
+		return c.assetList(filters)
+	case "get":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli asset get <name>")
+		}
+		return c.assetGet(args[1])
+	case "create":
+		opts := assetCreateOptions{}
+		for _, arg := range args[1:] {
+			switch {
+			case len(arg) > 5 && arg[:5] == "--pr=":
+				opts.receipt = arg[5:]
+			case len(arg) > 11 && arg[:11] == "--location=":
+				opts.location = arg[11:]
+			case len(arg) > 12 && arg[:12] == "--available=":
+				opts.available = arg[12:]
+			case arg == "--grouped":
+				opts.grouped = true
+			case arg == "--submit":
+				opts.submit = true
+			default:
+				return errorf("unknown argument: %s", arg)
+			}
+		}
+		if opts.receipt == "" {
+			return errorf("usage: erp-cli asset create --pr=<receipt> [--location=X] [--available=YYYY-MM-DD] [--grouped] [--submit]")
+		}
+		if _, err := time.Parse("2006-01-02", opts.available); opts.available != "" && err != nil {
+			return errorf("invalid date (expected YYYY-MM-DD): %s", opts.available)
+		}
+		return c.assetCreate(opts)
+	case "submit":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli asset submit <name>")
+		}
+		return c.assetSubmit(args[1])
+	default:
+		return errorf("unknown asset subcommand: %s", args[0])
+	}
+}
+
+// assetLocation returns the given location, or the only Location there is
+func (c *Client) assetLocation(location string) (string, error) {
+	if location != "" {
+		return location, nil
+	}
+	result, err := c.Request("GET", "Location?limit_page_length=2&fields=[\"name\"]", nil)
+	if err != nil {
+		return "", err
+	}
+	data, _ := result["data"].([]interface{})
+	if len(data) != 1 {
+		return "", errorf("assets need a location: pass --location=X")
+	}
+	m, _ := data[0].(map[string]interface{})
+	return stringField(m, "name"), nil
+}
+
+// assetFinanceBooks copies the depreciation settings of an Asset Category
+// into the finance book rows of a new asset. The first depreciation falls
+// at the end of the first period after the asset is available for use.
+func (c *Client) assetFinanceBooks(category, available string) ([]map[string]interface{}, error) {
+	result, err := c.Request("GET", "Asset%20Category/"+url.PathEscape(category), nil)
+	if err != nil {
+		return nil, err
+	}
+	data, _ := result["data"].(map[string]interface{})
+	start, err := time.Parse("2006-01-02", available)
+	if err != nil {
+		return nil, err
+	}
+
+	var books []map[string]interface{}
+	rows, _ := data["finance_books"].([]interface{})
+	for _, row := range rows {
+		m, ok := row.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		frequency, _ := m["frequency_of_depreciation"].(float64)
+		if frequency <= 0 {
+			frequency = 12
+		}
+		// Last day of the month the first period ends in
+		first := time.Date(start.Year(), start.Month()+time.Month(frequency)+1, 0, 0, 0, 0, 0, time.UTC)
+		book := map[string]interface{}{
+			"depreciation_method":           m["depreciation_method"],
+			"total_number_of_depreciations": m["total_number_of_depreciations"],
+			"frequency_of_depreciation":     frequency,
+			"depreciation_start_date":       first.Format("2006-01-02"),
+		}
+		if financeBook := stringField(m, "finance_book"); financeBook != "" {
+			book["finance_book"] = financeBook
+		}
+		books = append(books, book)
+	}
+	return books, nil
+}
+
+func (c *Client) assetCreate(opts assetCreateOptions) error {
+	printf("%sCreating assets from purchase receipt: %s%s\n", Blue, opts.receipt, Reset)
+
+	result, err := c.Request("GET", "Purchase%20Receipt/"+url.PathEscape(opts.receipt), nil)
+	if err != nil {
+		return err
+	}
+	pr, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return errorf("purchase receipt not found")
+	}
+	if docStatus, _ := pr["docstatus"].(float64); docStatus != 1 {
+		return errorf("purchase receipt must be submitted first")
+	}
+
+	// Rows that were already capitalized, e.g. by the item's auto-create
+	filters, err := encodeFilters([][]interface{}{{"purchase_receipt", "=", opts.receipt}, {"docstatus", "<", 2}})
+	if err != nil {
+		return err
+	}
+	result, err = c.Request("GET", "Asset?limit_page_length=0&fields=[\"item_code\"]&filters="+filters, nil)
+	if err != nil {
+		return err
+	}
+	existing := map[string]bool{}
+	if data, ok := result["data"].([]interface{}); ok {
+		for _, row := range data {
+			if m, ok := row.(map[string]interface{}); ok {
+				existing[stringField(m, "item_code")] = true
+			}
+		}
+	}
+
+	available := opts.available
+	if available == "" {
+		available = stringField(pr, "posting_date")
+	}
+	var location string
+	books := map[string][]map[string]interface{}{} // By asset category
+
+	var created []string
+	skipped := 0
+	rows, _ := pr["items"].([]interface{})
+	for _, row := range rows {
+		m, ok := row.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if isAsset, _ := m["is_fixed_asset"].(float64); isAsset != 1 {
+			continue
+		}
+		itemCode := stringField(m, "item_code")
+		if existing[itemCode] {
+			skipped++
+			continue
+		}
+
+		if location == "" {
+			if location, err = c.assetLocation(opts.location); err != nil {
+				return err
+			}
+		}
+		category := stringField(m, "asset_category")
+		if category == "" {
+			result, err := c.Request("GET", "Item/"+url.PathEscape(itemCode), nil)
+			if err != nil {
+				return err
+			}
+			item, _ := result["data"].(map[string]interface{})
+			category = stringField(item, "asset_category")
+		}
+		if _, ok := books[category]; !ok && category != "" {
+			if books[category], err = c.assetFinanceBooks(category, available); err != nil {
+				return err
+			}
+		}
+
+		qty, _ := m["qty"].(float64)
+		rate, _ := m["base_net_rate"].(float64)
+		count, perAsset := int(qty), 1.0
+		if opts.grouped || qty != float64(int(qty)) {
+			count, perAsset = 1, qty
+		}
+
+		for i := 0; i < count; i++ {
+			body := map[string]interface{}{
+				"item_code":               itemCode,
+				"asset_name":              stringField(m, "item_name"),
+				"asset_category":          category,
+				"company":                 pr["company"],
+				"location":                location,
+				"purchase_receipt":        opts.receipt,
+				"purchase_date":           pr["posting_date"],
+				"available_for_use_date":  available,
+				"gross_purchase_amount":   rate * perAsset,
+				"purchase_receipt_amount": rate * perAsset,
+				"asset_quantity":          perAsset,
+			}
+			if len(books[category]) > 0 {
+				body["calculate_depreciation"] = 1
+				body["finance_books"] = books[category]
+			}
+
+			result, err := c.Request("POST", "Asset", body)
+			if err != nil {
+				return err
+			}
+			data, _ := result["data"].(map[string]interface{})
+			name := stringField(data, "name")
+			created = append(created, name)
+			printf("%s✓ Asset created: %s%s (%s, %s)\n", Green, name, Reset, itemCode, c.FormatCurrency(rate*perAsset))
+
+			if opts.submit {
+				if err := c.submitDocument("Asset", name); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if skipped > 0 {
+		printf("%s  %d row(s) already have assets%s\n", Yellow, skipped, Reset)
+	}
+	if len(created) == 0 {
+		if skipped == 0 {
+			printf("%sNo fixed-asset items on %s%s\n", Yellow, opts.receipt, Reset)
+		}
+		return nil
+	}
+	printf("  Location: %s | Available for use: %s\n", location, available)
+	if opts.submit {
+		printf("  Submitted: %d asset(s)\n", len(created))
+	} else {
+		printf("  Status: Draft\n")
+		printf("  Use 'erp-cli asset submit <name>' to capitalize them\n")
+	}
+	return nil
+}
+
+func (c *Client) assetSubmit(name string) error {
+	printf("%sSubmitting asset: %s%s\n", Blue, name, Reset)
+
+	if err := c.submitDocument("Asset", name); err != nil {
+		return err
+	}
+
+	printf("%s✓ Asset submitted: %s%s\n", Green, name, Reset)
+	return nil
+}
+
+func (c *Client) assetList(filters [][]interface{}) error {
+	printf("%sFetching assets...%s\n", Blue, Reset)
+
+	endpoint := "Asset?" + c.pageLimit(0) + "&fields=[\"name\",\"asset_name\",\"asset_category\",\"location\",\"status\",\"gross_purchase_amount\",\"value_after_depreciation\"]&order_by=creation%20desc"
+	if len(filters) > 0 {
+		encoded, err := encodeFilters(filters)
+		if err != nil {
+			return err
+		}
+		endpoint += "&filters=" + encoded
+	}
+
+	result, err := c.Request("GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	data, _ := result["data"].([]interface{})
+	if len(data) == 0 {
+		printf("%sNo assets found%s\n", Yellow, Reset)
+		return nil
+	}
+
+	printf("\n%sAssets (%d):%s\n", Cyan, len(data), Reset)
+	c.printPageNote(len(data))
+	for _, row := range data {
+		m, ok := row.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		status := stringField(m, "status")
+		statusColor := Green
+		switch status {
+		case "Draft":
+			statusColor = Yellow
+		case "Scrapped", "Sold", "Cancelled":
+			statusColor = Red
+		}
+		gross, _ := m["gross_purchase_amount"].(float64)
+		value, _ := m["value_after_depreciation"].(float64)
+		fmt.Printf("  %s - %s\n", stringField(m, "name"), stringField(m, "asset_name"))
+		printf("    %s @ %s | Status: %s%s%s | Cost: %s | Value: %s\n",
+			stringField(m, "asset_category"), stringField(m, "location"), statusColor, status, Reset,
+			c.FormatCurrency(gross), c.FormatCurrency(value))
+	}
+	return nil
+}
+
+// assetSchedule returns the depreciation schedule rows of an asset. Older
+// versions keep them on the asset; newer ones in an Asset Depreciation
+// Schedule per finance book.
+func (c *Client) assetSchedule(name string, data map[string]interface{}) []interface{} {
+	if rows, ok := data["schedules"].([]interface{}); ok && len(rows) > 0 {
+		return rows
+	}
+
+	filters, err := encodeFilters([][]interface{}{{"asset", "=", name}, {"docstatus", "<", 2}})
+	if err != nil {
+		return nil
+	}
+	result, err := c.Request("GET", "Asset%20Depreciation%20Schedule?limit_page_length=1&order_by=docstatus%20desc&fields=[\"name\"]&filters="+filters, nil)
+	if err != nil {
+		return nil
+	}
+	list, _ := result["data"].([]interface{})
+	if len(list) == 0 {
+		return nil
+	}
+	m, _ := list[0].(map[string]interface{})
+	result, err = c.Request("GET", "Asset%20Depreciation%20Schedule/"+url.PathEscape(stringField(m, "name")), nil)
+	if err != nil {
+		return nil
+	}
+	schedule, _ := result["data"].(map[string]interface{})
+	rows, _ := schedule["depreciation_schedule"].([]interface{})
+	return rows
+}
+
+func (c *Client) assetGet(name string) error {
+	printf("%sFetching asset: %s%s\n", Blue, name, Reset)
+
+	result, err := c.Request("GET", "Asset/"+url.PathEscape(name), nil)
+	if err != nil {
+		return err
+	}
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return errorf("asset not found")
+	}
+
+	printf("\n%sAsset: %s%s\n", Cyan, name, Reset)
+	fields := []struct {
+		key   string
+		label string
+	}{
+		{"asset_name", "Name"},
+		{"item_code", "Item"},
+		{"asset_category", "Category"},
+		{"location", "Location"},
+		{"custodian", "Custodian"},
+		{"status", "Status"},
+		{"purchase_receipt", "Purchase Receipt"},
+		{"purchase_date", "Purchase Date"},
+		{"available_for_use_date", "Available For Use"},
+	}
+	for _, f := range fields {
+		if val := stringField(data, f.key); val != "" {
+			fmt.Printf("  %s: %s\n", f.label, val)
+		}
+	}
+	gross, _ := data["gross_purchase_amount"].(float64)
+	printf("  Cost: %s\n", c.FormatCurrency(gross))
+	if value, ok := data["value_after_depreciation"].(float64); ok && value > 0 {
+		printf("  Value: %s\n", c.FormatCurrency(value))
+	}
+
+	rows := c.assetSchedule(name, data)
+	if len(rows) == 0 {
+		if calc, _ := data["calculate_depreciation"].(float64); calc != 1 {
+			printf("\n  %sNot depreciated%s\n", Yellow, Reset)
+		}
+		return nil
+	}
+
+	today := c.Today()
+	printf("\n  %sDepreciation Schedule:%s\n", Yellow, Reset)
+	fmt.Printf("    %-12s %14s %14s  %s\n", T("Date"), T("Amount"), T("Accumulated"), T("Entry"))
+	for _, row := range rows {
+		m, ok := row.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		amount, _ := m["depreciation_amount"].(float64)
+		accumulated, _ := m["accumulated_depreciation_amount"].(float64)
+		entry := stringField(m, "journal_entry")
+		date := stringField(m, "schedule_date")
+		color := ""
+		switch {
+		case entry != "":
+			color = Green
+		case date < today:
+			entry = "overdue"
+			color = Red
+		}
+		fmt.Printf("    %s%-12s %14s %14s  %s%s\n", color, date, c.FormatCurrency(amount), c.FormatCurrency(accumulated), entry, Reset)
+	}
+	return nil
+}
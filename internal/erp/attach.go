@@ -0,0 +1,258 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+)
+
+// doctypeShortNames lets document commands take the CLI's short names
+// (attach pi ACC-PINV-... instead of attach "Purchase Invoice" ...)
+var doctypeShortNames = map[string]string{
+	"quotation": "Quotation",
+	"so":        "Sales Order",
+	"si":        "Sales Invoice",
+	"dn":        "Delivery Note",
+	"po":        "Purchase Order",
+	"pi":        "Purchase Invoice",
+	"pr":        "Purchase Receipt",
+	"payment":   "Payment Entry",
+	"je":        "Journal Entry",
+	"expense":   "Expense Claim",
+	"wo":        "Work Order",
+	"bom":       "BOM",
+	"item":      "Item",
+	"customer":  "Customer",
+	"supplier":  "Supplier",
+}
+
+// resolveDoctype expands a short name, leaving full doctype names as given
+func resolveDoctype(name string) string {
+	if doctype, ok := doctypeShortNames[strings.ToLower(name)]; ok {
+		return doctype
+	}
+	return name
+}
+
+// attachment is a File record linked to a document
+type attachment struct {
+	name     string // File docname
+	fileName string
+	fileURL  string
+	size     float64
+	private  bool
+	created  string
+}
+
+// CmdAttach uploads files to a document
+func (c *Client) CmdAttach(args []string) error {
+	if len(args) < 3 {
+		fmt.Println(T("Usage: erp-cli attach <doctype> <name> <file>... [--public]"))
+		fmt.Println()
+		fmt.Println(T("Files are private unless --public is given. The doctype may be a"))
+		fmt.Println(T("full name (\"Purchase Invoice\") or a command name (pi, si, po, ...)."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli attach pi ACC-PINV-2025-00001 supplier-invoice.pdf"))
+		fmt.Println(T("  erp-cli attach \"Sales Order\" SAL-ORD-2025-00001 po-scan.pdf drawing.png"))
+		fmt.Println(T("  erp-cli attach item CPU-I7 datasheet.pdf --public"))
+		return nil
+	}
+
+	doctype := resolveDoctype(args[0])
+	name := args[1]
+	private := true
+	var files []string
+	for _, arg := range args[2:] {
+		if arg == "--public" {
+			private = false
+			continue
+		}
+		files = append(files, arg)
+	}
+	if len(files) == 0 {
+		return errorf("usage: erp-cli attach <doctype> <name> <file>... [--public]")
+	}
+
+	for _, file := range files {
+		printf("%sAttaching %s...%s\n", Blue, file, Reset)
+		fileURL, err := c.uploadFile(file, doctype, name, private)
+		if err != nil {
+			return err
+		}
+		printf("%s✓ Attached: %s%s\n", Green, fileURL, Reset)
+	}
+	return nil
+}
+
+// CmdAttachments lists and downloads the files attached to a document
+func (c *Client) CmdAttachments(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli attachments <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get"))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli attachments list pi ACC-PINV-2025-00001"))
+		fmt.Println(T("  erp-cli attachments get pi ACC-PINV-2025-00001 supplier-invoice.pdf"))
+		fmt.Println(T("  erp-cli attachments get pi ACC-PINV-2025-00001 supplier-invoice.pdf -o /tmp/inv.pdf"))
+		return nil
+	}
+
+	switch args[0] {
+	case "list":
+		if len(args) < 3 {
+			return errorf("usage: erp-cli attachments list <doctype> <name>")
+		}
+		return c.attachmentsList(resolveDoctype(args[1]), args[2])
+	case "get":
+		if len(args) < 4 {
+			return errorf("usage: erp-cli attachments get <doctype> <name> <file_name> [-o path]")
+		}
+		output := ""
+		for i, arg := range args[4:] {
+			if arg == "-o" && i+5 < len(args) {
+				output = args[i+5]
+			}
+		}
+		return c.attachmentsGet(resolveDoctype(args[1]), args[2], args[3], output)
+	default:
+		return errorf("unknown attachments subcommand: %s", args[0])
+	}
+}
+
+// fetchAttachments returns the files attached to a document, oldest first
+func (c *Client) fetchAttachments(doctype, name string) ([]attachment, error) {
+	filters, err := encodeFilters([][]interface{}{
+		{"attached_to_doctype", "=", doctype},
+		{"attached_to_name", "=", name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.Request("GET", "File?limit_page_length=0&fields=[\"name\",\"file_name\",\"file_url\",\"file_size\",\"is_private\",\"creation\"]&order_by=creation%20asc&filters="+filters, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []attachment
+	data, _ := result["data"].([]interface{})
+	for _, row := range data {
+		f, ok := row.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		size, _ := f["file_size"].(float64)
+		private, _ := f["is_private"].(float64)
+		files = append(files, attachment{
+			name:     stringField(f, "name"),
+			fileName: stringField(f, "file_name"),
+			fileURL:  stringField(f, "file_url"),
+			size:     size,
+			private:  private == 1,
+			created:  stringField(f, "creation"),
+		})
+	}
+	return files, nil
+}
+
+// formatFileSize renders a byte count for humans
+func formatFileSize(size float64) string {
+	switch {
+	case size >= 1<<20:
+		return tf("%.1f MB", size/(1<<20))
+	case size >= 1<<10:
+		return tf("%.0f KB", size/(1<<10))
+	}
+	return fmt.Sprintf("%.0f B", size)
+}
+
+func (c *Client) attachmentsList(doctype, name string) error {
+	files, err := c.fetchAttachments(doctype, name)
+	if err != nil {
+		return err
+	}
+
+	if len(files) == 0 {
+		printf("%sNo attachments on %s %s%s\n", Yellow, doctype, name, Reset)
+		return nil
+	}
+
+	printf("%sAttachments of %s %s:%s\n", Cyan, doctype, name, Reset)
+	for _, f := range files {
+		visibility := "public"
+		if f.private {
+			visibility = "private"
+		}
+		created := f.created
+		if len(created) > 16 {
+			created = created[:16]
+		}
+		fmt.Printf("  %-40s %10s  %-7s  %s\n", f.fileName, formatFileSize(f.size), visibility, created)
+	}
+	printf("\n%sTotal: %d files%s\n", Cyan, len(files), Reset)
+	return nil
+}
+
+// findAttachment picks an attachment by file name (or File docname)
+func findAttachment(files []attachment, fileName string) (attachment, bool) {
+	for _, f := range files {
+		if f.fileName == fileName || f.name == fileName {
+			return f, true
+		}
+	}
+	for _, f := range files {
+		if strings.EqualFold(f.fileName, fileName) {
+			return f, true
+		}
+	}
+	return attachment{}, false
+}
+
+// downloadAttachment saves an attachment, returning the path written
+func (c *Client) downloadAttachment(f attachment, output string) (string, error) {
+	if f.fileURL == "" {
+		return "", errorf("%s has no file URL", f.fileName)
+	}
+	fileURL := f.fileURL
+	if strings.HasPrefix(fileURL, "/") {
+		// Stored URLs are not escaped; file names often contain spaces
+		fileURL = (&url.URL{Path: fileURL}).EscapedPath()
+	} else if u, err := url.Parse(fileURL); err == nil && u.IsAbs() {
+		return "", errorf("%s links to an external URL: %s", f.fileName, fileURL)
+	}
+
+	data, err := c.download(fileURL)
+	if err != nil {
+		return "", err
+	}
+
+	if output == "" {
+		output = path.Base(f.fileName)
+	}
+	if err := os.WriteFile(output, data, 0644); err != nil {
+		return "", errorf("failed to write %s: %w", output, err)
+	}
+	return output, nil
+}
+
+func (c *Client) attachmentsGet(doctype, name, fileName, output string) error {
+	files, err := c.fetchAttachments(doctype, name)
+	if err != nil {
+		return err
+	}
+
+	f, ok := findAttachment(files, fileName)
+	if !ok {
+		return errorf("%s %s has no attachment named %s (see: erp-cli attachments list)", doctype, name, fileName)
+	}
+
+	saved, err := c.downloadAttachment(f, output)
+	if err != nil {
+		return err
+	}
+	printf("%s✓ Saved: %s (%s)%s\n", Green, saved, formatFileSize(f.size), Reset)
+	return nil
+}
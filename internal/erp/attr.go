@@ -10,8 +10,8 @@ import (
 // CmdAttr handles attribute commands
 func (c *Client) CmdAttr(args []string) error {
 	if len(args) == 0 {
-		fmt.Println("Usage: erp-cli attr <subcommand> [args...]")
-		fmt.Println("Subcommands: list, get, create-text, create-numeric, create-list, add-values, delete")
+		fmt.Println(T("Usage: erp-cli attr <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, create-text, create-numeric, create-list, add-values, delete"))
 		return nil
 	}
 
@@ -20,41 +20,41 @@ func (c *Client) CmdAttr(args []string) error {
 		return c.attrList()
 	case "get":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli attr get <name>")
+			return errorf("usage: erp-cli attr get <name>")
 		}
 		return c.attrGet(args[1])
 	case "create-text":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli attr create-text <name>")
+			return errorf("usage: erp-cli attr create-text <name>")
 		}
 		return c.attrCreateText(args[1])
 	case "create-numeric":
 		if len(args) < 5 {
-			return fmt.Errorf("usage: erp-cli attr create-numeric <name> <from> <to> <increment>")
+			return errorf("usage: erp-cli attr create-numeric <name> <from> <to> <increment>")
 		}
 		return c.attrCreateNumeric(args[1], args[2], args[3], args[4])
 	case "create-list":
 		if len(args) < 3 {
-			return fmt.Errorf("usage: erp-cli attr create-list <name> <value:abbr> [value:abbr...]")
+			return errorf("usage: erp-cli attr create-list <name> <value:abbr> [value:abbr...]")
 		}
 		return c.attrCreateList(args[1], args[2:])
 	case "add-values":
 		if len(args) < 3 {
-			return fmt.Errorf("usage: erp-cli attr add-values <name> <value:abbr> [value:abbr...]")
+			return errorf("usage: erp-cli attr add-values <name> <value:abbr> [value:abbr...]")
 		}
 		return c.attrAddValues(args[1], args[2:])
 	case "delete":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli attr delete <name>")
+			return errorf("usage: erp-cli attr delete <name>")
 		}
 		return c.attrDelete(args[1])
 	default:
-		return fmt.Errorf("unknown attr subcommand: %s", args[0])
+		return errorf("unknown attr subcommand: %s", args[0])
 	}
 }
 
 func (c *Client) attrList() error {
-	fmt.Printf("%sFetching item attributes...%s\n", Blue, Reset)
+	printf("%sFetching item attributes...%s\n", Blue, Reset)
 
 	result, err := c.Request("GET", "Item%20Attribute?limit_page_length=0", nil)
 	if err != nil {
@@ -72,7 +72,7 @@ func (c *Client) attrList() error {
 }
 
 func (c *Client) attrGet(name string) error {
-	fmt.Printf("%sFetching attribute: %s%s\n", Blue, name, Reset)
+	printf("%sFetching attribute: %s%s\n", Blue, name, Reset)
 
 	encoded := url.PathEscape(name)
 	result, err := c.Request("GET", "Item%20Attribute/"+encoded, nil)
@@ -106,7 +106,7 @@ func (c *Client) attrGet(name string) error {
 }
 
 func (c *Client) attrCreateText(name string) error {
-	fmt.Printf("%sCreating text attribute: %s%s\n", Blue, name, Reset)
+	printf("%sCreating text attribute: %s%s\n", Blue, name, Reset)
 
 	body := map[string]interface{}{
 		"attribute_name": name,
@@ -117,12 +117,12 @@ func (c *Client) attrCreateText(name string) error {
 		return err
 	}
 
-	fmt.Printf("%s✓ Attribute created: %s%s\n", Green, name, Reset)
+	printf("%s✓ Attribute created: %s%s\n", Green, name, Reset)
 	return nil
 }
 
 func (c *Client) attrCreateNumeric(name, from, to, increment string) error {
-	fmt.Printf("%sCreating numeric attribute: %s (%s-%s, step %s)%s\n", Blue, name, from, to, increment, Reset)
+	printf("%sCreating numeric attribute: %s (%s-%s, step %s)%s\n", Blue, name, from, to, increment, Reset)
 
 	body := map[string]interface{}{
 		"attribute_name": name,
@@ -137,18 +137,18 @@ func (c *Client) attrCreateNumeric(name, from, to, increment string) error {
 		return err
 	}
 
-	fmt.Printf("%s✓ Numeric attribute created: %s%s\n", Green, name, Reset)
+	printf("%s✓ Numeric attribute created: %s%s\n", Green, name, Reset)
 	return nil
 }
 
 func (c *Client) attrCreateList(name string, values []string) error {
-	fmt.Printf("%sCreating list attribute: %s%s\n", Blue, name, Reset)
+	printf("%sCreating list attribute: %s%s\n", Blue, name, Reset)
 
 	var attrValues []map[string]string
 	for _, v := range values {
 		parts := strings.SplitN(v, ":", 2)
 		if len(parts) != 2 {
-			return fmt.Errorf("invalid format '%s'. Use 'value:abbreviation'", v)
+			return errorf("invalid format '%s'. Use 'value:abbreviation'", v)
 		}
 		attrValues = append(attrValues, map[string]string{
 			"attribute_value": parts[0],
@@ -166,13 +166,13 @@ func (c *Client) attrCreateList(name string, values []string) error {
 		return err
 	}
 
-	fmt.Printf("%s✓ List attribute created: %s%s\n", Green, name, Reset)
-	fmt.Printf("  Values: %s\n", strings.Join(values, ", "))
+	printf("%s✓ List attribute created: %s%s\n", Green, name, Reset)
+	printf("  Values: %s\n", strings.Join(values, ", "))
 	return nil
 }
 
 func (c *Client) attrAddValues(name string, values []string) error {
-	fmt.Printf("%sAdding values to attribute: %s%s\n", Blue, name, Reset)
+	printf("%sAdding values to attribute: %s%s\n", Blue, name, Reset)
 
 	encoded := url.PathEscape(name)
 	result, err := c.Request("GET", "Item%20Attribute/"+encoded, nil)
@@ -182,7 +182,7 @@ func (c *Client) attrAddValues(name string, values []string) error {
 
 	data, ok := result["data"].(map[string]interface{})
 	if !ok {
-		return fmt.Errorf("attribute not found")
+		return errorf("attribute not found")
 	}
 
 	var existingValues []map[string]interface{}
@@ -197,7 +197,7 @@ func (c *Client) attrAddValues(name string, values []string) error {
 	for _, v := range values {
 		parts := strings.SplitN(v, ":", 2)
 		if len(parts) != 2 {
-			return fmt.Errorf("invalid format '%s'. Use 'value:abbreviation'", v)
+			return errorf("invalid format '%s'. Use 'value:abbreviation'", v)
 		}
 		existingValues = append(existingValues, map[string]interface{}{
 			"attribute_value": parts[0],
@@ -214,13 +214,13 @@ func (c *Client) attrAddValues(name string, values []string) error {
 		return err
 	}
 
-	fmt.Printf("%s✓ Values added to: %s%s\n", Green, name, Reset)
-	fmt.Printf("  New values: %s\n", strings.Join(values, ", "))
+	printf("%s✓ Values added to: %s%s\n", Green, name, Reset)
+	printf("  New values: %s\n", strings.Join(values, ", "))
 	return nil
 }
 
 func (c *Client) attrDelete(name string) error {
-	fmt.Printf("%sDeleting attribute: %s%s\n", Blue, name, Reset)
+	printf("%sDeleting attribute: %s%s\n", Blue, name, Reset)
 
 	encoded := url.PathEscape(name)
 	_, err := c.Request("DELETE", "Item%20Attribute/"+encoded, nil)
@@ -228,6 +228,6 @@ func (c *Client) attrDelete(name string) error {
 		return err
 	}
 
-	fmt.Printf("%s✓ Attribute deleted: %s%s\n", Green, name, Reset)
+	printf("%s✓ Attribute deleted: %s%s\n", Green, name, Reset)
 	return nil
 }
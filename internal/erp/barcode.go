@@ -0,0 +1,298 @@
+package erp
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+)
+
+// validateGTIN checks length and GS1 check digit of an EAN-8, UPC-A, EAN-13 or GTIN-14 code.
+// Returns the ERPNext barcode type for the code.
+func validateGTIN(code string) (string, error) {
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return "", errorf("non-numeric barcode: %s", code)
+		}
+	}
+
+	var barcodeType string
+	switch len(code) {
+	case 8:
+		barcodeType = "EAN"
+	case 12:
+		barcodeType = "UPC-A"
+	case 13:
+		barcodeType = "EAN"
+	case 14:
+		barcodeType = "GTIN"
+	default:
+		return "", errorf("invalid length %d for barcode %s (expected 8, 12, 13 or 14)", len(code), code)
+	}
+
+	// GS1 check digit: weights 3,1,3,1... from the rightmost data digit
+	sum := 0
+	for i := len(code) - 2; i >= 0; i-- {
+		digit := int(code[i] - '0')
+		if (len(code)-2-i)%2 == 0 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	check := (10 - sum%10) % 10
+
+	if int(code[len(code)-1]-'0') != check {
+		return "", errorf("invalid check digit for barcode %s (expected %d)", code, check)
+	}
+	return barcodeType, nil
+}
+
+// fetchItemBarcodes returns all item codes with the barcodes assigned to
+// each, and which of them are templates
+func (c *Client) fetchItemBarcodes() (map[string][]string, map[string]bool, error) {
+	fields := url.QueryEscape("[\"name\",\"has_variants\",\"`tabItem Barcode`.barcode\"]")
+	result, err := c.Request("GET", "Item?limit_page_length=0&fields="+fields, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items := make(map[string][]string)
+	templates := make(map[string]bool)
+	if data, ok := result["data"].([]interface{}); ok {
+		for _, row := range data {
+			if m, ok := row.(map[string]interface{}); ok {
+				name, _ := m["name"].(string)
+				barcode, _ := m["barcode"].(string)
+				if _, exists := items[name]; !exists {
+					items[name] = nil
+				}
+				if barcode != "" {
+					items[name] = append(items[name], barcode)
+				}
+				if v, ok := m["has_variants"].(float64); ok && v == 1 {
+					templates[name] = true
+				}
+			}
+		}
+	}
+	return items, templates, nil
+}
+
+// itemAssignBarcodes assigns barcodes from a CSV file (item_code,barcode)
+func (c *Client) itemAssignBarcodes(inputFile string, dryRun bool) error {
+	if dryRun {
+		printf("%s[DRY RUN] Assigning barcodes from: %s%s\n", Yellow, inputFile, Reset)
+	} else {
+		printf("%sAssigning barcodes from: %s%s\n", Blue, inputFile, Reset)
+	}
+
+	file, err := os.Open(inputFile)
+	if err != nil {
+		return errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	records, err := reader.ReadAll()
+	if err != nil {
+		return errorf("failed to read CSV: %w", err)
+	}
+
+	if len(records) < 2 {
+		return errorf("CSV file is empty or has no data rows")
+	}
+
+	itemCol, barcodeCol := -1, -1
+	for i, col := range records[0] {
+		switch strings.TrimSpace(col) {
+		case "item_code":
+			itemCol = i
+		case "barcode":
+			barcodeCol = i
+		}
+	}
+	if itemCol < 0 || barcodeCol < 0 {
+		return errorf("CSV must have item_code and barcode columns")
+	}
+
+	existing, templates, err := c.fetchItemBarcodes()
+	if err != nil {
+		return err
+	}
+
+	// Barcodes already in the item master
+	owner := make(map[string]string)
+	for item, barcodes := range existing {
+		for _, b := range barcodes {
+			owner[b] = item
+		}
+	}
+
+	// Validate every row first so a bad file changes nothing
+	type assignment struct {
+		item, barcode, barcodeType string
+	}
+	var valid []assignment
+	invalid := 0
+	seen := make(map[string]int)
+
+	for i, record := range records[1:] {
+		row := i + 2
+		if itemCol >= len(record) || barcodeCol >= len(record) {
+			printf("  %sRow %d: insufficient columns%s\n", Red, row, Reset)
+			invalid++
+			continue
+		}
+		item := strings.TrimSpace(record[itemCol])
+		barcode := strings.TrimSpace(record[barcodeCol])
+
+		if _, ok := existing[item]; !ok {
+			printf("  %sRow %d: item not found: %s%s\n", Red, row, item, Reset)
+			invalid++
+			continue
+		}
+		if templates[item] {
+			printf("  %sRow %d: %s is a template; assign barcodes to its variants%s\n", Red, row, item, Reset)
+			invalid++
+			continue
+		}
+
+		barcodeType, err := validateGTIN(barcode)
+		if err != nil {
+			printf("  %sRow %d: %s%s\n", Red, row, err, Reset)
+			invalid++
+			continue
+		}
+
+		if prev, dup := seen[barcode]; dup {
+			printf("  %sRow %d: duplicate barcode %s (also on row %d)%s\n", Red, row, barcode, prev, Reset)
+			invalid++
+			continue
+		}
+		seen[barcode] = row
+
+		if other, taken := owner[barcode]; taken {
+			if other == item {
+				printf("  %sRow %d: %s already has %s%s\n", Yellow, row, item, barcode, Reset)
+				continue
+			}
+			printf("  %sRow %d: barcode %s already assigned to %s%s\n", Red, row, barcode, other, Reset)
+			invalid++
+			continue
+		}
+
+		valid = append(valid, assignment{item, barcode, barcodeType})
+	}
+
+	if invalid > 0 {
+		return errorf("%d invalid rows; fix the file and retry (nothing was assigned)", invalid)
+	}
+
+	assigned, failed := 0, 0
+	if dryRun {
+		for _, a := range valid {
+			printf("  [DRY RUN] Would assign %s (%s) to %s\n", a.barcode, a.barcodeType, a.item)
+			assigned++
+		}
+		printf("\n%sSummary: %d assigned, %d failed%s\n", Cyan, assigned, failed, Reset)
+		return nil
+	}
+
+	// Group by item so each item is read and written once
+	var order []string
+	byItem := make(map[string][]assignment)
+	for _, a := range valid {
+		if _, ok := byItem[a.item]; !ok {
+			order = append(order, a.item)
+		}
+		byItem[a.item] = append(byItem[a.item], a)
+	}
+
+	for _, item := range order {
+		var barcodes, types []string
+		for _, a := range byItem[item] {
+			barcodes = append(barcodes, a.barcode)
+			types = append(types, a.barcodeType)
+		}
+
+		err := c.addItemBarcodes(item, barcodes, types)
+		for _, a := range byItem[item] {
+			if err != nil {
+				printf("  %s✗ Failed: %s → %s (%s)%s\n", Red, a.barcode, a.item, err, Reset)
+				failed++
+				continue
+			}
+			printf("  %s✓ Assigned: %s → %s%s\n", Green, a.barcode, a.item, Reset)
+			assigned++
+		}
+	}
+
+	printf("\n%sSummary: %d assigned, %d failed%s\n", Cyan, assigned, failed, Reset)
+	return nil
+}
+
+// addItemBarcodes appends barcodes to an item's barcodes child table
+func (c *Client) addItemBarcodes(itemCode string, barcodes, barcodeTypes []string) error {
+	encoded := url.PathEscape(itemCode)
+	result, err := c.Request("GET", "Item/"+encoded, nil)
+	if err != nil {
+		return err
+	}
+
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return errorf("item not found")
+	}
+
+	var rows []interface{}
+	if existing, ok := data["barcodes"].([]interface{}); ok {
+		rows = existing
+	}
+
+	for i, barcode := range barcodes {
+		row := map[string]interface{}{"barcode": barcode}
+		// ERPNext only knows EAN and UPC-A; GTIN-14 is stored untyped
+		if barcodeTypes[i] != "GTIN" {
+			row["barcode_type"] = barcodeTypes[i]
+		}
+		rows = append(rows, row)
+	}
+
+	_, err = c.Request("PUT", "Item/"+encoded, map[string]interface{}{"barcodes": rows})
+	return err
+}
+
+// itemMissingBarcodes lists items without any barcode
+func (c *Client) itemMissingBarcodes() error {
+	printf("%sFetching items without barcodes...%s\n", Blue, Reset)
+
+	items, templates, err := c.fetchItemBarcodes()
+	if err != nil {
+		return err
+	}
+	// Templates are never scanned, their variants are
+	for template := range templates {
+		delete(items, template)
+	}
+
+	var missing []string
+	for item, barcodes := range items {
+		if len(barcodes) == 0 {
+			missing = append(missing, item)
+		}
+	}
+	sort.Strings(missing)
+
+	if len(missing) == 0 {
+		printf("%s✓ All %d items have barcodes%s\n", Green, len(items), Reset)
+		return nil
+	}
+
+	printf("\n%sItems missing barcodes (%d of %d):%s\n", Cyan, len(missing), len(items), Reset)
+	for _, item := range missing {
+		fmt.Printf("  %s\n", item)
+	}
+	return nil
+}
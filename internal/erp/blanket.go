@@ -0,0 +1,431 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// blanketCreateOptions holds flags for blanket create
+type blanketCreateOptions struct {
+	selling bool   // Agreement with a customer instead of a supplier
+	from    string // Defaults to today
+	to      string // Defaults to a year after the start
+	submit  bool
+}
+
+// blanketLine is an item of an agreement with the quantity still to order
+type blanketLine struct {
+	item      string
+	rate      float64
+	qty       float64
+	ordered   float64
+	remaining float64
+}
+
+// CmdBlanket handles Blanket Order commands
+func (c *Client) CmdBlanket(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli blanket <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, create, submit"))
+		fmt.Println()
+		fmt.Println(T("A blanket order agrees quantities and rates with a supplier (or, with"))
+		fmt.Println(T("--selling, a customer) for a period. Items are CODE:QTY@RATE. Orders"))
+		fmt.Println(T("created with 'po create --blanket=X' or 'so create --blanket=X' take"))
+		fmt.Println(T("its rates, and their quantities count against it once submitted."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli blanket create \"Intel Corporation\" CPU-I7:500@430 CPU-I5:300@260 --to=2026-12-31"))
+		fmt.Println(T("  erp-cli blanket create \"Acme Corp\" PC-GAMING:120@1150 --selling --submit"))
+		fmt.Println(T("  erp-cli blanket submit MFG-BLR-2026-00001"))
+		fmt.Println(T("  erp-cli blanket list --party=Intel"))
+		fmt.Println(T("  erp-cli blanket get MFG-BLR-2026-00001"))
+		fmt.Println(T("  erp-cli po create \"Intel Corporation\" --blanket=MFG-BLR-2026-00001 --items=CPU-I7:50"))
+		return nil
+	}
+
+	switch args[0] {
+	case "list":
+		party, orderType := "", ""
+		for _, arg := range args[1:] {
+			if len(arg) > 8 && arg[:8] == "--party=" {
+				party = arg[8:]
+			}
+			if arg == "--selling" {
+				orderType = "Selling"
+			}
+			if arg == "--purchasing" {
+				orderType = "Purchasing"
+			}
+		}
+		return c.blanketList(party, orderType)
+	case "get":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli blanket get <name>")
+		}
+		return c.blanketGet(args[1])
+	case "create":
+		opts := blanketCreateOptions{}
+		var positional []string
+		for _, arg := range args[1:] {
+			switch {
+			case len(arg) > 7 && arg[:7] == "--from=":
+				opts.from = arg[7:]
+			case len(arg) > 5 && arg[:5] == "--to=":
+				opts.to = arg[5:]
+			case arg == "--selling":
+				opts.selling = true
+			case arg == "--submit":
+				opts.submit = true
+			default:
+				positional = append(positional, arg)
+			}
+		}
+		if len(positional) < 2 {
+			return errorf("usage: erp-cli blanket create <supplier|customer> <item:qty@rate> [...] [--selling] [--from=YYYY-MM-DD] [--to=YYYY-MM-DD] [--submit]")
+		}
+		for _, date := range []string{opts.from, opts.to} {
+			if _, err := time.Parse("2006-01-02", date); date != "" && err != nil {
+				return errorf("invalid date (expected YYYY-MM-DD): %s", date)
+			}
+		}
+		var items []bomComponent
+		for _, arg := range positional[1:] {
+			item, err := parseBOMComponent(arg)
+			if err != nil {
+				return err
+			}
+			if item.rate <= 0 {
+				return errorf("blanket order items need a rate (CODE:QTY@RATE): %s", arg)
+			}
+			items = append(items, item)
+		}
+		return c.blanketCreate(positional[0], items, opts)
+	case "submit":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli blanket submit <name>")
+		}
+		return c.blanketSubmit(args[1])
+	default:
+		return errorf("unknown blanket subcommand: %s", args[0])
+	}
+}
+
+// blanketPartyField returns the party field of a blanket order type
+func blanketPartyField(orderType string) string {
+	if orderType == "Selling" {
+		return "customer"
+	}
+	return "supplier"
+}
+
+func (c *Client) blanketCreate(party string, items []bomComponent, opts blanketCreateOptions) error {
+	orderType := "Purchasing"
+	if opts.selling {
+		orderType = "Selling"
+	}
+	printf("%sCreating %s blanket order for: %s%s\n", Blue, strings.ToLower(orderType), party, Reset)
+
+	company, err := c.GetCompany()
+	if err != nil {
+		return err
+	}
+
+	from := opts.from
+	if from == "" {
+		from = c.Today()
+	}
+	to := opts.to
+	if to == "" {
+		start, err := time.Parse("2006-01-02", from)
+		if err != nil {
+			return err
+		}
+		to = start.AddDate(1, 0, -1).Format("2006-01-02")
+	}
+	if to < from {
+		return errorf("the agreement ends (%s) before it starts (%s)", to, from)
+	}
+
+	var rows []map[string]interface{}
+	total := 0.0
+	for _, item := range items {
+		rows = append(rows, map[string]interface{}{
+			"item_code": item.item,
+			"qty":       item.qty,
+			"rate":      item.rate,
+		})
+		total += item.qty * item.rate
+	}
+
+	body := map[string]interface{}{
+		"blanket_order_type":         orderType,
+		blanketPartyField(orderType): party,
+		"from_date":                  from,
+		"to_date":                    to,
+		"company":                    company,
+		"items":                      rows,
+	}
+
+	result, err := c.Request("POST", "Blanket%20Order", body)
+	if err != nil {
+		return err
+	}
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return errorf("unexpected response creating blanket order")
+	}
+	name := stringField(data, "name")
+
+	printf("%s✓ Blanket Order created: %s%s\n", Green, name, Reset)
+	printf("  Period: %s to %s\n", from, to)
+	printf("  Items: %d (%s)\n", len(rows), c.FormatCurrency(total))
+
+	if !opts.submit {
+		printf("  Status: Draft\n")
+		printf("  Use 'erp-cli blanket submit %s' to submit\n", name)
+		return nil
+	}
+	return c.blanketSubmit(name)
+}
+
+func (c *Client) blanketSubmit(name string) error {
+	printf("%sSubmitting blanket order: %s%s\n", Blue, name, Reset)
+
+	if err := c.submitDocument("Blanket Order", name); err != nil {
+		return err
+	}
+
+	printf("%s✓ Blanket Order submitted: %s%s\n", Green, name, Reset)
+	return nil
+}
+
+func (c *Client) blanketList(party, orderType string) error {
+	printf("%sFetching blanket orders...%s\n", Blue, Reset)
+
+	filters := [][]interface{}{}
+	if orderType != "" {
+		filters = append(filters, []interface{}{"blanket_order_type", "=", orderType})
+	}
+
+	endpoint := "Blanket%20Order?" + c.pageLimit(0) + "&fields=[\"name\",\"blanket_order_type\",\"supplier\",\"customer\",\"from_date\",\"to_date\",\"docstatus\"]&order_by=to_date%20desc"
+	if len(filters) > 0 {
+		encoded, err := encodeFilters(filters)
+		if err != nil {
+			return err
+		}
+		endpoint += "&filters=" + encoded
+	}
+
+	result, err := c.Request("GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	// The party is either of two fields, so it is matched here
+	var rows []map[string]interface{}
+	data, _ := result["data"].([]interface{})
+	for _, row := range data {
+		m, ok := row.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := stringField(m, "supplier") + stringField(m, "customer")
+		if party == "" || strings.Contains(strings.ToLower(name), strings.ToLower(party)) {
+			rows = append(rows, m)
+		}
+	}
+
+	if len(rows) == 0 {
+		printf("%sNo blanket orders found%s\n", Yellow, Reset)
+		return nil
+	}
+
+	today := c.Today()
+	printf("\n%sBlanket Orders (%d):%s\n", Cyan, len(rows), Reset)
+	c.printPageNote(len(data))
+	for _, m := range rows {
+		status, statusColor := "Active", Green
+		docStatus, _ := m["docstatus"].(float64)
+		switch {
+		case docStatus == 0:
+			status, statusColor = "Draft", Yellow
+		case docStatus == 2:
+			status, statusColor = "Cancelled", Red
+		case stringField(m, "to_date") < today:
+			status, statusColor = "Expired", Red
+		}
+		orderType := stringField(m, "blanket_order_type")
+		fmt.Printf("  %s - %s\n", stringField(m, "name"), stringField(m, blanketPartyField(orderType)))
+		printf("    %s | %s to %s | Status: %s%s%s\n",
+			orderType, stringField(m, "from_date"), stringField(m, "to_date"), statusColor, status, Reset)
+	}
+	return nil
+}
+
+// blanketLines returns the items of a blanket order with how much of each
+// is left to order
+func blanketLines(data map[string]interface{}) []blanketLine {
+	var lines []blanketLine
+	items, _ := data["items"].([]interface{})
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		line := blanketLine{item: stringField(m, "item_code")}
+		line.rate, _ = m["rate"].(float64)
+		line.qty, _ = m["qty"].(float64)
+		line.ordered, _ = m["ordered_qty"].(float64)
+		line.remaining = line.qty - line.ordered
+		if line.remaining < 0 {
+			line.remaining = 0
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func (c *Client) blanketGet(name string) error {
+	printf("%sFetching blanket order: %s%s\n", Blue, name, Reset)
+
+	result, err := c.Request("GET", "Blanket%20Order/"+url.PathEscape(name), nil)
+	if err != nil {
+		return err
+	}
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return errorf("blanket order not found")
+	}
+
+	orderType := stringField(data, "blanket_order_type")
+	printf("\n%sBlanket Order: %s%s\n", Cyan, name, Reset)
+	printf("  Type: %s\n", orderType)
+	if orderType == "Selling" {
+		printf("  Customer: %s\n", stringField(data, "customer"))
+	} else {
+		printf("  Supplier: %s\n", stringField(data, "supplier"))
+	}
+	printf("  Period: %s to %s\n", stringField(data, "from_date"), stringField(data, "to_date"))
+
+	lines := blanketLines(data)
+	if len(lines) > 0 {
+		printf("\n  %sItems (ordered / agreed):%s\n", Yellow, Reset)
+		for _, line := range lines {
+			pct := 0.0
+			if line.qty > 0 {
+				pct = line.ordered / line.qty * 100
+			}
+			color := Green
+			if pct >= 100 {
+				color = Red
+			} else if pct >= 80 {
+				color = Yellow
+			}
+			printf("    - %s @ %s: %s%g / %g (%.0f%%)%s, %g left\n",
+				line.item, c.FormatCurrency(line.rate), color, line.ordered, line.qty, pct, Reset, line.remaining)
+		}
+	}
+
+	c.printActivity("Blanket Order", name)
+	return nil
+}
+
+// blanketOrderItems builds the item rows of an order drawn from a blanket
+// order. picks maps item codes to quantities; without picks every item
+// still to order is taken in full. The agreement must be submitted, current,
+// of the given type and with the given party.
+func (c *Client) blanketOrderItems(name, orderType, party string, picks []bomComponent) ([]map[string]interface{}, error) {
+	result, err := c.Request("GET", "Blanket%20Order/"+url.PathEscape(name), nil)
+	if err != nil {
+		return nil, err
+	}
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return nil, errorf("blanket order not found: %s", name)
+	}
+
+	if docStatus, _ := data["docstatus"].(float64); docStatus != 1 {
+		return nil, errorf("blanket order must be submitted first: %s", name)
+	}
+	if stringField(data, "blanket_order_type") != orderType {
+		return nil, errorf("%s is a %s blanket order", name, strings.ToLower(stringField(data, "blanket_order_type")))
+	}
+	if agreed := stringField(data, blanketPartyField(orderType)); agreed != party {
+		return nil, errorf("%s is agreed with %s, not %s", name, agreed, party)
+	}
+	if today := c.Today(); today < stringField(data, "from_date") || today > stringField(data, "to_date") {
+		return nil, errorf("%s runs from %s to %s", name, stringField(data, "from_date"), stringField(data, "to_date"))
+	}
+
+	lines := map[string]blanketLine{}
+	var order []string
+	for _, line := range blanketLines(data) {
+		lines[line.item] = line
+		order = append(order, line.item)
+	}
+	if len(picks) == 0 {
+		for _, item := range order {
+			if lines[item].remaining > 0 {
+				picks = append(picks, bomComponent{item: item, qty: lines[item].remaining})
+			}
+		}
+		if len(picks) == 0 {
+			return nil, errorf("everything agreed in %s has been ordered", name)
+		}
+	}
+
+	var rows []map[string]interface{}
+	for _, pick := range picks {
+		line, ok := lines[pick.item]
+		if !ok {
+			return nil, errorf("%s is not part of blanket order %s", pick.item, name)
+		}
+		if pick.qty > line.remaining {
+			return nil, errorf("only %g of %s left on %s (%g requested)", line.remaining, pick.item, name, pick.qty)
+		}
+		rows = append(rows, map[string]interface{}{
+			"item_code":          pick.item,
+			"qty":                pick.qty,
+			"rate":               line.rate,
+			"blanket_order":      name,
+			"blanket_order_rate": line.rate,
+		})
+	}
+	return rows, nil
+}
+
+// parseBlanketFlags reads --blanket=X and --items=CODE:QTY,... for po/so create
+func parseBlanketFlags(args []string) (string, []bomComponent, error) {
+	blanket := ""
+	var picks []bomComponent
+	for _, arg := range args {
+		if len(arg) > 10 && arg[:10] == "--blanket=" {
+			blanket = arg[10:]
+		}
+		if len(arg) > 8 && arg[:8] == "--items=" {
+			for _, spec := range strings.Split(arg[8:], ",") {
+				pick, err := parseBOMComponent(strings.TrimSpace(spec))
+				if err != nil {
+					return "", nil, err
+				}
+				picks = append(picks, pick)
+			}
+		}
+	}
+	if len(picks) > 0 && blanket == "" {
+		return "", nil, errorf("--items needs --blanket=X")
+	}
+	return blanket, picks, nil
+}
+
+// printBlanketRows prints the lines an order took from a blanket order
+func (c *Client) printBlanketRows(blanket string, rows []map[string]interface{}) {
+	printf("  From Blanket Order: %s\n", blanket)
+	for _, row := range rows {
+		qty, _ := row["qty"].(float64)
+		rate, _ := row["rate"].(float64)
+		fmt.Printf("    - %s: %g x %s\n", row["item_code"], qty, c.FormatCurrency(rate))
+	}
+}
@@ -0,0 +1,590 @@
+package erp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// maxBOMDepth stops bom get from following a circular sub-assembly chain
+const maxBOMDepth = 10
+
+// bomComponent is one raw material line of a BOM
+type bomComponent struct {
+	row  int // CSV line for import boms, 0 otherwise
+	item string
+	qty  float64
+	rate float64 // 0 lets ERPNext price it
+}
+
+// bomListOptions holds filters for bom list
+type bomListOptions struct {
+	item string
+	all  bool // Include inactive BOMs
+}
+
+// bomCreateOptions holds flags for bom create
+type bomCreateOptions struct {
+	quantity float64 // Units of the parent the components make
+	submit   bool
+}
+
+// CmdBom handles Bill of Materials commands
+func (c *Client) CmdBom(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli bom <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, create, submit"))
+		fmt.Println()
+		fmt.Println(T("Components are CODE:QTY or CODE:QTY@RATE. A component with its own"))
+		fmt.Println(T("default BOM becomes a sub-assembly, so create (and submit) the lower"))
+		fmt.Println(T("levels first. get accepts a BOM name or an item code (its default BOM)."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli bom list"))
+		fmt.Println(T("  erp-cli bom list --item=PC-GAMING --all"))
+		fmt.Println(T("  erp-cli bom get PC-GAMING"))
+		fmt.Println(T("  erp-cli bom create PC-GAMING CPU-I7:1 RAM-16GB:2 CASE-ATX:1@35 --submit"))
+		fmt.Println(T("  erp-cli bom create CABLE-KIT CABLE-1M:10 --qty=5"))
+		fmt.Println(T("  erp-cli bom submit BOM-PC-GAMING-001"))
+		return nil
+	}
+
+	switch args[0] {
+	case "list":
+		opts := bomListOptions{}
+		for _, arg := range args[1:] {
+			if len(arg) > 7 && arg[:7] == "--item=" {
+				opts.item = arg[7:]
+			}
+			if arg == "--all" {
+				opts.all = true
+			}
+		}
+		return c.bomList(opts)
+	case "get":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli bom get <bom|item_code>")
+		}
+		return c.bomGet(args[1])
+	case "create":
+		opts := bomCreateOptions{quantity: 1}
+		var positional []string
+		for _, arg := range args[1:] {
+			switch {
+			case len(arg) > 6 && arg[:6] == "--qty=":
+				q, err := strconv.ParseFloat(arg[6:], 64)
+				if err != nil || q <= 0 {
+					return errorf("invalid quantity: %s", arg[6:])
+				}
+				opts.quantity = q
+			case arg == "--submit":
+				opts.submit = true
+			default:
+				positional = append(positional, arg)
+			}
+		}
+		if len(positional) < 2 {
+			return errorf("usage: erp-cli bom create <item_code> <component:qty[@rate]> [...] [--qty=N] [--submit]")
+		}
+		var components []bomComponent
+		for _, arg := range positional[1:] {
+			comp, err := parseBOMComponent(arg)
+			if err != nil {
+				return err
+			}
+			components = append(components, comp)
+		}
+		return c.bomCreate(positional[0], components, opts)
+	case "submit":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli bom submit <bom>")
+		}
+		return c.bomSubmit(args[1])
+	default:
+		return errorf("unknown bom subcommand: %s", args[0])
+	}
+}
+
+// parseBOMComponent parses CODE:QTY[@RATE]; the quantity defaults to 1
+func parseBOMComponent(arg string) (bomComponent, error) {
+	comp := bomComponent{item: arg, qty: 1}
+	if idx := strings.LastIndex(arg, "@"); idx > 0 {
+		rate, err := strconv.ParseFloat(arg[idx+1:], 64)
+		if err != nil || rate < 0 {
+			return comp, errorf("invalid rate in component: %s", arg)
+		}
+		comp.rate = rate
+		comp.item = arg[:idx]
+	}
+	if idx := strings.LastIndex(comp.item, ":"); idx > 0 {
+		qty, err := strconv.ParseFloat(comp.item[idx+1:], 64)
+		if err != nil || qty <= 0 {
+			return comp, errorf("invalid quantity in component: %s", arg)
+		}
+		comp.qty = qty
+		comp.item = comp.item[:idx]
+	}
+	return comp, nil
+}
+
+func (c *Client) bomList(opts bomListOptions) error {
+	printf("%sFetching BOMs...%s\n", Blue, Reset)
+
+	filters := [][]interface{}{}
+	if !opts.all {
+		filters = append(filters, []interface{}{"is_active", "=", 1})
+	}
+	if opts.item != "" {
+		filters = append(filters, []interface{}{"item", "=", opts.item})
+	}
+
+	endpoint := "BOM?" + c.pageLimit(0) + "&fields=[\"name\",\"item\",\"quantity\",\"is_active\",\"is_default\",\"total_cost\",\"docstatus\"]&order_by=item%20asc"
+	if len(filters) > 0 {
+		encoded, err := encodeFilters(filters)
+		if err != nil {
+			return err
+		}
+		endpoint += "&filters=" + encoded
+	}
+
+	result, err := c.Request("GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].([]interface{}); ok {
+		if len(data) == 0 {
+			printf("%sNo BOMs found%s\n", Yellow, Reset)
+			return nil
+		}
+
+		printf("\n%sBOMs (%d):%s\n", Cyan, len(data), Reset)
+		c.printPageNote(len(data))
+		for _, item := range data {
+			if m, ok := item.(map[string]interface{}); ok {
+				qty, _ := m["quantity"].(float64)
+				cost, _ := m["total_cost"].(float64)
+				docstatus, _ := m["docstatus"].(float64)
+				status, statusColor := docStatusLabel(docstatus)
+
+				flags := ""
+				if isDefault, _ := m["is_default"].(float64); isDefault == 1 {
+					flags += " [default]"
+				}
+				if active, _ := m["is_active"].(float64); active == 0 {
+					flags += " [inactive]"
+				}
+				fmt.Printf("  %s - %s%s\n", m["name"], m["item"], flags)
+				printf("    Qty: %g | Status: %s%s%s | Cost: %s\n",
+					qty, statusColor, status, Reset, c.FormatCurrency(cost))
+			}
+		}
+	}
+	return nil
+}
+
+// defaultBOM returns the default BOM of an item, falling back to its most
+// recent active submitted one
+func (c *Client) defaultBOM(item string) (string, error) {
+	for _, onlyDefault := range []bool{true, false} {
+		filters := [][]interface{}{
+			{"item", "=", item},
+			{"is_active", "=", 1},
+			{"docstatus", "=", 1},
+		}
+		if onlyDefault {
+			filters = append(filters, []interface{}{"is_default", "=", 1})
+		}
+		encoded, err := encodeFilters(filters)
+		if err != nil {
+			return "", err
+		}
+		result, err := c.Request("GET", "BOM?fields=[\"name\"]&order_by=creation%20desc&limit_page_length=1&filters="+encoded, nil)
+		if err != nil {
+			return "", err
+		}
+		if data, ok := result["data"].([]interface{}); ok && len(data) > 0 {
+			if m, ok := data[0].(map[string]interface{}); ok {
+				return stringField(m, "name"), nil
+			}
+		}
+	}
+	return "", errorf("no active BOM for %s", item)
+}
+
+// fetchBOM loads a BOM by name, or the default BOM of an item code
+func (c *Client) fetchBOM(ref string) (map[string]interface{}, error) {
+	result, err := c.Request("GET", "BOM/"+url.PathEscape(ref), nil)
+	if err != nil {
+		name, bomErr := c.defaultBOM(ref)
+		if bomErr != nil {
+			return nil, errorf("BOM not found: %s", ref)
+		}
+		if result, err = c.Request("GET", "BOM/"+url.PathEscape(name), nil); err != nil {
+			return nil, err
+		}
+	}
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return nil, errorf("BOM not found: %s", ref)
+	}
+	return data, nil
+}
+
+func (c *Client) bomGet(ref string) error {
+	printf("%sFetching BOM: %s%s\n", Blue, ref, Reset)
+
+	data, err := c.fetchBOM(ref)
+	if err != nil {
+		return err
+	}
+
+	qty, _ := data["quantity"].(float64)
+	cost, _ := data["total_cost"].(float64)
+	docstatus, _ := data["docstatus"].(float64)
+	status, statusColor := docStatusLabel(docstatus)
+
+	printf("\n%sBOM: %s%s\n", Cyan, data["name"], Reset)
+	printf("  Item: %s (%s)\n", data["item"], data["item_name"])
+	printf("  Quantity: %g %s\n", qty, data["uom"])
+	printf("  Status: %s%s%s\n", statusColor, status, Reset)
+	if isDefault, _ := data["is_default"].(float64); isDefault == 1 {
+		fmt.Println(T("  Default: yes"))
+	}
+	printf("  Total Cost: %s\n", c.FormatCurrency(cost))
+
+	printf("\n  %sComponents:%s\n", Yellow, Reset)
+	c.printBOMItems(data, "    ", map[string]bool{stringField(data, "name"): true}, 1)
+	return nil
+}
+
+// printBOMItems prints the lines of a BOM, expanding sub-assemblies below
+// the line that uses them
+func (c *Client) printBOMItems(bom map[string]interface{}, indent string, seen map[string]bool, depth int) {
+	items, _ := bom["items"].([]interface{})
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		qty, _ := m["qty"].(float64)
+		rate, _ := m["rate"].(float64)
+		fmt.Printf("%s- %s x %g %s @ %s\n", indent, m["item_code"], qty, m["uom"], c.FormatCurrency(rate))
+
+		sub := stringField(m, "bom_no")
+		if sub == "" {
+			continue
+		}
+		if seen[sub] || depth >= maxBOMDepth {
+			printf("%s  %s(%s not expanded)%s\n", indent, Yellow, sub, Reset)
+			continue
+		}
+		result, err := c.Request("GET", "BOM/"+url.PathEscape(sub), nil)
+		if err != nil {
+			continue
+		}
+		if data, ok := result["data"].(map[string]interface{}); ok {
+			seen[sub] = true
+			c.printBOMItems(data, indent+"  ", seen, depth+1)
+			delete(seen, sub)
+		}
+	}
+}
+
+// newBOMBody builds a BOM. Given rates are kept by costing the BOM
+// manually; otherwise ERPNext uses the valuation rate.
+func newBOMBody(company, item string, quantity float64, components []bomComponent) map[string]interface{} {
+	manual := false
+	var items []interface{}
+	for _, comp := range components {
+		line := map[string]interface{}{
+			"item_code": comp.item,
+			"qty":       comp.qty,
+		}
+		if comp.rate > 0 {
+			line["rate"] = comp.rate
+			manual = true
+		}
+		items = append(items, line)
+	}
+
+	body := map[string]interface{}{
+		"item":     item,
+		"company":  company,
+		"quantity": quantity,
+		"items":    items,
+	}
+	if manual {
+		body["rm_cost_as_per"] = "Manual"
+	}
+	return body
+}
+
+// insertBOM creates a BOM and optionally submits it, returning its name
+func (c *Client) insertBOM(company, item string, quantity float64, components []bomComponent, submit bool) (string, error) {
+	result, err := c.Request("POST", "BOM", newBOMBody(company, item, quantity, components))
+	if err != nil {
+		return "", err
+	}
+	data, _ := result["data"].(map[string]interface{})
+	name := stringField(data, "name")
+	if submit {
+		if err := c.submitDocument("BOM", name); err != nil {
+			return name, errorf("%s created but not submitted: %w", name, err)
+		}
+	}
+	return name, nil
+}
+
+func (c *Client) bomCreate(item string, components []bomComponent, opts bomCreateOptions) error {
+	printf("%sCreating BOM for: %s (qty %g)%s\n", Blue, item, opts.quantity, Reset)
+	for _, comp := range components {
+		if comp.rate > 0 {
+			printf("  Component: %s x %g @ %.2f\n", comp.item, comp.qty, comp.rate)
+		} else {
+			printf("  Component: %s x %g\n", comp.item, comp.qty)
+		}
+	}
+
+	company, err := c.GetCompany()
+	if err != nil {
+		return err
+	}
+
+	name, err := c.insertBOM(company, item, opts.quantity, components, opts.submit)
+	if err != nil {
+		return err
+	}
+	if opts.submit {
+		printf("%s✓ BOM created and submitted: %s%s\n", Green, name, Reset)
+	} else {
+		printf("%s✓ BOM created: %s (draft, submit with: erp-cli bom submit %s)%s\n", Green, name, name, Reset)
+	}
+	return nil
+}
+
+func (c *Client) bomSubmit(name string) error {
+	printf("%sSubmitting BOM: %s%s\n", Blue, name, Reset)
+
+	if err := c.submitDocument("BOM", name); err != nil {
+		return err
+	}
+
+	printf("%s✓ BOM submitted: %s%s\n", Green, name, Reset)
+	return nil
+}
+
+// exportBOMs writes the default BOM of every item, one row per component
+func (c *Client) exportBOMs(outputFile string) error {
+	printf("%sExporting BOMs...%s\n", Blue, Reset)
+
+	filters, err := encodeFilters([][]interface{}{
+		{"is_active", "=", 1},
+		{"is_default", "=", 1},
+		{"docstatus", "=", 1},
+	})
+	if err != nil {
+		return err
+	}
+	fields, _ := json.Marshal([]string{"name", "item", "quantity", "`tabBOM Item`.item_code", "`tabBOM Item`.qty", "`tabBOM Item`.rate"})
+	order := url.QueryEscape("item asc, `tabBOM Item`.idx asc")
+	result, err := c.Request("GET", "BOM?limit_page_length=0&filters="+filters+"&order_by="+order+"&fields="+url.QueryEscape(string(fields)), nil)
+	if err != nil {
+		return err
+	}
+
+	data, _ := result["data"].([]interface{})
+	if len(data) == 0 {
+		printf("%sNo BOMs found%s\n", Yellow, Reset)
+		return nil
+	}
+
+	writer, err := newTableWriter(outputFile, "BOMs")
+	if err != nil {
+		return err
+	}
+	if err := writer.Write([]string{"parent", "quantity", "component", "qty", "rate", "bom"}); err != nil {
+		return errorf("failed to write header: %w", err)
+	}
+
+	boms := map[string]bool{}
+	for _, item := range data {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		quantity, _ := m["quantity"].(float64)
+		qty, _ := m["qty"].(float64)
+		rate, _ := m["rate"].(float64)
+		row := []string{
+			stringField(m, "item"),
+			strconv.FormatFloat(quantity, 'f', -1, 64),
+			stringField(m, "item_code"),
+			strconv.FormatFloat(qty, 'f', -1, 64),
+			strconv.FormatFloat(rate, 'f', -1, 64),
+			stringField(m, "name"),
+		}
+		if err := writer.Write(row); err != nil {
+			return errorf("failed to write row: %w", err)
+		}
+		boms[row[5]] = true
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	printf("%s✓ Exported %d BOMs to %s%s\n", Green, len(boms), outputFile, Reset)
+	return nil
+}
+
+// importBOMs creates and submits one BOM per parent of a CSV with parent,
+// component, qty and optional rate and quantity (parent units) columns.
+// Parents used as components elsewhere in the file are created first, so
+// multi-level structures link up as sub-assemblies.
+func (c *Client) importBOMs(inputFile string, run *importRun) error {
+	if run.dryRun {
+		printf("%s[DRY RUN] Importing BOMs from: %s%s\n", Yellow, inputFile, Reset)
+	} else {
+		printf("%sImporting BOMs from: %s%s\n", Blue, inputFile, Reset)
+	}
+
+	records, columns, err := readCSVColumns(inputFile, run.mapping, "parent", "component", "qty")
+	if err != nil {
+		return err
+	}
+
+	var parents []string
+	byParent := map[string][]bomComponent{}
+	quantity := map[string]float64{}
+	skipped := 0
+	for i, record := range records {
+		if run.finished(i + 2) {
+			continue
+		}
+		parent := csvCell(record, columns, "parent")
+		comp := csvCell(record, columns, "component")
+		if parent == "" || comp == "" {
+			printf("  %sRow %d: skipped (no parent or component)%s\n", Yellow, i+2, Reset)
+			run.record(i+2, parent, rowSkipped, "no parent or component")
+			skipped++
+			continue
+		}
+		qty, err := strconv.ParseFloat(csvCell(record, columns, "qty"), 64)
+		if err != nil || qty <= 0 {
+			printf("  %sRow %d: skipped (invalid qty)%s\n", Yellow, i+2, Reset)
+			run.record(i+2, parent, rowSkipped, "invalid qty")
+			skipped++
+			continue
+		}
+		rate := 0.0
+		if value := csvCell(record, columns, "rate"); value != "" {
+			if rate, err = strconv.ParseFloat(value, 64); err != nil || rate < 0 {
+				printf("  %sRow %d: skipped (invalid rate)%s\n", Yellow, i+2, Reset)
+				run.record(i+2, parent, rowSkipped, "invalid rate")
+				skipped++
+				continue
+			}
+		}
+		if value := csvCell(record, columns, "quantity"); value != "" {
+			if q, err := strconv.ParseFloat(value, 64); err == nil && q > 0 {
+				quantity[parent] = q
+			}
+		}
+
+		if _, ok := byParent[parent]; !ok {
+			parents = append(parents, parent)
+		}
+		byParent[parent] = append(byParent[parent], bomComponent{row: i + 2, item: comp, qty: qty, rate: rate})
+	}
+
+	order, err := bomLevelOrder(parents, byParent)
+	if err != nil {
+		return err
+	}
+
+	if run.dryRun {
+		for _, parent := range order {
+			printf("  [DRY RUN] Would create BOM for %s (%d components)\n", parent, len(byParent[parent]))
+		}
+		printf("\n%sSummary: %d BOMs, %d skipped%s\n", Cyan, len(order), skipped, Reset)
+		return nil
+	}
+	if len(order) == 0 {
+		printf("\n%sSummary: 0 created, %d skipped, 0 failed%s\n", Cyan, skipped, Reset)
+		return nil
+	}
+
+	company, err := c.GetCompany()
+	if err != nil {
+		return err
+	}
+
+	created := 0
+	failed := 0
+	for _, parent := range order {
+		qty := quantity[parent]
+		if qty == 0 {
+			qty = 1
+		}
+		name, err := c.insertBOM(company, parent, qty, byParent[parent], true)
+		status, message := rowCreated, name
+		if err != nil {
+			printf("  %s✗ Failed: %s (%s)%s\n", Red, parent, err, Reset)
+			status, message = rowError, err.Error()
+			// A draft was left behind; don't create a second one on --resume
+			if name != "" {
+				status = rowCreated
+			}
+			failed++
+		} else {
+			printf("  %s✓ %s: %s (%d components)%s\n", Green, name, parent, len(byParent[parent]), Reset)
+			created++
+		}
+		for _, comp := range byParent[parent] {
+			run.record(comp.row, parent, status, message)
+		}
+	}
+
+	printf("\n%sSummary: %d created, %d skipped, %d failed%s\n", Cyan, created, skipped, failed, Reset)
+	return nil
+}
+
+// bomLevelOrder sorts parents so every sub-assembly comes before the BOMs
+// that use it, keeping the file order otherwise
+func bomLevelOrder(parents []string, byParent map[string][]bomComponent) ([]string, error) {
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := map[string]int{}
+	var order []string
+	var visit func(parent string, path []string) error
+	visit = func(parent string, path []string) error {
+		switch state[parent] {
+		case done:
+			return nil
+		case visiting:
+			return errorf("circular BOM: %s", strings.Join(append(path, parent), " -> "))
+		}
+		state[parent] = visiting
+		for _, comp := range byParent[parent] {
+			if _, ok := byParent[comp.item]; ok {
+				if err := visit(comp.item, append(path, parent)); err != nil {
+					return err
+				}
+			}
+		}
+		state[parent] = done
+		order = append(order, parent)
+		return nil
+	}
+	for _, parent := range parents {
+		if err := visit(parent, nil); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
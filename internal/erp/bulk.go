@@ -0,0 +1,253 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// bulkBatchSize is the number of documents sent per bulk request.
+// frappe.client.insert_many refuses more than 200.
+const bulkBatchSize = 50
+
+// bulkWorkers is how many documents a bulk action processes at once
+const bulkWorkers = 4
+
+// bulkPreviewSize is how many matching documents are listed before asking
+// for confirmation
+const bulkPreviewSize = 10
+
+// bulkResult reports the outcome of one document in a bulk operation
+type bulkResult struct {
+	doc map[string]interface{}
+	err error
+}
+
+// insertMany creates documents in batches through frappe.client.insert_many,
+// one round trip per batch instead of per document. A batch runs in a single
+// transaction, so when it fails it is retried one document at a time to find
+// and report the failing rows while still creating the valid ones.
+func (c *Client) insertMany(doctype string, docs []map[string]interface{}) []bulkResult {
+	var results []bulkResult
+
+	// insert_many skips Request, so the --set fields are filled here
+	for _, doc := range docs {
+		if err := c.applyFieldSets("POST", url.PathEscape(doctype), doc); err != nil {
+			for _, doc := range docs {
+				results = append(results, bulkResult{doc, err})
+			}
+			return results
+		}
+	}
+
+	for start := 0; start < len(docs); start += bulkBatchSize {
+		end := start + bulkBatchSize
+		if end > len(docs) {
+			end = len(docs)
+		}
+		batch := docs[start:end]
+
+		payload := make([]map[string]interface{}, len(batch))
+		for i, doc := range batch {
+			withType := map[string]interface{}{"doctype": doctype}
+			for k, v := range doc {
+				withType[k] = v
+			}
+			payload[i] = withType
+		}
+
+		_, err := c.CallMethod("POST", "frappe.client.insert_many", map[string]interface{}{"docs": payload})
+		if err == nil {
+			for _, doc := range batch {
+				results = append(results, bulkResult{doc, nil})
+			}
+			continue
+		}
+
+		if len(batch) > 1 {
+			printf("  %sBatch %d-%d failed, retrying one by one%s\n", Yellow, start+1, end, Reset)
+		}
+		for _, doc := range batch {
+			_, err := c.Request("POST", strings.ReplaceAll(doctype, " ", "%20"), doc)
+			results = append(results, bulkResult{doc, err})
+		}
+	}
+	return results
+}
+
+// CmdBulk submits, cancels or deletes every document matching the filters
+func (c *Client) CmdBulk(args []string) error {
+	if len(args) < 2 {
+		fmt.Println(T("Usage: erp-cli bulk <action> <doctype> [--filter key=value ...] [--notify|--no-notify] [--yes]"))
+		fmt.Println(T("Actions: submit, cancel, delete"))
+		fmt.Println()
+		fmt.Println(T("Filters take =, !=, <, <=, > or >= (values with % match with like)."))
+		fmt.Println(T("Only documents the action applies to are picked: drafts for submit,"))
+		fmt.Println(T("submitted documents for cancel, drafts and cancelled ones for delete."))
+		fmt.Println(T("The matches are listed and confirmed first unless --yes is given."))
+		fmt.Println(T("Submitted quotations, orders and invoices email the customer as their"))
+		fmt.Println(T("submit commands do (ERP_NOTIFY_ON, --notify, --no-notify)."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli bulk submit si --filter customer=\"Acme Corp\""))
+		fmt.Println(T("  erp-cli bulk submit \"Sales Invoice\" --filter status=Draft --filter \"creation<2025-01-01\""))
+		fmt.Println(T("  erp-cli bulk cancel po --filter supplier=\"Old Supplier\" --yes"))
+		fmt.Println(T("  erp-cli bulk delete quotation --filter \"valid_till<2024-01-01\""))
+		return nil
+	}
+
+	doctype := resolveDoctype(args[1])
+	filters, err := parseDocFilters(args[2:])
+	if err != nil {
+		return err
+	}
+	yes := false
+	for _, arg := range args[2:] {
+		if arg == "--yes" || arg == "-y" {
+			yes = true
+		}
+	}
+
+	var verb, done string
+	var applies []interface{}
+	var run func(name string) error
+	switch args[0] {
+	case "submit":
+		verb, done = "Submit", "Submitted"
+		applies = []interface{}{"docstatus", "=", 0}
+		notify := c.notifyOnSubmit(doctype, args[2:])
+		run = func(name string) error { return c.submitNotifying(doctype, name, notify) }
+	case "cancel":
+		verb, done = "Cancel", "Cancelled"
+		applies = []interface{}{"docstatus", "=", 1}
+		run = func(name string) error { return c.cancelDocument(doctype, name) }
+	case "delete":
+		verb, done = "Delete", "Deleted"
+		applies = []interface{}{"docstatus", "!=", 1}
+		run = func(name string) error {
+			_, err := c.Request("DELETE", url.PathEscape(doctype)+"/"+url.PathEscape(name), nil)
+			return err
+		}
+	default:
+		return errorf("unknown bulk action: %s (use submit, cancel or delete)", args[0])
+	}
+
+	hasDocstatus := false
+	for _, f := range filters {
+		if f[0] == "docstatus" {
+			hasDocstatus = true
+		}
+	}
+	if !hasDocstatus {
+		filters = append(filters, applies)
+	}
+
+	printf("%sFinding %s documents to %s...%s\n", Blue, doctype, args[0], Reset)
+	names, err := c.fetchDocNames(doctype, filters)
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		printf("%sNo matching %s documents%s\n", Yellow, doctype, Reset)
+		return nil
+	}
+
+	printf("\n%s%d matching %s documents:%s\n", Cyan, len(names), doctype, Reset)
+	for i, name := range names {
+		if i == bulkPreviewSize {
+			printf("  ... and %d more\n", len(names)-bulkPreviewSize)
+			break
+		}
+		fmt.Printf("  %s\n", name)
+	}
+	fmt.Println()
+
+	if !yes && !confirm(tf("%s %d documents?", verb, len(names))) {
+		printf("%sAborted%s\n", Yellow, Reset)
+		return nil
+	}
+
+	errs := runBulk(names, func(name string, err error) {
+		if err != nil {
+			fmt.Printf("%s✗ %s: %s%s\n", Red, name, err, Reset)
+			return
+		}
+		fmt.Printf("%s✓ %s: %s%s\n", Green, done, name, Reset)
+	}, run)
+
+	failed := 0
+	for _, err := range errs {
+		if err != nil {
+			failed++
+		}
+	}
+
+	printf("\n%sSummary: %d %s, %d failed%s\n", Cyan, len(names)-failed, strings.ToLower(done), failed, Reset)
+	if failed > 0 {
+		printf("\n%sFailed:%s\n", Red, Reset)
+		for i, err := range errs {
+			if err != nil {
+				fmt.Printf("  %s: %s\n", names[i], err)
+			}
+		}
+	}
+	return nil
+}
+
+// fetchDocNames returns the names of the documents matching the filters
+func (c *Client) fetchDocNames(doctype string, filters [][]interface{}) ([]string, error) {
+	endpoint := url.PathEscape(doctype) + "?limit_page_length=0&fields=[\"name\"]&order_by=name%20asc"
+	if len(filters) > 0 {
+		encoded, err := encodeFilters(filters)
+		if err != nil {
+			return nil, err
+		}
+		endpoint += "&filters=" + encoded
+	}
+
+	result, err := c.Request("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	data, _ := result["data"].([]interface{})
+	for _, row := range data {
+		if m, ok := row.(map[string]interface{}); ok {
+			names = append(names, stringField(m, "name"))
+		}
+	}
+	return names, nil
+}
+
+// runBulk applies action to every name with bulkWorkers requests in flight,
+// calling report as each one finishes. The errors are returned in the order
+// of names.
+func runBulk(names []string, report func(name string, err error), action func(name string) error) []error {
+	errs := make([]error, len(names))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+
+	for w := 0; w < bulkWorkers && w < len(names); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				err := action(names[i])
+				mu.Lock()
+				errs[i] = err
+				report(names[i], err)
+				mu.Unlock()
+			}
+		}()
+	}
+	for i := range names {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return errs
+}
@@ -0,0 +1,179 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// CmdBundle handles Product Bundle commands
+func (c *Client) CmdBundle(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli bundle <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, create, delete"))
+		fmt.Println()
+		fmt.Println(T("The parent item must be a non-stock item; components are stock items."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli bundle list"))
+		fmt.Println(T("  erp-cli bundle get KIT-GAMING"))
+		fmt.Println(T("  erp-cli bundle create KIT-GAMING CPU-I7:1 RAM-16GB:2 SSD-1TB:1"))
+		fmt.Println(T("  erp-cli bundle delete KIT-GAMING"))
+		return nil
+	}
+
+	switch args[0] {
+	case "list":
+		return c.bundleList()
+	case "get":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli bundle get <parent_code>")
+		}
+		return c.bundleGet(args[1])
+	case "create":
+		if len(args) < 3 {
+			return errorf("usage: erp-cli bundle create <parent_code> <child:qty> [child:qty...]")
+		}
+		return c.bundleCreate(args[1], args[2:])
+	case "delete":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli bundle delete <parent_code>")
+		}
+		return c.bundleDelete(args[1])
+	default:
+		return errorf("unknown bundle subcommand: %s", args[0])
+	}
+}
+
+func (c *Client) bundleList() error {
+	printf("%sFetching product bundles...%s\n", Blue, Reset)
+
+	result, err := c.Request("GET", "Product%20Bundle?limit_page_length=0&fields=[\"name\",\"new_item_code\",\"description\"]", nil)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].([]interface{}); ok {
+		if len(data) == 0 {
+			printf("%sNo product bundles found%s\n", Yellow, Reset)
+			return nil
+		}
+
+		printf("\n%sProduct Bundles (%d):%s\n", Cyan, len(data), Reset)
+		for _, item := range data {
+			if m, ok := item.(map[string]interface{}); ok {
+				fmt.Printf("  %s\n", m["new_item_code"])
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Client) bundleGet(parent string) error {
+	printf("%sFetching product bundle: %s%s\n", Blue, parent, Reset)
+
+	encoded := url.PathEscape(parent)
+	result, err := c.Request("GET", "Product%20Bundle/"+encoded, nil)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].(map[string]interface{}); ok {
+		printf("\n%sProduct Bundle: %s%s\n", Cyan, parent, Reset)
+		if desc, ok := data["description"].(string); ok && desc != "" {
+			printf("  Description: %s\n", desc)
+		}
+
+		if items, ok := data["items"].([]interface{}); ok && len(items) > 0 {
+			printf("\n  %sComponents:%s\n", Yellow, Reset)
+			for _, item := range items {
+				if m, ok := item.(map[string]interface{}); ok {
+					qty, _ := m["qty"].(float64)
+					fmt.Printf("    - %s x %g\n", m["item_code"], qty)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Client) bundleCreate(parent string, components []string) error {
+	printf("%sCreating product bundle: %s%s\n", Blue, parent, Reset)
+
+	var items []map[string]interface{}
+	for _, comp := range components {
+		code, qty := comp, 1.0
+		if idx := strings.LastIndex(comp, ":"); idx > 0 {
+			q, err := strconv.ParseFloat(comp[idx+1:], 64)
+			if err != nil || q <= 0 {
+				return errorf("invalid quantity in component: %s", comp)
+			}
+			code, qty = comp[:idx], q
+		}
+		items = append(items, map[string]interface{}{
+			"item_code": code,
+			"qty":       qty,
+		})
+		printf("  Component: %s x %g\n", code, qty)
+	}
+
+	body := map[string]interface{}{
+		"new_item_code": parent,
+		"items":         items,
+	}
+
+	_, err := c.Request("POST", "Product%20Bundle", body)
+	if err != nil {
+		return err
+	}
+
+	printf("%s✓ Product Bundle created: %s%s\n", Green, parent, Reset)
+	return nil
+}
+
+func (c *Client) bundleDelete(parent string) error {
+	printf("%sDeleting product bundle: %s%s\n", Blue, parent, Reset)
+
+	encoded := url.PathEscape(parent)
+	_, err := c.Request("DELETE", "Product%20Bundle/"+encoded, nil)
+	if err != nil {
+		return err
+	}
+
+	printf("%s✓ Product Bundle deleted: %s%s\n", Green, parent, Reset)
+	return nil
+}
+
+// packedItemLines returns the expanded bundle components (packed_items) of a
+// SO/DN line, already indented for display below it
+func packedItemLines(doc, line map[string]interface{}) []string {
+	packed, ok := doc["packed_items"].([]interface{})
+	if !ok || len(packed) == 0 {
+		return nil
+	}
+
+	var lines []string
+	for _, p := range packed {
+		pm, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		// Match on the parent row when available, otherwise on the parent item code
+		if rowName, ok := pm["parent_detail_docname"].(string); ok && rowName != "" {
+			if rowName != line["name"] {
+				continue
+			}
+		} else if pm["parent_item"] != line["item_code"] {
+			continue
+		}
+
+		qty, _ := pm["qty"].(float64)
+		text := fmt.Sprintf("      ↳ %v x %g", pm["item_code"], qty)
+		if wh, ok := pm["warehouse"].(string); ok && wh != "" {
+			text += fmt.Sprintf(" (%s)", wh)
+		}
+		lines = append(lines, text)
+	}
+	return lines
+}
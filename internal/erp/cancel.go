@@ -0,0 +1,189 @@
+package erp
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// linkedDoc identifies a submitted document that depends on another
+type linkedDoc struct {
+	doctype string
+	name    string
+}
+
+// downstreamLink describes where a child table row points back to its source document
+type downstreamLink struct {
+	doctype    string // Document that holds the link
+	childTable string // Child doctype with the link field
+	field      string // Link field pointing to the source
+	typeField  string // Field naming the source doctype, for dynamic links
+}
+
+// downstreamLinks lists, per doctype, the documents that can be created from it
+var downstreamLinks = map[string][]downstreamLink{
+	"Quotation": {
+		{"Sales Order", "Sales Order Item", "prevdoc_docname", ""},
+	},
+	"Sales Order": {
+		{"Delivery Note", "Delivery Note Item", "against_sales_order", ""},
+		{"Sales Invoice", "Sales Invoice Item", "sales_order", ""},
+		{"Payment Entry", "Payment Entry Reference", "reference_name", "reference_doctype"},
+	},
+	"Delivery Note": {
+		{"Sales Invoice", "Sales Invoice Item", "delivery_note", ""},
+	},
+	"Sales Invoice": {
+		{"Delivery Note", "Delivery Note Item", "against_sales_invoice", ""},
+		{"Payment Entry", "Payment Entry Reference", "reference_name", "reference_doctype"},
+	},
+	"Purchase Order": {
+		{"Purchase Receipt", "Purchase Receipt Item", "purchase_order", ""},
+		{"Purchase Invoice", "Purchase Invoice Item", "purchase_order", ""},
+		{"Payment Entry", "Payment Entry Reference", "reference_name", "reference_doctype"},
+	},
+	"Purchase Receipt": {
+		{"Purchase Invoice", "Purchase Invoice Item", "purchase_receipt", ""},
+	},
+	"Purchase Invoice": {
+		{"Payment Entry", "Payment Entry Reference", "reference_name", "reference_doctype"},
+	},
+}
+
+// cancelOptions holds flags for cancel subcommands
+type cancelOptions struct {
+	cascade bool // Cancel linked documents first
+	yes     bool // Skip confirmation
+}
+
+func parseCancelOptions(args []string) cancelOptions {
+	opts := cancelOptions{}
+	for _, arg := range args {
+		if arg == "--cascade" {
+			opts.cascade = true
+		}
+		if arg == "--yes" || arg == "-y" {
+			opts.yes = true
+		}
+	}
+	return opts
+}
+
+// findCancelChain returns the submitted documents that must be cancelled
+// before the given one, in the order they have to be cancelled
+func (c *Client) findCancelChain(doctype, name string) ([]linkedDoc, error) {
+	var chain []linkedDoc
+	visited := map[linkedDoc]bool{{doctype, name}: true}
+
+	var visit func(doc linkedDoc) error
+	visit = func(doc linkedDoc) error {
+		for _, link := range downstreamLinks[doc.doctype] {
+			conditions := [][]interface{}{
+				{link.childTable, link.field, "=", doc.name},
+				{"docstatus", "=", 1},
+			}
+			if link.typeField != "" {
+				conditions = append(conditions, []interface{}{link.childTable, link.typeField, "=", doc.doctype})
+			}
+			filters, err := encodeFilters(conditions)
+			if err != nil {
+				return err
+			}
+
+			endpoint := strings.ReplaceAll(link.doctype, " ", "%20") + "?limit_page_length=0&fields=[\"name\"]&filters=" + filters
+			result, err := c.Request("GET", endpoint, nil)
+			if err != nil {
+				return err
+			}
+
+			if data, ok := result["data"].([]interface{}); ok {
+				for _, row := range data {
+					m, ok := row.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					child := linkedDoc{link.doctype, fmt.Sprintf("%v", m["name"])}
+					if visited[child] {
+						continue
+					}
+					visited[child] = true
+					if err := visit(child); err != nil {
+						return err
+					}
+					chain = append(chain, child)
+				}
+			}
+		}
+		return nil
+	}
+
+	if err := visit(linkedDoc{doctype, name}); err != nil {
+		return nil, err
+	}
+	return chain, nil
+}
+
+// checkCancelChain returns an error listing the cancellation order when
+// the document still has submitted downstream documents
+func (c *Client) checkCancelChain(doctype, name string) error {
+	chain, err := c.findCancelChain(doctype, name)
+	if err != nil {
+		return err
+	}
+	if len(chain) == 0 {
+		return nil
+	}
+
+	var names []string
+	for _, doc := range chain {
+		names = append(names, doc.name)
+	}
+	return errorf("cancel linked documents first: %s", strings.Join(names, " → "))
+}
+
+// cancelWithChain cancels a document, handling submitted downstream documents:
+// without --cascade it prints the required order and stops; with it, it
+// cancels the chain (after confirmation) and then the document itself
+func (c *Client) cancelWithChain(doctype, name string, opts cancelOptions) error {
+	chain, err := c.findCancelChain(doctype, name)
+	if err != nil {
+		return err
+	}
+
+	if len(chain) > 0 {
+		printf("\n%s%s %s has %d linked submitted document(s).%s\n", Yellow, doctype, name, len(chain), Reset)
+		printf("%sCancellation order:%s\n", Cyan, Reset)
+		for i, doc := range chain {
+			fmt.Printf("  %d. %s %s\n", i+1, doc.doctype, doc.name)
+		}
+		fmt.Printf("  %d. %s %s\n\n", len(chain)+1, doctype, name)
+
+		if !opts.cascade {
+			return errorf("linked documents must be cancelled first (use --cascade to cancel them all)")
+		}
+
+		if !opts.yes && !confirm(tf("Cancel all %d documents?", len(chain)+1)) {
+			printf("%sAborted%s\n", Yellow, Reset)
+			return nil
+		}
+
+		for _, doc := range chain {
+			if err := c.cancelDocument(doc.doctype, doc.name); err != nil {
+				return errorf("failed to cancel %s %s: %w", doc.doctype, doc.name, err)
+			}
+			printf("%s✓ Cancelled: %s %s%s\n", Green, doc.doctype, doc.name, Reset)
+		}
+	}
+
+	return c.cancelDocument(doctype, name)
+}
+
+// confirm asks a yes/no question on stdin, defaulting to no
+func confirm(question string) bool {
+	printf("%s [y/N]: ", T(question))
+	reader := bufio.NewReader(os.Stdin)
+	answer, _ := reader.ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
@@ -6,10 +6,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -44,36 +47,36 @@ func parseAPIResponse(statusCode int, respBody []byte) (map[string]interface{},
 		if statusCode >= 200 && statusCode < 300 {
 			return map[string]interface{}{}, nil
 		}
-		return nil, fmt.Errorf("API error: HTTP %d (empty response)", statusCode)
+		return nil, errorf("API error: HTTP %d (empty response)", statusCode)
 	}
 
 	var result map[string]interface{}
 	if err := json.Unmarshal(respBody, &result); err != nil {
 		if statusCode < 200 || statusCode >= 300 {
-			return nil, fmt.Errorf("API error: HTTP %d: %s", statusCode, strings.TrimSpace(string(respBody)))
+			return nil, errorf("API error: HTTP %d: %s", statusCode, strings.TrimSpace(string(respBody)))
 		}
-		return nil, fmt.Errorf("failed to parse response: %s", string(respBody))
+		return nil, errorf("failed to parse response: %s", string(respBody))
 	}
 
 	if statusCode < 200 || statusCode >= 300 {
 		if exc, ok := result["exception"]; ok {
-			return nil, fmt.Errorf("API error (HTTP %d): %v", statusCode, exc)
+			return nil, errorf("API error (HTTP %d): %v", statusCode, exc)
 		}
 		if msg, ok := result["message"]; ok {
-			return nil, fmt.Errorf("API error (HTTP %d): %v", statusCode, msg)
+			return nil, errorf("API error (HTTP %d): %v", statusCode, msg)
 		}
-		return nil, fmt.Errorf("API error (HTTP %d)", statusCode)
+		return nil, errorf("API error (HTTP %d)", statusCode)
 	}
 
 	if exc, ok := result["exception"]; ok {
-		return nil, fmt.Errorf("API error: %v", exc)
+		return nil, errorf("API error: %v", exc)
 	}
 
 	return result, nil
 }
 
-// Colors for terminal output
-const (
+// Colors for terminal output, empty when colors are off (see ConfigureOutput)
+var (
 	Red    = "\033[0;31m"
 	Green  = "\033[0;32m"
 	Yellow = "\033[1;33m"
@@ -92,6 +95,54 @@ type Config struct {
 	NginxCookieName string // Cookie name for reverse proxy auth (default: "auth_cookie")
 	Company         string // Company name for stock operations (auto-detected if empty)
 	Brand           string // CLI branding shown in TUI (default: "ERPNext CLI")
+	Timezone        string // IANA zone for dates (default: server's System Settings)
+	LowBandwidth    bool   // Smaller lists and fewer requests (see lowbandwidth.go)
+	Theme           string // TUI theme name or theme file (see theme.go)
+	Language        string // Language of the CLI and TUI text (see i18n.go)
+	RefreshSeconds  int    // TUI auto-refresh interval (0 = off, see tui_refresh.go)
+
+	// Exchange rates (see fx.go)
+	FXProvider   string   // fx sync URL template (default: Frankfurter)
+	FXCurrencies []string // Currencies fx sync fetches
+
+	// Doctypes snapshot pull mirrors (empty: the catalog, see localdb.go)
+	SnapshotDoctypes []string
+
+	// Customer emails on submit (see notify.go)
+	NotifyOn       []string // Doctypes that email on submit: quotation, so, si
+	NotifyTemplate string   // Email Template of the emails (default: built-in)
+
+	// Item creation defaults
+	DefaultUOM          string // stock_uom for new items (default: "Unit")
+	DefaultItemGroup    string // item_group when none is given
+	DefaultIsStockItem  bool   // is_stock_item for new items (default: true)
+	DefaultWarrantyDays int    // warranty_period in days (0 = not set)
+
+	// Document defaults, for what new documents leave out (see docdefaults.go)
+	DefaultWarehouse        string // Warehouse of stock receive/issue and purchase rows
+	DefaultSellingPriceList string // After the customer's own price list
+	DefaultBuyingPriceList  string
+	DefaultCustomerGroup    string
+	DefaultTerritory        string
+	DefaultSupplierGroup    string
+
+	// Command aliases (alias.<name>="<command> [default args]") and default
+	// flags per command (defaults.<command>[.<subcommand>]="--flag=value ...")
+	Aliases         map[string]string
+	CommandDefaults map[string]string
+
+	// Dashboard panels in display order (empty: all, see panels.go) and
+	// custom query report panels (panel.<id>="<Report Name> [key=value ...]")
+	DashboardPanels []string
+	CustomPanels    map[string]string
+
+	// Named TUI list filters (filter.<name>="<Doctype> [key=value ...]")
+	SavedFilters map[string]string
+
+	// TUI key bindings by "<view>.<action>" (key.<view>.<action>="<key>")
+	KeyBindings map[string]string
+
+	path string // File the config was read from, for SaveSetting
 }
 
 // CurrencyInfo holds currency details
@@ -102,11 +153,25 @@ type CurrencyInfo struct {
 
 // Client handles API requests
 type Client struct {
-	Config     *Config
-	HTTPClient *http.Client
-	ActiveURL  string
-	Mode       string // "vpn" or "internet"
-	Currency   *CurrencyInfo
+	Config           *Config
+	HTTPClient       *http.Client
+	ActiveURL        string
+	Mode             string // "vpn" or "internet"
+	Currency         *CurrencyInfo
+	Location         *time.Location // Server time zone (see GetLocation)
+	locationMu       sync.Mutex
+	Local            bool // Answer reads from local snapshots (see localdb.go)
+	localNoted       map[string]bool
+	versions         map[string]string   // Installed app versions (see compat.go)
+	perms            map[string]bool     // Permission answers (see perms.go)
+	permRoles        map[string][]string // Roles granting the permissions denied
+	permsMu          sync.Mutex
+	fieldSets        map[string]map[string]string // --set fields by doctype, "" for the command's (see setfields.go)
+	fieldSetsMeta    map[string]map[string]map[string]interface{}
+	fieldSetsCreated map[string]bool // Doctypes created with the --set fields
+	fieldSetsMu      sync.Mutex
+	created          []createdDoc // Documents created by this run (see cmdhistory.go)
+	tuiHistory       bool         // Record creations at once, there being no command
 }
 
 // LoadConfig reads the .erp-config file
@@ -121,18 +186,26 @@ func LoadConfig() (*Config, error) {
 	}
 
 	if configPath == "" {
-		return nil, fmt.Errorf("config file not found. Copy .erp-config.example to .erp-config")
+		return nil, errorf("config file not found. Copy .erp-config.example to .erp-config")
 	}
 
 	file, err := os.Open(configPath)
 	if err != nil {
-		return nil, fmt.Errorf("cannot open config: %w", err)
+		return nil, errorf("cannot open config: %w", err)
 	}
 	defer file.Close()
 
 	config := &Config{
-		NginxCookieName: "auth_cookie",
-		Brand:           "ERPNext CLI",
+		NginxCookieName:    "auth_cookie",
+		Brand:              "ERPNext CLI",
+		DefaultUOM:         "Unit",
+		DefaultIsStockItem: true,
+		Aliases:            map[string]string{},
+		CommandDefaults:    map[string]string{},
+		CustomPanels:       map[string]string{},
+		SavedFilters:       map[string]string{},
+		KeyBindings:        map[string]string{},
+		path:               configPath,
 	}
 
 	scanner := bufio.NewScanner(file)
@@ -150,6 +223,40 @@ func LoadConfig() (*Config, error) {
 		key := strings.TrimSpace(parts[0])
 		value := strings.Trim(strings.TrimSpace(parts[1]), "\"'")
 
+		if name, ok := strings.CutPrefix(key, "alias."); ok {
+			// Only strip the outer pair of quotes so quoted default
+			// arguments at the end of the alias survive
+			if name != "" {
+				config.Aliases[name] = trimOuterQuotes(strings.TrimSpace(parts[1]))
+			}
+			continue
+		}
+		if command, ok := strings.CutPrefix(key, "defaults."); ok {
+			if command != "" {
+				config.CommandDefaults[command] = trimOuterQuotes(strings.TrimSpace(parts[1]))
+			}
+			continue
+		}
+		if id, ok := strings.CutPrefix(key, "panel."); ok {
+			if id != "" {
+				config.CustomPanels[id] = trimOuterQuotes(strings.TrimSpace(parts[1]))
+			}
+			continue
+		}
+		if name, ok := strings.CutPrefix(key, "filter."); ok {
+			if name != "" {
+				config.SavedFilters[name] = trimOuterQuotes(strings.TrimSpace(parts[1]))
+			}
+			continue
+		}
+		if binding, ok := strings.CutPrefix(key, "key."); ok {
+			// Only strip the outer pair of quotes so a quote can be bound
+			if binding != "" {
+				config.KeyBindings[binding] = trimOuterQuotes(strings.TrimSpace(parts[1]))
+			}
+			continue
+		}
+
 		switch key {
 		case "ERP_VPN":
 			config.ERPVPN = value
@@ -167,20 +274,126 @@ func LoadConfig() (*Config, error) {
 			}
 		case "ERP_COMPANY":
 			config.Company = value
+		case "ERP_TIMEZONE":
+			config.Timezone = value
+		case "ERP_THEME":
+			config.Theme = value
+		case "ERP_LANG":
+			config.Language = value
+		case "ERP_TUI_REFRESH":
+			if seconds, err := strconv.Atoi(value); err == nil {
+				config.RefreshSeconds = seconds
+			}
+		case "ERP_FX_PROVIDER":
+			config.FXProvider = value
+		case "ERP_FX_CURRENCIES":
+			config.FXCurrencies = splitCurrencies(value)
+		case "ERP_NOTIFY_ON":
+			config.NotifyOn = nil
+			for _, short := range strings.Split(value, ",") {
+				if short = strings.ToLower(strings.TrimSpace(short)); short != "" {
+					config.NotifyOn = append(config.NotifyOn, short)
+				}
+			}
+		case "ERP_NOTIFY_TEMPLATE":
+			config.NotifyTemplate = value
+		case "ERP_SNAPSHOT_DOCTYPES":
+			config.SnapshotDoctypes = nil
+			for _, doctype := range strings.Split(value, ",") {
+				if doctype = strings.TrimSpace(doctype); doctype != "" {
+					config.SnapshotDoctypes = append(config.SnapshotDoctypes, resolveDoctype(doctype))
+				}
+			}
+		case "ERP_LOW_BANDWIDTH":
+			config.LowBandwidth = value == "1" || value == "true"
+		case "ERP_DASHBOARD_PANELS":
+			config.DashboardPanels = nil
+			for _, id := range strings.Split(value, ",") {
+				if id = strings.TrimSpace(id); id != "" {
+					config.DashboardPanels = append(config.DashboardPanels, id)
+				}
+			}
 		case "ERP_BRAND":
 			if value != "" {
 				config.Brand = value
 			}
+		case "ERP_DEFAULT_UOM":
+			if value != "" {
+				config.DefaultUOM = value
+			}
+		case "ERP_DEFAULT_ITEM_GROUP":
+			config.DefaultItemGroup = value
+		case "ERP_DEFAULT_IS_STOCK_ITEM":
+			config.DefaultIsStockItem = value != "0" && value != "false"
+		case "ERP_DEFAULT_WARRANTY_DAYS":
+			if days, err := strconv.Atoi(value); err == nil {
+				config.DefaultWarrantyDays = days
+			}
+		case "ERP_DEFAULT_WAREHOUSE":
+			config.DefaultWarehouse = value
+		case "ERP_DEFAULT_SELLING_PRICE_LIST":
+			config.DefaultSellingPriceList = value
+		case "ERP_DEFAULT_BUYING_PRICE_LIST":
+			config.DefaultBuyingPriceList = value
+		case "ERP_DEFAULT_CUSTOMER_GROUP":
+			config.DefaultCustomerGroup = value
+		case "ERP_DEFAULT_TERRITORY":
+			config.DefaultTerritory = value
+		case "ERP_DEFAULT_SUPPLIER_GROUP":
+			config.DefaultSupplierGroup = value
 		}
 	}
 
 	if config.ERPURL == "" || config.APIKey == "" || config.APISecret == "" {
-		return nil, fmt.Errorf("missing required config: ERP_URL, ERP_API_KEY, ERP_API_SECRET")
+		return nil, errorf("missing required config: ERP_URL, ERP_API_KEY, ERP_API_SECRET")
 	}
 
 	return config, nil
 }
 
+// SaveSetting writes key="value" to the config file, replacing the line of
+// that key or appending one. Comments and other lines are kept as they are.
+func (c *Config) SaveSetting(key, value string) error {
+	if c.path == "" {
+		return errorf("no config file to save to")
+	}
+	info, err := os.Stat(c.path)
+	if err != nil {
+		return errorf("cannot open config: %w", err)
+	}
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return errorf("cannot open config: %w", err)
+	}
+
+	line := fmt.Sprintf("%s=\"%s\"", key, value)
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	replaced := false
+	for i, l := range lines {
+		if k, _, ok := strings.Cut(strings.TrimSpace(l), "="); ok && strings.TrimSpace(k) == key {
+			lines[i] = line
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		lines = append(lines, line)
+	}
+
+	if err := os.WriteFile(c.path, []byte(strings.Join(lines, "\n")+"\n"), info.Mode().Perm()); err != nil {
+		return errorf("cannot write config: %w", err)
+	}
+	return nil
+}
+
+// trimOuterQuotes removes one matching pair of surrounding quotes
+func trimOuterQuotes(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
 // NewClient creates a new API client
 func NewClient(config *Config) *Client {
 	return &Client{
@@ -216,19 +429,47 @@ func (c *Client) DetectConnection() {
 
 // Request makes an API request
 func (c *Client) Request(method, endpoint string, body interface{}) (map[string]interface{}, error) {
+	if method == "POST" || method == "PUT" {
+		if err := c.applyFieldSets(method, endpoint, body); err != nil {
+			return nil, err
+		}
+		if err := c.adaptPayload(endpoint, body); err != nil {
+			return nil, err
+		}
+	}
+	if method == "POST" {
+		c.applyDocDefaults(endpoint, body)
+	}
+	result, err := c.do(method, fmt.Sprintf("%s/api/resource/%s", c.ActiveURL, endpoint), body)
+	if err == nil && method == "POST" {
+		c.recordCreated(endpoint, result)
+	}
+	return result, err
+}
+
+// CallMethod calls a whitelisted server method (/api/method/{method})
+func (c *Client) CallMethod(httpMethod, method string, body interface{}) (map[string]interface{}, error) {
+	return c.do(httpMethod, fmt.Sprintf("%s/api/method/%s", c.ActiveURL, method), body)
+}
+
+// do performs an authenticated request against a full URL
+func (c *Client) do(method, fullURL string, body interface{}) (map[string]interface{}, error) {
+	if c.Local {
+		return c.localRequest(method, fullURL)
+	}
+
 	var reqBody io.Reader
 	if body != nil {
 		jsonBody, err := json.Marshal(body)
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal body: %w", err)
+			return nil, errorf("failed to marshal body: %w", err)
 		}
 		reqBody = bytes.NewBuffer(jsonBody)
 	}
 
-	fullURL := fmt.Sprintf("%s/api/resource/%s", c.ActiveURL, endpoint)
 	req, err := http.NewRequest(method, fullURL, reqBody)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Authorization", fmt.Sprintf("token %s:%s", c.Config.APIKey, c.Config.APISecret))
@@ -241,21 +482,115 @@ func (c *Client) Request(method, endpoint string, body interface{}) (map[string]
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return nil, errorf("failed to read response: %w", err)
 	}
 
 	return parseAPIResponse(resp.StatusCode, respBody)
 }
 
+// download fetches a file from a path on the server (e.g. /api/method/...)
+// and returns its raw bytes. Error responses are decoded like API errors.
+func (c *Client) download(path string) ([]byte, error) {
+	req, err := http.NewRequest("GET", c.ActiveURL+path, nil)
+	if err != nil {
+		return nil, errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("token %s:%s", c.Config.APIKey, c.Config.APISecret))
+
+	if c.Mode == "internet" && c.Config.NginxCookie != "" {
+		req.AddCookie(&http.Cookie{Name: c.Config.NginxCookieName, Value: c.Config.NginxCookie})
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, errorf("download failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 || strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		if _, err := parseAPIResponse(resp.StatusCode, respBody); err != nil {
+			return nil, err
+		}
+		return nil, errorf("download failed: the server sent JSON instead of a file")
+	}
+	return respBody, nil
+}
+
+// uploadFile attaches a local file to a document via /api/method/upload_file
+// and returns the stored file's URL
+func (c *Client) uploadFile(path, doctype, docname string, private bool) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", errorf("cannot open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", filepath.Base(path))
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		return "", errorf("failed to read %s: %w", path, err)
+	}
+	writer.WriteField("doctype", doctype)
+	writer.WriteField("docname", docname)
+	if private {
+		writer.WriteField("is_private", "1")
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest("POST", c.ActiveURL+"/api/method/upload_file", &body)
+	if err != nil {
+		return "", errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("token %s:%s", c.Config.APIKey, c.Config.APISecret))
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Accept", "application/json")
+
+	if c.Mode == "internet" && c.Config.NginxCookie != "" {
+		req.AddCookie(&http.Cookie{Name: c.Config.NginxCookieName, Value: c.Config.NginxCookie})
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return "", errorf("upload failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errorf("failed to read response: %w", err)
+	}
+
+	result, err := parseAPIResponse(resp.StatusCode, respBody)
+	if err != nil {
+		return "", errorf("upload failed: %w", err)
+	}
+	if msg, ok := result["message"].(map[string]interface{}); ok {
+		fileURL, _ := msg["file_url"].(string)
+		return fileURL, nil
+	}
+	return "", nil
+}
+
 // CmdPing tests the connection
 func (c *Client) CmdPing() error {
-	fmt.Printf("%sTesting connection to ERP...%s\n", Blue, Reset)
+	printf("%sTesting connection to ERP...%s\n", Blue, Reset)
 
 	c.DetectConnection()
 
@@ -269,7 +604,7 @@ func (c *Client) CmdPing() error {
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("connection failed: %w", err)
+		return errorf("connection failed: %w", err)
 	}
 	defer resp.Body.Close()
 
@@ -278,17 +613,18 @@ func (c *Client) CmdPing() error {
 	json.Unmarshal(body, &result)
 
 	if msg, ok := result["message"].(string); ok && msg != "" {
-		fmt.Printf("%s✓ Connection successful%s\n", Green, Reset)
-		fmt.Printf("  Authenticated as: %s%s%s\n", Yellow, msg, Reset)
+		printf("%s✓ Connection successful%s\n", Green, Reset)
+		printf("  Authenticated as: %s%s%s\n", Yellow, msg, Reset)
+		printf("  Server: %s\n", c.serverVersionLine())
 		if c.Mode == "vpn" {
-			fmt.Printf("  Mode: %sVPN direct%s (%s)\n", Cyan, Reset, c.ActiveURL)
+			printf("  Mode: %sVPN direct%s (%s)\n", Cyan, Reset, c.ActiveURL)
 		} else {
-			fmt.Printf("  Mode: %sInternet%s (%s)\n", Yellow, Reset, c.ActiveURL)
+			printf("  Mode: %sInternet%s (%s)\n", Yellow, Reset, c.ActiveURL)
 		}
 		return nil
 	}
 
-	return fmt.Errorf("authentication failed: %s", string(body))
+	return errorf("authentication failed: %s", string(body))
 }
 
 // Common currency symbols map
@@ -328,6 +664,10 @@ func (c *Client) GetCurrency() (*CurrencyInfo, error) {
 	if c.Currency != nil {
 		return c.Currency, nil
 	}
+	if code, ok := c.cachedLookup("currency"); ok {
+		c.Currency = currencyInfo(code)
+		return c.Currency, nil
+	}
 
 	// Get company name first
 	company, err := c.GetCompany()
@@ -348,21 +688,26 @@ func (c *Client) GetCurrency() (*CurrencyInfo, error) {
 	if data, ok := result["data"].(map[string]interface{}); ok {
 		if currency, ok := data["default_currency"].(string); ok && currency != "" {
 			currencyCode = currency
+			c.storeLookup("currency", currencyCode)
 		}
 	}
 
+	c.Currency = currencyInfo(currencyCode)
+	return c.Currency, nil
+}
+
+// currencyInfo builds the CurrencyInfo for a currency code
+func currencyInfo(code string) *CurrencyInfo {
 	// Get symbol from map or use code as fallback
-	symbol := currencyCode
-	if s, ok := currencySymbols[currencyCode]; ok {
+	symbol := code
+	if s, ok := currencySymbols[code]; ok {
 		symbol = s
 	}
 
-	c.Currency = &CurrencyInfo{
-		Code:   currencyCode,
+	return &CurrencyInfo{
+		Code:   code,
 		Symbol: symbol,
 	}
-
-	return c.Currency, nil
 }
 
 // FormatCurrency formats an amount with the currency symbol
@@ -375,39 +720,82 @@ func (c *Client) FormatCurrency(amount float64) string {
 }
 
 // CmdConfig shows current configuration
-func (c *Client) CmdConfig() error {
-	fmt.Printf("%sCurrent configuration:%s\n", Blue, Reset)
+func (c *Client) CmdConfig(args []string) error {
+	if len(args) > 0 {
+		switch args[0] {
+		case "set":
+			return c.configSet(args[1:])
+		default:
+			return errorf("unknown config subcommand: %s", args[0])
+		}
+	}
+
+	printf("%sCurrent configuration:%s\n", Blue, Reset)
 	if c.Config.ERPVPN != "" {
-		fmt.Printf("  VPN URL: %s\n", c.Config.ERPVPN)
+		printf("  VPN URL: %s\n", c.Config.ERPVPN)
 	} else {
-		fmt.Printf("  VPN URL: %snot configured%s\n", Yellow, Reset)
+		printf("  VPN URL: %snot configured%s\n", Yellow, Reset)
 	}
-	fmt.Printf("  Internet URL: %s\n", c.Config.ERPURL)
+	printf("  Internet URL: %s\n", c.Config.ERPURL)
 	apiKey := c.Config.APIKey
 	if len(apiKey) > 8 {
 		apiKey = apiKey[:8] + "..."
 	}
-	fmt.Printf("  API Key: %s\n", apiKey)
-	fmt.Printf("  API Secret: ****\n")
+	printf("  API Key: %s\n", apiKey)
+	printf("  API Secret: ****\n")
 
 	if c.Config.NginxCookie != "" {
-		fmt.Printf("  Nginx Cookie: configured\n")
+		printf("  Nginx Cookie: configured\n")
 	} else {
-		fmt.Printf("  Nginx Cookie: %snot configured%s (needed for internet mode)\n", Yellow, Reset)
+		printf("  Nginx Cookie: %snot configured%s (needed for internet mode)\n", Yellow, Reset)
 	}
 
 	if c.Config.Company != "" {
-		fmt.Printf("  Company: %s\n", c.Config.Company)
+		printf("  Company: %s\n", c.Config.Company)
+	}
+
+	printf("  Item defaults: UOM=%s", c.Config.DefaultUOM)
+	if c.Config.DefaultItemGroup != "" {
+		printf(", Group=%s", c.Config.DefaultItemGroup)
+	}
+	printf(", Stock item=%v", c.Config.DefaultIsStockItem)
+	if c.Config.DefaultWarrantyDays > 0 {
+		printf(", Warranty=%d days", c.Config.DefaultWarrantyDays)
+	}
+	fmt.Println()
+
+	var defaults []string
+	for _, d := range configDefaults {
+		if value := *d.field(c.Config); value != "" {
+			defaults = append(defaults, fmt.Sprintf("%s=%s", d.name, value))
+		}
+	}
+	if len(defaults) > 0 {
+		printf("  Document defaults: %s\n", strings.Join(defaults, ", "))
+	}
+
+	panels, unknown := c.dashboardPanels()
+	ids := make([]string, len(panels))
+	for i, p := range panels {
+		ids[i] = p.id
+	}
+	printf("  Dashboard panels: %s\n", strings.Join(ids, ", "))
+	for _, id := range unknown {
+		printf("  %sUnknown dashboard panel: %s%s\n", Yellow, id, Reset)
 	}
 
 	fmt.Println()
 	c.DetectConnection()
 	if c.Mode == "vpn" {
-		fmt.Printf("  Active mode: %sVPN direct%s\n", Cyan, Reset)
+		printf("  Active mode: %sVPN direct%s\n", Cyan, Reset)
 	} else {
-		fmt.Printf("  Active mode: %sInternet%s\n", Yellow, Reset)
+		printf("  Active mode: %sInternet%s\n", Yellow, Reset)
+	}
+	printf("  Active URL: %s\n", c.ActiveURL)
+	printf("  Time zone: %s (now %s)\n", c.GetLocation(), c.Timestamp())
+	if c.LowBandwidth() {
+		printf("  Low-bandwidth mode: %son%s (lists capped at %d, lookups cached for %dh)\n", Yellow, Reset, lowBandwidthPageSize, int(lowBandwidthCacheTTL.Hours()))
 	}
-	fmt.Printf("  Active URL: %s\n", c.ActiveURL)
 
 	return nil
 }
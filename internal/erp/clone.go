@@ -0,0 +1,182 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// cloneDateFields are moved to today when cloning; the copy is a new
+// transaction, not a backdated one
+var cloneDateFields = []string{"transaction_date", "posting_date", "bill_date"}
+
+// cloneDueFields are moved to today when they would be in the past
+var cloneDueFields = []string{"delivery_date", "schedule_date", "valid_till", "due_date", "expected_delivery_date"}
+
+// cloneDropTables are recomputed by ERPNext for the new document
+var cloneDropTables = []string{"payment_schedule"}
+
+// CmdClone copies a document into a new draft
+func (c *Client) CmdClone(args []string) error {
+	if len(args) < 2 {
+		fmt.Println(T("Usage: erp-cli clone <doctype> <name> [--set field=value ...]"))
+		fmt.Println()
+		fmt.Println(T("Copies a document into a new draft, as Duplicate does in the desk:"))
+		fmt.Println(T("naming, status and no-copy fields are left out and dates start today."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli clone po PUR-ORD-2025-00001"))
+		fmt.Println(T("  erp-cli clone so SAL-ORD-2025-00001 --set customer=\"Beta Ltd\" --set delivery_date=2025-07-01"))
+		fmt.Println(T("  erp-cli clone quotation QTN-00001 --set party_name=\"New Customer\""))
+		return nil
+	}
+
+	doctype := resolveDoctype(args[0])
+	name := args[1]
+	sets, err := parseCloneSets(args[2:])
+	if err != nil {
+		return err
+	}
+
+	printf("%sCloning %s %s...%s\n", Blue, doctype, name, Reset)
+
+	result, err := c.Request("GET", url.PathEscape(doctype)+"/"+url.PathEscape(name), nil)
+	if err != nil {
+		return err
+	}
+	doc, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return errorf("%s not found: %s", doctype, name)
+	}
+
+	noCopy, err := c.noCopyFields(doctype)
+	if err != nil {
+		printf("%s⚠ Cannot read the %s meta (%s); copying all fields%s\n", Yellow, doctype, err, Reset)
+	}
+	c.prepareClone(doctype, doc, noCopy)
+
+	for field, value := range sets {
+		// Keep numbers numeric so the server does not have to cast them
+		if _, numeric := doc[field].(float64); numeric {
+			if n, err := strconv.ParseFloat(value, 64); err == nil {
+				doc[field] = n
+				continue
+			}
+		}
+		doc[field] = value
+	}
+
+	result, err = c.Request("POST", url.PathEscape(doctype), doc)
+	if err != nil {
+		return err
+	}
+	created, _ := result["data"].(map[string]interface{})
+	printf("%s✓ Cloned %s -> %s (Draft)%s\n", Green, name, stringField(created, "name"), Reset)
+	return nil
+}
+
+// parseCloneSets reads --set field=value (repeatable, also --set=field=value)
+func parseCloneSets(args []string) (map[string]string, error) {
+	sets := map[string]string{}
+	for i := 0; i < len(args); i++ {
+		set := ""
+		switch {
+		case args[i] == "--set":
+			if i+1 >= len(args) {
+				return nil, errorf("--set needs field=value")
+			}
+			set = args[i+1]
+			i++
+		case len(args[i]) > 6 && args[i][:6] == "--set=":
+			set = args[i][6:]
+		default:
+			return nil, errorf("unknown argument: %s", args[i])
+		}
+
+		field, value, ok := strings.Cut(set, "=")
+		if !ok || field == "" {
+			return nil, errorf("invalid --set %q (expected field=value)", set)
+		}
+		sets[field] = value
+	}
+	return sets, nil
+}
+
+// noCopyFields returns, per doctype (the document's and its child tables'),
+// the fields marked "No Copy" in the meta
+func (c *Client) noCopyFields(doctype string) (map[string]map[string]bool, error) {
+	result, err := c.CallMethod("GET", "frappe.desk.form.load.getdoctype?doctype="+url.QueryEscape(doctype), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	noCopy := map[string]map[string]bool{}
+	docs, _ := result["docs"].([]interface{})
+	for _, d := range docs {
+		meta, ok := d.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		fields := map[string]bool{}
+		list, _ := meta["fields"].([]interface{})
+		for _, f := range list {
+			if field, ok := f.(map[string]interface{}); ok {
+				if flag, _ := field["no_copy"].(float64); flag == 1 {
+					fields[stringField(field, "fieldname")] = true
+				}
+			}
+		}
+		noCopy[stringField(meta, "name")] = fields
+	}
+	if len(noCopy) == 0 {
+		return nil, errorf("no meta returned")
+	}
+	return noCopy, nil
+}
+
+// prepareClone turns a fetched document into the body of its copy
+func (c *Client) prepareClone(doctype string, doc map[string]interface{}, noCopy map[string]map[string]bool) {
+	cleanDumpDoc(doc)
+	for _, field := range []string{"name", "amended_from", "status"} {
+		delete(doc, field)
+	}
+	for _, table := range cloneDropTables {
+		delete(doc, table)
+	}
+
+	today := c.Today()
+	dropNoCopy(doc, noCopy[doctype], today)
+	for _, value := range doc {
+		rows, ok := value.([]interface{})
+		if !ok {
+			continue
+		}
+		for _, row := range rows {
+			if child, ok := row.(map[string]interface{}); ok {
+				childType := stringField(child, "doctype")
+				delete(child, "parenttype")
+				delete(child, "parentfield")
+				dropNoCopy(child, noCopy[childType], today)
+			}
+		}
+	}
+}
+
+// dropNoCopy removes no-copy fields from a document or child row and moves
+// its dates to today
+func dropNoCopy(doc map[string]interface{}, noCopy map[string]bool, today string) {
+	for field := range noCopy {
+		delete(doc, field)
+	}
+	for _, field := range cloneDateFields {
+		if _, ok := doc[field]; ok {
+			doc[field] = today
+		}
+	}
+	for _, field := range cloneDueFields {
+		if date, ok := doc[field].(string); ok && date != "" && date < today {
+			doc[field] = today
+		}
+	}
+}
@@ -0,0 +1,297 @@
+package erp
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Command history: every CLI command run is recorded with the documents it
+// created, so 'history' can show their names and 'redo' can run a command
+// again. Documents created from the TUI are recorded too, without a
+// command, and its main menu lists the latest ones.
+
+const (
+	commandHistoryFile = "command-history.json"
+	maxCommandHistory  = 500
+	recentCreatedCount = 5
+)
+
+// historyMu serializes history updates from the TUI's concurrent commands
+var historyMu sync.Mutex
+
+// historyEntry is one recorded command, or one TUI creation (no Args)
+type historyEntry struct {
+	ID      int          `json:"id"`
+	Args    []string     `json:"args,omitempty"`
+	Site    string       `json:"site"`
+	Time    time.Time    `json:"time"`
+	Failed  bool         `json:"failed,omitempty"`
+	Created []createdDoc `json:"created,omitempty"`
+}
+
+// createdDoc is a document a command created
+type createdDoc struct {
+	Doctype string `json:"doctype"`
+	Name    string `json:"name"`
+}
+
+func loadCommandHistory() ([]historyEntry, error) {
+	var entries []historyEntry
+	if err := loadLocalJSON(commandHistoryFile, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// appendHistory adds an entry, numbering it and dropping the oldest beyond
+// maxCommandHistory
+func appendHistory(entry historyEntry) error {
+	historyMu.Lock()
+	defer historyMu.Unlock()
+
+	entries, err := loadCommandHistory()
+	if err != nil {
+		return err
+	}
+	entry.ID = 1
+	if len(entries) > 0 {
+		entry.ID = entries[len(entries)-1].ID + 1
+	}
+	entries = append(entries, entry)
+	if len(entries) > maxCommandHistory {
+		entries = entries[len(entries)-maxCommandHistory:]
+	}
+	return saveLocalJSON(commandHistoryFile, entries)
+}
+
+// RecordCommand adds a finished CLI command and the documents it created
+// to the history
+func (c *Client) RecordCommand(args []string, cmdErr error) {
+	appendHistory(historyEntry{
+		Args:    args,
+		Site:    c.Config.ERPURL,
+		Time:    time.Now(),
+		Failed:  cmdErr != nil,
+		Created: c.created,
+	})
+}
+
+// noteCreated keeps a document created by this run for the history; in
+// the TUI, which has no command to record, it is recorded at once
+func (c *Client) noteCreated(doctype, name string) {
+	doc := createdDoc{Doctype: doctype, Name: name}
+	if c.tuiHistory {
+		appendHistory(historyEntry{Site: c.Config.ERPURL, Time: time.Now(), Created: []createdDoc{doc}})
+		return
+	}
+	c.created = append(c.created, doc)
+}
+
+// recentCreated returns the latest documents created on the site, newest
+// first
+func (c *Client) recentCreated(n int) []createdDoc {
+	entries, err := loadCommandHistory()
+	if err != nil {
+		return nil
+	}
+	var docs []createdDoc
+	for i := len(entries) - 1; i >= 0 && len(docs) < n; i-- {
+		if entries[i].Site != c.Config.ERPURL {
+			continue
+		}
+		for j := len(entries[i].Created) - 1; j >= 0 && len(docs) < n; j-- {
+			docs = append(docs, entries[i].Created[j])
+		}
+	}
+	return docs
+}
+
+// commandLine renders recorded arguments as they would be typed
+func commandLine(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = quoteArg(arg)
+	}
+	return "erp-cli " + strings.Join(quoted, " ")
+}
+
+// commandHistory lists the recorded commands of the site, oldest first,
+// or with --last prints the name of the last document created
+func (c *Client) commandHistory(args []string) error {
+	limit, last := defaultHistoryLimit, false
+	for _, arg := range args {
+		switch {
+		case arg == "--last":
+			last = true
+		case len(arg) > 8 && arg[:8] == "--limit=":
+			n, err := strconv.Atoi(arg[8:])
+			if err != nil || n <= 0 {
+				return errorf("invalid limit: %s", arg[8:])
+			}
+			limit = n
+		default:
+			return errorf("unknown argument: %s", arg)
+		}
+	}
+
+	if last {
+		docs := c.recentCreated(1)
+		if len(docs) == 0 {
+			return errorf("no documents created yet")
+		}
+		fmt.Println(docs[0].Name)
+		return nil
+	}
+
+	entries, err := loadCommandHistory()
+	if err != nil {
+		return err
+	}
+	var site []historyEntry
+	for _, entry := range entries {
+		if entry.Site == c.Config.ERPURL {
+			site = append(site, entry)
+		}
+	}
+	if len(site) == 0 {
+		printf("%sNo commands recorded yet%s\n", Yellow, Reset)
+		return nil
+	}
+	if len(site) > limit {
+		site = site[len(site)-limit:]
+	}
+
+	fmt.Printf("%-5s %-17s %s\n", "#", T("WHEN"), T("COMMAND"))
+	fmt.Println(strings.Repeat("-", 80))
+	for _, entry := range site {
+		command := commandLine(entry.Args)
+		if len(entry.Args) == 0 {
+			command = "(TUI)"
+		}
+		status := ""
+		if entry.Failed {
+			status = tf(" %s✗ failed%s", Red, Reset)
+		}
+		fmt.Printf("%-5d %-17s %s%s\n", entry.ID, entry.Time.Format("2006-01-02 15:04"), command, status)
+		for _, doc := range entry.Created {
+			fmt.Printf("      %s→ %s %s%s\n", Green, doc.Doctype, doc.Name, Reset)
+		}
+	}
+	fmt.Println()
+	printf("%sRun one again with 'erp-cli redo <#>'%s\n", Cyan, Reset)
+	return nil
+}
+
+// globalFlags are the options main takes from anywhere on the command line
+var globalFlags = map[string]bool{"--no-color": true, "--ascii": true, "--offline": true, "--local": true, "--no-defaults": true}
+
+// redoWriteCommands are the commands that write besides those
+// writesDocuments tells
+var redoWriteCommands = map[string]bool{"bulk": true, "apply": true, "clone": true, "run": true, "sync": true}
+
+// redoWrites tells whether a recorded command changes data, once its
+// global flags are left out and its alias expanded
+func (c *Client) redoWrites(recorded []string) bool {
+	var args []string
+	for _, arg := range recorded {
+		if !globalFlags[arg] && !strings.HasPrefix(arg, "--lang=") {
+			args = append(args, arg)
+		}
+	}
+	if expanded, err := c.Config.ExpandAlias(args); err == nil {
+		args = expanded
+	}
+	return len(args) > 0 && (redoWriteCommands[args[0]] || writesDocuments(args))
+}
+
+// CmdRedo runs a recorded command again, by default the last one. Commands
+// that change data are confirmed first unless --yes.
+func (c *Client) CmdRedo(args []string) error {
+	entries, err := loadCommandHistory()
+	if err != nil {
+		return err
+	}
+
+	yes := false
+	var rest []string
+	for _, arg := range args {
+		if arg == "--yes" || arg == "-y" {
+			yes = true
+		} else {
+			rest = append(rest, arg)
+		}
+	}
+	args = rest
+
+	var entry *historyEntry
+	if len(args) > 0 {
+		id, err := strconv.Atoi(strings.TrimPrefix(args[0], "#"))
+		if err != nil {
+			return errorf("usage: erp-cli redo [<#>] [--yes] (see 'erp-cli history')")
+		}
+		for i := range entries {
+			if entries[i].ID == id {
+				entry = &entries[i]
+			}
+		}
+		if entry == nil {
+			return errorf("no command #%d in the history", id)
+		}
+		if entry.Site != c.Config.ERPURL {
+			return errorf("command #%d ran against %s, not this site", id, entry.Site)
+		}
+	} else {
+		for i := len(entries) - 1; i >= 0 && entry == nil; i-- {
+			if entries[i].Site == c.Config.ERPURL && len(entries[i].Args) > 0 {
+				entry = &entries[i]
+			}
+		}
+	}
+	if entry == nil || len(entry.Args) == 0 {
+		return errorf("no command to run again")
+	}
+
+	executable, err := os.Executable()
+	if err != nil {
+		return errorf("cannot find erp-cli executable: %w", err)
+	}
+	fmt.Printf("%s$ %s%s\n", Cyan, commandLine(entry.Args), Reset)
+	if !yes && c.redoWrites(entry.Args) && !confirm("This command changes data. Run it again?") {
+		printf("%sAborted%s\n", Yellow, Reset)
+		return nil
+	}
+	cmd := exec.Command(executable, entry.Args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return errorf("command #%d failed again", entry.ID)
+	}
+	return nil
+}
+
+// recentHeight is the number of lines the main menu leaves to renderRecent
+func (m Model) recentHeight() int {
+	if len(m.recent) == 0 {
+		return 0
+	}
+	return len(m.recent) + 2
+}
+
+// renderRecent lists the documents created lately under the main menu
+func (m Model) renderRecent() string {
+	if len(m.recent) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("\n\n" + helpStyle.Bold(true).Render(T("Recently created")))
+	for _, doc := range m.recent {
+		b.WriteString("\n" + helpStyle.Render(fmt.Sprintf("  %s %s", doc.Doctype, doc.Name)))
+	}
+	return b.String()
+}
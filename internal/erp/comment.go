@@ -0,0 +1,255 @@
+package erp
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	htmlTagRe   = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</div>|<[^>]+>`)
+	htmlSpaceRe = regexp.MustCompile(`[ \t]+`)
+	blankLineRe = regexp.MustCompile(`\n{2,}`)
+)
+
+// docComment is a comment left on a document
+type docComment struct {
+	by      string
+	content string // Plain text
+	created string
+}
+
+// docAssignment is an open or closed ToDo assigning a document to a user
+type docAssignment struct {
+	user        string
+	status      string
+	description string
+	date        string // Due date, may be empty
+}
+
+// CmdComment adds a comment to a document
+func (c *Client) CmdComment(args []string) error {
+	if len(args) < 3 {
+		fmt.Println(T("Usage: erp-cli comment <doctype> <name> <text>"))
+		fmt.Println()
+		fmt.Println(T("Comments show up in the document's timeline and in the get output."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli comment so SAL-ORD-2025-00001 \"Approved, ship Monday\""))
+		fmt.Println(T("  erp-cli comment \"Purchase Order\" PUR-ORD-2025-00001 \"Price checked against quote\""))
+		return nil
+	}
+
+	doctype := resolveDoctype(args[0])
+	name := args[1]
+	text := strings.TrimSpace(strings.Join(args[2:], " "))
+	if text == "" {
+		return errorf("comment text is empty")
+	}
+
+	if err := c.addComment(doctype, name, text); err != nil {
+		return err
+	}
+
+	printf("%s✓ Comment added to %s %s%s\n", Green, doctype, name, Reset)
+	return nil
+}
+
+// addComment leaves a plain text comment on a document's timeline
+func (c *Client) addComment(doctype, name, text string) error {
+	user, err := c.loggedUser()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.CallMethod("POST", "frappe.desk.form.utils.add_comment", map[string]interface{}{
+		"reference_doctype": doctype,
+		"reference_name":    name,
+		"content":           html.EscapeString(text),
+		"comment_email":     user,
+		"comment_by":        user,
+	})
+	return err
+}
+
+// CmdAssign assigns a document to a user
+func (c *Client) CmdAssign(args []string) error {
+	if len(args) < 3 {
+		fmt.Println(T("Usage: erp-cli assign <doctype> <name> <user> [--note=X] [--date=YYYY-MM-DD]"))
+		fmt.Println()
+		fmt.Println(T("Creates a ToDo for the user, as the Assign To sidebar does."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli assign pi ACC-PINV-2025-00001 jane@example.com --note=\"Please approve\""))
+		fmt.Println(T("  erp-cli assign so SAL-ORD-2025-00001 ops@example.com --date=2025-06-30"))
+		return nil
+	}
+
+	doctype := resolveDoctype(args[0])
+	name := args[1]
+	user := args[2]
+	body := map[string]interface{}{
+		"doctype":   doctype,
+		"name":      name,
+		"assign_to": []string{user},
+	}
+	for _, arg := range args[3:] {
+		if len(arg) > 7 && arg[:7] == "--note=" {
+			body["description"] = arg[7:]
+		}
+		if len(arg) > 7 && arg[:7] == "--date=" {
+			if _, err := time.Parse("2006-01-02", arg[7:]); err != nil {
+				return errorf("invalid date %q (use YYYY-MM-DD)", arg[7:])
+			}
+			body["date"] = arg[7:]
+		}
+	}
+
+	if _, err := c.CallMethod("POST", "frappe.desk.form.assign_to.add", body); err != nil {
+		return err
+	}
+
+	printf("%s✓ %s %s assigned to %s%s\n", Green, doctype, name, user, Reset)
+	return nil
+}
+
+// loggedUser returns the user the API key belongs to
+func (c *Client) loggedUser() (string, error) {
+	result, err := c.CallMethod("GET", "frappe.auth.get_logged_user", nil)
+	if err != nil {
+		return "", err
+	}
+	user, _ := result["message"].(string)
+	if user == "" {
+		return "", errorf("cannot determine the logged-in user")
+	}
+	return user, nil
+}
+
+// htmlToText turns the HTML of a timeline comment into plain text
+func htmlToText(s string) string {
+	s = htmlTagRe.ReplaceAllStringFunc(s, func(tag string) string {
+		if strings.HasPrefix(tag, "</") || strings.HasPrefix(strings.ToLower(tag), "<br") {
+			return "\n"
+		}
+		return ""
+	})
+	s = html.UnescapeString(s)
+	s = htmlSpaceRe.ReplaceAllString(s, " ")
+	s = blankLineRe.ReplaceAllString(s, "\n")
+	return strings.TrimSpace(s)
+}
+
+// fetchComments returns the comments on a document, oldest first
+func (c *Client) fetchComments(doctype, name string) ([]docComment, error) {
+	filters, err := encodeFilters([][]interface{}{
+		{"reference_doctype", "=", doctype},
+		{"reference_name", "=", name},
+		{"comment_type", "=", "Comment"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.Request("GET", "Comment?limit_page_length=0&fields=[\"comment_by\",\"owner\",\"content\",\"creation\"]&order_by=creation%20asc&filters="+filters, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var comments []docComment
+	data, _ := result["data"].([]interface{})
+	for _, item := range data {
+		if m, ok := item.(map[string]interface{}); ok {
+			by := stringField(m, "comment_by")
+			if by == "" {
+				by = stringField(m, "owner")
+			}
+			comments = append(comments, docComment{
+				by:      by,
+				content: htmlToText(stringField(m, "content")),
+				created: stringField(m, "creation"),
+			})
+		}
+	}
+	return comments, nil
+}
+
+// fetchAssignments returns the ToDos assigning a document, cancelled ones
+// (removed assignments) left out
+func (c *Client) fetchAssignments(doctype, name string) ([]docAssignment, error) {
+	filters, err := encodeFilters([][]interface{}{
+		{"reference_type", "=", doctype},
+		{"reference_name", "=", name},
+		{"status", "!=", "Cancelled"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.Request("GET", "ToDo?limit_page_length=0&fields=[\"allocated_to\",\"status\",\"description\",\"date\"]&order_by=creation%20asc&filters="+filters, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var assignments []docAssignment
+	data, _ := result["data"].([]interface{})
+	for _, item := range data {
+		if m, ok := item.(map[string]interface{}); ok {
+			assignments = append(assignments, docAssignment{
+				user:        stringField(m, "allocated_to"),
+				status:      stringField(m, "status"),
+				description: htmlToText(stringField(m, "description")),
+				date:        stringField(m, "date"),
+			})
+		}
+	}
+	return assignments, nil
+}
+
+// assignmentLine describes an assignment in one line
+func assignmentLine(a docAssignment) string {
+	line := fmt.Sprintf("%s (%s", a.user, a.status)
+	if a.date != "" {
+		line += ", due " + a.date
+	}
+	line += ")"
+	if a.description != "" {
+		line += ": " + firstLine(a.description)
+	}
+	return line
+}
+
+// firstLine returns the first line of a multi-line text
+func firstLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return s[:i] + " ..."
+	}
+	return s
+}
+
+// printActivity prints the assignments and comments of a document below
+// its get output. Failures are not fatal: the document itself was shown.
+func (c *Client) printActivity(doctype, name string) {
+	if assignments, err := c.fetchAssignments(doctype, name); err == nil && len(assignments) > 0 {
+		printf("\n  %sAssigned to:%s\n", Yellow, Reset)
+		for _, a := range assignments {
+			fmt.Printf("    - %s\n", assignmentLine(a))
+		}
+	}
+
+	if comments, err := c.fetchComments(doctype, name); err == nil && len(comments) > 0 {
+		printf("\n  %sComments:%s\n", Yellow, Reset)
+		for _, cm := range comments {
+			created := cm.created
+			if len(created) > 16 {
+				created = created[:16]
+			}
+			fmt.Printf("    %s %s:\n", created, cm.by)
+			for _, line := range strings.Split(cm.content, "\n") {
+				fmt.Printf("      %s\n", line)
+			}
+		}
+	}
+}
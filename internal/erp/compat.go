@@ -0,0 +1,212 @@
+package erp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Compatibility layer: payloads are written for current ERPNext and adapted
+// here for the version the site runs, detected once per run from the
+// installed apps. Unknown versions (the lookup failed) are sent as written.
+
+// minERPNextVersion is the oldest major version the CLI is tested against
+const minERPNextVersion = 13
+
+// serialBatchDoctypes are the doctypes whose item rows take serial_no and
+// batch_no, which from v15 only apply with use_serial_batch_fields set (the
+// default being Serial and Batch Bundles)
+var serialBatchDoctypes = map[string]bool{
+	"Delivery Note":        true,
+	"Sales Invoice":        true,
+	"Purchase Receipt":     true,
+	"Purchase Invoice":     true,
+	"Stock Entry":          true,
+	"Stock Reconciliation": true,
+	"POS Invoice":          true,
+}
+
+// taxTemplateDoctypes are the doctypes whose taxes_and_charges names a
+// template of the given doctype
+var taxTemplateDoctypes = map[string]string{
+	"Quotation":          "Sales Taxes and Charges Template",
+	"Sales Order":        "Sales Taxes and Charges Template",
+	"Delivery Note":      "Sales Taxes and Charges Template",
+	"Sales Invoice":      "Sales Taxes and Charges Template",
+	"POS Invoice":        "Sales Taxes and Charges Template",
+	"Purchase Order":     "Purchase Taxes and Charges Template",
+	"Purchase Receipt":   "Purchase Taxes and Charges Template",
+	"Purchase Invoice":   "Purchase Taxes and Charges Template",
+	"Supplier Quotation": "Purchase Taxes and Charges Template",
+}
+
+// seriesVariables are the naming series variables ERPNext added, with the
+// version that added them
+var seriesVariables = map[string]int{
+	".FY.":   14,
+	".ABBR.": 14,
+}
+
+// appDoctypes are doctypes that moved out of ERPNext into another app, with
+// the ERPNext version that moved them
+var appDoctypes = map[string]struct {
+	app   string
+	since int
+}{
+	"Expense Claim":      {"hrms", 14},
+	"Expense Claim Type": {"hrms", 14},
+}
+
+// ServerVersions returns the versions of the apps installed on the site
+// ("frappe", "erpnext", ...), looked up once per run. Nil when unknown.
+func (c *Client) ServerVersions() map[string]string {
+	if c.versions != nil {
+		return c.versions
+	}
+	c.versions = map[string]string{}
+
+	raw, ok := c.cachedLookup("versions")
+	if !ok {
+		result, err := c.CallMethod("GET", "frappe.utils.change_log.get_versions", nil)
+		if err != nil {
+			return nil
+		}
+		apps, _ := result["message"].(map[string]interface{})
+		versions := map[string]string{}
+		for app, info := range apps {
+			if m, ok := info.(map[string]interface{}); ok {
+				versions[app] = stringField(m, "version")
+			}
+		}
+		encoded, _ := json.Marshal(versions)
+		raw = string(encoded)
+		c.storeLookup("versions", raw)
+	}
+	json.Unmarshal([]byte(raw), &c.versions)
+
+	if major := c.majorVersion("erpnext"); major > 0 && major < minERPNextVersion {
+		fmt.Fprint(os.Stderr, tf("%s⚠ ERPNext v%s is older than v%d, the oldest version this CLI supports: some commands may fail%s\n", Yellow, c.versions["erpnext"], minERPNextVersion, Reset))
+	}
+	return c.versions
+}
+
+// majorVersion returns the major version of an installed app, 0 when it is
+// not installed or the versions are unknown
+func (c *Client) majorVersion(app string) int {
+	version := c.ServerVersions()[app]
+	major, _ := strconv.Atoi(strings.SplitN(version, ".", 2)[0])
+	return major
+}
+
+// versionsKnown reports whether the site's versions could be looked up
+func (c *Client) versionsKnown() bool {
+	return len(c.ServerVersions()) > 0
+}
+
+// requireDoctype fails when a doctype is not available on the site: moved
+// to an app that is not installed
+func (c *Client) requireDoctype(doctype string) error {
+	moved, ok := appDoctypes[doctype]
+	if !ok || !c.versionsKnown() {
+		return nil
+	}
+	if c.majorVersion("erpnext") >= moved.since && c.majorVersion(moved.app) == 0 {
+		return errorf("%s is part of the %s app since ERPNext v%d, and it is not installed on this site (ERPNext v%s)",
+			doctype, moved.app, moved.since, c.versions["erpnext"])
+	}
+	return nil
+}
+
+// adaptPayload rewrites a document about to be created or saved for the
+// site's ERPNext version, failing when it uses what the version lacks
+func (c *Client) adaptPayload(endpoint string, body interface{}) error {
+	doc, ok := body.(map[string]interface{})
+	if !ok || !c.versionsKnown() {
+		return nil
+	}
+	doctype := endpointDoctype(endpoint)
+	major := c.majorVersion("erpnext")
+
+	if serialBatchDoctypes[doctype] && major >= 15 {
+		for _, row := range docRows(doc["items"]) {
+			if stringField(row, "serial_no") != "" || stringField(row, "batch_no") != "" {
+				row["use_serial_batch_fields"] = 1
+			}
+		}
+	}
+
+	// v13 leaves the taxes table empty when a document names its taxes
+	// template through the API, so the template's rows are sent with it
+	if template := stringField(doc, "taxes_and_charges"); template != "" && major < 14 && len(docRows(doc["taxes"])) == 0 {
+		if master, ok := taxTemplateDoctypes[doctype]; ok {
+			rows, err := c.templateTaxes(master, template)
+			if err != nil {
+				return errorf("cannot read taxes template %s: %w", template, err)
+			}
+			doc["taxes"] = rows
+		}
+	}
+
+	if series := stringField(doc, "naming_series"); series != "" {
+		for variable, since := range seriesVariables {
+			if strings.Contains(series, variable) && major < since {
+				return errorf("naming series %s: %s needs ERPNext v%d (the site runs v%s)", series, variable, since, c.versions["erpnext"])
+			}
+		}
+	}
+	return nil
+}
+
+// templateTaxes returns the rows of a taxes and charges template
+func (c *Client) templateTaxes(master, template string) ([]interface{}, error) {
+	result, err := c.CallMethod("GET", "erpnext.controllers.accounts_controller.get_taxes_and_charges?master_doctype="+url.QueryEscape(master)+"&master_name="+url.QueryEscape(template), nil)
+	if err != nil {
+		return nil, err
+	}
+	rows, _ := result["message"].([]interface{})
+	return rows, nil
+}
+
+// endpointDoctype returns the doctype of a resource endpoint
+func endpointDoctype(endpoint string) string {
+	doctype, _, _ := strings.Cut(endpoint, "?")
+	doctype, _, _ = strings.Cut(doctype, "/")
+	doctype, _ = url.PathUnescape(doctype)
+	return doctype
+}
+
+// docRows returns the rows of a child table in a payload, built either as
+// []map[string]interface{} or []interface{}
+func docRows(table interface{}) []map[string]interface{} {
+	switch rows := table.(type) {
+	case []map[string]interface{}:
+		return rows
+	case []interface{}:
+		var maps []map[string]interface{}
+		for _, row := range rows {
+			if m, ok := row.(map[string]interface{}); ok {
+				maps = append(maps, m)
+			}
+		}
+		return maps
+	}
+	return nil
+}
+
+// serverVersionLine describes the site's versions for ping and doctor
+func (c *Client) serverVersionLine() string {
+	versions := c.ServerVersions()
+	if len(versions) == 0 {
+		return "unknown"
+	}
+	var parts []string
+	for _, app := range []string{"erpnext", "frappe", "hrms"} {
+		if version := versions[app]; version != "" {
+			parts = append(parts, fmt.Sprintf("%s %s", app, version))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
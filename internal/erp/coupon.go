@@ -0,0 +1,302 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// docDiscount is the document-level discount of quotation, so and si
+// creation
+type docDiscount struct {
+	percent float64 // --discount=10%
+	amount  float64 // --discount-amount=50
+	coupon  string  // --coupon=CODE, the code customers type
+}
+
+func parseDiscountFlags(args []string) (docDiscount, error) {
+	d := docDiscount{}
+	for _, arg := range args {
+		switch {
+		case len(arg) > 11 && arg[:11] == "--discount=":
+			percent, err := strconv.ParseFloat(strings.TrimSuffix(arg[11:], "%"), 64)
+			if err != nil || percent <= 0 || percent > 100 {
+				return d, errorf("invalid --discount %q (use a percentage, e.g. 10%%)", arg[11:])
+			}
+			d.percent = percent
+		case len(arg) > 18 && arg[:18] == "--discount-amount=":
+			amount, err := strconv.ParseFloat(arg[18:], 64)
+			if err != nil || amount <= 0 {
+				return d, errorf("invalid --discount-amount: %s", arg[18:])
+			}
+			d.amount = amount
+		case len(arg) > 9 && arg[:9] == "--coupon=":
+			d.coupon = arg[9:]
+		}
+	}
+	if d.percent > 0 && d.amount > 0 {
+		return d, errorf("--discount and --discount-amount are mutually exclusive")
+	}
+	return d, nil
+}
+
+// applyDiscount sets the additional discount and coupon of a new document.
+// The coupon is checked first, since ERPNext only says it is invalid once
+// items are added.
+func (c *Client) applyDiscount(body map[string]interface{}, d docDiscount) error {
+	if d.coupon != "" {
+		name, err := c.validCoupon(d.coupon)
+		if err != nil {
+			return err
+		}
+		body["coupon_code"] = name
+	}
+	if d.percent > 0 {
+		body["apply_discount_on"] = "Grand Total"
+		body["additional_discount_percentage"] = d.percent
+	}
+	if d.amount > 0 {
+		body["apply_discount_on"] = "Grand Total"
+		body["discount_amount"] = d.amount
+	}
+	return nil
+}
+
+// printDiscount prints the discount of a new document
+func (c *Client) printDiscount(d docDiscount) {
+	if d.percent > 0 {
+		printf("  Discount: %g%% on the grand total\n", d.percent)
+	}
+	if d.amount > 0 {
+		printf("  Discount: %s on the grand total\n", c.FormatCurrency(d.amount))
+	}
+	if d.coupon != "" {
+		printf("  Coupon: %s\n", d.coupon)
+	}
+}
+
+// printDocDiscount prints the discount and coupon lines of a get command
+func (c *Client) printDocDiscount(data map[string]interface{}) {
+	for _, line := range c.docDiscountLines(data) {
+		fmt.Println(line)
+	}
+}
+
+// docDiscountLines are the discount and coupon of a document, shared by the
+// get commands and the TUI detail views
+func (c *Client) docDiscountLines(data map[string]interface{}) []string {
+	var lines []string
+	if amount, _ := data["discount_amount"].(float64); amount > 0 {
+		line := tf("  Discount: %s", c.formatDoc(data, amount))
+		if percent, _ := data["additional_discount_percentage"].(float64); percent > 0 {
+			line += fmt.Sprintf(" (%g%%)", percent)
+		}
+		lines = append(lines, line)
+	}
+	if coupon := stringField(data, "coupon_code"); coupon != "" {
+		lines = append(lines, "  Coupon: "+coupon)
+	}
+	return lines
+}
+
+// validCoupon looks a coupon up by its code and returns its name, failing
+// when it is expired, not yet valid or used up
+func (c *Client) validCoupon(code string) (string, error) {
+	filters, err := encodeFilters([][]interface{}{{"coupon_code", "=", code}})
+	if err != nil {
+		return "", err
+	}
+	result, err := c.Request("GET", "Coupon%20Code?fields=[\"name\",\"valid_from\",\"valid_upto\",\"maximum_use\",\"used\"]&filters="+filters, nil)
+	if err != nil {
+		return "", err
+	}
+	data, _ := result["data"].([]interface{})
+	if len(data) == 0 {
+		return "", errorf("coupon code not found: %s", code)
+	}
+	coupon, _ := data[0].(map[string]interface{})
+
+	today := c.Today()
+	if from := stringField(coupon, "valid_from"); from != "" && today < from {
+		return "", errorf("coupon %s is valid from %s", code, from)
+	}
+	if upto := stringField(coupon, "valid_upto"); upto != "" && today > upto {
+		return "", errorf("coupon %s expired on %s", code, upto)
+	}
+	maxUse, _ := coupon["maximum_use"].(float64)
+	used, _ := coupon["used"].(float64)
+	if maxUse > 0 && used >= maxUse {
+		return "", errorf("coupon %s has been used %g of %g times", code, used, maxUse)
+	}
+	return stringField(coupon, "name"), nil
+}
+
+// couponCreateOptions holds flags for coupon create
+type couponCreateOptions struct {
+	pricingRule string
+	customer    string // Makes it a gift card for that customer
+	maxUse      int
+	from        string
+	upto        string
+}
+
+// CmdCoupon handles Coupon Code commands
+func (c *Client) CmdCoupon(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli coupon <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, create"))
+		fmt.Println()
+		fmt.Println(T("A coupon applies a Pricing Rule marked \"Coupon Code Based\" to the documents"))
+		fmt.Println(T("created with --coupon=CODE (quotation, so and si create)."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli coupon list"))
+		fmt.Println(T("  erp-cli coupon create SUMMER10 --pricing-rule=PRLE-0004 --max-use=100 --to=2026-08-31"))
+		fmt.Println(T("  erp-cli coupon create GIFT-ACME --pricing-rule=PRLE-0005 --customer=\"Acme Corp\" --max-use=1"))
+		fmt.Println(T("  erp-cli so create \"Acme Corp\" --coupon=SUMMER10"))
+		return nil
+	}
+
+	switch args[0] {
+	case "list":
+		return c.couponList(len(args) > 1 && args[1] == "--all")
+	case "create":
+		if len(args) < 2 || strings.HasPrefix(args[1], "--") {
+			return errorf("usage: erp-cli coupon create <code> --pricing-rule=X [--customer=X] [--max-use=N] [--from=YYYY-MM-DD] [--to=YYYY-MM-DD]")
+		}
+		opts := couponCreateOptions{}
+		for _, arg := range args[2:] {
+			switch {
+			case len(arg) > 15 && arg[:15] == "--pricing-rule=":
+				opts.pricingRule = arg[15:]
+			case len(arg) > 11 && arg[:11] == "--customer=":
+				opts.customer = arg[11:]
+			case len(arg) > 10 && arg[:10] == "--max-use=":
+				n, err := strconv.Atoi(arg[10:])
+				if err != nil || n < 0 {
+					return errorf("invalid --max-use: %s", arg[10:])
+				}
+				opts.maxUse = n
+			case len(arg) > 7 && arg[:7] == "--from=":
+				opts.from = arg[7:]
+			case len(arg) > 5 && arg[:5] == "--to=":
+				opts.upto = arg[5:]
+			default:
+				return errorf("unknown argument: %s", arg)
+			}
+		}
+		if opts.pricingRule == "" {
+			return errorf("--pricing-rule is required")
+		}
+		for _, date := range []string{opts.from, opts.upto} {
+			if _, err := time.Parse("2006-01-02", date); date != "" && err != nil {
+				return errorf("invalid date (expected YYYY-MM-DD): %s", date)
+			}
+		}
+		return c.couponCreate(args[1], opts)
+	default:
+		return errorf("unknown coupon subcommand: %s", args[0])
+	}
+}
+
+func (c *Client) couponCreate(code string, opts couponCreateOptions) error {
+	printf("%sCreating coupon: %s%s\n", Blue, code, Reset)
+
+	result, err := c.Request("GET", "Pricing%20Rule/"+url.PathEscape(opts.pricingRule), nil)
+	if err != nil {
+		return err
+	}
+	rule, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return errorf("pricing rule not found: %s", opts.pricingRule)
+	}
+	if based, _ := rule["coupon_code_based"].(float64); based != 1 {
+		return errorf("pricing rule %s is not coupon code based (tick \"Coupon Code Based\" on it first)", opts.pricingRule)
+	}
+
+	body := map[string]interface{}{
+		"coupon_name":  code,
+		"coupon_code":  code,
+		"coupon_type":  "Promotional",
+		"pricing_rule": opts.pricingRule,
+		"maximum_use":  opts.maxUse,
+	}
+	if opts.customer != "" {
+		body["coupon_type"] = "Gift Card"
+		body["customer"] = opts.customer
+	}
+	if opts.from != "" {
+		body["valid_from"] = opts.from
+	}
+	if opts.upto != "" {
+		body["valid_upto"] = opts.upto
+	}
+
+	result, err = c.Request("POST", "Coupon%20Code", body)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].(map[string]interface{}); ok {
+		printf("%s✓ Coupon created: %s%s\n", Green, stringField(data, "coupon_code"), Reset)
+		printf("  Type: %s\n", stringField(data, "coupon_type"))
+		printf("  Rule: %s\n", c.describePricingRule(rule))
+		if opts.maxUse > 0 {
+			printf("  Uses: %d\n", opts.maxUse)
+		}
+	}
+	return nil
+}
+
+// couponList lists the coupons still usable today, or all with --all
+func (c *Client) couponList(all bool) error {
+	printf("%sFetching coupons...%s\n", Blue, Reset)
+
+	result, err := c.Request("GET", "Coupon%20Code?"+c.pageLimit(0)+"&fields=[\"name\",\"coupon_code\",\"coupon_type\",\"customer\",\"pricing_rule\",\"valid_from\",\"valid_upto\",\"maximum_use\",\"used\"]&order_by=creation%20desc", nil)
+	if err != nil {
+		return err
+	}
+
+	data, _ := result["data"].([]interface{})
+	today := c.Today()
+	var rows []map[string]interface{}
+	for _, row := range data {
+		m, ok := row.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		maxUse, _ := m["maximum_use"].(float64)
+		used, _ := m["used"].(float64)
+		upto := stringField(m, "valid_upto")
+		if !all && ((upto != "" && today > upto) || (maxUse > 0 && used >= maxUse)) {
+			continue
+		}
+		rows = append(rows, m)
+	}
+	if len(rows) == 0 {
+		printf("%sNo coupons found%s\n", Yellow, Reset)
+		return nil
+	}
+
+	printf("\n%sCoupons (%d):%s\n", Cyan, len(rows), Reset)
+	c.printPageNote(len(data))
+	for _, m := range rows {
+		maxUse, _ := m["maximum_use"].(float64)
+		used, _ := m["used"].(float64)
+		uses := tf("%g used", used)
+		if maxUse > 0 {
+			uses = fmt.Sprintf("%g/%g used", used, maxUse)
+		}
+		line := tf("  %s%s%s - %s | Rule: %s | %s", Green, stringField(m, "coupon_code"), Reset, stringField(m, "coupon_type"), stringField(m, "pricing_rule"), uses)
+		if customer := stringField(m, "customer"); customer != "" {
+			line += " | Customer: " + customer
+		}
+		if upto := stringField(m, "valid_upto"); upto != "" {
+			line += " | Until: " + upto
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// creditStatus summarizes what a customer owes against its credit limit,
+// following ERPNext's own credit check: outstanding invoices plus submitted
+// orders not yet billed, minus payments received but not allocated
+type creditStatus struct {
+	customer    string
+	company     string
+	invoices    []map[string]interface{} // Unpaid submitted Sales Invoices
+	payments    []map[string]interface{} // Payment Entries with unallocated amount
+	outstanding float64
+	unbilled    float64
+	unallocated float64
+	creditLimit float64 // 0 means no limit
+	bypass      bool    // Credit check only on SI, not on SO
+	frozen      bool
+}
+
+// Exposure returns the amount counted against the credit limit
+func (s *creditStatus) Exposure() float64 {
+	return s.outstanding + s.unbilled - s.unallocated
+}
+
+// Available returns the remaining credit, or 0 when there is no limit
+func (s *creditStatus) Available() float64 {
+	if s.creditLimit == 0 {
+		return 0
+	}
+	return s.creditLimit - s.Exposure()
+}
+
+// fetchCreditStatus collects the balance and credit limit of a customer
+func (c *Client) fetchCreditStatus(customer string) (*creditStatus, error) {
+	company, err := c.GetCompany()
+	if err != nil {
+		return nil, err
+	}
+	status := &creditStatus{customer: customer, company: company}
+
+	result, err := c.Request("GET", "Customer/"+url.PathEscape(customer), nil)
+	if err != nil {
+		return nil, err
+	}
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return nil, errorf("customer not found: %s", customer)
+	}
+	status.frozen = data["is_frozen"] == float64(1)
+	if limits, ok := data["credit_limits"].([]interface{}); ok {
+		for _, l := range limits {
+			if lm, ok := l.(map[string]interface{}); ok && lm["company"] == company {
+				status.creditLimit, _ = lm["credit_limit"].(float64)
+				status.bypass = lm["bypass_credit_limit_check"] == float64(1)
+			}
+		}
+	}
+
+	// Unpaid invoices
+	filters, err := encodeFilters([][]interface{}{
+		{"customer", "=", customer},
+		{"company", "=", company},
+		{"docstatus", "=", 1},
+		{"outstanding_amount", "!=", 0},
+	})
+	if err != nil {
+		return nil, err
+	}
+	result, err = c.Request("GET", "Sales%20Invoice?limit_page_length=0&fields=[\"name\",\"posting_date\",\"due_date\",\"grand_total\",\"outstanding_amount\"]&order_by=due_date%20asc&filters="+filters, nil)
+	if err != nil {
+		return nil, err
+	}
+	if rows, ok := result["data"].([]interface{}); ok {
+		for _, row := range rows {
+			if m, ok := row.(map[string]interface{}); ok {
+				amount, _ := m["outstanding_amount"].(float64)
+				status.outstanding += amount
+				status.invoices = append(status.invoices, m)
+			}
+		}
+	}
+
+	// Submitted orders not fully billed
+	filters, err = encodeFilters([][]interface{}{
+		{"customer", "=", customer},
+		{"company", "=", company},
+		{"docstatus", "=", 1},
+		{"per_billed", "<", 100},
+		{"status", "not in", []string{"Closed", "Completed"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	result, err = c.Request("GET", "Sales%20Order?limit_page_length=0&fields=[\"name\",\"grand_total\",\"per_billed\"]&filters="+filters, nil)
+	if err != nil {
+		return nil, err
+	}
+	if rows, ok := result["data"].([]interface{}); ok {
+		for _, row := range rows {
+			if m, ok := row.(map[string]interface{}); ok {
+				total, _ := m["grand_total"].(float64)
+				billed, _ := m["per_billed"].(float64)
+				status.unbilled += total * (100 - billed) / 100
+			}
+		}
+	}
+
+	// Advances and payments not yet allocated to invoices
+	filters, err = encodeFilters([][]interface{}{
+		{"party_type", "=", "Customer"},
+		{"party", "=", customer},
+		{"company", "=", company},
+		{"payment_type", "=", "Receive"},
+		{"docstatus", "=", 1},
+		{"unallocated_amount", ">", 0},
+	})
+	if err != nil {
+		return nil, err
+	}
+	result, err = c.Request("GET", "Payment%20Entry?limit_page_length=0&fields=[\"name\",\"posting_date\",\"unallocated_amount\"]&filters="+filters, nil)
+	if err != nil {
+		return nil, err
+	}
+	if rows, ok := result["data"].([]interface{}); ok {
+		for _, row := range rows {
+			if m, ok := row.(map[string]interface{}); ok {
+				amount, _ := m["unallocated_amount"].(float64)
+				status.unallocated += amount
+				status.payments = append(status.payments, m)
+			}
+		}
+	}
+
+	return status, nil
+}
+
+// customerBalance prints outstanding invoices, unallocated payments and credit
+func (c *Client) customerBalance(customer string) error {
+	printf("%sFetching balance for: %s%s\n", Blue, customer, Reset)
+
+	status, err := c.fetchCreditStatus(customer)
+	if err != nil {
+		return err
+	}
+
+	printf("\n%sBalance: %s (%s)%s\n", Cyan, customer, status.company, Reset)
+	if status.frozen {
+		printf("  %sAccount is FROZEN%s\n", Red, Reset)
+	}
+
+	if len(status.invoices) > 0 {
+		printf("\n  %sOutstanding invoices (%d):%s\n", Yellow, len(status.invoices), Reset)
+		today := c.Today()
+		for _, inv := range status.invoices {
+			amount, _ := inv["outstanding_amount"].(float64)
+			due, _ := inv["due_date"].(string)
+			line := tf("    %s  due %s  %s", inv["name"], due, c.FormatCurrency(amount))
+			if due != "" && due < today {
+				line = tf("%s%s  OVERDUE%s", Red, line, Reset)
+			}
+			fmt.Println(line)
+		}
+	}
+
+	if len(status.payments) > 0 {
+		printf("\n  %sUnallocated payments (%d):%s\n", Yellow, len(status.payments), Reset)
+		for _, p := range status.payments {
+			amount, _ := p["unallocated_amount"].(float64)
+			fmt.Printf("    %s  %v  %s\n", p["name"], p["posting_date"], c.FormatCurrency(amount))
+		}
+	}
+
+	fmt.Println()
+	printf("  Outstanding:       %s\n", c.FormatCurrency(status.outstanding))
+	printf("  Unbilled orders:   %s\n", c.FormatCurrency(status.unbilled))
+	printf("  Unallocated:      -%s\n", c.FormatCurrency(status.unallocated))
+	printf("  %sExposure:          %s%s\n", Cyan, c.FormatCurrency(status.Exposure()), Reset)
+
+	if status.creditLimit == 0 {
+		printf("  Credit limit:      %snone%s\n", Yellow, Reset)
+		return nil
+	}
+
+	printf("  Credit limit:      %s", c.FormatCurrency(status.creditLimit))
+	if status.bypass {
+		printf(" (checked on invoices only)")
+	}
+	fmt.Println()
+
+	available := status.Available()
+	color := Green
+	if available < 0 {
+		color = Red
+	}
+	printf("  Available credit:  %s%s%s\n", color, c.FormatCurrency(available), Reset)
+	return nil
+}
+
+// checkOrderCredit refuses to submit a Sales Order that would push the
+// customer over its credit limit, or is for a frozen customer
+func (c *Client) checkOrderCredit(soName string) error {
+	result, err := c.Request("GET", "Sales%20Order/"+url.PathEscape(soName), nil)
+	if err != nil {
+		return err
+	}
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return errorf("sales order not found")
+	}
+
+	customer, _ := data["customer"].(string)
+	total, _ := data["grand_total"].(float64)
+
+	status, err := c.fetchCreditStatus(customer)
+	if err != nil {
+		return err
+	}
+
+	if status.frozen {
+		return errorf("customer %s is frozen (use --force to submit anyway)", customer)
+	}
+	// Customers set to bypass the check on orders are checked on invoices only
+	if status.creditLimit == 0 || status.bypass {
+		return nil
+	}
+
+	after := status.Available() - total
+	if after < 0 {
+		printf("%sWarning: %s would exceed the credit limit of %s%s\n", Yellow, soName, customer, Reset)
+		printf("  Credit limit:     %s\n", c.FormatCurrency(status.creditLimit))
+		printf("  Current exposure: %s\n", c.FormatCurrency(status.Exposure()))
+		printf("  This order:       %s\n", c.FormatCurrency(total))
+		printf("  Over limit by:    %s%s%s\n", Red, c.FormatCurrency(-after), Reset)
+		return errorf("credit limit exceeded (use --force to submit anyway)")
+	}
+	return nil
+}
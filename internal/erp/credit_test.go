@@ -0,0 +1,43 @@
+package erp
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// creditServer answers for a 500 order of a customer with a 100 credit limit
+func creditServer(bypass int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/resource/Sales Order/SO-0001":
+			fmt.Fprint(w, `{"data":{"customer":"ACME","grand_total":500}}`)
+		case "/api/resource/Customer/ACME":
+			fmt.Fprintf(w, `{"data":{"credit_limits":[{"company":"MC","credit_limit":100,"bypass_credit_limit_check":%d}]}}`, bypass)
+		default:
+			fmt.Fprint(w, `{"data":[]}`)
+		}
+	}))
+}
+
+func TestCheckOrderCredit(t *testing.T) {
+	for _, tt := range []struct {
+		bypass  int
+		wantErr bool
+	}{
+		{bypass: 0, wantErr: true},
+		{bypass: 1, wantErr: false},
+	} {
+		server := creditServer(tt.bypass)
+		c := NewClient(&Config{Company: "MC"})
+		c.ActiveURL = server.URL
+		c.Currency = &CurrencyInfo{Code: "EUR", Symbol: "€"}
+
+		err := c.checkOrderCredit("SO-0001")
+		if (err != nil) != tt.wantErr {
+			t.Errorf("bypass=%d: got error %v, want error %v", tt.bypass, err, tt.wantErr)
+		}
+		server.Close()
+	}
+}
@@ -0,0 +1,226 @@
+package erp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// docCurrency is the --currency and --exchange-rate of document creation
+type docCurrency struct {
+	code string  // Transaction currency, the company currency when empty
+	rate float64 // Company currency per unit of code, looked up when 0
+}
+
+func parseCurrencyFlags(args []string) (docCurrency, error) {
+	cur := docCurrency{}
+	for _, arg := range args {
+		switch {
+		case len(arg) > 11 && arg[:11] == "--currency=":
+			cur.code = strings.ToUpper(arg[11:])
+		case len(arg) > 16 && arg[:16] == "--exchange-rate=":
+			rate, err := strconv.ParseFloat(arg[16:], 64)
+			if err != nil || rate <= 0 {
+				return cur, errorf("invalid --exchange-rate: %s", arg[16:])
+			}
+			cur.rate = rate
+		}
+	}
+	return cur, nil
+}
+
+// exchangeRate returns the latest Currency Exchange rate from one currency
+// to another on or before date, trying the inverse pair as well
+func (c *Client) exchangeRate(from, to, date string) (float64, error) {
+	if from == to {
+		return 1, nil
+	}
+	for _, pair := range [][2]string{{from, to}, {to, from}} {
+		filters, err := encodeFilters([][]interface{}{
+			{"from_currency", "=", pair[0]},
+			{"to_currency", "=", pair[1]},
+			{"date", "<=", date},
+		})
+		if err != nil {
+			return 0, err
+		}
+		result, err := c.Request("GET", "Currency%20Exchange?limit_page_length=1&order_by=date%20desc&fields=[\"exchange_rate\"]&filters="+filters, nil)
+		if err != nil {
+			return 0, err
+		}
+		data, _ := result["data"].([]interface{})
+		if len(data) == 0 {
+			continue
+		}
+		row, _ := data[0].(map[string]interface{})
+		if rate, _ := row["exchange_rate"].(float64); rate > 0 {
+			if pair[0] == to {
+				return 1 / rate, nil
+			}
+			return rate, nil
+		}
+	}
+	return 0, errorf("no exchange rate from %s to %s on or before %s (add one with 'erp-cli fx set %s %s <rate>' or pass --exchange-rate)", from, to, date, from, to)
+}
+
+// applyCurrency sets the currency and conversion rate of a new document,
+// returning the currency with the rate used. The company currency needs no
+// flags, so nothing is set for it.
+func (c *Client) applyCurrency(body map[string]interface{}, cur docCurrency, date string) (docCurrency, error) {
+	company, _ := c.GetCurrency()
+	if cur.code == "" || cur.code == company.Code {
+		if cur.rate > 0 && cur.code == "" {
+			return cur, errorf("--exchange-rate needs --currency")
+		}
+		return docCurrency{}, nil
+	}
+	if cur.rate == 0 {
+		rate, err := c.exchangeRate(cur.code, company.Code, date)
+		if err != nil {
+			return cur, err
+		}
+		cur.rate = rate
+	}
+	body["currency"] = cur.code
+	body["conversion_rate"] = cur.rate
+	return cur, nil
+}
+
+// copyOrderCurrency copies the currency and rate of the order a delivery
+// or invoice is made from, as ERPNext's own mapping does
+func copyOrderCurrency(body, order map[string]interface{}) {
+	if code := stringField(order, "currency"); code != "" {
+		body["currency"] = code
+		body["conversion_rate"] = order["conversion_rate"]
+	}
+}
+
+// overrideCurrency applies the --currency and --exchange-rate of a document
+// made from an order: the currency must be the order's, the rate replaces
+// the order's with the one of the new document's date
+func (c *Client) overrideCurrency(body map[string]interface{}, cur docCurrency) (docCurrency, error) {
+	code := stringField(body, "currency")
+	if cur.code != "" && cur.code != code {
+		return cur, errorf("the order is in %s, not %s", code, cur.code)
+	}
+	company, _ := c.GetCurrency()
+	if code == "" || code == company.Code {
+		return docCurrency{}, nil
+	}
+	if cur.rate > 0 {
+		body["conversion_rate"] = cur.rate
+	}
+	rate, _ := body["conversion_rate"].(float64)
+	return docCurrency{code: code, rate: rate}, nil
+}
+
+// setPaymentRate fills the amounts and exchange rates of a Payment Entry
+// against a foreign-currency invoice. amount is in the party account's
+// currency, the bank side is in company currency.
+func setPaymentRate(body map[string]interface{}, amount, rate float64) {
+	if body["payment_type"] == "Receive" {
+		body["paid_amount"] = amount
+		body["source_exchange_rate"] = rate
+		body["received_amount"] = amount * rate
+		body["target_exchange_rate"] = 1
+		return
+	}
+	body["paid_amount"] = amount * rate
+	body["source_exchange_rate"] = 1
+	body["received_amount"] = amount
+	body["target_exchange_rate"] = rate
+}
+
+// paymentPartyCurrency is the currency of the party side of a Payment
+// Entry, which its allocated amounts are in
+func paymentPartyCurrency(body map[string]interface{}) string {
+	if body["payment_type"] == "Receive" {
+		return stringField(body, "paid_from_account_currency")
+	}
+	return stringField(body, "paid_to_account_currency")
+}
+
+// paymentPartyAmount is the amount of a Payment Entry in the party currency
+func paymentPartyAmount(body map[string]interface{}) float64 {
+	field := "received_amount"
+	if body["payment_type"] == "Receive" {
+		field = "paid_amount"
+	}
+	amount, _ := body[field].(float64)
+	return amount
+}
+
+// paymentCurrency checks the --currency of a payment against the invoice
+// it settles and applies --exchange-rate, returning the currency and rate
+// used for foreign-currency invoices
+func (c *Client) paymentCurrency(body map[string]interface{}, cur docCurrency) (docCurrency, error) {
+	company, _ := c.GetCurrency()
+	code := paymentPartyCurrency(body)
+	if code == "" {
+		code = company.Code
+	}
+	if cur.code != "" && cur.code != code {
+		return cur, errorf("the invoice is in %s, not %s", code, cur.code)
+	}
+	if code == company.Code {
+		if cur.rate > 0 {
+			return cur, errorf("--exchange-rate only applies to foreign-currency invoices")
+		}
+		return docCurrency{}, nil
+	}
+	if cur.rate > 0 {
+		setPaymentRate(body, paymentPartyAmount(body), cur.rate)
+	}
+	field := "target_exchange_rate"
+	if body["payment_type"] == "Receive" {
+		field = "source_exchange_rate"
+	}
+	rate, _ := body[field].(float64)
+	return docCurrency{code: code, rate: rate}, nil
+}
+
+// printCurrency prints the currency of a new foreign-currency document
+func (c *Client) printCurrency(cur docCurrency) {
+	if cur.code == "" {
+		return
+	}
+	company, _ := c.GetCurrency()
+	printf("  Currency: %s (1 %s = %s)\n", cur.code, cur.code, c.FormatCurrencyIn(cur.rate, company.Code))
+}
+
+// FormatCurrencyIn formats an amount in the given currency, the company
+// currency when code is empty
+func (c *Client) FormatCurrencyIn(amount float64, code string) string {
+	if code == "" {
+		return c.FormatCurrency(amount)
+	}
+	return fmt.Sprintf("%s%.2f", currencyInfo(code).Symbol, amount)
+}
+
+// formatDoc formats an amount of a document in the document's currency
+func (c *Client) formatDoc(data map[string]interface{}, amount float64) string {
+	return c.FormatCurrencyIn(amount, stringField(data, "currency"))
+}
+
+// docTotal formats the grand total of a document; foreign-currency
+// documents also show it in company currency with the rate
+func (c *Client) docTotal(data map[string]interface{}) string {
+	total, _ := data["grand_total"].(float64)
+	return c.docAmount(data, total, "base_grand_total")
+}
+
+// docAmount formats an amount of a document with, for foreign-currency
+// documents, the company currency value held in baseField
+func (c *Client) docAmount(data map[string]interface{}, amount float64, baseField string) string {
+	code := stringField(data, "currency")
+	company, _ := c.GetCurrency()
+	if code == "" || code == company.Code {
+		return c.FormatCurrency(amount)
+	}
+	base, ok := data[baseField].(float64)
+	rate, _ := data["conversion_rate"].(float64)
+	if !ok {
+		base = amount * rate
+	}
+	return tf("%s (%s at %g)", c.FormatCurrencyIn(amount, code), c.FormatCurrency(base), rate)
+}
@@ -19,14 +19,19 @@ type Customer struct {
 // CmdCustomer handles customer commands
 func (c *Client) CmdCustomer(args []string) error {
 	if len(args) == 0 {
-		fmt.Println("Usage: erp-cli customer <subcommand> [args...]")
-		fmt.Println("Subcommands: list, get, create, delete")
+		fmt.Println(T("Usage: erp-cli customer <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, balance, create, set, add-address, add-contact, addresses, delete"))
 		fmt.Println()
-		fmt.Println("Examples:")
-		fmt.Println("  erp-cli customer list")
-		fmt.Println("  erp-cli customer get \"Acme Corp\"")
-		fmt.Println("  erp-cli customer create \"New Customer\" --group=\"Commercial\" --territory=\"Spain\"")
-		fmt.Println("  erp-cli customer delete \"Old Customer\"")
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli customer list"))
+		fmt.Println(T("  erp-cli customer get \"Acme Corp\""))
+		fmt.Println(T("  erp-cli customer balance \"Acme Corp\""))
+		fmt.Println(T("  erp-cli customer create \"New Customer\" --group=\"Commercial\" --territory=\"Spain\""))
+		fmt.Println(T("  erp-cli customer set \"Acme Corp\" group=\"Commercial\" tax-id=ES12345678"))
+		fmt.Println(T("  erp-cli customer add-address \"Acme Corp\" --line1=\"Main St 1\" --city=Bilbao --country=Spain --primary"))
+		fmt.Println(T("  erp-cli customer add-contact \"Acme Corp\" John --last=Doe --email=john@example.com"))
+		fmt.Println(T("  erp-cli customer addresses \"Acme Corp\""))
+		fmt.Println(T("  erp-cli customer delete \"Old Customer\""))
 		return nil
 	}
 
@@ -35,22 +40,47 @@ func (c *Client) CmdCustomer(args []string) error {
 		return c.customerList()
 	case "get":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli customer get <name>")
+			return errorf("usage: erp-cli customer get <name>")
 		}
 		return c.customerGet(args[1])
+	case "balance":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli customer balance <name>")
+		}
+		return c.customerBalance(args[1])
 	case "create":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli customer create <name> [--group=X] [--territory=X]")
+			return errorf("usage: erp-cli customer create <name> [--group=X] [--territory=X]")
 		}
 		opts := parseCustomerOptions(args[2:])
 		return c.customerCreate(args[1], opts)
+	case "set":
+		if len(args) < 3 {
+			return errorf("usage: erp-cli customer set <name> <property=value> [property=value...]")
+		}
+		return c.partySet("Customer", args[1], args[2:])
+	case "add-address":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli customer add-address <name> --line1=X --city=X --country=X [--type=Billing|Shipping] [--line2=X] [--state=X] [--zip=X] [--email=X] [--phone=X] [--primary] [--shipping]")
+		}
+		return c.partyAddAddress("Customer", args[1], parseAddressOptions(args[2:]))
+	case "add-contact":
+		if len(args) < 3 {
+			return errorf("usage: erp-cli customer add-contact <name> <first_name> [--last=X] [--email=X] [--phone=X] [--mobile=X] [--primary]")
+		}
+		return c.partyAddContact("Customer", args[1], args[2], parseContactOptions(args[3:]))
+	case "list-addresses", "addresses":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli customer addresses <name>")
+		}
+		return c.partyListAddresses("Customer", args[1])
 	case "delete":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli customer delete <name>")
+			return errorf("usage: erp-cli customer delete <name>")
 		}
 		return c.customerDelete(args[1])
 	default:
-		return fmt.Errorf("unknown customer subcommand: %s", args[0])
+		return errorf("unknown customer subcommand: %s", args[0])
 	}
 }
 
@@ -73,7 +103,7 @@ func parseCustomerOptions(args []string) customerOptions {
 }
 
 func (c *Client) customerList() error {
-	fmt.Printf("%sFetching customers...%s\n", Blue, Reset)
+	printf("%sFetching customers...%s\n", Blue, Reset)
 
 	result, err := c.Request("GET", "Customer?limit_page_length=0&fields=[\"name\",\"customer_name\",\"customer_group\",\"territory\",\"disabled\"]", nil)
 	if err != nil {
@@ -82,11 +112,11 @@ func (c *Client) customerList() error {
 
 	if data, ok := result["data"].([]interface{}); ok {
 		if len(data) == 0 {
-			fmt.Printf("%sNo customers found%s\n", Yellow, Reset)
+			printf("%sNo customers found%s\n", Yellow, Reset)
 			return nil
 		}
 
-		fmt.Printf("\n%sCustomers (%d):%s\n", Cyan, len(data), Reset)
+		printf("\n%sCustomers (%d):%s\n", Cyan, len(data), Reset)
 		for _, item := range data {
 			if m, ok := item.(map[string]interface{}); ok {
 				name := m["name"]
@@ -116,7 +146,7 @@ func (c *Client) customerList() error {
 }
 
 func (c *Client) customerGet(name string) error {
-	fmt.Printf("%sFetching customer: %s%s\n", Blue, name, Reset)
+	printf("%sFetching customer: %s%s\n", Blue, name, Reset)
 
 	encoded := url.PathEscape(name)
 	result, err := c.Request("GET", "Customer/"+encoded, nil)
@@ -143,12 +173,14 @@ func (c *Client) customerGet(name string) error {
 
 		jsonOut, _ := json.MarshalIndent(output, "", "  ")
 		fmt.Println(string(jsonOut))
+
+		return c.printPartyLinks("Customer", name)
 	}
 	return nil
 }
 
 func (c *Client) customerCreate(name string, opts customerOptions) error {
-	fmt.Printf("%sCreating customer: %s%s\n", Blue, name, Reset)
+	printf("%sCreating customer: %s%s\n", Blue, name, Reset)
 
 	body := map[string]interface{}{
 		"customer_name": name,
@@ -156,12 +188,12 @@ func (c *Client) customerCreate(name string, opts customerOptions) error {
 
 	if opts.group != "" {
 		body["customer_group"] = opts.group
-		fmt.Printf("  Group: %s\n", opts.group)
+		printf("  Group: %s\n", opts.group)
 	}
 
 	if opts.territory != "" {
 		body["territory"] = opts.territory
-		fmt.Printf("  Territory: %s\n", opts.territory)
+		printf("  Territory: %s\n", opts.territory)
 	}
 
 	result, err := c.Request("POST", "Customer", body)
@@ -170,14 +202,14 @@ func (c *Client) customerCreate(name string, opts customerOptions) error {
 	}
 
 	if data, ok := result["data"].(map[string]interface{}); ok {
-		fmt.Printf("%s✓ Customer created: %s%s\n", Green, data["name"], Reset)
+		printf("%s✓ Customer created: %s%s\n", Green, data["name"], Reset)
 	}
 
 	return nil
 }
 
 func (c *Client) customerDelete(name string) error {
-	fmt.Printf("%sDeleting customer: %s%s\n", Blue, name, Reset)
+	printf("%sDeleting customer: %s%s\n", Blue, name, Reset)
 
 	encoded := url.PathEscape(name)
 	_, err := c.Request("DELETE", "Customer/"+encoded, nil)
@@ -185,6 +217,6 @@ func (c *Client) customerDelete(name string) error {
 		return err
 	}
 
-	fmt.Printf("%s✓ Customer deleted: %s%s\n", Green, name, Reset)
+	printf("%s✓ Customer deleted: %s%s\n", Green, name, Reset)
 	return nil
 }
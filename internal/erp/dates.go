@@ -0,0 +1,124 @@
+package erp
+
+import (
+	"strings"
+	"time"
+)
+
+// GetLocation returns the server's time zone so dates match what ERPNext
+// considers "today". ERP_TIMEZONE overrides it; falls back to the local zone.
+func (c *Client) GetLocation() *time.Location {
+	// The TUI looks it up from several commands at once
+	c.locationMu.Lock()
+	defer c.locationMu.Unlock()
+
+	// Return cached location if available
+	if c.Location != nil {
+		return c.Location
+	}
+
+	c.Location = time.Local
+
+	zone := c.Config.Timezone
+	if zone == "" {
+		zone, _ = c.cachedLookup("timezone")
+	}
+	if zone == "" {
+		result, err := c.CallMethod("GET", "frappe.client.get_single_value?doctype=System%20Settings&field=time_zone", nil)
+		if err == nil {
+			zone, _ = result["message"].(string)
+			c.storeLookup("timezone", zone)
+		}
+	}
+
+	if zone != "" {
+		if loc, err := time.LoadLocation(zone); err == nil {
+			c.Location = loc
+		}
+	}
+	return c.Location
+}
+
+// Now returns the current time in the server's time zone
+func (c *Client) Now() time.Time {
+	return time.Now().In(c.GetLocation())
+}
+
+// Today returns the server's current date (YYYY-MM-DD)
+func (c *Client) Today() string {
+	return c.Now().Format("2006-01-02")
+}
+
+// Timestamp formats the current server time with its zone, for report headers
+func (c *Client) Timestamp() string {
+	return c.Now().Format("2006-01-02 15:04:05 MST")
+}
+
+// postingOptions holds --posting-date/--posting-time flags
+type postingOptions struct {
+	date string // YYYY-MM-DD
+	time string // HH:MM:SS
+}
+
+func parsePostingOptions(args []string) (postingOptions, error) {
+	opts := postingOptions{}
+	for _, arg := range args {
+		if len(arg) > 15 && arg[:15] == "--posting-date=" {
+			if _, err := time.Parse("2006-01-02", arg[15:]); err != nil {
+				return opts, errorf("invalid posting date (expected YYYY-MM-DD): %s", arg[15:])
+			}
+			opts.date = arg[15:]
+		}
+		if len(arg) > 15 && arg[:15] == "--posting-time=" {
+			value := arg[15:]
+			if strings.Count(value, ":") == 1 {
+				value += ":00"
+			}
+			if _, err := time.Parse("15:04:05", value); err != nil {
+				return opts, errorf("invalid posting time (expected HH:MM[:SS]): %s", arg[15:])
+			}
+			opts.time = value
+		}
+	}
+	return opts, nil
+}
+
+// set reports whether a posting date or time was given
+func (p postingOptions) set() bool {
+	return p.date != "" || p.time != ""
+}
+
+// applyPosting sets the posting date/time of a new document. Without flags,
+// posting_date is the server's today; explicit values also enable
+// set_posting_time so ERPNext keeps them, once the date is checked against
+// the fiscal years and frozen period. Payment Entries have no posting time.
+func (c *Client) applyPosting(body map[string]interface{}, opts postingOptions, hasTime bool) error {
+	date, err := c.documentDate(opts.date)
+	if err != nil {
+		return err
+	}
+	body["posting_date"] = date
+
+	if !hasTime || !opts.set() {
+		return nil
+	}
+	body["set_posting_time"] = 1
+	if opts.time != "" {
+		body["posting_time"] = opts.time
+	} else {
+		body["posting_time"] = c.Now().Format("15:04:05")
+	}
+	return nil
+}
+
+// printPosting shows an explicit posting date/time before creating a document
+func (c *Client) printPosting(opts postingOptions) {
+	if !opts.set() {
+		return
+	}
+	date := opts.date
+	if date == "" {
+		date = c.Today()
+	}
+	printf("  Posting: %s %s (%s)\n", date, opts.time, c.GetLocation())
+}
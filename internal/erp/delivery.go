@@ -3,7 +3,6 @@ package erp
 import (
 	"fmt"
 	"net/url"
-	"time"
 )
 
 // DeliveryNoteItem represents an item in a Delivery Note
@@ -29,16 +28,18 @@ type DeliveryNote struct {
 // CmdDN handles Delivery Note commands
 func (c *Client) CmdDN(args []string) error {
 	if len(args) == 0 {
-		fmt.Println("Usage: erp-cli dn <subcommand> [args...]")
-		fmt.Println("Subcommands: list, get, create-from-so, submit, cancel")
+		fmt.Println(T("Usage: erp-cli dn <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, create-from-so, submit, cancel, pdf"))
 		fmt.Println()
-		fmt.Println("Examples:")
-		fmt.Println("  erp-cli dn list")
-		fmt.Println("  erp-cli dn list --customer=\"Acme\" --status=Draft")
-		fmt.Println("  erp-cli dn get DN-00001")
-		fmt.Println("  erp-cli dn create-from-so SAL-ORD-2025-00001")
-		fmt.Println("  erp-cli dn submit DN-00001")
-		fmt.Println("  erp-cli dn cancel DN-00001")
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli dn list"))
+		fmt.Println(T("  erp-cli dn list --customer=\"Acme\" --status=Draft"))
+		fmt.Println(T("  erp-cli dn get DN-00001"))
+		fmt.Println(T("  erp-cli dn create-from-so SAL-ORD-2025-00001"))
+		fmt.Println(T("  erp-cli dn create-from-so SAL-ORD-2025-00001 --shipping-rule=\"Express\"   (default: the SO's rule)"))
+		fmt.Println(T("  erp-cli dn submit DN-00001"))
+		fmt.Println(T("  erp-cli dn cancel DN-00001"))
+		fmt.Println(T("  erp-cli dn pdf DN-00001"))
 		return nil
 	}
 
@@ -48,32 +49,46 @@ func (c *Client) CmdDN(args []string) error {
 		return c.dnList(opts)
 	case "get":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli dn get <name>")
+			return errorf("usage: erp-cli dn get <name> [--template=file.tmpl]")
+		}
+		if tmpl := parseTemplateFlag(args[2:]); tmpl != "" {
+			return c.renderDocTemplate("Delivery Note", args[1], tmpl)
 		}
 		return c.dnGet(args[1])
 	case "create-from-so":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli dn create-from-so <so_name>")
+			return errorf("usage: erp-cli dn create-from-so <so_name> [--shipping-rule=X] [--billing-address=X] [--shipping-address=X] [--terms=X] [--remarks=\"...\"] [--letterhead=X]")
+		}
+		posting, err := parsePostingOptions(args[2:])
+		if err != nil {
+			return err
 		}
-		return c.dnCreateFromSO(args[1])
+		return c.dnCreateFromSO(args[1], posting, parseShippingRule(args[2:]), parseTermsFlags(args[2:]), parseAddressFlags(args[2:]))
 	case "submit":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli dn submit <name>")
+			return errorf("usage: erp-cli dn submit <name>")
 		}
 		return c.dnSubmit(args[1])
 	case "cancel":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli dn cancel <name>")
+			return errorf("usage: erp-cli dn cancel <name> [--cascade] [--yes]")
+		}
+		return c.dnCancel(args[1], parseCancelOptions(args[2:]))
+	case "pdf":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli dn pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]")
 		}
-		return c.dnCancel(args[1])
+		return c.docPDF("Delivery Note", args[1], parsePDFOptions(args[2:]))
 	default:
-		return fmt.Errorf("unknown dn subcommand: %s", args[0])
+		return errorf("unknown dn subcommand: %s", args[0])
 	}
 }
 
 type dnListOptions struct {
 	customer string
 	status   string
+	xlsx     string // Output file for --xlsx
+	template string // --template file
 }
 
 func parseDNListOptions(args []string) dnListOptions {
@@ -85,29 +100,40 @@ func parseDNListOptions(args []string) dnListOptions {
 		if len(arg) > 9 && arg[:9] == "--status=" {
 			opts.status = arg[9:]
 		}
+		if path, ok := parseXLSXFlag(arg, "delivery-notes.xlsx"); ok {
+			opts.xlsx = path
+		}
+		if len(arg) > 11 && arg[:11] == "--template=" {
+			opts.template = arg[11:]
+		}
 	}
 	return opts
 }
 
 func (c *Client) dnList(opts dnListOptions) error {
-	fmt.Printf("%sFetching delivery notes...%s\n", Blue, Reset)
-
-	filters := []string{}
+	filters := [][]interface{}{}
 	if opts.customer != "" {
-		filters = append(filters, fmt.Sprintf(`["customer","like","%%%s%%"]`, opts.customer))
+		filters = append(filters, []interface{}{"customer", "like", fmt.Sprintf("%%%s%%", opts.customer)})
 	}
 	if opts.status != "" {
-		filters = append(filters, fmt.Sprintf(`["status","=","%s"]`, opts.status))
+		filters = append(filters, []interface{}{"status", "=", opts.status})
+	}
+
+	if opts.xlsx != "" {
+		return c.exportDocsXLSX("Delivery Note", filters, opts.xlsx)
 	}
+	if opts.template != "" {
+		return c.renderListTemplate("Delivery Note", filters, opts.template)
+	}
+	printf("%sFetching delivery notes...%s\n", Blue, Reset)
 
-	endpoint := "Delivery%20Note?limit_page_length=0&fields=[\"name\",\"customer\",\"posting_date\",\"status\",\"grand_total\",\"docstatus\"]&order_by=creation%20desc"
+	endpoint := "Delivery%20Note?" + c.pageLimit(0) + "&fields=[\"name\",\"customer\",\"posting_date\",\"status\",\"grand_total\",\"docstatus\"]&order_by=creation%20desc"
 	if len(filters) > 0 {
-		filterStr := "[" + filters[0]
-		for i := 1; i < len(filters); i++ {
-			filterStr += "," + filters[i]
+		encoded, err := encodeFilters(filters)
+		if err != nil {
+			return err
 		}
-		filterStr += "]"
-		endpoint += "&filters=" + url.QueryEscape(filterStr)
+		endpoint += "&filters=" + encoded
 	}
 
 	result, err := c.Request("GET", endpoint, nil)
@@ -117,11 +143,12 @@ func (c *Client) dnList(opts dnListOptions) error {
 
 	if data, ok := result["data"].([]interface{}); ok {
 		if len(data) == 0 {
-			fmt.Printf("%sNo delivery notes found%s\n", Yellow, Reset)
+			printf("%sNo delivery notes found%s\n", Yellow, Reset)
 			return nil
 		}
 
-		fmt.Printf("\n%sDelivery Notes (%d):%s\n", Cyan, len(data), Reset)
+		printf("\n%sDelivery Notes (%d):%s\n", Cyan, len(data), Reset)
+		c.printPageNote(len(data))
 		for _, item := range data {
 			if m, ok := item.(map[string]interface{}); ok {
 				name := m["name"]
@@ -138,7 +165,7 @@ func (c *Client) dnList(opts dnListOptions) error {
 				}
 
 				fmt.Printf("  %s - %s\n", name, customer)
-				fmt.Printf("    Date: %s | Status: %s%s%s | Total: %s\n",
+				printf("    Date: %s | Status: %s%s%s | Total: %s\n",
 					date, statusColor, status, Reset, c.FormatCurrency(total))
 			}
 		}
@@ -147,7 +174,7 @@ func (c *Client) dnList(opts dnListOptions) error {
 }
 
 func (c *Client) dnGet(name string) error {
-	fmt.Printf("%sFetching delivery note: %s%s\n", Blue, name, Reset)
+	printf("%sFetching delivery note: %s%s\n", Blue, name, Reset)
 
 	encoded := url.PathEscape(name)
 	result, err := c.Request("GET", "Delivery%20Note/"+encoded, nil)
@@ -156,16 +183,16 @@ func (c *Client) dnGet(name string) error {
 	}
 
 	if data, ok := result["data"].(map[string]interface{}); ok {
-		fmt.Printf("\n%sDelivery Note: %s%s\n", Cyan, name, Reset)
+		printf("\n%sDelivery Note: %s%s\n", Cyan, name, Reset)
 
-		fmt.Printf("  Customer: %s\n", data["customer"])
-		fmt.Printf("  Date: %s\n", data["posting_date"])
-		fmt.Printf("  Status: %s\n", data["status"])
-		grandTotal, _ := data["grand_total"].(float64)
-		fmt.Printf("  Total: %s\n", c.FormatCurrency(grandTotal))
+		printf("  Customer: %s\n", data["customer"])
+		printf("  Date: %s\n", data["posting_date"])
+		printf("  Status: %s\n", data["status"])
+		printf("  Total: %s\n", c.docTotal(data))
+		printDocAddresses(data)
 
 		if items, ok := data["items"].([]interface{}); ok && len(items) > 0 {
-			fmt.Printf("\n  %sItems:%s\n", Yellow, Reset)
+			printf("\n  %sItems:%s\n", Yellow, Reset)
 			for _, item := range items {
 				if m, ok := item.(map[string]interface{}); ok {
 					itemCode := m["item_code"]
@@ -175,42 +202,86 @@ func (c *Client) dnGet(name string) error {
 					so := m["against_sales_order"]
 					soStr := ""
 					if so != nil && so != "" {
-						soStr = fmt.Sprintf(" (SO: %s)", so)
+						soStr = tf(" (SO: %s)", so)
+					}
+					fmt.Printf("    - %s: %.0f x %s = %s%s\n", itemCode, qty, c.formatDoc(data, rate), c.formatDoc(data, amount), soStr)
+					for _, line := range packedItemLines(data, m) {
+						fmt.Println(line)
 					}
-					fmt.Printf("    - %s: %.0f x %s = %s%s\n", itemCode, qty, c.FormatCurrency(rate), c.FormatCurrency(amount), soStr)
 				}
 			}
 		}
+
+		c.printDocCharges(data)
+		printDocTerms(data)
+		c.printActivity("Delivery Note", name)
 	}
 	return nil
 }
 
-func (c *Client) dnCreateFromSO(soName string) error {
-	fmt.Printf("%sCreating delivery note from SO: %s%s\n", Blue, soName, Reset)
+func (c *Client) dnCreateFromSO(soName string, posting postingOptions, shipping string, terms docTerms, addr docAddresses) error {
+	printf("%sCreating delivery note from SO: %s%s\n", Blue, soName, Reset)
+	c.printPosting(posting)
+
+	body, err := c.dnFromSO(soName, posting)
+	if err != nil {
+		return err
+	}
+	if err := c.applyShippingRule(body, shipping); err != nil {
+		return err
+	}
+
+	if err := c.applyAddresses(body, addr); err != nil {
+		return err
+	}
+	if terms, err = c.applyTerms(body, "Delivery Note", terms, true); err != nil {
+		return err
+	}
 
+	result, err := c.Request("POST", "Delivery%20Note", body)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].(map[string]interface{}); ok {
+		dnName := data["name"]
+		printf("%s✓ Delivery Note created: %s%s\n", Green, dnName, Reset)
+		printf("  From SO: %s\n", soName)
+		printf("  Items: %d\n", len(body["items"].([]map[string]interface{})))
+		printShippingRule(stringField(body, "shipping_rule"))
+		printf("  Status: Draft\n")
+		printAddresses(addr)
+		printTerms(terms)
+		c.finishRemarks("Delivery Note", stringField(data, "name"), terms)
+		printf("  Use 'erp-cli dn submit %s' to submit\n", dnName)
+	}
+
+	return nil
+}
+
+// dnFromSO builds the body of a Delivery Note shipping a submitted SO
+func (c *Client) dnFromSO(soName string, posting postingOptions) (map[string]interface{}, error) {
 	encoded := url.PathEscape(soName)
 	result, err := c.Request("GET", "Sales%20Order/"+encoded, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	soData, ok := result["data"].(map[string]interface{})
 	if !ok {
-		return fmt.Errorf("sales order not found")
+		return nil, errorf("sales order not found")
 	}
 
 	docStatus, _ := soData["docstatus"].(float64)
 	if docStatus != 1 {
-		return fmt.Errorf("sales order must be submitted first")
+		return nil, errorf("sales order must be submitted first")
 	}
 
 	company, err := c.GetCompany()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	today := time.Now().Format("2006-01-02")
-
 	var dnItems []map[string]interface{}
 	if items, ok := soData["items"].([]interface{}); ok {
 		for _, item := range items {
@@ -227,53 +298,46 @@ func (c *Client) dnCreateFromSO(soName string) error {
 	}
 
 	if len(dnItems) == 0 {
-		return fmt.Errorf("no items found in sales order")
+		return nil, errorf("no items found in sales order")
 	}
 
 	body := map[string]interface{}{
-		"customer":     soData["customer"],
-		"posting_date": today,
-		"company":      company,
-		"items":        dnItems,
+		"customer": soData["customer"],
+		"company":  company,
+		"items":    dnItems,
 	}
-
-	result, err = c.Request("POST", "Delivery%20Note", body)
-	if err != nil {
-		return err
+	if err := c.applyPosting(body, posting, true); err != nil {
+		return nil, err
 	}
-
-	if data, ok := result["data"].(map[string]interface{}); ok {
-		dnName := data["name"]
-		fmt.Printf("%s✓ Delivery Note created: %s%s\n", Green, dnName, Reset)
-		fmt.Printf("  From SO: %s\n", soName)
-		fmt.Printf("  Items: %d\n", len(dnItems))
-		fmt.Printf("  Status: Draft\n")
-		fmt.Printf("  Use 'erp-cli dn submit %s' to submit\n", dnName)
+	if rule := stringField(soData, "shipping_rule"); rule != "" {
+		body["shipping_rule"] = rule
 	}
-
-	return nil
+	copyOrderCurrency(body, soData)
+	copyOrderTerms(body, soData)
+	copyOrderAddresses(body, soData)
+	return body, nil
 }
 
 func (c *Client) dnSubmit(name string) error {
-	fmt.Printf("%sSubmitting delivery note: %s%s\n", Blue, name, Reset)
+	printf("%sSubmitting delivery note: %s%s\n", Blue, name, Reset)
 
 	err := c.submitDocument("Delivery Note", name)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("%s✓ Delivery Note submitted: %s%s\n", Green, name, Reset)
+	printf("%s✓ Delivery Note submitted: %s%s\n", Green, name, Reset)
 	return nil
 }
 
-func (c *Client) dnCancel(name string) error {
-	fmt.Printf("%sCancelling delivery note: %s%s\n", Blue, name, Reset)
+func (c *Client) dnCancel(name string, opts cancelOptions) error {
+	printf("%sCancelling delivery note: %s%s\n", Blue, name, Reset)
 
-	err := c.cancelDocument("Delivery Note", name)
+	err := c.cancelWithChain("Delivery Note", name, opts)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("%s✓ Delivery Note cancelled: %s%s\n", Green, name, Reset)
+	printf("%s✓ Delivery Note cancelled: %s%s\n", Green, name, Reset)
 	return nil
 }
@@ -0,0 +1,136 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Document defaults: the warehouse, price lists and groups of the config
+// (ERP_DEFAULT_WAREHOUSE and the like) fill the new documents that leave
+// them out, whichever command, form or import creates them. A value given
+// on the command line, or a row's own warehouse, always wins.
+
+// configDefaults are the defaults 'config set' saves: its name for them,
+// their config key, their field in Config and the doctype of their values
+var configDefaults = []struct {
+	name    string
+	key     string
+	field   func(*Config) *string
+	doctype string
+}{
+	{"warehouse", "ERP_DEFAULT_WAREHOUSE", func(c *Config) *string { return &c.DefaultWarehouse }, "Warehouse"},
+	{"selling-price-list", "ERP_DEFAULT_SELLING_PRICE_LIST", func(c *Config) *string { return &c.DefaultSellingPriceList }, "Price List"},
+	{"buying-price-list", "ERP_DEFAULT_BUYING_PRICE_LIST", func(c *Config) *string { return &c.DefaultBuyingPriceList }, "Price List"},
+	{"customer-group", "ERP_DEFAULT_CUSTOMER_GROUP", func(c *Config) *string { return &c.DefaultCustomerGroup }, "Customer Group"},
+	{"territory", "ERP_DEFAULT_TERRITORY", func(c *Config) *string { return &c.DefaultTerritory }, "Territory"},
+	{"supplier-group", "ERP_DEFAULT_SUPPLIER_GROUP", func(c *Config) *string { return &c.DefaultSupplierGroup }, "Supplier Group"},
+}
+
+// configSet saves a document default to the config file, once the site
+// confirms the value exists; an empty value clears it
+func (c *Client) configSet(args []string) error {
+	if len(args) == 0 || len(args) > 2 {
+		fmt.Println(T("Usage: erp-cli config set <key> [value]"))
+		fmt.Println()
+		fmt.Println(T("Keys (an empty value clears the default):"))
+		for _, d := range configDefaults {
+			fmt.Printf("  %-20s %-32s %s\n", d.name, d.key, d.doctype)
+		}
+		fmt.Println()
+		fmt.Println(T("Example:"))
+		fmt.Println(T("  erp-cli config set warehouse \"Stores - MC\""))
+		return nil
+	}
+
+	for _, d := range configDefaults {
+		if args[0] != d.name && !strings.EqualFold(args[0], d.key) {
+			continue
+		}
+		value := ""
+		if len(args) == 2 {
+			value = strings.TrimSpace(args[1])
+		}
+		if value != "" {
+			c.DetectConnection()
+			if _, err := c.Request("GET", url.PathEscape(d.doctype)+"/"+url.PathEscape(value), nil); err != nil {
+				return errorf("cannot check %s %q: %w", d.doctype, value, err)
+			}
+		}
+		if err := c.Config.SaveSetting(d.key, value); err != nil {
+			return err
+		}
+		*d.field(c.Config) = value
+		if value == "" {
+			printf("%s✓ Cleared %s%s\n", Green, d.key, Reset)
+		} else {
+			printf("%s✓ Saved %s=\"%s\"%s\n", Green, d.key, value, Reset)
+		}
+		return nil
+	}
+	return errorf("unknown setting: %s (see 'erp-cli config set')", args[0])
+}
+
+// warehouseDoctypes are the documents whose item rows receive into the
+// default warehouse when they name none
+var warehouseDoctypes = map[string]bool{
+	"Purchase Order":   true,
+	"Purchase Receipt": true,
+}
+
+// applyDocDefaults fills the configured defaults into a document being
+// created (POST <Doctype>)
+func (c *Client) applyDocDefaults(endpoint string, body interface{}) {
+	doc, ok := body.(map[string]interface{})
+	path, _, _ := strings.Cut(endpoint, "?")
+	if !ok || strings.Contains(path, "/") {
+		return
+	}
+	config := c.Config
+	doctype := endpointDoctype(endpoint)
+
+	fill := func(field, value string) {
+		if value != "" && stringField(doc, field) == "" {
+			doc[field] = value
+		}
+	}
+	switch doctype {
+	case "Customer":
+		fill("customer_group", config.DefaultCustomerGroup)
+		fill("territory", config.DefaultTerritory)
+	case "Supplier":
+		fill("supplier_group", config.DefaultSupplierGroup)
+	case "Quotation", "Sales Order", "Sales Invoice":
+		// The customer's own price list still comes first
+		if config.DefaultSellingPriceList != "" && stringField(doc, "selling_price_list") == "" {
+			customer := stringField(doc, "customer")
+			if doctype == "Quotation" {
+				customer = stringField(doc, "party_name")
+			}
+			doc["selling_price_list"] = c.sellingPriceList(customer)
+		}
+	case "Purchase Order", "Purchase Receipt", "Purchase Invoice":
+		fill("buying_price_list", config.DefaultBuyingPriceList)
+	}
+
+	if warehouseDoctypes[doctype] && config.DefaultWarehouse != "" {
+		for _, row := range docRows(doc["items"]) {
+			if stringField(row, "warehouse") == "" {
+				row["warehouse"] = config.DefaultWarehouse
+			}
+		}
+	}
+}
+
+// defaultWarehouse returns the warehouse argument at args[i], or the
+// configured default when the argument is left out, with the arguments
+// after it
+func (c *Client) defaultWarehouse(args []string, i int) (string, []string, bool) {
+	if i < len(args) && !strings.HasPrefix(args[i], "--") {
+		return args[i], args[i+1:], true
+	}
+	if i > len(args) {
+		i = len(args)
+	}
+	return c.Config.DefaultWarehouse, args[i:], c.Config.DefaultWarehouse != ""
+}
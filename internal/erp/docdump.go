@@ -0,0 +1,255 @@
+package erp
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// maxDumpLine is the longest JSONL line import doc accepts (10 MB)
+const maxDumpLine = 10 * 1024 * 1024
+
+// dumpMetaFields are set by the server and dropped before a document is
+// written to another site
+var dumpMetaFields = []string{"owner", "creation", "modified", "modified_by", "docstatus"}
+
+// filterOperators are the comparisons --filter accepts, two-character ones
+// first so "<=" is not read as "<"
+var filterOperators = []string{"!=", "<=", ">=", "=", "<", ">"}
+
+// parseDocFilters reads --filter key=value (repeatable, also
+// --filter=key=value). Besides = the operators !=, <, <=, > and >= are
+// accepted (--filter "creation<2025-01-01"). Values containing % match with
+// like.
+func parseDocFilters(args []string) ([][]interface{}, error) {
+	var filters [][]interface{}
+	for i := 0; i < len(args); i++ {
+		filter := ""
+		switch {
+		case args[i] == "--filter":
+			if i+1 >= len(args) {
+				return nil, errorf("--filter needs key=value")
+			}
+			filter = args[i+1]
+			i++
+		case len(args[i]) > 9 && args[i][:9] == "--filter=":
+			filter = args[i][9:]
+		default:
+			continue
+		}
+
+		at := strings.IndexAny(filter, "!<>=")
+		if at <= 0 {
+			return nil, errorf("invalid filter %q (expected key=value)", filter)
+		}
+		key, rest := filter[:at], filter[at:]
+		operator := ""
+		for _, op := range filterOperators {
+			if strings.HasPrefix(rest, op) {
+				operator = op
+				break
+			}
+		}
+		if operator == "" {
+			return nil, errorf("invalid filter %q (expected key=value)", filter)
+		}
+		value := rest[len(operator):]
+		if operator == "=" && strings.Contains(value, "%") {
+			operator = "like"
+		}
+		filters = append(filters, []interface{}{key, operator, value})
+	}
+	return filters, nil
+}
+
+// exportDocs writes every matching document, child tables included, as one
+// JSON object per line
+func (c *Client) exportDocs(doctype string, filters [][]interface{}, outputFile string) error {
+	printf("%sExporting %s documents...%s\n", Blue, doctype, Reset)
+
+	endpoint := url.PathEscape(doctype) + "?limit_page_length=0&fields=[\"name\"]&order_by=name%20asc"
+	if len(filters) > 0 {
+		encoded, err := encodeFilters(filters)
+		if err != nil {
+			return err
+		}
+		endpoint += "&filters=" + encoded
+	}
+
+	result, err := c.Request("GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	if data, ok := result["data"].([]interface{}); ok {
+		for _, item := range data {
+			if m, ok := item.(map[string]interface{}); ok {
+				names = append(names, stringField(m, "name"))
+			}
+		}
+	}
+	if len(names) == 0 {
+		printf("%sNo %s documents found%s\n", Yellow, doctype, Reset)
+		return nil
+	}
+
+	file, err := os.Create(outputFile)
+	if err != nil {
+		return errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	count := 0
+	for _, name := range names {
+		detail, err := c.Request("GET", url.PathEscape(doctype)+"/"+url.PathEscape(name), nil)
+		if err != nil {
+			printf("  %s✗ Failed: %s (%s)%s\n", Red, name, err, Reset)
+			continue
+		}
+		doc, ok := detail["data"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		line, err := json.Marshal(doc)
+		if err != nil {
+			return errorf("failed to encode %s: %w", name, err)
+		}
+		writer.Write(line)
+		writer.WriteByte('\n')
+		count++
+	}
+
+	if err := writer.Flush(); err != nil {
+		return errorf("failed to write file: %w", err)
+	}
+
+	printf("%s✓ Exported %d %s documents to %s%s\n", Green, count, doctype, outputFile, Reset)
+	return nil
+}
+
+// cleanDumpDoc drops server-managed fields from a dumped document and its
+// child rows so it can be inserted on another site. Submitted documents
+// come back as drafts.
+func cleanDumpDoc(doc map[string]interface{}) {
+	for _, f := range dumpMetaFields {
+		delete(doc, f)
+	}
+	for key, value := range doc {
+		if strings.HasPrefix(key, "_") {
+			delete(doc, key)
+			continue
+		}
+		rows, ok := value.([]interface{})
+		if !ok {
+			continue
+		}
+		for _, row := range rows {
+			if child, ok := row.(map[string]interface{}); ok {
+				cleanDumpDoc(child)
+				// The new parent assigns its own child names
+				delete(child, "name")
+				delete(child, "parent")
+			}
+		}
+	}
+}
+
+// importDocs creates the documents of a JSONL dump. Existing documents are
+// skipped, or replaced (child tables included) with upsert.
+func (c *Client) importDocs(inputFile string, upsert bool, run *importRun) error {
+	dryRun := run.dryRun
+	if dryRun {
+		printf("%s[DRY RUN] Importing documents from: %s%s\n", Yellow, inputFile, Reset)
+	} else {
+		printf("%sImporting documents from: %s%s\n", Blue, inputFile, Reset)
+	}
+
+	file, err := os.Open(inputFile)
+	if err != nil {
+		return errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	created := 0
+	updated := 0
+	skipped := 0
+	failed := 0
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), maxDumpLine)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || run.finished(lineNo) {
+			continue
+		}
+
+		var doc map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &doc); err != nil {
+			printf("  %sLine %d: skipped (invalid JSON: %s)%s\n", Yellow, lineNo, err, Reset)
+			run.record(lineNo, "", rowSkipped, "invalid JSON")
+			skipped++
+			continue
+		}
+		run.mapping.applyDoc(doc)
+		doctype := stringField(doc, "doctype")
+		name := stringField(doc, "name")
+		if doctype == "" || name == "" {
+			printf("  %sLine %d: skipped (no doctype or name)%s\n", Yellow, lineNo, Reset)
+			run.record(lineNo, "", rowSkipped, "no doctype or name")
+			skipped++
+			continue
+		}
+		cleanDumpDoc(doc)
+
+		label := doctype + " " + name
+		path := url.PathEscape(doctype) + "/" + url.PathEscape(name)
+		_, err := c.Request("GET", path, nil)
+		exists := err == nil
+
+		switch {
+		case exists && !upsert:
+			printf("  %s- Exists: %s (use --upsert to overwrite)%s\n", Yellow, label, Reset)
+			run.record(lineNo, label, rowSkipped, "exists")
+			skipped++
+		case dryRun && exists:
+			printf("  [DRY RUN] Would update: %s\n", label)
+			updated++
+		case dryRun:
+			printf("  [DRY RUN] Would create: %s\n", label)
+			created++
+		case exists:
+			if _, err := c.Request("PUT", path, doc); err != nil {
+				printf("  %s✗ Failed: %s (%s)%s\n", Red, label, err, Reset)
+				run.record(lineNo, label, rowError, err.Error())
+				failed++
+				continue
+			}
+			printf("  %s✓ Updated: %s%s\n", Green, label, Reset)
+			run.record(lineNo, label, rowUpdated, "")
+			updated++
+		default:
+			if _, err := c.Request("POST", url.PathEscape(doctype), doc); err != nil {
+				printf("  %s✗ Failed: %s (%s)%s\n", Red, label, err, Reset)
+				run.record(lineNo, label, rowError, err.Error())
+				failed++
+				continue
+			}
+			printf("  %s✓ Created: %s%s\n", Green, label, Reset)
+			run.record(lineNo, label, rowCreated, "")
+			created++
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return errorf("failed to read %s: %w", inputFile, err)
+	}
+
+	printf("\n%sSummary: %d created, %d updated, %d skipped, %d failed%s\n", Cyan, created, updated, skipped, failed, Reset)
+	return nil
+}
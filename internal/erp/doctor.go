@@ -0,0 +1,299 @@
+package erp
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// doctorDoctypes are the doctypes whose read permission doctor checks
+var doctorDoctypes = []string{
+	"Item", "Bin", "Customer", "Supplier", "Quotation", "Sales Order", "Sales Invoice",
+	"Delivery Note", "Purchase Order", "Purchase Invoice", "Purchase Receipt",
+	"Payment Entry", "Journal Entry", "Stock Entry",
+}
+
+// doctor collects the problems found and their remediation hints
+type doctor struct {
+	problems int
+	warnings int
+}
+
+func (d *doctor) ok(format string, args ...interface{}) {
+	fmt.Printf("  %s✓%s %s\n", Green, Reset, tf(format, args...))
+}
+
+func (d *doctor) warn(hint, format string, args ...interface{}) {
+	d.warnings++
+	fmt.Printf("  %s⚠ %s%s\n", Yellow, tf(format, args...), Reset)
+	if hint != "" {
+		fmt.Printf("    → %s\n", T(hint))
+	}
+}
+
+func (d *doctor) fail(hint, format string, args ...interface{}) {
+	d.problems++
+	fmt.Printf("  %s✗ %s%s\n", Red, tf(format, args...), Reset)
+	if hint != "" {
+		fmt.Printf("    → %s\n", T(hint))
+	}
+}
+
+// CmdDoctor checks the configuration, network path, credentials and
+// permissions, printing a hint for each problem
+func (c *Client) CmdDoctor() error {
+	d := &doctor{}
+
+	printf("%sConfiguration%s\n", Cyan, Reset)
+	if c.Config.path != "" {
+		d.ok("Config file: %s", c.Config.path)
+	}
+	if c.Config.APIKey == "" || c.Config.APISecret == "" {
+		d.fail("Set ERP_API_KEY and ERP_API_SECRET (User > Settings > API Access > Generate Keys)", "API key or secret missing")
+	} else {
+		d.ok("API key: %s…", c.Config.APIKey[:min(len(c.Config.APIKey), 6)])
+	}
+
+	type endpoint struct {
+		label string
+		url   string
+	}
+	var endpoints []endpoint
+	if c.Config.ERPVPN != "" {
+		endpoints = append(endpoints, endpoint{"VPN", c.Config.ERPVPN})
+	}
+	if c.Config.ERPURL != "" {
+		endpoints = append(endpoints, endpoint{"Internet", c.Config.ERPURL})
+	} else {
+		d.fail("Set ERP_URL to the site's address, e.g. https://erp.example.com", "ERP_URL missing")
+	}
+
+	reachable := ""
+	latency := map[string]time.Duration{}
+	for _, ep := range endpoints {
+		fmt.Println()
+		fmt.Printf("%s%s: %s%s\n", Cyan, ep.label, ep.url, Reset)
+		if rtt, ok := c.doctorEndpoint(d, ep.label, ep.url); ok {
+			latency[ep.label] = rtt
+			if reachable == "" {
+				reachable = ep.url
+			}
+		}
+	}
+	if vpn, ok := latency["VPN"]; ok {
+		if internet, ok := latency["Internet"]; ok {
+			fmt.Println()
+			printf("  VPN %s vs internet %s: the CLI uses the VPN when it answers within 2s\n", vpn.Round(time.Millisecond), internet.Round(time.Millisecond))
+		}
+	}
+
+	if reachable == "" {
+		fmt.Println()
+		return errorf("the site is not reachable; %d problem(s) found", d.problems)
+	}
+	c.ActiveURL = reachable
+	c.Mode = "internet"
+	if reachable == c.Config.ERPVPN {
+		c.Mode = "vpn"
+	}
+
+	fmt.Println()
+	printf("%sServer%s\n", Cyan, Reset)
+	c.doctorServer(d)
+
+	fmt.Println()
+	printf("%sPermissions (read)%s\n", Cyan, Reset)
+	c.doctorPermissions(d)
+
+	fmt.Println()
+	switch {
+	case d.problems > 0:
+		return errorf("%d problem(s) and %d warning(s) found", d.problems, d.warnings)
+	case d.warnings > 0:
+		printf("%s%d warning(s), no problems%s\n", Yellow, d.warnings, Reset)
+	default:
+		printf("%s✓ All checks passed%s\n", Green, Reset)
+	}
+	return nil
+}
+
+// doctorEndpoint checks DNS, TLS and authentication of one site address
+// and returns its latency when it answers
+func (c *Client) doctorEndpoint(d *doctor, label, rawURL string) (time.Duration, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		d.fail("Use a full address with scheme, e.g. https://erp.example.com", "Invalid URL: %s", rawURL)
+		return 0, false
+	}
+	if u.Scheme == "http" && label == "Internet" {
+		d.warn("Use https:// so the API token is not sent in clear text", "Plain HTTP over the internet")
+	}
+
+	host := u.Hostname()
+	start := time.Now()
+	addrs, err := net.LookupHost(host)
+	if err != nil {
+		hint := "Check the host name in ERP_URL and your DNS settings"
+		if label == "VPN" {
+			hint = "Connect the VPN, or check the host name in ERP_VPN (the internet address is used meanwhile)"
+		}
+		d.fail(hint, "DNS: %s does not resolve", host)
+		return 0, false
+	}
+	d.ok("DNS: %s → %s (%s)", host, strings.Join(addrs, ", "), time.Since(start).Round(time.Millisecond))
+
+	if u.Scheme == "https" {
+		port := u.Port()
+		if port == "" {
+			port = "443"
+		}
+		dialer := &net.Dialer{Timeout: 5 * time.Second}
+		conn, err := tls.DialWithDialer(dialer, "tcp", net.JoinHostPort(host, port), &tls.Config{ServerName: host})
+		if err != nil {
+			var certErr *tls.CertificateVerificationError
+			switch {
+			case errors.As(err, &certErr):
+				d.fail("Renew the certificate, or make sure ERP_URL uses the name it was issued for", "TLS: %s", err)
+			default:
+				d.fail(tf("Check that the server is up and port %s is open from this network", port), "Connect: %s", err)
+			}
+			return 0, false
+		}
+		cert := conn.ConnectionState().PeerCertificates[0]
+		conn.Close()
+		days := int(time.Until(cert.NotAfter).Hours() / 24)
+		if days < 14 {
+			d.warn("Renew the certificate before it expires (e.g. bench setup lets-encrypt)", "TLS: certificate expires in %d day(s) (%s)", days, cert.NotAfter.Format("2006-01-02"))
+		} else {
+			d.ok("TLS: valid until %s (%s)", cert.NotAfter.Format("2006-01-02"), cert.Issuer.CommonName)
+		}
+	}
+
+	// Latency: the best of three unauthenticated pings
+	var best time.Duration
+	for i := 0; i < 3; i++ {
+		status, _, _, rtt, err := c.doctorGet(rawURL, "/api/method/ping", false, label)
+		if err != nil {
+			d.fail("Check that the server is up and reachable from this network", "Ping: %s", err)
+			return 0, false
+		}
+		if status != http.StatusOK {
+			hint := "The server answered but not as a Frappe site; check the URL"
+			if label == "Internet" && (status == http.StatusUnauthorized || status == http.StatusForbidden) {
+				hint = "A reverse proxy is blocking the request: set NGINX_COOKIE (and NGINX_COOKIE_NAME)"
+			}
+			d.fail(hint, "Ping: HTTP %d", status)
+			return 0, false
+		}
+		if best == 0 || rtt < best {
+			best = rtt
+		}
+	}
+	if best > time.Second {
+		d.warn("Consider ERP_LOW_BANDWIDTH=1 for fewer and smaller requests", "Latency: %s", best.Round(time.Millisecond))
+	} else {
+		d.ok("Latency: %s", best.Round(time.Millisecond))
+	}
+
+	status, header, body, _, err := c.doctorGet(rawURL, "/api/method/frappe.auth.get_logged_user", true, label)
+	if err != nil {
+		d.fail("", "Authentication: %s", err)
+		return best, false
+	}
+	var result map[string]interface{}
+	json.Unmarshal(body, &result)
+	user, _ := result["message"].(string)
+	switch {
+	case status == http.StatusOK && user != "":
+		d.ok("Token: authenticated as %s", user)
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		d.fail("Regenerate the keys in User > Settings > API Access and update ERP_API_KEY/ERP_API_SECRET; the user must be enabled", "Token rejected (HTTP %d)", status)
+		return best, false
+	default:
+		d.fail("", "Authentication: HTTP %d", status)
+		return best, false
+	}
+
+	if date, err := http.ParseTime(header.Get("Date")); err == nil {
+		if skew := time.Since(date); skew > 2*time.Minute || skew < -2*time.Minute {
+			d.warn("Sync this machine's clock (NTP); dates near midnight may be off", "Clock differs from the server's by %s", skew.Round(time.Second))
+		}
+	}
+	return best, true
+}
+
+// doctorGet makes a GET request without the client's error handling, for
+// the status, headers and timing
+func (c *Client) doctorGet(baseURL, path string, auth bool, label string) (int, http.Header, []byte, time.Duration, error) {
+	req, err := http.NewRequest("GET", baseURL+path, nil)
+	if err != nil {
+		return 0, nil, nil, 0, err
+	}
+	if auth {
+		req.Header.Set("Authorization", fmt.Sprintf("token %s:%s", c.Config.APIKey, c.Config.APISecret))
+	}
+	req.Header.Set("Accept", "application/json")
+	if label == "Internet" && c.Config.NginxCookie != "" {
+		req.AddCookie(&http.Cookie{Name: c.Config.NginxCookieName, Value: c.Config.NginxCookie})
+	}
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, nil, nil, 0, err
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, resp.Header, body, time.Since(start), nil
+}
+
+// doctorServer reports the Frappe and ERPNext versions and the company
+func (c *Client) doctorServer(d *doctor) {
+	switch major := c.majorVersion("erpnext"); {
+	case !c.versionsKnown():
+		d.warn("", "Versions not available")
+	case major == 0:
+		d.fail("The site needs the ERPNext app installed", "ERPNext is not installed (%s)", c.serverVersionLine())
+	case major < minERPNextVersion:
+		d.warn("Upgrade the site; older versions are not supported", "%s", c.serverVersionLine())
+	default:
+		d.ok("%s", c.serverVersionLine())
+	}
+
+	company, err := c.GetCompany()
+	if err != nil {
+		d.fail("Create a company in ERPNext, or set ERP_COMPANY", "Company: %s", err)
+	} else {
+		d.ok("Company: %s", company)
+	}
+	zone := c.GetLocation().String()
+	d.ok("Time zone: %s", zone)
+}
+
+// doctorPermissions checks the user can list the key doctypes
+func (c *Client) doctorPermissions(d *doctor) {
+	var denied []string
+	for _, doctype := range doctorDoctypes {
+		_, err := c.Request("GET", strings.ReplaceAll(doctype, " ", "%20")+"?limit_page_length=1", nil)
+		if err != nil {
+			denied = append(denied, doctype)
+		}
+	}
+	if len(denied) == 0 {
+		d.ok("All %d key doctypes readable", len(doctorDoctypes))
+		return
+	}
+	for _, doctype := range denied {
+		d.fail("", "%s: no read access", doctype)
+	}
+	fmt.Println(T("    → Give the API user a role with these doctypes (e.g. Stock User, Sales User,"))
+	fmt.Println(T("      Purchase User, Accounts User) in User > Roles"))
+}
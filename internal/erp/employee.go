@@ -0,0 +1,178 @@
+package erp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// CmdEmployee handles Employee commands (read only)
+func (c *Client) CmdEmployee(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli employee <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, me"))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli employee list"))
+		fmt.Println(T("  erp-cli employee list --department=\"Research & Development\" --status=Left"))
+		fmt.Println(T("  erp-cli employee get HR-EMP-00001"))
+		fmt.Println(T("  erp-cli employee me"))
+		return nil
+	}
+
+	switch args[0] {
+	case "list":
+		return c.employeeList(parseEmployeeListOptions(args[1:]))
+	case "get":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli employee get <id>")
+		}
+		return c.employeeGet(args[1])
+	case "me":
+		emp, err := c.currentEmployee()
+		if err != nil {
+			return err
+		}
+		return c.employeeGet(stringField(emp, "name"))
+	default:
+		return errorf("unknown employee subcommand: %s", args[0])
+	}
+}
+
+type employeeListOptions struct {
+	department string
+	status     string // Active, Inactive, Suspended, Left (default: Active)
+}
+
+func parseEmployeeListOptions(args []string) employeeListOptions {
+	opts := employeeListOptions{status: "Active"}
+	for _, arg := range args {
+		if len(arg) > 13 && arg[:13] == "--department=" {
+			opts.department = arg[13:]
+		}
+		if len(arg) > 9 && arg[:9] == "--status=" {
+			opts.status = arg[9:]
+		}
+	}
+	return opts
+}
+
+// currentEmployee returns the active Employee linked to the API user
+func (c *Client) currentEmployee() (map[string]interface{}, error) {
+	user, err := c.loggedUser()
+	if err != nil {
+		return nil, err
+	}
+
+	filters, err := encodeFilters([][]interface{}{
+		{"user_id", "=", user},
+		{"status", "=", "Active"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	result, err := c.Request("GET", "Employee?fields=[\"name\",\"employee_name\",\"company\",\"department\"]&filters="+filters, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if data, ok := result["data"].([]interface{}); ok && len(data) > 0 {
+		if emp, ok := data[0].(map[string]interface{}); ok {
+			return emp, nil
+		}
+	}
+	return nil, errorf("no active employee is linked to user %s (set User ID on the Employee record)", user)
+}
+
+func (c *Client) employeeList(opts employeeListOptions) error {
+	printf("%sFetching employees...%s\n", Blue, Reset)
+
+	filters := [][]interface{}{{"status", "=", opts.status}}
+	if opts.department != "" {
+		filters = append(filters, []interface{}{"department", "like", fmt.Sprintf("%%%s%%", opts.department)})
+	}
+	encoded, err := encodeFilters(filters)
+	if err != nil {
+		return err
+	}
+
+	result, err := c.Request("GET", "Employee?limit_page_length=0&fields=[\"name\",\"employee_name\",\"designation\",\"department\",\"company_email\"]&order_by=employee_name%20asc&filters="+encoded, nil)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].([]interface{}); ok {
+		if len(data) == 0 {
+			printf("%sNo employees found%s\n", Yellow, Reset)
+			return nil
+		}
+
+		printf("\n%sEmployees (%d):%s\n", Cyan, len(data), Reset)
+		for _, item := range data {
+			if m, ok := item.(map[string]interface{}); ok {
+				fmt.Printf("  %s - %s\n", m["name"], m["employee_name"])
+				line := ""
+				if designation := stringField(m, "designation"); designation != "" {
+					line = designation
+				}
+				if department := stringField(m, "department"); department != "" {
+					if line != "" {
+						line += " | "
+					}
+					line += department
+				}
+				if email := stringField(m, "company_email"); email != "" {
+					if line != "" {
+						line += " | "
+					}
+					line += email
+				}
+				if line != "" {
+					fmt.Printf("    %s\n", line)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Client) employeeGet(name string) error {
+	printf("%sFetching employee: %s%s\n", Blue, name, Reset)
+
+	result, err := c.Request("GET", "Employee/"+url.PathEscape(name), nil)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].(map[string]interface{}); ok {
+		output := map[string]interface{}{
+			"name":             data["name"],
+			"employee_name":    data["employee_name"],
+			"status":           data["status"],
+			"company":          data["company"],
+			"department":       data["department"],
+			"designation":      data["designation"],
+			"reports_to":       data["reports_to"],
+			"user_id":          data["user_id"],
+			"company_email":    data["company_email"],
+			"cell_number":      data["cell_number"],
+			"date_of_joining":  data["date_of_joining"],
+			"expense_approver": data["expense_approver"],
+			"leave_approver":   data["leave_approver"],
+			"holiday_list":     data["holiday_list"],
+			"employment_type":  data["employment_type"],
+			"relieving_date":   data["relieving_date"],
+		}
+
+		// Remove nil/empty values
+		for k, v := range output {
+			if v == nil || v == "" || v == float64(0) {
+				delete(output, k)
+			}
+		}
+
+		jsonOut, _ := json.MarshalIndent(output, "", "  ")
+		fmt.Println(string(jsonOut))
+	}
+	return nil
+}
@@ -0,0 +1,408 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// expenseOptions holds flags for expense create/add
+type expenseOptions struct {
+	description string
+	date        string   // YYYY-MM-DD, default today
+	receipts    []string // Files to attach (--receipt, repeatable)
+	posting     postingOptions
+}
+
+func parseExpenseOptions(args []string) (expenseOptions, error) {
+	opts := expenseOptions{}
+	for _, arg := range args {
+		if len(arg) > 7 && arg[:7] == "--desc=" {
+			opts.description = arg[7:]
+		}
+		if len(arg) > 7 && arg[:7] == "--date=" {
+			if _, err := time.Parse("2006-01-02", arg[7:]); err != nil {
+				return opts, errorf("invalid date (expected YYYY-MM-DD): %s", arg[7:])
+			}
+			opts.date = arg[7:]
+		}
+		if len(arg) > 10 && arg[:10] == "--receipt=" {
+			opts.receipts = append(opts.receipts, arg[10:])
+		}
+	}
+	posting, err := parsePostingOptions(args)
+	if err != nil {
+		return opts, err
+	}
+	opts.posting = posting
+	return opts, nil
+}
+
+// CmdExpense handles Expense Claim commands for the API user's employee
+func (c *Client) CmdExpense(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli expense <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, types, create, add, attach, submit"))
+		fmt.Println()
+		fmt.Println(T("Claims are filed for the employee linked to your API user."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli expense types"))
+		fmt.Println(T("  erp-cli expense create Travel 45.80 --desc=\"Taxi to customer\" --receipt=taxi.jpg"))
+		fmt.Println(T("  erp-cli expense add HR-EXP-2025-00001 Food 12.50 --date=2025-01-06"))
+		fmt.Println(T("  erp-cli expense attach HR-EXP-2025-00001 lunch.pdf"))
+		fmt.Println(T("  erp-cli expense list"))
+		fmt.Println(T("  erp-cli expense list --status=Draft"))
+		fmt.Println(T("  erp-cli expense get HR-EXP-2025-00001"))
+		fmt.Println(T("  erp-cli expense submit HR-EXP-2025-00001"))
+		return nil
+	}
+	if err := c.requireDoctype("Expense Claim"); err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "list":
+		status := ""
+		for _, arg := range args[1:] {
+			if len(arg) > 9 && arg[:9] == "--status=" {
+				status = arg[9:]
+			}
+		}
+		return c.expenseList(status)
+	case "get":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli expense get <name>")
+		}
+		return c.expenseGet(args[1])
+	case "types":
+		return c.expenseTypes()
+	case "create":
+		if len(args) < 3 {
+			return errorf("usage: erp-cli expense create <type> <amount> [--desc=X] [--date=YYYY-MM-DD] [--receipt=file] [--posting-date=YYYY-MM-DD]")
+		}
+		amount, err := strconv.ParseFloat(args[2], 64)
+		if err != nil || amount <= 0 {
+			return errorf("invalid amount: %s", args[2])
+		}
+		opts, err := parseExpenseOptions(args[3:])
+		if err != nil {
+			return err
+		}
+		return c.expenseCreate(args[1], amount, opts)
+	case "add":
+		if len(args) < 4 {
+			return errorf("usage: erp-cli expense add <name> <type> <amount> [--desc=X] [--date=YYYY-MM-DD] [--receipt=file]")
+		}
+		amount, err := strconv.ParseFloat(args[3], 64)
+		if err != nil || amount <= 0 {
+			return errorf("invalid amount: %s", args[3])
+		}
+		opts, err := parseExpenseOptions(args[4:])
+		if err != nil {
+			return err
+		}
+		return c.expenseAdd(args[1], args[2], amount, opts)
+	case "attach":
+		if len(args) < 3 {
+			return errorf("usage: erp-cli expense attach <name> <file> [file...]")
+		}
+		return c.expenseAttach(args[1], args[2:])
+	case "submit":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli expense submit <name>")
+		}
+		return c.expenseSubmit(args[1])
+	default:
+		return errorf("unknown expense subcommand: %s", args[0])
+	}
+}
+
+// expenseLine builds an Expense Claim Detail row
+func (c *Client) expenseLine(expenseType string, amount float64, opts expenseOptions) map[string]interface{} {
+	date := opts.date
+	if date == "" {
+		date = c.Today()
+	}
+	line := map[string]interface{}{
+		"expense_date":      date,
+		"expense_type":      expenseType,
+		"amount":            amount,
+		"sanctioned_amount": amount,
+	}
+	if opts.description != "" {
+		line["description"] = opts.description
+	}
+	return line
+}
+
+func (c *Client) expenseCreate(expenseType string, amount float64, opts expenseOptions) error {
+	emp, err := c.currentEmployee()
+	if err != nil {
+		return err
+	}
+
+	printf("%sCreating expense claim for %s...%s\n", Blue, emp["employee_name"], Reset)
+
+	data, err := c.createExpenseClaim(emp, expenseType, amount, opts)
+	if err != nil {
+		return err
+	}
+	name := stringField(data, "name")
+	printf("%s✓ Expense claim created: %s%s\n", Green, name, Reset)
+	fmt.Printf("  %s: %s\n", expenseType, c.FormatCurrency(amount))
+	if approver := stringField(data, "expense_approver"); approver != "" {
+		printf("  Approver: %s\n", approver)
+	}
+
+	return c.expenseAttach(name, opts.receipts)
+}
+
+// createExpenseClaim creates a draft claim of one expense for an employee
+// (shared with the TUI)
+func (c *Client) createExpenseClaim(emp map[string]interface{}, expenseType string, amount float64, opts expenseOptions) (map[string]interface{}, error) {
+	employee := stringField(emp, "name")
+	company := stringField(emp, "company")
+	if company == "" {
+		var err error
+		if company, err = c.GetCompany(); err != nil {
+			return nil, err
+		}
+	}
+
+	body := map[string]interface{}{
+		"employee": employee,
+		"company":  company,
+		"expenses": []interface{}{c.expenseLine(expenseType, amount, opts)},
+	}
+	if err := c.applyPosting(body, opts.posting, false); err != nil {
+		return nil, err
+	}
+
+	// The approver and payable account are only defaulted by the web form
+	result, err := c.Request("GET", "Employee/"+url.PathEscape(employee), nil)
+	if err == nil {
+		if data, ok := result["data"].(map[string]interface{}); ok {
+			if approver := stringField(data, "expense_approver"); approver != "" {
+				body["expense_approver"] = approver
+			}
+		}
+	}
+	result, err = c.Request("GET", "Company/"+url.PathEscape(company), nil)
+	if err == nil {
+		if data, ok := result["data"].(map[string]interface{}); ok {
+			if account := stringField(data, "default_expense_claim_payable_account"); account != "" {
+				body["payable_account"] = account
+			}
+		}
+	}
+
+	result, err = c.Request("POST", "Expense%20Claim", body)
+	if err != nil {
+		return nil, err
+	}
+
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return nil, errorf("unexpected response creating expense claim")
+	}
+	return data, nil
+}
+
+func (c *Client) expenseAdd(name, expenseType string, amount float64, opts expenseOptions) error {
+	printf("%sAdding expense to: %s%s\n", Blue, name, Reset)
+
+	result, err := c.Request("GET", "Expense%20Claim/"+url.PathEscape(name), nil)
+	if err != nil {
+		return err
+	}
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return errorf("expense claim not found: %s", name)
+	}
+	if docStatus, _ := data["docstatus"].(float64); docStatus != 0 {
+		return errorf("can only add expenses to draft claims")
+	}
+
+	expenses, _ := data["expenses"].([]interface{})
+	body := map[string]interface{}{
+		"expenses": append(expenses, c.expenseLine(expenseType, amount, opts)),
+	}
+	if err := c.updateDraft("Expense Claim", name, data, body); err != nil {
+		return err
+	}
+	printf("%s✓ Added %s: %s%s\n", Green, expenseType, c.FormatCurrency(amount), Reset)
+
+	return c.expenseAttach(name, opts.receipts)
+}
+
+// expenseAttach uploads receipts as private attachments of a claim
+func (c *Client) expenseAttach(name string, files []string) error {
+	for _, path := range files {
+		printf("%sAttaching %s...%s\n", Blue, path, Reset)
+		fileURL, err := c.uploadFile(path, "Expense Claim", name, true)
+		if err != nil {
+			return err
+		}
+		printf("%s✓ Receipt attached: %s%s\n", Green, fileURL, Reset)
+	}
+	return nil
+}
+
+func (c *Client) expenseSubmit(name string) error {
+	printf("%sSubmitting expense claim: %s%s\n", Blue, name, Reset)
+	if err := c.submitExpenseClaim(name); err != nil {
+		return err
+	}
+	printf("%s✓ Expense claim submitted: %s%s\n", Green, name, Reset)
+	return nil
+}
+
+// submitExpenseClaim submits a claim. HRMS only allows submitting claims
+// that have been approved or rejected, so a pending claim is reported as
+// such.
+func (c *Client) submitExpenseClaim(name string) error {
+	result, err := c.Request("GET", "Expense%20Claim/"+url.PathEscape(name), nil)
+	if err != nil {
+		return err
+	}
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return errorf("expense claim not found: %s", name)
+	}
+	if docStatus, _ := data["docstatus"].(float64); docStatus != 0 {
+		return errorf("%s is not a draft", name)
+	}
+	if approval := stringField(data, "approval_status"); approval == "" || approval == "Draft" {
+		approver := stringField(data, "expense_approver")
+		if approver == "" {
+			approver = T("the expense approver")
+		}
+		return errorf("%s is waiting for approval by %s; it can be submitted once approved or rejected", name, approver)
+	}
+	return c.submitDocument("Expense Claim", name)
+}
+
+func (c *Client) expenseList(status string) error {
+	printf("%sFetching expense claims...%s\n", Blue, Reset)
+
+	emp, err := c.currentEmployee()
+	if err != nil {
+		return err
+	}
+
+	filters := [][]interface{}{{"employee", "=", emp["name"]}}
+	if status != "" {
+		filters = append(filters, []interface{}{"status", "=", status})
+	}
+	encoded, err := encodeFilters(filters)
+	if err != nil {
+		return err
+	}
+
+	result, err := c.Request("GET", "Expense%20Claim?"+c.pageLimit(0)+"&fields=[\"name\",\"posting_date\",\"status\",\"approval_status\",\"total_claimed_amount\",\"total_sanctioned_amount\"]&order_by=creation%20desc&filters="+encoded, nil)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].([]interface{}); ok {
+		if len(data) == 0 {
+			printf("%sNo expense claims found%s\n", Yellow, Reset)
+			return nil
+		}
+
+		printf("\n%sExpense Claims (%d):%s\n", Cyan, len(data), Reset)
+		c.printPageNote(len(data))
+		for _, item := range data {
+			if m, ok := item.(map[string]interface{}); ok {
+				status := m["status"]
+				statusColor := Yellow
+				if status == "Paid" || status == "Submitted" {
+					statusColor = Green
+				} else if status == "Cancelled" || status == "Rejected" {
+					statusColor = Red
+				}
+				claimed, _ := m["total_claimed_amount"].(float64)
+				fmt.Printf("  %s - %s\n", m["name"], m["posting_date"])
+				printf("    Status: %s%s%s | Approval: %s | Claimed: %s\n",
+					statusColor, status, Reset, m["approval_status"], c.FormatCurrency(claimed))
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Client) expenseGet(name string) error {
+	printf("%sFetching expense claim: %s%s\n", Blue, name, Reset)
+
+	result, err := c.Request("GET", "Expense%20Claim/"+url.PathEscape(name), nil)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].(map[string]interface{}); ok {
+		printf("\n%sExpense Claim: %s%s\n", Cyan, name, Reset)
+		printf("  Employee: %s (%s)\n", data["employee_name"], data["employee"])
+		printf("  Date: %s\n", data["posting_date"])
+		printf("  Status: %s | Approval: %s\n", data["status"], data["approval_status"])
+		if approver := stringField(data, "expense_approver"); approver != "" {
+			printf("  Approver: %s\n", approver)
+		}
+		claimed, _ := data["total_claimed_amount"].(float64)
+		sanctioned, _ := data["total_sanctioned_amount"].(float64)
+		printf("  Claimed: %s | Sanctioned: %s\n", c.FormatCurrency(claimed), c.FormatCurrency(sanctioned))
+
+		if expenses, ok := data["expenses"].([]interface{}); ok && len(expenses) > 0 {
+			printf("\n  %sExpenses:%s\n", Yellow, Reset)
+			for _, e := range expenses {
+				if m, ok := e.(map[string]interface{}); ok {
+					amount, _ := m["amount"].(float64)
+					line := fmt.Sprintf("    - %s %s: %s", m["expense_date"], m["expense_type"], c.FormatCurrency(amount))
+					if desc := stringField(m, "description"); desc != "" {
+						line += " (" + desc + ")"
+					}
+					fmt.Println(line)
+				}
+			}
+		}
+
+		attachments, err := c.fetchAttachments("Expense Claim", name)
+		if err == nil && len(attachments) > 0 {
+			printf("\n  %sReceipts:%s\n", Yellow, Reset)
+			for _, a := range attachments {
+				fmt.Printf("    - %s\n", a.fileName)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Client) expenseTypes() error {
+	printf("%sFetching expense claim types...%s\n", Blue, Reset)
+
+	result, err := c.Request("GET", "Expense%20Claim%20Type?limit_page_length=0&fields=[\"name\",\"description\"]", nil)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].([]interface{}); ok {
+		if len(data) == 0 {
+			printf("%sNo expense claim types found%s\n", Yellow, Reset)
+			return nil
+		}
+
+		printf("\n%sExpense Claim Types (%d):%s\n", Cyan, len(data), Reset)
+		for _, item := range data {
+			if m, ok := item.(map[string]interface{}); ok {
+				if desc := strings.TrimSpace(stringField(m, "description")); desc != "" {
+					fmt.Printf("  %s - %s\n", m["name"], desc)
+				} else {
+					fmt.Printf("  %s\n", m["name"])
+				}
+			}
+		}
+	}
+	return nil
+}
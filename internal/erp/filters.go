@@ -2,7 +2,6 @@ package erp
 
 import (
 	"encoding/json"
-	"fmt"
 	"net/url"
 )
 
@@ -14,8 +13,26 @@ func encodeFilters(filters [][]interface{}) (string, error) {
 
 	encoded, err := json.Marshal(filters)
 	if err != nil {
-		return "", fmt.Errorf("failed to encode filters: %w", err)
+		return "", errorf("failed to encode filters: %w", err)
 	}
 
 	return url.QueryEscape(string(encoded)), nil
 }
+
+// uniqueDocs drops the repeated rows of a list fetched with a child table
+// filter, which returns a document once per matching line
+func uniqueDocs(data []interface{}) []interface{} {
+	seen := map[string]bool{}
+	var docs []interface{}
+	for _, row := range data {
+		if m, ok := row.(map[string]interface{}); ok {
+			name := stringField(m, "name")
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+		}
+		docs = append(docs, row)
+	}
+	return docs
+}
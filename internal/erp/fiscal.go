@@ -0,0 +1,137 @@
+package erp
+
+import (
+	"fmt"
+	"time"
+)
+
+// transactionDate returns the --transaction-date of order creation
+// (quotation, so, po), empty for today
+func transactionDate(args []string) (string, error) {
+	date := ""
+	for _, arg := range args {
+		if len(arg) > 19 && arg[:19] == "--transaction-date=" {
+			if _, err := time.Parse("2006-01-02", arg[19:]); err != nil {
+				return "", errorf("invalid transaction date (expected YYYY-MM-DD): %s", arg[19:])
+			}
+			date = arg[19:]
+		}
+	}
+	return date, nil
+}
+
+// documentDate returns the date of a new document: today, or a date given
+// with a flag once it passes checkPeriod
+func (c *Client) documentDate(date string) (string, error) {
+	if date == "" {
+		return c.Today(), nil
+	}
+	if err := c.checkPeriod(date); err != nil {
+		return "", err
+	}
+	return date, nil
+}
+
+// checkPeriod fails for a date outside every open fiscal year or inside
+// the frozen accounting period, before ERPNext rejects the document with a
+// less helpful message. Only explicit dates are checked.
+func (c *Client) checkPeriod(date string) error {
+	filters, err := encodeFilters([][]interface{}{
+		{"year_start_date", "<=", date},
+		{"year_end_date", ">=", date},
+		{"disabled", "=", 0},
+	})
+	if err != nil {
+		return err
+	}
+	result, err := c.Request("GET", "Fiscal%20Year?fields=[\"name\"]&filters="+filters, nil)
+	if err != nil {
+		return err
+	}
+	if data, _ := result["data"].([]interface{}); len(data) == 0 {
+		return errorf("%s is not in an open fiscal year (see 'erp-cli fiscal-year list')", date)
+	}
+
+	if frozen := c.frozenUpto(); frozen != "" && date <= frozen {
+		return errorf("%s is in the frozen period (accounts are frozen up to %s)", date, frozen)
+	}
+	return nil
+}
+
+// frozenUpto returns the "Accounts Frozen Till Date" of Accounts Settings,
+// empty when nothing is frozen or it cannot be read
+func (c *Client) frozenUpto() string {
+	result, err := c.CallMethod("GET", "frappe.client.get_single_value?doctype=Accounts%20Settings&field=acc_frozen_upto", nil)
+	if err != nil {
+		return ""
+	}
+	frozen, _ := result["message"].(string)
+	return frozen
+}
+
+// CmdFiscalYear handles Fiscal Year commands
+func (c *Client) CmdFiscalYear(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli fiscal-year <subcommand>"))
+		fmt.Println(T("Subcommands: list"))
+		fmt.Println()
+		fmt.Println(T("Documents can be dated with --posting-date (invoices, receipts, deliveries,"))
+		fmt.Println(T("payments, stock and journal entries) or --transaction-date (quotations and"))
+		fmt.Println(T("orders) within an open fiscal year and after the frozen period."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli fiscal-year list"))
+		fmt.Println(T("  erp-cli si create-from-so SAL-ORD-2025-00001 --posting-date=2026-09-30"))
+		fmt.Println(T("  erp-cli so create \"Acme Corp\" --transaction-date=2026-09-30"))
+		return nil
+	}
+
+	switch args[0] {
+	case "list":
+		return c.fiscalYearList()
+	default:
+		return errorf("unknown fiscal-year subcommand: %s", args[0])
+	}
+}
+
+// fiscalYearList lists the fiscal years, marking the current one, and the
+// frozen period
+func (c *Client) fiscalYearList() error {
+	printf("%sFetching fiscal years...%s\n", Blue, Reset)
+
+	result, err := c.Request("GET", "Fiscal%20Year?"+c.pageLimit(0)+"&fields=[\"name\",\"year_start_date\",\"year_end_date\",\"disabled\"]&order_by=year_start_date%20desc", nil)
+	if err != nil {
+		return err
+	}
+
+	data, _ := result["data"].([]interface{})
+	if len(data) == 0 {
+		printf("%sNo fiscal years found%s\n", Yellow, Reset)
+		return nil
+	}
+
+	today := c.Today()
+	printf("\n%sFiscal Years (%d):%s\n", Cyan, len(data), Reset)
+	c.printPageNote(len(data))
+	for _, row := range data {
+		m, ok := row.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		start, end := stringField(m, "year_start_date"), stringField(m, "year_end_date")
+		status := Green + "Open" + Reset
+		if disabled, _ := m["disabled"].(float64); disabled == 1 {
+			status = Red + "Disabled" + Reset
+		}
+		current := ""
+		if start <= today && today <= end {
+			current = " (current)"
+		}
+		printf("  %s: %s to %s | %s%s\n", stringField(m, "name"), start, end, status, current)
+	}
+
+	if frozen := c.frozenUpto(); frozen != "" {
+		printf("\n  Accounts frozen up to: %s%s%s\n", Yellow, frozen, Reset)
+	}
+	return nil
+}
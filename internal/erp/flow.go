@@ -0,0 +1,477 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// flowItem is an --item line of a flow
+type flowItem struct {
+	code string
+	qty  float64
+	rate float64 // Zero lets the price list decide
+}
+
+// flowDoc is a document a flow created
+type flowDoc struct {
+	doctype   string
+	name      string
+	submitted bool
+}
+
+// flowStep creates and submits one document of a flow
+type flowStep struct {
+	doctype string
+	note    string // Shown after the doctype in the plan
+	build   func(done []flowDoc) (map[string]interface{}, error)
+	check   func(name string) error // Optional, runs before submitting
+	notify  bool                    // Email the customer once the flow is complete
+}
+
+// CmdFlow runs a whole document chain in one command
+func (c *Client) CmdFlow(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli flow <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: sell, buy"))
+		fmt.Println()
+		fmt.Println(T("sell --customer=X --item CODE:QTY[:RATE] ... [--deliver] [--invoice] [--collect]"))
+		fmt.Println(T("  Sales Order, then Delivery Note, Sales Invoice and Payment Entry as asked"))
+		fmt.Println(T("  (--collect implies --invoice). Also --posting-date=YYYY-MM-DD, --force"))
+		fmt.Println(T("  (submit over the credit limit, as so submit --force), --notify|--no-notify"))
+		fmt.Println(T("  (email the order and invoice, as their submit commands do) and --yes."))
+		fmt.Println(T("buy --supplier=X --item CODE:QTY:RATE ... [--receive] [--bill] [--pay]"))
+		fmt.Println(T("  Purchase Order, then Purchase Receipt, Purchase Invoice and Payment Entry"))
+		fmt.Println(T("  as asked (--pay implies --bill). Also --posting-date=YYYY-MM-DD and --yes."))
+		fmt.Println()
+		fmt.Println(T("Every document is created and submitted after a single confirmation. If a"))
+		fmt.Println(T("step fails, the documents already created are cancelled and deleted."))
+		fmt.Println(T("--set field=value fills the first document; --set \"si:field=value\" (or any"))
+		fmt.Println(T("doctype of the flow) the others."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli flow sell --customer=\"Acme Corp\" --item CPU-I7:2 --item RAM-16:4:45 --deliver --invoice --collect"))
+		fmt.Println(T("  erp-cli flow sell --customer=\"Acme Corp\" --item CPU-I7:1 --invoice --yes"))
+		fmt.Println(T("  erp-cli flow buy --supplier=\"Intel\" --item CPU-I7:10:250 --receive --bill --pay"))
+		return nil
+	}
+
+	switch args[0] {
+	case "sell":
+		return c.flowSell(args[1:])
+	case "buy":
+		return c.flowBuy(args[1:])
+	default:
+		return errorf("unknown flow subcommand: %s", args[0])
+	}
+}
+
+// parseFlowItems reads --item CODE:QTY[:RATE] (repeatable, also --item=...)
+func parseFlowItems(args []string) ([]flowItem, error) {
+	var items []flowItem
+	for i := 0; i < len(args); i++ {
+		spec := ""
+		switch {
+		case args[i] == "--item":
+			if i+1 >= len(args) {
+				return nil, errorf("--item needs CODE:QTY")
+			}
+			spec = args[i+1]
+			i++
+		case len(args[i]) > 7 && args[i][:7] == "--item=":
+			spec = args[i][7:]
+		default:
+			continue
+		}
+
+		parts := strings.Split(spec, ":")
+		if len(parts) < 2 || len(parts) > 3 || parts[0] == "" {
+			return nil, errorf("invalid item %q (expected CODE:QTY or CODE:QTY:RATE)", spec)
+		}
+		item := flowItem{code: parts[0]}
+		qty, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil || qty <= 0 {
+			return nil, errorf("invalid quantity in item: %s", spec)
+		}
+		item.qty = qty
+		if len(parts) == 3 {
+			rate, err := strconv.ParseFloat(parts[2], 64)
+			if err != nil || rate < 0 {
+				return nil, errorf("invalid rate in item: %s", spec)
+			}
+			item.rate = rate
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// flowName returns the name of the document of a doctype a flow created
+func flowName(done []flowDoc, doctype string) string {
+	for _, doc := range done {
+		if doc.doctype == doctype {
+			return doc.name
+		}
+	}
+	return ""
+}
+
+// printFlowItems lists the lines of a flow in its plan
+func (c *Client) printFlowItems(items []flowItem) {
+	printf("  %sItems:%s\n", Yellow, Reset)
+	for _, item := range items {
+		line := fmt.Sprintf("    %s x %s", item.code, formatQty(item.qty))
+		if item.rate > 0 {
+			line += " @ " + c.FormatCurrency(item.rate)
+		}
+		fmt.Println(line)
+	}
+}
+
+func (c *Client) flowSell(args []string) error {
+	customer := ""
+	deliver, invoice, collect, force, yes := false, false, false, false, false
+	for _, arg := range args {
+		switch {
+		case len(arg) > 11 && arg[:11] == "--customer=":
+			customer = arg[11:]
+		case arg == "--deliver":
+			deliver = true
+		case arg == "--invoice":
+			invoice = true
+		case arg == "--collect":
+			collect = true
+		case arg == "--force":
+			force = true
+		case arg == "--yes" || arg == "-y":
+			yes = true
+		}
+	}
+	if customer == "" {
+		return errorf("usage: erp-cli flow sell --customer=X --item CODE:QTY[:RATE] ... [--deliver] [--invoice] [--collect]")
+	}
+	items, err := parseFlowItems(args)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return errorf("at least one --item CODE:QTY is required")
+	}
+	posting, err := parsePostingOptions(args)
+	if err != nil {
+		return err
+	}
+	invoice = invoice || collect
+
+	// The credit check of so submit, --force only warns
+	checkCredit := func(name string) error {
+		err := c.checkOrderCredit(name)
+		if err != nil && force {
+			printf("%sForced: %s%s\n", Yellow, err, Reset)
+			return nil
+		}
+		return err
+	}
+
+	steps := []flowStep{{
+		doctype: "Sales Order",
+		build: func([]flowDoc) (map[string]interface{}, error) {
+			return c.flowSalesOrder(customer, items, posting.date)
+		},
+		check:  checkCredit,
+		notify: c.notifyOnSubmit("Sales Order", args),
+	}}
+	if deliver {
+		steps = append(steps, flowStep{
+			doctype: "Delivery Note",
+			build: func(done []flowDoc) (map[string]interface{}, error) {
+				return c.dnFromSO(flowName(done, "Sales Order"), posting)
+			},
+		})
+	}
+	if invoice {
+		steps = append(steps, flowStep{
+			doctype: "Sales Invoice",
+			build: func(done []flowDoc) (map[string]interface{}, error) {
+				return c.siFromSO(flowName(done, "Sales Order"), nil, posting)
+			},
+			notify: c.notifyOnSubmit("Sales Invoice", args),
+		})
+	}
+	if collect {
+		steps = append(steps, flowStep{
+			doctype: "Payment Entry",
+			note:    " (full outstanding amount)",
+			build: func(done []flowDoc) (map[string]interface{}, error) {
+				return c.paymentFromInvoice(flowName(done, "Sales Invoice"), 0, "Receive", posting)
+			},
+		})
+	}
+
+	printf("\n%sSell to %s%s\n", Cyan, customer, Reset)
+	c.printPosting(posting)
+	c.printFlowItems(items)
+	return c.runFlow(steps, yes)
+}
+
+// flowSalesOrder builds a Sales Order with all the lines of the flow
+func (c *Client) flowSalesOrder(customer string, items []flowItem, postingDate string) (map[string]interface{}, error) {
+	company, err := c.GetCompany()
+	if err != nil {
+		return nil, err
+	}
+	// The orders of a backdated flow share its posting date
+	date, err := c.documentDate(postingDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []map[string]interface{}
+	for _, item := range items {
+		// Accept the customer's own item code, as so add-item does
+		code, customerCode, err := c.resolveCustomerItemCode(customer, item.code)
+		if err != nil {
+			return nil, err
+		}
+		row := map[string]interface{}{
+			"item_code":     code,
+			"qty":           item.qty,
+			"delivery_date": date,
+		}
+		if customerCode != "" {
+			row["customer_item_code"] = customerCode
+		}
+		if item.rate > 0 {
+			row["rate"] = item.rate
+		}
+		rows = append(rows, row)
+	}
+
+	return map[string]interface{}{
+		"customer":         customer,
+		"transaction_date": date,
+		"delivery_date":    date,
+		"company":          company,
+		"items":            rows,
+	}, nil
+}
+
+func (c *Client) flowBuy(args []string) error {
+	supplier := ""
+	receive, bill, pay, yes := false, false, false, false
+	for _, arg := range args {
+		switch {
+		case len(arg) > 11 && arg[:11] == "--supplier=":
+			supplier = arg[11:]
+		case arg == "--receive":
+			receive = true
+		case arg == "--bill":
+			bill = true
+		case arg == "--pay":
+			pay = true
+		case arg == "--yes" || arg == "-y":
+			yes = true
+		}
+	}
+	if supplier == "" {
+		return errorf("usage: erp-cli flow buy --supplier=X --item CODE:QTY:RATE ... [--receive] [--bill] [--pay]")
+	}
+	items, err := parseFlowItems(args)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return errorf("at least one --item CODE:QTY:RATE is required")
+	}
+	posting, err := parsePostingOptions(args)
+	if err != nil {
+		return err
+	}
+	bill = bill || pay
+
+	steps := []flowStep{{
+		doctype: "Purchase Order",
+		build: func([]flowDoc) (map[string]interface{}, error) {
+			return c.flowPurchaseOrder(supplier, items, posting.date)
+		},
+	}}
+	if receive {
+		steps = append(steps, flowStep{
+			doctype: "Purchase Receipt",
+			build: func(done []flowDoc) (map[string]interface{}, error) {
+				return c.prFromPO(flowName(done, "Purchase Order"), posting)
+			},
+		})
+	}
+	if bill {
+		steps = append(steps, flowStep{
+			doctype: "Purchase Invoice",
+			build: func(done []flowDoc) (map[string]interface{}, error) {
+				return c.piFromPO(flowName(done, "Purchase Order"), posting)
+			},
+		})
+	}
+	if pay {
+		steps = append(steps, flowStep{
+			doctype: "Payment Entry",
+			note:    " (full outstanding amount)",
+			build: func(done []flowDoc) (map[string]interface{}, error) {
+				return c.paymentFromInvoice(flowName(done, "Purchase Invoice"), 0, "Pay", posting)
+			},
+		})
+	}
+
+	printf("\n%sBuy from %s%s\n", Cyan, supplier, Reset)
+	c.printPosting(posting)
+	c.printFlowItems(items)
+	return c.runFlow(steps, yes)
+}
+
+// flowPurchaseOrder builds a Purchase Order with all the lines of the flow
+func (c *Client) flowPurchaseOrder(supplier string, items []flowItem, postingDate string) (map[string]interface{}, error) {
+	company, err := c.GetCompany()
+	if err != nil {
+		return nil, err
+	}
+	// The orders of a backdated flow share its posting date
+	date, err := c.documentDate(postingDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []map[string]interface{}
+	for _, item := range items {
+		row := map[string]interface{}{
+			"item_code":     item.code,
+			"qty":           item.qty,
+			"schedule_date": date,
+		}
+		if item.rate > 0 {
+			row["rate"] = item.rate
+		}
+		rows = append(rows, row)
+	}
+
+	return map[string]interface{}{
+		"supplier":         supplier,
+		"transaction_date": date,
+		"schedule_date":    date,
+		"company":          company,
+		"items":            rows,
+	}, nil
+}
+
+// runFlow shows the plan, asks once and then creates and submits each step
+// in turn. A failed step rolls back the documents created before it.
+func (c *Client) runFlow(steps []flowStep, yes bool) error {
+	printf("  %sSteps:%s\n", Yellow, Reset)
+	for i, step := range steps {
+		fmt.Printf("    %d. %s%s\n", i+1, step.doctype, T(step.note))
+	}
+	fmt.Println()
+
+	if !yes && !confirm(tf("Create and submit %d documents?", len(steps))) {
+		printf("%sAborted%s\n", Yellow, Reset)
+		return nil
+	}
+
+	var done []flowDoc
+	for i, step := range steps {
+		fmt.Printf("%s[%d/%d] %s...%s\n", Blue, i+1, len(steps), step.doctype, Reset)
+		doc, err := c.runFlowStep(step, done)
+		if doc.name != "" {
+			done = append(done, doc)
+		}
+		if err != nil {
+			fmt.Printf("%s✗ %s: %s%s\n", Red, step.doctype, err, Reset)
+			c.rollbackFlow(done)
+			return errorf("flow stopped at %s: %w", step.doctype, err)
+		}
+		printf("%s✓ %s submitted: %s%s\n", Green, doc.doctype, doc.name, Reset)
+	}
+
+	printf("\n%sFlow complete:%s\n", Cyan, Reset)
+	for _, doc := range done {
+		fmt.Printf("  %-16s %s\n", doc.doctype+":", doc.name)
+	}
+
+	// Only once nothing can be rolled back, so no email goes out for a
+	// document that is then cancelled
+	for i, doc := range done {
+		if steps[i].notify {
+			c.notifyCustomer(doc.doctype, doc.name)
+		}
+	}
+	return nil
+}
+
+// runFlowStep creates and submits the document of one step. The returned
+// flowDoc has a name as soon as the document exists, even on failure.
+func (c *Client) runFlowStep(step flowStep, done []flowDoc) (flowDoc, error) {
+	doc := flowDoc{doctype: step.doctype}
+	body, err := step.build(done)
+	if err != nil {
+		return doc, err
+	}
+
+	result, err := c.Request("POST", url.PathEscape(step.doctype), body)
+	if err != nil {
+		return doc, err
+	}
+	created, _ := result["data"].(map[string]interface{})
+	doc.name = stringField(created, "name")
+	if doc.name == "" {
+		return doc, errorf("the server did not return the new document")
+	}
+
+	if step.check != nil {
+		if err := step.check(doc.name); err != nil {
+			return doc, err
+		}
+	}
+	if err := c.submitDocument(step.doctype, doc.name); err != nil {
+		return doc, err
+	}
+	doc.submitted = true
+	return doc, nil
+}
+
+// rollbackFlow undoes a failed flow, newest document first: submitted ones
+// are cancelled, then each one is deleted. Whatever cannot be undone is
+// listed for cleaning up by hand.
+func (c *Client) rollbackFlow(done []flowDoc) {
+	if len(done) == 0 {
+		return
+	}
+	printf("\n%sRolling back %d document(s)...%s\n", Yellow, len(done), Reset)
+
+	var left []string
+	for i := len(done) - 1; i >= 0; i-- {
+		doc := done[i]
+		if doc.submitted {
+			if err := c.cancelDocument(doc.doctype, doc.name); err != nil {
+				printf("%s✗ Cannot cancel %s %s: %s%s\n", Red, doc.doctype, doc.name, err, Reset)
+				left = append(left, tf("%s %s (submitted)", doc.doctype, doc.name))
+				continue
+			}
+		}
+		if _, err := c.Request("DELETE", url.PathEscape(doc.doctype)+"/"+url.PathEscape(doc.name), nil); err != nil {
+			printf("%s✗ Cannot delete %s %s: %s%s\n", Red, doc.doctype, doc.name, err, Reset)
+			state := "draft"
+			if doc.submitted {
+				state = "cancelled"
+			}
+			left = append(left, fmt.Sprintf("%s %s (%s)", doc.doctype, doc.name, state))
+			continue
+		}
+		printf("%s✓ Rolled back: %s %s%s\n", Green, doc.doctype, doc.name, Reset)
+	}
+
+	if len(left) > 0 {
+		printf("\n%sLeft behind (clean up by hand):%s\n", Red, Reset)
+		for _, doc := range left {
+			fmt.Printf("  %s\n", doc)
+		}
+	}
+}
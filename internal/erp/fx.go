@@ -0,0 +1,311 @@
+package erp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultFXProvider is the rate source of fx sync when ERP_FX_PROVIDER is
+// not set: the ECB reference rates served by Frankfurter. {date}, {base}
+// and {symbols} are filled in, and the reply must hold a "rates" object of
+// units per one base, the format Frankfurter and most free APIs share.
+const defaultFXProvider = "https://api.frankfurter.app/{date}?from={base}&to={symbols}"
+
+// CmdFX handles Currency Exchange commands
+func (c *Client) CmdFX(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli fx <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, set, sync"))
+		fmt.Println()
+		fmt.Println(T("Rates are Currency Exchange records, used by --currency on document"))
+		fmt.Println(T("creation. A rate is the units of <to> for one <from>."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli fx list"))
+		fmt.Println(T("  erp-cli fx list --from=EUR --date=2026-10-01"))
+		fmt.Println(T("  erp-cli fx set EUR USD 1.085"))
+		fmt.Println(T("  erp-cli fx set GBP USD 1.27 --date=2026-10-15"))
+		fmt.Println(T("  erp-cli fx sync                       (currencies from ERP_FX_CURRENCIES)"))
+		fmt.Println(T("  erp-cli fx sync --currencies=EUR,GBP --date=2026-10-15"))
+		return nil
+	}
+
+	switch args[0] {
+	case "list":
+		from, to, date := "", "", ""
+		for _, arg := range args[1:] {
+			switch {
+			case len(arg) > 7 && arg[:7] == "--from=":
+				from = strings.ToUpper(arg[7:])
+			case len(arg) > 5 && arg[:5] == "--to=":
+				to = strings.ToUpper(arg[5:])
+			case len(arg) > 7 && arg[:7] == "--date=":
+				date = arg[7:]
+			default:
+				return errorf("unknown argument: %s", arg)
+			}
+		}
+		return c.fxList(from, to, date)
+	case "set":
+		if len(args) < 4 {
+			return errorf("usage: erp-cli fx set <from> <to> <rate> [--date=YYYY-MM-DD]")
+		}
+		rate, err := strconv.ParseFloat(args[3], 64)
+		if err != nil || rate <= 0 {
+			return errorf("invalid rate: %s", args[3])
+		}
+		date, err := c.parseFXDate(args[4:])
+		if err != nil {
+			return err
+		}
+		return c.fxSet(strings.ToUpper(args[1]), strings.ToUpper(args[2]), rate, date)
+	case "sync":
+		date, err := c.parseFXDate(args[1:])
+		if err != nil {
+			return err
+		}
+		currencies := c.Config.FXCurrencies
+		for _, arg := range args[1:] {
+			if len(arg) > 13 && arg[:13] == "--currencies=" {
+				currencies = splitCurrencies(arg[13:])
+			}
+		}
+		if len(currencies) == 0 {
+			return errorf("no currencies to sync (set ERP_FX_CURRENCIES or pass --currencies=EUR,GBP)")
+		}
+		return c.fxSync(currencies, date)
+	default:
+		return errorf("unknown fx subcommand: %s", args[0])
+	}
+}
+
+// parseFXDate returns the --date of fx set and sync, today by default
+func (c *Client) parseFXDate(args []string) (string, error) {
+	date := c.Today()
+	for _, arg := range args {
+		if len(arg) > 7 && arg[:7] == "--date=" {
+			date = arg[7:]
+			if _, err := time.Parse("2006-01-02", date); err != nil {
+				return "", errorf("invalid date (expected YYYY-MM-DD): %s", date)
+			}
+		}
+	}
+	return date, nil
+}
+
+// splitCurrencies parses a comma-separated list of currency codes
+func splitCurrencies(value string) []string {
+	var codes []string
+	for _, code := range strings.Split(value, ",") {
+		if code = strings.ToUpper(strings.TrimSpace(code)); code != "" {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
+
+// fxList lists Currency Exchange records, newest first. With --date it
+// shows the rates in force on that day.
+func (c *Client) fxList(from, to, date string) error {
+	printf("%sFetching exchange rates...%s\n", Blue, Reset)
+
+	var filters [][]interface{}
+	if from != "" {
+		filters = append(filters, []interface{}{"from_currency", "=", from})
+	}
+	if to != "" {
+		filters = append(filters, []interface{}{"to_currency", "=", to})
+	}
+	if date != "" {
+		filters = append(filters, []interface{}{"date", "<=", date})
+	}
+	endpoint := "Currency%20Exchange?" + c.pageLimit(0) + "&fields=[\"name\",\"date\",\"from_currency\",\"to_currency\",\"exchange_rate\"]&order_by=date%20desc"
+	if len(filters) > 0 {
+		encoded, err := encodeFilters(filters)
+		if err != nil {
+			return err
+		}
+		endpoint += "&filters=" + encoded
+	}
+
+	result, err := c.Request("GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	data, _ := result["data"].([]interface{})
+	var rows []map[string]interface{}
+	seen := map[string]bool{}
+	for _, row := range data {
+		m, ok := row.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		// The rate in force on a date is the latest one of its pair
+		pair := stringField(m, "from_currency") + "/" + stringField(m, "to_currency")
+		if date != "" && seen[pair] {
+			continue
+		}
+		seen[pair] = true
+		rows = append(rows, m)
+	}
+	if len(rows) == 0 {
+		printf("%sNo exchange rates found%s\n", Yellow, Reset)
+		return nil
+	}
+
+	title := T("Exchange rates")
+	if date != "" {
+		title = tf("Exchange rates on %s", date)
+	}
+	fmt.Printf("\n%s%s (%d):%s\n", Cyan, title, len(rows), Reset)
+	c.printPageNote(len(data))
+	for _, m := range rows {
+		rate, _ := m["exchange_rate"].(float64)
+		fmt.Printf("  %s  %s%s/%s%s  %g\n", stringField(m, "date"), Green, stringField(m, "from_currency"), stringField(m, "to_currency"), Reset, rate)
+	}
+	return nil
+}
+
+// fxSet records the rate of a currency pair on a date, updating the
+// record of that day when there is one
+func (c *Client) fxSet(from, to string, rate float64, date string) error {
+	if from == to {
+		return errorf("from and to are the same currency")
+	}
+	updated, err := c.saveExchangeRate(from, to, rate, date)
+	if err != nil {
+		return err
+	}
+	verb := "set"
+	if updated {
+		verb = "updated"
+	}
+	printf("%s✓ Exchange rate %s: 1 %s = %g %s on %s%s\n", Green, verb, from, rate, to, date, Reset)
+	return nil
+}
+
+// saveExchangeRate creates or updates the Currency Exchange record of a
+// pair and date, reporting whether it was an update
+func (c *Client) saveExchangeRate(from, to string, rate float64, date string) (bool, error) {
+	filters, err := encodeFilters([][]interface{}{
+		{"from_currency", "=", from},
+		{"to_currency", "=", to},
+		{"date", "=", date},
+	})
+	if err != nil {
+		return false, err
+	}
+	result, err := c.Request("GET", "Currency%20Exchange?fields=[\"name\"]&filters="+filters, nil)
+	if err != nil {
+		return false, err
+	}
+	if data, _ := result["data"].([]interface{}); len(data) > 0 {
+		existing, _ := data[0].(map[string]interface{})
+		_, err := c.Request("PUT", "Currency%20Exchange/"+url.PathEscape(stringField(existing, "name")), map[string]interface{}{
+			"exchange_rate": rate,
+		})
+		return true, err
+	}
+
+	_, err = c.Request("POST", "Currency%20Exchange", map[string]interface{}{
+		"date":          date,
+		"from_currency": from,
+		"to_currency":   to,
+		"exchange_rate": rate,
+		"for_buying":    1,
+		"for_selling":   1,
+	})
+	return false, err
+}
+
+// fxSync fetches the rates of the given currencies against the company
+// currency from the provider and records them for date
+func (c *Client) fxSync(currencies []string, date string) error {
+	company, err := c.GetCurrency()
+	if err != nil {
+		return err
+	}
+	var symbols []string
+	for _, code := range currencies {
+		if code != company.Code {
+			symbols = append(symbols, code)
+		}
+	}
+	if len(symbols) == 0 {
+		return errorf("nothing to sync: %s is the company currency", company.Code)
+	}
+
+	provider := c.Config.FXProvider
+	if provider == "" {
+		provider = defaultFXProvider
+	}
+	printf("%sFetching %s rates for %s...%s\n", Blue, company.Code, date, Reset)
+
+	rates, err := c.fetchProviderRates(provider, company.Code, symbols, date)
+	if err != nil {
+		return err
+	}
+
+	// Records go from the foreign currency to the company's, the direction
+	// documents look them up in
+	saved := 0
+	for _, code := range symbols {
+		perBase, ok := rates[code]
+		if !ok || perBase <= 0 {
+			printf("  %s✗ %s: not offered by the provider%s\n", Red, code, Reset)
+			continue
+		}
+		rate := 1 / perBase
+		if _, err := c.saveExchangeRate(code, company.Code, rate, date); err != nil {
+			fmt.Printf("  %s✗ %s: %v%s\n", Red, code, err, Reset)
+			continue
+		}
+		fmt.Printf("  %s✓ 1 %s = %.6g %s%s\n", Green, code, rate, company.Code, Reset)
+		saved++
+	}
+
+	printf("%s✓ %d of %d rates saved%s\n", Green, saved, len(symbols), Reset)
+	if saved < len(symbols) {
+		return errorf("%d rates could not be synced", len(symbols)-saved)
+	}
+	return nil
+}
+
+// fetchProviderRates calls the rate provider URL template and returns its
+// rates of each symbol per one base
+func (c *Client) fetchProviderRates(provider, base string, symbols []string, date string) (map[string]float64, error) {
+	endpoint := strings.NewReplacer(
+		"{date}", date,
+		"{base}", base,
+		"{symbols}", strings.Join(symbols, ","),
+	).Replace(provider)
+
+	// The provider is not the ERPNext server, so no credentials are sent
+	resp, err := c.HTTPClient.Get(endpoint)
+	if err != nil {
+		return nil, errorf("rate provider: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errorf("rate provider: %w", err)
+	}
+	if resp.StatusCode != 200 {
+		return nil, errorf("rate provider returned %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var reply struct {
+		Rates map[string]float64 `json:"rates"`
+	}
+	if err := json.Unmarshal(body, &reply); err != nil || reply.Rates == nil {
+		return nil, errorf("rate provider reply has no \"rates\" object (see ERP_FX_PROVIDER)")
+	}
+	return reply.Rates, nil
+}
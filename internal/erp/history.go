@@ -0,0 +1,247 @@
+package erp
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// defaultHistoryLimit is how many versions history shows without --limit
+const defaultHistoryLimit = 20
+
+// versionChange is one field changed by a save
+type versionChange struct {
+	field    string
+	old, new interface{}
+}
+
+// docVersion is one saved change of a document, from a Version record
+type docVersion struct {
+	by      string
+	created string
+	lines   []string // Field-by-field diff, see versionLines
+}
+
+// versionData is the JSON Frappe stores in Version.data
+type versionData struct {
+	Changed    [][]interface{} `json:"changed"`     // [field, old, new]
+	Added      [][]interface{} `json:"added"`       // [table, row]
+	Removed    [][]interface{} `json:"removed"`     // [table, row]
+	RowChanged [][]interface{} `json:"row_changed"` // [table, index, row name, [[field, old, new]]]
+}
+
+// CmdHistory shows who changed what on a document, or without one the
+// commands run from this machine (see cmdhistory.go)
+func (c *Client) CmdHistory(args []string) error {
+	if len(args) == 0 || strings.HasPrefix(args[0], "--") {
+		return c.commandHistory(args)
+	}
+	if len(args) < 2 {
+		fmt.Println(T("Usage: erp-cli history [--limit=N] [--last]"))
+		fmt.Println(T("       erp-cli history <doctype> <name> [--limit=N]"))
+		fmt.Println()
+		fmt.Println(T("Without a document, lists the commands run from this machine and the"))
+		fmt.Println(T("documents they created (--last prints only the last created name)."))
+		fmt.Println(T("With one, shows its saved versions, newest first, with the user, the"))
+		fmt.Println(T("time and every field that changed."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli history"))
+		fmt.Println(T("  erp-cli so submit $(erp-cli history --last)"))
+		fmt.Println(T("  erp-cli history po PUR-ORD-2025-00001"))
+		fmt.Println(T("  erp-cli history Item CPU-I7 --limit=5"))
+		return nil
+	}
+
+	limit := defaultHistoryLimit
+	for _, arg := range args[2:] {
+		if len(arg) > 8 && arg[:8] == "--limit=" {
+			n, err := strconv.Atoi(arg[8:])
+			if err != nil || n <= 0 {
+				return errorf("invalid limit: %s", arg[8:])
+			}
+			limit = n
+		}
+	}
+
+	doctype := resolveDoctype(args[0])
+	name := args[1]
+	printf("%sFetching history of %s %s...%s\n", Blue, doctype, name, Reset)
+
+	versions, err := c.fetchVersions(doctype, name, limit)
+	if err != nil {
+		return err
+	}
+	if len(versions) == 0 {
+		printf("%sNo changes recorded for %s %s (is Track Changes enabled for %s?)%s\n", Yellow, doctype, name, doctype, Reset)
+		return nil
+	}
+
+	printf("\n%sHistory of %s %s:%s\n", Cyan, doctype, name, Reset)
+	for _, v := range versions {
+		fmt.Printf("\n  %s%s%s  %s\n", Yellow, v.created, Reset, v.by)
+		for _, line := range v.lines {
+			fmt.Printf("    %s\n", line)
+		}
+	}
+	if len(versions) == limit {
+		printf("\n%sShowing the latest %d changes (use --limit=N for more)%s\n", Cyan, limit, Reset)
+	}
+	return nil
+}
+
+// fetchVersions returns the latest versions of a document, newest first.
+// Versions that change no field are skipped, so it pages through them
+// until it has the limit or runs out.
+func (c *Client) fetchVersions(doctype, name string, limit int) ([]docVersion, error) {
+	filters, err := encodeFilters([][]interface{}{
+		{"ref_doctype", "=", doctype},
+		{"docname", "=", name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []docVersion
+	for start := 0; len(versions) < limit; start += limit {
+		result, err := c.Request("GET", fmt.Sprintf("Version?limit_start=%d&limit_page_length=%d&fields=[\"owner\",\"creation\",\"data\"]&order_by=creation%%20desc&filters=%s", start, limit, filters), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		data, _ := result["data"].([]interface{})
+		for _, item := range data {
+			if len(versions) == limit {
+				break
+			}
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			var diff versionData
+			if err := json.Unmarshal([]byte(stringField(m, "data")), &diff); err != nil {
+				continue // Not a field diff (e.g. a comment-only version)
+			}
+			lines := versionLines(diff)
+			if len(lines) == 0 {
+				continue
+			}
+			created := stringField(m, "creation")
+			if len(created) > 19 {
+				created = created[:19]
+			}
+			versions = append(versions, docVersion{
+				by:      stringField(m, "owner"),
+				created: created,
+				lines:   lines,
+			})
+		}
+		if len(data) < limit {
+			break
+		}
+	}
+	return versions, nil
+}
+
+// versionLines renders a Version diff as plain text lines
+func versionLines(diff versionData) []string {
+	var lines []string
+	for _, ch := range parseVersionChanges(diff.Changed) {
+		lines = append(lines, formatVersionChange(ch))
+	}
+	for _, row := range diff.Added {
+		if len(row) == 2 {
+			lines = append(lines, tf("+ %v row: %s", row[0], versionRowLabel(row[1])))
+		}
+	}
+	for _, row := range diff.Removed {
+		if len(row) == 2 {
+			lines = append(lines, tf("- %v row: %s", row[0], versionRowLabel(row[1])))
+		}
+	}
+	for _, row := range diff.RowChanged {
+		if len(row) != 4 {
+			continue
+		}
+		changes, _ := row[3].([]interface{})
+		rowChanges := make([][]interface{}, 0, len(changes))
+		for _, ch := range changes {
+			if fields, ok := ch.([]interface{}); ok {
+				rowChanges = append(rowChanges, fields)
+			}
+		}
+		index, _ := row[1].(float64)
+		for _, ch := range parseVersionChanges(rowChanges) {
+			lines = append(lines, tf("%v row %d: %s", row[0], int(index)+1, formatVersionChange(ch)))
+		}
+	}
+	return lines
+}
+
+// parseVersionChanges reads [field, old, new] triples
+func parseVersionChanges(raw [][]interface{}) []versionChange {
+	var changes []versionChange
+	for _, ch := range raw {
+		if len(ch) != 3 {
+			continue
+		}
+		changes = append(changes, versionChange{field: fmt.Sprintf("%v", ch[0]), old: ch[1], new: ch[2]})
+	}
+	return changes
+}
+
+// formatVersionChange renders field: old -> new, docstatus as its label
+func formatVersionChange(ch versionChange) string {
+	if ch.field == "docstatus" {
+		oldStatus, _ := ch.old.(float64)
+		newStatus, _ := ch.new.(float64)
+		oldLabel, _ := docStatusLabel(oldStatus)
+		newLabel, _ := docStatusLabel(newStatus)
+		return tf("docstatus: %s -> %s", oldLabel, newLabel)
+	}
+	return fmt.Sprintf("%s: %s -> %s", ch.field, versionValue(ch.old), versionValue(ch.new))
+}
+
+// versionValue renders a changed value on one line
+func versionValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return "(empty)"
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case string:
+		if val == "" {
+			return "(empty)"
+		}
+		val = strings.Join(strings.Fields(htmlToText(val)), " ")
+		if runes := []rune(val); len(runes) > 60 {
+			val = string(runes[:57]) + "..."
+		}
+		return val
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// versionRowLabel names an added or removed child row by its most telling
+// field
+func versionRowLabel(row interface{}) string {
+	m, ok := row.(map[string]interface{})
+	if !ok {
+		return fmt.Sprintf("%v", row)
+	}
+	label := ""
+	for _, field := range []string{"item_code", "account", "reference_name", "sales_person", "name"} {
+		if s := stringField(m, field); s != "" {
+			label = s
+			break
+		}
+	}
+	if qty, ok := m["qty"].(float64); ok {
+		label += fmt.Sprintf(" x %s", strconv.FormatFloat(qty, 'f', -1, 64))
+	}
+	if rate, ok := m["rate"].(float64); ok {
+		label += fmt.Sprintf(" @ %s", strconv.FormatFloat(rate, 'f', -1, 64))
+	}
+	return strings.TrimSpace(label)
+}
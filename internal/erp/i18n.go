@@ -0,0 +1,115 @@
+package erp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Message catalogs: user-facing text (command output, errors, the TUI) is
+// written in English in the code and looked up in the catalog of the
+// language picked with --lang or ERP_LANG. Catalogs are keyed by the English
+// text; text missing from one is shown in English, so a catalog can grow a
+// screen at a time.
+
+// languages are the supported languages and their catalogs (English, the
+// language of the code, needs none)
+var languages = map[string]map[string]string{
+	"en": nil,
+	"es": spanishMessages,
+}
+
+// catalog is the catalog in use, nil for English
+var catalog map[string]string
+
+// SetLanguage picks the language of the CLI and TUI text. Codes may carry a
+// region or encoding (es_ES.UTF-8); an empty code keeps English.
+func SetLanguage(code string) error {
+	code = strings.ToLower(strings.TrimSpace(code))
+	if i := strings.IndexAny(code, "_-."); i >= 0 {
+		code = code[:i]
+	}
+	if code == "" {
+		catalog = nil
+		return nil
+	}
+	messages, ok := languages[code]
+	if !ok {
+		var codes []string
+		for known := range languages {
+			codes = append(codes, known)
+		}
+		sort.Strings(codes)
+		return fmt.Errorf("unsupported language %q (use %s)", code, strings.Join(codes, ", "))
+	}
+	catalog = messages
+	return nil
+}
+
+// T returns text in the language picked, or as given without a
+// translation. Spaces and newlines around the text are kept, so "  Name:\n"
+// is looked up as "Name:".
+func T(text string) string {
+	if translated, ok := lookup(text); ok {
+		return translated
+	}
+	return text
+}
+
+// lookup finds text in the catalog, keeping its surrounding whitespace
+func lookup(text string) (string, bool) {
+	if catalog == nil {
+		return "", false
+	}
+	if translated, ok := catalog[text]; ok {
+		return translated, true
+	}
+	core := strings.TrimSpace(text)
+	if core == "" || core == text {
+		return "", false
+	}
+	translated, ok := catalog[core]
+	if !ok {
+		return "", false
+	}
+	start := strings.Index(text, core)
+	return text[:start] + translated + text[start+len(core):], true
+}
+
+// tf formats with a translated format string
+func tf(format string, args ...interface{}) string {
+	if translated, ok := lookup(format); ok {
+		return fmt.Sprintf(translated, args...)
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// printf prints with a translated format string
+func printf(format string, args ...interface{}) {
+	fmt.Print(tf(format, args...))
+}
+
+// errorf is fmt.Errorf with a translated format string
+func errorf(format string, args ...interface{}) error {
+	if translated, ok := lookup(format); ok {
+		return fmt.Errorf(translated, args...)
+	}
+	return fmt.Errorf(format, args...)
+}
+
+// trHelp translates the actions of a key help line ("key: action • ..."),
+// leaving the keys as they are
+func trHelp(help string) string {
+	if catalog == nil {
+		return help
+	}
+	hints := strings.Split(help, " • ")
+	for i, hint := range hints {
+		if key, action, ok := strings.Cut(hint, ": "); ok {
+			hints[i] = key + ": " + T(action)
+		} else {
+			hints[i] = T(hint)
+		}
+	}
+	return strings.Join(hints, " • ")
+}
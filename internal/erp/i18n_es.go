@@ -0,0 +1,3276 @@
+package erp
+
+// spanishMessages is the Spanish catalog
+var spanishMessages = map[string]string{
+	// Errors and notices
+	"Error":                "Error",
+	"Unknown command: %s":  "Comando desconocido: %s",
+	"Warnings:":            "Avisos:",
+	"Loading...":           "Cargando...",
+	"Loading dashboard...": "Cargando panel...",
+	"Initializing...":      "Iniciando...",
+	"No data":              "Sin datos",
+	"No data available":    "No hay datos disponibles",
+	"Recently created":     "Creados recientemente",
+	"refreshed %s":         "actualizado %s",
+	"%d new (ctrl+n)":      "%d nuevas (ctrl+n)",
+	"Unknown panel in ERP_DASHBOARD_PANELS: %s": "Panel desconocido en ERP_DASHBOARD_PANELS: %s",
+
+	// Main menu
+	"Main":                     "Inicio",
+	"Dashboard":                "Panel",
+	"Executive summary & KPIs": "Resumen ejecutivo e indicadores",
+	"Inventory":                "Inventario",
+	"Items, Templates, Groups, Brands, Attributes": "Artículos, plantillas, grupos, marcas, atributos",
+	"Stock": "Existencias",
+	"Warehouses, Stock Levels, Serial Numbers": "Almacenes, niveles de stock, números de serie",
+	"Sales": "Ventas",
+	"Customers, Quotations, Orders, Invoices, Delivery, Subscriptions": "Clientes, presupuestos, pedidos, facturas, entregas, suscripciones",
+	"Purchasing":                         "Compras",
+	"Suppliers, POs, Invoices, Receipts": "Proveedores, pedidos, facturas, recepciones",
+	"Payments":                           "Pagos",
+	"Receive & Pay invoices":             "Cobrar y pagar facturas",
+	"Manufacturing":                      "Fabricación",
+	"Work Orders, BOMs":                  "Órdenes de trabajo, listas de materiales",
+	"Inbox":                              "Bandeja de entrada",
+	"Quick notes to turn into documents": "Notas rápidas para convertir en documentos",
+	"Timesheet":                          "Parte de horas",
+	"Hours logged this week":             "Horas registradas esta semana",
+	"HR":                                 "Personal",
+	"Employees, Expense Claims":          "Empleados, notas de gastos",
+
+	// Submenus
+	"Items":                        "Artículos",
+	"View all items":               "Ver todos los artículos",
+	"Templates":                    "Plantillas",
+	"Item templates with variants": "Plantillas de artículo con variantes",
+	"Groups":                       "Grupos",
+	"Item groups hierarchy":        "Jerarquía de grupos de artículos",
+	"Brands":                       "Marcas",
+	"Brand management":             "Gestión de marcas",
+	"Attributes":                   "Atributos",
+	"Item attributes for variants": "Atributos de artículo para variantes",
+	"Warehouses":                   "Almacenes",
+	"View all warehouses":          "Ver todos los almacenes",
+	"Stock Levels":                 "Niveles de stock",
+	"Current stock & operations":   "Stock actual y operaciones",
+	"Serial Numbers":               "Números de serie",
+	"Track serialized items":       "Seguimiento de artículos con número de serie",
+	"Customers":                    "Clientes",
+	"Customer management":          "Gestión de clientes",
+	"Quotations":                   "Presupuestos",
+	"Sales quotations":             "Presupuestos de venta",
+	"Sales Orders":                 "Pedidos de venta",
+	"SO workflow":                  "Flujo de pedidos de venta",
+	"Sales Invoices":               "Facturas de venta",
+	"Customer invoices":            "Facturas a clientes",
+	"Delivery Notes":               "Albaranes de entrega",
+	"Shipments from SO":            "Envíos desde pedidos de venta",
+	"Subscriptions":                "Suscripciones",
+	"Recurring service billing":    "Facturación recurrente de servicios",
+	"Suppliers":                    "Proveedores",
+	"Supplier management":          "Gestión de proveedores",
+	"Purchase Orders":              "Pedidos de compra",
+	"PO workflow":                  "Flujo de pedidos de compra",
+	"Purchase Invoices":            "Facturas de compra",
+	"Supplier invoices":            "Facturas de proveedores",
+	"Purchase Receipts":            "Albaranes de compra",
+	"Goods received":               "Mercancía recibida",
+	"All Payments":                 "Todos los pagos",
+	"View all payment entries":     "Ver todas las entradas de pago",
+	"Work Orders":                  "Órdenes de trabajo",
+	"Start and finish production":  "Iniciar y terminar la producción",
+	"BOMs":                         "Listas de materiales",
+	"Bills of materials":           "Listas de materiales",
+	"Employees":                    "Empleados",
+	"Active employees":             "Empleados en activo",
+	"Expense Claims":               "Notas de gastos",
+	"Your expense claims":          "Tus notas de gastos",
+
+	// Key help
+	"navigate":                           "navegar",
+	"select":                             "elegir",
+	"go to":                              "ir a",
+	"go":                                 "ir",
+	"quit":                               "salir",
+	"back":                               "volver",
+	"back to document":                   "volver al documento",
+	"view detail":                        "ver detalle",
+	"detail":                             "detalle",
+	"new":                                "nuevo",
+	"new PO":                             "nuevo pedido",
+	"new from SO":                        "nuevo desde pedido de venta",
+	"new from PO":                        "nuevo desde pedido de compra",
+	"new work order":                     "nueva orden de trabajo",
+	"new attribute":                      "nuevo atributo",
+	"delete":                             "borrar",
+	"refresh":                            "actualizar",
+	"reload":                             "recargar",
+	"search":                             "buscar",
+	"type to search":                     "escribe para buscar",
+	"filter":                             "filtrar",
+	"sort":                               "ordenar",
+	"board":                              "tablero",
+	"list":                               "lista",
+	"page":                               "página",
+	"column":                             "columna",
+	"card":                               "tarjeta",
+	"scroll":                             "desplazar",
+	"close":                              "cerrar",
+	"copy":                               "copiar",
+	"copy name":                          "copiar nombre",
+	"copy field":                         "copiar campo",
+	"open in browser":                    "abrir en el navegador",
+	"receive":                            "recibir",
+	"transfer":                           "transferir",
+	"issue":                              "sacar",
+	"submit":                             "validar",
+	"cancel":                             "cancelar",
+	"confirm":                            "confirmar",
+	"save":                               "guardar",
+	"save PDF":                           "guardar PDF",
+	"attach file":                        "adjuntar archivo",
+	"history":                            "historial",
+	"edit":                               "editar",
+	"edit lines":                         "editar líneas",
+	"edit values":                        "editar valores",
+	"add item":                           "añadir artículo",
+	"remove line":                        "quitar línea",
+	"select line":                        "elegir línea",
+	"discard":                            "descartar",
+	"apply":                              "aplicar",
+	"next field":                         "siguiente campo",
+	"pick":                               "marcar",
+	"pick value":                         "marcar valor",
+	"review":                             "revisar",
+	"create":                             "crear",
+	"create invoice":                     "crear factura",
+	"create payment":                     "crear pago",
+	"create SO":                          "crear pedido de venta",
+	"create DN":                          "crear albarán",
+	"create PR":                          "crear albarán de compra",
+	"create variant":                     "crear variante",
+	"create variants":                    "crear variantes",
+	"generate variants (templates only)": "generar variantes (solo plantillas)",
+	"from quotation":                     "desde presupuesto",
+	"convert to document":                "convertir en documento",
+	"start (transfer materials)":         "iniciar (transferir materiales)",
+	"finish (manufacture)":               "terminar (fabricar)",
+	"previous/next week":                 "semana anterior/siguiente",
+	"submit week":                        "validar semana",
+	"text, numeric, select":              "texto, numérico, lista",
+	"item group":                         "grupo de artículo",
+	"resume draft":                       "recuperar borrador",
+	"undo delete":                        "deshacer borrado",
+	"undo cancel":                        "deshacer cancelación",
+	"start empty":                        "empezar vacío",
+
+	// Dashboard
+	"ERPNEXT DASHBOARD":                       "PANEL DE ERPNEXT",
+	"Updated: %s | Mode: %s | Currency: %s":   "Actualizado: %s | Modo: %s | Moneda: %s",
+	"Generated: %s | Mode: %s | Currency: %s": "Generado: %s | Modo: %s | Moneda: %s",
+	"STOCK":                      "EXISTENCIAS",
+	"SALES":                      "VENTAS",
+	"PURCHASES":                  "COMPRAS",
+	"PAYMENTS":                   "PAGOS",
+	"SYSTEM":                     "SISTEMA",
+	"TRENDS":                     "TENDENCIAS",
+	"Total Items":                "Artículos",
+	"Inventory Value":            "Valor del inventario",
+	"Zero Stock Items":           "Artículos sin stock",
+	"Open Quotations":            "Presupuestos abiertos",
+	"Pending SOs":                "Pedidos pendientes",
+	"Completed SOs":              "Pedidos completados",
+	"Unpaid Invoices":            "Facturas sin pagar",
+	"Draft POs":                  "Pedidos en borrador",
+	"Pending POs":                "Pedidos pendientes",
+	"Completed POs":              "Pedidos completados",
+	"Top Suppliers:":             "Principales proveedores:",
+	"%d POs":                     "%d pedidos",
+	"Receivables":                "Por cobrar",
+	"Payables":                   "Por pagar",
+	"Item Groups":                "Grupos de artículos",
+	"Purchases":                  "Compras",
+	"Stock Value":                "Valor del stock",
+	"No trend data":              "Sin datos de tendencia",
+	"Top Customers (%d months):": "Principales clientes (%d meses):",
+	"No rows":                    "Sin filas",
+	"... and %d more rows":       "... y %d filas más",
+
+	// Addresses
+	"address %s is not one of %s's (see 'erp-cli customer addresses \"%s\"')": "la dirección %s no es de %s (ver 'erp-cli customer addresses \"%s\"')",
+	"Bill To: %s": "Facturar a: %s",
+	"Ship To: %s": "Enviar a: %s",
+
+	// Aging
+	"invalid --as-of date (expected YYYY-MM-DD): %s": "fecha --as-of no válida (se espera AAAA-MM-DD): %s",
+	"invalid date: %s": "fecha no válida: %s",
+	"--as-of a past date needs the payment ledger of ERPNext v14 or later (this site runs v%s)": "--as-of con una fecha pasada necesita el libro de pagos de ERPNext v14 o posterior (este sitio tiene v%s)",
+	"%s  %s (as of %s)%s":         "%s  %s (a %s)%s",
+	"%sNo outstanding invoices%s": "%sNo hay facturas pendientes%s",
+	"Total":                       "Total",
+	"not due":                     "no vencida",
+	"%d days overdue":             "vencida hace %d días",
+	"%s  due %s  %s  %s%s%s":      "%s  vence %s  %s  %s%s%s",
+	"Outstanding: %s | %sOverdue: %s%s | %d %s": "Pendiente: %s | %sVencido: %s%s | %d %s",
+	"failed to create file: %w":                 "no se pudo crear el archivo: %w",
+	"failed to write CSV header: %w":            "no se pudo escribir la cabecera CSV: %w",
+	"failed to write CSV row: %w":               "no se pudo escribir la fila CSV: %w",
+	"failed to write CSV: %w":                   "no se pudo escribir el CSV: %w",
+	"%sGenerating accounts payable aging...%s":  "%sGenerando antigüedad de cuentas por pagar...%s",
+	"%s✓ Exported %d invoices to %s%s":          "%s✓ %d facturas exportadas a %s%s",
+	"%sGenerated: %s%s":                         "%sGenerado: %s%s",
+
+	// Aliases and command defaults
+	"alias %s: %w":      "alias %s: %w",
+	"alias %s is empty": "el alias %s está vacío",
+	"defaults.%s: %w":   "defaults.%s: %w",
+	"defaults.%s: %q is not a flag (use --flag or --flag=value)": "defaults.%s: %q no es una opción (usa --opcion o --opcion=valor)",
+	"unterminated %c quote":                  "comilla %c sin cerrar",
+	"trailing backslash":                     "barra invertida al final",
+	"%sNo aliases defined%s":                 "%sNo hay alias definidos%s",
+	"Add them to .erp-config, one per line:": "Añádelos a .erp-config, uno por línea:",
+	"Default flags per command work the same way (--no-defaults skips them):":  "Las opciones por defecto de cada comando funcionan igual (--no-defaults las omite):",
+	"A flag given on the command line replaces its default, except repeatable": "Una opción dada en la línea de comandos sustituye a su valor por defecto, salvo las repetibles",
+	"ones (--filter, --set, --item, ...), whose defaults are always added.":    "(--filter, --set, --item, ...), cuyos valores por defecto se añaden siempre.",
+	"Aliases":                    "Alias",
+	"Command defaults":           "Opciones por defecto",
+	"%-12s %s %s(invalid: %s)%s": "%-12s %s %s(no válido: %s)%s",
+
+	// Sales analytics
+	"invalid period: %s (use monthly or weekly)":                         "periodo no válido: %s (usa monthly o weekly)",
+	"invalid --last: %s (expected a positive number)":                    "--last no válido: %s (se espera un número positivo)",
+	"%sGenerating sales report for the last %d %s...%s":                  "%sGenerando informe de ventas de los últimos %d %s...%s",
+	"%sNo submitted sales invoices since %s%s":                           "%sNo hay facturas de venta validadas desde %s%s",
+	"%s                       SALES REPORT                           %s": "%s                     INFORME DE VENTAS                        %s",
+	"%sRevenue per %s (%s to %s):%s":                                     "%sIngresos por %s (%s a %s):%s",
+	"%-9s %14s %4d inv  %s%s%s":                                          "%-9s %14s %4d fact %s%s%s",
+	"%sTotal: %s from %d invoices | Average per %s: %s%s":                "%sTotal: %s de %d facturas | Media por %s: %s%s",
+	"Top customers:": "Principales clientes:",
+	"Top items:":     "Principales artículos:",
+	"None":           "Ninguno",
+
+	// Manifests (apply)
+	"Usage: erp-cli apply -f <manifest.yaml> [--dry-run]":                       "Uso: erp-cli apply -f <manifiesto.yaml> [--dry-run]",
+	"Creates the documents a manifest declares and updates the declared fields": "Crea los documentos que declara un manifiesto y actualiza los campos declarados",
+	"that differ on the server. Undeclared fields and documents are left as":    "que difieren en el servidor. Los campos y documentos no declarados se dejan",
+	"they are; child rows (attribute values, template attributes) are added.":   "como están; las filas hijas (valores de atributo, atributos de plantilla) se añaden.",
+	"--dry-run prints the changes without making them.":                         "--dry-run muestra los cambios sin hacerlos.",
+	"Sections and keys:":         "Secciones y claves:",
+	"Examples:":                  "Ejemplos:",
+	"%sNothing declared in %s%s": "%sNada declarado en %s%s",
+	"%s[DRY RUN] Comparing %d document(s) with the server...%s": "%s[SIMULACIÓN] Comparando %d documento(s) con el servidor...%s",
+	"%sApplying %d document(s)...%s":                            "%sAplicando %d documento(s)...%s",
+	"%sTo create: %d, To update: %d, Unchanged: %d%s":           "%sA crear: %d, A actualizar: %d, Sin cambios: %d%s",
+	"%sCreated: %d, Updated: %d, Unchanged: %d%s":               "%sCreados: %d, Actualizados: %d, Sin cambios: %d%s",
+	"%d document(s) failed":                                     "%d documento(s) fallaron",
+	"%s: expected sections (item_groups:, attributes:, ...)":    "%s: se esperan secciones (item_groups:, attributes:, ...)",
+	"%s: unknown section %q (expected %s)":                      "%s: sección desconocida %q (se espera %s)",
+	"%s: %s must be a list":                                     "%s: %s debe ser una lista",
+	"%s[%d]: expected key: value pairs":                         "%s[%d]: se esperan pares clave: valor",
+	"values must be a list":                                     "values debe ser una lista",
+	"invalid value in %s":                                       "valor no válido en %s",
+	"item_code is required":                                     "item_code es obligatorio",
+	"attributes must be a list":                                 "attributes debe ser una lista",
+	"invalid attribute in %s":                                   "atributo no válido en %s",
+	"name is required":                                          "name es obligatorio",
+	"unknown key %q (expected %s)":                              "clave desconocida %q (se espera %s)",
+	"%s must be true or false, got %q":                          "%s debe ser true o false, no %q",
+	"%s must be a number, got %q":                               "%s debe ser un número, no %q",
+	"failed to open manifest: %w":                               "no se pudo abrir el manifiesto: %w",
+
+	// Assets
+	"Usage: erp-cli asset <subcommand> [args...]":                                                               "Uso: erp-cli asset <subcomando> [argumentos...]",
+	"Subcommands: list, get, create, submit":                                                                    "Subcomandos: list, get, create, submit",
+	"create capitalizes the fixed-asset items of a submitted Purchase Receipt:":                                 "create activa los artículos de inmovilizado de un albarán de compra validado:",
+	"one asset per unit (or per row with --grouped), depreciated as their":                                      "un activo por unidad (o por fila con --grouped), amortizado según diga su",
+	"Asset Category says. Rows that already have assets are skipped.":                                           "categoría de activo. Se omiten las filas que ya tienen activos.",
+	"usage: erp-cli asset get <name>":                                                                           "uso: erp-cli asset get <nombre>",
+	"unknown argument: %s":                                                                                      "argumento desconocido: %s",
+	"usage: erp-cli asset create --pr=<receipt> [--location=X] [--available=YYYY-MM-DD] [--grouped] [--submit]": "uso: erp-cli asset create --pr=<albarán> [--location=X] [--available=AAAA-MM-DD] [--grouped] [--submit]",
+	"invalid date (expected YYYY-MM-DD): %s":                                                                    "fecha no válida (se espera AAAA-MM-DD): %s",
+	"usage: erp-cli asset submit <name>":                                                                        "uso: erp-cli asset submit <nombre>",
+	"unknown asset subcommand: %s":                                                                              "subcomando de asset desconocido: %s",
+	"assets need a location: pass --location=X":                                                                 "los activos necesitan una ubicación: indica --location=X",
+	"%sCreating assets from purchase receipt: %s%s":                                                             "%sCreando activos desde el albarán de compra: %s%s",
+	"purchase receipt not found":                                                                                "albarán de compra no encontrado",
+	"purchase receipt must be submitted first":                                                                  "primero hay que validar el albarán de compra",
+	"%s✓ Asset created: %s%s (%s, %s)":                                                                          "%s✓ Activo creado: %s%s (%s, %s)",
+	"%s  %d row(s) already have assets%s":                                                                       "%s  %d fila(s) ya tienen activos%s",
+	"%sNo fixed-asset items on %s%s":                                                                            "%sNo hay artículos de inmovilizado en %s%s",
+	"Location: %s | Available for use: %s":                                                                      "Ubicación: %s | Disponible para uso: %s",
+	"Submitted: %d asset(s)":                                                                                    "Validados: %d activo(s)",
+	"Status: Draft":                                                                                             "Estado: Borrador",
+	"Use 'erp-cli asset submit <name>' to capitalize them":                                                      "Usa 'erp-cli asset submit <nombre>' para activarlos",
+	"%sSubmitting asset: %s%s":                                                                                  "%sValidando activo: %s%s",
+	"%s✓ Asset submitted: %s%s":                                                                                 "%s✓ Activo validado: %s%s",
+	"%sFetching assets...%s":                                                                                    "%sObteniendo activos...%s",
+	"%sNo assets found%s":                                                                                       "%sNo se encontraron activos%s",
+	"%sAssets (%d):%s":                                                                                          "%sActivos (%d):%s",
+	"%s @ %s | Status: %s%s%s | Cost: %s | Value: %s":                                                           "%s @ %s | Estado: %s%s%s | Coste: %s | Valor: %s",
+	"%sFetching asset: %s%s":                                                                                    "%sObteniendo activo: %s%s",
+	"asset not found":                                                                                           "activo no encontrado",
+	"%sAsset: %s%s":                                                                                             "%sActivo: %s%s",
+	"Cost: %s":                                                                                                  "Coste: %s",
+	"Value: %s":                                                                                                 "Valor: %s",
+	"%sNot depreciated%s":                                                                                       "%sSin amortizar%s",
+	"%sDepreciation Schedule:%s":                                                                                "%sCalendario de amortización:%s",
+	"Date":                                                                                                      "Fecha",
+	"Amount":                                                                                                    "Importe",
+	"Accumulated":                                                                                               "Acumulado",
+	"Entry":                                                                                                     "Asiento",
+
+	// Attachments
+	"Usage: erp-cli attach <doctype> <name> <file>... [--public]":           "Uso: erp-cli attach <doctype> <nombre> <archivo>... [--public]",
+	"Files are private unless --public is given. The doctype may be a":      "Los archivos son privados salvo con --public. El doctype puede ser el",
+	"full name (\"Purchase Invoice\") or a command name (pi, si, po, ...).": "nombre completo (\"Purchase Invoice\") o el de un comando (pi, si, po, ...).",
+	"usage: erp-cli attach <doctype> <name> <file>... [--public]":           "uso: erp-cli attach <doctype> <nombre> <archivo>... [--public]",
+	"%sAttaching %s...%s":                                                   "%sAdjuntando %s...%s",
+	"%s✓ Attached: %s%s":                                                    "%s✓ Adjuntado: %s%s",
+	"Usage: erp-cli attachments <subcommand> [args...]":                     "Uso: erp-cli attachments <subcomando> [argumentos...]",
+	"Subcommands: list, get":                                                "Subcomandos: list, get",
+	"usage: erp-cli attachments list <doctype> <name>":                      "uso: erp-cli attachments list <doctype> <nombre>",
+	"usage: erp-cli attachments get <doctype> <name> <file_name> [-o path]": "uso: erp-cli attachments get <doctype> <nombre> <archivo> [-o ruta]",
+	"unknown attachments subcommand: %s":                                    "subcomando de attachments desconocido: %s",
+	"%sNo attachments on %s %s%s":                                           "%s%s %s no tiene adjuntos%s",
+	"%sAttachments of %s %s:%s":                                             "%sAdjuntos de %s %s:%s",
+	"%sTotal: %d files%s":                                                   "%sTotal: %d archivos%s",
+	"%s has no file URL":                                                    "%s no tiene URL de archivo",
+	"%s links to an external URL: %s":                                       "%s enlaza a una URL externa: %s",
+	"failed to write %s: %w":                                                "no se pudo escribir %s: %w",
+	"%s %s has no attachment named %s (see: erp-cli attachments list)":      "%s %s no tiene ningún adjunto llamado %s (ver: erp-cli attachments list)",
+	"%s✓ Saved: %s (%s)%s":                                                  "%s✓ Guardado: %s (%s)%s",
+
+	// Attributes
+	"Usage: erp-cli attr <subcommand> [args...]":                                           "Uso: erp-cli attr <subcomando> [argumentos...]",
+	"Subcommands: list, get, create-text, create-numeric, create-list, add-values, delete": "Subcomandos: list, get, create-text, create-numeric, create-list, add-values, delete",
+	"usage: erp-cli attr get <name>":                                                       "uso: erp-cli attr get <nombre>",
+	"usage: erp-cli attr create-text <name>":                                               "uso: erp-cli attr create-text <nombre>",
+	"usage: erp-cli attr create-numeric <name> <from> <to> <increment>":                    "uso: erp-cli attr create-numeric <nombre> <desde> <hasta> <incremento>",
+	"usage: erp-cli attr create-list <name> <value:abbr> [value:abbr...]":                  "uso: erp-cli attr create-list <nombre> <valor:abrev> [valor:abrev...]",
+	"usage: erp-cli attr add-values <name> <value:abbr> [value:abbr...]":                   "uso: erp-cli attr add-values <nombre> <valor:abrev> [valor:abrev...]",
+	"usage: erp-cli attr delete <name>":                                                    "uso: erp-cli attr delete <nombre>",
+	"unknown attr subcommand: %s":                                                          "subcomando de attr desconocido: %s",
+	"%sFetching item attributes...%s":                                                      "%sObteniendo atributos de artículo...%s",
+	"%sFetching attribute: %s%s":                                                           "%sObteniendo atributo: %s%s",
+	"%sCreating text attribute: %s%s":                                                      "%sCreando atributo de texto: %s%s",
+	"%s✓ Attribute created: %s%s":                                                          "%s✓ Atributo creado: %s%s",
+	"%sCreating numeric attribute: %s (%s-%s, step %s)%s":                                  "%sCreando atributo numérico: %s (%s-%s, paso %s)%s",
+	"%s✓ Numeric attribute created: %s%s":                                                  "%s✓ Atributo numérico creado: %s%s",
+	"%sCreating list attribute: %s%s":                                                      "%sCreando atributo de lista: %s%s",
+	"invalid format '%s'. Use 'value:abbreviation'":                                        "formato no válido '%s'. Usa 'valor:abreviatura'",
+	"%s✓ List attribute created: %s%s":                                                     "%s✓ Atributo de lista creado: %s%s",
+	"Values: %s":                                                                           "Valores: %s",
+	"%sAdding values to attribute: %s%s":                                                   "%sAñadiendo valores al atributo: %s%s",
+	"attribute not found":                                                                  "atributo no encontrado",
+	"%s✓ Values added to: %s%s":                                                            "%s✓ Valores añadidos a: %s%s",
+	"New values: %s":                                                                       "Valores nuevos: %s",
+	"%sDeleting attribute: %s%s":                                                           "%sBorrando atributo: %s%s",
+	"%s✓ Attribute deleted: %s%s":                                                          "%s✓ Atributo borrado: %s%s",
+
+	// Barcodes
+	"non-numeric barcode: %s":                                        "código de barras no numérico: %s",
+	"invalid length %d for barcode %s (expected 8, 12, 13 or 14)":    "longitud %d no válida para el código de barras %s (se espera 8, 12, 13 o 14)",
+	"invalid check digit for barcode %s (expected %d)":               "dígito de control no válido en el código de barras %s (se espera %d)",
+	"%s[DRY RUN] Assigning barcodes from: %s%s":                      "%s[SIMULACIÓN] Asignando códigos de barras desde: %s%s",
+	"%sAssigning barcodes from: %s%s":                                "%sAsignando códigos de barras desde: %s%s",
+	"failed to open file: %w":                                        "no se pudo abrir el archivo: %w",
+	"failed to read CSV: %w":                                         "no se pudo leer el CSV: %w",
+	"CSV file is empty or has no data rows":                          "el archivo CSV está vacío o no tiene filas de datos",
+	"CSV must have item_code and barcode columns":                    "el CSV debe tener las columnas item_code y barcode",
+	"%sRow %d: insufficient columns%s":                               "%sFila %d: faltan columnas%s",
+	"%sRow %d: item not found: %s%s":                                 "%sFila %d: artículo no encontrado: %s%s",
+	"%sRow %d: %s is a template; assign barcodes to its variants%s":  "%sFila %d: %s es una plantilla; asigna los códigos a sus variantes%s",
+	"%sRow %d: %s%s":                                                 "%sFila %d: %s%s",
+	"%sRow %d: duplicate barcode %s (also on row %d)%s":              "%sFila %d: código de barras %s repetido (también en la fila %d)%s",
+	"%sRow %d: %s already has %s%s":                                  "%sFila %d: %s ya tiene %s%s",
+	"%sRow %d: barcode %s already assigned to %s%s":                  "%sFila %d: el código de barras %s ya está asignado a %s%s",
+	"%d invalid rows; fix the file and retry (nothing was assigned)": "%d filas no válidas; corrige el archivo y vuelve a intentarlo (no se asignó nada)",
+	"[DRY RUN] Would assign %s (%s) to %s":                           "[SIMULACIÓN] Se asignaría %s (%s) a %s",
+	"%sSummary: %d assigned, %d failed%s":                            "%sResumen: %d asignados, %d fallidos%s",
+	"%s✗ Failed: %s → %s (%s)%s":                                     "%s✗ Fallo: %s → %s (%s)%s",
+	"%s✓ Assigned: %s → %s%s":                                        "%s✓ Asignado: %s → %s%s",
+	"item not found":                                                 "artículo no encontrado",
+	"%sFetching items without barcodes...%s":                         "%sObteniendo artículos sin código de barras...%s",
+	"%s✓ All %d items have barcodes%s":                               "%s✓ Los %d artículos tienen código de barras%s",
+	"%sItems missing barcodes (%d of %d):%s":                         "%sArtículos sin código de barras (%d de %d):%s",
+
+	// Blanket orders
+	"Usage: erp-cli blanket <subcommand> [args...]":                                                                                        "Uso: erp-cli blanket <subcomando> [argumentos...]",
+	"A blanket order agrees quantities and rates with a supplier (or, with":                                                                "Un pedido marco acuerda cantidades y precios con un proveedor (o, con",
+	"--selling, a customer) for a period. Items are CODE:QTY@RATE. Orders":                                                                 "--selling, con un cliente) para un periodo. Los artículos son CÓDIGO:CANT@PRECIO. Los pedidos",
+	"created with 'po create --blanket=X' or 'so create --blanket=X' take":                                                                 "creados con 'po create --blanket=X' o 'so create --blanket=X' toman",
+	"its rates, and their quantities count against it once submitted.":                                                                     "sus precios, y sus cantidades se descuentan de él una vez validados.",
+	"usage: erp-cli blanket get <name>":                                                                                                    "uso: erp-cli blanket get <nombre>",
+	"usage: erp-cli blanket create <supplier|customer> <item:qty@rate> [...] [--selling] [--from=YYYY-MM-DD] [--to=YYYY-MM-DD] [--submit]": "uso: erp-cli blanket create <proveedor|cliente> <artículo:cant@precio> [...] [--selling] [--from=AAAA-MM-DD] [--to=AAAA-MM-DD] [--submit]",
+	"blanket order items need a rate (CODE:QTY@RATE): %s":                                                                                  "los artículos de un pedido marco necesitan precio (CÓDIGO:CANT@PRECIO): %s",
+	"usage: erp-cli blanket submit <name>":                                                                                                 "uso: erp-cli blanket submit <nombre>",
+	"unknown blanket subcommand: %s":                                                                                                       "subcomando de blanket desconocido: %s",
+	"%sCreating %s blanket order for: %s%s":                                                                                                "%sCreando pedido marco de %s para: %s%s",
+	"the agreement ends (%s) before it starts (%s)":                                                                                        "el acuerdo termina (%s) antes de empezar (%s)",
+	"unexpected response creating blanket order":                                                                                           "respuesta inesperada al crear el pedido marco",
+	"%s✓ Blanket Order created: %s%s":                                                                                                      "%s✓ Pedido marco creado: %s%s",
+	"Period: %s to %s":                                                                                                                     "Periodo: %s a %s",
+	"Items: %d (%s)":                                                                                                                       "Artículos: %d (%s)",
+	"Use 'erp-cli blanket submit %s' to submit":                                                                                            "Usa 'erp-cli blanket submit %s' para validarlo",
+	"%sSubmitting blanket order: %s%s":                                                                                                     "%sValidando pedido marco: %s%s",
+	"%s✓ Blanket Order submitted: %s%s":                                                                                                    "%s✓ Pedido marco validado: %s%s",
+	"%sFetching blanket orders...%s":                                                                                                       "%sObteniendo pedidos marco...%s",
+	"%sNo blanket orders found%s":                                                                                                          "%sNo se encontraron pedidos marco%s",
+	"%sBlanket Orders (%d):%s":                                                                                                             "%sPedidos marco (%d):%s",
+	"%s | %s to %s | Status: %s%s%s":                                                                                                       "%s | %s a %s | Estado: %s%s%s",
+	"%sFetching blanket order: %s%s":                                                                                                       "%sObteniendo pedido marco: %s%s",
+	"blanket order not found":                                                                                                              "pedido marco no encontrado",
+	"%sBlanket Order: %s%s":                                                                                                                "%sPedido marco: %s%s",
+	"Type: %s":                                                                                                                             "Tipo: %s",
+	"Customer: %s":                                                                                                                         "Cliente: %s",
+	"Supplier: %s":                                                                                                                         "Proveedor: %s",
+	"%sItems (ordered / agreed):%s":                                                                                                        "%sArtículos (pedido / acordado):%s",
+	"- %s @ %s: %s%g / %g (%.0f%%)%s, %g left":                                                                                             "- %s @ %s: %s%g / %g (%.0f%%)%s, quedan %g",
+	"blanket order not found: %s":                                                                                                          "pedido marco no encontrado: %s",
+	"blanket order must be submitted first: %s":                                                                                            "primero hay que validar el pedido marco: %s",
+	"%s is a %s blanket order":                                                                                                             "%s es un pedido marco de %s",
+	"%s is agreed with %s, not %s":                                                                                                         "%s está acordado con %s, no con %s",
+	"%s runs from %s to %s":                                                                                                                "%s va del %s al %s",
+	"everything agreed in %s has been ordered":                                                                                             "ya se ha pedido todo lo acordado en %s",
+	"%s is not part of blanket order %s":                                                                                                   "%s no forma parte del pedido marco %s",
+	"only %g of %s left on %s (%g requested)":                                                                                              "solo quedan %g de %s en %s (se piden %g)",
+	"--items needs --blanket=X":                                                                                                            "--items necesita --blanket=X",
+	"From Blanket Order: %s":                                                                                                               "Desde pedido marco: %s",
+
+	// BOMs
+	"Usage: erp-cli bom <subcommand> [args...]":                                               "Uso: erp-cli bom <subcomando> [argumentos...]",
+	"Components are CODE:QTY or CODE:QTY@RATE. A component with its own":                      "Los componentes son CÓDIGO:CANT o CÓDIGO:CANT@PRECIO. Un componente con su propia",
+	"default BOM becomes a sub-assembly, so create (and submit) the lower":                    "lista de materiales por defecto pasa a ser un subconjunto, así que crea (y valida) primero",
+	"levels first. get accepts a BOM name or an item code (its default BOM).":                 "los niveles inferiores. get acepta un nombre de lista o un código de artículo (su lista por defecto).",
+	"usage: erp-cli bom get <bom|item_code>":                                                  "uso: erp-cli bom get <lista|código_artículo>",
+	"invalid quantity: %s":                                                                    "cantidad no válida: %s",
+	"usage: erp-cli bom create <item_code> <component:qty[@rate]> [...] [--qty=N] [--submit]": "uso: erp-cli bom create <código_artículo> <componente:cant[@precio]> [...] [--qty=N] [--submit]",
+	"usage: erp-cli bom submit <bom>":                                                         "uso: erp-cli bom submit <lista>",
+	"unknown bom subcommand: %s":                                                              "subcomando de bom desconocido: %s",
+	"invalid rate in component: %s":                                                           "precio no válido en el componente: %s",
+	"invalid quantity in component: %s":                                                       "cantidad no válida en el componente: %s",
+	"%sFetching BOMs...%s":                                                                    "%sObteniendo listas de materiales...%s",
+	"%sNo BOMs found%s":                                                                       "%sNo se encontraron listas de materiales%s",
+	"%sBOMs (%d):%s":                                                                          "%sListas de materiales (%d):%s",
+	"Qty: %g | Status: %s%s%s | Cost: %s":                                                     "Cant: %g | Estado: %s%s%s | Coste: %s",
+	"no active BOM for %s":                                                                    "no hay lista de materiales activa para %s",
+	"BOM not found: %s":                                                                       "lista de materiales no encontrada: %s",
+	"%sFetching BOM: %s%s":                                                                    "%sObteniendo lista de materiales: %s%s",
+	"%sBOM: %s%s":                                                                             "%sLista de materiales: %s%s",
+	"Item: %s (%s)":                                                                           "Artículo: %s (%s)",
+	"Quantity: %g %s":                                                                         "Cantidad: %g %s",
+	"Status: %s%s%s":                                                                          "Estado: %s%s%s",
+	"Default: yes":                                                                            "Por defecto: sí",
+	"Total Cost: %s":                                                                          "Coste total: %s",
+	"%sComponents:%s":                                                                         "%sComponentes:%s",
+	"%s  %s(%s not expanded)%s":                                                               "%s  %s(%s sin desglosar)%s",
+	"%s created but not submitted: %w":                                                        "%s creada pero no validada: %w",
+	"%sCreating BOM for: %s (qty %g)%s":                                                       "%sCreando lista de materiales para: %s (cant %g)%s",
+	"Component: %s x %g @ %.2f":                                                               "Componente: %s x %g @ %.2f",
+	"Component: %s x %g":                                                                      "Componente: %s x %g",
+	"%s✓ BOM created and submitted: %s%s":                                                     "%s✓ Lista de materiales creada y validada: %s%s",
+	"%s✓ BOM created: %s (draft, submit with: erp-cli bom submit %s)%s":                       "%s✓ Lista de materiales creada: %s (borrador, valídala con: erp-cli bom submit %s)%s",
+	"%sSubmitting BOM: %s%s":                                                                  "%sValidando lista de materiales: %s%s",
+	"%s✓ BOM submitted: %s%s":                                                                 "%s✓ Lista de materiales validada: %s%s",
+	"%sExporting BOMs...%s":                                                                   "%sExportando listas de materiales...%s",
+	"failed to write header: %w":                                                              "no se pudo escribir la cabecera: %w",
+	"failed to write row: %w":                                                                 "no se pudo escribir la fila: %w",
+	"%s✓ Exported %d BOMs to %s%s":                                                            "%s✓ %d listas de materiales exportadas a %s%s",
+	"%s[DRY RUN] Importing BOMs from: %s%s":                                                   "%s[SIMULACIÓN] Importando listas de materiales desde: %s%s",
+	"%sImporting BOMs from: %s%s":                                                             "%sImportando listas de materiales desde: %s%s",
+	"%sRow %d: skipped (no parent or component)%s":                                            "%sFila %d: omitida (sin padre o componente)%s",
+	"no parent or component":                                                                  "sin padre o componente",
+	"%sRow %d: skipped (invalid qty)%s":                                                       "%sFila %d: omitida (cantidad no válida)%s",
+	"invalid qty":                                                                             "cantidad no válida",
+	"%sRow %d: skipped (invalid rate)%s":                                                      "%sFila %d: omitida (precio no válido)%s",
+	"invalid rate":                                                                            "precio no válido",
+	"[DRY RUN] Would create BOM for %s (%d components)":                                       "[SIMULACIÓN] Se crearía la lista de materiales de %s (%d componentes)",
+	"%sSummary: %d BOMs, %d skipped%s":                                                        "%sResumen: %d listas, %d omitidas%s",
+	"%sSummary: 0 created, %d skipped, 0 failed%s":                                            "%sResumen: 0 creados, %d omitidos, 0 fallidos%s",
+	"%s✗ Failed: %s (%s)%s":                                                                   "%s✗ Fallo: %s (%s)%s",
+	"%s✓ %s: %s (%d components)%s":                                                            "%s✓ %s: %s (%d componentes)%s",
+	"%sSummary: %d created, %d skipped, %d failed%s":                                          "%sResumen: %d creados, %d omitidos, %d fallidos%s",
+	"circular BOM: %s":                                                                        "lista de materiales circular: %s",
+
+	// Bulk actions
+	"%sBatch %d-%d failed, retrying one by one%s":                                                    "%sFalló el lote %d-%d, reintentando uno a uno%s",
+	"Usage: erp-cli bulk <action> <doctype> [--filter key=value ...] [--notify|--no-notify] [--yes]": "Uso: erp-cli bulk <acción> <doctype> [--filter clave=valor ...] [--notify|--no-notify] [--yes]",
+	"Actions: submit, cancel, delete":                                                                "Acciones: submit, cancel, delete",
+	"Filters take =, !=, <, <=, > or >= (values with % match with like).":                            "Los filtros admiten =, !=, <, <=, > o >= (los valores con % se comparan con like).",
+	"Only documents the action applies to are picked: drafts for submit,":                            "Solo se eligen los documentos a los que se aplica la acción: borradores para submit,",
+	"submitted documents for cancel, drafts and cancelled ones for delete.":                          "validados para cancel, borradores y cancelados para delete.",
+	"The matches are listed and confirmed first unless --yes is given.":                              "Las coincidencias se listan y se confirman primero salvo con --yes.",
+	"Submitted quotations, orders and invoices email the customer as their":                          "Los presupuestos, pedidos y facturas validados envían un correo al cliente igual que sus",
+	"submit commands do (ERP_NOTIFY_ON, --notify, --no-notify).":                                     "comandos submit (ERP_NOTIFY_ON, --notify, --no-notify).",
+	"unknown bulk action: %s (use submit, cancel or delete)":                                         "acción de bulk desconocida: %s (usa submit, cancel o delete)",
+	"%sFinding %s documents to %s...%s":                                                              "%sBuscando documentos %s para %s...%s",
+	"%sNo matching %s documents%s":                                                                   "%sNingún documento %s coincide%s",
+	"%s%d matching %s documents:%s":                                                                  "%s%d documentos %s coinciden:%s",
+	"... and %d more":                                                                                "... y %d más",
+	"%s %d documents?":                                                                               "¿%s %d documentos?",
+	"%sAborted%s":                                                                                    "%sCancelado%s",
+	"%sSummary: %d %s, %d failed%s":                                                                  "%sResumen: %d %s, %d fallidos%s",
+	"%sFailed:%s":                                                                                    "%sFallidos:%s",
+
+	// Product bundles
+	"Usage: erp-cli bundle <subcommand> [args...]":                          "Uso: erp-cli bundle <subcomando> [argumentos...]",
+	"Subcommands: list, get, create, delete":                                "Subcomandos: list, get, create, delete",
+	"The parent item must be a non-stock item; components are stock items.": "El artículo padre no debe ser de stock; los componentes sí son artículos de stock.",
+	"usage: erp-cli bundle get <parent_code>":                               "uso: erp-cli bundle get <código_padre>",
+	"usage: erp-cli bundle create <parent_code> <child:qty> [child:qty...]": "uso: erp-cli bundle create <código_padre> <hijo:cant> [hijo:cant...]",
+	"usage: erp-cli bundle delete <parent_code>":                            "uso: erp-cli bundle delete <código_padre>",
+	"unknown bundle subcommand: %s":                                         "subcomando de bundle desconocido: %s",
+	"%sFetching product bundles...%s":                                       "%sObteniendo lotes de productos...%s",
+	"%sNo product bundles found%s":                                          "%sNo se encontraron lotes de productos%s",
+	"%sProduct Bundles (%d):%s":                                             "%sLotes de productos (%d):%s",
+	"%sFetching product bundle: %s%s":                                       "%sObteniendo lote de productos: %s%s",
+	"%sProduct Bundle: %s%s":                                                "%sLote de productos: %s%s",
+	"Description: %s":                                                       "Descripción: %s",
+	"%sCreating product bundle: %s%s":                                       "%sCreando lote de productos: %s%s",
+	"%s✓ Product Bundle created: %s%s":                                      "%s✓ Lote de productos creado: %s%s",
+	"%sDeleting product bundle: %s%s":                                       "%sBorrando lote de productos: %s%s",
+	"%s✓ Product Bundle deleted: %s%s":                                      "%s✓ Lote de productos borrado: %s%s",
+
+	// Cancellation
+	"cancel linked documents first: %s":                                           "cancela primero los documentos vinculados: %s",
+	"%s%s %s has %d linked submitted document(s).%s":                              "%s%s %s tiene %d documento(s) validado(s) vinculado(s).%s",
+	"%sCancellation order:%s":                                                     "%sOrden de cancelación:%s",
+	"linked documents must be cancelled first (use --cascade to cancel them all)": "hay que cancelar primero los documentos vinculados (usa --cascade para cancelarlos todos)",
+	"Cancel all %d documents?":                                                    "¿Cancelar los %d documentos?",
+	"failed to cancel %s %s: %w":                                                  "no se pudo cancelar %s %s: %w",
+	"%s✓ Cancelled: %s %s%s":                                                      "%s✓ Cancelado: %s %s%s",
+
+	// Client and configuration
+	"API error: HTTP %d (empty response)": "error de la API: HTTP %d (respuesta vacía)",
+	"API error: HTTP %d: %s":              "error de la API: HTTP %d: %s",
+	"failed to parse response: %s":        "no se pudo interpretar la respuesta: %s",
+	"API error (HTTP %d): %v":             "error de la API (HTTP %d): %v",
+	"API error (HTTP %d)":                 "error de la API (HTTP %d)",
+	"API error: %v":                       "error de la API: %v",
+	"config file not found. Copy .erp-config.example to .erp-config": "no se encontró el archivo de configuración. Copia .erp-config.example a .erp-config",
+	"cannot open config: %w": "no se puede abrir la configuración: %w",
+	"missing required config: ERP_URL, ERP_API_KEY, ERP_API_SECRET": "falta configuración obligatoria: ERP_URL, ERP_API_KEY, ERP_API_SECRET",
+	"no config file to save to":                                     "no hay archivo de configuración donde guardar",
+	"cannot write config: %w":                                       "no se puede escribir la configuración: %w",
+	"failed to marshal body: %w":                                    "no se pudo serializar el cuerpo: %w",
+	"failed to create request: %w":                                  "no se pudo crear la petición: %w",
+	"request failed: %w":                                            "la petición falló: %w",
+	"failed to read response: %w":                                   "no se pudo leer la respuesta: %w",
+	"download failed: %w":                                           "la descarga falló: %w",
+	"download failed: the server sent JSON instead of a file":       "la descarga falló: el servidor envió JSON en lugar de un archivo",
+	"cannot open %s: %w":                                            "no se puede abrir %s: %w",
+	"failed to read %s: %w":                                         "no se pudo leer %s: %w",
+	"upload failed: %w":                                             "la subida falló: %w",
+	"%sTesting connection to ERP...%s":                              "%sProbando la conexión con el ERP...%s",
+	"connection failed: %w":                                         "la conexión falló: %w",
+	"%s✓ Connection successful%s":                                   "%s✓ Conexión correcta%s",
+	"Authenticated as: %s%s%s":                                      "Autenticado como: %s%s%s",
+	"Server: %s":                                                    "Servidor: %s",
+	"Mode: %sVPN direct%s (%s)":                                     "Modo: %sVPN directa%s (%s)",
+	"Mode: %sInternet%s (%s)":                                       "Modo: %sInternet%s (%s)",
+	"authentication failed: %s":                                     "la autenticación falló: %s",
+	"unknown config subcommand: %s":                                 "subcomando de config desconocido: %s",
+	"%sCurrent configuration:%s":                                    "%sConfiguración actual:%s",
+	"VPN URL: %s":                                                   "URL VPN: %s",
+	"VPN URL: %snot configured%s":                                   "URL VPN: %sno configurada%s",
+	"Internet URL: %s":                                              "URL de Internet: %s",
+	"API Key: %s":                                                   "Clave de API: %s",
+	"API Secret: ****":                                              "Secreto de API: ****",
+	"Nginx Cookie: configured":                                      "Cookie de Nginx: configurada",
+	"Nginx Cookie: %snot configured%s (needed for internet mode)":   "Cookie de Nginx: %sno configurada%s (necesaria para el modo Internet)",
+	"Company: %s":                                                   "Empresa: %s",
+	"Item defaults: UOM=%s":                                         "Valores por defecto de artículo: UdM=%s",
+	", Group=%s":                                                    ", Grupo=%s",
+	", Stock item=%v":                                               ", Artículo de stock=%v",
+	", Warranty=%d days":                                            ", Garantía=%d días",
+	"Document defaults: %s":                                         "Valores por defecto de documentos: %s",
+	"Dashboard panels: %s":                                          "Paneles del panel de control: %s",
+	"%sUnknown dashboard panel: %s%s":                               "%sPanel desconocido: %s%s",
+	"Active mode: %sVPN direct%s":                                   "Modo activo: %sVPN directa%s",
+	"Active mode: %sInternet%s":                                     "Modo activo: %sInternet%s",
+	"Active URL: %s":                                                "URL activa: %s",
+	"Time zone: %s (now %s)":                                        "Zona horaria: %s (ahora %s)",
+	"Low-bandwidth mode: %son%s (lists capped at %d, lookups cached for %dh)": "Modo de poco ancho de banda: %sactivado%s (listas limitadas a %d, búsquedas en caché durante %dh)",
+
+	// Clone
+	"Usage: erp-cli clone <doctype> <name> [--set field=value ...]":         "Uso: erp-cli clone <doctype> <nombre> [--set campo=valor ...]",
+	"Copies a document into a new draft, as Duplicate does in the desk:":    "Copia un documento en un borrador nuevo, como Duplicar en el escritorio:",
+	"naming, status and no-copy fields are left out and dates start today.": "se omiten la numeración, el estado y los campos no copiables, y las fechas empiezan hoy.",
+	"%sCloning %s %s...%s": "%sClonando %s %s...%s",
+	"%s not found: %s":     "%s no encontrado: %s",
+	"%s⚠ Cannot read the %s meta (%s); copying all fields%s": "%s⚠ No se pueden leer los metadatos de %s (%s); se copian todos los campos%s",
+	"%s✓ Cloned %s -> %s (Draft)%s":                          "%s✓ Clonado %s -> %s (Borrador)%s",
+	"--set needs field=value":                                "--set necesita campo=valor",
+	"invalid --set %q (expected field=value)":                "--set no válido %q (se espera campo=valor)",
+	"no meta returned":                                       "no se recibieron metadatos",
+
+	// Command history
+	"invalid limit: %s":            "límite no válido: %s",
+	"no documents created yet":     "todavía no se ha creado ningún documento",
+	"%sNo commands recorded yet%s": "%sTodavía no hay comandos registrados%s",
+	"WHEN":                         "CUÁNDO",
+	"COMMAND":                      "COMANDO",
+	"%s✗ failed%s":                 "%s✗ falló%s",
+	"%sRun one again with 'erp-cli redo <#>'%s":                 "%sVuelve a ejecutar uno con 'erp-cli redo <#>'%s",
+	"usage: erp-cli redo [<#>] [--yes] (see 'erp-cli history')": "uso: erp-cli redo [<#>] [--yes] (ver 'erp-cli history')",
+	"no command #%d in the history":                             "no hay ningún comando #%d en el historial",
+	"command #%d ran against %s, not this site":                 "el comando #%d se ejecutó contra %s, no contra este sitio",
+	"no command to run again":                                   "no hay ningún comando que volver a ejecutar",
+	"cannot find erp-cli executable: %w":                        "no se encuentra el ejecutable de erp-cli: %w",
+	"This command changes data. Run it again?":                  "Este comando modifica datos. ¿Ejecutarlo de nuevo?",
+	"command #%d failed again":                                  "el comando #%d volvió a fallar",
+
+	// Comments and assignments
+	"Usage: erp-cli comment <doctype> <name> <text>":                               "Uso: erp-cli comment <doctype> <nombre> <texto>",
+	"Comments show up in the document's timeline and in the get output.":           "Los comentarios aparecen en la cronología del documento y en la salida de get.",
+	"comment text is empty":                                                        "el texto del comentario está vacío",
+	"%s✓ Comment added to %s %s%s":                                                 "%s✓ Comentario añadido a %s %s%s",
+	"Usage: erp-cli assign <doctype> <name> <user> [--note=X] [--date=YYYY-MM-DD]": "Uso: erp-cli assign <doctype> <nombre> <usuario> [--note=X] [--date=AAAA-MM-DD]",
+	"Creates a ToDo for the user, as the Assign To sidebar does.":                  "Crea una tarea pendiente para el usuario, como la barra lateral Asignar a.",
+	"invalid date %q (use YYYY-MM-DD)":                                             "fecha no válida %q (usa AAAA-MM-DD)",
+	"%s✓ %s %s assigned to %s%s":                                                   "%s✓ %s %s asignado a %s%s",
+	"cannot determine the logged-in user":                                          "no se puede determinar el usuario conectado",
+	"%sAssigned to:%s":                                                             "%sAsignado a:%s",
+	"%sComments:%s":                                                                "%sComentarios:%s",
+
+	// Server compatibility
+	"%s⚠ ERPNext v%s is older than v%d, the oldest version this CLI supports: some commands may fail%s": "%s⚠ ERPNext v%s es anterior a v%d, la versión más antigua que admite esta CLI: algunos comandos pueden fallar%s",
+	"%s is part of the %s app since ERPNext v%d, and it is not installed on this site (ERPNext v%s)":    "%s forma parte de la app %s desde ERPNext v%d, y no está instalada en este sitio (ERPNext v%s)",
+	"cannot read taxes template %s: %w":                          "no se puede leer la plantilla de impuestos %s: %w",
+	"naming series %s: %s needs ERPNext v%d (the site runs v%s)": "serie de numeración %s: %s necesita ERPNext v%d (el sitio tiene v%s)",
+
+	// Coupons
+	"invalid --discount %q (use a percentage, e.g. 10%%)":     "--discount no válido %q (usa un porcentaje, p. ej. 10%%)",
+	"invalid --discount-amount: %s":                           "--discount-amount no válido: %s",
+	"--discount and --discount-amount are mutually exclusive": "--discount y --discount-amount son incompatibles",
+	"Discount: %g%% on the grand total":                       "Descuento: %g%% sobre el total",
+	"Discount: %s on the grand total":                         "Descuento: %s sobre el total",
+	"Coupon: %s":                                              "Cupón: %s",
+	"Discount: %s":                                            "Descuento: %s",
+	"coupon code not found: %s":                               "código de cupón no encontrado: %s",
+	"coupon %s is valid from %s":                              "el cupón %s es válido desde %s",
+	"coupon %s expired on %s":                                 "el cupón %s caducó el %s",
+	"coupon %s has been used %g of %g times":                  "el cupón %s se ha usado %g de %g veces",
+	"Usage: erp-cli coupon <subcommand> [args...]":            "Uso: erp-cli coupon <subcomando> [argumentos...]",
+	"Subcommands: list, create":                               "Subcomandos: list, create",
+	"A coupon applies a Pricing Rule marked \"Coupon Code Based\" to the documents":                                           "Un cupón aplica una regla de precios marcada como \"Coupon Code Based\" a los documentos",
+	"created with --coupon=CODE (quotation, so and si create).":                                                               "creados con --coupon=CÓDIGO (quotation, so y si create).",
+	"usage: erp-cli coupon create <code> --pricing-rule=X [--customer=X] [--max-use=N] [--from=YYYY-MM-DD] [--to=YYYY-MM-DD]": "uso: erp-cli coupon create <código> --pricing-rule=X [--customer=X] [--max-use=N] [--from=AAAA-MM-DD] [--to=AAAA-MM-DD]",
+	"invalid --max-use: %s":         "--max-use no válido: %s",
+	"--pricing-rule is required":    "--pricing-rule es obligatorio",
+	"unknown coupon subcommand: %s": "subcomando de coupon desconocido: %s",
+	"%sCreating coupon: %s%s":       "%sCreando cupón: %s%s",
+	"pricing rule not found: %s":    "regla de precios no encontrada: %s",
+	"pricing rule %s is not coupon code based (tick \"Coupon Code Based\" on it first)": "la regla de precios %s no se basa en cupones (marca antes \"Coupon Code Based\" en ella)",
+	"%s✓ Coupon created: %s%s":    "%s✓ Cupón creado: %s%s",
+	"Rule: %s":                    "Regla: %s",
+	"Uses: %d":                    "Usos: %d",
+	"%sFetching coupons...%s":     "%sObteniendo cupones...%s",
+	"%sNo coupons found%s":        "%sNo se encontraron cupones%s",
+	"%sCoupons (%d):%s":           "%sCupones (%d):%s",
+	"%g used":                     "%g usados",
+	"%s%s%s - %s | Rule: %s | %s": "%s%s%s - %s | Regla: %s | %s",
+
+	// Credit
+	"customer not found: %s":                               "cliente no encontrado: %s",
+	"%sFetching balance for: %s%s":                         "%sObteniendo saldo de: %s%s",
+	"%sBalance: %s (%s)%s":                                 "%sSaldo: %s (%s)%s",
+	"%sAccount is FROZEN%s":                                "%sLa cuenta está CONGELADA%s",
+	"%sOutstanding invoices (%d):%s":                       "%sFacturas pendientes (%d):%s",
+	"%s  due %s  %s":                                       "%s  vence %s  %s",
+	"%s%s  OVERDUE%s":                                      "%s%s  VENCIDA%s",
+	"%sUnallocated payments (%d):%s":                       "%sPagos sin asignar (%d):%s",
+	"Outstanding:       %s":                                "Pendiente:         %s",
+	"Unbilled orders:   %s":                                "Sin facturar:      %s",
+	"Unallocated:      -%s":                                "Sin asignar:      -%s",
+	"%sExposure:          %s%s":                            "%sRiesgo:            %s%s",
+	"Credit limit:      %snone%s":                          "Límite crédito:    %sninguno%s",
+	"Credit limit:      %s":                                "Límite crédito:    %s",
+	"(checked on invoices only)":                           "(solo se comprueba en facturas)",
+	"Available credit:  %s%s%s":                            "Crédito libre:     %s%s%s",
+	"sales order not found":                                "pedido de venta no encontrado",
+	"customer %s is frozen (use --force to submit anyway)": "el cliente %s está congelado (usa --force para validar igualmente)",
+	"%sWarning: %s would exceed the credit limit of %s%s":  "%sAviso: %s superaría el límite de crédito de %s%s",
+	"Credit limit:     %s":                                 "Límite crédito:   %s",
+	"Current exposure: %s":                                 "Riesgo actual:    %s",
+	"This order:       %s":                                 "Este pedido:      %s",
+	"Over limit by:    %s%s%s":                             "Exceso:           %s%s%s",
+	"credit limit exceeded (use --force to submit anyway)": "límite de crédito superado (usa --force para validar igualmente)",
+
+	"invalid --exchange-rate: %s": "--exchange-rate no válido: %s",
+	"no exchange rate from %s to %s on or before %s (add one with 'erp-cli fx set %s %s <rate>' or pass --exchange-rate)": "no hay tipo de cambio de %s a %s en o antes del %s (añade uno con 'erp-cli fx set %s %s <tipo>' o indica --exchange-rate)",
+	"--exchange-rate needs --currency":                          "--exchange-rate necesita --currency",
+	"the order is in %s, not %s":                                "el pedido está en %s, no en %s",
+	"the invoice is in %s, not %s":                              "la factura está en %s, no en %s",
+	"--exchange-rate only applies to foreign-currency invoices": "--exchange-rate solo se aplica a facturas en moneda extranjera",
+	"Currency: %s (1 %s = %s)":                                  "Moneda: %s (1 %s = %s)",
+	"%s (%s at %g)":                                             "%s (%s a %g)",
+
+	// Customers
+	"Usage: erp-cli customer <subcommand> [args...]":                                            "Uso: erp-cli customer <subcomando> [argumentos...]",
+	"Subcommands: list, get, balance, create, set, add-address, add-contact, addresses, delete": "Subcomandos: list, get, balance, create, set, add-address, add-contact, addresses, delete",
+	"usage: erp-cli customer get <name>":                                                        "uso: erp-cli customer get <nombre>",
+	"usage: erp-cli customer balance <name>":                                                    "uso: erp-cli customer balance <nombre>",
+	"usage: erp-cli customer create <name> [--group=X] [--territory=X]":                         "uso: erp-cli customer create <nombre> [--group=X] [--territory=X]",
+	"usage: erp-cli customer set <name> <property=value> [property=value...]":                   "uso: erp-cli customer set <nombre> <propiedad=valor> [propiedad=valor...]",
+	"usage: erp-cli customer add-address <name> --line1=X --city=X --country=X [--type=Billing|Shipping] [--line2=X] [--state=X] [--zip=X] [--email=X] [--phone=X] [--primary] [--shipping]": "uso: erp-cli customer add-address <nombre> --line1=X --city=X --country=X [--type=Billing|Shipping] [--line2=X] [--state=X] [--zip=X] [--email=X] [--phone=X] [--primary] [--shipping]",
+	"usage: erp-cli customer add-contact <name> <first_name> [--last=X] [--email=X] [--phone=X] [--mobile=X] [--primary]":                                                                    "uso: erp-cli customer add-contact <nombre> <nombre_pila> [--last=X] [--email=X] [--phone=X] [--mobile=X] [--primary]",
+	"usage: erp-cli customer addresses <name>": "uso: erp-cli customer addresses <nombre>",
+	"usage: erp-cli customer delete <name>":    "uso: erp-cli customer delete <nombre>",
+	"unknown customer subcommand: %s":          "subcomando de customer desconocido: %s",
+	"%sFetching customers...%s":                "%sObteniendo clientes...%s",
+	"%sNo customers found%s":                   "%sNo se encontraron clientes%s",
+	"%sCustomers (%d):%s":                      "%sClientes (%d):%s",
+	"%sFetching customer: %s%s":                "%sObteniendo cliente: %s%s",
+	"%sCreating customer: %s%s":                "%sCreando cliente: %s%s",
+	"Group: %s":                                "Grupo: %s",
+	"Territory: %s":                            "Territorio: %s",
+	"%s✓ Customer created: %s%s":               "%s✓ Cliente creado: %s%s",
+	"%sDeleting customer: %s%s":                "%sBorrando cliente: %s%s",
+	"%s✓ Customer deleted: %s%s":               "%s✓ Cliente borrado: %s%s",
+
+	// Posting dates
+	"invalid posting date (expected YYYY-MM-DD): %s": "fecha de contabilización no válida (se espera AAAA-MM-DD): %s",
+	"invalid posting time (expected HH:MM[:SS]): %s": "hora de contabilización no válida (se espera HH:MM[:SS]): %s",
+	"Posting: %s %s (%s)":                            "Contabilización: %s %s (%s)",
+
+	// Delivery notes
+	"Usage: erp-cli dn <subcommand> [args...]":                    "Uso: erp-cli dn <subcomando> [argumentos...]",
+	"Subcommands: list, get, create-from-so, submit, cancel, pdf": "Subcomandos: list, get, create-from-so, submit, cancel, pdf",
+	"usage: erp-cli dn get <name> [--template=file.tmpl]":         "uso: erp-cli dn get <nombre> [--template=archivo.tmpl]",
+	"usage: erp-cli dn create-from-so <so_name> [--shipping-rule=X] [--billing-address=X] [--shipping-address=X] [--terms=X] [--remarks=\"...\"] [--letterhead=X]": "uso: erp-cli dn create-from-so <pedido_venta> [--shipping-rule=X] [--billing-address=X] [--shipping-address=X] [--terms=X] [--remarks=\"...\"] [--letterhead=X]",
+	"usage: erp-cli dn submit <name>":                                                         "uso: erp-cli dn submit <nombre>",
+	"usage: erp-cli dn cancel <name> [--cascade] [--yes]":                                     "uso: erp-cli dn cancel <nombre> [--cascade] [--yes]",
+	"usage: erp-cli dn pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]": "uso: erp-cli dn pdf <nombre> [-o archivo.pdf] [--print-format=X] [--letterhead=Y] [--open]",
+	"unknown dn subcommand: %s":                                                               "subcomando de dn desconocido: %s",
+	"%sFetching delivery notes...%s":                                                          "%sObteniendo albaranes...%s",
+	"%sNo delivery notes found%s":                                                             "%sNo se encontraron albaranes%s",
+	"%sDelivery Notes (%d):%s":                                                                "%sAlbaranes (%d):%s",
+	"Date: %s | Status: %s%s%s | Total: %s":                                                   "Fecha: %s | Estado: %s%s%s | Total: %s",
+	"%sFetching delivery note: %s%s":                                                          "%sObteniendo albarán: %s%s",
+	"%sDelivery Note: %s%s":                                                                   "%sAlbarán: %s%s",
+	"Date: %s":                                                                                "Fecha: %s",
+	"Status: %s":                                                                              "Estado: %s",
+	"Total: %s":                                                                               "Total: %s",
+	"%sItems:%s":                                                                              "%sArtículos:%s",
+	"(SO: %s)":                                                                                "(pedido: %s)",
+	"%sCreating delivery note from SO: %s%s":                                                  "%sCreando albarán desde el pedido de venta: %s%s",
+	"%s✓ Delivery Note created: %s%s":                                                         "%s✓ Albarán creado: %s%s",
+	"From SO: %s":                                                                             "Desde pedido de venta: %s",
+	"Items: %d":                                                                               "Artículos: %d",
+	"Use 'erp-cli dn submit %s' to submit":                                                    "Usa 'erp-cli dn submit %s' para validarlo",
+	"sales order must be submitted first":                                                     "primero hay que validar el pedido de venta",
+	"no items found in sales order":                                                           "el pedido de venta no tiene artículos",
+	"%sSubmitting delivery note: %s%s":                                                        "%sValidando albarán: %s%s",
+	"%s✓ Delivery Note submitted: %s%s":                                                       "%s✓ Albarán validado: %s%s",
+	"%sCancelling delivery note: %s%s":                                                        "%sCancelando albarán: %s%s",
+	"%s✓ Delivery Note cancelled: %s%s":                                                       "%s✓ Albarán cancelado: %s%s",
+
+	// Document defaults
+	"Usage: erp-cli config set <key> [value]":   "Uso: erp-cli config set <clave> [valor]",
+	"Keys (an empty value clears the default):": "Claves (un valor vacío borra el valor por defecto):",
+	"Example:":               "Ejemplo:",
+	"cannot check %s %q: %w": "no se puede comprobar %s %q: %w",
+	"%s✓ Cleared %s%s":       "%s✓ %s borrado%s",
+	"%s✓ Saved %s=\"%s\"%s":  "%s✓ Guardado %s=\"%s\"%s",
+	"unknown setting: %s (see 'erp-cli config set')": "ajuste desconocido: %s (ver 'erp-cli config set')",
+
+	// Document dumps
+	"--filter needs key=value":                     "--filter necesita clave=valor",
+	"invalid filter %q (expected key=value)":       "filtro no válido %q (se espera clave=valor)",
+	"%sExporting %s documents...%s":                "%sExportando documentos %s...%s",
+	"%sNo %s documents found%s":                    "%sNo se encontraron documentos %s%s",
+	"failed to encode %s: %w":                      "no se pudo codificar %s: %w",
+	"failed to write file: %w":                     "no se pudo escribir el archivo: %w",
+	"%s✓ Exported %d %s documents to %s%s":         "%s✓ %d documentos %s exportados a %s%s",
+	"%s[DRY RUN] Importing documents from: %s%s":   "%s[SIMULACIÓN] Importando documentos desde: %s%s",
+	"%sImporting documents from: %s%s":             "%sImportando documentos desde: %s%s",
+	"%sLine %d: skipped (invalid JSON: %s)%s":      "%sLínea %d: omitida (JSON no válido: %s)%s",
+	"invalid JSON":                                 "JSON no válido",
+	"%sLine %d: skipped (no doctype or name)%s":    "%sLínea %d: omitida (sin doctype o nombre)%s",
+	"no doctype or name":                           "sin doctype o nombre",
+	"%s- Exists: %s (use --upsert to overwrite)%s": "%s- Ya existe: %s (usa --upsert para sobrescribir)%s",
+	"exists":                     "ya existe",
+	"[DRY RUN] Would update: %s": "[SIMULACIÓN] Se actualizaría: %s",
+	"[DRY RUN] Would create: %s": "[SIMULACIÓN] Se crearía: %s",
+	"%s✓ Updated: %s%s":          "%s✓ Actualizado: %s%s",
+	"%s✓ Created: %s%s":          "%s✓ Creado: %s%s",
+	"%sSummary: %d created, %d updated, %d skipped, %d failed%s": "%sResumen: %d creados, %d actualizados, %d omitidos, %d fallidos%s",
+
+	// Doctor
+	"%sConfiguration%s": "%sConfiguración%s",
+	"Config file: %s":   "Archivo de configuración: %s",
+	"Set ERP_API_KEY and ERP_API_SECRET (User > Settings > API Access > Generate Keys)": "Define ERP_API_KEY y ERP_API_SECRET (Usuario > Ajustes > Acceso API > Generar claves)",
+	"API key or secret missing": "Falta la clave o el secreto de API",
+	"API key: %s…":              "Clave de API: %s…",
+	"Set ERP_URL to the site's address, e.g. https://erp.example.com": "Define ERP_URL con la dirección del sitio, p. ej. https://erp.example.com",
+	"ERP_URL missing": "Falta ERP_URL",
+	"VPN %s vs internet %s: the CLI uses the VPN when it answers within 2s": "VPN %s frente a internet %s: la CLI usa la VPN cuando responde en menos de 2s",
+	"the site is not reachable; %d problem(s) found":                        "no se puede acceder al sitio; %d problema(s) encontrado(s)",
+	"%sServer%s":                                                   "%sServidor%s",
+	"%sPermissions (read)%s":                                       "%sPermisos (lectura)%s",
+	"%d problem(s) and %d warning(s) found":                        "%d problema(s) y %d aviso(s) encontrados",
+	"%s%d warning(s), no problems%s":                               "%s%d aviso(s), ningún problema%s",
+	"%s✓ All checks passed%s":                                      "%s✓ Todas las comprobaciones correctas%s",
+	"Use a full address with scheme, e.g. https://erp.example.com": "Usa una dirección completa con esquema, p. ej. https://erp.example.com",
+	"Invalid URL: %s":                                              "URL no válida: %s",
+	"Use https:// so the API token is not sent in clear text":      "Usa https:// para que el token de API no viaje en claro",
+	"Plain HTTP over the internet":                                 "HTTP sin cifrar por internet",
+	"DNS: %s does not resolve":                                     "DNS: %s no se resuelve",
+	"DNS: %s → %s (%s)":                                            "DNS: %s → %s (%s)",
+	"Renew the certificate, or make sure ERP_URL uses the name it was issued for": "Renueva el certificado, o asegúrate de que ERP_URL usa el nombre para el que se emitió",
+	"TLS: %s":     "TLS: %s",
+	"Connect: %s": "Conexión: %s",
+	"Check that the server is up and port %s is open from this network":       "Comprueba que el servidor está en marcha y que el puerto %s está abierto desde esta red",
+	"Renew the certificate before it expires (e.g. bench setup lets-encrypt)": "Renueva el certificado antes de que caduque (p. ej. bench setup lets-encrypt)",
+	"TLS: certificate expires in %d day(s) (%s)":                              "TLS: el certificado caduca en %d día(s) (%s)",
+	"TLS: valid until %s (%s)":                                                "TLS: válido hasta %s (%s)",
+	"Check that the server is up and reachable from this network":             "Comprueba que el servidor está en marcha y es accesible desde esta red",
+	"Ping: %s":      "Ping: %s",
+	"Ping: HTTP %d": "Ping: HTTP %d",
+	"Consider ERP_LOW_BANDWIDTH=1 for fewer and smaller requests": "Considera ERP_LOW_BANDWIDTH=1 para hacer menos peticiones y más pequeñas",
+	"Latency: %s":                "Latencia: %s",
+	"Authentication: %s":         "Autenticación: %s",
+	"Token: authenticated as %s": "Token: autenticado como %s",
+	"Regenerate the keys in User > Settings > API Access and update ERP_API_KEY/ERP_API_SECRET; the user must be enabled": "Regenera las claves en Usuario > Ajustes > Acceso API y actualiza ERP_API_KEY/ERP_API_SECRET; el usuario debe estar activo",
+	"Token rejected (HTTP %d)": "Token rechazado (HTTP %d)",
+	"Authentication: HTTP %d":  "Autenticación: HTTP %d",
+	"Sync this machine's clock (NTP); dates near midnight may be off": "Sincroniza el reloj de esta máquina (NTP); las fechas cerca de medianoche pueden no cuadrar",
+
+	"Clock differs from the server's by %s":              "El reloj difiere del del servidor en %s",
+	"Versions not available":                             "Versiones no disponibles",
+	"The site needs the ERPNext app installed":           "El sitio necesita tener instalada la app ERPNext",
+	"ERPNext is not installed (%s)":                      "ERPNext no está instalado (%s)",
+	"Upgrade the site; older versions are not supported": "Actualiza el sitio; las versiones antiguas no están soportadas",
+	"Create a company in ERPNext, or set ERP_COMPANY":    "Crea una empresa en ERPNext, o define ERP_COMPANY",
+	"Time zone: %s":                "Zona horaria: %s",
+	"All %d key doctypes readable": "Los %d doctypes clave se pueden leer",
+	"%s: no read access":           "%s: sin acceso de lectura",
+	"→ Give the API user a role with these doctypes (e.g. Stock User, Sales User,": "→ Da al usuario de la API un rol con estos doctypes (p. ej. Stock User, Sales User,",
+	"Purchase User, Accounts User) in User > Roles":                                "Purchase User, Accounts User) en Usuario > Roles",
+
+	// Employees
+	"Usage: erp-cli employee <subcommand> [args...]":                               "Uso: erp-cli employee <subcomando> [argumentos...]",
+	"Subcommands: list, get, me":                                                   "Subcomandos: list, get, me",
+	"usage: erp-cli employee get <id>":                                             "uso: erp-cli employee get <id>",
+	"unknown employee subcommand: %s":                                              "subcomando de employee desconocido: %s",
+	"no active employee is linked to user %s (set User ID on the Employee record)": "ningún empleado activo está vinculado al usuario %s (rellena el ID de usuario en la ficha del empleado)",
+	"%sFetching employees...%s":                                                    "%sObteniendo empleados...%s",
+	"%sNo employees found%s":                                                       "%sNo se encontraron empleados%s",
+	"%sEmployees (%d):%s":                                                          "%sEmpleados (%d):%s",
+	"%sFetching employee: %s%s":                                                    "%sObteniendo empleado: %s%s",
+
+	// Expense claims
+	"Usage: erp-cli expense <subcommand> [args...]":                                                                             "Uso: erp-cli expense <subcomando> [argumentos...]",
+	"Subcommands: list, get, types, create, add, attach, submit":                                                                "Subcomandos: list, get, types, create, add, attach, submit",
+	"Claims are filed for the employee linked to your API user.":                                                                "Las notas de gastos se presentan a nombre del empleado vinculado a tu usuario de API.",
+	"usage: erp-cli expense get <name>":                                                                                         "uso: erp-cli expense get <nombre>",
+	"usage: erp-cli expense create <type> <amount> [--desc=X] [--date=YYYY-MM-DD] [--receipt=file] [--posting-date=YYYY-MM-DD]": "uso: erp-cli expense create <tipo> <importe> [--desc=X] [--date=AAAA-MM-DD] [--receipt=archivo] [--posting-date=AAAA-MM-DD]",
+	"invalid amount: %s": "importe no válido: %s",
+	"usage: erp-cli expense add <name> <type> <amount> [--desc=X] [--date=YYYY-MM-DD] [--receipt=file]": "uso: erp-cli expense add <nombre> <tipo> <importe> [--desc=X] [--date=AAAA-MM-DD] [--receipt=archivo]",
+	"usage: erp-cli expense attach <name> <file> [file...]":                                             "uso: erp-cli expense attach <nombre> <archivo> [archivo...]",
+	"usage: erp-cli expense submit <name>":                                                              "uso: erp-cli expense submit <nombre>",
+	"unknown expense subcommand: %s":                                                                    "subcomando de expense desconocido: %s",
+	"%sCreating expense claim for %s...%s":                                                              "%sCreando nota de gastos para %s...%s",
+	"%s✓ Expense claim created: %s%s":                                                                   "%s✓ Nota de gastos creada: %s%s",
+	"Approver: %s":                                                                                      "Aprobador: %s",
+	"unexpected response creating expense claim":                                                        "respuesta inesperada al crear la nota de gastos",
+	"%sAdding expense to: %s%s":                                                                         "%sAñadiendo gasto a: %s%s",
+	"expense claim not found: %s":                                                                       "nota de gastos no encontrada: %s",
+	"can only add expenses to draft claims":                                                             "solo se pueden añadir gastos a notas en borrador",
+	"%s✓ Added %s: %s%s":                                                                                "%s✓ Añadido %s: %s%s",
+	"%s✓ Receipt attached: %s%s":                                                                        "%s✓ Justificante adjuntado: %s%s",
+	"%sSubmitting expense claim: %s%s":                                                                  "%sValidando nota de gastos: %s%s",
+	"%s✓ Expense claim submitted: %s%s":                                                                 "%s✓ Nota de gastos validada: %s%s",
+	"%s is not a draft":                                                                                 "%s no es un borrador",
+	"the expense approver":                                                                              "el aprobador de gastos",
+	"%s is waiting for approval by %s; it can be submitted once approved or rejected": "%s está pendiente de aprobación por %s; se podrá validar cuando se apruebe o rechace",
+	"%sFetching expense claims...%s":              "%sObteniendo notas de gastos...%s",
+	"%sNo expense claims found%s":                 "%sNo se encontraron notas de gastos%s",
+	"%sExpense Claims (%d):%s":                    "%sNotas de gastos (%d):%s",
+	"Status: %s%s%s | Approval: %s | Claimed: %s": "Estado: %s%s%s | Aprobación: %s | Reclamado: %s",
+	"%sFetching expense claim: %s%s":              "%sObteniendo nota de gastos: %s%s",
+	"%sExpense Claim: %s%s":                       "%sNota de gastos: %s%s",
+	"Employee: %s (%s)":                           "Empleado: %s (%s)",
+	"Status: %s | Approval: %s":                   "Estado: %s | Aprobación: %s",
+	"Claimed: %s | Sanctioned: %s":                "Reclamado: %s | Aprobado: %s",
+	"%sExpenses:%s":                               "%sGastos:%s",
+	"%sReceipts:%s":                               "%sJustificantes:%s",
+	"%sFetching expense claim types...%s":         "%sObteniendo tipos de gasto...%s",
+	"%sNo expense claim types found%s":            "%sNo se encontraron tipos de gasto%s",
+	"%sExpense Claim Types (%d):%s":               "%sTipos de gasto (%d):%s",
+
+	// Filters
+	"failed to encode filters: %w": "no se pudieron codificar los filtros: %w",
+
+	// Fiscal years
+	"invalid transaction date (expected YYYY-MM-DD): %s":                "fecha de transacción no válida (se espera AAAA-MM-DD): %s",
+	"%s is not in an open fiscal year (see 'erp-cli fiscal-year list')": "%s no está en un ejercicio fiscal abierto (ver 'erp-cli fiscal-year list')",
+	"%s is in the frozen period (accounts are frozen up to %s)":         "%s está en el periodo congelado (las cuentas están congeladas hasta %s)",
+	"Usage: erp-cli fiscal-year <subcommand>":                           "Uso: erp-cli fiscal-year <subcomando>",
+	"Subcommands: list": "Subcomandos: list",
+	"Documents can be dated with --posting-date (invoices, receipts, deliveries,": "Los documentos se pueden fechar con --posting-date (facturas, recepciones, entregas,",
+	"payments, stock and journal entries) or --transaction-date (quotations and":  "pagos, asientos de stock y contables) o --transaction-date (presupuestos y",
+	"orders) within an open fiscal year and after the frozen period.":             "pedidos) dentro de un ejercicio fiscal abierto y después del periodo congelado.",
+	"unknown fiscal-year subcommand: %s":                                          "subcomando de fiscal-year desconocido: %s",
+	"%sFetching fiscal years...%s":                                                "%sObteniendo ejercicios fiscales...%s",
+	"%sNo fiscal years found%s":                                                   "%sNo se encontraron ejercicios fiscales%s",
+	"%sFiscal Years (%d):%s":                                                      "%sEjercicios fiscales (%d):%s",
+	"%s: %s to %s | %s%s":                                                         "%s: %s a %s | %s%s",
+	"Accounts frozen up to: %s%s%s":                                               "Cuentas congeladas hasta: %s%s%s",
+
+	// Flows
+	"Usage: erp-cli flow <subcommand> [args...]": "Uso: erp-cli flow <subcomando> [argumentos...]",
+	"Subcommands: sell, buy":                     "Subcomandos: sell, buy",
+	"sell --customer=X --item CODE:QTY[:RATE] ... [--deliver] [--invoice] [--collect]":                     "sell --customer=X --item CÓDIGO:CANT[:PRECIO] ... [--deliver] [--invoice] [--collect]",
+	"Sales Order, then Delivery Note, Sales Invoice and Payment Entry as asked":                            "Pedido de venta, y después albarán, factura de venta y pago según se pida",
+	"(--collect implies --invoice). Also --posting-date=YYYY-MM-DD, --force":                               "(--collect implica --invoice). También --posting-date=AAAA-MM-DD, --force",
+	"(submit over the credit limit, as so submit --force), --notify|--no-notify":                           "(validar por encima del límite de crédito, como so submit --force), --notify|--no-notify",
+	"(email the order and invoice, as their submit commands do) and --yes.":                                "(enviar por correo el pedido y la factura, como sus comandos submit) y --yes.",
+	"buy --supplier=X --item CODE:QTY:RATE ... [--receive] [--bill] [--pay]":                               "buy --supplier=X --item CÓDIGO:CANT:PRECIO ... [--receive] [--bill] [--pay]",
+	"Purchase Order, then Purchase Receipt, Purchase Invoice and Payment Entry":                            "Pedido de compra, y después albarán de compra, factura de compra y pago",
+	"as asked (--pay implies --bill). Also --posting-date=YYYY-MM-DD and --yes.":                           "según se pida (--pay implica --bill). También --posting-date=AAAA-MM-DD y --yes.",
+	"Every document is created and submitted after a single confirmation. If a":                            "Todos los documentos se crean y validan tras una única confirmación. Si un",
+	"step fails, the documents already created are cancelled and deleted.":                                 "paso falla, los documentos ya creados se cancelan y se borran.",
+	"--set field=value fills the first document; --set \"si:field=value\" (or any":                         "--set campo=valor rellena el primer documento; --set \"si:campo=valor\" (o cualquier",
+	"doctype of the flow) the others.":                                                                     "doctype del flujo) los demás.",
+	"unknown flow subcommand: %s":                                                                          "subcomando de flow desconocido: %s",
+	"--item needs CODE:QTY":                                                                                "--item necesita CÓDIGO:CANT",
+	"invalid item %q (expected CODE:QTY or CODE:QTY:RATE)":                                                 "artículo no válido %q (se espera CÓDIGO:CANT o CÓDIGO:CANT:PRECIO)",
+	"invalid quantity in item: %s":                                                                         "cantidad no válida en el artículo: %s",
+	"invalid rate in item: %s":                                                                             "precio no válido en el artículo: %s",
+	"usage: erp-cli flow sell --customer=X --item CODE:QTY[:RATE] ... [--deliver] [--invoice] [--collect]": "uso: erp-cli flow sell --customer=X --item CÓDIGO:CANT[:PRECIO] ... [--deliver] [--invoice] [--collect]",
+	"at least one --item CODE:QTY is required":                                                             "se necesita al menos un --item CÓDIGO:CANT",
+	"%sForced: %s%s": "%sForzado: %s%s",
+	"%sSell to %s%s": "%sVender a %s%s",
+	"usage: erp-cli flow buy --supplier=X --item CODE:QTY:RATE ... [--receive] [--bill] [--pay]": "uso: erp-cli flow buy --supplier=X --item CÓDIGO:CANT:PRECIO ... [--receive] [--bill] [--pay]",
+	"at least one --item CODE:QTY:RATE is required":                                              "se necesita al menos un --item CÓDIGO:CANT:PRECIO",
+	"%sBuy from %s%s":                            "%sComprar a %s%s",
+	"%sSteps:%s":                                 "%sPasos:%s",
+	"Create and submit %d documents?":            "¿Crear y validar %d documentos?",
+	"flow stopped at %s: %w":                     "el flujo se detuvo en %s: %w",
+	"%s✓ %s submitted: %s%s":                     "%s✓ %s validado: %s%s",
+	"%sFlow complete:%s":                         "%sFlujo completado:%s",
+	"the server did not return the new document": "el servidor no devolvió el documento nuevo",
+	"%sRolling back %d document(s)...%s":         "%sDeshaciendo %d documento(s)...%s",
+	"%s✗ Cannot cancel %s %s: %s%s":              "%s✗ No se puede cancelar %s %s: %s%s",
+	"%s %s (submitted)":                          "%s %s (validado)",
+	"%s✗ Cannot delete %s %s: %s%s":              "%s✗ No se puede borrar %s %s: %s%s",
+	"%s✓ Rolled back: %s %s%s":                   "%s✓ Deshecho: %s %s%s",
+	"%sLeft behind (clean up by hand):%s":        "%sQuedan pendientes (límpialos a mano):%s",
+
+	// Exchange rates
+	"Usage: erp-cli fx <subcommand> [args...]":                            "Uso: erp-cli fx <subcomando> [argumentos...]",
+	"Subcommands: list, set, sync":                                        "Subcomandos: list, set, sync",
+	"Rates are Currency Exchange records, used by --currency on document": "Los tipos son registros Currency Exchange, que usa --currency al crear",
+	"creation. A rate is the units of <to> for one <from>.":               "documentos. Un tipo son las unidades de <hasta> por cada <desde>.",
+	"usage: erp-cli fx set <from> <to> <rate> [--date=YYYY-MM-DD]":        "uso: erp-cli fx set <desde> <hasta> <tipo> [--date=AAAA-MM-DD]",
+
+	"invalid rate: %s": "tipo no válido: %s",
+	"no currencies to sync (set ERP_FX_CURRENCIES or pass --currencies=EUR,GBP)": "no hay monedas que sincronizar (define ERP_FX_CURRENCIES o indica --currencies=EUR,GBP)",
+	"unknown fx subcommand: %s":                                         "subcomando de fx desconocido: %s",
+	"%sFetching exchange rates...%s":                                    "%sObteniendo tipos de cambio...%s",
+	"%sNo exchange rates found%s":                                       "%sNo se encontraron tipos de cambio%s",
+	"Exchange rates":                                                    "Tipos de cambio",
+	"Exchange rates on %s":                                              "Tipos de cambio a %s",
+	"from and to are the same currency":                                 "origen y destino son la misma moneda",
+	"%s✓ Exchange rate %s: 1 %s = %g %s on %s%s":                        "%s✓ Tipo de cambio %s: 1 %s = %g %s a %s%s",
+	"nothing to sync: %s is the company currency":                       "nada que sincronizar: %s es la moneda de la empresa",
+	"%sFetching %s rates for %s...%s":                                   "%sObteniendo tipos de %s para %s...%s",
+	"%s✗ %s: not offered by the provider%s":                             "%s✗ %s: el proveedor no la ofrece%s",
+	"%s✓ %d of %d rates saved%s":                                        "%s✓ %d de %d tipos guardados%s",
+	"%d rates could not be synced":                                      "no se pudieron sincronizar %d tipos",
+	"rate provider: %w":                                                 "proveedor de tipos: %w",
+	"rate provider returned %d: %s":                                     "el proveedor de tipos devolvió %d: %s",
+	"rate provider reply has no \"rates\" object (see ERP_FX_PROVIDER)": "la respuesta del proveedor de tipos no tiene objeto \"rates\" (ver ERP_FX_PROVIDER)",
+
+	// History
+	"Usage: erp-cli history [--limit=N] [--last]":                          "Uso: erp-cli history [--limit=N] [--last]",
+	"erp-cli history <doctype> <name> [--limit=N]":                         "erp-cli history <doctype> <nombre> [--limit=N]",
+	"Without a document, lists the commands run from this machine and the": "Sin documento, lista los comandos ejecutados desde esta máquina y los",
+	"documents they created (--last prints only the last created name).":   "documentos que crearon (--last muestra solo el nombre del último creado).",
+	"With one, shows its saved versions, newest first, with the user, the": "Con uno, muestra sus versiones guardadas, de la más nueva a la más antigua, con el usuario, la",
+	"time and every field that changed.":                                   "hora y cada campo que cambió.",
+	"%sFetching history of %s %s...%s":                                     "%sObteniendo historial de %s %s...%s",
+	"%sNo changes recorded for %s %s (is Track Changes enabled for %s?)%s": "%sNo hay cambios registrados para %s %s (¿está activado Track Changes en %s?)%s",
+	"%sHistory of %s %s:%s":                                                "%sHistorial de %s %s:%s",
+	"%sShowing the latest %d changes (use --limit=N for more)%s":           "%sMostrando los últimos %d cambios (usa --limit=N para ver más)%s",
+	"+ %v row: %s":        "+ fila de %v: %s",
+	"- %v row: %s":        "- fila de %v: %s",
+	"%v row %d: %s":       "%v fila %d: %s",
+	"docstatus: %s -> %s": "docstatus: %s -> %s",
+
+	// Export and import
+	"Usage: erp-cli export <type> -o <file> [--xlsx]":                                   "Uso: erp-cli export <tipo> -o <archivo> [--xlsx]",
+	"erp-cli export doc <doctype> [--filter key=value ...] -o <file.jsonl>":             "erp-cli export doc <doctype> [--filter clave=valor ...] -o <archivo.jsonl>",
+	"Types: items, templates, attributes, variants, boms, doc":                          "Tipos: items, templates, attributes, variants, boms, doc",
+	"Files ending in .xlsx (or any file with --xlsx) are written as Excel":              "Los archivos que acaban en .xlsx (o cualquiera con --xlsx) se escriben como hojas",
+	"spreadsheets, keeping leading zeros in item codes.":                                "de Excel, conservando los ceros a la izquierda de los códigos.",
+	"output file required. Use -o <file>":                                               "falta el archivo de salida. Usa -o <archivo>",
+	"usage: erp-cli export variants <template> -o <file>":                               "uso: erp-cli export variants <plantilla> -o <archivo>",
+	"usage: erp-cli export doc <doctype> [--filter key=value ...] -o <file.jsonl>":      "uso: erp-cli export doc <doctype> [--filter clave=valor ...] -o <archivo.jsonl>",
+	"--xlsx is not supported for document dumps":                                        "--xlsx no se admite en volcados de documentos",
+	"unknown export type: %s":                                                           "tipo de exportación desconocido: %s",
+	"Usage: erp-cli import <type> -f <file> [--map <file.yaml>] [--dry-run] [--resume]": "Uso: erp-cli import <tipo> -f <archivo> [--map <archivo.yaml>] [--dry-run] [--resume]",
+	"erp-cli import doc -f <file.jsonl> [--upsert] [--dry-run] [--resume]":              "erp-cli import doc -f <archivo.jsonl> [--upsert] [--dry-run] [--resume]",
+	"Types: items, variants, stock, prices, boms, doc":                                  "Tipos: items, variants, stock, prices, boms, doc",
+	"stock: columns item_code, warehouse, qty, rate (optional valuation rate).":         "stock: columnas item_code, warehouse, qty, rate (tasa de valoración opcional).",
+	"One Opening Stock reconciliation per warehouse, or a Material Receipt":             "Una regularización de stock inicial por almacén, o una entrada de material",
+	"with --receipt. Also --difference-account=X, --posting-date=YYYY-MM-DD.":           "con --receipt. También --difference-account=X, --posting-date=AAAA-MM-DD.",
+	"prices: columns item_code, price_list, rate (creates or updates Item Prices).":     "prices: columnas item_code, price_list, rate (crea o actualiza precios de artículo).",
+	"boms: columns parent, component, qty, rate and quantity (optional). One":           "boms: columnas parent, component, qty, rate y quantity (opcional). Una",
+	"submitted BOM per parent; sub-assemblies are created before their parents.":        "lista de materiales validada por padre; los subconjuntos se crean antes que sus padres.",
+	"import doc reads a dump from `export doc`. Existing documents are":                 "import doc lee un volcado de `export doc`. Los documentos existentes se",
+	"skipped unless --upsert, which overwrites them with the dumped version.":           "omiten salvo con --upsert, que los sobrescribe con la versión volcada.",
+	"--map mapping.yaml renames input columns to the fields above, fills":               "--map mapping.yaml renombra las columnas de entrada a los campos anteriores, rellena",
+	"defaults and applies transforms (trim, uppercase, lowercase):":                     "valores por defecto y aplica transformaciones (trim, uppercase, lowercase):",
+	"Every row's outcome is written to <file>.results.csv as it happens. After a":       "El resultado de cada fila se escribe en <archivo>.results.csv según ocurre. Tras una",
+	"failed run, --resume skips the rows that already succeeded.":                       "ejecución fallida, --resume omite las filas que ya se importaron.",
+	"input file required. Use -f <file>":                                                "falta el archivo de entrada. Usa -f <archivo>",
+	"unknown import type: %s":                                                           "tipo de importación desconocido: %s",
+	"%sExporting %s...%s":                                                               "%sExportando %s...%s",
+	"%s✓ Exported %d %s to %s%s":                                                        "%s✓ Exportados %d %s a %s%s",
+	"%sExporting attributes...%s":                                                       "%sExportando atributos...%s",
+	"%s✓ Exported %d attributes to %s%s":                                                "%s✓ %d atributos exportados a %s%s",
+	"%sExporting variants of: %s%s":                                                     "%sExportando variantes de: %s%s",
+	"%s✓ Exported %d variants to %s%s":                                                  "%s✓ %d variantes exportadas a %s%s",
+	"%s[DRY RUN] Importing items from: %s%s":                                            "%s[SIMULACIÓN] Importando artículos desde: %s%s",
+	"%sImporting items from: %s%s":                                                      "%sImportando artículos desde: %s%s",
+	"%sRow %d: skipped (insufficient columns)%s":                                        "%sFila %d: omitida (faltan columnas)%s",
+	"insufficient columns":                                                              "faltan columnas",
+	"%sRow %d: skipped (no item_code)%s":                                                "%sFila %d: omitida (sin item_code)%s",
+	"no item_code":                                                                      "sin item_code",
+	"%s[DRY RUN] Importing variants from: %s%s":                                         "%s[SIMULACIÓN] Importando variantes desde: %s%s",
+	"%sImporting variants from: %s%s":                                                   "%sImportando variantes desde: %s%s",
+	"CSV must have 'template' and 'item_code' columns":                                  "el CSV debe tener las columnas 'template' e 'item_code'",
+	"%sRow %d: skipped (template not found: %s)%s":                                      "%sFila %d: omitida (plantilla no encontrada: %s)%s",
+	"template not found:":                                                               "plantilla no encontrada:",
+	"[DRY RUN] Would create: %s (%s)":                                                   "[SIMULACIÓN] Se crearía: %s (%s)",
+	"Attributes: %s":                                                                    "Atributos: %s",
+
+	// Import mappings and runs
+	"failed to open mapping: %w":                                           "no se pudo abrir el mapeo: %w",
+	"%s:%d: expected sections (columns, defaults, transforms)":             "%s:%d: se esperan secciones (columns, defaults, transforms)",
+	"%s:%d: unknown section %q (expected columns, defaults or transforms)": "%s:%d: sección desconocida %q (se espera columns, defaults o transforms)",
+	"%s:%d: %s: expected key: value":                                       "%s:%d: %s: se espera clave: valor",
+	"%s:%d: expected key: value":                                           "%s:%d: se espera clave: valor",
+	"%s:%d: %s: expected a single value":                                   "%s:%d: %s: se espera un único valor",
+	"expected transform names":                                             "se esperan nombres de transformación",
+	"unknown transform %q (use trim, uppercase or lowercase)":              "transformación desconocida %q (usa trim, uppercase o lowercase)",
+	"nothing to resume: %w":                                                "nada que reanudar: %w",
+	"failed to create results file: %w":                                    "no se pudo crear el archivo de resultados: %w",
+	"%s%d rows already imported by the previous run%s":                     "%s%d filas ya importadas en la ejecución anterior%s",
+	"failed to write results file: %w":                                     "no se pudo escribir el archivo de resultados: %w",
+	"Results: %s (rerun with --resume to retry failed rows)":               "Resultados: %s (vuelve a ejecutar con --resume para reintentar las filas fallidas)",
+
+	// Items
+	"Usage: erp-cli item <subcommand> [args...]":                                "Uso: erp-cli item <subcomando> [argumentos...]",
+	"Subcommands: list, get, create, add-attr, set, delete, map-customer-code,": "Subcomandos: list, get, create, add-attr, set, delete, map-customer-code,",
+	"Set options:": "Opciones de set:",
+	"item set <code> serial=on|off       Enable/disable serial numbers":              "item set <code> serial=on|off       Activa/desactiva números de serie",
+	"item set <code> batch=on|off        Enable/disable batch numbers":               "item set <code> batch=on|off        Activa/desactiva números de lote",
+	"item set <code> serial-series=XXX   Set serial number series (e.g., SN-.#####)": "item set <code> serial-series=XXX   Serie de números de serie (p. ej., SN-.#####)",
+	"item set <code> description=\"...\"   Any Item field (description, brand, ...)": "item set <code> description=\"...\"   Cualquier campo de Item (description, brand, ...)",
+	"item set <code> price=450           Selling price (default selling price list)": "item set <code> price=450           Precio de venta (lista de precios de venta por defecto)",
+	"item set <code> buying_price=300    Buying price (default buying price list)":   "item set <code> buying_price=300    Precio de compra (lista de precios de compra por defecto)",
+	"item set <code> barcodes+=4006381333931   Add barcode (barcodes-= removes)":     "item set <code> barcodes+=4006381333931   Añade código de barras (barcodes-= lo quita)",
+	"item set <code> suppliers+=\"Intel:BX8070\" Add supplier part number":           "item set <code> suppliers+=\"Intel:BX8070\" Añade referencia de proveedor",
+	"item set <code> taxes+=\"VAT 21%\"     Add item tax template":                   "item set <code> taxes+=\"VAT 21%\"     Añade plantilla de impuestos de artículo",
+	"item set <code> --json-patch patch.json  Merge fields/child tables from JSON":   "item set <code> --json-patch patch.json  Fusiona campos/tablas hijas desde JSON",
+	"Customer item codes:": "Códigos de artículo del cliente:",
+	"Barcodes (EAN-8, UPC-A, EAN-13, GTIN-14 with check digit validation):":             "Códigos de barras (EAN-8, UPC-A, EAN-13, GTIN-14 con validación del dígito de control):",
+	"item assign-barcodes -f barcodes.csv [--dry-run]   CSV columns: item_code,barcode": "item assign-barcodes -f barcodes.csv [--dry-run]   Columnas CSV: item_code,barcode",
+	"item missing-barcodes                              List items without barcodes":    "item missing-barcodes                              Lista artículos sin código de barras",
+	"usage: erp-cli item get <code>":                                                    "uso: erp-cli item get <código>",
+	"usage: erp-cli item create <code> <name> [group]":                                  "uso: erp-cli item create <código> <nombre> [grupo]",
+	"item group required (or set ERP_DEFAULT_ITEM_GROUP in config)":                     "falta el grupo de artículos (o define ERP_DEFAULT_ITEM_GROUP en la configuración)",
+	"usage: erp-cli item add-attr <code> <attr1> [attr2...]":                            "uso: erp-cli item add-attr <código> <atrib1> [atrib2...]",
+
+	"usage: erp-cli item set <code> <property=value>":                               "uso: erp-cli item set <código> <propiedad=valor>",
+	"usage: erp-cli item delete <code>":                                             "uso: erp-cli item delete <código>",
+	"usage: erp-cli item map-customer-code <code> <customer> <their_code|--remove>": "uso: erp-cli item map-customer-code <código> <cliente> <su_código|--remove>",
+	"unknown item subcommand: %s":                                                   "subcomando de item desconocido: %s",
+	"%sFetching items...%s":                                                         "%sObteniendo artículos...%s",
+	"%sTemplates only:%s":                                                           "%sSolo plantillas:%s",
+	"%sFetching item: %s%s":                                                         "%sObteniendo artículo: %s%s",
+	"%sCreating item: %s%s":                                                         "%sCreando artículo: %s%s",
+	"%s✓ Item created: %s%s":                                                        "%s✓ Artículo creado: %s%s",
+	"Group: %s | UOM: %s":                                                           "Grupo: %s | UdM: %s",
+	"%sAdding attributes to item: %s%s":                                             "%sAñadiendo atributos al artículo: %s%s",
+	"%s✓ Attributes added to: %s%s":                                                 "%s✓ Atributos añadidos a: %s%s",
+	"New attributes: %s":                                                            "Atributos nuevos: %s",
+	"%sDeleting item: %s%s":                                                         "%sBorrando artículo: %s%s",
+	"%s✓ Item deleted: %s%s":                                                        "%s✓ Artículo borrado: %s%s",
+	"%sUpdating item: %s%s":                                                         "%sActualizando artículo: %s%s",
+	"--json-patch requires a file":                                                  "--json-patch necesita un archivo",
+	"invalid setting format '%s'. Use 'property=value'":                             "formato de ajuste no válido '%s'. Usa 'propiedad=valor'",
+	"item not found: %s":                                                            "artículo no encontrado: %s",
+	"Serial Numbers: %senabled%s":                                                   "Números de serie: %sactivados%s",
+	"Serial Numbers: %sdisabled%s":                                                  "Números de serie: %sdesactivados%s",
+	"invalid value for serial: use 'on' or 'off'":                                   "valor no válido para serial: usa 'on' u 'off'",
+	"Batch Numbers: %senabled%s":                                                    "Números de lote: %sactivados%s",
+	"Batch Numbers: %sdisabled%s":                                                   "Números de lote: %sdesactivados%s",
+	"invalid value for batch: use 'on' or 'off'":                                    "valor no válido para batch: usa 'on' u 'off'",
+	"Serial Series: %s%s%s":                                                         "Serie de números de serie: %s%s%s",
+	"Stock Item: %senabled%s":                                                       "Artículo de stock: %sactivado%s",
+	"Stock Item: %sdisabled%s":                                                      "Artículo de stock: %sdesactivado%s",
+	"invalid value for stock: use 'on' or 'off'":                                    "valor no válido para stock: usa 'on' u 'off'",
+	"Valuation Method: %s":                                                          "Método de valoración: %s",
+	"Warranty Period: %s days":                                                      "Periodo de garantía: %s días",
+	"invalid price: %s":                                                             "precio no válido: %s",
+	"Price (%s): %s":                                                                "Precio (%s): %s",
+	"no valid settings provided":                                                    "no se indicó ningún ajuste válido",
+	"%s✓ Item updated: %s%s":                                                        "%s✓ Artículo actualizado: %s%s",
+	"unknown child table '%s' (use barcodes, suppliers or taxes)":                   "tabla hija desconocida '%s' (usa barcodes, suppliers o taxes)",
+	"%s not found in %s":                                                            "%s no encontrado en %s",
+	"failed to read patch file: %w":                                                 "no se pudo leer el archivo de parche: %w",
+	"invalid JSON in %s: %w":                                                        "JSON no válido en %s: %w",
+	"%s: (from %s)":                                                                 "%s: (de %s)",
+	"no customer code for %s on item %s":                                            "no hay código de cliente para %s en el artículo %s",
+	"%s✓ Customer code removed: %s (%s)%s":                                          "%s✓ Código de cliente quitado: %s (%s)%s",
+	"%s✓ Customer code mapped: %s → %s (%s)%s":                                      "%s✓ Código de cliente asignado: %s → %s (%s)%s",
+
+	// Templates, groups and brands
+	"Usage: erp-cli template <subcommand> [args...]":                          "Uso: erp-cli template <subcomando> [argumentos...]",
+	"Subcommands: create":                                                     "Subcomandos: create",
+	"usage: erp-cli template create <code> <name> <group> <attr1> [attr2...]": "uso: erp-cli template create <código> <nombre> <grupo> <atrib1> [atrib2...]",
+	"unknown template subcommand: %s":                                         "subcomando de template desconocido: %s",
+	"%sCreating template: %s%s":                                               "%sCreando plantilla: %s%s",
+	"%s✓ Template created: %s%s":                                              "%s✓ Plantilla creada: %s%s",
+	"Usage: erp-cli group <subcommand> [args...]":                             "Uso: erp-cli group <subcomando> [argumentos...]",
+	"Subcommands: list, tree, create, rename, delete":                         "Subcomandos: list, tree, create, rename, delete",
+	"usage: erp-cli group rename <old> <new>":                                 "uso: erp-cli group rename <antiguo> <nuevo>",
+	"usage: erp-cli group delete <name> [--cascade-check]":                    "uso: erp-cli group delete <nombre> [--cascade-check]",
+	"usage: erp-cli group create <name> [parent]":                             "uso: erp-cli group create <nombre> [padre]",
+	"unknown group subcommand: %s":                                            "subcomando de group desconocido: %s",
+	"%sFetching item groups...%s":                                             "%sObteniendo grupos de artículos...%s",
+	"%sCreating item group: %s%s":                                             "%sCreando grupo de artículos: %s%s",
+	"%s✓ Group created: %s%s":                                                 "%s✓ Grupo creado: %s%s",
+	"Parent: %s":                                                              "Padre: %s",
+	"%sFetching item group hierarchy...%s":                                    "%sObteniendo la jerarquía de grupos de artículos...%s",
+	"%sNo item groups found%s":                                                "%sNo se encontraron grupos de artículos%s",
+	"%sRenaming item group: %s → %s%s":                                        "%sRenombrando grupo de artículos: %s → %s%s",
+	"%s✓ Group renamed: %s%s":                                                 "%s✓ Grupo renombrado: %s%s",
+	"%sChecking dependents of: %s%s":                                          "%sComprobando dependientes de: %s%s",
+	"item group not found: %s":                                                "grupo de artículos no encontrado: %s",
+	"%sCannot delete %s:%s":                                                   "%sNo se puede borrar %s:%s",
+	"Subgroups: %d":                                                           "Subgrupos: %d",
+	"item group %s has dependents; move or delete them first":                 "el grupo de artículos %s tiene dependientes; muévelos o bórralos antes",
+	"No subgroups or items":                                                   "Sin subgrupos ni artículos",
+	"%sDeleting item group: %s%s":                                             "%sBorrando grupo de artículos: %s%s",
+	"%s✓ Group deleted: %s%s":                                                 "%s✓ Grupo borrado: %s%s",
+	"Usage: erp-cli brand <subcommand> [args...]":                             "Uso: erp-cli brand <subcomando> [argumentos...]",
+	"Subcommands: list, create, add-to-attr":                                  "Subcomandos: list, create, add-to-attr",
+	"usage: erp-cli brand create <name>":                                      "uso: erp-cli brand create <nombre>",
+	"usage: erp-cli brand add-to-attr <name>":                                 "uso: erp-cli brand add-to-attr <nombre>",
+	"unknown brand subcommand: %s":                                            "subcomando de brand desconocido: %s",
+	"%sFetching brands...%s":                                                  "%sObteniendo marcas...%s",
+	"%sCreating brand: %s%s":                                                  "%sCreando marca: %s%s",
+	"%s✓ Brand created: %s%s":                                                 "%s✓ Marca creada: %s%s",
+	"%sAdding brand to Brand attribute: %s%s":                                 "%sAñadiendo marca al atributo Brand: %s%s",
+
+	// Journal entries
+	"Usage: erp-cli je <subcommand> [args...]":                                                 "Uso: erp-cli je <subcomando> [argumentos...]",
+	"Subcommands: list, get, create, submit, cancel":                                           "Subcomandos: list, get, create, submit, cancel",
+	"usage: erp-cli je get <name> [--template=file.tmpl]":                                      "uso: erp-cli je get <nombre> [--template=archivo.tmpl]",
+	"usage: erp-cli je create --debit ACC:AMOUNT --credit ACC:AMOUNT [--party=X] [--remark=X]": "uso: erp-cli je create --debit CUENTA:IMPORTE --credit CUENTA:IMPORTE [--party=X] [--remark=X]",
+	"usage: erp-cli je submit <name>":                                                          "uso: erp-cli je submit <nombre>",
+	"usage: erp-cli je cancel <name>":                                                          "uso: erp-cli je cancel <nombre>",
+	"unknown je subcommand: %s":                                                                "subcomando de je desconocido: %s",
+	"invalid line %q (expected ACCOUNT:AMOUNT)":                                                "línea no válida %q (se espera CUENTA:IMPORTE)",
+	"invalid amount in %q":                                                                     "importe no válido en %q",
+	"%s needs ACCOUNT:AMOUNT":                                                                  "%s necesita CUENTA:IMPORTE",
+	"account not found: %s":                                                                    "cuenta no encontrada: %s",
+	"%s is a group account; post to one of its children":                                       "%s es una cuenta de grupo; contabiliza en una de sus hijas",
+	"account %s belongs to %s, not %s":                                                         "la cuenta %s pertenece a %s, no a %s",
+	"journal entry does not balance: debits %s, credits %s (difference %s)":                    "el asiento no cuadra: debe %s, haber %s (diferencia %s)",
+	"%sCreating journal entry...%s":                                                            "%sCreando asiento contable...%s",
+	"%s is a %s account; pass --party=<name>":                                                  "%s es una cuenta %s; indica --party=<nombre>",
+	"%sNote: no receivable or payable account, --party ignored%s":                              "%sNota: no hay cuenta a cobrar ni a pagar, se ignora --party%s",
+	"%s✓ Journal Entry created: %s%s":                                                          "%s✓ Asiento contable creado: %s%s",
+	"Dr %-32s %s":                                                                              "D  %-32s %s",
+	"Cr %-32s %s":                                                                              "H  %-32s %s",
+	"Use 'erp-cli je submit %s' to submit":                                                     "Usa 'erp-cli je submit %s' para validarlo",
+	"invalid status: %s (use Draft, Submitted or Cancelled)":                                   "estado no válido: %s (usa Draft, Submitted o Cancelled)",
+	"%sFetching journal entries...%s":                                                          "%sObteniendo asientos contables...%s",
+	"%sNo journal entries found%s":                                                             "%sNo se encontraron asientos contables%s",
+	"%sJournal Entries (%d):%s":                                                                "%sAsientos contables (%d):%s",
+	"%sFetching journal entry: %s%s":                                                           "%sObteniendo asiento contable: %s%s",
+	"%sJournal Entry: %s%s":                                                                    "%sAsiento contable: %s%s",
+	"Reference: %s (%s)":                                                                       "Referencia: %s (%s)",
+	"Remark: %s":                                                                               "Observación: %s",
+	"%sAccounts:%s":                                                                            "%sCuentas:%s",
+	"Total Debit: %s | Total Credit: %s":                                                       "Total debe: %s | Total haber: %s",
+	"%sSubmitting journal entry: %s%s":                                                         "%sValidando asiento contable: %s%s",
+	"%s✓ Journal Entry submitted: %s%s":                                                        "%s✓ Asiento contable validado: %s%s",
+	"%sCancelling journal entry: %s%s":                                                         "%sCancelando asiento contable: %s%s",
+	"%s✓ Journal Entry cancelled: %s%s":                                                        "%s✓ Asiento contable cancelado: %s%s",
+
+	// Landed cost vouchers
+	"Usage: erp-cli lcv <subcommand> [args...]":                           "Uso: erp-cli lcv <subcomando> [argumentos...]",
+	"A landed cost voucher adds freight, duty and similar charges to the": "Un justificante de costes en destino añade portes, aranceles y cargos similares a la",
+	"valuation of the items of submitted Purchase Receipts. Charges are":  "valoración de los artículos de albaranes de compra validados. Los cargos se",
+	"spread over the items by amount (default) or by quantity.":           "reparten entre los artículos por importe (por defecto) o por cantidad.",
+	"usage: erp-cli lcv get <name>":                                       "uso: erp-cli lcv get <nombre>",
+	"usage: erp-cli lcv submit <name>":                                    "uso: erp-cli lcv submit <nombre>",
+	"unknown lcv subcommand: %s":                                          "subcomando de lcv desconocido: %s",
+	"usage: erp-cli lcv create --pr <receipt> --charge \"Description:amount\" [...] [--distribute=amount|qty] [--account=X] [--posting-date=YYYY-MM-DD] [--submit]": "uso: erp-cli lcv create --pr <albarán> --charge \"Descripción:importe\" [...] [--distribute=amount|qty] [--account=X] [--posting-date=AAAA-MM-DD] [--submit]",
+	"%s needs a value": "%s necesita un valor",
+	"invalid charge %q (expected Description:amount)":   "cargo no válido %q (se espera Descripción:importe)",
+	"invalid --distribute (expected amount or qty): %s": "--distribute no válido (se espera amount o qty): %s",
+	"%sCreating landed cost voucher for: %s%s":          "%sCreando justificante de costes en destino para: %s%s",
+
+	"company %s has no Expenses Included In Valuation account: pass --account=X": "la empresa %s no tiene cuenta de gastos incluidos en la valoración: indica --account=X",
+	"purchase receipt not found: %s":                                             "albarán de compra no encontrado: %s",
+	"purchase receipt must be submitted first: %s":                               "primero hay que validar el albarán de compra: %s",
+	"unexpected response creating landed cost voucher":                           "respuesta inesperada al crear el justificante de costes en destino",
+	"%s✓ Landed Cost Voucher created: %s%s":                                      "%s✓ Justificante de costes en destino creado: %s%s",
+	"Charges: %s (by %s, to %s)":                                                 "Cargos: %s (por %s, a %s)",
+	"Use 'erp-cli lcv submit %s' to add the charges to the valuation":            "Usa 'erp-cli lcv submit %s' para sumar los cargos a la valoración",
+	"%s✓ Landed Cost Voucher submitted: %s%s":                                    "%s✓ Justificante de costes en destino validado: %s%s",
+	"(%s per unit)":                          "(%s por unidad)",
+	"%sSubmitting landed cost voucher: %s%s": "%sValidando justificante de costes en destino: %s%s",
+	"%sFetching landed cost vouchers...%s":   "%sObteniendo justificantes de costes en destino...%s",
+	"%sNo landed cost vouchers found%s":      "%sNo se encontraron justificantes de costes en destino%s",
+	"%sLanded Cost Vouchers (%d):%s":         "%sJustificantes de costes en destino (%d):%s",
+	"Charges: %s by %s | Status: %s%s%s":     "Cargos: %s por %s | Estado: %s%s%s",
+	"%sFetching landed cost voucher: %s%s":   "%sObteniendo justificante de costes en destino: %s%s",
+	"landed cost voucher not found":          "justificante de costes en destino no encontrado",
+	"%sLanded Cost Voucher: %s%s":            "%sJustificante de costes en destino: %s%s",
+	"Distributed By: %s":                     "Repartido por: %s",
+	"Total Charges: %s":                      "Total de cargos: %s",
+	"%sCharges:%s":                           "%sCargos:%s",
+
+	// General ledger and account balances
+	"no customer or supplier named %s (use --party-type=X for other party types)": "no hay ningún cliente ni proveedor llamado %s (usa --party-type=X para otros tipos de tercero)",
+	"--from %s is after --to %s": "--from %s es posterior a --to %s",
+	"Usage: erp-cli gl <account> [--from=YYYY-MM-DD] [--to=YYYY-MM-DD] [--party=X]": "Uso: erp-cli gl <cuenta> [--from=AAAA-MM-DD] [--to=AAAA-MM-DD] [--party=X]",
+	"%sFetching general ledger: %s%s":                                               "%sObteniendo libro mayor: %s%s",
+	"%sGeneral Ledger: %s (%s to %s)%s":                                             "%sLibro mayor: %s (%s a %s)%s",
+	"Voucher":                                                                       "Comprobante",
+	"Debit":                                                                         "Debe",
+	"Credit":                                                                        "Haber",
+	"Balance":                                                                       "Saldo",
+	"Opening":                                                                       "Apertura",
+	"%sNo ledger entries in this period%s":                                          "%sNo hay apuntes en este periodo%s",
+	"Closing":                                                                       "Cierre",
+	"Usage: erp-cli account <subcommand> [args...]":                            "Uso: erp-cli account <subcomando> [argumentos...]",
+	"Subcommands: balance":                                                     "Subcomandos: balance",
+	"usage: erp-cli account balance <account> [--date=YYYY-MM-DD] [--party=X]": "uso: erp-cli account balance <cuenta> [--date=AAAA-MM-DD] [--party=X]",
+	"unknown account subcommand: %s":                                           "subcomando de account desconocido: %s",
+	"%sFetching balance: %s%s":                                                 "%sObteniendo saldo: %s%s",
+	"%sAccount: %s%s":                                                          "%sCuenta: %s%s",
+	"Party: %s":                                                                "Tercero: %s",
+	"Balance on %s: %s":                                                        "Saldo a %s: %s",
+	"Posted that day: %s debit | %s credit":                                    "Contabilizado ese día: %s debe | %s haber",
+
+	// Event listener
+	"invalid --interval (e.g. 30s, 5m): %s":                                               "--interval no válido (p. ej. 30s, 5m): %s",
+	"invalid --port: %s":                                                                  "--port no válido: %s",
+	"at least one --event \"Doctype:event\" is required":                                  "se necesita al menos un --event \"Doctype:evento\"",
+	"on_trash events need --webhook (deletions cannot be polled)":                         "los eventos on_trash necesitan --webhook (los borrados no se pueden sondear)",
+	"invalid --event %q (use \"Doctype:event\")":                                          "--event no válido %q (usa \"Doctype:evento\")",
+	"unknown event %q (use one of: %s)":                                                   "evento desconocido %q (usa uno de: %s)",
+	"Usage: erp-cli listen --event \"Doctype:event\" [--event ...] [--exec cmd]":          "Uso: erp-cli listen --event \"Doctype:evento\" [--event ...] [--exec comando]",
+	"Prints one JSON line per document event on stdout (status goes to stderr)":           "Escribe una línea JSON por evento de documento en stdout (el estado va a stderr)",
+	"and, with --exec, runs the command (through sh -c) with the event JSON on stdin and": "y, con --exec, ejecuta el comando (mediante sh -c) con el JSON del evento en stdin y",
+	"ERP_EVENT, ERP_DOCTYPE and ERP_NAME in its environment.":                             "ERP_EVENT, ERP_DOCTYPE y ERP_NAME en su entorno.",
+	"Events: %s": "Eventos: %s",
+	"By default the server is polled for changes. With --webhook, Frappe Webhooks": "Por defecto se sondea el servidor en busca de cambios. Con --webhook, se registran Webhooks",
+	"are registered to call a listener on --port instead (the URL must reach this": "de Frappe que llaman a un receptor en --port (la URL debe llegar a esta",
+	"machine from the server) and are removed again on Ctrl+C.":                    "máquina desde el servidor) y se quitan de nuevo con Ctrl+C.",
+	"%s⚠ %s failed for %s %s: %s%s":                                                "%s⚠ %s falló para %s %s: %s%s",
+	"%sListening for %s (polling every %s, Ctrl+C to stop)%s":                      "%sEscuchando %s (sondeo cada %s, Ctrl+C para parar)%s",
+	"failed to generate webhook secret: %w":                                        "no se pudo generar el secreto del webhook: %w",
+	"%s⚠ Webhook %s not removed: %s%s":                                             "%s⚠ Webhook %s no quitado: %s%s",
+	"%s⚠ Invalid webhook payload: %s%s":                                            "%s⚠ Contenido de webhook no válido: %s%s",
+	"%sListening for %s on port %d (webhooks to %s, Ctrl+C to stop)%s":             "%sEscuchando %s en el puerto %d (webhooks a %s, Ctrl+C para parar)%s",
+	"listener failed: %w":                                                          "el receptor falló: %w",
+	"%s✓ Stopped, %d webhook(s) removed%s":                                         "%s✓ Detenido, %d webhook(s) quitado(s)%s",
+	"failed to register webhook for %s:%s: %w":                                     "no se pudo registrar el webhook para %s:%s: %w",
+
+	// Local snapshots
+	"failed to create %s: %w":                                                    "no se pudo crear %s: %w",
+	"failed to read snapshot of %s: %w":                                          "no se pudo leer la instantánea de %s: %w",
+	"failed to parse snapshot of %s: %w":                                         "no se pudo interpretar la instantánea de %s: %w",
+	"failed to write snapshot of %s: %w":                                         "no se pudo escribir la instantánea de %s: %w",
+	"Usage: erp-cli snapshot <subcommand> [args]":                                "Uso: erp-cli snapshot <subcomando> [argumentos]",
+	"Subcommands:":                                                               "Subcomandos:",
+	"Mirror doctypes locally (ERP_SNAPSHOT_DOCTYPES, or the":                     "Replica doctypes en local (ERP_SNAPSHOT_DOCTYPES, o el",
+	"catalog, stock levels and parties by default)":                              "catálogo, niveles de stock y terceros por defecto)",
+	"status               Mirrored doctypes, sizes and ages":                     "status               Doctypes replicados, tamaños y antigüedad",
+	"drop [doctype...]    Delete snapshots (all when none is given)":             "drop [doctype...]    Borra instantáneas (todas si no se indica ninguno)",
+	"Pulls after the first only fetch documents modified since and drop deleted": "Las descargas posteriores a la primera solo traen los documentos modificados desde entonces y quitan los",
+	"ones. --full includes child tables (one request per changed document).":     "borrados. --full incluye las tablas hijas (una petición por documento cambiado).",
+	"Then add the global --local to list and get commands to read the snapshot":  "Después añade la opción global --local a los comandos list y get para leer la instantánea",
+	"instead of the server; snapshots over a day old are flagged as stale.":      "en lugar del servidor; las instantáneas de más de un día se marcan como desfasadas.",
+	"unknown snapshot subcommand: %s":                                            "subcomando de snapshot desconocido: %s",
+	"%sPulling %s...%s":                                                          "%sDescargando %s...%s",
+	"%s✓ %d documents (%d new or changed, %d removed)%s":                         "%s✓ %d documentos (%d nuevos o cambiados, %d quitados)%s",
+	"%d of %d doctypes not pulled":                                               "%d de %d doctypes sin descargar",
+	"%sNo snapshots yet. Run 'erp-cli snapshot pull'.%s":                         "%sTodavía no hay instantáneas. Ejecuta 'erp-cli snapshot pull'.%s",
+	"DOCS":                         "DOCS",
+	"SIZE":                         "TAMAÑO",
+	"PULLED":                       "DESCARGADO",
+	"AGE":                          "ANTIGÜEDAD",
+	"%s✓ Dropped %d snapshot(s)%s": "%s✓ %d instantánea(s) borrada(s)%s",
+	"%dm ago":                      "hace %dm",
+	"%dh ago":                      "hace %dh",
+	"%dd ago":                      "hace %dd",
+	"not available with --local (snapshots only answer document reads)":                   "no disponible con --local (las instantáneas solo responden lecturas de documentos)",
+	"no local snapshot of %s (run 'erp-cli snapshot pull \"%s\"')":                        "no hay instantánea local de %s (ejecuta 'erp-cli snapshot pull \"%s\"')",
+	"API error (HTTP 404): %s %s not found in the local snapshot":                         "error de la API (HTTP 404): %s %s no está en la instantánea local",
+	"aggregates are not available with --local":                                           "los agregados no están disponibles con --local",
+	"filters on %s rows need a full snapshot (run 'erp-cli snapshot pull \"%s\" --full')": "los filtros sobre filas de %s necesitan una instantánea completa (ejecuta 'erp-cli snapshot pull \"%s\" --full')",
+	"- stale, run 'erp-cli snapshot pull'":                                                "- desfasada, ejecuta 'erp-cli snapshot pull'",
+	"%s[local] %s as of %s (%s%s)%s":                                                      "%s[local] %s a %s (%s%s)%s",
+	"invalid filters: %w":                                                                 "filtros no válidos: %w",
+	"invalid filter: %v":                                                                  "filtro no válido: %v",
+
+	// Optimistic locking
+	"%s %s was modified by someone else while you were editing it; refresh and try again": "otra persona modificó %s %s mientras lo editabas; recarga y vuelve a intentarlo",
+	"%s %v was changed by %v at %v since it was loaded; reopen it to see the changes":     "%s %v lo cambió %v a las %v después de cargarlo; vuelve a abrirlo para ver los cambios",
+
+	// Low-bandwidth mode
+	"%sShowing the %d most recent (low-bandwidth mode)%s": "%sMostrando los %d más recientes (modo de poco ancho de banda)%s",
+
+	// Margins
+	"%sGenerating margin report for the last %d months...%s":                                               "%sGenerando informe de márgenes de los últimos %d meses...%s",
+	"%s                     GROSS MARGIN REPORT                      %s":                                   "%s                 INFORME DE MARGEN BRUTO                      %s",
+	"%sPer month (%s to %s):%s":                                                                            "%sPor mes (%s a %s):%s",
+	"%sPer item (lowest margin first):%s":                                                                  "%sPor artículo (menor margen primero):%s",
+	"%sPer customer (by selling amount):%s":                                                                "%sPor cliente (según importe de venta):%s",
+	"%sNote: %d of %d lines had no incoming rate; their cost uses the current valuation or buying price%s": "%sNota: %d de %d líneas no tenían tasa de entrada; su coste usa la valoración actual o el precio de compra%s",
+	"Cost":   "Coste",
+	"Margin": "Margen",
+
+	// Notes
+	"Usage: erp-cli note <text> | note <subcommand> [args...]":                      "Uso: erp-cli note <texto> | note <subcomando> [argumentos...]",
+	"Subcommands: list, delete":                                                     "Subcomandos: list, delete",
+	"Notes are stored locally and can be turned into documents from the TUI Inbox.": "Las notas se guardan en local y se pueden convertir en documentos desde la bandeja de entrada de la TUI.",
+	"usage: erp-cli note delete <id>":                                               "uso: erp-cli note delete <id>",
+	"invalid note id: %s":                                                           "id de nota no válido: %s",
+	"%s✓ Note deleted: #%d%s":                                                       "%s✓ Nota borrada: #%d%s",
+	"%s✓ Note saved: #%d%s":                                                         "%s✓ Nota guardada: #%d%s",
+	"%sNo notes found%s":                                                            "%sNo se encontraron notas%s",
+	"%sNotes (%d):%s":                                                               "%sNotas (%d):%s",
+
+	"note text is empty":  "el texto de la nota está vacío",
+	"note not found: #%d": "nota no encontrada: #%d",
+
+	// Notifications
+	"%s⚠ Customer not notified: %s%s":                  "%s⚠ No se avisó al cliente: %s%s",
+	"%s✓ Emailed to %s%s":                              "%s✓ Enviado por correo a %s%s",
+	"(customer not notified: %s)":                      "(no se avisó al cliente: %s)",
+	"(emailed to %s)":                                  "(enviado por correo a %s)",
+	"submitted, but the customer was not notified: %w": "validado, pero no se avisó al cliente: %w",
+	"the document has no customer contact":             "el documento no tiene contacto del cliente",
+	"%s has no contact with an email (add one with 'erp-cli customer add-contact')": "%s no tiene ningún contacto con correo (añade uno con 'erp-cli customer add-contact')",
+	"email template %s: %w": "plantilla de correo %s: %w",
+
+	// Offline queue
+	"'%s' needs the server and cannot run with --offline (only create and update commands are queued)": "'%s' necesita el servidor y no puede ejecutarse con --offline (solo se encolan los comandos de creación y actualización)",
+	"%s✓ Queued #%d: erp-cli %s%s":                                       "%s✓ Encolada #%d: erp-cli %s%s",
+	"%d operation(s) pending. Run 'erp-cli sync push' when back online.": "%d operación(es) pendiente(s). Ejecuta 'erp-cli sync push' cuando vuelvas a tener conexión.",
+	"%sNo queued operations%s":                                           "%sNo hay operaciones en cola%s",
+	"QUEUED":                                                             "ENCOLADA",
+	"invalid operation id: %s":                                           "id de operación no válido: %s",
+	"%s✓ Dropped #%d: erp-cli %s%s":                                      "%s✓ Descartada #%d: erp-cli %s%s",
+	"no queued operation #%d (see 'erp-cli sync queue')":                 "no hay ninguna operación #%d en cola (ver 'erp-cli sync queue')",
+	"%s[DRY RUN] Checking %d queued operation(s)...%s":                   "%s[SIMULACIÓN] Comprobando %d operación(es) en cola...%s",
+	"%sPushing %d queued operation(s)...%s":                              "%sEnviando %d operación(es) en cola...%s",
+	"%s✗ Signature mismatch: not queued with these credentials (drop it with 'erp-cli sync drop %d')%s": "%s✗ La firma no coincide: no se encoló con estas credenciales (descártala con 'erp-cli sync drop %d')%s",
+	"%s⚠ Waiting for an earlier operation on %s %s%s":                                                   "%s⚠ Esperando a una operación anterior sobre %s %s%s",
+	"%s⚠ Conflict: %s (push --force to run it anyway, or drop it)%s":                                    "%s⚠ Conflicto: %s (push --force para ejecutarla igualmente, o descártala)%s",
+	"%sWould run%s":                                                 "%sSe ejecutaría%s",
+	"%s✗ Failed, kept in the queue%s":                               "%s✗ Falló, se mantiene en la cola%s",
+	"%sPushed: %d, Conflicts: %d, Failed: %d, Pending: %d%s":        "%sEnviadas: %d, Conflictos: %d, Fallidas: %d, Pendientes: %d%s",
+	"%s %s not found":                                               "%s %s no encontrado",
+	"%s %s was modified on the server at %s, after this was queued": "%s %s se modificó en el servidor a las %s, después de encolar esto",
+
+	// Opening stock and prices
+	"CSV is missing the %s column (map it with --map)":                "al CSV le falta la columna %s (mapéala con --map)",
+	"%s[DRY RUN] Importing opening stock from: %s%s":                  "%s[SIMULACIÓN] Importando stock inicial desde: %s%s",
+	"%sImporting opening stock from: %s%s":                            "%sImportando stock inicial desde: %s%s",
+	"%sRow %d: skipped (no item_code or warehouse)%s":                 "%sFila %d: omitida (sin item_code o warehouse)%s",
+	"no item_code or warehouse":                                       "sin item_code o warehouse",
+	"%sRow %d: skipped (zero qty)%s":                                  "%sFila %d: omitida (cantidad cero)%s",
+	"zero qty":                                                        "cantidad cero",
+	"[DRY RUN] Would create %s for %s (%d items)":                     "[SIMULACIÓN] Se crearía %s para %s (%d artículos)",
+	"%sSummary: %d documents, %d skipped%s":                           "%sResumen: %d documentos, %d omitidos%s",
+	"%s✗ %s created but not submitted: %s (%s)%s":                     "%s✗ %s creado pero no validado: %s (%s)%s",
+	"%s✓ %s: %s (%d items)%s":                                         "%s✓ %s: %s (%d artículos)%s",
+	"no Temporary Opening account in %s (use --difference-account=X)": "no hay cuenta Temporary Opening en %s (usa --difference-account=X)",
+	"%s[DRY RUN] Importing item prices from: %s%s":                    "%s[SIMULACIÓN] Importando precios de artículo desde: %s%s",
+	"%sImporting item prices from: %s%s":                              "%sImportando precios de artículo desde: %s%s",
+	"%sRow %d: skipped (no item_code or price_list)%s":                "%sFila %d: omitida (sin item_code o price_list)%s",
+	"no item_code or price_list":                                      "sin item_code o price_list",
+	"[DRY RUN] Would set: %s @ %s = %.2f":                             "[SIMULACIÓN] Se fijaría: %s @ %s = %.2f",
+	"%s✗ Failed: %s @ %s (%s)%s":                                      "%s✗ Fallo: %s @ %s (%s)%s",
+	"%s✓ Set: %s @ %s = %.2f%s":                                       "%s✓ Fijado: %s @ %s = %.2f%s",
+	"%sSummary: %d set, %d skipped, %d failed%s":                      "%sResumen: %d fijados, %d omitidos, %d fallidos%s",
+
+	// Interactive order builder
+	"%sNew Sales Order%s": "%sNuevo pedido de venta%s",
+	"Type part of an item code or name to look it up. A blank item finishes": "Escribe parte de un código o nombre de artículo para buscarlo. Un artículo vacío termina",
+	"the order, - removes the last line. Ctrl+D aborts.":                     "el pedido, - quita la última línea. Ctrl+D cancela.",
+	"Customer:":                            "Cliente:",
+	"Customer: %s | Price List: %s":        "Cliente: %s | Lista de precios: %s",
+	"Item %d:":                             "Artículo %d:",
+	"%sRemoved %s%s":                       "%sQuitado %s%s",
+	"%sNo items, nothing created%s":        "%sSin artículos, no se creó nada%s",
+	"%sSales Order for %s:%s":              "%sPedido de venta para %s:%s",
+	"%sTotal: %s%s (before taxes)":         "%sTotal: %s%s (antes de impuestos)",
+	"Create this sales order? [y/N]:":      "¿Crear este pedido de venta? [y/N]:",
+	"→ %s (customer code: %s)":             "→ %s (código del cliente: %s)",
+	"Qty [1]:":                             "Cant [1]:",
+	"%sEnter a positive number%s":          "%sIntroduce un número positivo%s",
+	"%sEnter a rate (no price on %s)%s":    "%sIntroduce un precio (no hay precio en %s)%s",
+	"%sNo %s matches %q%s":                 "%sNingún %s coincide con %q%s",
+	"Pick 1-%d (blank to search again):":   "Elige 1-%d (vacío para buscar de nuevo):",
+	"Running total: %s (%d lines)":         "Total acumulado: %s (%d líneas)",
+	"%s✓ Sales Order created: %s%s":        "%s✓ Pedido de venta creado: %s%s",
+	"Grand Total: %s":                      "Total: %s",
+	"Use 'erp-cli so submit %s' to submit": "Usa 'erp-cli so submit %s' para validarlo",
+
+	// Dashboard panels
+	"no report name": "sin nombre de informe",
+
+	// Parties (customers and suppliers)
+	"%sUpdating %s: %s%s":                           "%sActualizando %s: %s%s",
+	"invalid value for disabled: use 'on' or 'off'": "valor no válido para disabled: usa 'on' u 'off'",
+	"%s✓ %s updated: %s%s":                          "%s✓ %s actualizado: %s%s",
+	"--line1, --city and --country are required":    "--line1, --city y --country son obligatorios",
+	"%sAdding %s address to %s: %s%s":               "%sAñadiendo dirección de %s a %s: %s%s",
+	"%s✓ Address created: %s%s":                     "%s✓ Dirección creada: %s%s",
+	"%sAdding contact to %s: %s%s":                  "%sAñadiendo contacto a %s: %s%s",
+	"Email: %s":                                     "Correo: %s",
+	"Phone: %s":                                     "Teléfono: %s",
+	"Mobile: %s":                                    "Móvil: %s",
+	"%s✓ Contact created: %s%s":                     "%s✓ Contacto creado: %s%s",
+	"%sAddresses and contacts skipped (low-bandwidth mode, see: erp-cli %s addresses)%s": "%sDirecciones y contactos omitidos (modo de poco ancho de banda, ver: erp-cli %s addresses)%s",
+	"%sNo addresses (add one with: erp-cli %s add-address)%s":                            "%sSin direcciones (añade una con: erp-cli %s add-address)%s",
+	"%sAddresses (%d):%s":            "%sDirecciones (%d):%s",
+	"%sContacts (%d):%s":             "%sContactos (%d):%s",
+	"%sFetching addresses for: %s%s": "%sObteniendo direcciones de: %s%s",
+	"%sNo addresses found%s":         "%sNo se encontraron direcciones%s",
+
+	// Payments
+	"Usage: erp-cli payment <subcommand> [args...]":                                                "Uso: erp-cli payment <subcomando> [argumentos...]",
+	"Subcommands: list, get, receive, pay, submit, cancel, pdf":                                    "Subcomandos: list, get, receive, pay, submit, cancel, pdf",
+	"usage: erp-cli payment get <name> [--template=file.tmpl]":                                     "uso: erp-cli payment get <nombre> [--template=archivo.tmpl]",
+	"usage: erp-cli payment receive <si_name> [--amount=X] [--currency=X] [--exchange-rate=N]":     "uso: erp-cli payment receive <factura_venta> [--amount=X] [--currency=X] [--exchange-rate=N]",
+	"usage: erp-cli payment pay <pi_name> [--amount=X] [--currency=X] [--exchange-rate=N]":         "uso: erp-cli payment pay <factura_compra> [--amount=X] [--currency=X] [--exchange-rate=N]",
+	"usage: erp-cli payment submit <name>":                                                         "uso: erp-cli payment submit <nombre>",
+	"usage: erp-cli payment cancel <name>":                                                         "uso: erp-cli payment cancel <nombre>",
+	"usage: erp-cli payment pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]": "uso: erp-cli payment pdf <nombre> [-o archivo.pdf] [--print-format=X] [--letterhead=Y] [--open]",
+	"unknown payment subcommand: %s":                                                               "subcomando de payment desconocido: %s",
+	"%sFetching payment entries...%s":                                                              "%sObteniendo pagos...%s",
+	"%sNo payment entries found%s":                                                                 "%sNo se encontraron pagos%s",
+	"%sPayment Entries (%d):%s":                                                                    "%sPagos (%d):%s",
+	"Date: %s | Status: %s%s%s | Amount: %s":                                                       "Fecha: %s | Estado: %s%s%s | Importe: %s",
+	"%sFetching payment entry: %s%s":                                                               "%sObteniendo pago: %s%s",
+	"%sPayment Entry: %s%s":                                                                        "%sPago: %s%s",
+	"Receive (from Customer)":                                                                      "Cobro (de cliente)",
+	"Pay (to Supplier)":                                                                            "Pago (a proveedor)",
+	"Party Type: %s":                                                                               "Tipo de tercero: %s",
+	"Paid Amount: %s":                                                                              "Importe pagado: %s",
+	"Received Amount: %s":                                                                          "Importe recibido: %s",
+	"Mode of Payment: %s":                                                                          "Forma de pago: %s",
+	"%sReferences:%s":                                                                              "%sReferencias:%s",
+	"- %s: %s (Allocated: %s)":                                                                     "- %s: %s (Asignado: %s)",
+	"%sCreating payment entry for %s: %s%s":                                                        "%sCreando pago para %s: %s%s",
+	"%s✓ Payment Entry created: %s%s":                                                              "%s✓ Pago creado: %s%s",
+	"Amount: %s (%s)":                                                                              "Importe: %s (%s)",
+	"Amount: %s":                                                                                   "Importe: %s",
+	"For Invoice: %s":                                                                              "Para la factura: %s",
+	"Use 'erp-cli payment submit %s' to submit":                                                    "Usa 'erp-cli payment submit %s' para validarlo",
+	"%s not found":                                                                                 "%s no encontrado",
+	"%s must be submitted first":                                                                   "primero hay que validar %s",
+	"%s has no outstanding amount":                                                                 "%s no tiene importe pendiente",
+	"amount exceeds outstanding balance of %s":                                                     "el importe supera el saldo pendiente de %s",
+	"%sSubmitting payment entry: %s%s":                                                             "%sValidando pago: %s%s",
+	"%s✓ Payment Entry submitted: %s%s":                                                            "%s✓ Pago validado: %s%s",
+	"%sCancelling payment entry: %s%s":                                                             "%sCancelando pago: %s%s",
+	"%s✓ Payment Entry cancelled: %s%s":                                                            "%s✓ Pago cancelado: %s%s",
+
+	// PDFs
+	"server did not return a PDF for %s %s": "el servidor no devolvió un PDF para %s %s",
+	"%sDownloading %s %s...%s":              "%sDescargando %s %s...%s",
+	"%s✓ Saved: %s%s":                       "%s✓ Guardado: %s%s",
+
+	// Permissions
+	"you cannot %s %s: missing a role with %s permission on it": "no puedes %s %s: te falta un rol con permiso de %s sobre ello",
+	"you cannot %s %s: missing role %s":                         "no puedes %s %s: te falta el rol %s",
+	"you cannot %s %s: missing role (one of: %s)":               "no puedes %s %s: te falta un rol (uno de: %s)",
+
+	// Pricing rules and price resolution
+	"Usage: erp-cli pricing-rule <subcommand> [args...]": "Uso: erp-cli pricing-rule <subcomando> [argumentos...]",
+	"Subcommands: list, get, create, disable":            "Subcomandos: list, get, create, disable",
+	"Create options:": "Opciones de create:",
+	"--item=X | --group=X            Apply on item code or item group (required)":                   "--item=X | --group=X            Aplica a un código o grupo de artículos (obligatorio)",
+	"--discount=N | --rate=N         Discount percentage or fixed rate (required)":                  "--discount=N | --rate=N         Porcentaje de descuento o precio fijo (obligatorio)",
+	"Restrict to a customer or customer group":                                                      "Limita a un cliente o grupo de clientes",
+	"--min-qty=N                     Quantity break":                                                "--min-qty=N                     Cantidad mínima",
+	"usage: erp-cli pricing-rule get <name>":                                                        "uso: erp-cli pricing-rule get <nombre>",
+	"usage: erp-cli pricing-rule create <title> --item=X|--group=X --discount=N|--rate=N [options]": "uso: erp-cli pricing-rule create <título> --item=X|--group=X --discount=N|--rate=N [opciones]",
+	"usage: erp-cli pricing-rule disable <name>":                                                    "uso: erp-cli pricing-rule disable <nombre>",
+	"unknown pricing-rule subcommand: %s":                                                           "subcomando de pricing-rule desconocido: %s",
+	"specify exactly one of --item or --group":                                                      "indica exactamente uno de --item o --group",
+	"specify exactly one of --discount or --rate":                                                   "indica exactamente uno de --discount o --rate",
+	"--customer and --customer-group are mutually exclusive":                                        "--customer y --customer-group son incompatibles",
+	"%sFetching pricing rules...%s":                                                                 "%sObteniendo reglas de precios...%s",
+	"%sNo pricing rules found%s":                                                                    "%sNo se encontraron reglas de precios%s",
+	"%sPricing Rules (%d):%s":                                                                       "%sReglas de precios (%d):%s",
+	"%.2f%% off":                                                                                    "%.2f%% de descuento",
+	"%s | On: %v":                                                                                   "%s | Sobre: %v",
+	"| Customer: %v":                                                                                "| Cliente: %v",
+	"| Customer Group: %v":                                                                          "| Grupo de clientes: %v",
+	"| Min Qty: %.0f":                                                                               "| Cant mín: %.0f",
+	"%sFetching pricing rule: %s%s":                                                                 "%sObteniendo regla de precios: %s%s",
+	"%sPricing Rule: %s%s":                                                                          "%sRegla de precios: %s%s",
+	"Title: %v":                                                                                     "Título: %v",
+	"Valid From: %s":                                                                                "Válida desde: %s",
+	"Valid Until: %s":                                                                               "Válida hasta: %s",
+	"%sApplies to:%s":                                                                               "%sSe aplica a:%s",
+	"%sCreating pricing rule: %s%s":                                                                 "%sCreando regla de precios: %s%s",
+	"%s✓ Pricing Rule created: %s%s":                                                                "%s✓ Regla de precios creada: %s%s",
+	"%sDisabling pricing rule: %s%s":                                                                "%sDesactivando regla de precios: %s%s",
+	"%s✓ Pricing Rule disabled: %s%s":                                                               "%s✓ Regla de precios desactivada: %s%s",
+	"Usage: erp-cli price <subcommand> [args...]":                                                   "Uso: erp-cli price <subcomando> [argumentos...]",
+	"Subcommands: resolve":                                                                          "Subcomandos: resolve",
+	"usage: erp-cli price resolve <item> [--customer=X] [--qty=N] [--price-list=X]": "uso: erp-cli price resolve <artículo> [--customer=X] [--qty=N] [--price-list=X]",
+	"unknown price subcommand: %s":            "subcomando de price desconocido: %s",
+	"%sResolving price for: %s%s":             "%sCalculando precio de: %s%s",
+	"%sPrice: %s%s":                           "%sPrecio: %s%s",
+	"Quantity: %.0f":                          "Cantidad: %.0f",
+	"Price List: %s":                          "Lista de precios: %s",
+	"List Rate: %s":                           "Precio de lista: %s",
+	"Discount: %.2f%%":                        "Descuento: %.2f%%",
+	"Discount Amount: %s":                     "Importe de descuento: %s",
+	"Pricing Rules: %s":                       "Reglas de precios: %s",
+	"%sEffective Rate: %s%s":                  "%sPrecio efectivo: %s%s",
+	"Line Total: %s":                          "Total de la línea: %s",
+	"unexpected response from pricing engine": "respuesta inesperada del motor de precios",
+
+	// Plain-text printing
+	"invalid --width (at least 32): %s":                                                "--width no válido (mínimo 32): %s",
+	"Usage: erp-cli print <doctype> <name> [--width=N] [-o file.txt] [--lp[=printer]]": "Uso: erp-cli print <doctype> <nombre> [--width=N] [-o archivo.txt] [--lp[=impresora]]",
+	"Prints a document as plain text: header, party, items, taxes and totals,":         "Imprime un documento como texto plano: cabecera, tercero, artículos, impuestos y totales,",
+	"80 columns wide by default (--width=42 or 48 for receipt printers). Use the":      "a 80 columnas por defecto (--width=42 o 48 para impresoras de tiques). Usa la opción",
+	"global --ascii for printers without Unicode line drawing. The doctype may":        "global --ascii para impresoras sin dibujo de líneas Unicode. El doctype puede",
+	"be a full name or a command name (si, dn, so, ...).":                              "ser el nombre completo o el de un comando (si, dn, so, ...).",
+	"lp failed: %w":        "lp falló: %w",
+	"%s✓ Sent to %s: %s%s": "%s✓ Enviado a %s: %s%s",
+	"the default printer":  "la impresora por defecto",
+	"Amounts in %s":        "Importes en %s",
+
+	// Purchase orders
+	"Usage: erp-cli po <subcommand> [args...]":                      "Uso: erp-cli po <subcomando> [argumentos...]",
+	"Subcommands: list, get, create, add-item, submit, cancel, pdf": "Subcomandos: list, get, create, add-item, submit, cancel, pdf",
+	"usage: erp-cli po get <name> [--template=file.tmpl]":           "uso: erp-cli po get <nombre> [--template=archivo.tmpl]",
+	"usage: erp-cli po create <supplier> [--blanket=X [--items=CODE:QTY,...]] [--currency=X [--exchange-rate=N]] [--transaction-date=YYYY-MM-DD] [--terms=X] [--remarks=\"...\"] [--letterhead=X]": "uso: erp-cli po create <proveedor> [--blanket=X [--items=CÓDIGO:CANT,...]] [--currency=X [--exchange-rate=N]] [--transaction-date=AAAA-MM-DD] [--terms=X] [--remarks=\"...\"] [--letterhead=X]",
+	"usage: erp-cli po add-item <po_name> <item_code> <qty> [--rate=X]":                       "uso: erp-cli po add-item <pedido_compra> <código_artículo> <cant> [--rate=X]",
+	"usage: erp-cli po submit <name>":                                                         "uso: erp-cli po submit <nombre>",
+	"usage: erp-cli po cancel <name> [--cascade] [--yes]":                                     "uso: erp-cli po cancel <nombre> [--cascade] [--yes]",
+	"usage: erp-cli po pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]": "uso: erp-cli po pdf <nombre> [-o archivo.pdf] [--print-format=X] [--letterhead=Y] [--open]",
+	"unknown po subcommand: %s":                                                               "subcomando de po desconocido: %s",
+	"%sFetching purchase orders...%s":                                                         "%sObteniendo pedidos de compra...%s",
+	"%sNo purchase orders found%s":                                                            "%sNo se encontraron pedidos de compra%s",
+	"%sPurchase Orders (%d):%s":                                                               "%sPedidos de compra (%d):%s",
+	"%sFetching purchase order: %s%s":                                                         "%sObteniendo pedido de compra: %s%s",
+	"%sPurchase Order: %s%s":                                                                  "%sPedido de compra: %s%s",
+	"%sCreating purchase order for: %s%s":                                                     "%sCreando pedido de compra para: %s%s",
+	"%s✓ Purchase Order created: %s%s":                                                        "%s✓ Pedido de compra creado: %s%s",
+	"Use 'erp-cli po submit %s' to submit":                                                    "Usa 'erp-cli po submit %s' para validarlo",
+	"Use 'erp-cli po add-item %s <item> <qty>' to add items":                                  "Usa 'erp-cli po add-item %s <artículo> <cant>' para añadir artículos",
+	"%sAdding item to PO: %s%s":                                                               "%sAñadiendo artículo al pedido de compra: %s%s",
+	"Item: %s":                                                                                "Artículo: %s",
+	"purchase order not found":                                                                "pedido de compra no encontrado",
+	"cannot add items to submitted/cancelled PO":                                              "no se pueden añadir artículos a un pedido de compra validado o cancelado",
+	"Rate: %s":                           "Precio: %s",
+	"%s✓ Item added to PO: %s%s":         "%s✓ Artículo añadido al pedido de compra: %s%s",
+	"%sSubmitting purchase order: %s%s":  "%sValidando pedido de compra: %s%s",
+	"%s✓ Purchase Order submitted: %s%s": "%s✓ Pedido de compra validado: %s%s",
+	"%sCancelling purchase order: %s%s":  "%sCancelando pedido de compra: %s%s",
+	"%s✓ Purchase Order cancelled: %s%s": "%s✓ Pedido de compra cancelado: %s%s",
+
+	// Purchase invoices
+	"Usage: erp-cli pi <subcommand> [args...]":                                                                                       "Uso: erp-cli pi <subcomando> [argumentos...]",
+	"Subcommands: list, get, create-from-po, submit, cancel, pdf":                                                                    "Subcomandos: list, get, create-from-po, submit, cancel, pdf",
+	"usage: erp-cli pi get <name> [--template=file.tmpl]":                                                                            "uso: erp-cli pi get <nombre> [--template=archivo.tmpl]",
+	"usage: erp-cli pi create-from-po <po_name> [--currency=X] [--exchange-rate=N] [--terms=X] [--remarks=\"...\"] [--letterhead=X]": "uso: erp-cli pi create-from-po <pedido_compra> [--currency=X] [--exchange-rate=N] [--terms=X] [--remarks=\"...\"] [--letterhead=X]",
+	"usage: erp-cli pi submit <name>":                                                                                                "uso: erp-cli pi submit <nombre>",
+	"usage: erp-cli pi cancel <name> [--cascade] [--yes]":                                                                            "uso: erp-cli pi cancel <nombre> [--cascade] [--yes]",
+	"usage: erp-cli pi pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]":                                        "uso: erp-cli pi pdf <nombre> [-o archivo.pdf] [--print-format=X] [--letterhead=Y] [--open]",
+	"unknown pi subcommand: %s":                                                                                                      "subcomando de pi desconocido: %s",
+	"%sFetching purchase invoices...%s":                                                                                              "%sObteniendo facturas de compra...%s",
+	"%sNo purchase invoices found%s":                                                                                                 "%sNo se encontraron facturas de compra%s",
+	"%sPurchase Invoices (%d):%s":                                                                                                    "%sFacturas de compra (%d):%s",
+	"%sFetching purchase invoice: %s%s":                                                                                              "%sObteniendo factura de compra: %s%s",
+	"%sPurchase Invoice: %s%s":                                                                                                       "%sFactura de compra: %s%s",
+	"(PO: %s)":                                                                                                                       "(pedido: %s)",
+	"%sCreating purchase invoice from PO: %s%s":                                                                                      "%sCreando factura de compra desde el pedido de compra: %s%s",
+	"%s✓ Purchase Invoice created: %s%s":                                                                                             "%s✓ Factura de compra creada: %s%s",
+	"From PO: %s":                                                                                                                    "Desde pedido de compra: %s",
+	"Use 'erp-cli pi submit %s' to submit":                                                                                           "Usa 'erp-cli pi submit %s' para validarla",
+	"purchase order must be submitted first":                                                                                         "primero hay que validar el pedido de compra",
+	"no items found in purchase order":                                                                                               "el pedido de compra no tiene artículos",
+	"%sSubmitting purchase invoice: %s%s":                                                                                            "%sValidando factura de compra: %s%s",
+	"%s✓ Purchase Invoice submitted: %s%s":                                                                                           "%s✓ Factura de compra validada: %s%s",
+	"%sCancelling purchase invoice: %s%s":                                                                                            "%sCancelando factura de compra: %s%s",
+	"%s✓ Purchase Invoice cancelled: %s%s":                                                                                           "%s✓ Factura de compra cancelada: %s%s",
+
+	"submit failed: %w": "la validación falló: %w",
+	"cancel failed: %w": "la cancelación falló: %w",
+	"rename failed: %w": "el cambio de nombre falló: %w",
+
+	// Quality inspections
+	"Usage: erp-cli qi <subcommand> [args...]":                                 "Uso: erp-cli qi <subcomando> [argumentos...]",
+	"Subcommands: list, get, create, submit, pending":                          "Subcomandos: list, get, create, submit, pending",
+	"Items flagged \"inspection required\" block the submit of their Purchase": "Los artículos marcados con \"inspección obligatoria\" bloquean la validación de su albarán",
+	"Receipt or Delivery Note until an accepted inspection is linked to them.": "de compra o albarán de entrega hasta que se les vincule una inspección aceptada.",
+	"create submits the inspection, which links it to the document's row;":     "create valida la inspección, lo que la vincula a la fila del documento;",
+	"--readings takes Parameter:value pairs separated by commas.":              "--readings admite pares Parámetro:valor separados por comas.",
+	"usage: erp-cli qi get <name>":                                             "uso: erp-cli qi get <nombre>",
+	"usage: erp-cli qi submit <name>":                                          "uso: erp-cli qi submit <nombre>",
+	"usage: erp-cli qi pending <receipt or delivery note>":                     "uso: erp-cli qi pending <albarán de compra o de entrega>",
+	"unknown qi subcommand: %s":                                                "subcomando de qi desconocido: %s",
+	"invalid reading (expected Parameter:value): %s":                           "lectura no válida (se espera Parámetro:valor): %s",
+	"invalid sample size: %s":                                                  "tamaño de muestra no válido: %s",
+	"usage: erp-cli qi create --ref=<receipt or delivery note> --item=<item_code> --result=Accepted|Rejected [--readings=\"Param:value,...\"] [--sample=N] [--remarks=X] [--draft]": "uso: erp-cli qi create --ref=<albarán de compra o de entrega> --item=<código_artículo> --result=Accepted|Rejected [--readings=\"Parám:valor,...\"] [--sample=N] [--remarks=X] [--draft]",
+	"invalid result (expected Accepted or Rejected): %s":           "resultado no válido (se espera Accepted o Rejected): %s",
+	"no purchase receipt or delivery note named %s":                "no hay ningún albarán de compra ni de entrega llamado %s",
+	"%sCreating quality inspection: %s on %s%s":                    "%sCreando inspección de calidad: %s en %s%s",
+	"%s %s is already submitted: inspections are linked to drafts": "%s %s ya está validado: las inspecciones se vinculan a borradores",
+	"%s has no row for item %s":                                    "%s no tiene fila para el artículo %s",
+	"%s✓ Quality Inspection created: %s%s":                         "%s✓ Inspección de calidad creada: %s%s",
+	"Status: Draft (%s)":                                           "Estado: Borrador (%s)",
+	"Use 'erp-cli qi submit %s' to link it to %s":                  "Usa 'erp-cli qi submit %s' para vincularla a %s",
+	"quality inspection %s created but not submitted: %w":          "inspección de calidad %s creada pero no validada: %w",
+	"%s✓ Quality Inspection submitted: %s%s":                       "%s✓ Inspección de calidad validada: %s%s",
+	"All inspections done: use 'erp-cli %s submit %s' to submit":   "Todas las inspecciones hechas: usa 'erp-cli %s submit %s' para validar",
+	"%sSubmitting quality inspection: %s%s":                        "%sValidando inspección de calidad: %s%s",
+	"%s (rejected in %s)":                                          "%s (rechazado en %s)",
+	"%s needs an accepted quality inspection for: %s (erp-cli qi create --ref=%s --item=<item> --result=Accepted)": "%s necesita una inspección de calidad aceptada para: %s (erp-cli qi create --ref=%s --item=<artículo> --result=Accepted)",
+	"%sChecking inspections of: %s%s":                                               "%sComprobando inspecciones de: %s%s",
+	"%s✓ No inspections pending on %s %s%s":                                         "%s✓ No hay inspecciones pendientes en %s %s%s",
+	"%sPending Inspections on %s %s (%d):%s":                                        "%sInspecciones pendientes en %s %s (%d):%s",
+	"• %s %s(rejected: %s)%s":                                                       "• %s %s(rechazada: %s)%s",
+	"• %s %s(not inspected)%s":                                                      "• %s %s(sin inspeccionar)%s",
+	"Record each with 'erp-cli qi create --ref=%s --item=<item> --result=Accepted'": "Registra cada una con 'erp-cli qi create --ref=%s --item=<artículo> --result=Accepted'",
+	"%sFetching quality inspections...%s":                                           "%sObteniendo inspecciones de calidad...%s",
+	"%sNo quality inspections found%s":                                              "%sNo se encontraron inspecciones de calidad%s",
+	"%sQuality Inspections (%d):%s":                                                 "%sInspecciones de calidad (%d):%s",
+	"%s %s | Date: %s | Result: %s%s%s":                                             "%s %s | Fecha: %s | Resultado: %s%s%s",
+	"%sFetching quality inspection: %s%s":                                           "%sObteniendo inspección de calidad: %s%s",
+	"quality inspection not found":                                                  "inspección de calidad no encontrada",
+	"%sQuality Inspection: %s%s":                                                    "%sInspección de calidad: %s%s",
+	"Reference: %s %s":                                                              "Referencia: %s %s",
+	"Sample Size: %g":                                                               "Tamaño de muestra: %g",
+	"Result: %s":                                                                    "Resultado: %s",
+	"Inspected By: %s":                                                              "Inspeccionado por: %s",
+	"Remarks: %s":                                                                   "Observaciones: %s",
+	"%sReadings:%s":                                                                 "%sLecturas:%s",
+
+	// Query reports
+	"unexpected response from report %s":                                                    "respuesta inesperada del informe %s",
+	"Usage: erp-cli qreport <Report Name> [--filter key=value ...] [--csv[=file]] [--json]": "Uso: erp-cli qreport <Nombre del informe> [--filter clave=valor ...] [--csv[=archivo]] [--json]",
+	"erp-cli qreport --list [--module=X]":                                                   "erp-cli qreport --list [--module=X]",
+	"The company filter is added when not given. Other required filters":                    "El filtro de empresa se añade si no se indica. Otros filtros obligatorios",
+	"(usually from_date and to_date) depend on the report.":                                 "(normalmente from_date y to_date) dependen del informe.",
+	"%sRunning report: %s%s":                                                                "%sEjecutando informe: %s%s",
+	"%s✓ Exported %d rows to %s%s":                                                          "%s✓ %d filas exportadas a %s%s",
+	"%sFetching reports...%s":                                                               "%sObteniendo informes...%s",
+	"%sNo reports found%s":                                                                  "%sNo se encontraron informes%s",
+	"%sReports (%d):%s":                                                                     "%sInformes (%d):%s",
+	"%s%s (%d rows):%s":                                                                     "%s%s (%d filas):%s",
+	"%sNo rows%s":                                                                           "%sSin filas%s",
+
+	// Purchase receipts
+	"Usage: erp-cli pr <subcommand> [args...]":                                                    "Uso: erp-cli pr <subcomando> [argumentos...]",
+	"usage: erp-cli pr get <name> [--template=file.tmpl]":                                         "uso: erp-cli pr get <nombre> [--template=archivo.tmpl]",
+	"usage: erp-cli pr create-from-po <po_name> [--terms=X] [--remarks=\"...\"] [--letterhead=X]": "uso: erp-cli pr create-from-po <pedido_compra> [--terms=X] [--remarks=\"...\"] [--letterhead=X]",
+	"usage: erp-cli pr submit <name>":                                                             "uso: erp-cli pr submit <nombre>",
+	"usage: erp-cli pr cancel <name> [--cascade] [--yes]":                                         "uso: erp-cli pr cancel <nombre> [--cascade] [--yes]",
+	"usage: erp-cli pr pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]":     "uso: erp-cli pr pdf <nombre> [-o archivo.pdf] [--print-format=X] [--letterhead=Y] [--open]",
+	"unknown pr subcommand: %s":                                                                   "subcomando de pr desconocido: %s",
+	"%sFetching purchase receipts...%s":                                                           "%sObteniendo albaranes de compra...%s",
+	"%sNo purchase receipts found%s":                                                              "%sNo se encontraron albaranes de compra%s",
+	"%sPurchase Receipts (%d):%s":                                                                 "%sAlbaranes de compra (%d):%s",
+	"%sFetching purchase receipt: %s%s":                                                           "%sObteniendo albarán de compra: %s%s",
+	"%sPurchase Receipt: %s%s":                                                                    "%sAlbarán de compra: %s%s",
+	"%sCreating purchase receipt from PO: %s%s":                                                   "%sCreando albarán de compra desde el pedido de compra: %s%s",
+	"%s✓ Purchase Receipt created: %s%s":                                                          "%s✓ Albarán de compra creado: %s%s",
+	"Use 'erp-cli pr submit %s' to submit":                                                        "Usa 'erp-cli pr submit %s' para validarlo",
+	"%sSubmitting purchase receipt: %s%s":                                                         "%sValidando albarán de compra: %s%s",
+	"%s✓ Purchase Receipt submitted: %s%s":                                                        "%s✓ Albarán de compra validado: %s%s",
+	"%sCancelling purchase receipt: %s%s":                                                         "%sCancelando albarán de compra: %s%s",
+	"%s✓ Purchase Receipt cancelled: %s%s":                                                        "%s✓ Albarán de compra cancelado: %s%s",
+
+	// Auto repeat
+	"invalid day %q (use 1-31)":                    "día no válido %q (usa 1-31)",
+	"--end must be after --start":                  "--end debe ser posterior a --start",
+	"invalid frequency %q (use %s)":                "frecuencia no válida %q (usa %s)",
+	"%sSetting up %s repeat of %s...%s":            "%sConfigurando repetición %s de %s...%s",
+	"%s already repeats via %s":                    "%s ya se repite mediante %s",
+	"%s is cancelled":                              "%s está cancelado",
+	"%s✓ Created Auto Repeat: %s%s":                "%s✓ Repetición automática creada: %s%s",
+	"Frequency: %s":                                "Frecuencia: %s",
+	"Next: %s":                                     "Siguiente: %s",
+	"Until: %s":                                    "Hasta: %s",
+	"%sFetching repeat schedules...%s":             "%sObteniendo repeticiones programadas...%s",
+	"%sNo repeat schedules for %s%s":               "%sNo hay repeticiones programadas de %s%s",
+	"%s%s Repeats (%d):%s":                         "%sRepeticiones de %s (%d):%s",
+	"%s | Next: %s | Until: %s | Status: %s%s%s%s": "%s | Siguiente: %s | Hasta: %s | Estado: %s%s%s%s",
+
+	// Reports
+	"usage: erp-cli report diff --from YYYY-MM-DD [--to YYYY-MM-DD]":     "uso: erp-cli report diff --from AAAA-MM-DD [--to AAAA-MM-DD]",
+	"Usage: erp-cli report [subcommand]":                                 "Uso: erp-cli report [subcomando]",
+	"(none)      Executive dashboard (default)":                          "(ninguno)   Panel ejecutivo (por defecto)",
+	"summary     Alias for dashboard":                                    "summary     Alias del panel",
+	"stock       Detailed stock report":                                  "stock       Informe detallado de stock",
+	"purchases   Detailed purchasing report":                             "purchases   Informe detallado de compras",
+	"commissions [--month=YYYY-MM]":                                      "commissions [--month=AAAA-MM]",
+	"Invoiced totals and commission per sales person":                    "Totales facturados y comisión por comercial",
+	"FIFO value from the stock ledger vs current valuation":              "Valor FIFO del libro de stock frente a la valoración actual",
+	"snapshot    Store today's key metrics (also done by the dashboard)": "snapshot    Guarda las métricas clave de hoy (también lo hace el panel)",
+	"diff --from YYYY-MM-DD [--to YYYY-MM-DD]":                           "diff --from AAAA-MM-DD [--to AAAA-MM-DD]",
+	"Compare stored metric snapshots between two dates":                  "Compara las instantáneas de métricas guardadas entre dos fechas",
+	"ap [--supplier=X] [--as-of=YYYY-MM-DD] [--detail] [--csv[=file]]":   "ap [--supplier=X] [--as-of=AAAA-MM-DD] [--detail] [--csv[=archivo]]",
+	"Accounts payable aging by due date, per supplier":                   "Antigüedad de cuentas por pagar por vencimiento, por proveedor",
+	"Revenue per period, top customers and top items":                    "Ingresos por periodo, principales clientes y artículos",
+	"Estimated gross margin per month, item and customer":                "Margen bruto estimado por mes, artículo y cliente",
+	"%sLoading dashboard...%s":                                           "%sCargando panel...%s",
+	"%sWarning: snapshot not saved: %s%s":                                "%sAviso: instantánea no guardada: %s%s",
+	"%sGenerating stock report...%s":                                     "%sGenerando informe de stock...%s",
+	"%s                    STOCK REPORT                              %s": "%s                  INFORME DE STOCK                            %s",
+	"%sSummary:%s":                       "%sResumen:%s",
+	"Total items with stock entries: %d": "Artículos con movimientos de stock: %d",
+	"Total stock value: %s":              "Valor total del stock: %s",
+	"Total quantity: %.0f units":         "Cantidad total: %.0f unidades",
+	"Zero stock entries: %d":             "Entradas sin stock: %d",
+
+	"%sBy Warehouse:%s":                   "%sPor almacén:%s",
+	"Quantity: %.0f | Value: %s":          "Cantidad: %.0f | Valor: %s",
+	"%sGenerating purchasing report...%s": "%sGenerando informe de compras...%s",
+	"%s                    PURCHASING REPORT                         %s":  "%s                 INFORME DE COMPRAS                           %s",
+	"%sDraft Purchase Orders:%s":                                          "%sPedidos de compra en borrador:%s",
+	"No draft POs":                                                        "No hay pedidos de compra en borrador",
+	"%sPending Purchase Orders (To Receive):%s":                           "%sPedidos de compra pendientes (por recibir):%s",
+	"No pending POs":                                                      "No hay pedidos de compra pendientes",
+	"%sTotal pending: %s%s":                                               "%sTotal pendiente: %s%s",
+	"%sUnpaid Purchase Invoices:%s":                                       "%sFacturas de compra sin pagar:%s",
+	"No unpaid invoices":                                                  "No hay facturas sin pagar",
+	"- %s: %s (Outstanding: %s of %s) - %s":                               "- %s: %s (Pendiente: %s de %s) - %s",
+	"%sTotal outstanding: %s%s":                                           "%sTotal pendiente: %s%s",
+	"%sSupplier Statistics (by PO count):%s":                              "%sEstadísticas de proveedores (por número de pedidos):%s",
+	"... and %d more suppliers":                                           "... y %d proveedores más",
+	"%2d. %-30s %3d POs  %s":                                              "%2d. %-30s %3d pedidos  %s",
+	"invalid month: %s (expected YYYY-MM)":                                "mes no válido: %s (se espera AAAA-MM)",
+	"%sGenerating commissions report for %s...%s":                         "%sGenerando informe de comisiones de %s...%s",
+	"%sNo invoices with sales persons found for %s%s":                     "%sNo se encontraron facturas con comerciales en %s%s",
+	"%sCommissions (%s to %s):%s":                                         "%sComisiones (%s a %s):%s",
+	"%2d. %-30s %3d invoices  %s  Commission: %s":                         "%2d. %-30s %3d facturas  %s  Comisión: %s",
+	"%sTotal invoiced: %s | Total commission: %s%s":                       "%sTotal facturado: %s | Comisión total: %s%s",
+	"%sGenerating valuation comparison...%s":                              "%sGenerando comparación de valoración...%s",
+	"%sNo stock ledger entries found%s":                                   "%sNo se encontraron movimientos en el libro de stock%s",
+	"%sValuation Comparison (%d item/warehouse pairs):%s":                 "%sComparación de valoración (%d pares artículo/almacén):%s",
+	"FIFO value:   %s":                                                    "Valor FIFO:     %s",
+	"Booked value: %s":                                                    "Valor contable: %s",
+	"Difference:   %s":                                                    "Diferencia:     %s",
+	"%s✓ No deviations above %.0f%%%s":                                    "%s✓ Sin desviaciones por encima del %.0f%%%s",
+	"%sDeviations above %.0f%% (%d):%s":                                   "%sDesviaciones por encima del %.0f%% (%d):%s",
+	"Qty: %.2f | FIFO: %s | Booked: %s | %s%.1f%%%s":                      "Cant: %.2f | FIFO: %s | Contable: %s | %s%.1f%%%s",
+	"Large deviations usually point to back-dated or mis-rated postings.": "Las desviaciones grandes suelen indicar asientos con fecha retroactiva o mal valorados.",
+
+	// Scripts
+	"Usage: erp-cli run <script.erp | -> [--stop-on-error] [--log=FILE]":       "Uso: erp-cli run <script.erp | -> [--stop-on-error] [--log=ARCHIVO]",
+	"Runs the commands of a script, one per line as typed after 'erp-cli'.":    "Ejecuta los comandos de un script, uno por línea tal como se escriben tras 'erp-cli'.",
+	"Blank lines and lines starting with # are skipped; NAME=value sets a":     "Se omiten las líneas en blanco y las que empiezan por #; NOMBRE=valor define una",
+	"variable, used as $NAME or ${NAME} in later lines (environment variables": "variable, que se usa como $NOMBRE o ${NOMBRE} en las líneas siguientes (también las",
+	"too). After a command that creates documents:":                            "variables de entorno). Tras un comando que crea documentos:",
+	"$LAST_NAME, $LAST_DOCTYPE   The last document created":                    "$LAST_NAME, $LAST_DOCTYPE   El último documento creado",
+	"$LAST_SALES_ORDER, ...      The last one of each doctype":                 "$LAST_SALES_ORDER, ...      El último de cada doctype",
+	"Options:": "Opciones:",
+	"-                  Read the script from stdin":                           "-                  Lee el script de stdin",
+	"--stop-on-error    Stop at the first failed command (default: carry on)": "--stop-on-error    Se detiene en el primer comando que falle (por defecto: sigue)",
+	"--log=FILE         Append a transcript of commands and their output":     "--log=FILE         Añade una transcripción de los comandos y su salida",
+	"Example script:": "Script de ejemplo:",
+	"usage: erp-cli run <script.erp | -> [--stop-on-error] [--log=FILE]": "uso: erp-cli run <script.erp | -> [--stop-on-error] [--log=ARCHIVO]",
+	"cannot open script: %w":               "no se puede abrir el script: %w",
+	"cannot read script: %w":               "no se puede leer el script: %w",
+	"cannot open log: %w":                  "no se puede abrir el registro: %w",
+	"no command":                           "ningún comando",
+	"%s✗ Line %d: %s%s":                    "%s✗ Línea %d: %s%s",
+	"stopped at line %d":                   "detenido en la línea %d",
+	"%s✗ Line %d failed%s":                 "%s✗ La línea %d falló%s",
+	"%d line(s) failed, %d command(s) run": "%d línea(s) fallida(s), %d comando(s) ejecutado(s)",
+	"%s✓ %d command(s) run%s":              "%s✓ %d comando(s) ejecutado(s)%s",
+	"$%s is not set":                       "$%s no está definida",
+
+	// Quotations
+	"Usage: erp-cli quotation <subcommand> [args...]":            "Uso: erp-cli quotation <subcomando> [argumentos...]",
+	"usage: erp-cli quotation get <name> [--template=file.tmpl]": "uso: erp-cli quotation get <nombre> [--template=archivo.tmpl]",
+	"usage: erp-cli quotation create <customer> [--discount=N%%|--discount-amount=N] [--coupon=CODE] [--currency=X [--exchange-rate=N]] [--transaction-date=YYYY-MM-DD] [--terms=X] [--remarks=\"...\"] [--letterhead=X]": "uso: erp-cli quotation create <cliente> [--discount=N%%|--discount-amount=N] [--coupon=CÓDIGO] [--currency=X [--exchange-rate=N]] [--transaction-date=AAAA-MM-DD] [--terms=X] [--remarks=\"...\"] [--letterhead=X]",
+	"usage: erp-cli quotation add-item <name> <item_code> <qty> [--rate=X]":                          "uso: erp-cli quotation add-item <nombre> <código_artículo> <cant> [--rate=X]",
+	"usage: erp-cli quotation submit <name> [--notify|--no-notify]":                                  "uso: erp-cli quotation submit <nombre> [--notify|--no-notify]",
+	"usage: erp-cli quotation cancel <name> [--cascade] [--yes]":                                     "uso: erp-cli quotation cancel <nombre> [--cascade] [--yes]",
+	"usage: erp-cli quotation pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]": "uso: erp-cli quotation pdf <nombre> [-o archivo.pdf] [--print-format=X] [--letterhead=Y] [--open]",
+	"unknown quotation subcommand: %s":                                                               "subcomando de quotation desconocido: %s",
+	"%sFetching quotations...%s":                                                                     "%sObteniendo presupuestos...%s",
+	"%sNo quotations found%s":                                                                        "%sNo se encontraron presupuestos%s",
+	"%sQuotations (%d):%s":                                                                           "%sPresupuestos (%d):%s",
+	"%sFetching quotation: %s%s":                                                                     "%sObteniendo presupuesto: %s%s",
+	"%sQuotation: %s%s":                                                                              "%sPresupuesto: %s%s",
+	"Valid Till: %s":                                                                                 "Válido hasta: %s",
+	"%sCreating quotation for: %s%s":                                                                 "%sCreando presupuesto para: %s%s",
+	"%s✓ Quotation created: %s%s":                                                                    "%s✓ Presupuesto creado: %s%s",
+	"Valid until: %s":                                                                                "Válido hasta: %s",
+	"Use 'erp-cli quotation add-item %s <item> <qty>' to add items":                                  "Usa 'erp-cli quotation add-item %s <artículo> <cant>' para añadir artículos",
+	"%sAdding item to Quotation: %s%s":                                                               "%sAñadiendo artículo al presupuesto: %s%s",
+	"quotation not found":                                                                            "presupuesto no encontrado",
+	"cannot add items to submitted/cancelled quotation":                                              "no se pueden añadir artículos a un presupuesto validado o cancelado",
+	"Item: %s (customer code: %s)":                                                                   "Artículo: %s (código del cliente: %s)",
+	"%s✓ Item added to Quotation: %s%s":                                                              "%s✓ Artículo añadido al presupuesto: %s%s",
+	"%sSubmitting quotation: %s%s":                                                                   "%sValidando presupuesto: %s%s",
+	"%s✓ Quotation submitted: %s%s":                                                                  "%s✓ Presupuesto validado: %s%s",
+	"%sCancelling quotation: %s%s":                                                                   "%sCancelando presupuesto: %s%s",
+	"%s✓ Quotation cancelled: %s%s":                                                                  "%s✓ Presupuesto cancelado: %s%s",
+
+	// Sales orders
+	"Usage: erp-cli so <subcommand> [args...]":                                             "Uso: erp-cli so <subcomando> [argumentos...]",
+	"Subcommands: list, get, create, create-from-quotation, add-item, submit, cancel, pdf": "Subcomandos: list, get, create, create-from-quotation, add-item, submit, cancel, pdf",
+	"usage: erp-cli so get <name> [--template=file.tmpl]":                                  "uso: erp-cli so get <nombre> [--template=archivo.tmpl]",
+	"usage: erp-cli so create <customer> [--sales-person=Name[:pct]] [--blanket=X [--items=CODE:QTY,...]] [--discount=N%%|--discount-amount=N] [--coupon=CODE] [--shipping-rule=X] [--billing-address=X] [--shipping-address=X] [--currency=X [--exchange-rate=N]] [--transaction-date=YYYY-MM-DD] [--terms=X] [--remarks=\"...\"] [--letterhead=X] [-i]": "uso: erp-cli so create <cliente> [--sales-person=Nombre[:pct]] [--blanket=X [--items=CÓDIGO:CANT,...]] [--discount=N%%|--discount-amount=N] [--coupon=CÓDIGO] [--shipping-rule=X] [--billing-address=X] [--shipping-address=X] [--currency=X [--exchange-rate=N]] [--transaction-date=AAAA-MM-DD] [--terms=X] [--remarks=\"...\"] [--letterhead=X] [-i]",
+	"--blanket cannot be combined with -i":                                       "--blanket no se puede combinar con -i",
+	"--currency cannot be combined with -i (its rates come from the price list)": "--currency no se puede combinar con -i (sus precios vienen de la lista de precios)",
+	"usage: erp-cli so create-from-quotation <quotation_name> [--transaction-date=YYYY-MM-DD] [--billing-address=X] [--shipping-address=X] [--terms=X] [--remarks=\"...\"] [--letterhead=X]": "uso: erp-cli so create-from-quotation <presupuesto> [--transaction-date=AAAA-MM-DD] [--billing-address=X] [--shipping-address=X] [--terms=X] [--remarks=\"...\"] [--letterhead=X]",
+	"usage: erp-cli so add-item <so_name> <item_code> <qty> [--rate=X]":                       "uso: erp-cli so add-item <pedido_venta> <código_artículo> <cant> [--rate=X]",
+	"usage: erp-cli so submit <name> [--force] [--notify|--no-notify]":                        "uso: erp-cli so submit <nombre> [--force] [--notify|--no-notify]",
+	"usage: erp-cli so cancel <name> [--cascade] [--yes]":                                     "uso: erp-cli so cancel <nombre> [--cascade] [--yes]",
+	"usage: erp-cli so pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]": "uso: erp-cli so pdf <nombre> [-o archivo.pdf] [--print-format=X] [--letterhead=Y] [--open]",
+	"unknown so subcommand: %s":                                                               "subcomando de so desconocido: %s",
+	"%sFetching sales orders...%s":                                                            "%sObteniendo pedidos de venta...%s",
+	"%sNo sales orders found%s":                                                               "%sNo se encontraron pedidos de venta%s",
+	"%sSales Orders (%d):%s":                                                                  "%sPedidos de venta (%d):%s",
+	"%sFetching sales order: %s%s":                                                            "%sObteniendo pedido de venta: %s%s",
+	"%sSales Order: %s%s":                                                                     "%sPedido de venta: %s%s",
+	"Delivery Date: %s":                                                                       "Fecha de entrega: %s",
+	"Auto Repeat: %s":                                                                         "Repetición automática: %s",
+	"%sCreating sales order for: %s%s":                                                        "%sCreando pedido de venta para: %s%s",
+	"Sales Person: %s (%.0f%%)":                                                               "Comercial: %s (%.0f%%)",
+	"Use 'erp-cli so add-item %s <item> <qty>' to add items":                                  "Usa 'erp-cli so add-item %s <artículo> <cant>' para añadir artículos",
+	"%sCreating sales order from Quotation: %s%s":                                             "%sCreando pedido de venta desde el presupuesto: %s%s",
+	"quotation must be submitted first":                                                       "primero hay que validar el presupuesto",
+	"no items found in quotation":                                                             "el presupuesto no tiene artículos",
+
+	"From Quotation: %s":                         "Desde presupuesto: %s",
+	"%sAdding item to SO: %s%s":                  "%sAñadiendo artículo al pedido de venta: %s%s",
+	"cannot add items to submitted/cancelled SO": "no se pueden añadir artículos a un pedido de venta validado o cancelado",
+	"%s✓ Item added to SO: %s%s":                 "%s✓ Artículo añadido al pedido de venta: %s%s",
+	"%sSubmitting sales order: %s%s":             "%sValidando pedido de venta: %s%s",
+	"%s✓ Sales Order submitted: %s%s":            "%s✓ Pedido de venta validado: %s%s",
+	"%sCancelling sales order: %s%s":             "%sCancelando pedido de venta: %s%s",
+	"%s✓ Sales Order cancelled: %s%s":            "%s✓ Pedido de venta cancelado: %s%s",
+
+	// Sales invoices
+	"Usage: erp-cli si <subcommand> [args...]":                           "Uso: erp-cli si <subcomando> [argumentos...]",
+	"Subcommands: list, get, create-from-so, submit, cancel, pdf, recur": "Subcomandos: list, get, create-from-so, submit, cancel, pdf, recur",
+	"usage: erp-cli si get <name> [--template=file.tmpl]":                "uso: erp-cli si get <nombre> [--template=archivo.tmpl]",
+	"usage: erp-cli si create-from-so <so_name> [--sales-person=Name[:pct]] [--discount=N%%|--discount-amount=N] [--coupon=CODE] [--shipping-rule=X] [--billing-address=X] [--shipping-address=X] [--currency=X] [--exchange-rate=N] [--terms=X] [--remarks=\"...\"] [--letterhead=X]": "uso: erp-cli si create-from-so <pedido_venta> [--sales-person=Nombre[:pct]] [--discount=N%%|--discount-amount=N] [--coupon=CÓDIGO] [--shipping-rule=X] [--billing-address=X] [--shipping-address=X] [--currency=X] [--exchange-rate=N] [--terms=X] [--remarks=\"...\"] [--letterhead=X]",
+	"usage: erp-cli si submit <name> [--notify|--no-notify]":                                                                          "uso: erp-cli si submit <nombre> [--notify|--no-notify]",
+	"usage: erp-cli si cancel <name> [--cascade] [--yes]":                                                                             "uso: erp-cli si cancel <nombre> [--cascade] [--yes]",
+	"usage: erp-cli si pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]":                                         "uso: erp-cli si pdf <nombre> [-o archivo.pdf] [--print-format=X] [--letterhead=Y] [--open]",
+	"usage: erp-cli si recur <name> [--frequency=Monthly] [--start=YYYY-MM-DD] [--end=YYYY-MM-DD] [--day=N] [--submit] [--email=a,b]": "uso: erp-cli si recur <nombre> [--frequency=Monthly] [--start=AAAA-MM-DD] [--end=AAAA-MM-DD] [--day=N] [--submit] [--email=a,b]",
+	"unknown si subcommand: %s":                                 "subcomando de si desconocido: %s",
+	"%sFetching sales invoices...%s":                            "%sObteniendo facturas de venta...%s",
+	"%sNo sales invoices found%s":                               "%sNo se encontraron facturas de venta%s",
+	"%sSales Invoices (%d):%s":                                  "%sFacturas de venta (%d):%s",
+	"%sFetching sales invoice: %s%s":                            "%sObteniendo factura de venta: %s%s",
+	"%sSales Invoice: %s%s":                                     "%sFactura de venta: %s%s",
+	"%sCreating sales invoice from SO: %s%s":                    "%sCreando factura de venta desde el pedido de venta: %s%s",
+	"%s✓ Sales Invoice created: %s%s":                           "%s✓ Factura de venta creada: %s%s",
+	"Use 'erp-cli si submit %s' to submit":                      "Usa 'erp-cli si submit %s' para validarla",
+	"%sSubmitting sales invoice: %s%s":                          "%sValidando factura de venta: %s%s",
+	"%s✓ Sales Invoice submitted: %s%s":                         "%s✓ Factura de venta validada: %s%s",
+	"%sCancelling sales invoice: %s%s":                          "%sCancelando factura de venta: %s%s",
+	"%s✓ Sales Invoice cancelled: %s%s":                         "%s✓ Factura de venta cancelada: %s%s",
+	"invalid allocation for sales person: %s":                   "reparto no válido para el comercial: %s",
+	"no allocation left for sales persons without a percentage": "no queda reparto para los comerciales sin porcentaje",
+	"sales team allocation must total 100%% (got %.2f%%)":       "el reparto del equipo de ventas debe sumar 100%% (suma %.2f%%)",
+	"%sSales Team:%s":                                           "%sEquipo de ventas:%s",
+	", commission %s":                                           ", comisión %s",
+
+	// Serial numbers
+	"Usage: erp-cli serial <subcommand> [args...]":                                    "Uso: erp-cli serial <subcomando> [argumentos...]",
+	"Subcommands: create, list, get, find, history, set-warranty, create-batch":       "Subcomandos: create, list, get, find, history, set-warranty, create-batch",
+	"usage: erp-cli serial create <serial_no> <item_code> [--supplier=X] [--batch=X]": "uso: erp-cli serial create <núm_serie> <código_artículo> [--supplier=X] [--batch=X]",
+	"usage: erp-cli serial list <item_code> [--warehouse=X]":                          "uso: erp-cli serial list <código_artículo> [--warehouse=X]",
+	"usage: erp-cli serial get <serial_no>":                                           "uso: erp-cli serial get <núm_serie>",
+	"usage: erp-cli serial find <serial_no fragment>":                                 "uso: erp-cli serial find <fragmento de núm_serie>",
+	"usage: erp-cli serial history <serial_no>":                                       "uso: erp-cli serial history <núm_serie>",
+	"usage: erp-cli serial set-warranty <serial_no> <YYYY-MM-DD>":                     "uso: erp-cli serial set-warranty <núm_serie> <AAAA-MM-DD>",
+	"usage: erp-cli serial create-batch <item_code> <prefix> <start> <count>":         "uso: erp-cli serial create-batch <código_artículo> <prefijo> <inicio> <cantidad>",
+	"invalid start number: %s":                                                        "número inicial no válido: %s",
+	"invalid count: %s":                                                               "cantidad no válida: %s",
+	"unknown serial subcommand: %s":                                                   "subcomando de serial desconocido: %s",
+	"%sCreating serial number: %s%s":                                                  "%sCreando número de serie: %s%s",
+	"Batch: %s":                                                                       "Lote: %s",
+	"%s✓ Serial number created: %s%s":                                                 "%s✓ Número de serie creado: %s%s",
+	"%sFetching serial numbers for: %s%s":                                             "%sObteniendo números de serie de: %s%s",
+	"%sNo serial numbers found for: %s%s":                                             "%sNo se encontraron números de serie de: %s%s",
+	"%sSerial Numbers (%d):%s":                                                        "%sNúmeros de serie (%d):%s",
+	"%sActive (%d):%s":                                                                "%sActivos (%d):%s",
+	"%sDelivered (%d):%s":                                                             "%sEntregados (%d):%s",
+	"%sOther (%d):%s":                                                                 "%sOtros (%d):%s",
+	"(purchased: %s)":                                                                 "(comprado: %s)",
+	"%sFetching serial number: %s%s":                                                  "%sObteniendo número de serie: %s%s",
+	"%sSerial Number: %s%s":                                                           "%sNúmero de serie: %s%s",
+	"Delivered To: %s (%s)":                                                           "Entregado a: %s (%s)",
+	"%sSearching serial numbers: %s%s":                                                "%sBuscando números de serie: %s%s",
+	"%sNo serial numbers match: %s%s":                                                 "%sNingún número de serie coincide con: %s%s",
+	"%s  Showing the first 50: type more of the serial number%s":                      "%s  Mostrando los 50 primeros: escribe más del número de serie%s",
+	"%sFetching history of serial number: %s%s":                                       "%sObteniendo historial del número de serie: %s%s",
+	"%sSerial Number: %s%s (%s, %s)":                                                  "%sNúmero de serie: %s%s (%s, %s)",
+	"%s  No stock movements recorded%s":                                               "%s  No hay movimientos de stock registrados%s",
+	"Warranty Expiry: %s":                                                             "Fin de garantía: %s",
+	"invalid warranty date (expected YYYY-MM-DD): %s":                                 "fecha de garantía no válida (se espera AAAA-MM-DD): %s",
+	"%sSetting warranty of %s to %s%s":                                                "%sFijando la garantía de %s en %s%s",
+	"%s✓ Warranty expiry of %s: %s%s":                                                 "%s✓ Fin de garantía de %s: %s%s",
+	"%s  The warranty has already expired%s":                                          "%s  La garantía ya ha vencido%s",
+	"%sCreating %d serial numbers...%s":                                               "%sCreando %d números de serie...%s",
+	"Pattern: %s%03d - %s%03d":                                                        "Patrón: %s%03d - %s%03d",
+	"%sSummary: %d created, %d failed%s":                                              "%sResumen: %d creados, %d fallidos%s",
+
+	// Naming series
+	"Usage: erp-cli series <subcommand>":                                    "Uso: erp-cli series <subcomando>",
+	"New documents take the doctype's default naming series unless created": "Los documentos nuevos usan la serie de numeración por defecto del doctype salvo que se creen",
+	"with --naming-series=<series>, one of those listed for the doctype.":   "con --naming-series=<serie>, una de las que se listan para el doctype.",
+	"usage: erp-cli series list <doctype>":                                  "uso: erp-cli series list <doctype>",
+	"unknown series subcommand: %s":                                         "subcomando de series desconocido: %s",
+	"%s is not named by series":                                             "%s no se numera por series",
+	"%sNaming series for %s:%s":                                             "%sSeries de numeración de %s:%s",
+	"%sNo naming series set up%s":                                           "%sNo hay series de numeración configuradas%s",
+	"%s %s(default)%s":                                                      "%s %s(por defecto)%s",
+
+	// Field overrides (--set)
+	"--set and --naming-series only apply to commands that create or save documents": "--set y --naming-series solo se aplican a comandos que crean o guardan documentos",
+	"--naming-series only applies to new documents":                                  "--naming-series solo se aplica a documentos nuevos",
+	"cannot check --set fields against %s: %w":                                       "no se pueden comprobar los campos de --set contra %s: %w",
+	"--naming-series: %s is not named by series":                                     "--naming-series: %s no se numera por series",
+	"--set %s: %s has no field %q":                                                   "--set %s: %s no tiene el campo %q",
+	"--naming-series: %w (see 'erp-cli series list %s')":                             "--naming-series: %w (ver 'erp-cli series list %s')",
+	"--set %s: %w":                       "--set %s: %w",
+	"%s is a whole number field, got %q": "%s es un campo de número entero, no %q",
+	"%s is a number field, got %q":       "%s es un campo numérico, no %q",
+	"%q is not one of: %s":               "%q no es uno de: %s",
+	"a %s field cannot be set":           "no se puede fijar un campo %s",
+
+	// Shipping rules
+	"shipping rule not found: %s":               "regla de envío no encontrada: %s",
+	"shipping rule %s is disabled":              "la regla de envío %s está desactivada",
+	"shipping rule %s is for %s, not selling":   "la regla de envío %s es para %s, no para ventas",
+	"Shipping Rule: %s (freight added on save)": "Regla de envío: %s (los portes se añaden al guardar)",
+	"Shipping Rule: %s":                         "Regla de envío: %s",
+	"Charges:":                                  "Cargos:",
+	"Total charges: %s":                         "Total de cargos: %s",
+
+	// Metric snapshots
+	"dashboard data incomplete":      "datos del panel incompletos",
+	"%sTaking metrics snapshot...%s": "%sTomando instantánea de métricas...%s",
+	"%s✓ Snapshot saved: %s%s":       "%s✓ Instantánea guardada: %s%s",
+	"no snapshots stored yet; run 'erp-cli report' or 'erp-cli report snapshot' regularly": "todavía no hay instantáneas guardadas; ejecuta 'erp-cli report' o 'erp-cli report snapshot' con regularidad",
+	"no snapshot on or before %s (oldest is %s)":                                           "no hay instantánea en o antes del %s (la más antigua es del %s)",
+	"%sChanges %s → %s%s":                  "%sCambios %s → %s%s",
+	"%s(nearest snapshots to %s and %s)%s": "%s(instantáneas más cercanas a %s y %s)%s",
+
+	// Warehouses
+	"Usage: erp-cli warehouse <subcommand> [args...]":               "Uso: erp-cli warehouse <subcomando> [argumentos...]",
+	"Subcommands: list, tree, create, rename, disable":              "Subcomandos: list, tree, create, rename, disable",
+	"usage: erp-cli warehouse create <name> [--parent=X] [--group]": "uso: erp-cli warehouse create <nombre> [--parent=X] [--group]",
+	"usage: erp-cli warehouse rename <old_name> <new_name>":         "uso: erp-cli warehouse rename <nombre_antiguo> <nombre_nuevo>",
+
+	"usage: erp-cli warehouse disable <name>": "uso: erp-cli warehouse disable <nombre>",
+	"unknown warehouse subcommand: %s":        "subcomando de warehouse desconocido: %s",
+	"%sFetching warehouses...%s":              "%sObteniendo almacenes...%s",
+	"%s %s (parent: %s)":                      "%s %s (padre: %s)",
+	"%sFetching warehouse hierarchy...%s":     "%sObteniendo la jerarquía de almacenes...%s",
+	"%sNo warehouses found%s":                 "%sNo se encontraron almacenes%s",
+	"%sCreating warehouse: %s%s":              "%sCreando almacén: %s%s",
+	"Type: Group":                             "Tipo: Grupo",
+	"%s✓ Warehouse created: %s%s":             "%s✓ Almacén creado: %s%s",
+	"%sRenaming warehouse: %s → %s%s":         "%sRenombrando almacén: %s → %s%s",
+	"%s✓ Warehouse renamed: %s%s":             "%s✓ Almacén renombrado: %s%s",
+	"%sDisabling warehouse: %s%s":             "%sDesactivando almacén: %s%s",
+	"%s✓ Warehouse disabled: %s%s":            "%s✓ Almacén desactivado: %s%s",
+
+	// Stock
+	"Usage: erp-cli stock <subcommand> [args...]":                                                                              "Uso: erp-cli stock <subcomando> [argumentos...]",
+	"Subcommands: get, receive, transfer, issue":                                                                               "Subcomandos: get, receive, transfer, issue",
+	"receive and issue take ERP_DEFAULT_WAREHOUSE when the warehouse is left out.":                                             "receive e issue usan ERP_DEFAULT_WAREHOUSE cuando no se indica almacén.",
+	"usage: erp-cli stock get <item_code> [warehouse]":                                                                         "uso: erp-cli stock get <código_artículo> [almacén]",
+	"usage: erp-cli stock receive <item_code> <qty> <warehouse> [--rate=X] [--posting-date=YYYY-MM-DD] [--posting-time=HH:MM]": "uso: erp-cli stock receive <código_artículo> <cant> <almacén> [--rate=X] [--posting-date=AAAA-MM-DD] [--posting-time=HH:MM]",
+	"usage: erp-cli stock transfer <item_code> <qty> <from_warehouse> <to_warehouse>":                                          "uso: erp-cli stock transfer <código_artículo> <cant> <almacén_origen> <almacén_destino>",
+	"usage: erp-cli stock issue <item_code> <qty> <warehouse>":                                                                 "uso: erp-cli stock issue <código_artículo> <cant> <almacén>",
+	"unknown stock subcommand: %s":                                                                                             "subcomando de stock desconocido: %s",
+	"%sFetching stock for: %s%s":                                                                                               "%sObteniendo stock de: %s%s",
+	"%sNo stock found for: %s%s":                                                                                               "%sNo se encontró stock de: %s%s",
+	"%sStock for %s:%s":                                                                                                        "%sStock de %s:%s",
+	"Actual: %s%.0f%s":                                                                                                         "Real: %s%.0f%s",
+	"| Reserved: %s%.0f%s":                                                                                                     "| Reservado: %s%.0f%s",
+	"| Ordered: %s%.0f%s":                                                                                                      "| Pedido: %s%.0f%s",
+	"%sTotal: %.0f%s":                                                                                                          "%sTotal: %.0f%s",
+	"%sReceiving stock...%s":                                                                                                   "%sRecibiendo stock...%s",
+	"Warehouse: %s":                                                                                                            "Almacén: %s",
+	"%s✓ Stock Entry created: %s%s":                                                                                            "%s✓ Movimiento de stock creado: %s%s",
+	"%sWarning: Entry created but not submitted: %s%s":                                                                         "%sAviso: movimiento creado pero no validado: %s%s",
+	"You may need to submit it manually in the ERP":                                                                            "Puede que tengas que validarlo a mano en el ERP",
+	"%s✓ Stock Entry submitted%s":                                                                                              "%s✓ Movimiento de stock validado%s",
+	"%sTransferring stock...%s":                                                                                                "%sTransfiriendo stock...%s",
+	"From: %s":                                                                                                                 "Desde: %s",
+	"To: %s":                                                                                                                   "Hasta: %s",
+	"%sIssuing stock...%s":                                                                                                     "%sDando salida al stock...%s",
+	"no company found. Set ERP_COMPANY in config":                                                                              "no se encontró ninguna empresa. Define ERP_COMPANY en la configuración",
+
+	// Local store
+	"cannot determine home directory: %w": "no se puede determinar el directorio personal: %w",
+	"failed to parse %s: %w":              "no se pudo interpretar %s: %w",
+
+	// Subscriptions
+	"Usage: erp-cli subscription <subcommand> [args...]":                               "Uso: erp-cli subscription <subcomando> [argumentos...]",
+	"Subcommands: list, get, create, cancel, plans":                                    "Subcomandos: list, get, create, cancel, plans",
+	"A subscription bills a customer for one or more Subscription Plans every":         "Una suscripción factura a un cliente uno o varios planes de suscripción cada",
+	"period; ERPNext generates the invoices on its daily schedule. --plan is":          "periodo; ERPNext genera las facturas en su tarea diaria. --plan es",
+	"repeatable and takes PLAN or PLAN:QTY. Invoices are raised at the end":            "repetible y admite PLAN o PLAN:CANT. Las facturas se emiten al final",
+	"of each period unless --in-advance, and left as drafts unless --submit-invoices.": "de cada periodo salvo con --in-advance, y quedan en borrador salvo con --submit-invoices.",
+	"usage: erp-cli subscription get <name>":                                           "uso: erp-cli subscription get <nombre>",
+	"invalid --days-until-due: %s":                                                     "--days-until-due no válido: %s",
+	"usage: erp-cli subscription create <customer> --plan=X[:qty] [...] [--start=YYYY-MM-DD] [--end=YYYY-MM-DD] [--in-advance] [--submit-invoices] [--days-until-due=N]": "uso: erp-cli subscription create <cliente> --plan=X[:cant] [...] [--start=AAAA-MM-DD] [--end=AAAA-MM-DD] [--in-advance] [--submit-invoices] [--days-until-due=N]",
+	"usage: erp-cli subscription cancel <name> [--yes]":          "uso: erp-cli subscription cancel <nombre> [--yes]",
+	"unknown subscription subcommand: %s":                        "subcomando de subscription desconocido: %s",
+	"%sCreating subscription for: %s%s":                          "%sCreando suscripción para: %s%s",
+	"%s✓ Subscription created: %s%s":                             "%s✓ Suscripción creada: %s%s",
+	"Plan: %s x %g":                                              "Plan: %s x %g",
+	"First period: %s to %s":                                     "Primer periodo: %s a %s",
+	"at the end of each period":                                  "al final de cada periodo",
+	"left as drafts":                                             "en borrador",
+	"at the start of each period":                                "al principio de cada periodo",
+	"submitted":                                                  "validadas",
+	"Invoices: %s, %s":                                           "Facturas: %s, %s",
+	"the subscription ends (%s) before it starts (%s)":           "la suscripción termina (%s) antes de empezar (%s)",
+	"unexpected response creating subscription":                  "respuesta inesperada al crear la suscripción",
+	"Cancel subscription %s? No more invoices will be generated": "¿Cancelar la suscripción %s? No se generarán más facturas",
+	"Aborted":                                      "Cancelado",
+	"%sCancelling subscription: %s%s":              "%sCancelando suscripción: %s%s",
+	"%s✓ Subscription cancelled: %s%s":             "%s✓ Suscripción cancelada: %s%s",
+	"%sFetching subscriptions...%s":                "%sObteniendo suscripciones...%s",
+	"%sNo subscriptions found%s":                   "%sNo se encontraron suscripciones%s",
+	"%sSubscriptions (%d):%s":                      "%sSuscripciones (%d):%s",
+	"Since: %s | Status: %s%s%s | Period ends: %s": "Desde: %s | Estado: %s%s%s | Fin del periodo: %s",
+	"%sFetching subscription: %s%s":                "%sObteniendo suscripción: %s%s",
+	"subscription not found":                       "suscripción no encontrada",
+	"%sSubscription: %s%s":                         "%sSuscripción: %s%s",
+	"Start: %s":                                    "Inicio: %s",
+	"End: %s":                                      "Fin: %s",
+	"Current Period: %s to %s":                     "Periodo actual: %s a %s",
+	"Invoiced At: %s":                              "Facturado: %s",
+	"%sPlans:%s":                                   "%sPlanes:%s",
+	"%sInvoices:%s":                                "%sFacturas:%s",
+	"%s%s due%s":                                   "%s%s pendiente%s",
+	"%sFetching subscription plans...%s":           "%sObteniendo planes de suscripción...%s",
+	"%sNo subscription plans found%s":              "%sNo se encontraron planes de suscripción%s",
+	"%sSubscription Plans (%d):%s":                 "%sPlanes de suscripción (%d):%s",
+	"• %s%s%s  %s  %s per %s":                      "• %s%s%s  %s  %s por %s",
+
+	// Suppliers
+	"Usage: erp-cli supplier <subcommand> [args...]":                                   "Uso: erp-cli supplier <subcomando> [argumentos...]",
+	"Subcommands: list, get, create, set, add-address, add-contact, addresses, delete": "Subcomandos: list, get, create, set, add-address, add-contact, addresses, delete",
+	"usage: erp-cli supplier get <name>":                                               "uso: erp-cli supplier get <nombre>",
+	"usage: erp-cli supplier create <name> [--group=X] [--country=X]":                  "uso: erp-cli supplier create <nombre> [--group=X] [--country=X]",
+	"usage: erp-cli supplier set <name> <property=value> [property=value...]":          "uso: erp-cli supplier set <nombre> <propiedad=valor> [propiedad=valor...]",
+	"usage: erp-cli supplier add-address <name> --line1=X --city=X --country=X [--type=Billing|Shipping] [--line2=X] [--state=X] [--zip=X] [--email=X] [--phone=X] [--primary] [--shipping]": "uso: erp-cli supplier add-address <nombre> --line1=X --city=X --country=X [--type=Billing|Shipping] [--line2=X] [--state=X] [--zip=X] [--email=X] [--phone=X] [--primary] [--shipping]",
+	"usage: erp-cli supplier add-contact <name> <first_name> [--last=X] [--email=X] [--phone=X] [--mobile=X] [--primary]":                                                                    "uso: erp-cli supplier add-contact <nombre> <nombre_pila> [--last=X] [--email=X] [--phone=X] [--mobile=X] [--primary]",
+	"usage: erp-cli supplier addresses <name>": "uso: erp-cli supplier addresses <nombre>",
+	"usage: erp-cli supplier delete <name>":    "uso: erp-cli supplier delete <nombre>",
+	"unknown supplier subcommand: %s":          "subcomando de supplier desconocido: %s",
+	"%sFetching suppliers...%s":                "%sObteniendo proveedores...%s",
+	"%sNo suppliers found%s":                   "%sNo se encontraron proveedores%s",
+	"%sSuppliers (%d):%s":                      "%sProveedores (%d):%s",
+	"%sFetching supplier: %s%s":                "%sObteniendo proveedor: %s%s",
+	"%sCreating supplier: %s%s":                "%sCreando proveedor: %s%s",
+	"Country: %s":                              "País: %s",
+	"%s✓ Supplier created: %s%s":               "%s✓ Proveedor creado: %s%s",
+	"%sDeleting supplier: %s%s":                "%sBorrando proveedor: %s%s",
+	"%s✓ Supplier deleted: %s%s":               "%s✓ Proveedor borrado: %s%s",
+
+	// Change sync and offline queue commands
+	"invalid --since %q (use YYYY-MM-DD, \"YYYY-MM-DD HH:MM:SS\" or a cursor)": "--since no válido %q (usa AAAA-MM-DD, \"AAAA-MM-DD HH:MM:SS\" o un cursor)",
+	"invalid --limit: %s":                                                           "--limit no válido: %s",
+	"--doctype is required":                                                         "--doctype es obligatorio",
+	"invalid --format %q (use jsonl or csv)":                                        "--format no válido %q (usa jsonl o csv)",
+	"Usage: erp-cli sync <subcommand> [args]":                                       "Uso: erp-cli sync <subcomando> [argumentos]",
+	"Documents created or modified since the last sync":                             "Documentos creados o modificados desde la última sincronización",
+	"cursors              Saved cursors":                                            "cursors              Cursores guardados",
+	"reset <doctype|name> Forget a cursor (the next sync starts from scratch)":      "reset <doctype|name> Olvida un cursor (la siguiente sincronización empieza de cero)",
+	"Run the commands queued with --offline":                                        "Ejecuta los comandos encolados con --offline",
+	"queue                Commands queued with --offline":                           "queue                Comandos encolados con --offline",
+	"drop <id>            Remove a queued command without running it":               "drop <id>            Quita un comando encolado sin ejecutarlo",
+	"Each document is written with _change set to created or modified. The cursor":  "Cada documento se escribe con _change a created o modified. El cursor",
+	"after the last document is saved per site and doctype (or --cursor name) once": "tras el último documento se guarda por sitio y doctype (o con el nombre de --cursor) una vez",
+
+	"the output is written, so the next run picks up from there. -o appends JSONL": "se escribe la salida, para que la siguiente ejecución continúe desde ahí. -o añade al JSONL",
+	"(a CSV is replaced). --limit syncs in batches; --full includes child tables.": "(un CSV se sustituye). --limit sincroniza por lotes; --full incluye las tablas hijas.",
+	"usage: erp-cli sync reset <doctype|name>":                                     "uso: erp-cli sync reset <doctype|nombre>",
+	"usage: erp-cli sync drop <id>":                                                "uso: erp-cli sync drop <id>",
+	"unknown sync subcommand: %s":                                                  "subcomando de sync desconocido: %s",
+	"%sSyncing all %s documents (no cursor yet)...%s":                              "%sSincronizando todos los documentos %s (todavía sin cursor)...%s",
+	"%sSyncing %s changes since %s...%s":                                           "%sSincronizando cambios de %s desde %s...%s",
+	"%sNo changes%s":                                                               "%sSin cambios%s",
+	"%s %s: %w (nothing saved; rerun to resume)":                                   "%s %s: %w (no se guardó nada; vuelve a ejecutar para continuar)",
+	"failed to open %s: %w":                                                        "no se pudo abrir %s: %w",
+	"changes written but cursor not saved: %w":                                     "cambios escritos pero cursor no guardado: %w",
+	"(limit reached, run again for more)":                                          "(límite alcanzado, vuelve a ejecutar para obtener más)",
+	"%s✓ %d change(s), cursor: %s%s%s":                                             "%s✓ %d cambio(s), cursor: %s%s%s",
+	"%sNo sync cursors yet%s":                                                      "%sTodavía no hay cursores de sincronización%s",
+	"NAME":                                                                         "NOMBRE",
+	"SYNCED":                                                                       "SINCRONIZADO",
+	"no sync cursor named %s (see 'erp-cli sync cursors')":                         "no hay ningún cursor de sincronización llamado %s (ver 'erp-cli sync cursors')",
+	"%s✓ Cursor reset: %s%s":                                                       "%s✓ Cursor reiniciado: %s%s",
+
+	// Document templates
+	"template not found: %s (bundled: %s)": "plantilla no encontrada: %s (incluidas: %s)",
+	"template %s: %w":                      "plantilla %s: %w",
+
+	// Terms and letter heads
+	"letter head not found: %s":              "membrete no encontrado: %s",
+	"letter head %s is disabled":             "el membrete %s está desactivado",
+	"terms and conditions not found: %s":     "términos y condiciones no encontrados: %s",
+	"terms and conditions %s are disabled":   "los términos y condiciones %s están desactivados",
+	"terms and conditions %s are not for %s": "los términos y condiciones %s no son para %s",
+	"%s⚠ Remarks not saved: %s%s":            "%s⚠ Observaciones no guardadas: %s%s",
+	"Terms: %s":                              "Términos: %s",
+	"Letter Head: %s":                        "Membrete: %s",
+	"Terms:":                                 "Términos:",
+
+	// Themes
+	"unknown theme %q (use default, light, high-contrast or a theme file)": "tema desconocido %q (usa default, light, high-contrast o un archivo de tema)",
+	"%s:%d: expected key=value":    "%s:%d: se espera clave=valor",
+	"%s:%d: unknown base theme %q": "%s:%d: tema base desconocido %q",
+	"%s:%d: unknown border %q (use rounded, normal, thick, double or ascii)": "%s:%d: borde desconocido %q (usa rounded, normal, thick, double o ascii)",
+	"%s:%d: unknown key %q": "%s:%d: clave desconocida %q",
+
+	// Timesheets
+	"Usage: erp-cli timesheet <subcommand> [args...]":                                              "Uso: erp-cli timesheet <subcomando> [argumentos...]",
+	"Subcommands: add, week, list, get, submit":                                                    "Subcomandos: add, week, list, get, submit",
+	"Time is logged against the employee linked to your API user.":                                 "Las horas se imputan al empleado vinculado a tu usuario de API.",
+	"usage: erp-cli timesheet add <project> <hours> [--activity=X] [--date=YYYY-MM-DD] [--desc=X]": "uso: erp-cli timesheet add <proyecto> <horas> [--activity=X] [--date=AAAA-MM-DD] [--desc=X]",
+	"invalid hours: %s":                                     "horas no válidas: %s",
+	"usage: erp-cli timesheet get <name>":                   "uso: erp-cli timesheet get <nombre>",
+	"unknown timesheet subcommand: %s":                      "subcomando de timesheet desconocido: %s",
+	"%sLogging %.2fh on %s for %s (%s)...%s":                "%sImputando %.2fh en %s para %s (%s)...%s",
+	"%s✓ Timesheet created: %s%s":                           "%s✓ Parte de horas creado: %s%s",
+	"%s✓ Added to timesheet: %s%s":                          "%s✓ Añadido al parte de horas: %s%s",
+	"(no project)":                                          "(sin proyecto)",
+	"%sFetching timesheets...%s":                            "%sObteniendo partes de horas...%s",
+	"%sWeek of %s - %s%s":                                   "%sSemana del %s - %s%s",
+	"%sNo time logged this week%s":                          "%sNo hay horas imputadas esta semana%s",
+	"Draft: %s%s%s (submit with: erp-cli timesheet submit)": "Borrador: %s%s%s (valídalo con: erp-cli timesheet submit)",
+	"Submitted: %s%s%s":                                     "Validado: %s%s%s",
+	"%sNo timesheets found%s":                               "%sNo se encontraron partes de horas%s",
+	"%sTimesheets (%d):%s":                                  "%sPartes de horas (%d):%s",
+	"%s - %s to %s | %.2fh | %s%s%s":                        "%s - %s a %s | %.2fh | %s%s%s",
+	"%sFetching timesheet: %s%s":                            "%sObteniendo parte de horas: %s%s",
+	"%sTimesheet: %s%s":                                     "%sParte de horas: %s%s",
+	"Total hours: %.2f":                                     "Horas totales: %.2f",
+	"%sTime Logs:%s":                                        "%sRegistros de tiempo:%s",
+	"%sNo draft timesheet for the week of %s%s":             "%sNo hay parte de horas en borrador para la semana del %s%s",
+	"%sSubmitting timesheet: %s%s":                          "%sValidando parte de horas: %s%s",
+	"%s✓ Timesheet submitted: %s%s":                         "%s✓ Parte de horas validado: %s%s",
+
+	// TUI
+	"Ignored key bindings:":          "Atajos de teclado ignorados:",
+	"Group (%d)":                     "Grupo (%d)",
+	"no data found":                  "no se encontraron datos",
+	"Deleted: %s":                    "Borrado: %s",
+	"• %s: undo %s":                  "• %s: deshacer %s",
+	"Created by %s in %s • v%s":      "Creado por %s en %s • v%s",
+	"Attribute:":                     "Atributo:",
+	"Name: %v":                       "Nombre: %v",
+	"Type: Numeric":                  "Tipo: Numérico",
+	"Range: %v - %v (step: %v)":      "Rango: %v - %v (paso: %v)",
+	"Type: List/Text":                "Tipo: Lista/Texto",
+	"Values (%d):":                   "Valores (%d):",
+	"Item:":                          "Artículo:",
+	"Code":                           "Código",
+	"Name":                           "Nombre",
+	"Group":                          "Grupo",
+	"UoM":                            "UdM",
+	"Type: Template (with variants)": "Tipo: Plantilla (con variantes)",
+	"Attributes (%d):":               "Atributos (%d):",
+	"Delete \"%s\"?\n\n  This action cannot be undone.\n\n  [y] Yes, delete    [n] No, cancel": "¿Borrar \"%s\"?\n\n  Esta acción no se puede deshacer.\n\n  [y] Sí, borrar    [n] No, cancelar",
+
+	// TUI batch actions
+	"None of the %d selected can be %s (%s)":    "Los %d seleccionados no pueden ser %s (%s)",
+	"%s %d %s documents?":                       "¿%s %d documentos %s?",
+	"(%d selected skipped: %s)":                 "(%d seleccionados omitidos: %s)",
+	"%s %d of %d %s documents":                  "%s %d de %d documentos %s",
+	"batch %s of %d documents cannot be undone": "la acción %s por lotes sobre %d documentos no se puede deshacer",
+	"Submit":                    "Validar",
+	"Cancel":                    "Cancelar",
+	"Delete":                    "Borrar",
+	"Submitting":                "Validando",
+	"Cancelling":                "Cancelando",
+	"Deleting":                  "Borrando",
+	"Submitted":                 "Validados",
+	"Cancelled":                 "Cancelados",
+	"Deleted":                   "Borrados",
+	"not drafts":                "no son borradores",
+	"not submitted":             "no están validados",
+	"%d failed: %s":             "%d fallidos: %s",
+	"Nothing to export":         "Nada que exportar",
+	"failed to create file: %s": "no se pudo crear el archivo: %s",
+	"failed to write %s: %s":    "no se pudo escribir %s: %s",
+	"Exported %d rows to %s":    "%d filas exportadas a %s",
+	"• %d failed":               "• %d fallidos",
+	"%d selected":               "%d seleccionados",
+
+	// TUI boards and dashboard
+	"No documents":       "Sin documentos",
+	"↑ %d more":          "↑ %d más",
+	"↓ %d more":          "↓ %d más",
+	"↑↓ scroll • %.0f%%": "↑↓ desplazar • %.0f%%",
+
+	// TUI document view
+	"Attachments (%d):":               "Adjuntos (%d):",
+	"None - press u to upload a file": "Ninguno - pulsa u para subir un archivo",
+	"private":                         "privado",
+	"Assigned to:":                    "Asignado a:",
+	"Comments (%d):":                  "Comentarios (%d):",
+	"%d older comments not shown":     "%d comentarios anteriores sin mostrar",
+	"Path of the file to attach":      "Ruta del archivo a adjuntar",
+	"Attach to %s %s":                 "Adjuntar a %s %s",
+	"File:":                           "Archivo:",
+	"Files are uploaded as private attachments": "Los archivos se suben como adjuntos privados",
+	"File is required":                          "El archivo es obligatorio",
+	"Attached %s":                               "Adjuntado %s",
+	"History: %s %s":                            "Historial: %s %s",
+	"No changes recorded (Track Changes may be off for this doctype)": "No hay cambios registrados (puede que Track Changes esté desactivado en este doctype)",
+	"Latest %d changes - see erp-cli history for more":                "Últimos %d cambios - ver erp-cli history para más",
+	"%s Loading history...":                                           "%s Cargando historial...",
+	"Opened %s":                                                       "Abierto %s",
+	"Saved %s":                                                        "Guardado %s",
+	"Saved %s. Open it?":                                              "Guardado %s. ¿Abrirlo?",
+
+	// TUI form drafts
+	"Resume draft?":                           "¿Recuperar borrador?",
+	"This form has a draft from %s:":          "Este formulario tiene un borrador del %s:",
+	"[y] Resume the draft    [n] Start empty": "[y] Recuperar el borrador    [n] Empezar vacío",
+
+	// TUI filters
+	"invalid amount %q": "importe no válido %q",
+	"no doctype":        "sin doctype",
+	"invalid filter %q (use status, party, from, to, min or max)": "filtro no válido %q (usa status, party, from, to, min o max)",
+	"e.g. Draft, Unpaid, Overdue":                                 "p. ej. Draft, Unpaid, Overdue",
+	"Name or part of it":                                          "Nombre o parte de él",
+
+	"YYYY-MM-DD":                            "AAAA-MM-DD",
+	"Minimum total":                         "Total mínimo",
+	"Maximum total":                         "Total máximo",
+	"Name to save the filter as (optional)": "Nombre con el que guardar el filtro (opcional)",
+	"Filter names cannot contain spaces, = or quotes":    "Los nombres de filtro no pueden contener espacios, = ni comillas",
+	"Fill at least one field to save a filter":           "Rellena al menos un campo para guardar un filtro",
+	"Filter applied but not saved: %s":                   "Filtro aplicado pero no guardado: %s",
+	"Filter saved as %s":                                 "Filtro guardado como %s",
+	"No saved filters for %s (save one from the f form)": "No hay filtros guardados para %s (guarda uno desde el formulario f)",
+	"Filter %s":                             "Filtrar %s",
+	"Status:":                               "Estado:",
+	"Party:":                                "Tercero:",
+	"From date:":                            "Desde fecha:",
+	"To date:":                              "Hasta fecha:",
+	"Min total:":                            "Total mín:",
+	"Max total:":                            "Total máx:",
+	"Save as:":                              "Guardar como:",
+	"Saved: %s (F in the list cycles them)": "Guardado: %s (F en la lista los recorre)",
+	"Empty fields are ignored":              "Los campos vacíos se ignoran",
+
+	// TUI forms
+	"YYYY-MM-DD (default today)": "AAAA-MM-DD (por defecto hoy)",
+	"Date:\n  %s":                "Fecha:\n  %s",
+	"(remarks not saved: %s)":    "(observaciones no guardadas: %s)",
+	"%s\n\n  This action may be irreversible.\n\n  [y] Yes, proceed    [n] No, cancel": "%s\n\n  Esta acción puede ser irreversible.\n\n  [y] Sí, continuar    [n] No, cancelar",
+	"%d items":   "%d artículos",
+	"%d draft":   "%d en borrador",
+	"%d unpaid":  "%d sin pagar",
+	"%d pending": "%d pendientes",
+
+	// TUI HR
+	"Employee:":                         "Empleado:",
+	"Name: %s":                          "Nombre: %s",
+	"%s | %s | Approval: %s | %s":       "%s | %s | Aprobación: %s | %s",
+	"Expense Claim:":                    "Nota de gastos:",
+	"Expenses:":                         "Gastos:",
+	"Expense Claim Type (e.g., Travel)": "Tipo de gasto (p. ej., Travel)",
+	"Description (optional)":            "Descripción (opcional)",
+	"Create Expense Claim":              "Crear nota de gastos",
+	"Type:":                             "Tipo:",
+	"Amount:":                           "Importe:",
+	"Description:":                      "Descripción:",
+	"Filed for the employee linked to your user; attach receipts with 'u'": "Se presenta a nombre del empleado vinculado a tu usuario; adjunta justificantes con 'u'",
+	"Expense claim type is required":                                       "El tipo de gasto es obligatorio",
+	"Invalid amount":                                                       "Importe no válido",
+	"Expense claim created: %s":                                            "Nota de gastos creada: %s",
+	"Expense claim submitted: %s":                                          "Nota de gastos validada: %s",
+	"Submit Expense Claim %s?":                                             "¿Validar la nota de gastos %s?",
+
+	// TUI inbox
+	"invalid note: %s":              "nota no válida: %s",
+	"Deleted note #%d":              "Nota #%d borrada",
+	"invalid quantity":              "cantidad no válida",
+	"%s (note #%d not removed: %s)": "%s (nota #%d no quitada: %s)",
+	"%s (note #%d done)":            "%s (nota #%d hecha)",
+
+	// TUI inventory forms
+	"Item Code *":                             "Código de artículo *",
+	"Item Name *":                             "Nombre de artículo *",
+	"Item Group *":                            "Grupo de artículos *",
+	"Stock UOM":                               "UdM de stock",
+	"Create Item":                             "Crear artículo",
+	"Item Code: *":                            "Código de artículo: *",
+	"Item Name: *":                            "Nombre de artículo: *",
+	"Item Group: *":                           "Grupo de artículos: *",
+	"Stock UOM:":                              "UdM de stock:",
+	"* Required field":                        "* Campo obligatorio",
+	"defaults come from .erp-config":          "los valores por defecto vienen de .erp-config",
+	"Item code, name and group are required":  "El código, el nombre y el grupo del artículo son obligatorios",
+	"Item created: %s":                        "Artículo creado: %s",
+	"Failed to create item":                   "No se pudo crear el artículo",
+	"Group Name *":                            "Nombre del grupo *",
+	"Parent Group (optional)":                 "Grupo padre (opcional)",
+	"Create Item Group":                       "Crear grupo de artículos",
+	"Group Name: *":                           "Nombre del grupo: *",
+	"Parent Group:":                           "Grupo padre:",
+	"Group name is required":                  "El nombre del grupo es obligatorio",
+	"Group created: %s":                       "Grupo creado: %s",
+	"Failed to create group":                  "No se pudo crear el grupo",
+	"Brand Name *":                            "Nombre de la marca *",
+	"Create Brand":                            "Crear marca",
+	"Brand Name: *":                           "Nombre de la marca: *",
+	"Brand name is required":                  "El nombre de la marca es obligatorio",
+	"Brand created: %s":                       "Marca creada: %s",
+	"Failed to create brand":                  "No se pudo crear la marca",
+	"Warehouse Name *":                        "Nombre del almacén *",
+	"Parent Warehouse (optional)":             "Almacén padre (opcional)",
+	"Create Warehouse":                        "Crear almacén",
+	"Warehouse Name: *":                       "Nombre del almacén: *",
+	"Parent Warehouse:":                       "Almacén padre:",
+	"Warehouse name is required":              "El nombre del almacén es obligatorio",
+	"Warehouse created: %s":                   "Almacén creado: %s",
+	"Failed to create warehouse":              "No se pudo crear el almacén",
+	"value *":                                 "valor *",
+	"Create Variant from:":                    "Crear variante de:",
+	"No attributes defined for this template": "Esta plantilla no tiene atributos definidos",
+	"* Required fields - Enter values for each attribute": "* Campos obligatorios - Introduce valores para cada atributo",
+	"Template has no attributes defined":                  "La plantilla no tiene atributos definidos",
+	"Value for %s is required":                            "El valor de %s es obligatorio",
+	"Variant created: %s":                                 "Variante creada: %s",
+	"Failed to create variant":                            "No se pudo crear la variante",
+	"Pick at least one value of each attribute":           "Elige al menos un valor de cada atributo",
+	"All picked variants already exist":                   "Todas las variantes elegidas ya existen",
+	"%d variants picked (max %d)":                         "%d variantes elegidas (máx. %d)",
+	"Create %d variants of %s?":                           "¿Crear %d variantes de %s?",
+	"%s Loading attributes...":                            "%s Cargando atributos...",
+	"Generate Variants:":                                  "Generar variantes:",
+	"New variants: %s":                                    "Variantes nuevas: %s",
+	"(%d already exist)":                                  "(%d ya existen)",
+	"Created %d variants, %d failed (%s)":                 "%d variantes creadas, %d fallidas (%s)",
+	"Created %d variants of %s":                           "%d variantes de %s creadas",
+	"No variants yet • g: generate variants":              "Todavía no hay variantes • g: generar variantes",
+	"Variants (%d):":                                      "Variantes (%d):",
+	"Attribute Name *":                                    "Nombre del atributo *",
+	"Values (comma separated) *":                          "Valores (separados por comas) *",
+	"From Range *":                                        "Desde *",
+	"To Range *":                                          "Hasta *",
+	"Increment *":                                         "Incremento *",
+	"Create Text Attribute":                               "Crear atributo de texto",
+	"Attribute Name: *":                                   "Nombre del atributo: *",
+	"Values (comma separated): *":                         "Valores (separados por comas): *",
+	"Red, Blue, Green":                                    "Rojo, Azul, Verde",
+	"Create Numeric Attribute":                            "Crear atributo numérico",
+	"From Range: *":                                       "Desde: *",
+	"To Range: *":                                         "Hasta: *",
+	"Increment: *":                                        "Incremento: *",
+	"From 1, To 10, Increment 1":                          "Desde 1, hasta 10, incremento 1",
+	"Create Select Attribute":                             "Crear atributo de selección",
+	"Small, Medium, Large":                                "Pequeño, Mediano, Grande",
+	"* Required fields":                                   "* Campos obligatorios",
+	"Attribute name is required":                          "El nombre del atributo es obligatorio",
+	"Values are required":                                 "Los valores son obligatorios",
+	"At least one value is required":                      "Se necesita al menos un valor",
+	"All numeric fields are required":                     "Todos los campos numéricos son obligatorios",
+	"Invalid from range value":                            "Valor inicial no válido",
+	"Invalid to range value":                              "Valor final no válido",
+	"Invalid increment value":                             "Incremento no válido",
+	"Attribute created: %s":                               "Atributo creado: %s",
+	"Failed to create attribute":                          "No se pudo crear el atributo",
+	"Create Attribute":                                    "Crear atributo",
+	"Edit Attribute:":                                     "Editar atributo:",
+	"narrowing the range does not remove them":            "estrechar el rango no los quita",
+	"Add a value to create it, remove one to delete it (not if variants use it)": "Añade un valor para crearlo, quita uno para borrarlo (no si lo usan variantes)",
+	"Invalid %s value":                               "Valor de %s no válido",
+	"The range must go up, in steps above 0":         "El rango debe ser creciente, con pasos mayores que 0",
+	"Attribute %s: range updated":                    "Atributo %s: rango actualizado",
+	"Attribute %s: %d values (%d added, %d removed)": "Atributo %s: %d valores (%d añadidos, %d quitados)",
+
+	"Purchase Order Name":           "Nombre del pedido de compra",
+	"Create Invoice from PO":        "Crear factura desde pedido de compra",
+	"Purchase Order:":               "Pedido de compra:",
+	"Supplier: %v":                  "Proveedor: %v",
+	"Press Enter to create invoice": "Pulsa Intro para crear la factura",
+
+	// TUI key bindings
+	"key.%s: unknown action":       "key.%s: acción desconocida",
+	"key.%s: unknown view %q":      "key.%s: vista desconocida %q",
+	"key.%s: no key":               "key.%s: sin tecla",
+	"key.%s: %s cannot be rebound": "key.%s: %s no se puede reasignar",
+
+	// TUI line editor
+	"Only draft documents can be edited":     "Solo se pueden editar documentos en borrador",
+	"No lines to edit":                       "No hay líneas que editar",
+	"A document needs at least one line":     "Un documento necesita al menos una línea",
+	"Nothing changed":                        "No ha cambiado nada",
+	"Quantity":                               "Cantidad",
+	"Rate":                                   "Precio",
+	"Lines saved: %s":                        "Líneas guardadas: %s",
+	"Edit Lines: %s":                         "Editar líneas: %s",
+	"Total: %s (before taxes)":               "Total: %s (sin impuestos)",
+	"%d changed, %d removed • not saved yet": "%d cambiadas, %d quitadas • aún sin guardar",
+	"Line":      "Línea",
+	"Quantity:": "Cantidad:",
+	"Rate:":     "Precio:",
+	"Date:":     "Fecha:",
+
+	// TUI lists
+	"Page %d":       "Página %d",
+	"search: %q":    "búsqueda: %q",
+	"clear":         "limpiar",
+	"previous page": "página anterior",
+	"next page":     "página siguiente",
+	"Search:":       "Buscar:",
+	"name or party": "nombre o tercero",
+
+	// TUI manufacturing
+	"Work Order:":   "Orden de fabricación:",
+	"Item: %v (%v)": "Artículo: %v (%v)",
+	"BOM: %v":       "Lista de materiales: %v",
+	"Qty: %g | Transferred: %g | Produced: %g":       "Cant: %g | Transferida: %g | Producida: %g",
+	"WIP Warehouse: %s":                              "Almacén de trabajo en curso: %s",
+	"Target Warehouse: %s":                           "Almacén de destino: %s",
+	"Required Items:":                                "Artículos necesarios:",
+	"- %v: %g required, %g transferred, %g consumed": "- %v: %g necesarios, %g transferidos, %g consumidos",
+	"Item to manufacture":                            "Artículo a fabricar",
+	"BOM (leave empty for the default BOM)":          "Lista de materiales (vacío para la predeterminada)",
+	"Create Work Order":                              "Crear orden de fabricación",
+	"BOM (optional):":                                "Lista de materiales (opcional):",
+	"Warehouses come from Manufacturing Settings":    "Los almacenes vienen de la configuración de fabricación",
+	"Item is required":                               "El artículo es obligatorio",
+	"Invalid quantity":                               "Cantidad no válida",
+	"Work Order created: %s":                         "Orden de fabricación creada: %s",
+	"BOM:":                                           "Lista de materiales:",
+	"Quantity: %g":                                   "Cantidad: %g",
+	"Components:":                                    "Componentes:",
+	"BOM submitted: %s":                              "Lista de materiales validada: %s",
+	"Transfer materials for Work Order %s?":          "¿Transferir materiales para la orden de fabricación %s?",
+	"Finish Work Order %s (manufacture the pending quantity)?": "¿Terminar la orden de fabricación %s (fabricar la cantidad pendiente)?",
+	"Submit BOM %s?": "¿Validar la lista de materiales %s?",
+
+	// TUI notifications
+	"Notifications (%d)": "Notificaciones (%d)",
+	"created, submitted, cancelled and deleted documents show up here": "aquí aparecen los documentos creados, validados, cancelados y borrados",
+	"… %d older": "… %d más antiguas",
+
+	// TUI command palette
+	"View, create action or document name": "Vista, acción de crear o nombre de documento",
+	"Go to":                                "Ir a",
+	"Copy field":                           "Copiar campo",
+	"No matches":                           "Sin coincidencias",
+	"… %d more":                            "… %d más",
+	"Loading recent documents...":          "Cargando documentos recientes...",
+
+	// TUI purchasing
+	"Supplier:":                              "Proveedor:",
+	"DISABLED":                               "DESACTIVADO",
+	"Supplier Name":                          "Nombre del proveedor",
+	"Supplier Group (optional)":              "Grupo de proveedores (opcional)",
+	"Country (optional)":                     "País (opcional)",
+	"Create Supplier":                        "Crear proveedor",
+	"Name:":                                  "Nombre:",
+	"Group:":                                 "Grupo:",
+	"Country:":                               "País:",
+	"Supplier name is required":              "El nombre del proveedor es obligatorio",
+	"Supplier created: %s":                   "Proveedor creado: %s",
+	"Failed to create supplier":              "No se pudo crear el proveedor",
+	"Date: %v":                               "Fecha: %v",
+	"Items:":                                 "Artículos:",
+	"Item Code (optional)":                   "Código de artículo (opcional)",
+	"Quantity (default 1)":                   "Cantidad (por defecto 1)",
+	"Create Purchase Order":                  "Crear pedido de compra",
+	"After creation, add items with 'a' key": "Tras crearlo, añade artículos con la tecla 'a'",
+	"Supplier is required":                   "El proveedor es obligatorio",
+	"PO created: %s":                         "Pedido de compra creado: %s",
+	"Failed to create PO":                    "No se pudo crear el pedido de compra",
+	"Item Code":                              "Código de artículo",
+	"Rate (optional)":                        "Precio (opcional)",
+	"Add Item to PO:":                        "Añadir artículo al pedido de compra:",
+	"Item Code:":                             "Código de artículo:",
+	"Item code and quantity are required":    "El código de artículo y la cantidad son obligatorios",
+	"PO not found":                           "Pedido de compra no encontrado",
+	"Cannot add items to submitted/cancelled PO":     "No se pueden añadir artículos a un pedido de compra validado/cancelado",
+	"Item added to PO: %s":                           "Artículo añadido al pedido de compra: %s",
+	"PO submitted: %s":                               "Pedido de compra validado: %s",
+	"PO cancelled: %s":                               "Pedido de compra cancelado: %s",
+	"Purchase Invoice:":                              "Factura de compra:",
+	"Outstanding: %s":                                "Pendiente: %s",
+	"Purchase Order Name (e.g., PUR-ORD-2025-00001)": "Nombre del pedido de compra (p. ej., PUR-ORD-2025-00001)",
+	"Enter the name of a submitted Purchase Order":   "Introduce el nombre de un pedido de compra validado",
+	"Purchase Order name is required":                "El nombre del pedido de compra es obligatorio",
+	"Invoice created: %s":                            "Factura creada: %s",
+	"Failed to create invoice":                       "No se pudo crear la factura",
+	"Invoice submitted: %s":                          "Factura validada: %s",
+	"Invoice cancelled: %s":                          "Factura cancelada: %s",
+	"Purchase Receipt:":                              "Albarán de compra:",
+	"Create Purchase Receipt from PO":                "Crear albarán de compra desde pedido de compra",
+	"Purchase Receipt created: %s":                   "Albarán de compra creado: %s",
+	"Failed to create purchase receipt":              "No se pudo crear el albarán de compra",
+	"Purchase Receipt submitted: %s":                 "Albarán de compra validado: %s",
+	"Purchase Receipt cancelled: %s":                 "Albarán de compra cancelado: %s",
+	"Submit PO %s?":                                  "¿Validar el pedido de compra %s?",
+	"Cancel PO %s?":                                  "¿Cancelar el pedido de compra %s?",
+	"Submit Purchase Receipt %s?":                    "¿Validar el albarán de compra %s?",
+	"Cancel Purchase Receipt %s?":                    "¿Cancelar el albarán de compra %s?",
+	"Submit Invoice %s?":                             "¿Validar la factura %s?",
+	"Cancel Invoice %s?":                             "¿Cancelar la factura %s?",
+
+	// TUI sales
+	"Customer Name":              "Nombre del cliente",
+	"Customer Group (optional)":  "Grupo de clientes (opcional)",
+	"Territory (optional)":       "Territorio (opcional)",
+	"Create Customer":            "Crear cliente",
+	"Territory:":                 "Territorio:",
+	"Customer name is required":  "El nombre del cliente es obligatorio",
+	"Customer created: %s":       "Cliente creado: %s",
+	"Failed to create customer":  "No se pudo crear el cliente",
+	"Quotation:":                 "Presupuesto:",
+	"Customer: %v":               "Cliente: %v",
+	"Valid Till: %v":             "Válido hasta: %v",
+	"Create Quotation":           "Crear presupuesto",
+	"Customer is required":       "El cliente es obligatorio",
+	"Quotation created: %s":      "Presupuesto creado: %s",
+	"Failed to create quotation": "No se pudo crear el presupuesto",
+	"Add Item to Quotation:":     "Añadir artículo al presupuesto:",
+	"Quotation not found":        "Presupuesto no encontrado",
+	"Cannot add items to submitted/cancelled quotation": "No se pueden añadir artículos a un presupuesto validado/cancelado",
+	"Item added to Quotation: %s":                       "Artículo añadido al presupuesto: %s",
+	"Quotation submitted: %s":                           "Presupuesto validado: %s",
+	"Quotation cancelled: %s":                           "Presupuesto cancelado: %s",
+	"Sales Order:":                                      "Pedido de venta:",
+	"Delivery Date: %v":                                 "Fecha de entrega: %v",
+	"Sales Team:":                                       "Equipo de ventas:",
+	"Sales Person (optional, Name[:pct])":               "Comercial (opcional, Nombre[:pct])",
+	"Create Sales Order":                                "Crear pedido de venta",
+	"Sales Person:":                                     "Comercial:",
+
+	"SO created: %s":                                         "Pedido de venta creado: %s",
+	"Failed to create SO":                                    "No se pudo crear el pedido de venta",
+	"Quotation Name (e.g., QTN-00001)":                       "Nombre del presupuesto (p. ej., QTN-00001)",
+	"Create SO from Quotation":                               "Crear pedido de venta desde presupuesto",
+	"Enter the name of a submitted Quotation":                "Introduce el nombre de un presupuesto validado",
+	"Quotation name is required":                             "El nombre del presupuesto es obligatorio",
+	"Quotation must be submitted first":                      "Primero hay que validar el presupuesto",
+	"No items found in quotation":                            "El presupuesto no tiene artículos",
+	"SO created: %s (from %s)":                               "Pedido de venta creado: %s (desde %s)",
+	"Add Item to SO:":                                        "Añadir artículo al pedido de venta:",
+	"SO not found":                                           "Pedido de venta no encontrado",
+	"Cannot add items to submitted/cancelled SO":             "No se pueden añadir artículos a un pedido de venta validado/cancelado",
+	"Item added to SO: %s":                                   "Artículo añadido al pedido de venta: %s",
+	"SO submitted: %s":                                       "Pedido de venta validado: %s",
+	"SO cancelled: %s":                                       "Pedido de venta cancelado: %s",
+	"Sales Invoice:":                                         "Factura de venta:",
+	"Sales Order Name (e.g., SAL-ORD-2025-00001)":            "Nombre del pedido de venta (p. ej., SAL-ORD-2025-00001)",
+	"Create Invoice from SO":                                 "Crear factura desde pedido de venta",
+	"Enter the name of a submitted Sales Order":              "Introduce el nombre de un pedido de venta validado",
+	"Sales Order name is required":                           "El nombre del pedido de venta es obligatorio",
+	"Delivery Note:":                                         "Albarán:",
+	"Create Delivery Note from SO":                           "Crear albarán desde pedido de venta",
+	"Delivery Note created: %s":                              "Albarán creado: %s",
+	"Failed to create delivery note":                         "No se pudo crear el albarán",
+	"Delivery Note submitted: %s":                            "Albarán validado: %s",
+	"Delivery Note cancelled: %s":                            "Albarán cancelado: %s",
+	"Payment Entry:":                                         "Entrada de pago:",
+	"Party Type: %v":                                         "Tipo de tercero: %v",
+	"Party: %v":                                              "Tercero: %v",
+	"Mode of Payment: %v":                                    "Forma de pago: %v",
+	"References:":                                            "Referencias:",
+	"Sales Invoice Name":                                     "Nombre de la factura de venta",
+	"Purchase Invoice Name":                                  "Nombre de la factura de compra",
+	"Amount (leave empty for full amount)":                   "Importe (vacío para el importe total)",
+	"Create Payment (Receive)":                               "Crear pago (cobro)",
+	"Create Payment (Pay)":                                   "Crear pago (pago)",
+	"Amount (optional):":                                     "Importe (opcional):",
+	"Leave amount empty to pay the full outstanding balance": "Deja el importe vacío para pagar todo el saldo pendiente",
+	"Invoice name is required":                               "El nombre de la factura es obligatorio",
+	"Payment created: %s":                                    "Pago creado: %s",
+	"Failed to create payment":                               "No se pudo crear el pago",
+	"Payment submitted: %s":                                  "Pago validado: %s",
+	"Payment cancelled: %s":                                  "Pago cancelado: %s",
+	"Submit Quotation %s?":                                   "¿Validar el presupuesto %s?",
+	"Cancel Quotation %s?":                                   "¿Cancelar el presupuesto %s?",
+	"Submit SO %s?":                                          "¿Validar el pedido de venta %s?",
+	"Cancel SO %s?":                                          "¿Cancelar el pedido de venta %s?",
+	"Submit Delivery Note %s?":                               "¿Validar el albarán %s?",
+	"Cancel Delivery Note %s?":                               "¿Cancelar el albarán %s?",
+	"Submit Payment %s?":                                     "¿Validar el pago %s?",
+	"Cancel Payment %s?":                                     "¿Cancelar el pago %s?",
+	"Addresses and contacts not loaded (low-bandwidth mode)": "Direcciones y contactos no cargados (modo de bajo ancho de banda)",
+	"No addresses":                                           "Sin direcciones",
+	"Addresses (%d):":                                        "Direcciones (%d):",
+	"Contacts (%d):":                                         "Contactos (%d):",
+
+	// TUI setup wizard
+	"https://your-erp.example.com":                       "https://tu-erp.example.com",
+	"API Key from User Settings > API Access":            "API Key de User Settings > API Access",
+	"API Secret (generated with the key)":                "API Secret (generado con la clave)",
+	"http://192.168.1.100:8000 (optional)":               "http://192.168.1.100:8000 (opcional)",
+	"Cookie value for reverse proxy (optional)":          "Valor de la cookie del proxy inverso (opcional)",
+	"auth_cookie (default)":                              "auth_cookie (por defecto)",
+	"invalid URL: %w":                                    "URL no válida: %w",
+	"authentication failed: invalid API key or secret":   "autenticación fallida: API key o secret no válidos",
+	"server error: HTTP %d":                              "error del servidor: HTTP %d",
+	"invalid response from server":                       "respuesta no válida del servidor",
+	"Welcome to ERPNext CLI":                             "Bienvenido a ERPNext CLI",
+	"[Enter] Continue    [Esc] Cancel":                   "[Enter] Continuar    [Esc] Cancelar",
+	"Setup (1/1)":                                        "Configuración (1/1)",
+	"ERPNext URL *":                                      "URL de ERPNext *",
+	"Example: https://erp.mycompany.com":                 "Ejemplo: https://erp.miempresa.com",
+	"API Key *":                                          "API Key *",
+	"Find in: User Settings > API Access":                "Está en: User Settings > API Access",
+	"API Secret *":                                       "API Secret *",
+	"Generated with API Key":                             "Se genera con la API Key",
+	"VPN/Direct URL":                                     "URL VPN/directa",
+	"(optional)":                                         "(opcional)",
+	"Direct connection tried first":                      "Se prueba primero la conexión directa",
+	"Nginx Cookie":                                       "Cookie de Nginx",
+	"For reverse proxy authentication":                   "Para la autenticación del proxy inverso",
+	"Cookie Name":                                        "Nombre de la cookie",
+	"Default: auth_cookie":                               "Por defecto: auth_cookie",
+	"[Tab] Next field    [Enter] Submit    [Esc] Cancel": "[Tab] Siguiente campo    [Enter] Enviar    [Esc] Cancelar",
+	"Validating":                                         "Validando",
+	"Testing connection to ERPNext...":                   "Probando la conexión con ERPNext...",
+	"URL: %s":                                            "URL: %s",
+	"API Key: %s...":                                     "API Key: %s...",
+	"Setup Complete!":                                    "¡Configuración completada!",
+	"Configuration saved to:":                            "Configuración guardada en:",
+	"Connected as: %s":                                   "Conectado como: %s",
+	"Mode:":                                              "Modo:",
+	"VPN direct":                                         "VPN directa",
+	"Internet":                                           "Internet",
+	"[Enter] Start ERPNext CLI":                          "[Enter] Iniciar ERPNext CLI",
+	"Connection Failed":                                  "Conexión fallida",
+	"Error: %s":                                          "Error: %s",
+	"Please check:":                                      "Comprueba que:",
+	"* URL is correct and reachable":                     "* La URL es correcta y accesible",
+	"* API Key and Secret are valid":                     "* La API Key y el Secret son válidos",
+	"* Your ERPNext instance is running":                 "* Tu instancia de ERPNext está en marcha",
+	"[Enter] Try again    [Esc] Cancel":                  "[Enter] Reintentar    [Esc] Cancelar",
+
+	// TUI sharing
+	"(through the terminal)": "(a través del terminal)",
+	"Copied %s %s%s":         "Copiado %s %s%s",
+	"Field name or value":    "Nombre o valor del campo",
+
+	// TUI split view
+	"No document":      "Ningún documento",
+	"%s Loading %s...": "%s Cargando %s...",
+	"open for actions": "abrir para acciones",
+
+	// TUI stock
+	"Stock:":                        "Stock:",
+	"No stock found for this item":  "No hay stock de este artículo",
+	"Actual: %.0f":                  "Real: %.0f",
+	"| Reserved: %.0f":              "| Reservado: %.0f",
+	"| Ordered: %.0f":               "| Pedido: %.0f",
+	"| Value: %s":                   "| Valor: %s",
+	"Total: %.0f units | Value: %s": "Total: %.0f unidades | Valor: %s",
+	"Serial:":                       "Número de serie:",
+	"Warehouse":                     "Almacén",
+	"From Warehouse":                "Almacén de origen",
+	"To Warehouse":                  "Almacén de destino",
+	"Serial Number":                 "Número de serie",
+	"Supplier (optional)":           "Proveedor (opcional)",
+	"Receive Stock":                 "Recibir stock",
+	"Warehouse:":                    "Almacén:",
+	"Transfer Stock":                "Transferir stock",
+	"From Warehouse:":               "Almacén de origen:",
+	"To Warehouse:":                 "Almacén de destino:",
+	"Issue Stock":                   "Sacar stock",
+	"Create Serial Number":          "Crear número de serie",
+	"Serial Number:":                "Número de serie:",
+	"Item, quantity and warehouse are required": "El artículo, la cantidad y el almacén son obligatorios",
+	"Stock received: %s":                        "Stock recibido: %s",
+	"Failed to create stock entry":              "No se pudo crear el movimiento de stock",
+	"All fields are required":                   "Todos los campos son obligatorios",
+	"Stock transferred: %s":                     "Stock transferido: %s",
+	"Stock issued: %s":                          "Stock sacado: %s",
+	"Serial number and item code are required":  "El número de serie y el código de artículo son obligatorios",
+	"Serial number created: %s":                 "Número de serie creado: %s",
+
+	// TUI subscriptions
+	"%v | %s | period ends %s":          "%v | %s | el periodo termina el %s",
+	"Subscription:":                     "Suscripción:",
+	"Plans:":                            "Planes:",
+	"Invoices:":                         "Facturas:",
+	"Customer":                          "Cliente",
+	"Plan or PLAN:QTY, comma separated": "Plan o PLAN:CANT, separados por comas",
+	"Start date YYYY-MM-DD (empty for today)": "Fecha de inicio AAAA-MM-DD (vacío para hoy)",
+
+	"Create Subscription":                                        "Crear suscripción",
+	"Start (optional):":                                          "Inicio (opcional):",
+	"Invoiced at the end of each period, as drafts":              "Se factura al final de cada periodo, en borrador",
+	"At least one plan is required":                              "Se necesita al menos un plan",
+	"Invalid start date (expected YYYY-MM-DD)":                   "Fecha de inicio no válida (se espera AAAA-MM-DD)",
+	"Subscription created: %s":                                   "Suscripción creada: %s",
+	"Subscription cancelled: %s":                                 "Suscripción cancelada: %s",
+	"Cancel Subscription %s? No more invoices will be generated": "¿Cancelar la suscripción %s? No se generarán más facturas",
+	"… %d more, keep typing":                                     "… %d más, sigue escribiendo",
+
+	// TUI template wizard
+	"Template Code *":                      "Código de plantilla *",
+	"Template Name (defaults to the code)": "Nombre de plantilla (por defecto el código)",
+	"Type to filter":                       "Escribe para filtrar",
+	"Template code is required":            "El código de plantilla es obligatorio",
+	"No item group matches the filter":     "Ningún grupo de artículos coincide con el filtro",
+	"Pick at least one attribute (space)":  "Elige al menos un atributo (espacio)",
+	"Template created: %s":                 "Plantilla creada: %s",
+	"Failed to create template":            "No se pudo crear la plantilla",
+	"Details":                              "Detalles",
+	"Item Group":                           "Grupo de artículos",
+	"Review":                               "Revisión",
+	"Create Template · %d/%d %s":           "Crear plantilla · %d/%d %s",
+	"%s Loading...":                        "%s Cargando...",
+	"Template Code: *":                     "Código de plantilla: *",
+	"Template Name:":                       "Nombre de plantilla:",
+	"item group, attributes, review":       "grupo de artículos, atributos, revisión",
+	"No item group matches":                "Ningún grupo de artículos coincide",
+	"n creates one":                        "n crea uno",
+	"Picked: %d • Variants possible: %s":   "Elegidos: %d • Variantes posibles: %s",
+	"Code:       %s":                       "Código:     %s",
+	"Name:       %s":                       "Nombre:     %s",
+	"Item Group: %s":                       "Grupo:      %s",
+	"Attributes:":                          "Atributos:",
+	"Variants possible: %s":                "Variantes posibles: %s",
+	"More than one generate run makes (%d): generate them in parts": "Más de las que crea una generación (%d): genéralas por partes",
+	"Press Enter to create the template":                            "Pulsa Intro para crear la plantilla",
+
+	// TUI timesheets
+	"Timesheet submitted: %s":                  "Parte de horas validado: %s",
+	"Submit timesheet %s?":                     "¿Validar el parte de horas %s?",
+	"%s Loading timesheet...":                  "%s Cargando parte de horas...",
+	"Week of %s - %s":                          "Semana del %s al %s",
+	"erp-cli timesheet add <project> <hours>)": "erp-cli timesheet add <proyecto> <horas>)",
+	"Draft: %s":                                "Borrador: %s",
+	"Submitted: %s":                            "Validado: %s",
+
+	// TUI undo
+	"%s cannot be restored once deleted":       "%s no se puede restaurar una vez borrado",
+	"%s could not be saved before deleting it": "%s no se pudo guardar antes de borrarlo",
+	"Nothing to undo":                          "Nada que deshacer",
+	"Cannot undo:":                             "No se puede deshacer:",
+	"Cannot restore %s: %s":                    "No se puede restaurar %s: %s",
+	"Restored %s %s as %s":                     "%s %s restaurado como %s",
+	"Restored %s %s":                           "%s %s restaurado",
+	"Cannot amend %s: %s":                      "No se puede rectificar %s: %s",
+	"Cannot amend %s: not found":               "No se puede rectificar %s: no encontrado",
+	"Amended %s %s as draft %s":                "%s %s rectificado como borrador %s",
+
+	// TUI validation
+	"%q is not a number":            "%q no es un número",
+	"must be more than 0":           "debe ser mayor que 0",
+	"cannot be negative":            "no puede ser negativo",
+	"%q is not a date (YYYY-MM-DD)": "%q no es una fecha (AAAA-MM-DD)",
+	"Fix the field marked ✗: %s":    "Corrige el campo marcado con ✗: %s",
+
+	// Tutorial
+	"%sERPNext CLI tutorial%s": "%sTutorial de ERPNext CLI%s",
+	"This walks through the full order-to-cash flow: create an item, receive":  "Recorre el ciclo completo del pedido al cobro: crear un artículo, recibir",
+	"stock, sell it, deliver it, invoice it and get paid. Each step shows the": "stock, venderlo, entregarlo, facturarlo y cobrarlo. Cada paso muestra el",
+	"command it runs, so you can repeat it on your own afterwards.":            "comando que ejecuta, para que puedas repetirlo tú después.",
+	"%sIt creates and submits real documents on %s.%s":                         "%sCrea y valida documentos reales en %s.%s",
+	"Use a test site, not production.":                                         "Usa un sitio de pruebas, no el de producción.",
+	"Start the tutorial? [y/N]:":                                               "¿Empezar el tutorial? [y/N]:",
+	"%s── Step %d/%d: %s ──%s":                                                 "%s── Paso %d/%d: %s ──%s",
+	"%sTutorial stopped. Documents created so far are kept.%s":                 "%sTutorial detenido. Se conservan los documentos creados hasta ahora.%s",
+	"step %d (%s) failed: %w":                                                  "el paso %d (%s) falló: %w",
+	"Press Enter to run it, or q to quit:":                                     "Pulsa Intro para ejecutarlo, o q para salir:",
+	"%sLooking up an item group and warehouse...%s":                            "%sBuscando un grupo de artículos y un almacén...%s",
+	"no item group found; create one with: erp-cli group create <name>":        "no hay grupos de artículos; crea uno con: erp-cli group create <nombre>",
+	"no warehouse found for %s":                                                "no hay almacén para %s",
+	"Item group: %s | Warehouse: %s":                                           "Grupo de artículos: %s | Almacén: %s",
+	"could not find the new %s":                                                "no se encontró el nuevo %s",
+	"%s✓ Customer already exists: %s%s":                                        "%s✓ El cliente ya existe: %s%s",
+	"%s✓ Tutorial complete%s":                                                  "%s✓ Tutorial completado%s",
+	"Documents created:":                                                       "Documentos creados:",
+	"Item:           %s":                                                       "Artículo:       %s",
+	"Sales Order:    %s":                                                       "Pedido:         %s",
+	"Delivery Note:  %s":                                                       "Albarán:        %s",
+	"Sales Invoice:  %s":                                                       "Factura:        %s",
+	"Payment Entry:  %s":                                                       "Pago:           %s",
+	"Next steps:":                                                              "Siguientes pasos:",
+	"erp-cli so get %s             # The order, now delivered and billed":      "erp-cli so get %s             # El pedido, ya entregado y facturado",
+	"erp-cli stock get %s        # 8 left after delivering 2":                  "erp-cli stock get %s        # Quedan 8 tras entregar 2",
+	"erp-cli report                    # Dashboard":                            "erp-cli report                    # Panel",
+	"erp-cli                           # Same flow in the TUI":                 "erp-cli                           # El mismo flujo en la TUI",
+	"To undo it on the test site:":                                             "Para deshacerlo en el sitio de pruebas:",
+
+	// Variants
+	"Usage: erp-cli variant <subcommand> [args...]":                                          "Uso: erp-cli variant <subcomando> [argumentos...]",
+	"Subcommands: create, generate, list, matrix":                                            "Subcomandos: create, generate, list, matrix",
+	"erp-cli variant generate TSHIRT --yes    # Every missing combination":                   "erp-cli variant generate TSHIRT --yes    # Todas las combinaciones que faltan",
+	"erp-cli variant matrix TSHIRT            # Which combinations exist":                    "erp-cli variant matrix TSHIRT            # Qué combinaciones existen",
+	"usage: erp-cli variant create <template> <code> <attr1=val1> [attr2=val2...]":           "uso: erp-cli variant create <plantilla> <código> <atrib1=val1> [atrib2=val2...]",
+	"usage: erp-cli variant list <template>":                                                 "uso: erp-cli variant list <plantilla>",
+	"usage: erp-cli variant generate <template> [--attr Name=v1,v2 ...] [--dry-run] [--yes]": "uso: erp-cli variant generate <plantilla> [--attr Nombre=v1,v2 ...] [--dry-run] [--yes]",
+	"usage: erp-cli variant matrix <template> [--attr Name=v1,v2 ...]":                       "uso: erp-cli variant matrix <plantilla> [--attr Nombre=v1,v2 ...]",
+	"unknown variant subcommand: %s":                                                         "subcomando de variant desconocido: %s",
+	"%sCreating variant from template: %s%s":                                                 "%sCreando variante de la plantilla: %s%s",
+	"Variant code: %s":                                                                       "Código de variante: %s",
+	"failed to get template: %w":                                                             "no se pudo obtener la plantilla: %w",
+	"%s is not a template (has_variants=0)":                                                  "%s no es una plantilla (has_variants=0)",
+	"invalid attribute format '%s'. Use 'Attribute=Value'":                                   "formato de atributo no válido '%s'. Usa 'Atributo=Valor'",
+	"attribute '%s' is not defined in template '%s'":                                         "el atributo '%s' no está definido en la plantilla '%s'",
+	"missing required attribute: %s":                                                         "falta un atributo obligatorio: %s",
+	"%s✓ Variant created: %s%s":                                                              "%s✓ Variante creada: %s%s",
+	"%sFetching variants of: %s%s":                                                           "%sObteniendo variantes de: %s%s",
+	"%sNo variants found for template: %s%s":                                                 "%sNo se encontraron variantes de la plantilla: %s%s",
+	"%sVariants (%d):%s":                                                                     "%sVariantes (%d):%s",
+	"template %s has no attributes":                                                          "la plantilla %s no tiene atributos",
+	"failed to get attribute %s: %w":                                                         "no se pudo obtener el atributo %s: %w",
+	"%s is numeric: invalid value %q":                                                        "%s es numérico: valor no válido %q",
+	"'%s' is not a value of attribute %s":                                                    "'%s' no es un valor del atributo %s",
+	"more than %d variant combinations":                                                      "más de %d combinaciones de variantes",
+	"--attr needs Name=value1,value2":                                                        "--attr necesita Nombre=valor1,valor2",
+	"invalid --attr %q (expected Name=value1,value2)":                                        "--attr no válido %q (se espera Nombre=valor1,valor2)",
+	"%sComputing variants of: %s%s":                                                          "%sCalculando variantes de: %s%s",
+	"%sAttributes:%s":                                                                        "%sAtributos:%s",
+	"%w; narrow the values with --attr":                                                      "%w; reduce los valores con --attr",
+	"%s✓ All %d variants already exist%s":                                                    "%s✓ Las %d variantes ya existen%s",
+	"%d variants to create (max %d); narrow the values with --attr":                          "%d variantes por crear (máx. %d); reduce los valores con --attr",
+	"%sVariants to create (%d, %d already exist):%s":                                         "%sVariantes por crear (%d, %d ya existen):%s",
+	"%s[DRY RUN] Nothing created%s":                                                          "%s[SIMULACIÓN] No se creó nada%s",
+	"Create %d variants?":                                                                    "¿Crear %d variantes?",
+	"%sCoverage of %s (%s)%s":                                                                "%sCobertura de %s (%s)%s",
+	"%d of %d variants exist":                                                                "existen %d de %d variantes",
+	"%sCreate the %d missing with: %s%s":                                                     "%sCrea las %d que faltan con: %s%s",
+
+	// Work orders
+	"Usage: erp-cli wo <subcommand> [args...]":                                                               "Uso: erp-cli wo <subcomando> [argumentos...]",
+	"Subcommands: list, get, create, start, finish":                                                          "Subcomandos: list, get, create, start, finish",
+	"start moves the BOM components to the work-in-progress warehouse":                                       "start mueve los componentes de la lista de materiales al almacén de trabajo en curso",
+	"(Material Transfer for Manufacture); finish consumes them and receives":                                 "(Material Transfer for Manufacture); finish los consume y recibe",
+	"the finished goods (Manufacture). Both submit their Stock Entry, and":                                   "los productos terminados (Manufacture). Ambos validan su movimiento de stock, y",
+	"submit a draft Work Order first. --qty defaults to the pending quantity.":                               "validan antes una orden de fabricación en borrador. --qty es por defecto la cantidad pendiente.",
+	"usage: erp-cli wo get <name>":                                                                           "uso: erp-cli wo get <nombre>",
+	"usage: erp-cli wo create <item_code> <qty> [--bom=X] [--wip-warehouse=X] [--fg-warehouse=X] [--submit]": "uso: erp-cli wo create <código_artículo> <cant> [--bom=X] [--wip-warehouse=X] [--fg-warehouse=X] [--submit]",
+	"usage: erp-cli wo %s <name> [--qty=N] [--posting-date=YYYY-MM-DD]":                                      "uso: erp-cli wo %s <nombre> [--qty=N] [--posting-date=AAAA-MM-DD]",
+	"unknown wo subcommand: %s":                                                                              "subcomando de wo desconocido: %s",
+	"%sFetching work orders...%s":                                                                            "%sObteniendo órdenes de fabricación...%s",
+	"%sNo work orders found%s":                                                                               "%sNo se encontraron órdenes de fabricación%s",
+	"%sWork Orders (%d):%s":                                                                                  "%sÓrdenes de fabricación (%d):%s",
+	"Status: %s%s%s | Produced: %g/%g | Planned: %s":                                                         "Estado: %s%s%s | Producido: %g/%g | Previsto: %s",
+	"work order not found: %s":                                                                               "orden de fabricación no encontrada: %s",
+	"%sFetching work order: %s%s":                                                                            "%sObteniendo orden de fabricación: %s%s",
+	"%sWork Order: %s%s":                                                                                     "%sOrden de fabricación: %s%s",
+	"BOM: %s":                                                                                                "Lista de materiales: %s",
+	"%sRequired Items:%s":                                                                                    "%sArtículos necesarios:%s",
+	"- %s: %g required, %g transferred, %g consumed (from %s)":                                               "- %s: %g necesarios, %g transferidos, %g consumidos (desde %s)",
+	"%sCreating work order: %s x %g%s":                                                                       "%sCreando orden de fabricación: %s x %g%s",
+	"%s✓ Work Order created: %s%s":                                                                           "%s✓ Orden de fabricación creada: %s%s",
+	"Status: Not Started":                                                                                    "Estado: Sin empezar",
+	"Status: Draft (wo start submits it)":                                                                    "Estado: Borrador (wo start la valida)",
+
+	"Use 'erp-cli wo start %s' to transfer the materials": "Usa 'erp-cli wo start %s' para transferir los materiales",
+	"failed to submit %s: %w":                             "no se pudo validar %s: %w",
+	"work order %s is cancelled":                          "la orden de fabricación %s está cancelada",
+	"no stock entry returned for %s":                      "no se devolvió ningún movimiento de stock para %s",
+	"%sFinishing work order: %s%s":                        "%sTerminando orden de fabricación: %s%s",
+	"%sStarting work order: %s%s":                         "%sIniciando orden de fabricación: %s%s",
+	"%s✓ Stock Entry submitted: %s (%s)%s":                "%s✓ Movimiento de stock validado: %s (%s)%s",
+	"Use 'erp-cli wo finish %s' once production is done":  "Usa 'erp-cli wo finish %s' cuando termine la producción",
+
+	// Excel export
+	"failed to write XLSX: %w":                     "no se pudo escribir el XLSX: %w",
+	"XLSX export not supported for %s":             "la exportación XLSX no está disponible para %s",
+	"%sNo %s found%s":                              "%sNo se encontraron %s%s",
+	"%s✓ Exported %d documents (%d lines) to %s%s": "%s✓ %d documentos (%d líneas) exportados a %s%s",
+
+	// TUI help and status bits
+	"%d changed, %d removed": "%d cambiadas, %d quitadas",
+	"not saved yet":          "aún sin guardar",
+	"↑↓ scroll":              "↑↓ desplazar",
+	"%d failed":              "%d fallidos",
+	"undo %s":                "deshacer %s",
+	"export CSV":             "exportar CSV",
+	"Created by %s in %s":    "Creado por %s en %s",
+	"No variants yet":        "Todavía no hay variantes",
+	"generate variants":      "generar variantes",
+
+	// Usage (main.go)
+	"%sERPNext CLI%s - Created by Mikel Calvo in %s":  "%sERPNext CLI%s - Creado por Mikel Calvo en %s",
+	"Usage: erp-cli <command> [subcommand] [args...]": "Uso: erp-cli <comando> [subcomando] [argumentos...]",
+	"Commands:":                          "Comandos:",
+	"Test connection and authentication": "Probar la conexión y la autenticación",
+	"Diagnose DNS, TLS, latency (VPN vs internet), token, version":               "Diagnosticar DNS, TLS, latencia (VPN o internet), token, versión",
+	"and permissions, with hints to fix each problem":                            "y permisos, con pistas para arreglar cada problema",
+	"Show current configuration":                                                 "Mostrar la configuración actual",
+	"Save a default (warehouse, selling-price-list, customer-group, ...)":        "Guardar un valor por defecto (warehouse, selling-price-list, customer-group, ...)",
+	"Show version information":                                                   "Mostrar la versión",
+	"Guided order-to-cash walkthrough (test sites)":                              "Recorrido guiado del pedido al cobro (sitios de prueba)",
+	"List all item attributes":                                                   "Listar todos los atributos de artículo",
+	"Get attribute details":                                                      "Ver el detalle de un atributo",
+	"Create text attribute":                                                      "Crear atributo de texto",
+	"Create numeric attribute with range":                                        "Crear atributo numérico con rango",
+	"Create attribute with predefined values":                                    "Crear atributo con valores predefinidos",
+	"Add values to existing list attribute":                                      "Añadir valores a un atributo de lista existente",
+	"Delete an attribute":                                                        "Borrar un atributo",
+	"List items (optionally only templates)":                                     "Listar artículos (opcionalmente solo plantillas)",
+	"Get item details":                                                           "Ver el detalle de un artículo",
+	"%sitem create <code> <name> [group]%s Create simple item (config defaults)": "%sitem create <code> <name> [group]%s Crear artículo simple (valores de config)",
+	"%sitem add-attr <code> <attr1> [...]%s Add attributes to item/template":     "%sitem add-attr <code> <attr1> [...]%s Añadir atributos a artículo/plantilla",
+	"Update item properties":                                                     "Actualizar propiedades del artículo",
+	"(price=N, barcodes+=X, suppliers-=X, --json-patch f)":                       "(price=N, barcodes+=X, suppliers-=X, --json-patch f)",
+	"Delete an item":                                                             "Borrar un artículo",
+	"Map a customer's part number to an item":                                    "Asociar la referencia de un cliente a un artículo",
+	"Bulk-assign validated EAN/UPC barcodes":                                     "Asignar en bloque códigos EAN/UPC comprobados",
+	"List items without barcodes":                                                "Listar artículos sin código de barras",
+	"Templates:":                                                                 "Plantillas:",
+	"Create item template with attributes":                                       "Crear plantilla de artículo con atributos",
+	"Variants:":                                                                  "Variantes:",
+	"List all variants of a template":                                            "Listar todas las variantes de una plantilla",
+	"Create a variant from a template":                                           "Crear una variante a partir de una plantilla",
+	"Create all missing variants of the attribute matrix":                        "Crear todas las variantes que faltan de la matriz de atributos",
+	"Grid of which value combinations have a variant":                            "Tabla de qué combinaciones de valores tienen variante",
+	"Groups & Brands:":                                                           "Grupos y marcas:",
+	"List item groups":                                                           "Listar grupos de artículos",
+	"Create item group":                                                          "Crear grupo de artículos",
+	"Show item group hierarchy":                                                  "Mostrar la jerarquía de grupos de artículos",
+	"Rename an item group":                                                       "Renombrar un grupo de artículos",
+	"Delete group (check subgroups/items first)":                                 "Borrar grupo (comprueba antes subgrupos/artículos)",
+	"List brands":                                                                "Listar marcas",
+	"Create a new brand":                                                         "Crear una marca",
+	"Create brand AND add to attribute":                                          "Crear marca Y añadirla al atributo",
+	"Product Bundles:":                                                           "Paquetes de productos:",
+	"List product bundles":                                                       "Listar paquetes de productos",
+	"Show bundle components":                                                     "Mostrar los componentes del paquete",
+	"Create bundle (kit) from components":                                        "Crear paquete (kit) a partir de componentes",
+	"Delete a product bundle":                                                    "Borrar un paquete de productos",
+	"Bills of Materials:":                                                        "Listas de materiales:",
+	"List active BOMs (--all adds inactive)":                                     "Listar listas de materiales activas (--all añade las inactivas)",
+	"Show components, expanding sub-assemblies":                                  "Mostrar componentes, desplegando subconjuntos",
+	"Create BOM (qty = parent units made)":                                       "Crear lista de materiales (qty = unidades del padre fabricadas)",
+	"Submit a draft BOM":                                                         "Validar una lista de materiales en borrador",
+	"Manufacturing:":                                                             "Fabricación:",
+	"List work orders":                                                           "Listar órdenes de fabricación",
+	"Show work order with required items":                                        "Mostrar orden de fabricación con los artículos necesarios",
+	"Create work order (default BOM unless --bom)":                               "Crear orden de fabricación (lista de materiales predeterminada salvo --bom)",
+	"Transfer materials to WIP (Stock Entry)":                                    "Transferir materiales a trabajo en curso (Stock Entry)",
+	"Manufacture: consume materials, receive goods":                              "Fabricar: consumir materiales, recibir productos",
+	"List all warehouses":                                                        "Listar todos los almacenes",
+	"Show warehouse hierarchy":                                                   "Mostrar la jerarquía de almacenes",
+	"Create warehouse (or group)":                                                "Crear almacén (o grupo)",
+	"Rename a warehouse":                                                         "Renombrar un almacén",
+	"Disable a warehouse":                                                        "Desactivar un almacén",
+	"Get current stock":                                                          "Ver el stock actual",
+	"Receive stock (Material Receipt; wh: ERP_DEFAULT_WAREHOUSE)":                "Recibir stock (Material Receipt; wh: ERP_DEFAULT_WAREHOUSE)",
+	"Transfer stock between warehouses":                                          "Transferir stock entre almacenes",
+	"Issue stock (Material Issue)":                                               "Sacar stock (Material Issue)",
+	"Backdate stock, invoice, DN/PR and payment creation":                        "Crear con fecha pasada stock, facturas, albaranes y pagos",
+	"Serial Numbers:":                                                            "Números de serie:",
+	"Create a serial number":                                                     "Crear un número de serie",
+	"List serial numbers for an item":                                            "Listar los números de serie de un artículo",
+	"Get serial number details":                                                  "Ver el detalle de un número de serie",
+	"Find serial numbers containing text":                                        "Buscar números de serie que contengan un texto",
+	"Show movements and delivery customer":                                       "Mostrar movimientos y cliente de la entrega",
+	"Set the warranty expiry date":                                               "Fijar la fecha de fin de garantía",
+	"Create multiple serial numbers":                                             "Crear varios números de serie",
+	"Suppliers:":                                                                 "Proveedores:",
+	"List all suppliers":                                                         "Listar todos los proveedores",
+	"Get supplier details":                                                       "Ver el detalle de un proveedor",
+	"Create a new supplier":                                                      "Crear un proveedor",
+	"Update fields (group, tax-id, currency, ...)":                               "Actualizar campos (group, tax-id, currency, ...)",
+	"Add a linked address (--type, --zip, --primary)":                            "Añadir una dirección vinculada (--type, --zip, --primary)",
+	"Add a linked contact (--last, --phone, --mobile)":                           "Añadir un contacto vinculado (--last, --phone, --mobile)",
+	"List linked addresses":                                                      "Listar direcciones vinculadas",
+	"Delete a supplier":                                                          "Borrar un proveedor",
+	"Purchase Orders:":                                                           "Pedidos de compra:",
+	"List purchase orders":                                                       "Listar pedidos de compra",
+	"Get PO details with items":                                                  "Ver el detalle del pedido de compra con sus artículos",
+	"Create draft PO":                                                            "Crear pedido de compra en borrador",
+	"Order from a blanket order at its rates":                                    "Pedir desde un pedido marco a sus precios",
+	"Add item to PO":                                                             "Añadir artículo al pedido de compra",
+	"Submit PO":                                                                  "Validar pedido de compra",
+	"Cancel PO":                                                                  "Cancelar pedido de compra",
+	"Download the printed PDF":                                                   "Descargar el PDF impreso",
+	"Blanket Orders:":                                                            "Pedidos marco:",
+	"Agree quantities and rates for a period":                                    "Acordar cantidades y precios para un periodo",
+	"Submit a blanket order":                                                     "Validar un pedido marco",
+	"List blanket orders and whether they are active":                            "Listar pedidos marco y si están vigentes",
+	"Items with ordered and agreed quantities":                                   "Artículos con cantidades pedidas y acordadas",
+	"Purchase Invoices:":                                                         "Facturas de compra:",
+	"List purchase invoices":                                                     "Listar facturas de compra",
+	"Get invoice details":                                                        "Ver el detalle de una factura",
+	"Create invoice from PO":                                                     "Crear factura desde pedido de compra",
+	"Submit invoice":                                                             "Validar factura",
+	"Cancel invoice":                                                             "Cancelar factura",
+	"Customers:":                                                                 "Clientes:",
+	"List all customers":                                                         "Listar todos los clientes",
+	"Get customer details":                                                       "Ver el detalle de un cliente",
+	"Outstanding, unallocated payments and credit":                               "Pendiente, pagos sin asignar y crédito",
+	"Create a new customer":                                                      "Crear un cliente",
+	"List linked addresses (ids for --billing-address":                           "Listar direcciones vinculadas (ids para --billing-address",
+	"and --shipping-address of so, dn and si creation)":                          "y --shipping-address al crear so, dn y si)",
+	"Delete a customer":                                                          "Borrar un cliente",
+	"Quotations:":                                                                "Presupuestos:",
+	"List quotations":                                                            "Listar presupuestos",
+	"Get quotation details":                                                      "Ver el detalle de un presupuesto",
+	"Create draft quotation":                                                     "Crear presupuesto en borrador",
+	"Add item to quotation":                                                      "Añadir artículo al presupuesto",
+	"Submit quotation":                                                           "Validar presupuesto",
+	"Cancel quotation":                                                           "Cancelar presupuesto",
+	"quotation/so/po create and the create-from commands":                        "quotation/so/po create y los comandos create-from",
+	"take --terms=X (default: the company's terms),":                             "admiten --terms=X (por defecto: las condiciones de la empresa),",
+	"--remarks=\"...\" and --letterhead=X":                                       "--remarks=\"...\" y --letterhead=X",
+	"Sales Orders:":                                                              "Pedidos de venta:",
+	"List sales orders":                                                          "Listar pedidos de venta",
+	"Get SO details with items":                                                  "Ver el detalle del pedido de venta con sus artículos",
+	"Create draft SO (with sales team, freight from the rule)":                   "Crear pedido de venta en borrador (con equipo de ventas, portes de la regla)",
+	"Build an SO line by line (item lookup, running total)":                      "Construir un pedido de venta línea a línea (búsqueda de artículos, total acumulado)",
+	"Order from a selling blanket order":                                         "Pedir desde un pedido marco de venta",
+	"Create SO from quotation":                                                   "Crear pedido de venta desde presupuesto",
+	"Add item to SO":                                                             "Añadir artículo al pedido de venta",
+	"Submit SO (checks customer credit limit)":                                   "Validar pedido de venta (comprueba el límite de crédito del cliente)",
+	"Cancel SO":           "Cancelar pedido de venta",
+	"Sales Invoices:":     "Facturas de venta:",
+	"List sales invoices": "Listar facturas de venta",
+	"Create invoice from SO (SO's shipping rule by default)":       "Crear factura desde pedido de venta (con su regla de envío por defecto)",
+	"quotation/so/si submit email the customer contact":            "quotation/so/si submit envían un correo al contacto del cliente",
+	"when ERP_NOTIFY_ON lists them (--notify/--no-notify)":         "cuando ERP_NOTIFY_ON los incluye (--notify/--no-notify)",
+	"Repeat an invoice on a schedule (Auto Repeat)":                "Repetir una factura periódicamente (Auto Repeat)",
+	"Active repeat schedules":                                      "Repeticiones activas",
+	"Subscriptions:":                                               "Suscripciones:",
+	"List subscription plans with price and interval":              "Listar planes de suscripción con precio e intervalo",
+	"Bill a customer every period (invoices generated by ERPNext)": "Facturar a un cliente cada periodo (facturas generadas por ERPNext)",
+	"List customer subscriptions":                                  "Listar suscripciones de clientes",
+	"Plans, current period and generated invoices":                 "Planes, periodo actual y facturas generadas",
+	"Stop invoicing a subscription":                                "Dejar de facturar una suscripción",
+	"Delivery Notes:":                                              "Albaranes:",
+	"List delivery notes":                                          "Listar albaranes",
+	"Get delivery note details":                                    "Ver el detalle de un albarán",
+	"Create delivery note from SO (SO's shipping rule by default)": "Crear albarán desde pedido de venta (con su regla de envío por defecto)",
+	"Submit delivery note":                                         "Validar albarán",
+	"Cancel delivery note":                                         "Cancelar albarán",
+	"Purchase Receipts:":                                           "Albaranes de compra:",
+	"List purchase receipts":                                       "Listar albaranes de compra",
+	"Get receipt details":                                          "Ver el detalle de un albarán de compra",
+	"Create receipt from PO":                                       "Crear albarán de compra desde pedido de compra",
+	"Submit receipt":                                               "Validar albarán de compra",
+	"Cancel receipt":                                               "Cancelar albarán de compra",
+	"Quality Inspections:":                                         "Inspecciones de calidad:",
+	"Items still needing an accepted inspection":                   "Artículos que aún necesitan una inspección aceptada",
+	"Inspect an item and link it to the document":                  "Inspeccionar un artículo y vincularla al documento",
+	"Submit a draft inspection (--draft)":                          "Validar una inspección en borrador (--draft)",
+	"List quality inspections":                                     "Listar inspecciones de calidad",
+	"Get inspection details and readings":                          "Ver el detalle y las lecturas de una inspección",
+	"Landed Costs:":                                                "Costes en destino:",
+	"Add charges to the valuation of received items":               "Añadir cargos a la valoración de los artículos recibidos",
+	"Submit a landed cost voucher":                                 "Validar un justificante de costes en destino",
+	"List landed cost vouchers":                                    "Listar justificantes de costes en destino",
+	"Get voucher charges and their distribution":                   "Ver los cargos del justificante y su reparto",
+	"Fixed Assets:":                                                "Inmovilizado:",
+	"Capitalize the fixed-asset items of a receipt":                "Activar los artículos de inmovilizado de un albarán de compra",
+	"Submit (capitalize) a draft asset":                            "Validar (activar) un activo en borrador",
+	"List assets with cost and current value":                      "Listar activos con coste y valor actual",
+	"Asset details and depreciation schedule":                      "Detalle del activo y calendario de amortización",
+	"Payments:":                                                    "Pagos:",
+	"List payment entries":                                         "Listar entradas de pago",
+	"Get payment details":                                          "Ver el detalle de un pago",
+	"Create payment from Sales Invoice":                            "Crear cobro de una factura de venta",
+	"Create payment for Purchase Invoice":                          "Crear pago de una factura de compra",
+	"Submit payment":                                               "Validar pago",
+	"Cancel payment":                                               "Cancelar pago",
+	"quotation/so/po create take --currency=X and":                 "quotation/so/po create admiten --currency=X y",
+	"--exchange-rate=N (else the Currency Exchange":                "--exchange-rate=N (si no, el tipo de Currency Exchange);",
+	"rate); DN/SI/PI and payments follow the order's":              "albaranes, facturas y pagos siguen la moneda del pedido",
+	"or invoice's currency, --exchange-rate overrides":             "o de la factura, --exchange-rate la sustituye",
+	"Currency Exchange rates (in force on --date)":                 "Tipos de Currency Exchange (vigentes en --date)",
+	"Record a rate (1 from = rate to)":                             "Registrar un tipo (1 from = rate to)",
+	"Fetch rates from ERP_FX_PROVIDER":                             "Obtener tipos de ERP_FX_PROVIDER",
+	"Accounting:":                                                  "Contabilidad:",
+	"List journal entries":                                         "Listar asientos",
+	"Get journal entry details":                                    "Ver el detalle de un asiento",
+	"Create a balanced journal entry (flags repeatable)":           "Crear un asiento cuadrado (opciones repetibles)",
+	"Submit journal entry":                                         "Validar asiento",
+	"Cancel journal entry":                                         "Cancelar asiento",
+	"General ledger with running balance":                          "Libro mayor con saldo acumulado",
+	"Account balance on a date":                                    "Saldo de la cuenta en una fecha",
+	"Fiscal years and the frozen period":                           "Ejercicios fiscales y periodo cerrado",
+	"--posting-date (invoices, DN/PR, payments, stock,":            "--posting-date (facturas, albaranes, pagos, stock,",
+	"JE, expense, lcv) and --transaction-date (quotation,":         "asientos, gastos, lcv) y --transaction-date (quotation,",
+	"so, po create) must fall in an open fiscal year":              "so, po create) deben caer en un ejercicio abierto",
+	"and after the frozen period":                                  "y después del periodo cerrado",
+	"Naming series of a doctype (pick one with --naming-series=X)": "Series de numeración de un doctype (elige una con --naming-series=X)",
+	"Timesheets:":                                                  "Partes de horas:",
+	"Log hours on this week's draft timesheet":                     "Apuntar horas en el parte de horas en borrador de esta semana",
+	"Show the week grid of logged hours":                           "Mostrar la tabla semanal de horas apuntadas",
+	"List your timesheets":                                         "Listar tus partes de horas",
+	"Get timesheet details":                                        "Ver el detalle de un parte de horas",
+	"Submit a timesheet (default: this week's draft)":              "Validar un parte de horas (por defecto: el borrador de esta semana)",
+	"Employees & Expenses:":                                        "Empleados y gastos:",
+	"List employees (default: active)":                             "Listar empleados (por defecto: activos)",
+	"Get employee details":                                         "Ver el detalle de un empleado",
+	"Show the employee linked to your user":                        "Mostrar el empleado vinculado a tu usuario",
+	"List expense claim types":                                     "Listar tipos de gasto",
+	"Create an expense claim":                                      "Crear una nota de gastos",
+	"Add an expense to a draft claim":                              "Añadir un gasto a una nota en borrador",
+	"Attach receipts to a claim":                                   "Adjuntar justificantes a una nota",
+	"List your expense claims":                                     "Listar tus notas de gastos",
+	"Get expense claim details":                                    "Ver el detalle de una nota de gastos",
+	"Submit an approved claim":                                     "Validar una nota aprobada",
+	"Attachments:":                                                 "Adjuntos:",
+	"Upload files to any document (private by default)":            "Subir archivos a cualquier documento (privados por defecto)",
+	"List files attached to a document":                            "Listar los archivos adjuntos de un documento",
+	"Download an attachment":                                       "Descargar un adjunto",
+	"Documents (any doctype):":                                     "Documentos (cualquier doctype):",
+	"Add a comment to a document's timeline":                       "Añadir un comentario a la cronología de un documento",
+	"Assign a document to a user (ToDo)":                           "Asignar un documento a un usuario (ToDo)",
+	"Field-by-field change history (who, when, what)":              "Historial de cambios campo a campo (quién, cuándo, qué)",
+	"Copy a document into a new draft (dates start today)":         "Copiar un documento en un borrador nuevo (las fechas empiezan hoy)",
+	"Apply an action to every matching document concurrently":      "Aplicar una acción a todos los documentos que coinciden, en paralelo",
+	"SO -> DN -> SI -> Payment in one go, rolled back on failure":  "Pedido -> albarán -> factura -> pago de una vez, deshecho si falla",
+	"PO -> PR -> PI -> Payment in one go, rolled back on failure":  "Pedido -> albarán -> factura -> pago de una vez, deshecho si falla",
+	"Plain-text document for the terminal or a line printer":       "Documento en texto plano para el terminal o una impresora de líneas",
+	"Emit document events as JSON lines (polling, or a local":      "Emitir eventos de documentos como líneas JSON (sondeando, o con un",
+	"webhook listener with --webhook=URL [--port=N])":              "receptor de webhooks local con --webhook=URL [--port=N])",
+	"Notes:":                              "Notas:",
+	"Save a quick note for the TUI Inbox": "Guardar una nota rápida para la bandeja de la TUI",
+	"List notes with parsed hints":        "Listar notas con las pistas detectadas",
+	"Delete a note":                       "Borrar una nota",
+	"Aliases:":                            "Alias:",
+	"List command aliases defined in .erp-config":                        "Listar los alias de comandos definidos en .erp-config",
+	"Run a script of commands ($LAST_NAME is the last document created)": "Ejecutar un script de comandos ($LAST_NAME es el último documento creado)",
+	"Commands run and documents created (--last: last name)":             "Comandos ejecutados y documentos creados (--last: último nombre)",
+	"Run a command from the history again (default: last),":              "Volver a ejecutar un comando del historial (por defecto: el último),",
+	"confirming those that change data unless --yes":                     "confirmando los que modifican datos salvo con --yes",
+	"Import/Export:":                                           "Importar/Exportar:",
+	"Export items to CSV":                                      "Exportar artículos a CSV",
+	"Export templates to CSV":                                  "Exportar plantillas a CSV",
+	"Export attributes to CSV":                                 "Exportar atributos a CSV",
+	"Export variants to CSV":                                   "Exportar variantes a CSV",
+	"Export default BOMs (parent, component, qty, rate)":       "Exportar listas de materiales predeterminadas (padre, componente, cantidad, precio)",
+	"Write an Excel file instead of CSV":                       "Escribir un archivo Excel en vez de CSV",
+	"Export listed documents to Excel (header + items sheets)": "Exportar los documentos listados a Excel (hojas de cabecera y artículos)",
+	"%s<doc> get|list [...] --template=t%s Render through a Go template file or bundled template": "%s<doc> get|list [...] --template=t%s Mostrar con una plantilla Go propia o incluida",
+	"(quote-letter, packing-note, list-csv)":                                                      "(quote-letter, packing-note, list-csv)",
+	"Dump full documents (with child tables) as JSONL":                                            "Volcar documentos completos (con tablas hijas) como JSONL",
+	"Documents created/modified since the saved cursor":                                           "Documentos creados/modificados desde el cursor guardado",
+	"(--limit=N, --full for child tables, --cursor=name)":                                         "(--limit=N, --full para tablas hijas, --cursor=nombre)",
+	"List saved sync cursors":                                                                     "Listar cursores de sincronización guardados",
+	"Forget a sync cursor":                                                                        "Olvidar un cursor de sincronización",
+	"Run commands queued with --offline (conflicts are kept)":                                     "Ejecutar los comandos encolados con --offline (se conservan los conflictos)",
+	"List queued commands":                                                                        "Listar comandos encolados",
+	"Remove a queued command":                                                                     "Quitar un comando encolado",
+	"%ssnapshot pull [doctype...] [--full]%s Mirror doctypes locally for --local (catalog by default)": "%ssnapshot pull [doctype...] [--full]%s Copiar doctypes en local para --local (catálogo por defecto)",
+	"Mirrored doctypes and their age":                                                "Doctypes copiados y su antigüedad",
+	"Delete local snapshots":                                                         "Borrar instantáneas locales",
+	"%simport items -f <file> [--dry-run]%s Import items from CSV":                   "%simport items -f <file> [--dry-run]%s Importar artículos desde CSV",
+	"%simport variants -f <file> [--dry-run]%s Import variants from CSV":             "%simport variants -f <file> [--dry-run]%s Importar variantes desde CSV",
+	"Opening stock per warehouse (Stock Reconciliation)":                             "Stock de apertura por almacén (Stock Reconciliation)",
+	"Create/update Item Prices from CSV":                                             "Crear/actualizar precios de artículo desde CSV",
+	"%simport boms -f <file> [--dry-run]%s Create + submit BOMs, lower levels first": "%simport boms -f <file> [--dry-run]%s Crear y validar listas de materiales, niveles inferiores primero",
+	"Create (or with --upsert overwrite) dumped documents":                           "Crear (o con --upsert sobrescribir) documentos volcados",
+	"Skip rows done per <file>.results.csv, retry the rest":                          "Omitir las filas hechas según <file>.results.csv, reintentar el resto",
+	"Rename columns, fill defaults, trim/uppercase cells":                            "Renombrar columnas, rellenar valores por defecto, recortar/poner en mayúsculas celdas",
+	"Create/update item groups, attributes, warehouses, price":                       "Crear/actualizar grupos de artículos, atributos, almacenes, listas",
+	"lists and templates declared in a manifest":                                     "de precios y plantillas declarados en un manifiesto",
+	"Pricing:":                   "Precios:",
+	"List selling pricing rules": "Listar reglas de precios de venta",
+	"Get pricing rule details":   "Ver el detalle de una regla de precios",
+	"Create rule [--customer=X|--customer-group=X] [--min-qty=N]": "Crear regla [--customer=X|--customer-group=X] [--min-qty=N]",
+	"Disable a pricing rule":                                "Desactivar una regla de precios",
+	"Preview the effective rate for a customer":             "Ver el precio efectivo para un cliente",
+	"Coupons still usable (every one with --all)":           "Cupones aún utilizables (todos con --all)",
+	"Coupon for a coupon code based pricing rule":           "Cupón para una regla de precios basada en código de cupón",
+	"quotation/so create and si create-from-so take":        "quotation/so create y si create-from-so admiten",
+	"--discount=N%%, --discount-amount=N and --coupon=CODE": "--discount=N%%, --discount-amount=N y --coupon=CODE",
+	"Reports:":                                                                   "Informes:",
+	"Executive dashboard":                                                        "Panel de dirección",
+	"Detailed stock report":                                                      "Informe de stock detallado",
+	"Detailed purchasing report":                                                 "Informe de compras detallado",
+	"Invoiced totals per sales person":                                           "Totales facturados por comercial",
+	"Compare FIFO vs booked stock valuation":                                     "Comparar la valoración FIFO con la contabilizada",
+	"Save today's dashboard metrics locally":                                     "Guardar en local las métricas del panel de hoy",
+	"Compare two dashboard snapshots":                                            "Comparar dos instantáneas del panel",
+	"Accounts payable aging per supplier":                                        "Antigüedad de cuentas por pagar por proveedor",
+	"Revenue trend, top customers and items":                                     "Tendencia de ingresos, principales clientes y artículos",
+	"Gross margin per month, item and customer":                                  "Margen bruto por mes, artículo y cliente",
+	"Run an ERPNext query report":                                                "Ejecutar un informe de consulta de ERPNext",
+	"List the available query reports":                                           "Listar los informes de consulta disponibles",
+	"Global options:":                                                            "Opciones globales:",
+	"No colors (also with NO_COLOR, TERM=dumb or piped output)":                  "Sin colores (también con NO_COLOR, TERM=dumb o salida redirigida)",
+	"ASCII only: no box drawing, arrows or block characters":                     "Solo ASCII: sin cuadros, flechas ni caracteres de bloque",
+	"Queue create/update commands for 'sync push' instead of running them":       "Encolar los comandos de creación/actualización para 'sync push' en vez de ejecutarlos",
+	"Answer list/get reads from local snapshots (see snapshot pull)":             "Responder list/get desde instantáneas locales (ver snapshot pull)",
+	"Ignore the default flags configured for the command":                        "Ignorar las opciones por defecto configuradas para el comando",
+	"Language of the output, errors and TUI: en, es (or ERP_LANG in the config)": "Idioma de la salida, los errores y la TUI: en, es (o ERP_LANG en la configuración)",
+	"Set a field, e.g. a custom one, on the documents written (repeatable)":      "Fijar un campo, p. ej. uno personalizado, en los documentos escritos (repetible)",
+	"The same on the documents of another doctype the command writes":            "Lo mismo en los documentos de otro doctype que escribe el comando",
+	"Name the documents created with series X (see series list)":                 "Nombrar los documentos creados con la serie X (ver series list)",
+
+	// Keys reaching T through tables and variables
+	"Discount %": "Descuento %",
+	"Discount % on the grand total (optional)":      "% de descuento sobre el total (opcional)",
+	"Discount amount":                               "Importe de descuento",
+	"Discount amount on the grand total (optional)": "Importe de descuento sobre el total (opcional)",
+	"Coupon":                        "Cupón",
+	"Coupon code (optional)":        "Código de cupón (opcional)",
+	"Shipping rule":                 "Regla de envío",
+	"Shipping rule (optional)":      "Regla de envío (opcional)",
+	"Currency":                      "Moneda",
+	"Currency (optional, e.g. EUR)": "Moneda (opcional, p. ej. EUR)",
+	"Exchange rate":                 "Tipo de cambio",
+	"Exchange rate (optional, else Currency Exchange)": "Tipo de cambio (opcional, si no Currency Exchange)",
+	"Terms":                           "Condiciones",
+	"Terms and Conditions (optional)": "Términos y condiciones (opcional)",
+	"Remarks":                         "Observaciones",
+	"Remarks (optional)":              "Observaciones (opcional)",
+	"Letter head":                     "Membrete",
+	"Letter head (optional)":          "Membrete (opcional)",
+	"Billing address":                 "Dirección de facturación",
+	"Billing address (optional, one of the customer's)": "Dirección de facturación (opcional, una del cliente)",
+	"Shipping address": "Dirección de envío",
+	"Shipping address (optional, one of the customer's)": "Dirección de envío (opcional, una del cliente)",
+	"Text":    "Texto",
+	"Numeric": "Numérico",
+	"Select":  "Selección",
+	"Values typed as a list, e.g. Red, Blue, Green":        "Valores escritos como lista, p. ej. Rojo, Azul, Verde",
+	"A range of numbers, e.g. 1 to 10 in steps of 1":       "Un rango de números, p. ej. de 1 a 10 de 1 en 1",
+	"Values picked from a list, e.g. Small, Medium, Large": "Valores elegidos de una lista, p. ej. Pequeño, Mediano, Grande",
+	"from range":                         "inicio del rango",
+	"to range":                           "fin del rango",
+	"increment":                          "incremento",
+	"template not found: %s":             "plantilla no encontrada: %s",
+	"%s not submitted: %s":               "%s sin validar: %s",
+	"\nCustomer: ":                       "\nCliente: ",
+	"Rate [%s]:":                         "Precio [%s]:",
+	"\nCreate this sales order? [y/N]: ": "\n¿Crear este pedido de venta? [y/N]: ",
+	"No configuration file found.\nLet's set up your connection to ERPNext.\n\nYou'll need:\n  * Your ERPNext URL\n  * API Key & Secret (User Settings > API Access)": "No se encontró ningún archivo de configuración.\nVamos a configurar tu conexión con ERPNext.\n\nNecesitarás:\n  * La URL de tu ERPNext\n  * API Key y Secret (User Settings > API Access)",
+}
@@ -0,0 +1,36 @@
+package erp
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// formatVerb matches a fmt verb with its flags, width and precision
+var formatVerb = regexp.MustCompile(`%[-+#0]*[0-9]*(\.[0-9]+)?[vTtbcdoOqxXUeEfFgGspw%]`)
+
+func TestSpanishKeepsFormatVerbs(t *testing.T) {
+	for key, translated := range spanishMessages {
+		want := strings.Join(formatVerb.FindAllString(key, -1), " ")
+		got := strings.Join(formatVerb.FindAllString(translated, -1), " ")
+		if got != want {
+			t.Errorf("%q: verbs %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestTranslateKeepsPadding(t *testing.T) {
+	defer SetLanguage("")
+	if err := SetLanguage("es_ES.UTF-8"); err != nil {
+		t.Fatal(err)
+	}
+	if got := T("  Name:\n"); got != "  Nombre:\n" {
+		t.Errorf("got %q", got)
+	}
+	if got := T("not in the catalog"); got != "not in the catalog" {
+		t.Errorf("got %q", got)
+	}
+	if got := tf("Supplier: %s", "ACME"); got != "Proveedor: ACME" {
+		t.Errorf("got %q", got)
+	}
+}
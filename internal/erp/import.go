@@ -1,37 +1,50 @@
 package erp
 
 import (
-	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"net/url"
-	"os"
 	"strings"
 )
 
 // CmdExport handles export commands
 func (c *Client) CmdExport(args []string) error {
 	if len(args) == 0 {
-		fmt.Println("Usage: erp-cli export <type> -o <file>")
-		fmt.Println("Types: items, templates, attributes, variants")
+		fmt.Println(T("Usage: erp-cli export <type> -o <file> [--xlsx]"))
+		fmt.Println(T("       erp-cli export doc <doctype> [--filter key=value ...] -o <file.jsonl>"))
+		fmt.Println(T("Types: items, templates, attributes, variants, boms, doc"))
 		fmt.Println()
-		fmt.Println("Examples:")
-		fmt.Println("  erp-cli export items -o items.csv")
-		fmt.Println("  erp-cli export templates -o templates.csv")
-		fmt.Println("  erp-cli export attributes -o attrs.csv")
-		fmt.Println("  erp-cli export variants PSU-ATX -o psu-variants.csv")
+		fmt.Println(T("Files ending in .xlsx (or any file with --xlsx) are written as Excel"))
+		fmt.Println(T("spreadsheets, keeping leading zeros in item codes."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli export items -o items.csv"))
+		fmt.Println(T("  erp-cli export templates -o templates.csv"))
+		fmt.Println(T("  erp-cli export attributes -o attrs.csv"))
+		fmt.Println(T("  erp-cli export variants PSU-ATX -o psu-variants.csv"))
+		fmt.Println(T("  erp-cli export boms -o boms.csv"))
+		fmt.Println(T("  erp-cli export items -o items.xlsx"))
+		fmt.Println(T("  erp-cli export doc Item --filter item_group=Cables -o items.jsonl"))
+		fmt.Println(T("  erp-cli export doc \"Item Price\" --filter \"price_list=Standard Selling\" -o prices.jsonl"))
 		return nil
 	}
 
 	outputFile := ""
+	xlsx := false
 	for i, arg := range args {
 		if arg == "-o" && i+1 < len(args) {
 			outputFile = args[i+1]
 		}
+		if arg == "--xlsx" {
+			xlsx = true
+		}
 	}
 
 	if outputFile == "" {
-		return fmt.Errorf("output file required. Use -o <file>")
+		return errorf("output file required. Use -o <file>")
+	}
+	if xlsx && !isXLSXPath(outputFile) {
+		outputFile = strings.TrimSuffix(outputFile, ".csv") + ".xlsx"
 	}
 
 	switch args[0] {
@@ -41,30 +54,76 @@ func (c *Client) CmdExport(args []string) error {
 		return c.exportItems(outputFile, true)
 	case "attributes":
 		return c.exportAttributes(outputFile)
+	case "boms":
+		return c.exportBOMs(outputFile)
 	case "variants":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli export variants <template> -o <file>")
+			return errorf("usage: erp-cli export variants <template> -o <file>")
 		}
 		return c.exportVariants(args[1], outputFile)
+	case "doc":
+		if len(args) < 2 || args[1] == "-o" {
+			return errorf("usage: erp-cli export doc <doctype> [--filter key=value ...] -o <file.jsonl>")
+		}
+		if xlsx {
+			return errorf("--xlsx is not supported for document dumps")
+		}
+		filters, err := parseDocFilters(args[2:])
+		if err != nil {
+			return err
+		}
+		return c.exportDocs(args[1], filters, outputFile)
 	default:
-		return fmt.Errorf("unknown export type: %s", args[0])
+		return errorf("unknown export type: %s", args[0])
 	}
 }
 
 // CmdImport handles import commands
 func (c *Client) CmdImport(args []string) error {
 	if len(args) == 0 {
-		fmt.Println("Usage: erp-cli import <type> -f <file> [--dry-run]")
-		fmt.Println("Types: items, variants")
+		fmt.Println(T("Usage: erp-cli import <type> -f <file> [--map <file.yaml>] [--dry-run] [--resume]"))
+		fmt.Println(T("       erp-cli import doc -f <file.jsonl> [--upsert] [--dry-run] [--resume]"))
+		fmt.Println(T("Types: items, variants, stock, prices, boms, doc"))
+		fmt.Println()
+		fmt.Println(T("stock: columns item_code, warehouse, qty, rate (optional valuation rate)."))
+		fmt.Println(T("  One Opening Stock reconciliation per warehouse, or a Material Receipt"))
+		fmt.Println(T("  with --receipt. Also --difference-account=X, --posting-date=YYYY-MM-DD."))
+		fmt.Println(T("prices: columns item_code, price_list, rate (creates or updates Item Prices)."))
+		fmt.Println(T("boms: columns parent, component, qty, rate and quantity (optional). One"))
+		fmt.Println(T("  submitted BOM per parent; sub-assemblies are created before their parents."))
+		fmt.Println()
+		fmt.Println(T("import doc reads a dump from `export doc`. Existing documents are"))
+		fmt.Println(T("skipped unless --upsert, which overwrites them with the dumped version."))
 		fmt.Println()
-		fmt.Println("Examples:")
-		fmt.Println("  erp-cli import items -f items.csv")
-		fmt.Println("  erp-cli import variants -f variants.csv --dry-run")
+		fmt.Println(T("--map mapping.yaml renames input columns to the fields above, fills"))
+		fmt.Println(T("defaults and applies transforms (trim, uppercase, lowercase):"))
+		fmt.Println(T("  columns:"))
+		fmt.Println(T("    \"Supplier Code\": item_code"))
+		fmt.Println(T("  defaults:"))
+		fmt.Println(T("    price_list: Standard Buying"))
+		fmt.Println(T("  transforms:"))
+		fmt.Println(T("    item_code: [trim, uppercase]"))
+		fmt.Println()
+		fmt.Println(T("Every row's outcome is written to <file>.results.csv as it happens. After a"))
+		fmt.Println(T("failed run, --resume skips the rows that already succeeded."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli import items -f items.csv"))
+		fmt.Println(T("  erp-cli import variants -f variants.csv --dry-run"))
+		fmt.Println(T("  erp-cli import stock -f opening.csv --posting-date=2025-01-01 --dry-run"))
+		fmt.Println(T("  erp-cli import prices -f prices.csv"))
+		fmt.Println(T("  erp-cli import boms -f boms.csv --dry-run"))
+		fmt.Println(T("  erp-cli import doc -f items.jsonl --upsert"))
+		fmt.Println(T("  erp-cli import items -f items.csv --resume"))
+		fmt.Println(T("  erp-cli import prices -f supplier.csv --map supplier.yaml"))
 		return nil
 	}
 
 	inputFile := ""
 	dryRun := false
+	upsert := false
+	resume := false
+	mapFile := ""
 
 	for i, arg := range args {
 		if arg == "-f" && i+1 < len(args) {
@@ -73,20 +132,70 @@ func (c *Client) CmdImport(args []string) error {
 		if arg == "--dry-run" {
 			dryRun = true
 		}
+		if arg == "--upsert" {
+			upsert = true
+		}
+		if arg == "--resume" {
+			resume = true
+		}
+		if arg == "--map" && i+1 < len(args) {
+			mapFile = args[i+1]
+		}
+		if len(arg) > 6 && arg[:6] == "--map=" {
+			mapFile = arg[6:]
+		}
 	}
 
 	if inputFile == "" {
-		return fmt.Errorf("input file required. Use -f <file>")
+		return errorf("input file required. Use -f <file>")
 	}
 
 	switch args[0] {
-	case "items":
-		return c.importItems(inputFile, dryRun)
-	case "variants":
-		return c.importVariants(inputFile, dryRun)
+	case "items", "variants", "stock", "prices", "boms", "doc":
 	default:
-		return fmt.Errorf("unknown import type: %s", args[0])
+		return errorf("unknown import type: %s", args[0])
 	}
+
+	var stockOpts stockImportOptions
+	if args[0] == "stock" {
+		var err error
+		if stockOpts, err = parseStockImportOptions(args[1:]); err != nil {
+			return err
+		}
+	}
+
+	var mapping *importMapping
+	if mapFile != "" {
+		var err error
+		if mapping, err = loadImportMapping(mapFile); err != nil {
+			return err
+		}
+	}
+
+	run, err := newImportRun(inputFile, dryRun, resume)
+	if err != nil {
+		return err
+	}
+	run.mapping = mapping
+
+	switch args[0] {
+	case "items":
+		err = c.importItems(inputFile, run)
+	case "variants":
+		err = c.importVariants(inputFile, run)
+	case "stock":
+		err = c.importStock(inputFile, stockOpts, run)
+	case "prices":
+		err = c.importPrices(inputFile, run)
+	case "boms":
+		err = c.importBOMs(inputFile, run)
+	case "doc":
+		err = c.importDocs(inputFile, upsert, run)
+	}
+	if closeErr := run.close(); err == nil {
+		err = closeErr
+	}
+	return err
 }
 
 func (c *Client) exportItems(outputFile string, templatesOnly bool) error {
@@ -94,7 +203,7 @@ func (c *Client) exportItems(outputFile string, templatesOnly bool) error {
 	if templatesOnly {
 		itemType = "templates"
 	}
-	fmt.Printf("%sExporting %s...%s\n", Blue, itemType, Reset)
+	printf("%sExporting %s...%s\n", Blue, itemType, Reset)
 
 	endpoint := "Item?limit_page_length=0&fields=[\"item_code\",\"item_name\",\"item_group\",\"stock_uom\",\"has_variants\",\"variant_of\"]"
 	if templatesOnly {
@@ -106,17 +215,14 @@ func (c *Client) exportItems(outputFile string, templatesOnly bool) error {
 		return err
 	}
 
-	file, err := os.Create(outputFile)
+	writer, err := newTableWriter(outputFile, "Items")
 	if err != nil {
-		return fmt.Errorf("failed to create file: %w", err)
+		return err
 	}
-	defer file.Close()
-
-	writer := csv.NewWriter(file)
 
 	header := []string{"item_code", "item_name", "item_group", "stock_uom", "has_variants", "variant_of"}
 	if err := writer.Write(header); err != nil {
-		return fmt.Errorf("failed to write CSV header: %w", err)
+		return errorf("failed to write header: %w", err)
 	}
 
 	count := 0
@@ -130,41 +236,37 @@ func (c *Client) exportItems(outputFile string, templatesOnly bool) error {
 					}
 				}
 				if err := writer.Write(row); err != nil {
-					return fmt.Errorf("failed to write CSV row: %w", err)
+					return errorf("failed to write row: %w", err)
 				}
 				count++
 			}
 		}
 	}
 
-	writer.Flush()
-	if err := writer.Error(); err != nil {
-		return fmt.Errorf("failed to write CSV: %w", err)
+	if err := writer.Close(); err != nil {
+		return err
 	}
 
-	fmt.Printf("%s✓ Exported %d %s to %s%s\n", Green, count, itemType, outputFile, Reset)
+	printf("%s✓ Exported %d %s to %s%s\n", Green, count, itemType, outputFile, Reset)
 	return nil
 }
 
 func (c *Client) exportAttributes(outputFile string) error {
-	fmt.Printf("%sExporting attributes...%s\n", Blue, Reset)
+	printf("%sExporting attributes...%s\n", Blue, Reset)
 
 	result, err := c.Request("GET", "Item%20Attribute?limit_page_length=0", nil)
 	if err != nil {
 		return err
 	}
 
-	file, err := os.Create(outputFile)
+	writer, err := newTableWriter(outputFile, "Attributes")
 	if err != nil {
-		return fmt.Errorf("failed to create file: %w", err)
+		return err
 	}
-	defer file.Close()
-
-	writer := csv.NewWriter(file)
 
 	header := []string{"attribute_name", "numeric_values", "from_range", "to_range", "increment", "values"}
 	if err := writer.Write(header); err != nil {
-		return fmt.Errorf("failed to write CSV header: %w", err)
+		return errorf("failed to write header: %w", err)
 	}
 
 	count := 0
@@ -199,7 +301,7 @@ func (c *Client) exportAttributes(outputFile string) error {
 					row = append(row, strings.Join(values, "|"))
 
 					if err := writer.Write(row); err != nil {
-						return fmt.Errorf("failed to write CSV row: %w", err)
+						return errorf("failed to write row: %w", err)
 					}
 					count++
 				}
@@ -207,17 +309,16 @@ func (c *Client) exportAttributes(outputFile string) error {
 		}
 	}
 
-	writer.Flush()
-	if err := writer.Error(); err != nil {
-		return fmt.Errorf("failed to write CSV: %w", err)
+	if err := writer.Close(); err != nil {
+		return err
 	}
 
-	fmt.Printf("%s✓ Exported %d attributes to %s%s\n", Green, count, outputFile, Reset)
+	printf("%s✓ Exported %d attributes to %s%s\n", Green, count, outputFile, Reset)
 	return nil
 }
 
 func (c *Client) exportVariants(template, outputFile string) error {
-	fmt.Printf("%sExporting variants of: %s%s\n", Blue, template, Reset)
+	printf("%sExporting variants of: %s%s\n", Blue, template, Reset)
 
 	encoded := url.PathEscape(template)
 	tplResult, err := c.Request("GET", "Item/"+encoded, nil)
@@ -245,18 +346,15 @@ func (c *Client) exportVariants(template, outputFile string) error {
 		return err
 	}
 
-	file, err := os.Create(outputFile)
+	writer, err := newTableWriter(outputFile, "Variants")
 	if err != nil {
-		return fmt.Errorf("failed to create file: %w", err)
+		return err
 	}
-	defer file.Close()
-
-	writer := csv.NewWriter(file)
 
 	header := []string{"template", "item_code", "item_name"}
 	header = append(header, attrNames...)
 	if err := writer.Write(header); err != nil {
-		return fmt.Errorf("failed to write CSV header: %w", err)
+		return errorf("failed to write header: %w", err)
 	}
 
 	count := 0
@@ -294,7 +392,7 @@ func (c *Client) exportVariants(template, outputFile string) error {
 					}
 
 					if err := writer.Write(row); err != nil {
-						return fmt.Errorf("failed to write CSV row: %w", err)
+						return errorf("failed to write row: %w", err)
 					}
 					count++
 				}
@@ -302,46 +400,40 @@ func (c *Client) exportVariants(template, outputFile string) error {
 		}
 	}
 
-	writer.Flush()
-	if err := writer.Error(); err != nil {
-		return fmt.Errorf("failed to write CSV: %w", err)
+	if err := writer.Close(); err != nil {
+		return err
 	}
 
-	fmt.Printf("%s✓ Exported %d variants to %s%s\n", Green, count, outputFile, Reset)
+	printf("%s✓ Exported %d variants to %s%s\n", Green, count, outputFile, Reset)
 	return nil
 }
 
-func (c *Client) importItems(inputFile string, dryRun bool) error {
-	if dryRun {
-		fmt.Printf("%s[DRY RUN] Importing items from: %s%s\n", Yellow, inputFile, Reset)
+func (c *Client) importItems(inputFile string, run *importRun) error {
+	if run.dryRun {
+		printf("%s[DRY RUN] Importing items from: %s%s\n", Yellow, inputFile, Reset)
 	} else {
-		fmt.Printf("%sImporting items from: %s%s\n", Blue, inputFile, Reset)
-	}
-
-	file, err := os.Open(inputFile)
-	if err != nil {
-		return fmt.Errorf("failed to open file: %w", err)
+		printf("%sImporting items from: %s%s\n", Blue, inputFile, Reset)
 	}
-	defer file.Close()
 
-	reader := csv.NewReader(file)
-	records, err := reader.ReadAll()
+	records, err := readImportCSV(inputFile, run.mapping)
 	if err != nil {
-		return fmt.Errorf("failed to read CSV: %w", err)
-	}
-
-	if len(records) < 2 {
-		return fmt.Errorf("CSV file is empty or has no data rows")
+		return err
 	}
 
 	header := records[0]
 	created := 0
 	skipped := 0
 	failed := 0
+	var items []map[string]interface{}
+	var rows []int
 
 	for i, record := range records[1:] {
+		if run.finished(i + 2) {
+			continue
+		}
 		if len(record) < 3 {
-			fmt.Printf("  %sRow %d: skipped (insufficient columns)%s\n", Yellow, i+2, Reset)
+			printf("  %sRow %d: skipped (insufficient columns)%s\n", Yellow, i+2, Reset)
+			run.record(i+2, "", rowSkipped, "insufficient columns")
 			skipped++
 			continue
 		}
@@ -362,58 +454,47 @@ func (c *Client) importItems(inputFile string, dryRun bool) error {
 		}
 
 		if item["item_code"] == nil || item["item_code"] == "" {
-			fmt.Printf("  %sRow %d: skipped (no item_code)%s\n", Yellow, i+2, Reset)
+			printf("  %sRow %d: skipped (no item_code)%s\n", Yellow, i+2, Reset)
+			run.record(i+2, "", rowSkipped, "no item_code")
 			skipped++
 			continue
 		}
 
-		if item["stock_uom"] == nil {
-			item["stock_uom"] = "Unit"
-		}
-		if item["is_stock_item"] == nil {
-			item["is_stock_item"] = 1
+		// Fill columns missing from the CSV with configured defaults
+		for k, v := range c.newItemBody() {
+			if item[k] == nil {
+				item[k] = v
+			}
 		}
 
-		if dryRun {
-			fmt.Printf("  [DRY RUN] Would create: %s\n", item["item_code"])
+		if run.dryRun {
+			printf("  [DRY RUN] Would create: %s\n", item["item_code"])
 			created++
 		} else {
-			_, err := c.Request("POST", "Item", item)
-			if err != nil {
-				fmt.Printf("  %s✗ Failed: %s (%s)%s\n", Red, item["item_code"], err, Reset)
-				failed++
-			} else {
-				fmt.Printf("  %s✓ Created: %s%s\n", Green, item["item_code"], Reset)
-				created++
-			}
+			items = append(items, item)
+			rows = append(rows, i+2)
 		}
 	}
 
-	fmt.Printf("\n%sSummary: %d created, %d skipped, %d failed%s\n", Cyan, created, skipped, failed, Reset)
+	// Create in batches to save round trips
+	n, f := c.insertTracked("Item", items, rows, "item_code", run)
+	created += n
+	failed += f
+
+	printf("\n%sSummary: %d created, %d skipped, %d failed%s\n", Cyan, created, skipped, failed, Reset)
 	return nil
 }
 
-func (c *Client) importVariants(inputFile string, dryRun bool) error {
-	if dryRun {
-		fmt.Printf("%s[DRY RUN] Importing variants from: %s%s\n", Yellow, inputFile, Reset)
+func (c *Client) importVariants(inputFile string, run *importRun) error {
+	if run.dryRun {
+		printf("%s[DRY RUN] Importing variants from: %s%s\n", Yellow, inputFile, Reset)
 	} else {
-		fmt.Printf("%sImporting variants from: %s%s\n", Blue, inputFile, Reset)
+		printf("%sImporting variants from: %s%s\n", Blue, inputFile, Reset)
 	}
 
-	file, err := os.Open(inputFile)
+	records, err := readImportCSV(inputFile, run.mapping)
 	if err != nil {
-		return fmt.Errorf("failed to open file: %w", err)
-	}
-	defer file.Close()
-
-	reader := csv.NewReader(file)
-	records, err := reader.ReadAll()
-	if err != nil {
-		return fmt.Errorf("failed to read CSV: %w", err)
-	}
-
-	if len(records) < 2 {
-		return fmt.Errorf("CSV file is empty or has no data rows")
+		return err
 	}
 
 	header := records[0]
@@ -437,7 +518,7 @@ func (c *Client) importVariants(inputFile string, dryRun bool) error {
 	}
 
 	if templateIdx == -1 || codeIdx == -1 {
-		return fmt.Errorf("CSV must have 'template' and 'item_code' columns")
+		return errorf("CSV must have 'template' and 'item_code' columns")
 	}
 
 	created := 0
@@ -445,10 +526,16 @@ func (c *Client) importVariants(inputFile string, dryRun bool) error {
 	failed := 0
 
 	templateCache := make(map[string]map[string]interface{})
+	var variants []map[string]interface{}
+	var rows []int
 
 	for i, record := range records[1:] {
+		if run.finished(i + 2) {
+			continue
+		}
 		if len(record) < 3 {
-			fmt.Printf("  %sRow %d: skipped (insufficient columns)%s\n", Yellow, i+2, Reset)
+			printf("  %sRow %d: skipped (insufficient columns)%s\n", Yellow, i+2, Reset)
+			run.record(i+2, "", rowSkipped, "insufficient columns")
 			skipped++
 			continue
 		}
@@ -467,7 +554,8 @@ func (c *Client) importVariants(inputFile string, dryRun bool) error {
 			encoded := url.PathEscape(template)
 			result, err := c.Request("GET", "Item/"+encoded, nil)
 			if err != nil {
-				fmt.Printf("  %sRow %d: skipped (template not found: %s)%s\n", Yellow, i+2, template, Reset)
+				printf("  %sRow %d: skipped (template not found: %s)%s\n", Yellow, i+2, template, Reset)
+				run.record(i+2, code, rowSkipped, tf("template not found: %s", template))
 				skipped++
 				continue
 			}
@@ -500,10 +588,10 @@ func (c *Client) importVariants(inputFile string, dryRun bool) error {
 			}
 		}
 
-		if dryRun {
-			fmt.Printf("  [DRY RUN] Would create: %s (%s)\n", code, name)
+		if run.dryRun {
+			printf("  [DRY RUN] Would create: %s (%s)\n", code, name)
 			attrStr, _ := json.Marshal(attributes)
-			fmt.Printf("    Attributes: %s\n", attrStr)
+			printf("    Attributes: %s\n", attrStr)
 			created++
 		} else {
 			body := map[string]interface{}{
@@ -516,17 +604,16 @@ func (c *Client) importVariants(inputFile string, dryRun bool) error {
 				"attributes":    attributes,
 			}
 
-			_, err := c.Request("POST", "Item", body)
-			if err != nil {
-				fmt.Printf("  %s✗ Failed: %s (%s)%s\n", Red, code, err, Reset)
-				failed++
-			} else {
-				fmt.Printf("  %s✓ Created: %s%s\n", Green, code, Reset)
-				created++
-			}
+			variants = append(variants, body)
+			rows = append(rows, i+2)
 		}
 	}
 
-	fmt.Printf("\n%sSummary: %d created, %d skipped, %d failed%s\n", Cyan, created, skipped, failed, Reset)
+	// Create in batches to save round trips
+	n, f := c.insertTracked("Item", variants, rows, "item_code", run)
+	created += n
+	failed += f
+
+	printf("\n%sSummary: %d created, %d skipped, %d failed%s\n", Cyan, created, skipped, failed, Reset)
 	return nil
 }
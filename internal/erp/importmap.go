@@ -0,0 +1,239 @@
+package erp
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// importTransforms are the cell transforms a mapping file may name
+var importTransforms = map[string]func(string) string{
+	"trim":      strings.TrimSpace,
+	"uppercase": strings.ToUpper,
+	"lowercase": strings.ToLower,
+}
+
+// importMapping adapts an input file to the columns an importer expects.
+// It is read from a small YAML file:
+//
+//	columns:            # input header -> ERPNext field
+//	  Artikelnummer: item_code
+//	  "Preis EUR": rate
+//	defaults:           # used when a field is missing or empty
+//	  price_list: Standard Buying
+//	transforms:         # applied in order after defaults
+//	  item_code: [trim, uppercase]
+//	  item_name:
+//	    - trim
+type importMapping struct {
+	columns    map[string]string   // Lowercased input header -> field
+	defaults   map[string]string   // Field -> value
+	transforms map[string][]string // Field -> transform names
+}
+
+// loadImportMapping parses a mapping file. Only the three sections above
+// are understood; anything else is an error so typos do not go unnoticed.
+// Values are kept as written (007 stays 007).
+func loadImportMapping(path string) (*importMapping, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errorf("failed to open mapping: %w", err)
+	}
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	m := &importMapping{
+		columns:    map[string]string{},
+		defaults:   map[string]string{},
+		transforms: map[string][]string{},
+	}
+	if len(root.Content) == 0 {
+		return m, nil
+	}
+	top := yamlTarget(root.Content[0])
+	if top.Kind != yaml.MappingNode {
+		return nil, errorf("%s:%d: expected sections (columns, defaults, transforms)", path, top.Line)
+	}
+	for i := 0; i+1 < len(top.Content); i += 2 {
+		section, entries := top.Content[i].Value, yamlTarget(top.Content[i+1])
+		switch section {
+		case "columns", "defaults", "transforms":
+		default:
+			return nil, errorf("%s:%d: unknown section %q (expected columns, defaults or transforms)", path, top.Content[i].Line, section)
+		}
+		if entries.Tag == "!!null" {
+			continue
+		}
+		if entries.Kind != yaml.MappingNode {
+			return nil, errorf("%s:%d: %s: expected key: value", path, entries.Line, section)
+		}
+
+		for j := 0; j+1 < len(entries.Content); j += 2 {
+			key, value := entries.Content[j].Value, yamlTarget(entries.Content[j+1])
+			if key == "" {
+				return nil, errorf("%s:%d: expected key: value", path, entries.Content[j].Line)
+			}
+			if section == "transforms" {
+				names, err := transformNames(value)
+				if err != nil {
+					return nil, fmt.Errorf("%s:%d: %w", path, value.Line, err)
+				}
+				m.transforms[key] = append(m.transforms[key], names...)
+				continue
+			}
+			if value.Kind != yaml.ScalarNode {
+				return nil, errorf("%s:%d: %s: expected a single value", path, value.Line, key)
+			}
+			text := value.Value
+			if value.Tag == "!!null" {
+				text = ""
+			}
+			if section == "columns" {
+				m.columns[strings.ToLower(key)] = text
+			} else {
+				m.defaults[key] = text
+			}
+		}
+	}
+	return m, nil
+}
+
+// yamlTarget follows an alias to the node it names
+func yamlTarget(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode && node.Alias != nil {
+		node = node.Alias
+	}
+	return node
+}
+
+// transformNames reads the transforms of a field: a list, or one name or
+// several separated by commas
+func transformNames(node *yaml.Node) ([]string, error) {
+	var words []string
+	switch node.Kind {
+	case yaml.ScalarNode:
+		words = strings.Split(node.Value, ",")
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			item = yamlTarget(item)
+			if item.Kind != yaml.ScalarNode {
+				return nil, errorf("expected transform names")
+			}
+			words = append(words, item.Value)
+		}
+	default:
+		return nil, errorf("expected transform names")
+	}
+
+	var names []string
+	for _, name := range words {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if _, ok := importTransforms[name]; !ok {
+			return nil, errorf("unknown transform %q (use trim, uppercase or lowercase)", name)
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// field returns the field an input header maps to
+func (m *importMapping) field(header string) string {
+	if f, ok := m.columns[strings.ToLower(strings.TrimSpace(header))]; ok {
+		return f
+	}
+	return header
+}
+
+// value fills a default and runs the transforms of field
+func (m *importMapping) value(field, value string) string {
+	if value == "" {
+		value = m.defaults[field]
+	}
+	for _, name := range m.transforms[field] {
+		value = importTransforms[name](value)
+	}
+	return value
+}
+
+// applyCSV renames the header, adds columns for defaults the file lacks
+// and rewrites every cell. records includes the header row.
+func (m *importMapping) applyCSV(records [][]string) [][]string {
+	if m == nil || len(records) == 0 {
+		return records
+	}
+	header := make([]string, len(records[0]))
+	present := map[string]bool{}
+	for i, h := range records[0] {
+		header[i] = m.field(h)
+		present[header[i]] = true
+	}
+	for field := range m.defaults {
+		if !present[field] {
+			header = append(header, field)
+		}
+	}
+
+	out := [][]string{header}
+	for _, record := range records[1:] {
+		row := make([]string, len(header))
+		copy(row, record)
+		for i, field := range header {
+			row[i] = m.value(field, row[i])
+		}
+		out = append(out, row)
+	}
+	return out
+}
+
+// applyDoc does the same for a JSON document: keys are renamed, defaults
+// added and string values transformed
+func (m *importMapping) applyDoc(doc map[string]interface{}) {
+	if m == nil {
+		return
+	}
+	mapped := make(map[string]interface{}, len(doc))
+	for key, value := range doc {
+		mapped[m.field(key)] = value
+		delete(doc, key)
+	}
+	for field := range m.defaults {
+		if mapped[field] == nil {
+			mapped[field] = ""
+		}
+	}
+	for field, value := range mapped {
+		if s, ok := value.(string); ok {
+			value = m.value(field, s)
+		}
+		doc[field] = value
+	}
+}
+
+// readImportCSV reads an import file, header included, through the
+// mapping of the run
+func readImportCSV(path string, mapping *importMapping) ([][]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, errorf("failed to read CSV: %w", err)
+	}
+	if len(records) < 2 {
+		return nil, errorf("CSV file is empty or has no data rows")
+	}
+	return mapping.applyCSV(records), nil
+}
@@ -0,0 +1,58 @@
+package erp
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestImportMappingKeepsText(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mapping.yaml")
+	mapping := `columns:
+  Artikelnummer: item_code   # comment
+  "Preis EUR": rate
+defaults:
+  warehouse: 007
+  price_list: "Standard Buying"
+transforms:
+  item_code: [trim, uppercase]
+  item_name:
+    - trim
+`
+	if err := os.WriteFile(path, []byte(mapping), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	m, err := loadImportMapping(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := m.columns["preis eur"]; got != "rate" {
+		t.Errorf("column: got %q, want rate", got)
+	}
+	if got := m.defaults["warehouse"]; got != "007" {
+		t.Errorf("default: got %q, want 007", got)
+	}
+	if got := m.transforms["item_code"]; len(got) != 2 || got[1] != "uppercase" {
+		t.Errorf("transforms: got %v", got)
+	}
+	if got := m.transforms["item_name"]; len(got) != 1 || got[0] != "trim" {
+		t.Errorf("transforms: got %v", got)
+	}
+}
+
+func TestImportMappingErrors(t *testing.T) {
+	for _, tc := range []struct{ mapping, want string }{
+		{"colums:\n  a: b\n", `unknown section "colums"`},
+		{"transforms:\n  a: [trim, reverse]\n", `unknown transform "reverse"`},
+	} {
+		path := filepath.Join(t.TempDir(), "mapping.yaml")
+		if err := os.WriteFile(path, []byte(tc.mapping), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		_, err := loadImportMapping(path)
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("got %v, want %s", err, tc.want)
+		}
+	}
+}
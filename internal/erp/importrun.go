@@ -0,0 +1,152 @@
+package erp
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Row statuses written to the results file. Rows that ended created,
+// updated or set are skipped by --resume; skipped and error rows run again.
+const (
+	rowCreated = "created"
+	rowUpdated = "updated"
+	rowSet     = "set"
+	rowSkipped = "skipped"
+	rowError   = "error"
+)
+
+// importRun tracks one import. Every row's outcome is appended to a results
+// CSV next to the input file and flushed right away, so the file also
+// serves as the checkpoint a failed run resumes from.
+type importRun struct {
+	dryRun  bool
+	mapping *importMapping // From --map; nil leaves the input as is
+	results string         // Path of the results CSV
+	file    *os.File
+	writer  *csv.Writer
+	done    map[int]bool // Rows finished by an earlier run
+	resumed int          // Rows skipped because they were done
+}
+
+// importResultsPath is the results file of an input: items.csv gives
+// items.results.csv
+func importResultsPath(inputFile string) string {
+	return strings.TrimSuffix(inputFile, filepath.Ext(inputFile)) + ".results.csv"
+}
+
+// newImportRun opens the results file of inputFile. With resume, the rows
+// the previous run finished are kept and will be skipped. Dry runs write
+// nothing.
+func newImportRun(inputFile string, dryRun, resume bool) (*importRun, error) {
+	run := &importRun{dryRun: dryRun, results: importResultsPath(inputFile), done: map[int]bool{}}
+	if dryRun {
+		return run, nil
+	}
+
+	var previous [][]string
+	if resume {
+		file, err := os.Open(run.results)
+		if err != nil {
+			return nil, errorf("nothing to resume: %w", err)
+		}
+		records, err := csv.NewReader(file).ReadAll()
+		file.Close()
+		if err != nil {
+			return nil, errorf("failed to read %s: %w", run.results, err)
+		}
+		for i, record := range records {
+			if i == 0 || len(record) < 3 {
+				continue
+			}
+			row, err := strconv.Atoi(record[0])
+			if err != nil {
+				continue
+			}
+			switch record[2] {
+			case rowCreated, rowUpdated, rowSet:
+				run.done[row] = true
+				previous = append(previous, record)
+			}
+		}
+	}
+
+	file, err := os.Create(run.results)
+	if err != nil {
+		return nil, errorf("failed to create results file: %w", err)
+	}
+	run.file = file
+	run.writer = csv.NewWriter(file)
+	run.writer.Write([]string{"row", "key", "status", "message"})
+	for _, record := range previous {
+		run.writer.Write(record)
+	}
+	run.writer.Flush()
+	return run, run.writer.Error()
+}
+
+// finished reports whether an earlier run already completed row, counting
+// it as resumed
+func (r *importRun) finished(row int) bool {
+	if r.done[row] {
+		r.resumed++
+		return true
+	}
+	return false
+}
+
+// record writes the outcome of one row
+func (r *importRun) record(row int, key, status, message string) {
+	if r.writer == nil {
+		return
+	}
+	r.writer.Write([]string{strconv.Itoa(row), key, status, T(message)})
+	r.writer.Flush()
+}
+
+// close finishes the results file and says where it is
+func (r *importRun) close() error {
+	if r.resumed > 0 {
+		printf("%s%d rows already imported by the previous run%s\n", Cyan, r.resumed, Reset)
+	}
+	if r.writer == nil {
+		return nil
+	}
+	r.writer.Flush()
+	err := r.writer.Error()
+	r.file.Close()
+	if err != nil {
+		return errorf("failed to write results file: %w", err)
+	}
+	printf("Results: %s (rerun with --resume to retry failed rows)\n", r.results)
+	return nil
+}
+
+// insertTracked creates documents batch by batch, recording each row as
+// soon as its batch is done. rows[i] is the input row of docs[i]; key names
+// the field shown for each document.
+func (c *Client) insertTracked(doctype string, docs []map[string]interface{}, rows []int, key string, run *importRun) (created, failed int) {
+	for start := 0; start < len(docs); start += bulkBatchSize {
+		end := start + bulkBatchSize
+		if end > len(docs) {
+			end = len(docs)
+		}
+		for i, r := range c.insertMany(doctype, docs[start:end]) {
+			row := rows[start+i]
+			name := fmt.Sprintf("%v", r.doc[key])
+			if r.err != nil {
+				printf("  %s✗ Failed: %s (%s)%s\n", Red, name, r.err, Reset)
+				run.record(row, name, rowError, r.err.Error())
+				failed++
+			} else {
+				printf("  %s✓ Created: %s%s\n", Green, name, Reset)
+				run.record(row, name, rowCreated, "")
+				created++
+			}
+		}
+	}
+	return created, failed
+}
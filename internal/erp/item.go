@@ -4,19 +4,37 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"os"
+	"strconv"
 	"strings"
 )
 
 // CmdItem handles item commands
 func (c *Client) CmdItem(args []string) error {
 	if len(args) == 0 {
-		fmt.Println("Usage: erp-cli item <subcommand> [args...]")
-		fmt.Println("Subcommands: list, get, create, add-attr, set, delete")
+		fmt.Println(T("Usage: erp-cli item <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, create, add-attr, set, delete, map-customer-code,"))
+		fmt.Println(T("             assign-barcodes, missing-barcodes"))
 		fmt.Println()
-		fmt.Println("Set options:")
-		fmt.Println("  item set <code> serial=on|off       Enable/disable serial numbers")
-		fmt.Println("  item set <code> batch=on|off        Enable/disable batch numbers")
-		fmt.Println("  item set <code> serial-series=XXX   Set serial number series (e.g., SN-.#####)")
+		fmt.Println(T("Set options:"))
+		fmt.Println(T("  item set <code> serial=on|off       Enable/disable serial numbers"))
+		fmt.Println(T("  item set <code> batch=on|off        Enable/disable batch numbers"))
+		fmt.Println(T("  item set <code> serial-series=XXX   Set serial number series (e.g., SN-.#####)"))
+		fmt.Println(T("  item set <code> description=\"...\"   Any Item field (description, brand, ...)"))
+		fmt.Println(T("  item set <code> price=450           Selling price (default selling price list)"))
+		fmt.Println(T("  item set <code> buying_price=300    Buying price (default buying price list)"))
+		fmt.Println(T("  item set <code> barcodes+=4006381333931   Add barcode (barcodes-= removes)"))
+		fmt.Println(T("  item set <code> suppliers+=\"Intel:BX8070\" Add supplier part number"))
+		fmt.Println(T("  item set <code> taxes+=\"VAT 21%\"     Add item tax template"))
+		fmt.Println(T("  item set <code> --json-patch patch.json  Merge fields/child tables from JSON"))
+		fmt.Println()
+		fmt.Println(T("Customer item codes:"))
+		fmt.Println(T("  item map-customer-code <code> <customer> <their_code>"))
+		fmt.Println(T("  item map-customer-code <code> <customer> --remove"))
+		fmt.Println()
+		fmt.Println(T("Barcodes (EAN-8, UPC-A, EAN-13, GTIN-14 with check digit validation):"))
+		fmt.Println(T("  item assign-barcodes -f barcodes.csv [--dry-run]   CSV columns: item_code,barcode"))
+		fmt.Println(T("  item missing-barcodes                              List items without barcodes"))
 		return nil
 	}
 
@@ -26,41 +44,70 @@ func (c *Client) CmdItem(args []string) error {
 		return c.itemList(templatesOnly)
 	case "get":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli item get <code>")
+			return errorf("usage: erp-cli item get <code>")
 		}
 		return c.itemGet(args[1])
 	case "create":
-		if len(args) < 4 {
-			return fmt.Errorf("usage: erp-cli item create <code> <name> <group>")
+		if len(args) < 3 {
+			return errorf("usage: erp-cli item create <code> <name> [group]")
 		}
-		return c.itemCreate(args[1], args[2], args[3])
+		group := c.Config.DefaultItemGroup
+		if len(args) > 3 {
+			group = args[3]
+		}
+		if group == "" {
+			return errorf("item group required (or set ERP_DEFAULT_ITEM_GROUP in config)")
+		}
+		return c.itemCreate(args[1], args[2], group)
 	case "add-attr":
 		if len(args) < 3 {
-			return fmt.Errorf("usage: erp-cli item add-attr <code> <attr1> [attr2...]")
+			return errorf("usage: erp-cli item add-attr <code> <attr1> [attr2...]")
 		}
 		return c.itemAddAttr(args[1], args[2:])
 	case "set":
 		if len(args) < 3 {
-			return fmt.Errorf("usage: erp-cli item set <code> <property=value>")
+			return errorf("usage: erp-cli item set <code> <property=value>")
 		}
 		return c.itemSet(args[1], args[2:])
 	case "delete":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli item delete <code>")
+			return errorf("usage: erp-cli item delete <code>")
 		}
 		return c.itemDelete(args[1])
+	case "map-customer-code":
+		if len(args) < 4 {
+			return errorf("usage: erp-cli item map-customer-code <code> <customer> <their_code|--remove>")
+		}
+		return c.itemMapCustomerCode(args[1], args[2], args[3])
+	case "assign-barcodes":
+		inputFile := ""
+		dryRun := false
+		for i, arg := range args {
+			if arg == "-f" && i+1 < len(args) {
+				inputFile = args[i+1]
+			}
+			if arg == "--dry-run" {
+				dryRun = true
+			}
+		}
+		if inputFile == "" {
+			return errorf("input file required. Use -f <file>")
+		}
+		return c.itemAssignBarcodes(inputFile, dryRun)
+	case "missing-barcodes":
+		return c.itemMissingBarcodes()
 	default:
-		return fmt.Errorf("unknown item subcommand: %s", args[0])
+		return errorf("unknown item subcommand: %s", args[0])
 	}
 }
 
 func (c *Client) itemList(templatesOnly bool) error {
-	fmt.Printf("%sFetching items...%s\n", Blue, Reset)
+	printf("%sFetching items...%s\n", Blue, Reset)
 
 	endpoint := "Item?limit_page_length=0"
 	if templatesOnly {
 		endpoint = "Item?limit_page_length=0&filters=%5B%5B%22has_variants%22%2C%22%3D%22%2C1%5D%5D"
-		fmt.Printf("%sTemplates only:%s\n", Yellow, Reset)
+		printf("%sTemplates only:%s\n", Yellow, Reset)
 	}
 
 	result, err := c.Request("GET", endpoint, nil)
@@ -79,7 +126,7 @@ func (c *Client) itemList(templatesOnly bool) error {
 }
 
 func (c *Client) itemGet(code string) error {
-	fmt.Printf("%sFetching item: %s%s\n", Blue, code, Reset)
+	printf("%sFetching item: %s%s\n", Blue, code, Reset)
 
 	encoded := url.PathEscape(code)
 	result, err := c.Request("GET", "Item/"+encoded, nil)
@@ -97,6 +144,15 @@ func (c *Client) itemGet(code string) error {
 			}
 		}
 
+		customerCodes := make(map[string]interface{})
+		if rows, ok := data["customer_items"].([]interface{}); ok {
+			for _, r := range rows {
+				if rm, ok := r.(map[string]interface{}); ok {
+					customerCodes[fmt.Sprintf("%v", rm["customer_name"])] = rm["ref_code"]
+				}
+			}
+		}
+
 		hasSerial := "no"
 		if hs, ok := data["has_serial_no"].(float64); ok && hs == 1 {
 			hasSerial = "yes"
@@ -118,6 +174,9 @@ func (c *Client) itemGet(code string) error {
 			"serial_no_series": data["serial_no_series"],
 			"attributes":       attrs,
 		}
+		if len(customerCodes) > 0 {
+			output["customer_codes"] = customerCodes
+		}
 
 		for k, v := range output {
 			if k == "has_serial_no" || k == "has_batch_no" {
@@ -135,27 +194,45 @@ func (c *Client) itemGet(code string) error {
 }
 
 func (c *Client) itemCreate(code, name, group string) error {
-	fmt.Printf("%sCreating item: %s%s\n", Blue, code, Reset)
+	printf("%sCreating item: %s%s\n", Blue, code, Reset)
 
-	body := map[string]interface{}{
-		"item_code":     code,
-		"item_name":     name,
-		"item_group":    group,
-		"stock_uom":     "Unit",
-		"is_stock_item": 1,
-	}
+	body := c.newItemBody()
+	body["item_code"] = code
+	body["item_name"] = name
+	body["item_group"] = group
 
 	_, err := c.Request("POST", "Item", body)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("%s✓ Item created: %s%s\n", Green, code, Reset)
+	printf("%s✓ Item created: %s%s\n", Green, code, Reset)
+	printf("  Group: %s | UOM: %s\n", group, body["stock_uom"])
 	return nil
 }
 
+// newItemBody returns a new Item document with the configured defaults applied
+func (c *Client) newItemBody() map[string]interface{} {
+	isStock := 0
+	if c.Config.DefaultIsStockItem {
+		isStock = 1
+	}
+
+	body := map[string]interface{}{
+		"stock_uom":     c.Config.DefaultUOM,
+		"is_stock_item": isStock,
+	}
+	if c.Config.DefaultItemGroup != "" {
+		body["item_group"] = c.Config.DefaultItemGroup
+	}
+	if c.Config.DefaultWarrantyDays > 0 {
+		body["warranty_period"] = c.Config.DefaultWarrantyDays
+	}
+	return body
+}
+
 func (c *Client) itemAddAttr(code string, attrs []string) error {
-	fmt.Printf("%sAdding attributes to item: %s%s\n", Blue, code, Reset)
+	printf("%sAdding attributes to item: %s%s\n", Blue, code, Reset)
 
 	encoded := url.PathEscape(code)
 	result, err := c.Request("GET", "Item/"+encoded, nil)
@@ -165,7 +242,7 @@ func (c *Client) itemAddAttr(code string, attrs []string) error {
 
 	data, ok := result["data"].(map[string]interface{})
 	if !ok {
-		return fmt.Errorf("item not found")
+		return errorf("item not found")
 	}
 
 	var existingAttrs []map[string]interface{}
@@ -192,13 +269,13 @@ func (c *Client) itemAddAttr(code string, attrs []string) error {
 		return err
 	}
 
-	fmt.Printf("%s✓ Attributes added to: %s%s\n", Green, code, Reset)
-	fmt.Printf("  New attributes: %s\n", strings.Join(attrs, ", "))
+	printf("%s✓ Attributes added to: %s%s\n", Green, code, Reset)
+	printf("  New attributes: %s\n", strings.Join(attrs, ", "))
 	return nil
 }
 
 func (c *Client) itemDelete(code string) error {
-	fmt.Printf("%sDeleting item: %s%s\n", Blue, code, Reset)
+	printf("%sDeleting item: %s%s\n", Blue, code, Reset)
 
 	encoded := url.PathEscape(code)
 	_, err := c.Request("DELETE", "Item/"+encoded, nil)
@@ -206,69 +283,119 @@ func (c *Client) itemDelete(code string) error {
 		return err
 	}
 
-	fmt.Printf("%s✓ Item deleted: %s%s\n", Green, code, Reset)
+	printf("%s✓ Item deleted: %s%s\n", Green, code, Reset)
 	return nil
 }
 
 func (c *Client) itemSet(code string, settings []string) error {
-	fmt.Printf("%sUpdating item: %s%s\n", Blue, code, Reset)
+	printf("%sUpdating item: %s%s\n", Blue, code, Reset)
 
 	body := make(map[string]interface{})
+	prices := make(map[string]float64) // price list -> rate
+	var doc map[string]interface{}     // current item, fetched on first child table edit
+
+	for i := 0; i < len(settings); i++ {
+		setting := settings[i]
+
+		// --json-patch <file> merges a JSON object into the item
+		if setting == "--json-patch" || strings.HasPrefix(setting, "--json-patch=") {
+			patchFile := strings.TrimPrefix(setting, "--json-patch=")
+			if setting == "--json-patch" {
+				if i+1 >= len(settings) {
+					return errorf("--json-patch requires a file")
+				}
+				i++
+				patchFile = settings[i]
+			}
+			if err := loadJSONPatch(patchFile, body); err != nil {
+				return err
+			}
+			continue
+		}
 
-	for _, setting := range settings {
 		parts := strings.SplitN(setting, "=", 2)
 		if len(parts) != 2 {
-			return fmt.Errorf("invalid setting format '%s'. Use 'property=value'", setting)
+			return errorf("invalid setting format '%s'. Use 'property=value'", setting)
 		}
 
 		key := strings.TrimSpace(parts[0])
 		value := strings.TrimSpace(parts[1])
 
+		// Child table edits: table+=value / table-=value
+		if strings.HasSuffix(key, "+") || strings.HasSuffix(key, "-") {
+			if doc == nil {
+				result, err := c.Request("GET", "Item/"+url.PathEscape(code), nil)
+				if err != nil {
+					return err
+				}
+				var ok bool
+				if doc, ok = result["data"].(map[string]interface{}); !ok {
+					return errorf("item not found: %s", code)
+				}
+			}
+			if err := applyChildTableOp(doc, body, key[:len(key)-1], key[len(key)-1:], value); err != nil {
+				return err
+			}
+			continue
+		}
+
 		switch key {
 		case "serial", "has_serial_no":
 			if value == "on" || value == "1" || value == "true" {
 				body["has_serial_no"] = 1
-				fmt.Printf("  Serial Numbers: %senabled%s\n", Green, Reset)
+				printf("  Serial Numbers: %senabled%s\n", Green, Reset)
 			} else if value == "off" || value == "0" || value == "false" {
 				body["has_serial_no"] = 0
-				fmt.Printf("  Serial Numbers: %sdisabled%s\n", Yellow, Reset)
+				printf("  Serial Numbers: %sdisabled%s\n", Yellow, Reset)
 			} else {
-				return fmt.Errorf("invalid value for serial: use 'on' or 'off'")
+				return errorf("invalid value for serial: use 'on' or 'off'")
 			}
 
 		case "batch", "has_batch_no":
 			if value == "on" || value == "1" || value == "true" {
 				body["has_batch_no"] = 1
-				fmt.Printf("  Batch Numbers: %senabled%s\n", Green, Reset)
+				printf("  Batch Numbers: %senabled%s\n", Green, Reset)
 			} else if value == "off" || value == "0" || value == "false" {
 				body["has_batch_no"] = 0
-				fmt.Printf("  Batch Numbers: %sdisabled%s\n", Yellow, Reset)
+				printf("  Batch Numbers: %sdisabled%s\n", Yellow, Reset)
 			} else {
-				return fmt.Errorf("invalid value for batch: use 'on' or 'off'")
+				return errorf("invalid value for batch: use 'on' or 'off'")
 			}
 
 		case "serial-series", "serial_no_series":
 			body["serial_no_series"] = value
-			fmt.Printf("  Serial Series: %s%s%s\n", Cyan, value, Reset)
+			printf("  Serial Series: %s%s%s\n", Cyan, value, Reset)
 
 		case "stock", "is_stock_item":
 			if value == "on" || value == "1" || value == "true" {
 				body["is_stock_item"] = 1
-				fmt.Printf("  Stock Item: %senabled%s\n", Green, Reset)
+				printf("  Stock Item: %senabled%s\n", Green, Reset)
 			} else if value == "off" || value == "0" || value == "false" {
 				body["is_stock_item"] = 0
-				fmt.Printf("  Stock Item: %sdisabled%s\n", Yellow, Reset)
+				printf("  Stock Item: %sdisabled%s\n", Yellow, Reset)
 			} else {
-				return fmt.Errorf("invalid value for stock: use 'on' or 'off'")
+				return errorf("invalid value for stock: use 'on' or 'off'")
 			}
 
 		case "valuation", "valuation_method":
 			body["valuation_method"] = value
-			fmt.Printf("  Valuation Method: %s\n", value)
+			printf("  Valuation Method: %s\n", value)
 
 		case "warranty", "warranty_period":
 			body["warranty_period"] = value
-			fmt.Printf("  Warranty Period: %s days\n", value)
+			printf("  Warranty Period: %s days\n", value)
+
+		case "price", "buying_price", "buying-price":
+			rate, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return errorf("invalid price: %s", value)
+			}
+			list := c.sellingPriceList("")
+			if key != "price" {
+				list = c.buyingPriceList()
+			}
+			prices[list] = rate
+			printf("  Price (%s): %s\n", list, c.FormatCurrency(rate))
 
 		default:
 			body[key] = value
@@ -276,64 +403,308 @@ func (c *Client) itemSet(code string, settings []string) error {
 		}
 	}
 
-	if len(body) == 0 {
-		return fmt.Errorf("no valid settings provided")
+	if len(body) == 0 && len(prices) == 0 {
+		return errorf("no valid settings provided")
 	}
 
+	if len(body) > 0 {
+		encoded := url.PathEscape(code)
+		_, err := c.Request("PUT", "Item/"+encoded, body)
+		if err != nil {
+			return err
+		}
+	}
+
+	for list, rate := range prices {
+		if err := c.setItemPrice(code, list, rate); err != nil {
+			return err
+		}
+	}
+
+	printf("%s✓ Item updated: %s%s\n", Green, code, Reset)
+	return nil
+}
+
+// itemChildTables maps the names accepted by `item set table+=value` to the
+// Item child table, its key field, and how a value is turned into a row
+var itemChildTables = map[string]struct {
+	field string
+	key   string
+	row   func(value string) map[string]interface{}
+}{
+	"barcodes": {"barcodes", "barcode", func(v string) map[string]interface{} {
+		row := map[string]interface{}{"barcode": v}
+		if barcodeType, err := validateGTIN(v); err == nil && barcodeType != "GTIN" {
+			row["barcode_type"] = barcodeType
+		}
+		return row
+	}},
+	"suppliers": {"supplier_items", "supplier", func(v string) map[string]interface{} {
+		// Supplier[:part_no]
+		row := map[string]interface{}{"supplier": v}
+		if idx := strings.LastIndex(v, ":"); idx > 0 {
+			row["supplier"] = v[:idx]
+			row["supplier_part_no"] = v[idx+1:]
+		}
+		return row
+	}},
+	"taxes": {"taxes", "item_tax_template", func(v string) map[string]interface{} {
+		return map[string]interface{}{"item_tax_template": v}
+	}},
+}
+
+// applyChildTableOp adds (+) or removes (-) a row in an item child table.
+// Rows are taken from body if the table was already edited, otherwise from doc.
+func applyChildTableOp(doc, body map[string]interface{}, table, op, value string) error {
+	spec, ok := itemChildTables[table]
+	if !ok {
+		return errorf("unknown child table '%s' (use barcodes, suppliers or taxes)", table)
+	}
+
+	var rows []interface{}
+	if edited, ok := body[spec.field].([]interface{}); ok {
+		rows = edited
+	} else if existing, ok := doc[spec.field].([]interface{}); ok {
+		rows = existing
+	}
+
+	newRow := spec.row(value)
+	keyValue := newRow[spec.key]
+
+	var kept []interface{}
+	found := false
+	for _, r := range rows {
+		if rm, ok := r.(map[string]interface{}); ok && rm[spec.key] == keyValue {
+			found = true
+			if op == "-" {
+				continue
+			}
+			// Update existing row in place (e.g. new supplier part number)
+			for k, v := range newRow {
+				rm[k] = v
+			}
+		}
+		kept = append(kept, r)
+	}
+
+	if op == "-" {
+		if !found {
+			return errorf("%s not found in %s", keyValue, table)
+		}
+		fmt.Printf("  %s: %s-%s %v\n", table, Red, Reset, keyValue)
+	} else {
+		if !found {
+			kept = append(kept, newRow)
+		}
+		fmt.Printf("  %s: %s+%s %v\n", table, Green, Reset, keyValue)
+	}
+
+	if kept == nil {
+		kept = []interface{}{}
+	}
+	body[spec.field] = kept
+	return nil
+}
+
+// loadJSONPatch merges the top-level fields of a JSON object file into body
+// (JSON merge patch semantics: child tables given in the file replace the existing ones)
+func loadJSONPatch(path string, body map[string]interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return errorf("failed to read patch file: %w", err)
+	}
+
+	var patch map[string]interface{}
+	if err := json.Unmarshal(data, &patch); err != nil {
+		return errorf("invalid JSON in %s: %w", path, err)
+	}
+
+	for k, v := range patch {
+		body[k] = v
+		printf("  %s: (from %s)\n", k, path)
+	}
+	return nil
+}
+
+// setItemPrice creates or updates the Item Price of an item in a price list
+func (c *Client) setItemPrice(itemCode, priceList string, rate float64) error {
+	filters, err := encodeFilters([][]interface{}{
+		{"item_code", "=", itemCode},
+		{"price_list", "=", priceList},
+	})
+	if err != nil {
+		return err
+	}
+
+	result, err := c.Request("GET", "Item%20Price?fields=[\"name\"]&filters="+filters, nil)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].([]interface{}); ok && len(data) > 0 {
+		if m, ok := data[0].(map[string]interface{}); ok {
+			name := fmt.Sprintf("%v", m["name"])
+			_, err = c.Request("PUT", "Item%20Price/"+url.PathEscape(name), map[string]interface{}{"price_list_rate": rate})
+			return err
+		}
+	}
+
+	body := map[string]interface{}{
+		"item_code":       itemCode,
+		"price_list":      priceList,
+		"price_list_rate": rate,
+	}
+	_, err = c.Request("POST", "Item%20Price", body)
+	return err
+}
+
+// itemMapCustomerCode sets or removes a customer's own code for an item
+// (Item Customer Detail child table). Pass "--remove" as refCode to delete it.
+func (c *Client) itemMapCustomerCode(code, customer, refCode string) error {
 	encoded := url.PathEscape(code)
-	_, err := c.Request("PUT", "Item/"+encoded, body)
+	result, err := c.Request("GET", "Item/"+encoded, nil)
+	if err != nil {
+		return err
+	}
+
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return errorf("item not found: %s", code)
+	}
+
+	remove := refCode == "--remove"
+	var rows []map[string]interface{}
+	found := false
+	if existing, ok := data["customer_items"].([]interface{}); ok {
+		for _, r := range existing {
+			rm, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if rm["customer_name"] == customer {
+				found = true
+				if remove {
+					continue
+				}
+				rm["ref_code"] = refCode
+			}
+			rows = append(rows, rm)
+		}
+	}
+
+	if remove && !found {
+		return errorf("no customer code for %s on item %s", customer, code)
+	}
+	if !found {
+		rows = append(rows, map[string]interface{}{
+			"customer_name": customer,
+			"ref_code":      refCode,
+		})
+	}
+
+	if rows == nil {
+		rows = []map[string]interface{}{}
+	}
+	body := map[string]interface{}{
+		"customer_items": rows,
+	}
+
+	_, err = c.Request("PUT", "Item/"+encoded, body)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("%s✓ Item updated: %s%s\n", Green, code, Reset)
+	if remove {
+		printf("%s✓ Customer code removed: %s (%s)%s\n", Green, code, customer, Reset)
+	} else {
+		printf("%s✓ Customer code mapped: %s → %s (%s)%s\n", Green, refCode, code, customer, Reset)
+	}
 	return nil
 }
 
+// resolveCustomerItemCode looks up the item mapped to a customer's own code.
+// Returns the item code and the customer code, or the input unchanged if no mapping exists.
+func (c *Client) resolveCustomerItemCode(customer, code string) (string, string, error) {
+	if customer == "" {
+		return code, "", nil
+	}
+
+	filters, err := encodeFilters([][]interface{}{
+		{"Item Customer Detail", "customer_name", "=", customer},
+		{"Item Customer Detail", "ref_code", "=", code},
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	result, err := c.Request("GET", "Item?fields=[\"name\"]&filters="+filters, nil)
+	if err != nil {
+		return "", "", err
+	}
+
+	if data, ok := result["data"].([]interface{}); ok && len(data) > 0 {
+		if m, ok := data[0].(map[string]interface{}); ok {
+			if name, ok := m["name"].(string); ok {
+				return name, code, nil
+			}
+		}
+	}
+	return code, "", nil
+}
+
+// customerCodeSuffix formats the customer item code of a document line, if any
+func customerCodeSuffix(line map[string]interface{}) string {
+	if code, ok := line["customer_item_code"].(string); ok && code != "" {
+		return fmt.Sprintf(" [%s]", code)
+	}
+	return ""
+}
+
 // CmdTemplate handles template commands
 func (c *Client) CmdTemplate(args []string) error {
 	if len(args) == 0 {
-		fmt.Println("Usage: erp-cli template <subcommand> [args...]")
-		fmt.Println("Subcommands: create")
+		fmt.Println(T("Usage: erp-cli template <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: create"))
 		return nil
 	}
 
 	switch args[0] {
 	case "create":
 		if len(args) < 5 {
-			return fmt.Errorf("usage: erp-cli template create <code> <name> <group> <attr1> [attr2...]")
+			return errorf("usage: erp-cli template create <code> <name> <group> <attr1> [attr2...]")
 		}
 		return c.templateCreate(args[1], args[2], args[3], args[4:])
 	default:
-		return fmt.Errorf("unknown template subcommand: %s", args[0])
+		return errorf("unknown template subcommand: %s", args[0])
 	}
 }
 
-func (c *Client) templateCreate(code, name, group string, attrs []string) error {
-	fmt.Printf("%sCreating template: %s%s\n", Blue, code, Reset)
-	fmt.Printf("  Attributes: %s\n", strings.Join(attrs, ", "))
-
+// templateBody builds a new template Item with the given attributes
+func (c *Client) templateBody(code, name, group string, attrs []string) map[string]interface{} {
 	var attrList []map[string]string
 	for _, attr := range attrs {
 		attrList = append(attrList, map[string]string{"attribute": attr})
 	}
 
-	body := map[string]interface{}{
-		"item_code":     code,
-		"item_name":     name,
-		"item_group":    group,
-		"stock_uom":     "Unit",
-		"is_stock_item": 1,
-		"has_variants":  1,
-		"attributes":    attrList,
-	}
+	body := c.newItemBody()
+	body["item_code"] = code
+	body["item_name"] = name
+	body["item_group"] = group
+	body["has_variants"] = 1
+	body["attributes"] = attrList
+	return body
+}
+
+func (c *Client) templateCreate(code, name, group string, attrs []string) error {
+	printf("%sCreating template: %s%s\n", Blue, code, Reset)
+	printf("  Attributes: %s\n", strings.Join(attrs, ", "))
 
-	result, err := c.Request("POST", "Item", body)
+	result, err := c.Request("POST", "Item", c.templateBody(code, name, group, attrs))
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("%s✓ Template created: %s%s\n", Green, code, Reset)
+	printf("%s✓ Template created: %s%s\n", Green, code, Reset)
 
 	if data, ok := result["data"].(map[string]interface{}); ok {
 		output := map[string]interface{}{
@@ -352,17 +723,35 @@ func (c *Client) templateCreate(code, name, group string, attrs []string) error
 // CmdGroup handles group commands
 func (c *Client) CmdGroup(args []string) error {
 	if len(args) == 0 {
-		fmt.Println("Usage: erp-cli group <subcommand> [args...]")
-		fmt.Println("Subcommands: list, create")
+		fmt.Println(T("Usage: erp-cli group <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, tree, create, rename, delete"))
 		return nil
 	}
 
 	switch args[0] {
 	case "list":
 		return c.groupList()
+	case "tree":
+		return c.groupTree()
+	case "rename":
+		if len(args) < 3 {
+			return errorf("usage: erp-cli group rename <old> <new>")
+		}
+		return c.groupRename(args[1], args[2])
+	case "delete":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli group delete <name> [--cascade-check]")
+		}
+		cascadeCheck := false
+		for _, arg := range args[2:] {
+			if arg == "--cascade-check" {
+				cascadeCheck = true
+			}
+		}
+		return c.groupDelete(args[1], cascadeCheck)
 	case "create":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli group create <name> [parent]")
+			return errorf("usage: erp-cli group create <name> [parent]")
 		}
 		parent := "All Item Groups"
 		if len(args) > 2 {
@@ -370,12 +759,12 @@ func (c *Client) CmdGroup(args []string) error {
 		}
 		return c.groupCreate(args[1], parent)
 	default:
-		return fmt.Errorf("unknown group subcommand: %s", args[0])
+		return errorf("unknown group subcommand: %s", args[0])
 	}
 }
 
 func (c *Client) groupList() error {
-	fmt.Printf("%sFetching item groups...%s\n", Blue, Reset)
+	printf("%sFetching item groups...%s\n", Blue, Reset)
 
 	result, err := c.Request("GET", "Item%20Group?limit_page_length=0", nil)
 	if err != nil {
@@ -393,7 +782,7 @@ func (c *Client) groupList() error {
 }
 
 func (c *Client) groupCreate(name, parent string) error {
-	fmt.Printf("%sCreating item group: %s%s\n", Blue, name, Reset)
+	printf("%sCreating item group: %s%s\n", Blue, name, Reset)
 
 	body := map[string]interface{}{
 		"item_group_name":   name,
@@ -405,16 +794,134 @@ func (c *Client) groupCreate(name, parent string) error {
 		return err
 	}
 
-	fmt.Printf("%s✓ Group created: %s%s\n", Green, name, Reset)
-	fmt.Printf("  Parent: %s\n", parent)
+	printf("%s✓ Group created: %s%s\n", Green, name, Reset)
+	printf("  Parent: %s\n", parent)
+	return nil
+}
+
+// fetchGroupTree fetches all item groups and links them into a hierarchy
+func (c *Client) fetchGroupTree() ([]*treeNode, error) {
+	result, err := c.Request("GET", "Item%20Group?limit_page_length=0&fields=[\"name\",\"is_group\",\"parent_item_group\"]", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []*treeNode
+	if data, ok := result["data"].([]interface{}); ok {
+		for _, item := range data {
+			if m, ok := item.(map[string]interface{}); ok {
+				name, _ := m["name"].(string)
+				parent, _ := m["parent_item_group"].(string)
+				isGroup, _ := m["is_group"].(float64)
+				nodes = append(nodes, &treeNode{
+					name:    name,
+					parent:  parent,
+					isGroup: isGroup == 1,
+				})
+			}
+		}
+	}
+
+	return buildTree(nodes), nil
+}
+
+func (c *Client) groupTree() error {
+	printf("%sFetching item group hierarchy...%s\n", Blue, Reset)
+
+	roots, err := c.fetchGroupTree()
+	if err != nil {
+		return err
+	}
+
+	if len(roots) == 0 {
+		printf("%sNo item groups found%s\n", Yellow, Reset)
+		return nil
+	}
+
+	fmt.Println()
+	for _, line := range flattenTree(roots) {
+		icon := "📄"
+		if line.node.isGroup {
+			icon = "📁"
+		}
+		fmt.Printf("  %s%s %s\n", line.prefix, icon, line.node.name)
+	}
+	return nil
+}
+
+func (c *Client) groupRename(oldName, newName string) error {
+	printf("%sRenaming item group: %s → %s%s\n", Blue, oldName, newName, Reset)
+
+	if err := c.renameDocument("Item Group", oldName, newName); err != nil {
+		return err
+	}
+
+	printf("%s✓ Group renamed: %s%s\n", Green, newName, Reset)
+	return nil
+}
+
+func (c *Client) groupDelete(name string, cascadeCheck bool) error {
+	if cascadeCheck {
+		printf("%sChecking dependents of: %s%s\n", Blue, name, Reset)
+
+		roots, err := c.fetchGroupTree()
+		if err != nil {
+			return err
+		}
+
+		node := findTreeNode(roots, name)
+		if node == nil {
+			return errorf("item group not found: %s", name)
+		}
+
+		groups := subtreeNames(node)
+		filters, err := encodeFilters([][]interface{}{{"item_group", "in", groups}})
+		if err != nil {
+			return err
+		}
+
+		result, err := c.Request("GET", "Item?limit_page_length=0&fields=[\"name\",\"item_group\"]&filters="+filters, nil)
+		if err != nil {
+			return err
+		}
+
+		items, _ := result["data"].([]interface{})
+		subgroups := len(groups) - 1
+
+		if subgroups > 0 || len(items) > 0 {
+			printf("%sCannot delete %s:%s\n", Red, name, Reset)
+			if subgroups > 0 {
+				printf("  Subgroups: %d\n", subgroups)
+				for _, line := range flattenTree(node.children) {
+					fmt.Printf("    %s%s\n", line.prefix, line.node.name)
+				}
+			}
+			if len(items) > 0 {
+				printf("  Items: %d\n", len(items))
+			}
+			return errorf("item group %s has dependents; move or delete them first", name)
+		}
+
+		printf("  No subgroups or items\n")
+	}
+
+	printf("%sDeleting item group: %s%s\n", Blue, name, Reset)
+
+	encoded := url.PathEscape(name)
+	_, err := c.Request("DELETE", "Item%20Group/"+encoded, nil)
+	if err != nil {
+		return err
+	}
+
+	printf("%s✓ Group deleted: %s%s\n", Green, name, Reset)
 	return nil
 }
 
 // CmdBrand handles brand commands
 func (c *Client) CmdBrand(args []string) error {
 	if len(args) == 0 {
-		fmt.Println("Usage: erp-cli brand <subcommand> [args...]")
-		fmt.Println("Subcommands: list, create, add-to-attr")
+		fmt.Println(T("Usage: erp-cli brand <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, create, add-to-attr"))
 		return nil
 	}
 
@@ -423,21 +930,21 @@ func (c *Client) CmdBrand(args []string) error {
 		return c.brandList()
 	case "create":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli brand create <name>")
+			return errorf("usage: erp-cli brand create <name>")
 		}
 		return c.brandCreate(args[1])
 	case "add-to-attr":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli brand add-to-attr <name>")
+			return errorf("usage: erp-cli brand add-to-attr <name>")
 		}
 		return c.brandAddToAttr(args[1])
 	default:
-		return fmt.Errorf("unknown brand subcommand: %s", args[0])
+		return errorf("unknown brand subcommand: %s", args[0])
 	}
 }
 
 func (c *Client) brandList() error {
-	fmt.Printf("%sFetching brands...%s\n", Blue, Reset)
+	printf("%sFetching brands...%s\n", Blue, Reset)
 
 	result, err := c.Request("GET", "Brand?limit_page_length=0", nil)
 	if err != nil {
@@ -455,7 +962,7 @@ func (c *Client) brandList() error {
 }
 
 func (c *Client) brandCreate(name string) error {
-	fmt.Printf("%sCreating brand: %s%s\n", Blue, name, Reset)
+	printf("%sCreating brand: %s%s\n", Blue, name, Reset)
 
 	body := map[string]interface{}{
 		"brand": name,
@@ -466,11 +973,11 @@ func (c *Client) brandCreate(name string) error {
 		return err
 	}
 
-	fmt.Printf("%s✓ Brand created: %s%s\n", Green, name, Reset)
+	printf("%s✓ Brand created: %s%s\n", Green, name, Reset)
 	return nil
 }
 
 func (c *Client) brandAddToAttr(name string) error {
-	fmt.Printf("%sAdding brand to Brand attribute: %s%s\n", Blue, name, Reset)
+	printf("%sAdding brand to Brand attribute: %s%s\n", Blue, name, Reset)
 	return c.attrAddValues("Brand", []string{name + ":" + name})
 }
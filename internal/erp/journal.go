@@ -0,0 +1,449 @@
+package erp
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// CmdJE handles Journal Entry commands
+func (c *Client) CmdJE(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli je <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, create, submit, cancel"))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli je list"))
+		fmt.Println(T("  erp-cli je list --status=Draft --account=\"Cash - AC\""))
+		fmt.Println(T("  erp-cli je get ACC-JV-2025-00001"))
+		fmt.Println(T("  erp-cli je create --debit \"Rent - AC:1200\" --credit \"Cash - AC:1200\" --remark=\"May rent\""))
+		fmt.Println(T("  erp-cli je create --debit \"Debtors - AC:50\" --credit \"Write Off - AC:50\" --party=\"Acme Corp\""))
+		fmt.Println(T("  erp-cli je submit ACC-JV-2025-00001"))
+		fmt.Println(T("  erp-cli je cancel ACC-JV-2025-00001"))
+		return nil
+	}
+
+	switch args[0] {
+	case "list":
+		return c.jeList(parseJEListOptions(args[1:]))
+	case "get":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli je get <name> [--template=file.tmpl]")
+		}
+		if tmpl := parseTemplateFlag(args[2:]); tmpl != "" {
+			return c.renderDocTemplate("Journal Entry", args[1], tmpl)
+		}
+		return c.jeGet(args[1])
+	case "create":
+		opts, err := parseJEOptions(args[1:])
+		if err != nil {
+			return err
+		}
+		if len(opts.debits) == 0 || len(opts.credits) == 0 {
+			return errorf("usage: erp-cli je create --debit ACC:AMOUNT --credit ACC:AMOUNT [--party=X] [--remark=X]")
+		}
+		return c.jeCreate(opts)
+	case "submit":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli je submit <name>")
+		}
+		return c.jeSubmit(args[1])
+	case "cancel":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli je cancel <name>")
+		}
+		return c.jeCancel(args[1])
+	default:
+		return errorf("unknown je subcommand: %s", args[0])
+	}
+}
+
+// jeLine is one debit or credit given on the command line
+type jeLine struct {
+	account string
+	amount  float64
+}
+
+// jeOptions holds flags for je create
+type jeOptions struct {
+	debits    []jeLine
+	credits   []jeLine
+	party     string
+	partyType string // Empty: Customer or Supplier from the account type
+	remark    string
+	chequeNo  string
+	posting   postingOptions
+}
+
+// parseJELine splits ACC:AMOUNT at the last colon, since account names may
+// contain colons but amounts never do
+func parseJELine(value string) (jeLine, error) {
+	i := strings.LastIndex(value, ":")
+	if i <= 0 {
+		return jeLine{}, errorf("invalid line %q (expected ACCOUNT:AMOUNT)", value)
+	}
+	amount, err := strconv.ParseFloat(value[i+1:], 64)
+	if err != nil || amount <= 0 {
+		return jeLine{}, errorf("invalid amount in %q", value)
+	}
+	return jeLine{account: strings.TrimSpace(value[:i]), amount: amount}, nil
+}
+
+// parseJEOptions reads --debit and --credit (repeatable, as --debit=X or
+// --debit X) plus the entry-level flags
+func parseJEOptions(args []string) (jeOptions, error) {
+	opts := jeOptions{}
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		var side, value string
+		switch {
+		case arg == "--debit" || arg == "--credit":
+			if i+1 >= len(args) {
+				return opts, errorf("%s needs ACCOUNT:AMOUNT", arg)
+			}
+			side, value = arg[2:], args[i+1]
+			i++
+		case len(arg) > 8 && arg[:8] == "--debit=":
+			side, value = "debit", arg[8:]
+		case len(arg) > 9 && arg[:9] == "--credit=":
+			side, value = "credit", arg[9:]
+		case len(arg) > 8 && arg[:8] == "--party=":
+			opts.party = arg[8:]
+		case len(arg) > 13 && arg[:13] == "--party-type=":
+			opts.partyType = arg[13:]
+		case len(arg) > 9 && arg[:9] == "--remark=":
+			opts.remark = arg[9:]
+		case len(arg) > 6 && arg[:6] == "--ref=":
+			opts.chequeNo = arg[6:]
+		}
+		if side == "" {
+			continue
+		}
+
+		line, err := parseJELine(value)
+		if err != nil {
+			return opts, err
+		}
+		if side == "debit" {
+			opts.debits = append(opts.debits, line)
+		} else {
+			opts.credits = append(opts.credits, line)
+		}
+	}
+
+	posting, err := parsePostingOptions(args)
+	if err != nil {
+		return opts, err
+	}
+	opts.posting = posting
+	return opts, nil
+}
+
+// totals sums both sides rounded to cents
+func (o jeOptions) totals() (debit, credit float64) {
+	for _, l := range o.debits {
+		debit += l.amount
+	}
+	for _, l := range o.credits {
+		credit += l.amount
+	}
+	return math.Round(debit*100) / 100, math.Round(credit*100) / 100
+}
+
+// jeAccountInfo checks that an account can take journal rows for company
+// and returns its account type
+func (c *Client) jeAccountInfo(account, company string) (string, error) {
+	result, err := c.Request("GET", "Account/"+url.PathEscape(account), nil)
+	if err != nil {
+		return "", errorf("account not found: %s", account)
+	}
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return "", errorf("account not found: %s", account)
+	}
+	if isGroup, _ := data["is_group"].(float64); isGroup == 1 {
+		return "", errorf("%s is a group account; post to one of its children", account)
+	}
+	if accCompany := stringField(data, "company"); accCompany != "" && accCompany != company {
+		return "", errorf("account %s belongs to %s, not %s", account, accCompany, company)
+	}
+	return stringField(data, "account_type"), nil
+}
+
+func (c *Client) jeCreate(opts jeOptions) error {
+	debit, credit := opts.totals()
+	if debit != credit {
+		return errorf("journal entry does not balance: debits %s, credits %s (difference %s)",
+			c.FormatCurrency(debit), c.FormatCurrency(credit), c.FormatCurrency(math.Abs(debit-credit)))
+	}
+
+	company, err := c.GetCompany()
+	if err != nil {
+		return err
+	}
+
+	printf("%sCreating journal entry...%s\n", Blue, Reset)
+	c.printPosting(opts.posting)
+
+	var rows []map[string]interface{}
+	partyUsed := false
+	addRows := func(lines []jeLine, field string) error {
+		for _, l := range lines {
+			accountType, err := c.jeAccountInfo(l.account, company)
+			if err != nil {
+				return err
+			}
+			row := map[string]interface{}{
+				"account": l.account,
+				field:     l.amount,
+			}
+			// Receivable and payable rows must name the party they settle
+			if accountType == "Receivable" || accountType == "Payable" {
+				if opts.party == "" {
+					return errorf("%s is a %s account; pass --party=<name>", l.account, strings.ToLower(accountType))
+				}
+				partyType := opts.partyType
+				if partyType == "" {
+					partyType = "Customer"
+					if accountType == "Payable" {
+						partyType = "Supplier"
+					}
+				}
+				row["party_type"] = partyType
+				row["party"] = opts.party
+				partyUsed = true
+			}
+			rows = append(rows, row)
+		}
+		return nil
+	}
+	if err := addRows(opts.debits, "debit_in_account_currency"); err != nil {
+		return err
+	}
+	if err := addRows(opts.credits, "credit_in_account_currency"); err != nil {
+		return err
+	}
+	if opts.party != "" && !partyUsed {
+		printf("%sNote: no receivable or payable account, --party ignored%s\n", Yellow, Reset)
+	}
+
+	body := map[string]interface{}{
+		"voucher_type": "Journal Entry",
+		"company":      company,
+		"accounts":     rows,
+	}
+	if opts.remark != "" {
+		body["user_remark"] = opts.remark
+	}
+	if opts.chequeNo != "" {
+		body["cheque_no"] = opts.chequeNo
+		body["cheque_date"] = c.Today()
+		if opts.posting.date != "" {
+			body["cheque_date"] = opts.posting.date
+		}
+	}
+	if err := c.applyPosting(body, opts.posting, false); err != nil {
+		return err
+	}
+
+	result, err := c.Request("POST", "Journal%20Entry", body)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].(map[string]interface{}); ok {
+		name := data["name"]
+		printf("%s✓ Journal Entry created: %s%s\n", Green, name, Reset)
+		for _, l := range opts.debits {
+			printf("  Dr %-32s %s\n", l.account, c.FormatCurrency(l.amount))
+		}
+		for _, l := range opts.credits {
+			printf("  Cr %-32s %s\n", l.account, c.FormatCurrency(l.amount))
+		}
+		printf("  Total: %s\n", c.FormatCurrency(debit))
+		printf("  Status: Draft\n")
+		printf("  Use 'erp-cli je submit %s' to submit\n", name)
+	}
+	return nil
+}
+
+type jeListOptions struct {
+	status   string
+	account  string
+	xlsx     string // Output file for --xlsx
+	template string // --template file
+}
+
+func parseJEListOptions(args []string) jeListOptions {
+	opts := jeListOptions{}
+	for _, arg := range args {
+		if len(arg) > 9 && arg[:9] == "--status=" {
+			opts.status = arg[9:]
+		}
+		if len(arg) > 10 && arg[:10] == "--account=" {
+			opts.account = arg[10:]
+		}
+		if path, ok := parseXLSXFlag(arg, "journal-entries.xlsx"); ok {
+			opts.xlsx = path
+		}
+		if len(arg) > 11 && arg[:11] == "--template=" {
+			opts.template = arg[11:]
+		}
+	}
+	return opts
+}
+
+func (c *Client) jeList(opts jeListOptions) error {
+	filters := [][]interface{}{}
+	switch strings.ToLower(opts.status) {
+	case "":
+	case "draft":
+		filters = append(filters, []interface{}{"docstatus", "=", 0})
+	case "submitted":
+		filters = append(filters, []interface{}{"docstatus", "=", 1})
+	case "cancelled":
+		filters = append(filters, []interface{}{"docstatus", "=", 2})
+	default:
+		return errorf("invalid status: %s (use Draft, Submitted or Cancelled)", opts.status)
+	}
+	if opts.account != "" {
+		filters = append(filters, []interface{}{"Journal Entry Account", "account", "=", opts.account})
+	}
+
+	if opts.xlsx != "" {
+		return c.exportDocsXLSX("Journal Entry", filters, opts.xlsx)
+	}
+	if opts.template != "" {
+		return c.renderListTemplate("Journal Entry", filters, opts.template)
+	}
+	printf("%sFetching journal entries...%s\n", Blue, Reset)
+
+	endpoint := "Journal%20Entry?" + c.pageLimit(0) + "&fields=[\"name\",\"voucher_type\",\"posting_date\",\"total_debit\",\"user_remark\",\"docstatus\"]&order_by=creation%20desc"
+	if len(filters) > 0 {
+		encoded, err := encodeFilters(filters)
+		if err != nil {
+			return err
+		}
+		endpoint += "&filters=" + encoded
+	}
+
+	result, err := c.Request("GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].([]interface{}); ok {
+		data = uniqueDocs(data)
+		if len(data) == 0 {
+			printf("%sNo journal entries found%s\n", Yellow, Reset)
+			return nil
+		}
+
+		printf("\n%sJournal Entries (%d):%s\n", Cyan, len(data), Reset)
+		c.printPageNote(len(data))
+		for _, item := range data {
+			if m, ok := item.(map[string]interface{}); ok {
+				total, _ := m["total_debit"].(float64)
+				docstatus, _ := m["docstatus"].(float64)
+				status, statusColor := docStatusLabel(docstatus)
+
+				fmt.Printf("  %s - %s\n", m["name"], m["voucher_type"])
+				printf("    Date: %s | Status: %s%s%s | Total: %s\n",
+					m["posting_date"], statusColor, status, Reset, c.FormatCurrency(total))
+				if remark := stringField(m, "user_remark"); remark != "" {
+					fmt.Printf("    %s\n", remark)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// docStatusLabel names a docstatus and the colour to print it in
+func docStatusLabel(docstatus float64) (string, string) {
+	switch docstatus {
+	case 1:
+		return "Submitted", Green
+	case 2:
+		return "Cancelled", Red
+	}
+	return "Draft", Yellow
+}
+
+func (c *Client) jeGet(name string) error {
+	printf("%sFetching journal entry: %s%s\n", Blue, name, Reset)
+
+	result, err := c.Request("GET", "Journal%20Entry/"+url.PathEscape(name), nil)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].(map[string]interface{}); ok {
+		docstatus, _ := data["docstatus"].(float64)
+		status, statusColor := docStatusLabel(docstatus)
+
+		printf("\n%sJournal Entry: %s%s\n", Cyan, name, Reset)
+		printf("  Type: %s\n", data["voucher_type"])
+		printf("  Company: %s\n", data["company"])
+		printf("  Date: %s\n", data["posting_date"])
+		printf("  Status: %s%s%s\n", statusColor, status, Reset)
+		if ref := stringField(data, "cheque_no"); ref != "" {
+			printf("  Reference: %s (%s)\n", ref, data["cheque_date"])
+		}
+		if remark := stringField(data, "user_remark"); remark != "" {
+			printf("  Remark: %s\n", remark)
+		}
+
+		if rows, ok := data["accounts"].([]interface{}); ok && len(rows) > 0 {
+			printf("\n  %sAccounts:%s\n", Yellow, Reset)
+			for _, row := range rows {
+				r, ok := row.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				debit, _ := r["debit_in_account_currency"].(float64)
+				credit, _ := r["credit_in_account_currency"].(float64)
+				side, amount := "Dr", debit
+				if credit > 0 {
+					side, amount = "Cr", credit
+				}
+				fmt.Printf("    %s %-32s %s", side, r["account"], c.FormatCurrency(amount))
+				if party := stringField(r, "party"); party != "" {
+					fmt.Printf("  (%s: %s)", r["party_type"], party)
+				}
+				fmt.Println()
+			}
+		}
+
+		totalDebit, _ := data["total_debit"].(float64)
+		totalCredit, _ := data["total_credit"].(float64)
+		printf("\n  Total Debit: %s | Total Credit: %s\n", c.FormatCurrency(totalDebit), c.FormatCurrency(totalCredit))
+	}
+	return nil
+}
+
+func (c *Client) jeSubmit(name string) error {
+	printf("%sSubmitting journal entry: %s%s\n", Blue, name, Reset)
+
+	err := c.submitDocument("Journal Entry", name)
+	if err != nil {
+		return err
+	}
+
+	printf("%s✓ Journal Entry submitted: %s%s\n", Green, name, Reset)
+	return nil
+}
+
+func (c *Client) jeCancel(name string) error {
+	printf("%sCancelling journal entry: %s%s\n", Blue, name, Reset)
+
+	err := c.cancelDocument("Journal Entry", name)
+	if err != nil {
+		return err
+	}
+
+	printf("%s✓ Journal Entry cancelled: %s%s\n", Green, name, Reset)
+	return nil
+}
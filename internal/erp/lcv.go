@@ -0,0 +1,362 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// lcvCharge is one cost added on top of the received goods, e.g. freight
+type lcvCharge struct {
+	description string
+	amount      float64
+}
+
+// lcvCreateOptions holds flags for lcv create
+type lcvCreateOptions struct {
+	receipts   []string // Purchase Receipts the charges apply to
+	charges    []lcvCharge
+	distribute string // "Amount" or "Qty"
+	account    string // Defaults to the company's Expenses Included In Valuation
+	posting    postingOptions
+	submit     bool
+}
+
+// CmdLCV handles Landed Cost Voucher commands
+func (c *Client) CmdLCV(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli lcv <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, create, submit"))
+		fmt.Println()
+		fmt.Println(T("A landed cost voucher adds freight, duty and similar charges to the"))
+		fmt.Println(T("valuation of the items of submitted Purchase Receipts. Charges are"))
+		fmt.Println(T("spread over the items by amount (default) or by quantity."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli lcv create --pr PREC-00001 --charge \"Freight:250\" --charge \"Customs:800\""))
+		fmt.Println(T("  erp-cli lcv create --pr PREC-00001 --pr PREC-00002 --charge \"Freight:400\" --distribute=qty --submit"))
+		fmt.Println(T("  erp-cli lcv create --pr PREC-00001 --charge \"Duty:120\" --account=\"Customs Duty - XX\""))
+		fmt.Println(T("  erp-cli lcv submit MAT-LCV-2026-00001"))
+		fmt.Println(T("  erp-cli lcv list --pr=PREC-00001"))
+		fmt.Println(T("  erp-cli lcv get MAT-LCV-2026-00001"))
+		return nil
+	}
+
+	switch args[0] {
+	case "list":
+		receipt := ""
+		for _, arg := range args[1:] {
+			if len(arg) > 5 && arg[:5] == "--pr=" {
+				receipt = arg[5:]
+			}
+		}
+		return c.lcvList(receipt)
+	case "get":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli lcv get <name>")
+		}
+		return c.lcvGet(args[1])
+	case "create":
+		opts, err := parseLCVCreateOptions(args[1:])
+		if err != nil {
+			return err
+		}
+		return c.lcvCreate(opts)
+	case "submit":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli lcv submit <name>")
+		}
+		return c.lcvSubmit(args[1])
+	default:
+		return errorf("unknown lcv subcommand: %s", args[0])
+	}
+}
+
+// parseLCVCreateOptions reads the lcv create flags; --pr and --charge are
+// repeatable and take their value either after "=" or as the next argument
+func parseLCVCreateOptions(args []string) (lcvCreateOptions, error) {
+	opts := lcvCreateOptions{distribute: "Amount"}
+	usage := errorf("usage: erp-cli lcv create --pr <receipt> --charge \"Description:amount\" [...] [--distribute=amount|qty] [--account=X] [--posting-date=YYYY-MM-DD] [--submit]")
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		flag, value, hasValue := strings.Cut(arg, "=")
+		if !hasValue && (flag == "--pr" || flag == "--charge") {
+			if i+1 >= len(args) {
+				return opts, errorf("%s needs a value", flag)
+			}
+			value = args[i+1]
+			i++
+		}
+
+		switch {
+		case flag == "--pr":
+			opts.receipts = append(opts.receipts, value)
+		case flag == "--charge":
+			description, amount, ok := strings.Cut(value, ":")
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(amount), 64)
+			if !ok || strings.TrimSpace(description) == "" || err != nil || parsed <= 0 {
+				return opts, errorf("invalid charge %q (expected Description:amount)", value)
+			}
+			opts.charges = append(opts.charges, lcvCharge{strings.TrimSpace(description), parsed})
+		case flag == "--distribute" && hasValue:
+			switch strings.ToLower(value) {
+			case "amount":
+				opts.distribute = "Amount"
+			case "qty":
+				opts.distribute = "Qty"
+			default:
+				return opts, errorf("invalid --distribute (expected amount or qty): %s", value)
+			}
+		case flag == "--account" && hasValue:
+			opts.account = value
+		case flag == "--posting-date" && hasValue:
+			posting, err := parsePostingOptions([]string{arg})
+			if err != nil {
+				return opts, err
+			}
+			opts.posting = posting
+		case arg == "--submit":
+			opts.submit = true
+		default:
+			return opts, errorf("unknown argument: %s", arg)
+		}
+	}
+
+	if len(opts.receipts) == 0 || len(opts.charges) == 0 {
+		return opts, usage
+	}
+	return opts, nil
+}
+
+func (c *Client) lcvCreate(opts lcvCreateOptions) error {
+	printf("%sCreating landed cost voucher for: %s%s\n", Blue, strings.Join(opts.receipts, ", "), Reset)
+
+	company, err := c.GetCompany()
+	if err != nil {
+		return err
+	}
+
+	account := opts.account
+	if account == "" {
+		result, err := c.Request("GET", "Company/"+url.PathEscape(company), nil)
+		if err == nil {
+			if data, ok := result["data"].(map[string]interface{}); ok {
+				account = stringField(data, "expenses_included_in_valuation")
+			}
+		}
+		if account == "" {
+			return errorf("company %s has no Expenses Included In Valuation account: pass --account=X", company)
+		}
+	}
+
+	var receipts, items []map[string]interface{}
+	for _, name := range opts.receipts {
+		result, err := c.Request("GET", "Purchase%20Receipt/"+url.PathEscape(name), nil)
+		if err != nil {
+			return err
+		}
+		pr, ok := result["data"].(map[string]interface{})
+		if !ok {
+			return errorf("purchase receipt not found: %s", name)
+		}
+		if docStatus, _ := pr["docstatus"].(float64); docStatus != 1 {
+			return errorf("purchase receipt must be submitted first: %s", name)
+		}
+
+		grandTotal, _ := pr["grand_total"].(float64)
+		receipts = append(receipts, map[string]interface{}{
+			"receipt_document_type": "Purchase Receipt",
+			"receipt_document":      name,
+			"supplier":              pr["supplier"],
+			"posting_date":          pr["posting_date"],
+			"grand_total":           grandTotal,
+		})
+
+		// The web form fills these in with "Get Items From Purchase Receipts"
+		rows, _ := pr["items"].([]interface{})
+		for _, row := range rows {
+			m, ok := row.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			items = append(items, map[string]interface{}{
+				"item_code":             m["item_code"],
+				"description":           m["description"],
+				"qty":                   m["qty"],
+				"rate":                  m["base_rate"],
+				"amount":                m["base_amount"],
+				"receipt_document_type": "Purchase Receipt",
+				"receipt_document":      name,
+				"purchase_receipt_item": m["name"],
+				"cost_center":           m["cost_center"],
+			})
+		}
+	}
+
+	var taxes []map[string]interface{}
+	total := 0.0
+	for _, charge := range opts.charges {
+		taxes = append(taxes, map[string]interface{}{
+			"description":     charge.description,
+			"expense_account": account,
+			"amount":          charge.amount,
+		})
+		total += charge.amount
+	}
+
+	body := map[string]interface{}{
+		"company":                     company,
+		"distribute_charges_based_on": opts.distribute,
+		"purchase_receipts":           receipts,
+		"items":                       items,
+		"taxes":                       taxes,
+	}
+	if err := c.applyPosting(body, opts.posting, false); err != nil {
+		return err
+	}
+
+	result, err := c.Request("POST", "Landed%20Cost%20Voucher", body)
+	if err != nil {
+		return err
+	}
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return errorf("unexpected response creating landed cost voucher")
+	}
+	name := stringField(data, "name")
+
+	printf("%s✓ Landed Cost Voucher created: %s%s\n", Green, name, Reset)
+	printf("  Charges: %s (by %s, to %s)\n", c.FormatCurrency(total), strings.ToLower(opts.distribute), account)
+	c.printLCVItems(data)
+
+	if !opts.submit {
+		printf("  Status: Draft\n")
+		printf("  Use 'erp-cli lcv submit %s' to add the charges to the valuation\n", name)
+		return nil
+	}
+	if err := c.submitDocument("Landed Cost Voucher", name); err != nil {
+		return err
+	}
+	printf("%s✓ Landed Cost Voucher submitted: %s%s\n", Green, name, Reset)
+	return nil
+}
+
+// printLCVItems prints how a voucher spreads its charges over the items
+func (c *Client) printLCVItems(data map[string]interface{}) {
+	items, _ := data["items"].([]interface{})
+	if len(items) == 0 {
+		return
+	}
+	printf("\n  %sItems:%s\n", Yellow, Reset)
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		qty, _ := m["qty"].(float64)
+		amount, _ := m["amount"].(float64)
+		charges, _ := m["applicable_charges"].(float64)
+		line := fmt.Sprintf("    - %s: %g, %s + %s%s%s", stringField(m, "item_code"), qty, c.FormatCurrency(amount), Green, c.FormatCurrency(charges), Reset)
+		if qty > 0 && charges > 0 {
+			line += tf(" (%s per unit)", c.FormatCurrency(charges/qty))
+		}
+		fmt.Println(line)
+	}
+}
+
+func (c *Client) lcvSubmit(name string) error {
+	printf("%sSubmitting landed cost voucher: %s%s\n", Blue, name, Reset)
+
+	if err := c.submitDocument("Landed Cost Voucher", name); err != nil {
+		return err
+	}
+
+	printf("%s✓ Landed Cost Voucher submitted: %s%s\n", Green, name, Reset)
+	return nil
+}
+
+func (c *Client) lcvList(receipt string) error {
+	printf("%sFetching landed cost vouchers...%s\n", Blue, Reset)
+
+	endpoint := "Landed%20Cost%20Voucher?" + c.pageLimit(0) + "&fields=[\"name\",\"posting_date\",\"total_taxes_and_charges\",\"distribute_charges_based_on\",\"docstatus\"]&order_by=creation%20desc"
+	if receipt != "" {
+		filters, err := encodeFilters([][]interface{}{{"Landed Cost Purchase Receipt", "receipt_document", "=", receipt}})
+		if err != nil {
+			return err
+		}
+		endpoint += "&filters=" + filters
+	}
+
+	result, err := c.Request("GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	data, _ := result["data"].([]interface{})
+	if len(data) == 0 {
+		printf("%sNo landed cost vouchers found%s\n", Yellow, Reset)
+		return nil
+	}
+
+	printf("\n%sLanded Cost Vouchers (%d):%s\n", Cyan, len(data), Reset)
+	c.printPageNote(len(data))
+	for _, row := range data {
+		m, ok := row.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		status, statusColor := "Draft", Yellow
+		switch docStatus, _ := m["docstatus"].(float64); docStatus {
+		case 1:
+			status, statusColor = "Submitted", Green
+		case 2:
+			status, statusColor = "Cancelled", Red
+		}
+		total, _ := m["total_taxes_and_charges"].(float64)
+		fmt.Printf("  %s - %s\n", stringField(m, "name"), stringField(m, "posting_date"))
+		printf("    Charges: %s by %s | Status: %s%s%s\n",
+			c.FormatCurrency(total), strings.ToLower(stringField(m, "distribute_charges_based_on")), statusColor, status, Reset)
+	}
+	return nil
+}
+
+func (c *Client) lcvGet(name string) error {
+	printf("%sFetching landed cost voucher: %s%s\n", Blue, name, Reset)
+
+	result, err := c.Request("GET", "Landed%20Cost%20Voucher/"+url.PathEscape(name), nil)
+	if err != nil {
+		return err
+	}
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return errorf("landed cost voucher not found")
+	}
+
+	printf("\n%sLanded Cost Voucher: %s%s\n", Cyan, name, Reset)
+	printf("  Date: %s\n", stringField(data, "posting_date"))
+	printf("  Distributed By: %s\n", stringField(data, "distribute_charges_based_on"))
+	total, _ := data["total_taxes_and_charges"].(float64)
+	printf("  Total Charges: %s\n", c.FormatCurrency(total))
+
+	if receipts, ok := data["purchase_receipts"].([]interface{}); ok && len(receipts) > 0 {
+		printf("\n  %sReceipts:%s\n", Yellow, Reset)
+		for _, r := range receipts {
+			if m, ok := r.(map[string]interface{}); ok {
+				fmt.Printf("    - %s %s (%s)\n", stringField(m, "receipt_document_type"), stringField(m, "receipt_document"), stringField(m, "supplier"))
+			}
+		}
+	}
+	if taxes, ok := data["taxes"].([]interface{}); ok && len(taxes) > 0 {
+		printf("\n  %sCharges:%s\n", Yellow, Reset)
+		for _, t := range taxes {
+			if m, ok := t.(map[string]interface{}); ok {
+				amount, _ := m["amount"].(float64)
+				fmt.Printf("    - %s: %s (%s)\n", stringField(m, "description"), c.FormatCurrency(amount), stringField(m, "expense_account"))
+			}
+		}
+	}
+	c.printLCVItems(data)
+	return nil
+}
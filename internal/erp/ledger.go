@@ -0,0 +1,301 @@
+package erp
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ledgerOptions holds flags for gl and account balance
+type ledgerOptions struct {
+	from      string // YYYY-MM-DD, default first day of the month
+	to        string // YYYY-MM-DD, default today
+	party     string
+	partyType string // Empty: looked up as Customer, then Supplier
+}
+
+func parseLedgerOptions(args []string) (ledgerOptions, error) {
+	opts := ledgerOptions{}
+	for i, arg := range args {
+		if arg == "--from" && i+1 < len(args) {
+			opts.from = args[i+1]
+		}
+		if len(arg) > 7 && arg[:7] == "--from=" {
+			opts.from = arg[7:]
+		}
+		if arg == "--to" && i+1 < len(args) {
+			opts.to = args[i+1]
+		}
+		if len(arg) > 5 && arg[:5] == "--to=" {
+			opts.to = arg[5:]
+		}
+		if len(arg) > 7 && arg[:7] == "--date=" {
+			opts.to = arg[7:]
+		}
+		if len(arg) > 8 && arg[:8] == "--party=" {
+			opts.party = arg[8:]
+		}
+		if len(arg) > 13 && arg[:13] == "--party-type=" {
+			opts.partyType = arg[13:]
+		}
+	}
+
+	for _, date := range []string{opts.from, opts.to} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return opts, errorf("invalid date (expected YYYY-MM-DD): %s", date)
+		}
+	}
+	return opts, nil
+}
+
+// ledgerEntry is one posting row of the General Ledger report
+type ledgerEntry struct {
+	date        string
+	voucherType string
+	voucherNo   string
+	partyType   string
+	party       string
+	debit       float64
+	credit      float64
+	remarks     string
+}
+
+// ledger is the General Ledger of one account over a date range
+type ledger struct {
+	account string
+	from    string
+	to      string
+	opening float64 // Debit minus credit before from
+	entries []ledgerEntry
+}
+
+// totals sums the debits and credits within the range
+func (l *ledger) totals() (debit, credit float64) {
+	for _, e := range l.entries {
+		debit += e.debit
+		credit += e.credit
+	}
+	return debit, credit
+}
+
+// closing is the balance (debit minus credit) at the end of the range
+func (l *ledger) closing() float64 {
+	debit, credit := l.totals()
+	return l.opening + debit - credit
+}
+
+// partyTypeOf finds whether a party is a Customer or a Supplier
+func (c *Client) partyTypeOf(party string) (string, error) {
+	for _, partyType := range []string{"Customer", "Supplier"} {
+		if _, err := c.Request("GET", partyType+"/"+url.PathEscape(party), nil); err == nil {
+			return partyType, nil
+		}
+	}
+	return "", errorf("no customer or supplier named %s (use --party-type=X for other party types)", party)
+}
+
+// fetchLedger loads the General Ledger of account through the query report,
+// so balances match what ERPNext shows for the same filters
+func (c *Client) fetchLedger(account string, opts ledgerOptions) (*ledger, error) {
+	company, err := c.GetCompany()
+	if err != nil {
+		return nil, err
+	}
+
+	l := &ledger{account: account, from: opts.from, to: opts.to}
+	if l.to == "" {
+		l.to = c.Today()
+	}
+	if l.from == "" {
+		l.from = l.to[:8] + "01"
+	}
+	if l.from > l.to {
+		return nil, errorf("--from %s is after --to %s", l.from, l.to)
+	}
+
+	filters := map[string]interface{}{
+		"company":   company,
+		"from_date": l.from,
+		"to_date":   l.to,
+		"account":   []string{account},
+		"group_by":  "Group by Voucher (Consolidated)",
+	}
+	if opts.party != "" {
+		partyType := opts.partyType
+		if partyType == "" {
+			partyType, err = c.partyTypeOf(opts.party)
+			if err != nil {
+				return nil, err
+			}
+		}
+		filters["party_type"] = partyType
+		filters["party"] = []string{opts.party}
+	}
+
+	rows, err := c.runQueryReport("General Ledger", filters)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		debit, _ := row["debit"].(float64)
+		credit, _ := row["credit"].(float64)
+
+		// Summary rows carry a quoted label in the account column
+		switch strings.Trim(stringField(row, "account"), "'") {
+		case "Opening":
+			l.opening = debit - credit
+			continue
+		case "Total", "Closing (Opening + Total)":
+			continue
+		}
+		if stringField(row, "voucher_no") == "" {
+			continue
+		}
+
+		l.entries = append(l.entries, ledgerEntry{
+			date:        stringField(row, "posting_date"),
+			voucherType: stringField(row, "voucher_type"),
+			voucherNo:   stringField(row, "voucher_no"),
+			partyType:   stringField(row, "party_type"),
+			party:       stringField(row, "party"),
+			debit:       debit,
+			credit:      credit,
+			remarks:     stringField(row, "remarks"),
+		})
+	}
+	return l, nil
+}
+
+// formatBalance shows a debit-minus-credit balance with its side
+func (c *Client) formatBalance(balance float64) string {
+	if math.Abs(balance) < 0.005 {
+		return c.FormatCurrency(0)
+	}
+	if balance < 0 {
+		return c.FormatCurrency(-balance) + " Cr"
+	}
+	return c.FormatCurrency(balance) + " Dr"
+}
+
+// CmdGL shows the General Ledger of an account
+func (c *Client) CmdGL(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli gl <account> [--from=YYYY-MM-DD] [--to=YYYY-MM-DD] [--party=X]"))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli gl \"Cash - AC\""))
+		fmt.Println(T("  erp-cli gl \"Cash - AC\" --from=2025-01-01 --to=2025-03-31"))
+		fmt.Println(T("  erp-cli gl \"Debtors - AC\" --party=\"Acme Corp\""))
+		return nil
+	}
+
+	opts, err := parseLedgerOptions(args[1:])
+	if err != nil {
+		return err
+	}
+
+	printf("%sFetching general ledger: %s%s\n", Blue, args[0], Reset)
+	l, err := c.fetchLedger(args[0], opts)
+	if err != nil {
+		return err
+	}
+
+	title := l.account
+	if opts.party != "" {
+		title += " / " + opts.party
+	}
+	printf("\n%sGeneral Ledger: %s (%s to %s)%s\n", Cyan, title, l.from, l.to, Reset)
+	fmt.Printf("  %-10s  %-36s %14s %14s %16s\n", T("Date"), T("Voucher"), T("Debit"), T("Credit"), T("Balance"))
+	fmt.Printf("  %-10s  %-36s %14s %14s %16s\n", "", T("Opening"), "", "", c.formatBalance(l.opening))
+
+	if len(l.entries) == 0 {
+		printf("  %sNo ledger entries in this period%s\n", Yellow, Reset)
+	}
+
+	balance := l.opening
+	for _, e := range l.entries {
+		balance += e.debit - e.credit
+		debit, credit := "", ""
+		if e.debit != 0 {
+			debit = c.FormatCurrency(e.debit)
+		}
+		if e.credit != 0 {
+			credit = c.FormatCurrency(e.credit)
+		}
+		voucher := e.voucherNo
+		if len(voucher) > 36 {
+			voucher = voucher[:33] + "..."
+		}
+		fmt.Printf("  %-10s  %-36s %14s %14s %16s\n", e.date, voucher, debit, credit, c.formatBalance(balance))
+
+		detail := e.voucherType
+		if e.party != "" && opts.party == "" {
+			detail += " | " + e.party
+		}
+		fmt.Printf("  %-10s  %s\n", "", detail)
+	}
+
+	debit, credit := l.totals()
+	fmt.Printf("  %-10s  %-36s %14s %14s\n", "", T("Total"), c.FormatCurrency(debit), c.FormatCurrency(credit))
+	fmt.Printf("  %-10s  %-36s %14s %14s %16s\n", "", T("Closing"), "", "", c.formatBalance(l.closing()))
+	return nil
+}
+
+// CmdAccount handles account commands
+func (c *Client) CmdAccount(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli account <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: balance"))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli account balance \"Cash - AC\""))
+		fmt.Println(T("  erp-cli account balance \"Debtors - AC\" --date=2025-03-31 --party=\"Acme Corp\""))
+		return nil
+	}
+
+	switch args[0] {
+	case "balance":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli account balance <account> [--date=YYYY-MM-DD] [--party=X]")
+		}
+		opts, err := parseLedgerOptions(args[2:])
+		if err != nil {
+			return err
+		}
+		return c.accountBalance(args[1], opts)
+	default:
+		return errorf("unknown account subcommand: %s", args[0])
+	}
+}
+
+// accountBalance prints the closing balance of an account on a date. The
+// ledger is read for that single day: its opening covers everything before.
+func (c *Client) accountBalance(account string, opts ledgerOptions) error {
+	printf("%sFetching balance: %s%s\n", Blue, account, Reset)
+
+	if opts.to == "" {
+		opts.to = c.Today()
+	}
+	opts.from = opts.to
+	l, err := c.fetchLedger(account, opts)
+	if err != nil {
+		return err
+	}
+
+	printf("\n%sAccount: %s%s\n", Cyan, account, Reset)
+	if opts.party != "" {
+		printf("  Party: %s\n", opts.party)
+	}
+	printf("  Balance on %s: %s\n", l.to, c.formatBalance(l.closing()))
+	if len(l.entries) > 0 {
+		debit, credit := l.totals()
+		printf("  Posted that day: %s debit | %s credit\n", c.FormatCurrency(debit), c.FormatCurrency(credit))
+	}
+	return nil
+}
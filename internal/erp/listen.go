@@ -0,0 +1,425 @@
+package erp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// listenEvents are the document events listen subscribes to, as Frappe
+// Webhooks name them. Polling can only see the first four; deletions need
+// a webhook.
+var listenEvents = []string{"after_insert", "on_update", "on_submit", "on_cancel", "on_trash"}
+
+// listenEvent is one --event: a doctype and one of listenEvents
+type listenEvent struct {
+	doctype string
+	event   string
+}
+
+type listenOptions struct {
+	events   []listenEvent
+	exec     string        // Command run for every event, the event JSON on stdin
+	interval time.Duration // Polling interval (default: 30s)
+	webhook  string        // Public URL of the local listener; polls when empty
+	port     int           // Local listener port (default: 8765)
+}
+
+func parseListenOptions(args []string) (listenOptions, error) {
+	opts := listenOptions{interval: 30 * time.Second, port: 8765}
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		value := ""
+		switch {
+		case arg == "--event" || arg == "--exec":
+			if i+1 >= len(args) {
+				return opts, errorf("%s needs a value", arg)
+			}
+			value = args[i+1]
+			i++
+		case len(arg) > 8 && arg[:8] == "--event=":
+			arg, value = "--event", arg[8:]
+		case len(arg) > 7 && arg[:7] == "--exec=":
+			arg, value = "--exec", arg[7:]
+		case len(arg) > 11 && arg[:11] == "--interval=":
+			interval, err := time.ParseDuration(arg[11:])
+			if err != nil || interval < time.Second {
+				return opts, errorf("invalid --interval (e.g. 30s, 5m): %s", arg[11:])
+			}
+			opts.interval = interval
+		case len(arg) > 10 && arg[:10] == "--webhook=":
+			opts.webhook = strings.TrimRight(arg[10:], "/")
+		case len(arg) > 7 && arg[:7] == "--port=":
+			port, err := strconv.Atoi(arg[7:])
+			if err != nil || port < 1 || port > 65535 {
+				return opts, errorf("invalid --port: %s", arg[7:])
+			}
+			opts.port = port
+		}
+
+		switch arg {
+		case "--event":
+			ev, err := parseListenEvent(value)
+			if err != nil {
+				return opts, err
+			}
+			opts.events = append(opts.events, ev)
+		case "--exec":
+			opts.exec = value
+		}
+	}
+
+	if len(opts.events) == 0 {
+		return opts, errorf("at least one --event \"Doctype:event\" is required")
+	}
+	if opts.webhook == "" {
+		for _, ev := range opts.events {
+			if ev.event == "on_trash" {
+				return opts, errorf("on_trash events need --webhook (deletions cannot be polled)")
+			}
+		}
+	}
+	return opts, nil
+}
+
+// parseListenEvent parses "Sales Order:on_submit". The doctype may be a
+// command name (so, si, ...) and the event defaults to on_update.
+func parseListenEvent(value string) (listenEvent, error) {
+	doctype, event := value, "on_update"
+	if i := strings.LastIndex(value, ":"); i >= 0 {
+		doctype, event = value[:i], value[i+1:]
+	}
+	ev := listenEvent{doctype: resolveDoctype(strings.TrimSpace(doctype)), event: strings.TrimSpace(event)}
+	if ev.doctype == "" {
+		return ev, errorf("invalid --event %q (use \"Doctype:event\")", value)
+	}
+	for _, known := range listenEvents {
+		if ev.event == known {
+			return ev, nil
+		}
+	}
+	return ev, errorf("unknown event %q (use one of: %s)", ev.event, strings.Join(listenEvents, ", "))
+}
+
+// CmdListen emits document events as JSON lines and runs a command for each
+func (c *Client) CmdListen(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli listen --event \"Doctype:event\" [--event ...] [--exec cmd]"))
+		fmt.Println(T("                      [--interval=30s] [--webhook=https://host:port --port=8765]"))
+		fmt.Println()
+		fmt.Println(T("Prints one JSON line per document event on stdout (status goes to stderr)"))
+		fmt.Println(T("and, with --exec, runs the command (through sh -c) with the event JSON on stdin and"))
+		fmt.Println(T("ERP_EVENT, ERP_DOCTYPE and ERP_NAME in its environment."))
+		fmt.Println()
+		printf("Events: %s\n", strings.Join(listenEvents, ", "))
+		fmt.Println()
+		fmt.Println(T("By default the server is polled for changes. With --webhook, Frappe Webhooks"))
+		fmt.Println(T("are registered to call a listener on --port instead (the URL must reach this"))
+		fmt.Println(T("machine from the server) and are removed again on Ctrl+C."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli listen --event \"Sales Order:on_submit\" --exec ./pick-list.sh"))
+		fmt.Println(T("  erp-cli listen --event so:on_submit --event dn:on_submit | jq -r .name"))
+		fmt.Println(T("  erp-cli listen --event \"Sales Order:on_submit\" --webhook=http://10.0.0.5:8765"))
+		return nil
+	}
+
+	opts, err := parseListenOptions(args)
+	if err != nil {
+		return err
+	}
+	if opts.webhook != "" {
+		return c.listenWebhook(opts)
+	}
+	return c.listenPoll(opts)
+}
+
+// emitEvent prints an event as a JSON line and runs the --exec command. A
+// failing command is reported and listening goes on.
+func (c *Client) emitEvent(opts listenOptions, ev listenEvent, doc map[string]interface{}) {
+	name := stringField(doc, "name")
+	line, err := json.Marshal(map[string]interface{}{
+		"event":    ev.event,
+		"doctype":  ev.doctype,
+		"name":     name,
+		"modified": doc["modified"],
+		"doc":      doc,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s⚠ %s %s: %s%s\n", Yellow, ev.doctype, name, err, Reset)
+		return
+	}
+	fmt.Println(string(line))
+
+	if opts.exec == "" {
+		return
+	}
+	// Through the shell, so quoted arguments and pipes work as typed
+	cmd := exec.Command("sh", "-c", opts.exec)
+	cmd.Stdin = strings.NewReader(string(line) + "\n")
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), "ERP_EVENT="+ev.event, "ERP_DOCTYPE="+ev.doctype, "ERP_NAME="+name)
+	if err := cmd.Run(); err != nil {
+		fmt.Fprint(os.Stderr, tf("%s⚠ %s failed for %s %s: %s%s\n", Yellow, opts.exec, ev.event, name, err, Reset))
+	}
+}
+
+// listenPoll polls each --event's doctype for documents modified since the
+// last poll. The cursor starts at the newest modified timestamp on the
+// server, so only changes made after listen starts are emitted and the
+// local clock does not matter.
+func (c *Client) listenPoll(opts listenOptions) error {
+	cursors := map[string]string{}
+	// statuses holds the last docstatus seen of each document ("Doctype/name"),
+	// starting with those already submitted or cancelled, so on_submit and
+	// on_cancel only fire when the docstatus changes to 1 or 2
+	statuses := map[string]float64{}
+	for _, ev := range opts.events {
+		if _, ok := cursors[ev.doctype]; ok {
+			continue
+		}
+		cursor, err := c.latestModified(ev.doctype)
+		if err != nil {
+			return err
+		}
+		cursors[ev.doctype] = cursor
+		if err := c.snapshotStatuses(ev.doctype, statuses); err != nil {
+			return err
+		}
+	}
+	fmt.Fprint(os.Stderr, tf("%sListening for %s (polling every %s, Ctrl+C to stop)%s\n", Blue, listenEventNames(opts.events), opts.interval, Reset))
+
+	for {
+		time.Sleep(opts.interval)
+		for doctype, cursor := range cursors {
+			docs, err := c.fetchModifiedSince(doctype, cursor)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%s⚠ %s: %s%s\n", Yellow, doctype, err, Reset)
+				continue
+			}
+			for _, doc := range docs {
+				key := doctype + "/" + stringField(doc, "name")
+				previous := statuses[key]
+				for _, ev := range opts.events {
+					if ev.doctype == doctype && pollMatches(ev.event, doc, cursor, previous) {
+						c.emitEvent(opts, ev, doc)
+					}
+				}
+				statuses[key], _ = doc["docstatus"].(float64)
+				if modified := stringField(doc, "modified"); modified > cursors[doctype] {
+					cursors[doctype] = modified
+				}
+			}
+		}
+	}
+}
+
+// pollMatches tells whether a document modified since the last poll is an
+// event: new documents were created since, and submits and cancels are
+// changes of the docstatus from the one last seen (previous)
+func pollMatches(event string, doc map[string]interface{}, since string, previous float64) bool {
+	docstatus, _ := doc["docstatus"].(float64)
+	switch event {
+	case "after_insert":
+		return stringField(doc, "creation") > since
+	case "on_submit":
+		return docstatus == 1 && previous == 0
+	case "on_cancel":
+		return docstatus == 2 && previous != 2
+	}
+	return true
+}
+
+// snapshotStatuses records the docstatus of the documents of a doctype
+// already submitted or cancelled when listen starts
+func (c *Client) snapshotStatuses(doctype string, statuses map[string]float64) error {
+	encoded, err := encodeFilters([][]interface{}{{"docstatus", ">", 0}})
+	if err != nil {
+		return err
+	}
+	endpoint := strings.ReplaceAll(doctype, " ", "%20") + "?fields=[\"name\",\"docstatus\"]&limit_page_length=0&filters=" + encoded
+	result, err := c.Request("GET", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", doctype, err)
+	}
+	data, _ := result["data"].([]interface{})
+	for _, d := range data {
+		if m, ok := d.(map[string]interface{}); ok {
+			statuses[doctype+"/"+stringField(m, "name")], _ = m["docstatus"].(float64)
+		}
+	}
+	return nil
+}
+
+// latestModified returns the newest modified timestamp of a doctype
+func (c *Client) latestModified(doctype string) (string, error) {
+	endpoint := strings.ReplaceAll(doctype, " ", "%20") + "?fields=[\"modified\"]&order_by=modified%20desc&limit_page_length=1"
+	result, err := c.Request("GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", doctype, err)
+	}
+	data, _ := result["data"].([]interface{})
+	if len(data) == 0 {
+		return "", nil
+	}
+	m, _ := data[0].(map[string]interface{})
+	return stringField(m, "modified"), nil
+}
+
+// fetchModifiedSince returns the documents of a doctype modified after a
+// timestamp, oldest first, with all their fields
+func (c *Client) fetchModifiedSince(doctype, since string) ([]map[string]interface{}, error) {
+	endpoint := strings.ReplaceAll(doctype, " ", "%20") + "?fields=[\"*\"]&order_by=modified%20asc&limit_page_length=0"
+	if since != "" {
+		encoded, err := encodeFilters([][]interface{}{{"modified", ">", since}})
+		if err != nil {
+			return nil, err
+		}
+		endpoint += "&filters=" + encoded
+	}
+	result, err := c.Request("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	data, _ := result["data"].([]interface{})
+	docs := make([]map[string]interface{}, 0, len(data))
+	for _, d := range data {
+		if m, ok := d.(map[string]interface{}); ok {
+			docs = append(docs, m)
+		}
+	}
+	return docs, nil
+}
+
+func listenEventNames(events []listenEvent) string {
+	names := make([]string, len(events))
+	for i, ev := range events {
+		names[i] = ev.doctype + ":" + ev.event
+	}
+	return strings.Join(names, ", ")
+}
+
+// listenWebhook registers a Frappe Webhook per --event that posts to a
+// local listener, and removes them when interrupted. The webhooks are
+// signed with a fresh secret so the listener ignores other callers.
+func (c *Client) listenWebhook(opts listenOptions) error {
+	secret := make([]byte, 24)
+	if _, err := rand.Read(secret); err != nil {
+		return errorf("failed to generate webhook secret: %w", err)
+	}
+	key := hex.EncodeToString(secret)
+
+	var hooks []string
+	removeHooks := func() {
+		for _, hook := range hooks {
+			if _, err := c.Request("DELETE", "Webhook/"+url.PathEscape(hook), nil); err != nil {
+				fmt.Fprint(os.Stderr, tf("%s⚠ Webhook %s not removed: %s%s\n", Yellow, hook, err, Reset))
+			}
+		}
+	}
+	for i, ev := range opts.events {
+		hook, err := c.createListenWebhook(ev, fmt.Sprintf("%s/%d", opts.webhook, i), key)
+		if err != nil {
+			removeHooks()
+			return err
+		}
+		hooks = append(hooks, hook)
+	}
+
+	mux := http.NewServeMux()
+	for i, ev := range opts.events {
+		ev := ev
+		mux.HandleFunc(fmt.Sprintf("/%d", i), func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			if err != nil || !validWebhookSignature(key, body, r.Header.Get("X-Frappe-Webhook-Signature")) {
+				http.Error(w, "forbidden", http.StatusForbidden)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			var payload map[string]interface{}
+			if err := json.Unmarshal(body, &payload); err != nil {
+				fmt.Fprint(os.Stderr, tf("%s⚠ Invalid webhook payload: %s%s\n", Yellow, err, Reset))
+				return
+			}
+			c.emitWebhookEvent(opts, ev, stringField(payload, "name"))
+		})
+	}
+	server := &http.Server{Addr: fmt.Sprintf(":%d", opts.port), Handler: mux}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	go func() {
+		<-interrupt
+		server.Close()
+	}()
+
+	fmt.Fprint(os.Stderr, tf("%sListening for %s on port %d (webhooks to %s, Ctrl+C to stop)%s\n", Blue, listenEventNames(opts.events), opts.port, opts.webhook, Reset))
+	err := server.ListenAndServe()
+	removeHooks()
+	if err != nil && err != http.ErrServerClosed {
+		return errorf("listener failed: %w", err)
+	}
+	fmt.Fprint(os.Stderr, tf("%s✓ Stopped, %d webhook(s) removed%s\n", Green, len(hooks), Reset))
+	return nil
+}
+
+// emitWebhookEvent emits the event of a webhook call with the document as
+// it is now, fetched through the API since webhooks only send its name
+func (c *Client) emitWebhookEvent(opts listenOptions, ev listenEvent, name string) {
+	doc := map[string]interface{}{"name": name}
+	if ev.event != "on_trash" {
+		result, err := c.Request("GET", strings.ReplaceAll(ev.doctype, " ", "%20")+"/"+url.PathEscape(name), nil)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s⚠ %s %s: %s%s\n", Yellow, ev.doctype, name, err, Reset)
+			return
+		}
+		if data, ok := result["data"].(map[string]interface{}); ok {
+			doc = data
+		}
+	}
+	c.emitEvent(opts, ev, doc)
+}
+
+// createListenWebhook registers a webhook that posts the document name as
+// JSON on an event and returns its name
+func (c *Client) createListenWebhook(ev listenEvent, requestURL, secret string) (string, error) {
+	body := map[string]interface{}{
+		"webhook_doctype":   ev.doctype,
+		"webhook_docevent":  ev.event,
+		"request_url":       requestURL,
+		"request_method":    "POST",
+		"request_structure": "JSON",
+		"webhook_json":      `{"name": "{{ doc.name }}"}`,
+		"enable_security":   1,
+		"webhook_secret":    secret,
+		"enabled":           1,
+	}
+	result, err := c.Request("POST", "Webhook", body)
+	if err != nil {
+		return "", errorf("failed to register webhook for %s:%s: %w", ev.doctype, ev.event, err)
+	}
+	data, _ := result["data"].(map[string]interface{})
+	return stringField(data, "name"), nil
+}
+
+// validWebhookSignature checks Frappe's base64 HMAC-SHA256 of the body
+func validWebhookSignature(secret string, body []byte, signature string) bool {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
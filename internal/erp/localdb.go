@@ -0,0 +1,650 @@
+package erp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Local snapshots mirror doctypes into ~/.erp-cli/snapshots/<site>/, one JSON
+// file per doctype, so list and get commands can run without the server
+// (--local). In local mode every document read is answered from the
+// snapshot: list filters, ordering and paging are applied here, and
+// anything else (server methods, writes) fails.
+
+// defaultSnapshotDoctypes are pulled when ERP_SNAPSHOT_DOCTYPES is not set:
+// the catalog, stock levels and parties
+var defaultSnapshotDoctypes = []string{
+	"Company", "Item", "Item Group", "Brand", "Item Price", "Bin", "Warehouse",
+	"Customer", "Customer Group", "Supplier",
+}
+
+// snapshotStaleAfter is the age at which a snapshot is shown as stale
+const snapshotStaleAfter = 24 * time.Hour
+
+// localSnapshot is one mirrored doctype
+type localSnapshot struct {
+	Doctype string                   `json:"doctype"`
+	Pulled  time.Time                `json:"pulled"`
+	Full    bool                     `json:"full"` // Documents include child tables
+	Docs    []map[string]interface{} `json:"docs"`
+}
+
+// snapshotDir returns the snapshot directory of the configured site
+func (c *Client) snapshotDir() (string, error) {
+	dir, err := localDir()
+	if err != nil {
+		return "", err
+	}
+	site := c.Config.ERPURL
+	if u, err := url.Parse(site); err == nil && u.Host != "" {
+		site = u.Host
+	}
+	site = strings.NewReplacer("/", "_", ":", "_").Replace(site)
+	dir = filepath.Join(dir, "snapshots", site)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", errorf("failed to create %s: %w", dir, err)
+	}
+	return dir, nil
+}
+
+func (c *Client) snapshotPath(doctype string) (string, error) {
+	dir, err := c.snapshotDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, doctype+".json"), nil
+}
+
+// loadSnapshot returns the snapshot of a doctype, nil when there is none
+func (c *Client) loadSnapshot(doctype string) (*localSnapshot, error) {
+	path, err := c.snapshotPath(doctype)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorf("failed to read snapshot of %s: %w", doctype, err)
+	}
+	var snap localSnapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil, errorf("failed to parse snapshot of %s: %w", doctype, err)
+	}
+	return &snap, nil
+}
+
+func (c *Client) saveSnapshot(snap *localSnapshot) error {
+	path, err := c.snapshotPath(snap.Doctype)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(snap)
+	if err != nil {
+		return err
+	}
+	// Write to a temp file first so an interrupted pull keeps the old snapshot
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return errorf("failed to write snapshot of %s: %w", snap.Doctype, err)
+	}
+	return os.Rename(tmp, path)
+}
+
+// CmdSnapshot handles local snapshot commands
+func (c *Client) CmdSnapshot(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli snapshot <subcommand> [args]"))
+		fmt.Println()
+		fmt.Println(T("Subcommands:"))
+		fmt.Println(T("  pull [doctype...] [--full] [--rebuild]"))
+		fmt.Println(T("                       Mirror doctypes locally (ERP_SNAPSHOT_DOCTYPES, or the"))
+		fmt.Println(T("                       catalog, stock levels and parties by default)"))
+		fmt.Println(T("  status               Mirrored doctypes, sizes and ages"))
+		fmt.Println(T("  drop [doctype...]    Delete snapshots (all when none is given)"))
+		fmt.Println()
+		fmt.Println(T("Pulls after the first only fetch documents modified since and drop deleted"))
+		fmt.Println(T("ones. --full includes child tables (one request per changed document)."))
+		fmt.Println(T("Then add the global --local to list and get commands to read the snapshot"))
+		fmt.Println(T("instead of the server; snapshots over a day old are flagged as stale."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli snapshot pull"))
+		fmt.Println(T("  erp-cli snapshot pull item customer --full"))
+		fmt.Println(T("  erp-cli --local item list --group=Cables"))
+		fmt.Println(T("  erp-cli --local customer get \"Acme Corp\""))
+		return nil
+	}
+
+	switch args[0] {
+	case "pull":
+		full, rebuild := false, false
+		var doctypes []string
+		for _, arg := range args[1:] {
+			switch arg {
+			case "--full":
+				full = true
+			case "--rebuild":
+				rebuild = true
+			default:
+				doctypes = append(doctypes, resolveDoctype(arg))
+			}
+		}
+		if len(doctypes) == 0 {
+			doctypes = c.Config.SnapshotDoctypes
+		}
+		if len(doctypes) == 0 {
+			doctypes = defaultSnapshotDoctypes
+		}
+		return c.snapshotPull(doctypes, full, rebuild)
+	case "status":
+		return c.snapshotStatus()
+	case "drop":
+		return c.snapshotDrop(args[1:])
+	default:
+		return errorf("unknown snapshot subcommand: %s", args[0])
+	}
+}
+
+// snapshotPull mirrors doctypes. An existing snapshot is brought up to date
+// with the documents modified since its newest one, and the documents no
+// longer on the server are dropped.
+func (c *Client) snapshotPull(doctypes []string, full, rebuild bool) error {
+	failed := 0
+	for _, doctype := range doctypes {
+		printf("%sPulling %s...%s\n", Blue, doctype, Reset)
+		snap, changed, removed, err := c.pullSnapshot(doctype, full, rebuild)
+		if err != nil {
+			fmt.Printf("  %s✗ %s: %s%s\n", Red, doctype, err, Reset)
+			failed++
+			continue
+		}
+		printf("  %s✓ %d documents (%d new or changed, %d removed)%s\n", Green, len(snap.Docs), changed, removed, Reset)
+	}
+	if failed > 0 {
+		return errorf("%d of %d doctypes not pulled", failed, len(doctypes))
+	}
+	return nil
+}
+
+func (c *Client) pullSnapshot(doctype string, full, rebuild bool) (*localSnapshot, int, int, error) {
+	prev, err := c.loadSnapshot(doctype)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if rebuild || (prev != nil && prev.Full != full) {
+		prev = nil
+	}
+
+	since := ""
+	if prev != nil {
+		for _, doc := range prev.Docs {
+			if modified := stringField(doc, "modified"); modified > since {
+				since = modified
+			}
+		}
+	}
+	pulled := time.Now()
+	changed, err := c.fetchModifiedSince(doctype, since)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if full {
+		for i, doc := range changed {
+			result, err := c.Request("GET", url.PathEscape(doctype)+"/"+url.PathEscape(stringField(doc, "name")), nil)
+			if err != nil {
+				return nil, 0, 0, err
+			}
+			if data, ok := result["data"].(map[string]interface{}); ok {
+				changed[i] = data
+			}
+		}
+	}
+
+	snap := &localSnapshot{Doctype: doctype, Pulled: pulled, Full: full, Docs: changed}
+	removed := 0
+	if prev != nil {
+		result, err := c.Request("GET", url.PathEscape(doctype)+"?fields=[\"name\"]&limit_page_length=0", nil)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		current := map[string]bool{}
+		data, _ := result["data"].([]interface{})
+		for _, d := range data {
+			if m, ok := d.(map[string]interface{}); ok {
+				current[stringField(m, "name")] = true
+			}
+		}
+		updated := map[string]bool{}
+		for _, doc := range changed {
+			updated[stringField(doc, "name")] = true
+		}
+		for _, doc := range prev.Docs {
+			name := stringField(doc, "name")
+			switch {
+			case !current[name]:
+				removed++
+			case !updated[name]:
+				snap.Docs = append(snap.Docs, doc)
+			}
+		}
+	}
+	if err := c.saveSnapshot(snap); err != nil {
+		return nil, 0, 0, err
+	}
+	return snap, len(changed), removed, nil
+}
+
+// snapshotStatus lists the snapshots of the configured site
+func (c *Client) snapshotStatus() error {
+	dir, err := c.snapshotDir()
+	if err != nil {
+		return err
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) == 0 {
+		printf("%sNo snapshots yet. Run 'erp-cli snapshot pull'.%s\n", Yellow, Reset)
+		return nil
+	}
+	sort.Strings(files)
+
+	fmt.Printf("%-25s %8s %10s  %-16s %s\n", T("DOCTYPE"), T("DOCS"), T("SIZE"), T("PULLED"), T("AGE"))
+	fmt.Println(strings.Repeat("-", 75))
+	for _, file := range files {
+		snap, err := c.loadSnapshot(strings.TrimSuffix(filepath.Base(file), ".json"))
+		if err != nil || snap == nil {
+			continue
+		}
+		size := ""
+		if info, err := os.Stat(file); err == nil {
+			size = tf("%.1f KB", float64(info.Size())/1024)
+		}
+		doctype := snap.Doctype
+		if snap.Full {
+			doctype += " (full)"
+		}
+		age := snapshotAge(time.Since(snap.Pulled))
+		if time.Since(snap.Pulled) > snapshotStaleAfter {
+			age = Yellow + age + " (stale)" + Reset
+		}
+		fmt.Printf("%-25s %8d %10s  %-16s %s\n", doctype, len(snap.Docs), size, snap.Pulled.Format("2006-01-02 15:04"), age)
+	}
+	return nil
+}
+
+// snapshotDrop deletes the given snapshots, or all of the site's
+func (c *Client) snapshotDrop(doctypes []string) error {
+	dir, err := c.snapshotDir()
+	if err != nil {
+		return err
+	}
+	var files []string
+	if len(doctypes) == 0 {
+		files, _ = filepath.Glob(filepath.Join(dir, "*.json"))
+	}
+	for _, doctype := range doctypes {
+		files = append(files, filepath.Join(dir, resolveDoctype(doctype)+".json"))
+	}
+	dropped := 0
+	for _, file := range files {
+		if err := os.Remove(file); err == nil {
+			dropped++
+		}
+	}
+	printf("%s✓ Dropped %d snapshot(s)%s\n", Green, dropped, Reset)
+	return nil
+}
+
+// snapshotAge formats how long ago a snapshot was pulled
+func snapshotAge(d time.Duration) string {
+	switch {
+	case d < time.Hour:
+		return tf("%dm ago", int(d.Minutes()))
+	case d < 48*time.Hour:
+		return tf("%dh ago", int(d.Hours()))
+	default:
+		return tf("%dd ago", int(d.Hours()/24))
+	}
+}
+
+// localRequest answers an API request from the snapshots (--local)
+func (c *Client) localRequest(method, fullURL string) (map[string]interface{}, error) {
+	at := strings.Index(fullURL, "/api/resource/")
+	if method != "GET" || at < 0 {
+		return nil, errorf("not available with --local (snapshots only answer document reads)")
+	}
+	resource, query, _ := strings.Cut(fullURL[at+len("/api/resource/"):], "?")
+	resource, err := url.PathUnescape(resource)
+	if err != nil {
+		return nil, err
+	}
+	doctype, name, single := strings.Cut(resource, "/")
+
+	snap, err := c.loadSnapshot(doctype)
+	if err != nil {
+		return nil, err
+	}
+	if snap == nil {
+		return nil, errorf("no local snapshot of %s (run 'erp-cli snapshot pull \"%s\"')", doctype, doctype)
+	}
+	c.noteSnapshot(snap)
+
+	if single {
+		for _, doc := range snap.Docs {
+			if stringField(doc, "name") == name {
+				return map[string]interface{}{"data": doc}, nil
+			}
+		}
+		return nil, errorf("API error (HTTP 404): %s %s not found in the local snapshot", doctype, name)
+	}
+
+	params, err := url.ParseQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	if strings.Contains(params.Get("fields"), "(") {
+		return nil, errorf("aggregates are not available with --local")
+	}
+	filters, err := parseLocalFilters(params.Get("filters"), doctype)
+	if err != nil {
+		return nil, err
+	}
+	orFilters, err := parseLocalFilters(params.Get("or_filters"), doctype)
+	if err != nil {
+		return nil, err
+	}
+	// Filters on child rows need the rows, which only --full snapshots keep
+	for _, f := range append(append([]localFilter{}, filters...), orFilters...) {
+		if f.child != "" && !snap.Full {
+			return nil, errorf("filters on %s rows need a full snapshot (run 'erp-cli snapshot pull \"%s\" --full')", f.child, doctype)
+		}
+	}
+
+	var docs []interface{}
+	for _, doc := range snap.Docs {
+		if !matchAllFilters(doc, filters) || (len(orFilters) > 0 && !matchAnyFilter(doc, orFilters)) {
+			continue
+		}
+		docs = append(docs, doc)
+	}
+	sortLocalDocs(docs, params.Get("order_by"))
+
+	start, _ := strconv.Atoi(params.Get("limit_start"))
+	limit := 20 // Frappe's default page
+	if value := params.Get("limit_page_length"); value != "" {
+		limit, _ = strconv.Atoi(value)
+	}
+	if start > len(docs) {
+		start = len(docs)
+	}
+	docs = docs[start:]
+	if limit > 0 && len(docs) > limit {
+		docs = docs[:limit]
+	}
+	if docs == nil {
+		docs = []interface{}{}
+	}
+	return map[string]interface{}{"data": docs}, nil
+}
+
+// noteSnapshot tells once per doctype that results come from a snapshot,
+// and how old it is. It goes to stderr so piped output stays clean.
+func (c *Client) noteSnapshot(snap *localSnapshot) {
+	if c.localNoted == nil {
+		c.localNoted = map[string]bool{}
+	}
+	if c.localNoted[snap.Doctype] {
+		return
+	}
+	c.localNoted[snap.Doctype] = true
+	age := time.Since(snap.Pulled)
+	color, stale := Cyan, ""
+	if age > snapshotStaleAfter {
+		color, stale = Yellow, T(" - stale, run 'erp-cli snapshot pull'")
+	}
+	fmt.Fprint(os.Stderr, tf("%s[local] %s as of %s (%s%s)%s\n", color, snap.Doctype, snap.Pulled.Format("2006-01-02 15:04"), snapshotAge(age), stale, Reset))
+}
+
+// localFilter is one [field, operator, value] condition, on the document
+// or, when child is set, on its rows of that child doctype
+type localFilter struct {
+	child    string
+	field    string
+	operator string
+	value    interface{}
+}
+
+// parseLocalFilters reads filters on a doctype as the API takes them: a
+// list of [field, op, value] or [doctype, field, op, value], or a
+// {field: value} or {field: [op, value]} object
+func parseLocalFilters(raw, doctype string) ([]localFilter, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(raw), &decoded); err != nil {
+		return nil, errorf("invalid filters: %w", err)
+	}
+
+	var filters []localFilter
+	switch v := decoded.(type) {
+	case []interface{}:
+		for _, f := range v {
+			parts, ok := f.([]interface{})
+			child := ""
+			if ok && len(parts) == 4 {
+				if child, _ = parts[0].(string); child == doctype {
+					child = ""
+				}
+				parts = parts[1:]
+			}
+			if !ok || len(parts) != 3 {
+				return nil, errorf("invalid filter: %v", f)
+			}
+			field, _ := parts[0].(string)
+			operator, _ := parts[1].(string)
+			filters = append(filters, localFilter{child, field, strings.ToLower(operator), parts[2]})
+		}
+	case map[string]interface{}:
+		for field, value := range v {
+			if pair, ok := value.([]interface{}); ok && len(pair) == 2 {
+				operator, _ := pair[0].(string)
+				filters = append(filters, localFilter{"", field, strings.ToLower(operator), pair[1]})
+			} else {
+				filters = append(filters, localFilter{"", field, "=", value})
+			}
+		}
+	}
+	return filters, nil
+}
+
+func matchAllFilters(doc map[string]interface{}, filters []localFilter) bool {
+	for _, f := range filters {
+		if !f.match(doc) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchAnyFilter(doc map[string]interface{}, filters []localFilter) bool {
+	for _, f := range filters {
+		if f.match(doc) {
+			return true
+		}
+	}
+	return false
+}
+
+// match evaluates a filter the way the database would, comparing text
+// without case. A child filter matches when one of the rows does, as the
+// database's join does.
+func (f localFilter) match(doc map[string]interface{}) bool {
+	if f.child != "" {
+		row := f
+		row.child = ""
+		for _, value := range doc {
+			for _, r := range docRows(value) {
+				if stringField(r, "doctype") == f.child && row.match(r) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	field := f.field
+	if i := strings.LastIndex(field, "."); i >= 0 {
+		field = field[i+1:]
+	}
+	value := doc[strings.Trim(field, "`")]
+
+	switch f.operator {
+	case "=":
+		return compareLocal(value, f.value) == 0
+	case "!=":
+		return compareLocal(value, f.value) != 0
+	case ">":
+		return compareLocal(value, f.value) > 0
+	case "<":
+		return compareLocal(value, f.value) < 0
+	case ">=":
+		return compareLocal(value, f.value) >= 0
+	case "<=":
+		return compareLocal(value, f.value) <= 0
+	case "like", "not like":
+		pattern, _ := f.value.(string)
+		matched := likePattern(pattern).MatchString(fmt.Sprint(localText(value)))
+		return matched == (f.operator == "like")
+	case "in", "not in":
+		found := false
+		for _, option := range localList(f.value) {
+			if compareLocal(value, option) == 0 {
+				found = true
+				break
+			}
+		}
+		return found == (f.operator == "in")
+	case "is":
+		set := localText(value) != ""
+		return set == (f.value == "set")
+	case "between":
+		bounds := localList(f.value)
+		return len(bounds) == 2 && compareLocal(value, bounds[0]) >= 0 && compareLocal(value, bounds[1]) <= 0
+	}
+	return false
+}
+
+// compareLocal compares a document value with a filter value: as numbers
+// when the document's is one, otherwise as text without case
+func compareLocal(a, b interface{}) int {
+	if x, ok := a.(float64); ok {
+		if y, ok := localNumber(b); ok {
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(strings.ToLower(localText(a)), strings.ToLower(localText(b)))
+}
+
+// localNumber reads a filter value as a number
+func localNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func localText(v interface{}) string {
+	switch s := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return s
+	case float64:
+		return strconv.FormatFloat(s, 'f', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
+
+// localList reads an in/between value: a list or a comma-separated string
+func localList(v interface{}) []interface{} {
+	if list, ok := v.([]interface{}); ok {
+		return list
+	}
+	var list []interface{}
+	for _, s := range strings.Split(localText(v), ",") {
+		list = append(list, strings.TrimSpace(s))
+	}
+	return list
+}
+
+// likePattern turns a SQL LIKE pattern into a case-insensitive regexp
+func likePattern(pattern string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("(?is)^")
+	for _, r := range pattern {
+		switch r {
+		case '%':
+			b.WriteString(".*")
+		case '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
+
+// sortLocalDocs orders documents by an order_by clause ("modified desc,
+// name asc"), by modified (newest first) like the API when there is none
+func sortLocalDocs(docs []interface{}, orderBy string) {
+	if orderBy == "" {
+		orderBy = "modified desc"
+	}
+	type key struct {
+		field string
+		desc  bool
+	}
+	var keys []key
+	for _, part := range strings.Split(orderBy, ",") {
+		fields := strings.Fields(part)
+		if len(fields) == 0 {
+			continue
+		}
+		field := fields[0]
+		if i := strings.LastIndex(field, "."); i >= 0 {
+			field = field[i+1:]
+		}
+		keys = append(keys, key{strings.Trim(field, "`"), len(fields) > 1 && strings.EqualFold(fields[1], "desc")})
+	}
+	sort.SliceStable(docs, func(i, j int) bool {
+		a, _ := docs[i].(map[string]interface{})
+		b, _ := docs[j].(map[string]interface{})
+		for _, k := range keys {
+			if cmp := compareLocal(a[k.field], b[k.field]); cmp != 0 {
+				return (cmp < 0) != k.desc
+			}
+		}
+		return false
+	})
+}
@@ -0,0 +1,38 @@
+package erp
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Draft documents are edited read-modify-write (GET, change items, PUT), so two
+// users adding lines at the same time would silently overwrite each other.
+// Sending back the "modified" timestamp that was read makes Frappe reject the
+// save with a TimestampMismatchError when the document changed in between.
+
+// updateDraft PUTs changes to a document based on the version in loaded
+func (c *Client) updateDraft(doctype, name string, loaded, body map[string]interface{}) error {
+	if modified, ok := loaded["modified"]; ok {
+		body["modified"] = modified
+	}
+
+	endpoint := strings.ReplaceAll(doctype, " ", "%20") + "/" + url.PathEscape(name)
+	_, err := c.Request("PUT", endpoint, body)
+	if err != nil && strings.Contains(err.Error(), "TimestampMismatchError") {
+		return errorf("%s %s was modified by someone else while you were editing it; refresh and try again", doctype, name)
+	}
+	return err
+}
+
+// checkUnchanged compares a freshly fetched document with the version the user
+// was looking at, and refuses when someone saved it since
+func checkUnchanged(doctype string, shown, current map[string]interface{}) error {
+	if shown == nil || shown["name"] != current["name"] {
+		return nil
+	}
+	if shown["modified"] == nil || shown["modified"] == current["modified"] {
+		return nil
+	}
+	return errorf("%s %v was changed by %v at %v since it was loaded; reopen it to see the changes",
+		doctype, current["name"], current["modified_by"], current["modified"])
+}
@@ -0,0 +1,96 @@
+package erp
+
+import (
+	"fmt"
+	"time"
+)
+
+// Low-bandwidth mode (ERP_LOW_BANDWIDTH=1) trades completeness for fewer and
+// smaller requests, for sites reached over metered mobile connections:
+//   - document lists only fetch the most recent lowBandwidthPageSize entries
+//   - linked addresses and contacts are not fetched for customer/supplier details
+//   - the TUI does not reload the current view after each change (press r)
+//   - company, currency and time zone lookups are reused from disk for a day
+
+// lowBandwidthPageSize caps document lists in low-bandwidth mode
+const lowBandwidthPageSize = 20
+
+// lowBandwidthCacheTTL is how long cached lookups are reused in low-bandwidth mode
+const lowBandwidthCacheTTL = 24 * time.Hour
+
+// lookupCacheFile stores lookups shared across runs (see cachedLookup)
+const lookupCacheFile = "cache.json"
+
+// cachedValue is a lookup result saved to disk
+type cachedValue struct {
+	Value string    `json:"value"`
+	Saved time.Time `json:"saved"`
+}
+
+// LowBandwidth reports whether low-bandwidth mode is enabled
+func (c *Client) LowBandwidth() bool {
+	return c.Config != nil && c.Config.LowBandwidth
+}
+
+// pageLimit returns the limit_page_length query parameter for a document list.
+// n is the normal page size (0 = everything); low-bandwidth mode caps it.
+func (c *Client) pageLimit(n int) string {
+	if c.LowBandwidth() && (n == 0 || n > lowBandwidthPageSize) {
+		n = lowBandwidthPageSize
+	}
+	return fmt.Sprintf("limit_page_length=%d", n)
+}
+
+// printPageNote tells the user a list may have been cut short by low-bandwidth mode
+func (c *Client) printPageNote(count int) {
+	if c.LowBandwidth() && count >= lowBandwidthPageSize {
+		printf("%sShowing the %d most recent (low-bandwidth mode)%s\n", Yellow, count, Reset)
+	}
+}
+
+// cacheTTL returns how long lookups are reused across runs.
+// Outside low-bandwidth mode they are only cached for the life of the process.
+func (c *Client) cacheTTL() time.Duration {
+	if c.LowBandwidth() {
+		return lowBandwidthCacheTTL
+	}
+	return 0
+}
+
+// cacheKey scopes a lookup to the configured site
+func (c *Client) cacheKey(key string) string {
+	return c.Config.ERPURL + " " + key
+}
+
+// cachedLookup returns a lookup saved by storeLookup if it is still fresh
+func (c *Client) cachedLookup(key string) (string, bool) {
+	ttl := c.cacheTTL()
+	if ttl == 0 {
+		return "", false
+	}
+
+	cache := map[string]cachedValue{}
+	if err := loadLocalJSON(lookupCacheFile, &cache); err != nil {
+		return "", false
+	}
+
+	entry, ok := cache[c.cacheKey(key)]
+	if !ok || time.Since(entry.Saved) > ttl {
+		return "", false
+	}
+	return entry.Value, true
+}
+
+// storeLookup saves a lookup for later runs. It is a no-op when lookups are not cached.
+func (c *Client) storeLookup(key, value string) {
+	if c.cacheTTL() == 0 || value == "" {
+		return
+	}
+
+	cache := map[string]cachedValue{}
+	if err := loadLocalJSON(lookupCacheFile, &cache); err != nil {
+		cache = map[string]cachedValue{}
+	}
+	cache[c.cacheKey(key)] = cachedValue{Value: value, Saved: time.Now()}
+	_ = saveLocalJSON(lookupCacheFile, cache)
+}
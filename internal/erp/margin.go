@@ -0,0 +1,294 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// marginLine is one invoiced item line with its estimated cost
+type marginLine struct {
+	month    string
+	customer string
+	item     string
+	amount   float64 // Net selling amount in company currency
+	cost     float64
+	estimate bool // Cost taken from current valuation or buying price
+}
+
+// marginStat totals selling amount and cost for one item, customer or month
+type marginStat struct {
+	name   string
+	amount float64
+	cost   float64
+}
+
+func (s marginStat) margin() float64 {
+	return s.amount - s.cost
+}
+
+// percent is the gross margin as a share of the selling amount
+func (s marginStat) percent() float64 {
+	if s.amount == 0 {
+		return 0
+	}
+	return s.margin() / s.amount * 100
+}
+
+// reportMargin estimates gross margin per item, customer and month from
+// submitted Sales Invoice lines. The cost of a line is its incoming rate
+// (the valuation booked when the stock left); lines without one fall back
+// to the item's current valuation rate and then to its buying price.
+func (c *Client) reportMargin(last int) error {
+	today, err := time.Parse("2006-01-02", c.Today())
+	if err != nil {
+		return err
+	}
+	months := salesPeriods(today, salesReportOptions{last: last})
+	from, to := months[0].start, today.Format("2006-01-02")
+
+	printf("%sGenerating margin report for the last %d months...%s\n\n", Blue, last, Reset)
+
+	// Pre-fetch currency
+	c.GetCurrency()
+
+	filters, err := encodeFilters([][]interface{}{
+		{"docstatus", "=", 1},
+		{"posting_date", "between", []string{from, to}},
+	})
+	if err != nil {
+		return err
+	}
+	fields := url.QueryEscape("[\"posting_date\",\"customer\",\"`tabSales Invoice Item`.item_code\",\"`tabSales Invoice Item`.stock_qty\",\"`tabSales Invoice Item`.base_net_amount\",\"`tabSales Invoice Item`.incoming_rate\"]")
+	result, err := c.Request("GET", "Sales%20Invoice?limit_page_length=0&filters="+filters+"&fields="+fields, nil)
+	if err != nil {
+		return err
+	}
+
+	var lines []marginLine
+	var missing []string
+	seen := map[string]bool{}
+	qty := map[int]float64{}
+	if rows, ok := result["data"].([]interface{}); ok {
+		for _, row := range rows {
+			m, ok := row.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			item := stringField(m, "item_code")
+			date := stringField(m, "posting_date")
+			if item == "" || len(date) < 7 {
+				continue
+			}
+			amount, _ := m["base_net_amount"].(float64)
+			stockQty, _ := m["stock_qty"].(float64)
+			incoming, _ := m["incoming_rate"].(float64)
+
+			line := marginLine{
+				month:    date[:7],
+				customer: stringField(m, "customer"),
+				item:     item,
+				amount:   amount,
+				cost:     incoming * stockQty,
+			}
+			if incoming == 0 {
+				line.estimate = true
+				qty[len(lines)] = stockQty
+				if !seen[item] {
+					seen[item] = true
+					missing = append(missing, item)
+				}
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	if len(lines) == 0 {
+		printf("%sNo submitted sales invoices since %s%s\n", Yellow, from, Reset)
+		return nil
+	}
+
+	// Estimate the cost of lines sold without an incoming rate
+	if len(missing) > 0 {
+		rates, err := c.fetchCostRates(missing)
+		if err != nil {
+			return err
+		}
+		for i, stockQty := range qty {
+			lines[i].cost = rates[lines[i].item] * stockQty
+		}
+	}
+
+	byItem := map[string]*marginStat{}
+	byCustomer := map[string]*marginStat{}
+	byMonth := map[string]*marginStat{}
+	var total marginStat
+	estimated := 0
+	for _, l := range lines {
+		for _, group := range []struct {
+			stats map[string]*marginStat
+			key   string
+		}{{byItem, l.item}, {byCustomer, l.customer}, {byMonth, l.month}} {
+			s, ok := group.stats[group.key]
+			if !ok {
+				s = &marginStat{name: group.key}
+				group.stats[group.key] = s
+			}
+			s.amount += l.amount
+			s.cost += l.cost
+		}
+		total.amount += l.amount
+		total.cost += l.cost
+		if l.estimate {
+			estimated++
+		}
+	}
+
+	fmt.Printf("%s══════════════════════════════════════════════════════════════%s\n", Cyan, Reset)
+	printf("%s                     GROSS MARGIN REPORT                      %s\n", Cyan, Reset)
+	fmt.Printf("%s══════════════════════════════════════════════════════════════%s\n\n", Cyan, Reset)
+
+	// Months in calendar order, including those without sales
+	printf("%sPer month (%s to %s):%s\n", Yellow, from, to, Reset)
+	c.printMarginHeader("Month")
+	for _, p := range months {
+		s, ok := byMonth[p.start[:7]]
+		if !ok {
+			s = &marginStat{name: p.start[:7]}
+		}
+		c.printMarginRow(*s)
+	}
+	fmt.Println()
+
+	// Items worst margin first: those are the ones to reprice
+	printf("%sPer item (lowest margin first):%s\n", Yellow, Reset)
+	c.printMarginHeader("Item")
+	c.printMarginStats(sortedMargins(byItem, func(a, b marginStat) bool { return a.percent() < b.percent() }))
+	fmt.Println()
+
+	printf("%sPer customer (by selling amount):%s\n", Yellow, Reset)
+	c.printMarginHeader("Customer")
+	c.printMarginStats(sortedMargins(byCustomer, func(a, b marginStat) bool { return a.amount > b.amount }))
+	fmt.Println()
+
+	total.name = "Total"
+	c.printMarginRow(total)
+	if estimated > 0 {
+		printf("\n  %sNote: %d of %d lines had no incoming rate; their cost uses the current valuation or buying price%s\n",
+			Yellow, estimated, len(lines), Reset)
+	}
+
+	printf("\n%sGenerated: %s%s\n", Cyan, c.Timestamp(), Reset)
+	return nil
+}
+
+// fetchCostRates returns a cost rate per item: the average valuation rate
+// across warehouses, or the buying price when the item holds no stock
+func (c *Client) fetchCostRates(items []string) (map[string]float64, error) {
+	rates := map[string]float64{}
+
+	filters, err := encodeFilters([][]interface{}{{"item_code", "in", items}, {"actual_qty", ">", 0}})
+	if err != nil {
+		return nil, err
+	}
+	result, err := c.Request("GET", "Bin?limit_page_length=0&fields=[\"item_code\",\"actual_qty\",\"valuation_rate\"]&filters="+filters, nil)
+	if err != nil {
+		return nil, err
+	}
+	value := map[string]float64{}
+	qty := map[string]float64{}
+	if rows, ok := result["data"].([]interface{}); ok {
+		for _, row := range rows {
+			if m, ok := row.(map[string]interface{}); ok {
+				item := stringField(m, "item_code")
+				actual, _ := m["actual_qty"].(float64)
+				rate, _ := m["valuation_rate"].(float64)
+				value[item] += actual * rate
+				qty[item] += actual
+			}
+		}
+	}
+	for item, q := range qty {
+		if q > 0 {
+			rates[item] = value[item] / q
+		}
+	}
+
+	filters, err = encodeFilters([][]interface{}{{"item_code", "in", items}, {"buying", "=", 1}})
+	if err != nil {
+		return nil, err
+	}
+	result, err = c.Request("GET", "Item%20Price?limit_page_length=0&fields=[\"item_code\",\"price_list_rate\"]&filters="+filters, nil)
+	if err != nil {
+		return nil, err
+	}
+	if rows, ok := result["data"].([]interface{}); ok {
+		for _, row := range rows {
+			if m, ok := row.(map[string]interface{}); ok {
+				item := stringField(m, "item_code")
+				if rates[item] == 0 {
+					rates[item], _ = m["price_list_rate"].(float64)
+				}
+			}
+		}
+	}
+	return rates, nil
+}
+
+// sortedMargins returns the stats of a map ordered by less
+func sortedMargins(stats map[string]*marginStat, less func(a, b marginStat) bool) []marginStat {
+	list := make([]marginStat, 0, len(stats))
+	for _, s := range stats {
+		list = append(list, *s)
+	}
+	// Sort by name first so ties keep a stable order
+	sort.Slice(list, func(i, j int) bool { return list[i].name < list[j].name })
+	sort.SliceStable(list, func(i, j int) bool { return less(list[i], list[j]) })
+	return list
+}
+
+func (c *Client) printMarginHeader(label string) {
+	fmt.Printf("  %-28s %14s %14s %14s %8s\n", label, T("Sales"), T("Cost"), T("Margin"), "%")
+}
+
+// printMarginStats prints up to analyticsTop rows and counts the rest
+func (c *Client) printMarginStats(stats []marginStat) {
+	for i, s := range stats {
+		if i >= analyticsTop {
+			printf("  ... and %d more\n", len(stats)-analyticsTop)
+			break
+		}
+		c.printMarginRow(s)
+	}
+}
+
+// printMarginRow prints one margin line, red when it loses money
+func (c *Client) printMarginRow(s marginStat) {
+	name := s.name
+	if len(name) > 28 {
+		name = name[:25] + "..."
+	}
+	color := Reset
+	if s.margin() < 0 {
+		color = Red
+	}
+	fmt.Printf("  %-28s %14s %14s %s%14s %7.1f%%%s\n",
+		name, c.FormatCurrency(s.amount), c.FormatCurrency(s.cost), color, c.FormatCurrency(s.margin()), s.percent(), Reset)
+}
+
+// parseMarginLast reads --last=N months for report margin
+func parseMarginLast(args []string) (int, error) {
+	last := 3
+	for _, arg := range args {
+		if len(arg) > 7 && arg[:7] == "--last=" {
+			n, err := strconv.Atoi(arg[7:])
+			if err != nil || n < 1 {
+				return 0, errorf("invalid --last: %s (expected a positive number)", arg[7:])
+			}
+			last = n
+		}
+	}
+	return last, nil
+}
@@ -0,0 +1,209 @@
+package erp
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const notesFile = "notes.json"
+
+// Note is a locally stored quick-capture note, waiting to become a document
+type Note struct {
+	ID      int    `json:"id"`
+	Text    string `json:"text"`
+	Created string `json:"created"`
+}
+
+// noteHints holds what could be guessed from a note's text
+type noteHints struct {
+	doctype string // Sales Order, Quotation or Purchase Order
+	party   string // Customer or supplier
+	item    string
+	qty     float64
+}
+
+var (
+	noteItemRe  = regexp.MustCompile(`\b[A-Z0-9]+(?:-[A-Z0-9]+)+\b`)
+	noteQtyRe   = regexp.MustCompile(`(?i)\b(\d+(?:\.\d+)?)\s*(?:x|pcs|units?)\b`)
+	noteQtyXRe  = regexp.MustCompile(`(?i)\bx\s*(\d+(?:\.\d+)?)\b`)
+	noteForRe   = regexp.MustCompile(`(?i)\bfor\s+(.+?)(?:\s+(?:next|by|on|tomorrow|today|this|before|asap)\b|[,.;]|$)`)
+	noteFromRe  = regexp.MustCompile(`(?i)\bfrom\s+(.+?)(?:\s+(?:next|by|on|tomorrow|today|this|before|asap)\b|[,.;]|$)`)
+	noteQuoteRe = regexp.MustCompile(`(?i)\b(?:quote|quotation)\b`)
+	noteBuyRe   = regexp.MustCompile(`(?i)\b(?:buy|purchase|po|reorder|restock)\b`)
+)
+
+// CmdNote handles quick-capture notes
+func (c *Client) CmdNote(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli note <text> | note <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, delete"))
+		fmt.Println()
+		fmt.Println(T("Notes are stored locally and can be turned into documents from the TUI Inbox."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli note \"order 20x CPU-I7 for Acme next week\""))
+		fmt.Println(T("  erp-cli note \"buy 50 pcs RAM-16GB from Kingston\""))
+		fmt.Println(T("  erp-cli note list"))
+		fmt.Println(T("  erp-cli note delete 3"))
+		return nil
+	}
+
+	switch args[0] {
+	case "list":
+		return c.noteList()
+	case "delete":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli note delete <id>")
+		}
+		id, err := strconv.Atoi(strings.TrimPrefix(args[1], "#"))
+		if err != nil {
+			return errorf("invalid note id: %s", args[1])
+		}
+		if err := deleteNote(id); err != nil {
+			return err
+		}
+		printf("%s✓ Note deleted: #%d%s\n", Green, id, Reset)
+		return nil
+	default:
+		note, err := addNote(strings.Join(args, " "))
+		if err != nil {
+			return err
+		}
+		printf("%s✓ Note saved: #%d%s\n", Green, note.ID, Reset)
+		fmt.Printf("  %s\n", parseNoteHints(note.Text))
+		return nil
+	}
+}
+
+func (c *Client) noteList() error {
+	notes, err := loadNotes()
+	if err != nil {
+		return err
+	}
+
+	if len(notes) == 0 {
+		printf("%sNo notes found%s\n", Yellow, Reset)
+		return nil
+	}
+
+	printf("\n%sNotes (%d):%s\n", Cyan, len(notes), Reset)
+	for _, n := range notes {
+		fmt.Printf("  #%-4d %s  %s\n", n.ID, n.Created, n.Text)
+		fmt.Printf("        %s\n", parseNoteHints(n.Text))
+	}
+	return nil
+}
+
+func loadNotes() ([]Note, error) {
+	var notes []Note
+	if err := loadLocalJSON(notesFile, &notes); err != nil {
+		return nil, err
+	}
+	return notes, nil
+}
+
+// addNote stores a new note with the next free id
+func addNote(text string) (Note, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return Note{}, errorf("note text is empty")
+	}
+
+	notes, err := loadNotes()
+	if err != nil {
+		return Note{}, err
+	}
+
+	id := 1
+	for _, n := range notes {
+		if n.ID >= id {
+			id = n.ID + 1
+		}
+	}
+
+	note := Note{ID: id, Text: text, Created: time.Now().Format("2006-01-02 15:04")}
+	notes = append(notes, note)
+	return note, saveLocalJSON(notesFile, notes)
+}
+
+// findNote returns the note with the given id
+func findNote(id int) (Note, error) {
+	notes, err := loadNotes()
+	if err != nil {
+		return Note{}, err
+	}
+	for _, n := range notes {
+		if n.ID == id {
+			return n, nil
+		}
+	}
+	return Note{}, errorf("note not found: #%d", id)
+}
+
+func deleteNote(id int) error {
+	notes, err := loadNotes()
+	if err != nil {
+		return err
+	}
+
+	for i, n := range notes {
+		if n.ID == id {
+			notes = append(notes[:i], notes[i+1:]...)
+			return saveLocalJSON(notesFile, notes)
+		}
+	}
+	return errorf("note not found: #%d", id)
+}
+
+// parseNoteHints guesses the target document, party, item and quantity from free text
+func parseNoteHints(text string) noteHints {
+	h := noteHints{doctype: "Sales Order"}
+	partyRe := noteForRe
+	switch {
+	case noteQuoteRe.MatchString(text):
+		h.doctype = "Quotation"
+	case noteBuyRe.MatchString(text):
+		h.doctype = "Purchase Order"
+		partyRe = noteFromRe
+	}
+
+	if m := partyRe.FindStringSubmatch(text); m != nil {
+		h.party = strings.TrimSpace(m[1])
+	}
+
+	// Item codes look like CPU-I7 or RAM-16GB; skip dates and other pure numbers
+	for _, code := range noteItemRe.FindAllString(text, -1) {
+		if strings.IndexFunc(code, unicode.IsLetter) >= 0 {
+			h.item = code
+			break
+		}
+	}
+
+	if m := noteQtyRe.FindStringSubmatch(text); m != nil {
+		h.qty, _ = strconv.ParseFloat(m[1], 64)
+	} else if m := noteQtyXRe.FindStringSubmatch(text); m != nil {
+		h.qty, _ = strconv.ParseFloat(m[1], 64)
+	}
+
+	return h
+}
+
+// String summarizes the hints for display
+func (h noteHints) String() string {
+	parts := []string{"→ " + h.doctype}
+	if h.party != "" {
+		parts = append(parts, h.party)
+	}
+	if h.item != "" {
+		item := h.item
+		if h.qty > 0 {
+			item += fmt.Sprintf(" x %g", h.qty)
+		}
+		parts = append(parts, item)
+	}
+	return strings.Join(parts, " • ")
+}
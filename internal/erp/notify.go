@@ -0,0 +1,180 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// notifyDoctypes are the ERP_NOTIFY_ON names of the doctypes whose submit
+// can email the customer
+var notifyDoctypes = map[string]string{
+	"quotation": "Quotation",
+	"so":        "Sales Order",
+	"si":        "Sales Invoice",
+}
+
+// notifyOnSubmit returns whether submitting a document emails its
+// customer: on when ERP_NOTIFY_ON lists the doctype, --notify and
+// --no-notify decide per command
+func (c *Client) notifyOnSubmit(doctype string, args []string) bool {
+	known := false
+	for _, notified := range notifyDoctypes {
+		known = known || notified == doctype
+	}
+	if !known {
+		return false
+	}
+	notify := false
+	for _, short := range c.Config.NotifyOn {
+		if notifyDoctypes[short] == doctype {
+			notify = true
+		}
+	}
+	for _, arg := range args {
+		switch arg {
+		case "--notify":
+			notify = true
+		case "--no-notify":
+			notify = false
+		}
+	}
+	return notify
+}
+
+// notifyCustomer emails a submitted document to its customer contact with
+// the printed document attached, through Frappe's email API so the mail is
+// on the document's timeline. The submit stands when the email fails, so
+// failures are only reported.
+func (c *Client) notifyCustomer(doctype, name string) {
+	recipient, err := c.sendSubmitEmail(doctype, name)
+	if err != nil {
+		printf("  %s⚠ Customer not notified: %s%s\n", Yellow, err, Reset)
+		return
+	}
+	printf("  %s✓ Emailed to %s%s\n", Green, recipient, Reset)
+}
+
+// notifyNote emails a submitted document to its customer for the TUI,
+// returning a note on the outcome for the submit message
+func (c *Client) notifyNote(doctype, name string) string {
+	recipient, err := c.sendSubmitEmail(doctype, name)
+	if err != nil {
+		return tf(" (customer not notified: %s)", err)
+	}
+	return tf(" (emailed to %s)", recipient)
+}
+
+// submitNotifying submits a document and, when notify is set, emails it
+// to its customer. A failed email is an error too, although the document
+// stays submitted.
+func (c *Client) submitNotifying(doctype, name string, notify bool) error {
+	if err := c.submitDocument(doctype, name); err != nil {
+		return err
+	}
+	if !notify {
+		return nil
+	}
+	if _, err := c.sendSubmitEmail(doctype, name); err != nil {
+		return errorf("submitted, but the customer was not notified: %w", err)
+	}
+	return nil
+}
+
+// sendSubmitEmail emails a submitted document, returning the recipient
+func (c *Client) sendSubmitEmail(doctype, name string) (string, error) {
+	result, err := c.Request("GET", strings.ReplaceAll(doctype, " ", "%20")+"/"+url.PathEscape(name), nil)
+	if err != nil {
+		return "", err
+	}
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return "", errorf("%s not found: %s", strings.ToLower(doctype), name)
+	}
+
+	recipient, err := c.contactEmail(data)
+	if err != nil {
+		return "", err
+	}
+	subject, message, err := c.notifyMessage(doctype, data)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = c.CallMethod("POST", "frappe.core.doctype.communication.email.make", map[string]interface{}{
+		"recipients":            recipient,
+		"subject":               subject,
+		"content":               message,
+		"doctype":               doctype,
+		"name":                  name,
+		"send_email":            1,
+		"send_me_a_copy":        0,
+		"attach_document_print": 1,
+	})
+	if err != nil {
+		return "", err
+	}
+	return recipient, nil
+}
+
+// contactEmail returns the email of a document's contact person, or else
+// of the customer's primary contact (or first one with an email)
+func (c *Client) contactEmail(data map[string]interface{}) (string, error) {
+	if email := stringField(data, "contact_email"); email != "" {
+		return email, nil
+	}
+	customer := stringField(data, "customer")
+	if customer == "" && stringField(data, "quotation_to") == "Customer" {
+		customer = stringField(data, "party_name")
+	}
+	if customer == "" {
+		return "", errorf("the document has no customer contact")
+	}
+
+	contacts, err := c.fetchPartyContacts("Customer", customer)
+	if err != nil {
+		return "", err
+	}
+	email := ""
+	for _, ct := range contacts {
+		if address := stringField(ct, "email_id"); address != "" {
+			if ct["is_primary_contact"] == float64(1) {
+				return address, nil
+			}
+			if email == "" {
+				email = address
+			}
+		}
+	}
+	if email == "" {
+		return "", errorf("%s has no contact with an email (add one with 'erp-cli customer add-contact')", customer)
+	}
+	return email, nil
+}
+
+// notifyMessage returns the subject and body of a submit email, rendered
+// by the server from the ERP_NOTIFY_TEMPLATE Email Template when one is
+// set
+func (c *Client) notifyMessage(doctype string, data map[string]interface{}) (string, string, error) {
+	if template := c.Config.NotifyTemplate; template != "" {
+		result, err := c.CallMethod("POST", "frappe.email.doctype.email_template.email_template.get_email_template", map[string]interface{}{
+			"template_name": template,
+			"doc":           data,
+		})
+		if err != nil {
+			return "", "", errorf("email template %s: %w", template, err)
+		}
+		message, _ := result["message"].(map[string]interface{})
+		return stringField(message, "subject"), stringField(message, "message"), nil
+	}
+
+	name := stringField(data, "name")
+	customer := stringField(data, "customer_name")
+	if customer == "" {
+		customer = stringField(data, "party_name")
+	}
+	subject := fmt.Sprintf("%s %s", doctype, name)
+	message := fmt.Sprintf("Dear %s,<br><br>Please find attached %s %s.<br><br>Thank you for your business.",
+		customer, strings.ToLower(doctype), name)
+	return subject, message, nil
+}
@@ -0,0 +1,297 @@
+package erp
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Offline mode (--offline) records create and update commands in a local
+// queue instead of running them; sync push runs them once the server can
+// be reached. Each operation is signed with the API credentials so a queue
+// file edited by hand, or copied from another site, is not replayed.
+
+const offlineQueueFile = "offline-queue.json"
+
+// offlineOp is one queued command, as typed: its aliases and default
+// flags are expanded when it is pushed, not when it is queued
+type offlineOp struct {
+	ID        int       `json:"id"`
+	Args      []string  `json:"args"`
+	Flags     []string  `json:"flags,omitempty"` // Global flags in effect (--no-defaults, --lang=...)
+	Site      string    `json:"site"`
+	Queued    time.Time `json:"queued"`
+	Signature string    `json:"signature"`
+}
+
+// offlineSubcommands are the document subcommands that change data, besides
+// the create* and add-* families
+var offlineSubcommands = map[string]bool{
+	"set": true, "update": true, "submit": true, "cancel": true, "delete": true,
+	"rename": true, "close": true, "amend": true, "receive": true, "pay": true, "recur": true,
+}
+
+// offlineCommands are the top-level commands that change a document given
+// as <doctype> <name>
+var offlineCommands = map[string]bool{"comment": true, "assign": true, "attach": true}
+
+// offlineQueueable tells whether a command changes data and so can be
+// queued; reads need the server
+func offlineQueueable(args []string) bool {
+	if len(args) < 2 {
+		return false
+	}
+	if offlineCommands[args[0]] {
+		return true
+	}
+	sub := args[1]
+	return strings.HasPrefix(sub, "create") || strings.HasPrefix(sub, "add-") || offlineSubcommands[sub]
+}
+
+// offlineTarget returns the existing document a queued command changes, if
+// it names one, so sync push can tell whether it changed meanwhile
+func offlineTarget(args []string) (string, string, bool) {
+	if len(args) < 3 {
+		return "", "", false
+	}
+	if offlineCommands[args[0]] {
+		return resolveDoctype(args[1]), args[2], true
+	}
+	doctype, ok := doctypeShortNames[args[0]]
+	if !ok || strings.HasPrefix(args[1], "create") || strings.HasPrefix(args[2], "-") {
+		return "", "", false
+	}
+	return doctype, args[2], true
+}
+
+// command returns the command line of a queued operation
+func (op offlineOp) command() string {
+	return strings.Join(append(append([]string{}, op.Flags...), op.Args...), " ")
+}
+
+// opTarget returns the document a queued operation changes, with its
+// aliases expanded as they will be when it runs
+func (c *Client) opTarget(op offlineOp) (string, string, bool) {
+	args, err := c.Config.ExpandAlias(op.Args)
+	if err != nil {
+		args = op.Args
+	}
+	return offlineTarget(args)
+}
+
+// signOp signs a queued operation with the site's API credentials
+func (c *Client) signOp(op offlineOp) string {
+	signed := []interface{}{op.ID, op.Site, op.Queued.Unix(), op.Args}
+	if len(op.Flags) > 0 {
+		signed = append(signed, op.Flags)
+	}
+	payload, _ := json.Marshal(signed)
+	mac := hmac.New(sha256.New, []byte(c.Config.APIKey+":"+c.Config.APISecret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func loadOfflineQueue() ([]offlineOp, error) {
+	var queue []offlineOp
+	if err := loadLocalJSON(offlineQueueFile, &queue); err != nil {
+		return nil, err
+	}
+	return queue, nil
+}
+
+// QueueOffline records a command for sync push instead of running it. The
+// command is queued as typed, with the global flags in effect, while args
+// (its aliases and default flags expanded) tell whether it can be queued.
+func (c *Client) QueueOffline(typed, flags, args []string) error {
+	if !offlineQueueable(args) {
+		return errorf("'%s' needs the server and cannot run with --offline (only create and update commands are queued)", strings.Join(typed, " "))
+	}
+
+	queue, err := loadOfflineQueue()
+	if err != nil {
+		return err
+	}
+	id := 1
+	for _, op := range queue {
+		if op.ID >= id {
+			id = op.ID + 1
+		}
+	}
+	op := offlineOp{ID: id, Args: typed, Flags: flags, Site: c.Config.ERPURL, Queued: time.Now()}
+	op.Signature = c.signOp(op)
+	queue = append(queue, op)
+	if err := saveLocalJSON(offlineQueueFile, queue); err != nil {
+		return err
+	}
+
+	printf("%s✓ Queued #%d: erp-cli %s%s\n", Green, id, op.command(), Reset)
+	printf("  %d operation(s) pending. Run 'erp-cli sync push' when back online.\n", len(queue))
+	return nil
+}
+
+// syncQueue lists the queued operations
+func (c *Client) syncQueue() error {
+	queue, err := loadOfflineQueue()
+	if err != nil {
+		return err
+	}
+	if len(queue) == 0 {
+		printf("%sNo queued operations%s\n", Yellow, Reset)
+		return nil
+	}
+
+	fmt.Printf("%-5s %-17s %s\n", T("ID"), T("QUEUED"), T("COMMAND"))
+	fmt.Println(strings.Repeat("-", 80))
+	for _, op := range queue {
+		command := op.command()
+		if op.Site != c.Config.ERPURL {
+			command += " (" + op.Site + ")"
+		}
+		fmt.Printf("%-5d %-17s %s\n", op.ID, op.Queued.Format("2006-01-02 15:04"), command)
+	}
+	return nil
+}
+
+// syncDrop removes a queued operation without running it
+func (c *Client) syncDrop(idArg string) error {
+	id, err := strconv.Atoi(strings.TrimPrefix(idArg, "#"))
+	if err != nil {
+		return errorf("invalid operation id: %s", idArg)
+	}
+	queue, err := loadOfflineQueue()
+	if err != nil {
+		return err
+	}
+	for i, op := range queue {
+		if op.ID == id {
+			queue = append(queue[:i], queue[i+1:]...)
+			if err := saveLocalJSON(offlineQueueFile, queue); err != nil {
+				return err
+			}
+			printf("%s✓ Dropped #%d: erp-cli %s%s\n", Green, id, op.command(), Reset)
+			return nil
+		}
+	}
+	return errorf("no queued operation #%d (see 'erp-cli sync queue')", id)
+}
+
+// syncPush runs the queued operations of the configured site in order.
+// Operations on a document changed on the server since they were queued
+// are conflicts: they are reported and kept unless --force. Failed
+// operations are kept too, so a later push retries them.
+func (c *Client) syncPush(dryRun, force bool) error {
+	queue, err := loadOfflineQueue()
+	if err != nil {
+		return err
+	}
+	if len(queue) == 0 {
+		printf("%sNo queued operations%s\n", Yellow, Reset)
+		return nil
+	}
+	executable, err := os.Executable()
+	if err != nil {
+		return errorf("cannot find erp-cli executable: %w", err)
+	}
+	if dryRun {
+		printf("%s[DRY RUN] Checking %d queued operation(s)...%s\n", Yellow, len(queue), Reset)
+	} else {
+		printf("%sPushing %d queued operation(s)...%s\n", Blue, len(queue), Reset)
+	}
+
+	var kept []offlineOp
+	done, conflicts, failed := 0, 0, 0
+	// pushed holds the documents changed by this push, whose later queued
+	// operations are not conflicts with the earlier ones; blocked those
+	// with an operation kept back, whose later ones wait for it
+	pushed, blocked := map[string]bool{}, map[string]bool{}
+	for _, op := range queue {
+		if op.Site != c.Config.ERPURL {
+			kept = append(kept, op)
+			continue
+		}
+		command := "erp-cli " + op.command()
+		fmt.Println()
+		fmt.Printf("%s#%d%s %s\n", Cyan, op.ID, Reset, command)
+
+		if !hmac.Equal([]byte(op.Signature), []byte(c.signOp(op))) {
+			printf("  %s✗ Signature mismatch: not queued with these credentials (drop it with 'erp-cli sync drop %d')%s\n", Red, op.ID, Reset)
+			kept = append(kept, op)
+			failed++
+			continue
+		}
+		doctype, name, targeted := c.opTarget(op)
+		target := doctype + "/" + name
+		if targeted && blocked[target] {
+			printf("  %s⚠ Waiting for an earlier operation on %s %s%s\n", Yellow, doctype, name, Reset)
+			kept = append(kept, op)
+			continue
+		}
+		if conflict := c.offlineConflict(op); conflict != "" && !force && !pushed[target] {
+			printf("  %s⚠ Conflict: %s (push --force to run it anyway, or drop it)%s\n", Yellow, conflict, Reset)
+			kept = append(kept, op)
+			blocked[target] = targeted
+			conflicts++
+			continue
+		}
+		if dryRun {
+			printf("  %sWould run%s\n", Yellow, Reset)
+			kept = append(kept, op)
+			continue
+		}
+
+		cmd := exec.Command(executable, append(append([]string{}, op.Flags...), op.Args...)...)
+		cmd.Stdin = os.Stdin
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			printf("  %s✗ Failed, kept in the queue%s\n", Red, Reset)
+			kept = append(kept, op)
+			blocked[target] = targeted
+			failed++
+			continue
+		}
+		pushed[target] = true
+		done++
+	}
+
+	if !dryRun {
+		if err := saveLocalJSON(offlineQueueFile, kept); err != nil {
+			return err
+		}
+	}
+	fmt.Println()
+	printf("%sPushed: %d, Conflicts: %d, Failed: %d, Pending: %d%s\n", Green, done, conflicts, failed, len(kept), Reset)
+	return nil
+}
+
+// offlineConflict describes why a queued operation may no longer apply:
+// its document was deleted or changed on the server after it was queued
+func (c *Client) offlineConflict(op offlineOp) string {
+	doctype, name, ok := c.opTarget(op)
+	if !ok {
+		return ""
+	}
+	result, err := c.CallMethod("GET", "frappe.client.get_value?doctype="+url.QueryEscape(doctype)+"&fieldname=modified&filters="+url.QueryEscape(name), nil)
+	if err != nil {
+		return tf("%s %s not found", doctype, name)
+	}
+	message, _ := result["message"].(map[string]interface{})
+	modified := stringField(message, "modified")
+	// the server writes its local time, so read it in the server timezone
+	at, err := time.ParseInLocation("2006-01-02 15:04:05.999999", modified, c.GetLocation())
+	if err != nil {
+		return ""
+	}
+	if at.After(op.Queued) {
+		return tf("%s %s was modified on the server at %s, after this was queued", doctype, name, at.Format("2006-01-02 15:04:05"))
+	}
+	return ""
+}
@@ -0,0 +1,325 @@
+package erp
+
+import (
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// openingStockRow is one item/warehouse line of an opening stock CSV
+type openingStockRow struct {
+	row  int // CSV line, for the results file
+	item string
+	qty  float64
+	rate float64 // Valuation rate; 0 keeps the item's current one
+}
+
+// stockImportOptions holds flags for import stock
+type stockImportOptions struct {
+	receipt           bool   // Material Receipt instead of Stock Reconciliation
+	differenceAccount string // Stock Reconciliation only
+	posting           postingOptions
+}
+
+func parseStockImportOptions(args []string) (stockImportOptions, error) {
+	opts := stockImportOptions{}
+	for _, arg := range args {
+		if arg == "--receipt" {
+			opts.receipt = true
+		}
+		if len(arg) > 21 && arg[:21] == "--difference-account=" {
+			opts.differenceAccount = arg[21:]
+		}
+	}
+	posting, err := parsePostingOptions(args)
+	opts.posting = posting
+	return opts, err
+}
+
+// readCSVColumns reads a CSV file through mapping and maps its header
+// names to column indexes, failing when a required column is missing
+func readCSVColumns(path string, mapping *importMapping, required ...string) ([][]string, map[string]int, error) {
+	records, err := readImportCSV(path, mapping)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range required {
+		if _, ok := columns[name]; !ok {
+			return nil, nil, errorf("CSV is missing the %s column (map it with --map)", name)
+		}
+	}
+	return records[1:], columns, nil
+}
+
+// csvCell returns a trimmed cell by column name, or "" when absent
+func csvCell(record []string, columns map[string]int, name string) string {
+	i, ok := columns[name]
+	if !ok || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+// importStock loads opening stock from a CSV with item_code, warehouse, qty
+// and optional rate columns. Each warehouse gets one submitted Stock
+// Reconciliation (purpose Opening Stock), or a Material Receipt with
+// --receipt.
+func (c *Client) importStock(inputFile string, opts stockImportOptions, run *importRun) error {
+	if run.dryRun {
+		printf("%s[DRY RUN] Importing opening stock from: %s%s\n", Yellow, inputFile, Reset)
+	} else {
+		printf("%sImporting opening stock from: %s%s\n", Blue, inputFile, Reset)
+	}
+	c.printPosting(opts.posting)
+
+	records, columns, err := readCSVColumns(inputFile, run.mapping, "item_code", "warehouse", "qty")
+	if err != nil {
+		return err
+	}
+
+	byWarehouse := map[string][]openingStockRow{}
+	skipped := 0
+	for i, record := range records {
+		if run.finished(i + 2) {
+			continue
+		}
+		item := csvCell(record, columns, "item_code")
+		warehouse := csvCell(record, columns, "warehouse")
+		if item == "" || warehouse == "" {
+			printf("  %sRow %d: skipped (no item_code or warehouse)%s\n", Yellow, i+2, Reset)
+			run.record(i+2, item, rowSkipped, "no item_code or warehouse")
+			skipped++
+			continue
+		}
+		qty, err := strconv.ParseFloat(csvCell(record, columns, "qty"), 64)
+		if err != nil || qty < 0 {
+			printf("  %sRow %d: skipped (invalid qty)%s\n", Yellow, i+2, Reset)
+			run.record(i+2, item, rowSkipped, "invalid qty")
+			skipped++
+			continue
+		}
+		if opts.receipt && qty == 0 {
+			printf("  %sRow %d: skipped (zero qty)%s\n", Yellow, i+2, Reset)
+			run.record(i+2, item, rowSkipped, "zero qty")
+			skipped++
+			continue
+		}
+		rate := 0.0
+		if value := csvCell(record, columns, "rate"); value != "" {
+			rate, err = strconv.ParseFloat(value, 64)
+			if err != nil || rate < 0 {
+				printf("  %sRow %d: skipped (invalid rate)%s\n", Yellow, i+2, Reset)
+				run.record(i+2, item, rowSkipped, "invalid rate")
+				skipped++
+				continue
+			}
+		}
+		byWarehouse[warehouse] = append(byWarehouse[warehouse], openingStockRow{row: i + 2, item: item, qty: qty, rate: rate})
+	}
+
+	warehouses := make([]string, 0, len(byWarehouse))
+	for w := range byWarehouse {
+		warehouses = append(warehouses, w)
+	}
+	sort.Strings(warehouses)
+
+	doctype := "Stock Reconciliation"
+	if opts.receipt {
+		doctype = "Stock Entry"
+	}
+
+	if run.dryRun {
+		for _, w := range warehouses {
+			printf("  [DRY RUN] Would create %s for %s (%d items)\n", doctype, w, len(byWarehouse[w]))
+		}
+		printf("\n%sSummary: %d documents, %d skipped%s\n", Cyan, len(warehouses), skipped, Reset)
+		return nil
+	}
+	if len(warehouses) == 0 {
+		printf("\n%sSummary: 0 created, %d skipped, 0 failed%s\n", Cyan, skipped, Reset)
+		return nil
+	}
+
+	company, err := c.GetCompany()
+	if err != nil {
+		return err
+	}
+	if !opts.receipt && opts.differenceAccount == "" {
+		opts.differenceAccount, err = c.temporaryOpeningAccount(company)
+		if err != nil {
+			return err
+		}
+	}
+
+	created := 0
+	failed := 0
+	for _, w := range warehouses {
+		var body map[string]interface{}
+		if opts.receipt {
+			body = openingReceiptBody(company, w, byWarehouse[w])
+		} else {
+			body = openingReconciliationBody(company, w, opts.differenceAccount, byWarehouse[w])
+		}
+		if err := c.applyPosting(body, opts.posting, true); err != nil {
+			return err
+		}
+
+		result, err := c.Request("POST", url.PathEscape(doctype), body)
+		if err != nil {
+			printf("  %s✗ Failed: %s (%s)%s\n", Red, w, err, Reset)
+			recordStockRows(run, byWarehouse[w], rowError, err.Error())
+			failed++
+			continue
+		}
+		data, _ := result["data"].(map[string]interface{})
+		name := stringField(data, "name")
+		if err := c.submitDocument(doctype, name); err != nil {
+			printf("  %s✗ %s created but not submitted: %s (%s)%s\n", Yellow, name, w, err, Reset)
+			// The draft exists, so a resumed run must not create it again
+			recordStockRows(run, byWarehouse[w], rowCreated, tf("%s not submitted: %s", name, err))
+			failed++
+			continue
+		}
+		printf("  %s✓ %s: %s (%d items)%s\n", Green, name, w, len(byWarehouse[w]), Reset)
+		recordStockRows(run, byWarehouse[w], rowCreated, name)
+		created++
+	}
+
+	printf("\n%sSummary: %d created, %d skipped, %d failed%s\n", Cyan, created, skipped, failed, Reset)
+	return nil
+}
+
+// recordStockRows records the outcome of one warehouse document for each of
+// its rows
+func recordStockRows(run *importRun, rows []openingStockRow, status, message string) {
+	for _, r := range rows {
+		run.record(r.row, r.item, status, message)
+	}
+}
+
+func openingReconciliationBody(company, warehouse, account string, rows []openingStockRow) map[string]interface{} {
+	var items []interface{}
+	for _, r := range rows {
+		item := map[string]interface{}{
+			"item_code": r.item,
+			"warehouse": warehouse,
+			"qty":       r.qty,
+		}
+		if r.rate > 0 {
+			item["valuation_rate"] = r.rate
+		}
+		items = append(items, item)
+	}
+	return map[string]interface{}{
+		"company":         company,
+		"purpose":         "Opening Stock",
+		"expense_account": account,
+		"items":           items,
+	}
+}
+
+func openingReceiptBody(company, warehouse string, rows []openingStockRow) map[string]interface{} {
+	var items []interface{}
+	for _, r := range rows {
+		item := map[string]interface{}{
+			"item_code":   r.item,
+			"qty":         r.qty,
+			"t_warehouse": warehouse,
+		}
+		if r.rate > 0 {
+			item["basic_rate"] = r.rate
+		}
+		items = append(items, item)
+	}
+	return map[string]interface{}{
+		"stock_entry_type": "Material Receipt",
+		"company":          company,
+		"items":            items,
+	}
+}
+
+// temporaryOpeningAccount finds the company's Temporary Opening account,
+// which ERPNext expects as difference account of an opening reconciliation
+func (c *Client) temporaryOpeningAccount(company string) (string, error) {
+	filters, err := encodeFilters([][]interface{}{
+		{"company", "=", company},
+		{"account_type", "=", "Temporary"},
+		{"is_group", "=", 0},
+	})
+	if err != nil {
+		return "", err
+	}
+	result, err := c.Request("GET", "Account?fields=[\"name\"]&filters="+filters, nil)
+	if err != nil {
+		return "", err
+	}
+	if data, ok := result["data"].([]interface{}); ok && len(data) > 0 {
+		if m, ok := data[0].(map[string]interface{}); ok {
+			return stringField(m, "name"), nil
+		}
+	}
+	return "", errorf("no Temporary Opening account in %s (use --difference-account=X)", company)
+}
+
+// importPrices creates or updates Item Prices from a CSV with item_code,
+// price_list and rate columns
+func (c *Client) importPrices(inputFile string, run *importRun) error {
+	if run.dryRun {
+		printf("%s[DRY RUN] Importing item prices from: %s%s\n", Yellow, inputFile, Reset)
+	} else {
+		printf("%sImporting item prices from: %s%s\n", Blue, inputFile, Reset)
+	}
+
+	records, columns, err := readCSVColumns(inputFile, run.mapping, "item_code", "price_list", "rate")
+	if err != nil {
+		return err
+	}
+
+	set := 0
+	skipped := 0
+	failed := 0
+	for i, record := range records {
+		if run.finished(i + 2) {
+			continue
+		}
+		item := csvCell(record, columns, "item_code")
+		priceList := csvCell(record, columns, "price_list")
+		if item == "" || priceList == "" {
+			printf("  %sRow %d: skipped (no item_code or price_list)%s\n", Yellow, i+2, Reset)
+			run.record(i+2, item, rowSkipped, "no item_code or price_list")
+			skipped++
+			continue
+		}
+		rate, err := strconv.ParseFloat(csvCell(record, columns, "rate"), 64)
+		if err != nil || rate < 0 {
+			printf("  %sRow %d: skipped (invalid rate)%s\n", Yellow, i+2, Reset)
+			run.record(i+2, item, rowSkipped, "invalid rate")
+			skipped++
+			continue
+		}
+
+		if run.dryRun {
+			printf("  [DRY RUN] Would set: %s @ %s = %.2f\n", item, priceList, rate)
+			set++
+			continue
+		}
+		if err := c.setItemPrice(item, priceList, rate); err != nil {
+			printf("  %s✗ Failed: %s @ %s (%s)%s\n", Red, item, priceList, err, Reset)
+			run.record(i+2, item, rowError, err.Error())
+			failed++
+			continue
+		}
+		printf("  %s✓ Set: %s @ %s = %.2f%s\n", Green, item, priceList, rate, Reset)
+		run.record(i+2, item, rowSet, priceList)
+		set++
+	}
+
+	printf("\n%sSummary: %d set, %d skipped, %d failed%s\n", Cyan, set, skipped, failed, Reset)
+	return nil
+}
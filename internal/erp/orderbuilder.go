@@ -0,0 +1,369 @@
+package erp
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// linkSearchLimit is how many suggestions a lookup offers
+const linkSearchLimit = 10
+
+// linkMatch is a suggestion from the desk's link field search
+type linkMatch struct {
+	value       string
+	description string
+}
+
+// orderLine is a line of an order being built interactively
+type orderLine struct {
+	itemCode     string
+	customerCode string // The customer's own code, when that was typed
+	qty          float64
+	rate         float64
+}
+
+// orderBuilder prompts for an order line by line on stdin
+type orderBuilder struct {
+	c      *Client
+	reader *bufio.Reader
+}
+
+// soCreateInteractive builds a Sales Order by prompting for each line, with
+// item lookup, the price list rate as default and a running total
+func (c *Client) soCreateInteractive(customer string, team []map[string]interface{}, discount docDiscount, shipping, date string, terms docTerms, addr docAddresses) error {
+	b := &orderBuilder{c: c, reader: bufio.NewReader(os.Stdin)}
+
+	printf("%sNew Sales Order%s\n", Cyan, Reset)
+	fmt.Println(T("  Type part of an item code or name to look it up. A blank item finishes"))
+	fmt.Println(T("  the order, - removes the last line. Ctrl+D aborts."))
+
+	for customer == "" {
+		input, err := b.prompt("\nCustomer: ")
+		if err != nil {
+			return b.aborted(err)
+		}
+		if input == "" {
+			continue
+		}
+		match, err := b.pick("Customer", input)
+		if err != nil {
+			return b.aborted(err)
+		}
+		customer = match.value
+	}
+
+	priceList := c.sellingPriceList(customer)
+	printf("  Customer: %s | Price List: %s\n", customer, priceList)
+
+	var lines []orderLine
+	for {
+		input, err := b.prompt(tf("\nItem %d: ", len(lines)+1))
+		if err != nil {
+			return b.aborted(err)
+		}
+		if input == "" {
+			break
+		}
+		if input == "-" {
+			if len(lines) > 0 {
+				removed := lines[len(lines)-1]
+				lines = lines[:len(lines)-1]
+				printf("  %sRemoved %s%s\n", Yellow, removed.itemCode, Reset)
+				b.printTotal(lines)
+			}
+			continue
+		}
+
+		line, ok, err := b.readLine(customer, priceList, input)
+		if err != nil {
+			return b.aborted(err)
+		}
+		if !ok {
+			continue
+		}
+		lines = append(lines, line)
+		fmt.Printf("  %s✓ %s x %s @ %s = %s%s\n", Green, line.itemCode, formatQty(line.qty),
+			c.FormatCurrency(line.rate), c.FormatCurrency(line.qty*line.rate), Reset)
+		b.printTotal(lines)
+	}
+
+	if len(lines) == 0 {
+		printf("%sNo items, nothing created%s\n", Yellow, Reset)
+		return nil
+	}
+
+	total := 0.0
+	printf("\n%sSales Order for %s:%s\n", Cyan, customer, Reset)
+	for _, line := range lines {
+		fmt.Printf("  %-20s %8s x %12s = %14s\n", line.itemCode, formatQty(line.qty), c.FormatCurrency(line.rate), c.FormatCurrency(line.qty*line.rate))
+		total += line.qty * line.rate
+	}
+	printf("  %sTotal: %s%s (before taxes)\n", Green, c.FormatCurrency(total), Reset)
+
+	answer, err := b.prompt("\nCreate this sales order? [y/N]: ")
+	if err != nil {
+		return b.aborted(err)
+	}
+	if answer = strings.ToLower(answer); answer != "y" && answer != "yes" {
+		printf("%sAborted%s\n", Yellow, Reset)
+		return nil
+	}
+
+	return c.soCreateWithLines(customer, team, lines, discount, shipping, date, terms, addr)
+}
+
+// readLine asks for the item, quantity and rate of one line. ok is false
+// when no item was picked.
+func (b *orderBuilder) readLine(customer, priceList, input string) (orderLine, bool, error) {
+	line := orderLine{}
+
+	code, customerCode, err := b.c.resolveCustomerItemCode(customer, input)
+	if err != nil {
+		return line, false, err
+	}
+	if customerCode != "" {
+		printf("  → %s (customer code: %s)\n", code, customerCode)
+		line.itemCode, line.customerCode = code, customerCode
+	} else {
+		match, err := b.pick("Item", input)
+		if err != nil {
+			return line, false, err
+		}
+		if match.value == "" {
+			return line, false, nil
+		}
+		line.itemCode = match.value
+	}
+
+	for line.qty == 0 {
+		input, err := b.prompt("  Qty [1]: ")
+		if err != nil {
+			return line, false, err
+		}
+		if input == "" {
+			line.qty = 1
+			break
+		}
+		qty, err := strconv.ParseFloat(input, 64)
+		if err != nil || qty <= 0 {
+			printf("  %sEnter a positive number%s\n", Yellow, Reset)
+			continue
+		}
+		line.qty = qty
+	}
+
+	// The pricing engine's rate is the default; without one a rate is required
+	suggested := -1.0
+	if price, err := b.c.resolvePrice(line.itemCode, customer, line.qty, priceList); err == nil && price.rate > 0 {
+		suggested = price.rate
+	}
+	for {
+		label := "  Rate: "
+		if suggested >= 0 {
+			label = tf("  Rate [%s]: ", b.c.FormatCurrency(suggested))
+		}
+		input, err := b.prompt(label)
+		if err != nil {
+			return line, false, err
+		}
+		if input == "" && suggested >= 0 {
+			line.rate = suggested
+			break
+		}
+		rate, err := strconv.ParseFloat(input, 64)
+		if err != nil || rate < 0 {
+			printf("  %sEnter a rate (no price on %s)%s\n", Yellow, priceList, Reset)
+			continue
+		}
+		line.rate = rate
+		break
+	}
+	return line, true, nil
+}
+
+// pick looks up a document by code or part of its name. An exact name is
+// taken as is; otherwise the matches are listed to choose from. An empty
+// value means nothing was picked.
+func (b *orderBuilder) pick(doctype, input string) (linkMatch, error) {
+	if _, err := b.c.Request("GET", url.PathEscape(doctype)+"/"+url.PathEscape(input), nil); err == nil {
+		return linkMatch{value: input}, nil
+	}
+
+	matches, err := b.c.searchLink(doctype, input, linkSearchLimit)
+	if err != nil {
+		return linkMatch{}, err
+	}
+	switch len(matches) {
+	case 0:
+		printf("  %sNo %s matches %q%s\n", Yellow, strings.ToLower(doctype), input, Reset)
+		return linkMatch{}, nil
+	case 1:
+		fmt.Printf("  → %s\n", matchLabel(matches[0]))
+		return matches[0], nil
+	}
+
+	for i, m := range matches {
+		fmt.Printf("  %2d. %s\n", i+1, matchLabel(m))
+	}
+	for {
+		choice, err := b.prompt(tf("  Pick 1-%d (blank to search again): ", len(matches)))
+		if err != nil {
+			return linkMatch{}, err
+		}
+		if choice == "" {
+			return linkMatch{}, nil
+		}
+		n, err := strconv.Atoi(choice)
+		if err == nil && n >= 1 && n <= len(matches) {
+			return matches[n-1], nil
+		}
+	}
+}
+
+// prompt reads one trimmed line; io.EOF (Ctrl+D) aborts the builder
+func (b *orderBuilder) prompt(label string) (string, error) {
+	fmt.Print(T(label))
+	input, err := b.reader.ReadString('\n')
+	if err != nil && (err != io.EOF || input == "") {
+		return "", err
+	}
+	return strings.TrimSpace(input), nil
+}
+
+// aborted turns end of input into a clean exit
+func (b *orderBuilder) aborted(err error) error {
+	if err == io.EOF {
+		printf("\n%sAborted%s\n", Yellow, Reset)
+		return nil
+	}
+	return err
+}
+
+// printTotal prints the running total of the lines so far
+func (b *orderBuilder) printTotal(lines []orderLine) {
+	total := 0.0
+	for _, line := range lines {
+		total += line.qty * line.rate
+	}
+	printf("  Running total: %s (%d lines)\n", b.c.FormatCurrency(total), len(lines))
+}
+
+// searchLink runs the desk's link field search, which matches codes, names
+// and the doctype's search fields
+func (c *Client) searchLink(doctype, txt string, limit int) ([]linkMatch, error) {
+	result, err := c.CallMethod("GET", fmt.Sprintf("frappe.desk.search.search_link?doctype=%s&txt=%s&page_length=%d",
+		url.QueryEscape(doctype), url.QueryEscape(txt), limit), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Newer versions return the matches, older ones set "results"
+	list, ok := result["message"].([]interface{})
+	if !ok {
+		list, _ = result["results"].([]interface{})
+	}
+
+	var matches []linkMatch
+	for _, item := range list {
+		if m, ok := item.(map[string]interface{}); ok {
+			description := stringField(m, "description")
+			if description == "" {
+				description = stringField(m, "label")
+			}
+			matches = append(matches, linkMatch{
+				value:       stringField(m, "value"),
+				description: firstLine(htmlToText(description)),
+			})
+		}
+	}
+	return matches, nil
+}
+
+// matchLabel renders a suggestion as "value - description"
+func matchLabel(m linkMatch) string {
+	if m.description == "" || m.description == m.value {
+		return m.value
+	}
+	return m.value + " - " + m.description
+}
+
+// formatQty renders a quantity without trailing zeros
+func formatQty(qty float64) string {
+	return strconv.FormatFloat(qty, 'f', -1, 64)
+}
+
+// soCreateWithLines creates a draft Sales Order with all its lines at once
+func (c *Client) soCreateWithLines(customer string, team []map[string]interface{}, lines []orderLine, discount docDiscount, shipping, date string, terms docTerms, addr docAddresses) error {
+	company, err := c.GetCompany()
+	if err != nil {
+		return err
+	}
+	date, err = c.documentDate(date)
+	if err != nil {
+		return err
+	}
+
+	var items []map[string]interface{}
+	for _, line := range lines {
+		row := map[string]interface{}{
+			"item_code":     line.itemCode,
+			"qty":           line.qty,
+			"rate":          line.rate,
+			"delivery_date": date,
+		}
+		if line.customerCode != "" {
+			row["customer_item_code"] = line.customerCode
+		}
+		items = append(items, row)
+	}
+
+	body := map[string]interface{}{
+		"customer":         customer,
+		"transaction_date": date,
+		"delivery_date":    date,
+		"company":          company,
+		"items":            items,
+	}
+	if len(team) > 0 {
+		body["sales_team"] = team
+	}
+	if err := c.applyDiscount(body, discount); err != nil {
+		return err
+	}
+	if err := c.applyShippingRule(body, shipping); err != nil {
+		return err
+	}
+
+	if err := c.applyAddresses(body, addr); err != nil {
+		return err
+	}
+	if terms, err = c.applyTerms(body, "Sales Order", terms, true); err != nil {
+		return err
+	}
+
+	result, err := c.Request("POST", "Sales%20Order", body)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].(map[string]interface{}); ok {
+		soName := data["name"]
+		grandTotal, _ := data["grand_total"].(float64)
+		printf("%s✓ Sales Order created: %s%s\n", Green, soName, Reset)
+		printf("  Items: %d\n", len(items))
+		printf("  Grand Total: %s\n", c.FormatCurrency(grandTotal))
+		c.printDiscount(discount)
+		printShippingRule(shipping)
+		printf("  Status: Draft\n")
+		printAddresses(addr)
+		printTerms(terms)
+		c.finishRemarks("Sales Order", stringField(data, "name"), terms)
+		printf("  Use 'erp-cli so submit %s' to submit\n", soName)
+	}
+	return nil
+}
@@ -0,0 +1,327 @@
+package erp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// customPanelRows is how many report rows a custom panel shows
+const customPanelRows = 5
+
+// panelLine is one line of a dashboard panel. Lines without a value are
+// sub-headings; alert and good pick the highlight colour of the value.
+type panelLine struct {
+	label string
+	value string
+	alert bool
+	good  bool
+}
+
+// dashboardPanel is one section of the dashboard, shared by the CLI and the
+// TUI. fetch fills its part of ReportData; lines renders it.
+type dashboardPanel struct {
+	id    string
+	title string
+	fetch func(c *Client, data *ReportData, mu *sync.Mutex)
+	lines func(c *Client, data *ReportData) []panelLine
+}
+
+// customPanel holds the result of a query report shown as a panel
+type customPanel struct {
+	report string
+	result *queryReportResult
+	err    string
+}
+
+// builtinPanels lists the standard panels in their default order
+var builtinPanels = []dashboardPanel{
+	{id: "stock", title: "STOCK", fetch: (*Client).fetchStockMetrics, lines: stockPanelLines},
+	{id: "sales", title: "SALES", fetch: (*Client).fetchSalesMetrics, lines: salesPanelLines},
+	{id: "purchasing", title: "PURCHASES", fetch: (*Client).fetchPurchaseMetrics, lines: purchasingPanelLines},
+	{id: "receivables", title: "PAYMENTS", fetch: (*Client).fetchPaymentMetrics, lines: receivablesPanelLines},
+	{id: "system", title: "SYSTEM", fetch: (*Client).fetchSystemMetrics, lines: systemPanelLines},
+	{id: "trends", title: "TRENDS", fetch: (*Client).fetchTrendMetrics, lines: trendsPanelLines},
+}
+
+// snapshotPanels are the panels whose metrics feed `report diff`
+var snapshotPanels = []string{"stock", "sales", "purchasing", "receivables"}
+
+// dashboardPanels resolves ERP_DASHBOARD_PANELS into the panels to show, in
+// order. Without the setting every built-in panel is shown, followed by the
+// custom panels sorted by id. Unknown ids are returned separately.
+func (c *Client) dashboardPanels() ([]dashboardPanel, []string) {
+	ids := c.Config.DashboardPanels
+	if len(ids) == 0 {
+		for _, p := range builtinPanels {
+			ids = append(ids, p.id)
+		}
+		var custom []string
+		for id := range c.Config.CustomPanels {
+			custom = append(custom, id)
+		}
+		sort.Strings(custom)
+		ids = append(ids, custom...)
+	}
+
+	var panels []dashboardPanel
+	var unknown []string
+	seen := map[string]bool{}
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if p, ok := builtinPanel(id); ok {
+			panels = append(panels, p)
+			continue
+		}
+		if spec, ok := c.Config.CustomPanels[id]; ok {
+			panels = append(panels, customDashboardPanel(id, spec))
+			continue
+		}
+		unknown = append(unknown, id)
+	}
+	return panels, unknown
+}
+
+func builtinPanel(id string) (dashboardPanel, bool) {
+	for _, p := range builtinPanels {
+		if p.id == id {
+			return p, true
+		}
+	}
+	return dashboardPanel{}, false
+}
+
+// customDashboardPanel builds a panel from a panel.<id> config value:
+// the report name followed by optional key=value filters
+func customDashboardPanel(id, spec string) dashboardPanel {
+	return dashboardPanel{
+		id:    id,
+		title: strings.ToUpper(id),
+		fetch: func(c *Client, data *ReportData, mu *sync.Mutex) {
+			panel := &customPanel{}
+			report, filters, err := parsePanelSpec(spec)
+			panel.report = report
+			if err == nil {
+				panel.result, err = c.queryReport(report, filters)
+			}
+			if err != nil {
+				panel.err = err.Error()
+			}
+			mu.Lock()
+			data.Custom[id] = panel
+			mu.Unlock()
+		},
+		lines: func(c *Client, data *ReportData) []panelLine {
+			return customPanelLines(c, data.Custom[id])
+		},
+	}
+}
+
+// parsePanelSpec splits `"Report Name" key=value ...` into the report and
+// its filters
+func parsePanelSpec(spec string) (string, map[string]interface{}, error) {
+	args, err := splitArgs(spec)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(args) == 0 {
+		return "", nil, errorf("no report name")
+	}
+	filters := map[string]interface{}{}
+	for _, arg := range args[1:] {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok || key == "" {
+			return args[0], nil, errorf("invalid filter %q (expected key=value)", arg)
+		}
+		filters[key] = value
+	}
+	return args[0], filters, nil
+}
+
+// fetchReportData gathers the metrics of the given panels concurrently
+func (c *Client) fetchReportData(panels []dashboardPanel) *ReportData {
+	// Pre-fetch currency
+	c.GetCurrency()
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	data := &ReportData{Custom: map[string]*customPanel{}}
+
+	for _, p := range panels {
+		data.Panels = append(data.Panels, p.id)
+		wg.Add(1)
+		go func(p dashboardPanel) {
+			defer wg.Done()
+			p.fetch(c, data, &mu)
+		}(p)
+	}
+	wg.Wait()
+
+	return data
+}
+
+// hasPanels reports whether all the given panels were fetched
+func (data *ReportData) hasPanels(ids []string) bool {
+	for _, id := range ids {
+		found := false
+		for _, p := range data.Panels {
+			if p == id {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func stockPanelLines(c *Client, data *ReportData) []panelLine {
+	return []panelLine{
+		{label: T("Total Items"), value: fmt.Sprintf("%d", data.TotalItems)},
+		{label: T("Inventory Value"), value: c.FormatCurrency(data.TotalStockValue)},
+		{label: T("Zero Stock Items"), value: fmt.Sprintf("%d", data.ZeroStockItems), alert: data.ZeroStockItems > 0},
+	}
+}
+
+func salesPanelLines(c *Client, data *ReportData) []panelLine {
+	return []panelLine{
+		{label: T("Open Quotations"), value: fmt.Sprintf("%d", data.OpenQuotations)},
+		{label: T("Pending SOs"), value: fmt.Sprintf("%d", data.PendingSOs)},
+		{label: T("Completed SOs"), value: fmt.Sprintf("%d (%s)", data.CompletedSOs, c.FormatCurrency(data.CompletedSOValue)), good: true},
+		{label: T("Unpaid Invoices"), value: fmt.Sprintf("%d (%s)", data.UnpaidSIs, c.FormatCurrency(data.UnpaidSIValue)), alert: data.UnpaidSIs > 0},
+	}
+}
+
+func purchasingPanelLines(c *Client, data *ReportData) []panelLine {
+	lines := []panelLine{
+		{label: T("Draft POs"), value: fmt.Sprintf("%d (%s)", data.DraftPOs, c.FormatCurrency(data.DraftPOValue))},
+		{label: T("Pending POs"), value: fmt.Sprintf("%d (%s)", data.PendingPOs, c.FormatCurrency(data.PendingPOValue))},
+		{label: T("Completed POs"), value: fmt.Sprintf("%d (%s)", data.CompletedPOs, c.FormatCurrency(data.CompletedPOValue)), good: true},
+		{label: T("Unpaid Invoices"), value: fmt.Sprintf("%d (%s)", data.UnpaidInvoices, c.FormatCurrency(data.UnpaidValue)), alert: data.UnpaidInvoices > 0},
+	}
+	if len(data.TopSuppliers) > 0 {
+		lines = append(lines, panelLine{}, panelLine{label: T("Top Suppliers:")})
+		for i, s := range data.TopSuppliers {
+			name := s.Name
+			if len(name) > 25 {
+				name = name[:22] + "..."
+			}
+			lines = append(lines, panelLine{label: fmt.Sprintf("  %d. %s", i+1, name), value: tf("%d POs", s.POCount)})
+		}
+	}
+	return lines
+}
+
+func receivablesPanelLines(c *Client, data *ReportData) []panelLine {
+	return []panelLine{
+		{label: T("Receivables"), value: c.FormatCurrency(data.TotalReceivables), good: data.TotalReceivables > 0},
+		{label: T("Payables"), value: c.FormatCurrency(data.TotalPayables), alert: data.TotalPayables > 0},
+	}
+}
+
+func systemPanelLines(c *Client, data *ReportData) []panelLine {
+	return []panelLine{
+		{label: T("Suppliers"), value: fmt.Sprintf("%d", data.TotalSuppliers)},
+		{label: T("Customers"), value: fmt.Sprintf("%d", data.TotalCustomers)},
+		{label: T("Warehouses"), value: fmt.Sprintf("%d", data.TotalWarehouses)},
+		{label: T("Item Groups"), value: fmt.Sprintf("%d", data.TotalGroups)},
+	}
+}
+
+// customPanelLines shows the report summary when there is one, otherwise
+// the first rows as "first column: second column"
+func customPanelLines(c *Client, panel *customPanel) []panelLine {
+	if panel == nil {
+		return nil
+	}
+	lines := []panelLine{{label: panel.report}}
+	if panel.err != "" {
+		return append(lines, panelLine{label: T("Error"), value: panel.err, alert: true})
+	}
+
+	result := panel.result
+	if len(result.summary) > 0 {
+		for _, s := range result.summary {
+			lines = append(lines, panelLine{label: s.label, value: c.formatReportValue(s.value, s.datatype)})
+		}
+		return lines
+	}
+
+	if len(result.rows) == 0 || len(result.columns) == 0 {
+		return append(lines, panelLine{label: T("No rows")})
+	}
+	for i, row := range result.rows {
+		if i >= customPanelRows {
+			lines = append(lines, panelLine{label: tf("... and %d more rows", len(result.rows)-customPanelRows)})
+			break
+		}
+		line := panelLine{label: c.formatReportValue(row[result.columns[0].fieldname], result.columns[0].fieldtype)}
+		if len(result.columns) > 1 {
+			line.value = c.formatReportValue(row[result.columns[1].fieldname], result.columns[1].fieldtype)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// formatReportValue renders a report cell according to its fieldtype
+func (c *Client) formatReportValue(value interface{}, fieldtype string) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case float64:
+		switch fieldtype {
+		case "Currency":
+			return c.FormatCurrency(v)
+		case "Int":
+			return fmt.Sprintf("%d", int64(v))
+		case "Percent":
+			return fmt.Sprintf("%.1f%%", v)
+		}
+		if v == float64(int64(v)) {
+			return fmt.Sprintf("%d", int64(v))
+		}
+		return fmt.Sprintf("%.2f", v)
+	case string:
+		return v
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// renderPanelBox draws a panel as a box for the CLI dashboard
+func renderPanelBox(title string, lines []panelLine) {
+	const width = 61 // Inside the borders
+
+	top := "─ " + T(title) + " "
+	fmt.Print(asciiText(fmt.Sprintf("%s┌%s%s┐%s\n", Yellow, top, strings.Repeat("─", width-utf8.RuneCountInString(top)), Reset)))
+	for _, l := range lines {
+		text := "  " + l.label
+		if l.value != "" {
+			text = fmt.Sprintf("  %-20s %s", l.label+":", l.value)
+		}
+		if n := utf8.RuneCountInString(text); n > width {
+			text = string([]rune(text)[:width-3]) + "..."
+		}
+		pad := strings.Repeat(" ", width-utf8.RuneCountInString(text))
+
+		// Colour only the value so the padding stays aligned
+		if l.value != "" && (l.alert || l.good) {
+			color := Green
+			if l.alert {
+				color = Red
+			}
+			prefix := strings.TrimSuffix(text, l.value)
+			if len(prefix) < len(text) {
+				text = prefix + color + l.value + Reset
+			}
+		}
+		fmt.Print(asciiText(fmt.Sprintf("%s│%s%s%s%s│%s\n", Yellow, Reset, text, pad, Yellow, Reset)))
+	}
+	fmt.Print(asciiText(fmt.Sprintf("%s└%s┘%s\n", Yellow, strings.Repeat("─", width), Reset)))
+}
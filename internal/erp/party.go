@@ -0,0 +1,391 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Shared Customer/Supplier commands: field updates, addresses and contacts.
+// Addresses and Contacts are separate doctypes linked to the party through
+// their "links" (Dynamic Link) child table.
+
+// partyFieldAliases maps friendly `set` keys to fields, per party doctype
+var partyFieldAliases = map[string]map[string]string{
+	"Customer": {
+		"name":      "customer_name",
+		"group":     "customer_group",
+		"type":      "customer_type",
+		"territory": "territory",
+		"currency":  "default_currency",
+		"tax-id":    "tax_id",
+	},
+	"Supplier": {
+		"name":     "supplier_name",
+		"group":    "supplier_group",
+		"type":     "supplier_type",
+		"country":  "country",
+		"currency": "default_currency",
+		"tax-id":   "tax_id",
+	},
+}
+
+func partyEndpoint(doctype, name string) string {
+	return doctype + "/" + url.PathEscape(name)
+}
+
+// partySet updates Customer/Supplier fields from property=value settings
+func (c *Client) partySet(doctype, name string, settings []string) error {
+	printf("%sUpdating %s: %s%s\n", Blue, strings.ToLower(doctype), name, Reset)
+
+	body := make(map[string]interface{})
+	for _, setting := range settings {
+		parts := strings.SplitN(setting, "=", 2)
+		if len(parts) != 2 {
+			return errorf("invalid setting format '%s'. Use 'property=value'", setting)
+		}
+		key := strings.TrimSpace(parts[0])
+		value := strings.TrimSpace(parts[1])
+
+		if field, ok := partyFieldAliases[doctype][key]; ok {
+			key = field
+		}
+
+		switch key {
+		case "disabled":
+			if value == "on" || value == "1" || value == "true" {
+				body["disabled"] = 1
+			} else if value == "off" || value == "0" || value == "false" {
+				body["disabled"] = 0
+			} else {
+				return errorf("invalid value for disabled: use 'on' or 'off'")
+			}
+		default:
+			body[key] = value
+		}
+		fmt.Printf("  %s: %s\n", key, value)
+	}
+
+	if len(body) == 0 {
+		return errorf("no valid settings provided")
+	}
+
+	_, err := c.Request("PUT", partyEndpoint(doctype, name), body)
+	if err != nil {
+		return err
+	}
+
+	printf("%s✓ %s updated: %s%s\n", Green, doctype, name, Reset)
+	return nil
+}
+
+// addressOptions holds flags for add-address
+type addressOptions struct {
+	addressType string
+	line1       string
+	line2       string
+	city        string
+	state       string
+	pincode     string
+	country     string
+	email       string
+	phone       string
+	primary     bool
+	shipping    bool
+}
+
+func parseAddressOptions(args []string) addressOptions {
+	opts := addressOptions{addressType: "Billing"}
+	for _, arg := range args {
+		switch {
+		case len(arg) > 7 && arg[:7] == "--type=":
+			opts.addressType = arg[7:]
+		case len(arg) > 8 && arg[:8] == "--line1=":
+			opts.line1 = arg[8:]
+		case len(arg) > 8 && arg[:8] == "--line2=":
+			opts.line2 = arg[8:]
+		case len(arg) > 7 && arg[:7] == "--city=":
+			opts.city = arg[7:]
+		case len(arg) > 8 && arg[:8] == "--state=":
+			opts.state = arg[8:]
+		case len(arg) > 6 && arg[:6] == "--zip=":
+			opts.pincode = arg[6:]
+		case len(arg) > 10 && arg[:10] == "--country=":
+			opts.country = arg[10:]
+		case len(arg) > 8 && arg[:8] == "--email=":
+			opts.email = arg[8:]
+		case len(arg) > 8 && arg[:8] == "--phone=":
+			opts.phone = arg[8:]
+		case arg == "--primary":
+			opts.primary = true
+		case arg == "--shipping":
+			opts.shipping = true
+		}
+	}
+	return opts
+}
+
+// partyAddAddress creates an Address linked to a Customer/Supplier
+func (c *Client) partyAddAddress(doctype, name string, opts addressOptions) error {
+	if opts.line1 == "" || opts.city == "" || opts.country == "" {
+		return errorf("--line1, --city and --country are required")
+	}
+
+	printf("%sAdding %s address to %s: %s%s\n", Blue, opts.addressType, strings.ToLower(doctype), name, Reset)
+	fmt.Printf("  %s\n", formatAddress(map[string]interface{}{
+		"address_line1": opts.line1, "address_line2": opts.line2, "city": opts.city,
+		"state": opts.state, "pincode": opts.pincode, "country": opts.country,
+	}))
+
+	body := map[string]interface{}{
+		"address_title": name,
+		"address_type":  opts.addressType,
+		"address_line1": opts.line1,
+		"city":          opts.city,
+		"country":       opts.country,
+		"links": []interface{}{
+			map[string]interface{}{"link_doctype": doctype, "link_name": name},
+		},
+	}
+	optional := map[string]string{
+		"address_line2": opts.line2,
+		"state":         opts.state,
+		"pincode":       opts.pincode,
+		"email_id":      opts.email,
+		"phone":         opts.phone,
+	}
+	for field, value := range optional {
+		if value != "" {
+			body[field] = value
+		}
+	}
+	if opts.primary {
+		body["is_primary_address"] = 1
+	}
+	if opts.shipping {
+		body["is_shipping_address"] = 1
+	}
+
+	result, err := c.Request("POST", "Address", body)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].(map[string]interface{}); ok {
+		printf("%s✓ Address created: %s%s\n", Green, data["name"], Reset)
+	}
+	return nil
+}
+
+// contactOptions holds flags for add-contact
+type contactOptions struct {
+	lastName string
+	email    string
+	phone    string
+	mobile   string
+	primary  bool
+}
+
+func parseContactOptions(args []string) contactOptions {
+	opts := contactOptions{}
+	for _, arg := range args {
+		switch {
+		case len(arg) > 7 && arg[:7] == "--last=":
+			opts.lastName = arg[7:]
+		case len(arg) > 8 && arg[:8] == "--email=":
+			opts.email = arg[8:]
+		case len(arg) > 8 && arg[:8] == "--phone=":
+			opts.phone = arg[8:]
+		case len(arg) > 9 && arg[:9] == "--mobile=":
+			opts.mobile = arg[9:]
+		case arg == "--primary":
+			opts.primary = true
+		}
+	}
+	return opts
+}
+
+// partyAddContact creates a Contact linked to a Customer/Supplier
+func (c *Client) partyAddContact(doctype, name, firstName string, opts contactOptions) error {
+	printf("%sAdding contact to %s: %s%s\n", Blue, strings.ToLower(doctype), name, Reset)
+
+	body := map[string]interface{}{
+		"first_name": firstName,
+		"links": []interface{}{
+			map[string]interface{}{"link_doctype": doctype, "link_name": name},
+		},
+	}
+	if opts.lastName != "" {
+		body["last_name"] = opts.lastName
+	}
+	if opts.primary {
+		body["is_primary_contact"] = 1
+	}
+	if opts.email != "" {
+		body["email_ids"] = []interface{}{
+			map[string]interface{}{"email_id": opts.email, "is_primary": 1},
+		}
+		printf("  Email: %s\n", opts.email)
+	}
+
+	var phones []interface{}
+	if opts.phone != "" {
+		phones = append(phones, map[string]interface{}{"phone": opts.phone, "is_primary_phone": 1})
+		printf("  Phone: %s\n", opts.phone)
+	}
+	if opts.mobile != "" {
+		phones = append(phones, map[string]interface{}{"phone": opts.mobile, "is_primary_mobile_no": 1})
+		printf("  Mobile: %s\n", opts.mobile)
+	}
+	if len(phones) > 0 {
+		body["phone_nos"] = phones
+	}
+
+	result, err := c.Request("POST", "Contact", body)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].(map[string]interface{}); ok {
+		printf("%s✓ Contact created: %s%s\n", Green, data["name"], Reset)
+	}
+	return nil
+}
+
+// fetchLinked returns Address or Contact records linked to a party
+func (c *Client) fetchLinked(linkedDoctype, doctype, name string, fields string) ([]map[string]interface{}, error) {
+	filters, err := encodeFilters([][]interface{}{
+		{"Dynamic Link", "link_doctype", "=", doctype},
+		{"Dynamic Link", "link_name", "=", name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.Request("GET", linkedDoctype+"?limit_page_length=0&fields="+fields+"&filters="+filters, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []map[string]interface{}
+	if data, ok := result["data"].([]interface{}); ok {
+		for _, row := range data {
+			if m, ok := row.(map[string]interface{}); ok {
+				rows = append(rows, m)
+			}
+		}
+	}
+	return rows, nil
+}
+
+// fetchPartyAddresses returns the addresses linked to a Customer/Supplier
+func (c *Client) fetchPartyAddresses(doctype, name string) ([]map[string]interface{}, error) {
+	return c.fetchLinked("Address", doctype, name,
+		`["name","address_type","address_line1","address_line2","city","state","pincode","country","is_primary_address","is_shipping_address"]`)
+}
+
+// fetchPartyContacts returns the contacts linked to a Customer/Supplier
+func (c *Client) fetchPartyContacts(doctype, name string) ([]map[string]interface{}, error) {
+	return c.fetchLinked("Contact", doctype, name,
+		`["name","first_name","last_name","email_id","phone","mobile_no","is_primary_contact"]`)
+}
+
+// formatAddress renders an address on a single line
+func formatAddress(a map[string]interface{}) string {
+	var parts []string
+	for _, field := range []string{"address_line1", "address_line2", "pincode", "city", "state", "country"} {
+		if v, ok := a[field].(string); ok && v != "" {
+			parts = append(parts, v)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// addressLabel returns the type of an address with its primary/shipping flags
+func addressLabel(a map[string]interface{}) string {
+	label := fmt.Sprintf("%v", a["address_type"])
+	if a["is_primary_address"] == float64(1) {
+		label += ", primary"
+	}
+	if a["is_shipping_address"] == float64(1) {
+		label += ", shipping"
+	}
+	return label
+}
+
+// formatContact renders a contact on a single line
+func formatContact(ct map[string]interface{}) string {
+	name := strings.TrimSpace(fmt.Sprintf("%s %s", stringField(ct, "first_name"), stringField(ct, "last_name")))
+	parts := []string{name}
+	for _, field := range []string{"email_id", "phone", "mobile_no"} {
+		if v := stringField(ct, field); v != "" {
+			parts = append(parts, v)
+		}
+	}
+	text := strings.Join(parts, " • ")
+	if ct["is_primary_contact"] == float64(1) {
+		text += " (primary)"
+	}
+	return text
+}
+
+func stringField(m map[string]interface{}, key string) string {
+	v, _ := m[key].(string)
+	return v
+}
+
+// printPartyLinks prints the addresses and contacts of a party, used by get
+func (c *Client) printPartyLinks(doctype, name string) error {
+	if c.LowBandwidth() {
+		printf("\n%sAddresses and contacts skipped (low-bandwidth mode, see: erp-cli %s addresses)%s\n", Yellow, strings.ToLower(doctype), Reset)
+		return nil
+	}
+
+	addresses, err := c.fetchPartyAddresses(doctype, name)
+	if err != nil {
+		return err
+	}
+	contacts, err := c.fetchPartyContacts(doctype, name)
+	if err != nil {
+		return err
+	}
+
+	if len(addresses) == 0 {
+		printf("\n%sNo addresses (add one with: erp-cli %s add-address)%s\n", Yellow, strings.ToLower(doctype), Reset)
+	} else {
+		printf("\n%sAddresses (%d):%s\n", Cyan, len(addresses), Reset)
+		for _, a := range addresses {
+			fmt.Printf("  [%s] %s\n", addressLabel(a), formatAddress(a))
+		}
+	}
+
+	if len(contacts) > 0 {
+		printf("\n%sContacts (%d):%s\n", Cyan, len(contacts), Reset)
+		for _, ct := range contacts {
+			fmt.Printf("  %s\n", formatContact(ct))
+		}
+	}
+	return nil
+}
+
+// partyListAddresses lists the addresses of a Customer/Supplier with their ids
+func (c *Client) partyListAddresses(doctype, name string) error {
+	printf("%sFetching addresses for: %s%s\n", Blue, name, Reset)
+
+	addresses, err := c.fetchPartyAddresses(doctype, name)
+	if err != nil {
+		return err
+	}
+
+	if len(addresses) == 0 {
+		printf("%sNo addresses found%s\n", Yellow, Reset)
+		return nil
+	}
+
+	printf("\n%sAddresses (%d):%s\n", Cyan, len(addresses), Reset)
+	for _, a := range addresses {
+		fmt.Printf("  %s [%s]\n", a["name"], addressLabel(a))
+		fmt.Printf("    %s\n", formatAddress(a))
+	}
+	return nil
+}
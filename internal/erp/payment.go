@@ -5,26 +5,27 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
-	"time"
 )
 
 // CmdPayment handles Payment Entry commands
 func (c *Client) CmdPayment(args []string) error {
 	if len(args) == 0 {
-		fmt.Println("Usage: erp-cli payment <subcommand> [args...]")
-		fmt.Println("Subcommands: list, get, receive, pay, submit, cancel")
+		fmt.Println(T("Usage: erp-cli payment <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, receive, pay, submit, cancel, pdf"))
 		fmt.Println()
-		fmt.Println("Examples:")
-		fmt.Println("  erp-cli payment list")
-		fmt.Println("  erp-cli payment list --type=receive --party=\"Acme Corp\"")
-		fmt.Println("  erp-cli payment list --type=pay --status=Draft")
-		fmt.Println("  erp-cli payment get PE-00001")
-		fmt.Println("  erp-cli payment receive ACC-SINV-2025-00001")
-		fmt.Println("  erp-cli payment receive ACC-SINV-2025-00001 --amount=500")
-		fmt.Println("  erp-cli payment pay ACC-PINV-2025-00001")
-		fmt.Println("  erp-cli payment pay ACC-PINV-2025-00001 --amount=1000")
-		fmt.Println("  erp-cli payment submit PE-00001")
-		fmt.Println("  erp-cli payment cancel PE-00001")
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli payment list"))
+		fmt.Println(T("  erp-cli payment list --type=receive --party=\"Acme Corp\""))
+		fmt.Println(T("  erp-cli payment list --type=pay --status=Draft"))
+		fmt.Println(T("  erp-cli payment get PE-00001"))
+		fmt.Println(T("  erp-cli payment receive ACC-SINV-2025-00001"))
+		fmt.Println(T("  erp-cli payment receive ACC-SINV-2025-00001 --amount=500"))
+		fmt.Println(T("  erp-cli payment pay ACC-PINV-2025-00001"))
+		fmt.Println(T("  erp-cli payment pay ACC-PINV-2025-00001 --amount=1000"))
+		fmt.Println(T("  erp-cli payment pay ACC-PINV-2025-00002 --exchange-rate=1.09   (foreign-currency invoice)"))
+		fmt.Println(T("  erp-cli payment submit PE-00001"))
+		fmt.Println(T("  erp-cli payment cancel PE-00001"))
+		fmt.Println(T("  erp-cli payment pdf PE-00001 --open"))
 		return nil
 	}
 
@@ -34,12 +35,15 @@ func (c *Client) CmdPayment(args []string) error {
 		return c.paymentList(opts)
 	case "get":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli payment get <name>")
+			return errorf("usage: erp-cli payment get <name> [--template=file.tmpl]")
+		}
+		if tmpl := parseTemplateFlag(args[2:]); tmpl != "" {
+			return c.renderDocTemplate("Payment Entry", args[1], tmpl)
 		}
 		return c.paymentGet(args[1])
 	case "receive":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli payment receive <si_name> [--amount=X]")
+			return errorf("usage: erp-cli payment receive <si_name> [--amount=X] [--currency=X] [--exchange-rate=N]")
 		}
 		amount := 0.0
 		for _, arg := range args[2:] {
@@ -47,10 +51,18 @@ func (c *Client) CmdPayment(args []string) error {
 				amount, _ = strconv.ParseFloat(arg[9:], 64)
 			}
 		}
-		return c.paymentReceive(args[1], amount)
+		posting, err := parsePostingOptions(args[2:])
+		if err != nil {
+			return err
+		}
+		cur, err := parseCurrencyFlags(args[2:])
+		if err != nil {
+			return err
+		}
+		return c.paymentReceive(args[1], amount, posting, cur)
 	case "pay":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli payment pay <pi_name> [--amount=X]")
+			return errorf("usage: erp-cli payment pay <pi_name> [--amount=X] [--currency=X] [--exchange-rate=N]")
 		}
 		amount := 0.0
 		for _, arg := range args[2:] {
@@ -58,19 +70,32 @@ func (c *Client) CmdPayment(args []string) error {
 				amount, _ = strconv.ParseFloat(arg[9:], 64)
 			}
 		}
-		return c.paymentPay(args[1], amount)
+		posting, err := parsePostingOptions(args[2:])
+		if err != nil {
+			return err
+		}
+		cur, err := parseCurrencyFlags(args[2:])
+		if err != nil {
+			return err
+		}
+		return c.paymentPay(args[1], amount, posting, cur)
 	case "submit":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli payment submit <name>")
+			return errorf("usage: erp-cli payment submit <name>")
 		}
 		return c.paymentSubmit(args[1])
 	case "cancel":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli payment cancel <name>")
+			return errorf("usage: erp-cli payment cancel <name>")
 		}
 		return c.paymentCancel(args[1])
+	case "pdf":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli payment pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]")
+		}
+		return c.docPDF("Payment Entry", args[1], parsePDFOptions(args[2:]))
 	default:
-		return fmt.Errorf("unknown payment subcommand: %s", args[0])
+		return errorf("unknown payment subcommand: %s", args[0])
 	}
 }
 
@@ -78,6 +103,8 @@ type paymentListOptions struct {
 	party       string
 	paymentType string
 	status      string
+	xlsx        string // Output file for --xlsx
+	template    string // --template file
 }
 
 func parsePaymentListOptions(args []string) paymentListOptions {
@@ -92,13 +119,17 @@ func parsePaymentListOptions(args []string) paymentListOptions {
 		if len(arg) > 9 && arg[:9] == "--status=" {
 			opts.status = arg[9:]
 		}
+		if path, ok := parseXLSXFlag(arg, "payment-entries.xlsx"); ok {
+			opts.xlsx = path
+		}
+		if len(arg) > 11 && arg[:11] == "--template=" {
+			opts.template = arg[11:]
+		}
 	}
 	return opts
 }
 
 func (c *Client) paymentList(opts paymentListOptions) error {
-	fmt.Printf("%sFetching payment entries...%s\n", Blue, Reset)
-
 	filters := [][]interface{}{}
 	if opts.party != "" {
 		filters = append(filters, []interface{}{"party", "like", fmt.Sprintf("%%%s%%", opts.party)})
@@ -114,7 +145,15 @@ func (c *Client) paymentList(opts paymentListOptions) error {
 		filters = append(filters, []interface{}{"status", "=", opts.status})
 	}
 
-	endpoint := "Payment%20Entry?limit_page_length=0&fields=[\"name\",\"payment_type\",\"party_type\",\"party\",\"paid_amount\",\"posting_date\",\"status\",\"docstatus\"]&order_by=creation%20desc"
+	if opts.xlsx != "" {
+		return c.exportDocsXLSX("Payment Entry", filters, opts.xlsx)
+	}
+	if opts.template != "" {
+		return c.renderListTemplate("Payment Entry", filters, opts.template)
+	}
+	printf("%sFetching payment entries...%s\n", Blue, Reset)
+
+	endpoint := "Payment%20Entry?" + c.pageLimit(0) + "&fields=[\"name\",\"payment_type\",\"party_type\",\"party\",\"paid_amount\",\"posting_date\",\"status\",\"docstatus\"]&order_by=creation%20desc"
 	if len(filters) > 0 {
 		encoded, err := encodeFilters(filters)
 		if err != nil {
@@ -130,11 +169,12 @@ func (c *Client) paymentList(opts paymentListOptions) error {
 
 	if data, ok := result["data"].([]interface{}); ok {
 		if len(data) == 0 {
-			fmt.Printf("%sNo payment entries found%s\n", Yellow, Reset)
+			printf("%sNo payment entries found%s\n", Yellow, Reset)
 			return nil
 		}
 
-		fmt.Printf("\n%sPayment Entries (%d):%s\n", Cyan, len(data), Reset)
+		printf("\n%sPayment Entries (%d):%s\n", Cyan, len(data), Reset)
+		c.printPageNote(len(data))
 		for _, item := range data {
 			if m, ok := item.(map[string]interface{}); ok {
 				name := m["name"]
@@ -158,7 +198,7 @@ func (c *Client) paymentList(opts paymentListOptions) error {
 				}
 
 				fmt.Printf("  %s - %s (%s: %s)\n", name, typeIcon, partyType, party)
-				fmt.Printf("    Date: %s | Status: %s%s%s | Amount: %s\n",
+				printf("    Date: %s | Status: %s%s%s | Amount: %s\n",
 					date, statusColor, status, Reset, c.FormatCurrency(amount))
 			}
 		}
@@ -167,7 +207,7 @@ func (c *Client) paymentList(opts paymentListOptions) error {
 }
 
 func (c *Client) paymentGet(name string) error {
-	fmt.Printf("%sFetching payment entry: %s%s\n", Blue, name, Reset)
+	printf("%sFetching payment entry: %s%s\n", Blue, name, Reset)
 
 	encoded := url.PathEscape(name)
 	result, err := c.Request("GET", "Payment%20Entry/"+encoded, nil)
@@ -176,51 +216,106 @@ func (c *Client) paymentGet(name string) error {
 	}
 
 	if data, ok := result["data"].(map[string]interface{}); ok {
-		fmt.Printf("\n%sPayment Entry: %s%s\n", Cyan, name, Reset)
+		printf("\n%sPayment Entry: %s%s\n", Cyan, name, Reset)
 
 		paymentType, _ := data["payment_type"].(string)
-		typeStr := "Receive (from Customer)"
+		typeStr := T("Receive (from Customer)")
 		if paymentType == "Pay" {
-			typeStr = "Pay (to Supplier)"
+			typeStr = T("Pay (to Supplier)")
 		}
-		fmt.Printf("  Type: %s\n", typeStr)
-		fmt.Printf("  Party Type: %s\n", data["party_type"])
-		fmt.Printf("  Party: %s\n", data["party"])
-		fmt.Printf("  Date: %s\n", data["posting_date"])
-		fmt.Printf("  Status: %s\n", data["status"])
+		printf("  Type: %s\n", typeStr)
+		printf("  Party Type: %s\n", data["party_type"])
+		printf("  Party: %s\n", data["party"])
+		printf("  Date: %s\n", data["posting_date"])
+		printf("  Status: %s\n", data["status"])
 
 		paidAmount, _ := data["paid_amount"].(float64)
-		fmt.Printf("  Paid Amount: %s\n", c.FormatCurrency(paidAmount))
+		receivedAmount, _ := data["received_amount"].(float64)
+		paidCurrency := stringField(data, "paid_from_account_currency")
+		receivedCurrency := stringField(data, "paid_to_account_currency")
+		printf("  Paid Amount: %s\n", c.FormatCurrencyIn(paidAmount, paidCurrency))
+		if receivedCurrency != paidCurrency {
+			printf("  Received Amount: %s\n", c.FormatCurrencyIn(receivedAmount, receivedCurrency))
+		}
 
 		if mop, ok := data["mode_of_payment"]; ok && mop != nil && mop != "" {
-			fmt.Printf("  Mode of Payment: %s\n", mop)
+			printf("  Mode of Payment: %s\n", mop)
 		}
 
 		if refs, ok := data["references"].([]interface{}); ok && len(refs) > 0 {
-			fmt.Printf("\n  %sReferences:%s\n", Yellow, Reset)
+			printf("\n  %sReferences:%s\n", Yellow, Reset)
 			for _, ref := range refs {
 				if r, ok := ref.(map[string]interface{}); ok {
 					refDoctype := r["reference_doctype"]
 					refName := r["reference_name"]
 					allocated, _ := r["allocated_amount"].(float64)
-					fmt.Printf("    - %s: %s (Allocated: %s)\n", refDoctype, refName, c.FormatCurrency(allocated))
+					printf("    - %s: %s (Allocated: %s)\n", refDoctype, refName, c.FormatCurrencyIn(allocated, paymentPartyCurrency(data)))
 				}
 			}
 		}
+
+		c.printActivity("Payment Entry", name)
 	}
 	return nil
 }
 
-func (c *Client) paymentReceive(siName string, amount float64) error {
-	return c.createPaymentFromInvoice(siName, amount, "Receive")
+func (c *Client) paymentReceive(siName string, amount float64, posting postingOptions, cur docCurrency) error {
+	return c.createPaymentFromInvoice(siName, amount, "Receive", posting, cur)
 }
 
-func (c *Client) paymentPay(piName string, amount float64) error {
-	return c.createPaymentFromInvoice(piName, amount, "Pay")
+func (c *Client) paymentPay(piName string, amount float64, posting postingOptions, cur docCurrency) error {
+	return c.createPaymentFromInvoice(piName, amount, "Pay", posting, cur)
 }
 
 // createPaymentFromInvoice creates a payment entry from an invoice (Sales or Purchase)
-func (c *Client) createPaymentFromInvoice(invoiceName string, amount float64, paymentType string) error {
+func (c *Client) createPaymentFromInvoice(invoiceName string, amount float64, paymentType string, posting postingOptions, cur docCurrency) error {
+	invoiceLabel := "Purchase Invoice"
+	if paymentType == "Receive" {
+		invoiceLabel = "Sales Invoice"
+	}
+	printf("%sCreating payment entry for %s: %s%s\n", Blue, invoiceLabel, invoiceName, Reset)
+
+	body, err := c.paymentFromInvoice(invoiceName, amount, paymentType, posting)
+	if err != nil {
+		return err
+	}
+	if cur, err = c.paymentCurrency(body, cur); err != nil {
+		return err
+	}
+
+	result, err := c.Request("POST", "Payment%20Entry", body)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].(map[string]interface{}); ok {
+		peName := data["name"]
+		typeLabel := T("Pay (to Supplier)")
+		if paymentType == "Receive" {
+			typeLabel = T("Receive (from Customer)")
+		}
+		paidAmount, _ := body["paid_amount"].(float64)
+		printf("%s✓ Payment Entry created: %s%s\n", Green, peName, Reset)
+		printf("  Type: %s\n", typeLabel)
+		fmt.Printf("  %s: %s\n", body["party_type"], body["party"])
+		if cur.code != "" {
+			amount := paymentPartyAmount(body)
+			printf("  Amount: %s (%s)\n", c.FormatCurrencyIn(amount, cur.code), c.FormatCurrency(amount*cur.rate))
+			c.printCurrency(cur)
+		} else {
+			printf("  Amount: %s\n", c.FormatCurrency(paidAmount))
+		}
+		printf("  For Invoice: %s\n", invoiceName)
+		printf("  Status: Draft\n")
+		printf("  Use 'erp-cli payment submit %s' to submit\n", peName)
+	}
+
+	return nil
+}
+
+// paymentFromInvoice builds the body of a Payment Entry settling a submitted
+// invoice, for its outstanding amount unless amount is given
+func (c *Client) paymentFromInvoice(invoiceName string, amount float64, paymentType string, posting postingOptions) (map[string]interface{}, error) {
 	isReceive := paymentType == "Receive"
 
 	invoiceDoctype := "Purchase%20Invoice"
@@ -234,37 +329,38 @@ func (c *Client) createPaymentFromInvoice(invoiceName string, amount float64, pa
 		partyField = "customer"
 	}
 
-	fmt.Printf("%sCreating payment entry for %s: %s%s\n", Blue, invoiceLabel, invoiceName, Reset)
-
 	// Get the invoice
 	encoded := url.PathEscape(invoiceName)
 	result, err := c.Request("GET", invoiceDoctype+"/"+encoded, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	invoiceData, ok := result["data"].(map[string]interface{})
 	if !ok {
-		return fmt.Errorf("%s not found", strings.ToLower(invoiceLabel))
+		return nil, errorf("%s not found", strings.ToLower(invoiceLabel))
 	}
 
 	// Check if submitted
 	docStatus, _ := invoiceData["docstatus"].(float64)
 	if docStatus != 1 {
-		return fmt.Errorf("%s must be submitted first", strings.ToLower(invoiceLabel))
+		return nil, errorf("%s must be submitted first", strings.ToLower(invoiceLabel))
 	}
 
 	// Check outstanding amount
 	outstanding, _ := invoiceData["outstanding_amount"].(float64)
 	if outstanding <= 0 {
-		return fmt.Errorf("%s has no outstanding amount", strings.ToLower(invoiceLabel))
+		return nil, errorf("%s has no outstanding amount", strings.ToLower(invoiceLabel))
 	}
 
+	// Outstanding and allocated amounts are in the party account currency
+	partyCurrency := stringField(invoiceData, "party_account_currency")
+
 	// Use provided amount or outstanding amount
 	paidAmount := outstanding
 	if amount > 0 {
 		if amount > outstanding {
-			return fmt.Errorf("amount exceeds outstanding balance of %s", c.FormatCurrency(outstanding))
+			return nil, errorf("amount exceeds outstanding balance of %s", c.FormatCurrencyIn(outstanding, partyCurrency))
 		}
 		paidAmount = amount
 	}
@@ -274,7 +370,7 @@ func (c *Client) createPaymentFromInvoice(invoiceName string, amount float64, pa
 
 	company, err := c.GetCompany()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	refDoctype := "Purchase Invoice"
@@ -287,7 +383,6 @@ func (c *Client) createPaymentFromInvoice(invoiceName string, amount float64, pa
 		"party_type":   partyType,
 		"party":        party,
 		"paid_amount":  paidAmount,
-		"posting_date": time.Now().Format("2006-01-02"),
 		"company":      company,
 		"references": []map[string]interface{}{
 			{
@@ -299,50 +394,48 @@ func (c *Client) createPaymentFromInvoice(invoiceName string, amount float64, pa
 			},
 		},
 	}
-
-	result, err = c.Request("POST", "Payment%20Entry", body)
-	if err != nil {
-		return err
+	if err := c.applyPosting(body, posting, false); err != nil {
+		return nil, err
 	}
 
-	if data, ok := result["data"].(map[string]interface{}); ok {
-		peName := data["name"]
-		typeLabel := "Pay (to Supplier)"
+	// A foreign-currency party account is settled at the rate of the
+	// payment date, or the invoice's when no Currency Exchange covers it
+	companyCurrency, _ := c.GetCurrency()
+	if partyCurrency != "" && partyCurrency != companyCurrency.Code {
+		rate, err := c.exchangeRate(partyCurrency, companyCurrency.Code, stringField(body, "posting_date"))
+		if err != nil {
+			rate, _ = invoiceData["conversion_rate"].(float64)
+		}
 		if isReceive {
-			typeLabel = "Receive (from Customer)"
+			body["paid_from_account_currency"] = partyCurrency
+		} else {
+			body["paid_to_account_currency"] = partyCurrency
 		}
-		fmt.Printf("%s✓ Payment Entry created: %s%s\n", Green, peName, Reset)
-		fmt.Printf("  Type: %s\n", typeLabel)
-		fmt.Printf("  %s: %s\n", partyType, party)
-		fmt.Printf("  Amount: %s\n", c.FormatCurrency(paidAmount))
-		fmt.Printf("  For Invoice: %s\n", invoiceName)
-		fmt.Printf("  Status: Draft\n")
-		fmt.Printf("  Use 'erp-cli payment submit %s' to submit\n", peName)
+		setPaymentRate(body, paidAmount, rate)
 	}
-
-	return nil
+	return body, nil
 }
 
 func (c *Client) paymentSubmit(name string) error {
-	fmt.Printf("%sSubmitting payment entry: %s%s\n", Blue, name, Reset)
+	printf("%sSubmitting payment entry: %s%s\n", Blue, name, Reset)
 
 	err := c.submitDocument("Payment Entry", name)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("%s✓ Payment Entry submitted: %s%s\n", Green, name, Reset)
+	printf("%s✓ Payment Entry submitted: %s%s\n", Green, name, Reset)
 	return nil
 }
 
 func (c *Client) paymentCancel(name string) error {
-	fmt.Printf("%sCancelling payment entry: %s%s\n", Blue, name, Reset)
+	printf("%sCancelling payment entry: %s%s\n", Blue, name, Reset)
 
 	err := c.cancelDocument("Payment Entry", name)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("%s✓ Payment Entry cancelled: %s%s\n", Green, name, Reset)
+	printf("%s✓ Payment Entry cancelled: %s%s\n", Green, name, Reset)
 	return nil
 }
@@ -0,0 +1,115 @@
+package erp
+
+import (
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// pdfOptions holds flags for the pdf subcommands
+type pdfOptions struct {
+	output      string // File to write (default: <name>.pdf)
+	printFormat string // Print Format (default: the doctype's default format)
+	letterhead  string // Letter Head (default: the company's default)
+	open        bool   // Open the file once saved
+}
+
+func parsePDFOptions(args []string) pdfOptions {
+	opts := pdfOptions{}
+	for i, arg := range args {
+		if arg == "-o" && i+1 < len(args) {
+			opts.output = args[i+1]
+		}
+		if len(arg) > 15 && arg[:15] == "--print-format=" {
+			opts.printFormat = arg[15:]
+		}
+		if len(arg) > 13 && arg[:13] == "--letterhead=" {
+			opts.letterhead = arg[13:]
+		}
+		if arg == "--open" {
+			opts.open = true
+		}
+	}
+	return opts
+}
+
+// pdfFileName is the default file name for a document's PDF
+func pdfFileName(name string) string {
+	return strings.NewReplacer("/", "-", "\\", "-").Replace(name) + ".pdf"
+}
+
+// fetchPDF renders a document through Frappe's print view
+func (c *Client) fetchPDF(doctype, name string, opts pdfOptions) ([]byte, error) {
+	params := url.Values{}
+	params.Set("doctype", doctype)
+	params.Set("name", name)
+	if opts.printFormat != "" {
+		params.Set("format", opts.printFormat)
+	}
+	if opts.letterhead != "" {
+		params.Set("letterhead", opts.letterhead)
+	}
+	params.Set("no_letterhead", "0")
+
+	data, err := c.download("/api/method/frappe.utils.print_format.download_pdf?" + params.Encode())
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(string(data), "%PDF") {
+		return nil, errorf("server did not return a PDF for %s %s", doctype, name)
+	}
+	return data, nil
+}
+
+// savePDF downloads a document's PDF and writes it to disk, returning the
+// path written
+func (c *Client) savePDF(doctype, name string, opts pdfOptions) (string, error) {
+	data, err := c.fetchPDF(doctype, name, opts)
+	if err != nil {
+		return "", err
+	}
+
+	path := opts.output
+	if path == "" {
+		path = pdfFileName(name)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", errorf("failed to write %s: %w", path, err)
+	}
+	return path, nil
+}
+
+// openFile opens a file with the desktop's default application
+func openFile(path string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", path)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", path)
+	default:
+		cmd = exec.Command("xdg-open", path)
+	}
+	if err := cmd.Start(); err != nil {
+		return errorf("cannot open %s: %w", path, err)
+	}
+	return nil
+}
+
+// docPDF is the pdf subcommand shared by the document commands
+func (c *Client) docPDF(doctype, name string, opts pdfOptions) error {
+	printf("%sDownloading %s %s...%s\n", Blue, doctype, name, Reset)
+
+	path, err := c.savePDF(doctype, name, opts)
+	if err != nil {
+		return err
+	}
+
+	printf("%s✓ Saved: %s%s\n", Green, path, Reset)
+	if opts.open {
+		return openFile(path)
+	}
+	return nil
+}
@@ -0,0 +1,268 @@
+package erp
+
+import (
+	"net/url"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Permission checks: commands that change documents ask the server whether
+// the user may do so before starting, and fail with the roles that would
+// allow it instead of a permission error halfway through. The answers are
+// kept for the run. A check that cannot be made lets the command through;
+// the server still enforces the permission.
+
+// permissionSubcommands are the document subcommands checked, with the
+// permission they need, besides the create* (create) and add-* (write)
+// families
+var permissionSubcommands = map[string]string{
+	"set": "write", "update": "write", "rename": "write", "close": "write",
+	"submit": "submit", "cancel": "cancel", "amend": "amend", "delete": "delete",
+}
+
+// permissionVerbs phrase a permission type in messages
+var permissionVerbs = map[string]string{
+	"read": "read", "write": "edit", "create": "create", "submit": "submit",
+	"cancel": "cancel", "amend": "amend", "delete": "delete",
+}
+
+// preflightTarget returns the doctype and permission a command needs, if
+// it is one that is checked
+func preflightTarget(args []string) (string, string, bool) {
+	if len(args) < 3 || args[2] == "--help" || args[2] == "-h" {
+		return "", "", false
+	}
+	doctype, ok := doctypeShortNames[args[0]]
+	if !ok {
+		return "", "", false
+	}
+	sub := args[1]
+	switch {
+	case strings.HasPrefix(sub, "create"):
+		return doctype, "create", true
+	case strings.HasPrefix(sub, "add-"):
+		return doctype, "write", true
+	}
+	ptype, ok := permissionSubcommands[sub]
+	return doctype, ptype, ok
+}
+
+// can reports whether the user has a permission on a doctype; the error is
+// set when the server could not be asked
+func (c *Client) can(doctype, ptype string) (bool, error) {
+	key := doctype + "/" + ptype
+	c.permsMu.Lock()
+	allowed, ok := c.perms[key]
+	c.permsMu.Unlock()
+	if ok {
+		return allowed, nil
+	}
+
+	result, err := c.CallMethod("GET", "frappe.client.has_permission?doctype="+url.QueryEscape(doctype)+"&docname=&perm_type="+ptype, nil)
+	if err != nil {
+		return false, err
+	}
+	message, _ := result["message"].(map[string]interface{})
+	allowed, _ = message["has_permission"].(bool)
+
+	c.permsMu.Lock()
+	if c.perms == nil {
+		c.perms = map[string]bool{}
+	}
+	c.perms[key] = allowed
+	c.permsMu.Unlock()
+	return allowed, nil
+}
+
+// cachedPermission returns a permission answer already kept, without
+// asking the server; known is false when there is none
+func (c *Client) cachedPermission(doctype, ptype string) (allowed, known bool) {
+	c.permsMu.Lock()
+	defer c.permsMu.Unlock()
+	allowed, known = c.perms[doctype+"/"+ptype]
+	return allowed, known
+}
+
+// rolesWith returns the roles granted a permission on a doctype, from its
+// meta, kept for the run; nil when the meta is not readable
+func (c *Client) rolesWith(doctype, ptype string) []string {
+	key := doctype + "/" + ptype
+	c.permsMu.Lock()
+	roles, ok := c.permRoles[key]
+	c.permsMu.Unlock()
+	if ok {
+		return roles
+	}
+
+	roles = c.fetchRolesWith(doctype, ptype)
+	if roles != nil {
+		c.permsMu.Lock()
+		if c.permRoles == nil {
+			c.permRoles = map[string][]string{}
+		}
+		c.permRoles[key] = roles
+		c.permsMu.Unlock()
+	}
+	return roles
+}
+
+// fetchRolesWith reads the roles granted a permission from the meta
+func (c *Client) fetchRolesWith(doctype, ptype string) []string {
+	result, err := c.CallMethod("GET", "frappe.desk.form.load.getdoctype?doctype="+url.QueryEscape(doctype), nil)
+	if err != nil {
+		return nil
+	}
+	docs, _ := result["docs"].([]interface{})
+	if len(docs) == 0 {
+		return nil
+	}
+	meta, _ := docs[0].(map[string]interface{})
+	var roles []string
+	seen := map[string]bool{}
+	for _, perm := range docRows(meta["permissions"]) {
+		level, _ := perm["permlevel"].(float64)
+		granted, _ := perm[ptype].(float64)
+		role := stringField(perm, "role")
+		if level == 0 && granted == 1 && role != "" && !seen[role] {
+			seen[role] = true
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// permissionError checks a permission and describes what is missing when
+// the user lacks it
+func (c *Client) permissionError(doctype, ptype string) error {
+	allowed, err := c.can(doctype, ptype)
+	if err != nil || allowed {
+		return nil
+	}
+	return deniedError(doctype, ptype, c.rolesWith(doctype, ptype))
+}
+
+// deniedError describes a permission the user lacks and the roles that
+// would grant it
+func deniedError(doctype, ptype string, roles []string) error {
+	verb := permissionVerbs[ptype]
+	if verb == "" {
+		verb = ptype
+	}
+	switch len(roles) {
+	case 0:
+		return errorf("you cannot %s %s: missing a role with %s permission on it", verb, doctype, ptype)
+	case 1:
+		return errorf("you cannot %s %s: missing role %s", verb, doctype, roles[0])
+	}
+	return errorf("you cannot %s %s: missing role (one of: %s)", verb, doctype, strings.Join(roles, ", "))
+}
+
+// Preflight fails a command the user is not permitted to run, before it
+// makes any change
+func (c *Client) Preflight(args []string) error {
+	doctype, ptype, ok := preflightTarget(args)
+	if !ok {
+		return nil
+	}
+	return c.permissionError(doctype, ptype)
+}
+
+// formPermissions are the doctype and permission each TUI form needs
+var formPermissions = map[View]struct{ doctype, ptype string }{
+	ViewCreateSupplier:        {"Supplier", "create"},
+	ViewCreateSerial:          {"Serial No", "create"},
+	ViewStockReceive:          {"Stock Entry", "create"},
+	ViewStockTransfer:         {"Stock Entry", "create"},
+	ViewStockIssue:            {"Stock Entry", "create"},
+	ViewCreatePO:              {"Purchase Order", "create"},
+	ViewAddPOItem:             {"Purchase Order", "write"},
+	ViewCreatePI:              {"Purchase Invoice", "create"},
+	ViewCreatePIFromPO:        {"Purchase Invoice", "create"},
+	ViewCreatePR:              {"Purchase Receipt", "create"},
+	ViewCreateCustomer:        {"Customer", "create"},
+	ViewCreateQuotation:       {"Quotation", "create"},
+	ViewAddQuotationItem:      {"Quotation", "write"},
+	ViewCreateSO:              {"Sales Order", "create"},
+	ViewCreateSOFromQuotation: {"Sales Order", "create"},
+	ViewAddSOItem:             {"Sales Order", "write"},
+	ViewCreateSalesInvoice:    {"Sales Invoice", "create"},
+	ViewCreateDN:              {"Delivery Note", "create"},
+	ViewCreatePayment:         {"Payment Entry", "create"},
+	ViewCreateItem:            {"Item", "create"},
+	ViewCreateVariant:         {"Item", "create"},
+	ViewCreateGroup:           {"Item Group", "create"},
+	ViewCreateBrand:           {"Brand", "create"},
+	ViewCreateWarehouse:       {"Warehouse", "create"},
+	ViewCreateAttrText:        {"Item Attribute", "create"},
+	ViewCreateAttrNumeric:     {"Item Attribute", "create"},
+	ViewCreateAttrSelect:      {"Item Attribute", "create"},
+	ViewEditAttrValues:        {"Item Attribute", "write"},
+	ViewCreateWO:              {"Work Order", "create"},
+	ViewCreateSubscription:    {"Subscription", "create"},
+	ViewCreateExpenseClaim:    {"Expense Claim", "create"},
+}
+
+// confirmDoctypes are the doctypes of the TUI's submit_* and cancel_*
+// confirmations
+var confirmDoctypes = map[string]string{
+	"quotation": "Quotation",
+	"so":        "Sales Order",
+	"si":        "Sales Invoice",
+	"dn":        "Delivery Note",
+	"payment":   "Payment Entry",
+	"po":        "Purchase Order",
+	"pi":        "Purchase Invoice",
+	"pr":        "Purchase Receipt",
+	"bom":       "BOM",
+	"timesheet": "Timesheet",
+	"expense":   "Expense Claim",
+}
+
+// viewPermissionError checks the permission the TUI view about to open
+// needs: a form, or a submit or cancel confirmation. Update cannot wait
+// for the server, so only the answers prefetchPermissions already has
+// count; without one the view opens and the server decides on saving.
+func (m Model) viewPermissionError() error {
+	doctype, ptype := "", ""
+	if need, ok := formPermissions[m.view]; ok {
+		doctype, ptype = need.doctype, need.ptype
+	} else if m.view == ViewConfirmAction {
+		action, kind, _ := strings.Cut(m.confirmAction, "_")
+		if dt, ok := confirmDoctypes[kind]; ok && (action == "submit" || action == "cancel") {
+			doctype, ptype = dt, action
+		}
+	}
+	if doctype == "" {
+		return nil
+	}
+	if allowed, known := m.client.cachedPermission(doctype, ptype); !known || allowed {
+		return nil
+	}
+	m.client.permsMu.Lock()
+	roles := m.client.permRoles[doctype+"/"+ptype]
+	m.client.permsMu.Unlock()
+	return deniedError(doctype, ptype, roles)
+}
+
+// prefetchPermissions asks for the permissions of the TUI's forms and
+// confirmations in the background, with the roles of those denied, so
+// opening one does not wait for them
+func (m Model) prefetchPermissions() tea.Cmd {
+	client := m.client
+	return func() tea.Msg {
+		check := func(doctype, ptype string) {
+			if allowed, err := client.can(doctype, ptype); err == nil && !allowed {
+				client.rolesWith(doctype, ptype)
+			}
+		}
+		for _, need := range formPermissions {
+			check(need.doctype, need.ptype)
+		}
+		for _, doctype := range confirmDoctypes {
+			check(doctype, "submit")
+			check(doctype, "cancel")
+		}
+		return nil
+	}
+}
@@ -0,0 +1,445 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// CmdPricingRule handles Pricing Rule commands
+func (c *Client) CmdPricingRule(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli pricing-rule <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, create, disable"))
+		fmt.Println()
+		fmt.Println(T("Create options:"))
+		fmt.Println(T("  --item=X | --group=X            Apply on item code or item group (required)"))
+		fmt.Println(T("  --discount=N | --rate=N         Discount percentage or fixed rate (required)"))
+		fmt.Println(T("  --customer=X | --customer-group=X"))
+		fmt.Println(T("                                  Restrict to a customer or customer group"))
+		fmt.Println(T("  --min-qty=N                     Quantity break"))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli pricing-rule list"))
+		fmt.Println(T("  erp-cli pricing-rule create \"Wholesale 10%\" --group=\"Components\" --customer-group=Wholesale --discount=10"))
+		fmt.Println(T("  erp-cli pricing-rule create \"CPU qty break\" --item=CPU-I7 --min-qty=50 --rate=420"))
+		fmt.Println(T("  erp-cli pricing-rule disable PRLE-0001"))
+		return nil
+	}
+
+	switch args[0] {
+	case "list":
+		return c.pricingRuleList()
+	case "get":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli pricing-rule get <name>")
+		}
+		return c.pricingRuleGet(args[1])
+	case "create":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli pricing-rule create <title> --item=X|--group=X --discount=N|--rate=N [options]")
+		}
+		opts, err := parsePricingRuleOptions(args[2:])
+		if err != nil {
+			return err
+		}
+		return c.pricingRuleCreate(args[1], opts)
+	case "disable":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli pricing-rule disable <name>")
+		}
+		return c.pricingRuleDisable(args[1])
+	default:
+		return errorf("unknown pricing-rule subcommand: %s", args[0])
+	}
+}
+
+type pricingRuleOptions struct {
+	item          string
+	group         string
+	customer      string
+	customerGroup string
+	minQty        float64
+	discount      float64
+	rate          float64
+}
+
+func parsePricingRuleOptions(args []string) (pricingRuleOptions, error) {
+	opts := pricingRuleOptions{}
+	for _, arg := range args {
+		if len(arg) > 7 && arg[:7] == "--item=" {
+			opts.item = arg[7:]
+		}
+		if len(arg) > 8 && arg[:8] == "--group=" {
+			opts.group = arg[8:]
+		}
+		if len(arg) > 11 && arg[:11] == "--customer=" {
+			opts.customer = arg[11:]
+		}
+		if len(arg) > 17 && arg[:17] == "--customer-group=" {
+			opts.customerGroup = arg[17:]
+		}
+		if len(arg) > 10 && arg[:10] == "--min-qty=" {
+			opts.minQty, _ = strconv.ParseFloat(arg[10:], 64)
+		}
+		if len(arg) > 11 && arg[:11] == "--discount=" {
+			opts.discount, _ = strconv.ParseFloat(arg[11:], 64)
+		}
+		if len(arg) > 7 && arg[:7] == "--rate=" {
+			opts.rate, _ = strconv.ParseFloat(arg[7:], 64)
+		}
+	}
+
+	if (opts.item == "") == (opts.group == "") {
+		return opts, errorf("specify exactly one of --item or --group")
+	}
+	if (opts.discount > 0) == (opts.rate > 0) {
+		return opts, errorf("specify exactly one of --discount or --rate")
+	}
+	if opts.customer != "" && opts.customerGroup != "" {
+		return opts, errorf("--customer and --customer-group are mutually exclusive")
+	}
+	return opts, nil
+}
+
+func (c *Client) pricingRuleList() error {
+	printf("%sFetching pricing rules...%s\n", Blue, Reset)
+
+	filters, err := encodeFilters([][]interface{}{{"selling", "=", 1}})
+	if err != nil {
+		return err
+	}
+
+	result, err := c.Request("GET", "Pricing%20Rule?limit_page_length=0&fields=[\"name\",\"title\",\"apply_on\",\"rate_or_discount\",\"discount_percentage\",\"rate\",\"applicable_for\",\"customer\",\"customer_group\",\"min_qty\",\"disable\"]&filters="+filters, nil)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].([]interface{}); ok {
+		if len(data) == 0 {
+			printf("%sNo pricing rules found%s\n", Yellow, Reset)
+			return nil
+		}
+
+		printf("\n%sPricing Rules (%d):%s\n", Cyan, len(data), Reset)
+		for _, item := range data {
+			if m, ok := item.(map[string]interface{}); ok {
+				status := ""
+				if disabled, _ := m["disable"].(float64); disabled == 1 {
+					status = fmt.Sprintf(" %s[disabled]%s", Red, Reset)
+				}
+				fmt.Printf("  %s - %s%s\n", m["name"], m["title"], status)
+				fmt.Printf("    %s\n", c.describePricingRule(m))
+			}
+		}
+	}
+	return nil
+}
+
+// describePricingRule summarizes a pricing rule on one line
+func (c *Client) describePricingRule(m map[string]interface{}) string {
+	var effect string
+	if m["rate_or_discount"] == "Rate" {
+		rate, _ := m["rate"].(float64)
+		effect = "Rate " + c.FormatCurrency(rate)
+	} else {
+		discount, _ := m["discount_percentage"].(float64)
+		effect = tf("%.2f%% off", discount)
+	}
+
+	line := tf("%s | On: %v", effect, m["apply_on"])
+	switch m["applicable_for"] {
+	case "Customer":
+		line += tf(" | Customer: %v", m["customer"])
+	case "Customer Group":
+		line += tf(" | Customer Group: %v", m["customer_group"])
+	}
+	if minQty, _ := m["min_qty"].(float64); minQty > 0 {
+		line += tf(" | Min Qty: %.0f", minQty)
+	}
+	return line
+}
+
+func (c *Client) pricingRuleGet(name string) error {
+	printf("%sFetching pricing rule: %s%s\n", Blue, name, Reset)
+
+	encoded := url.PathEscape(name)
+	result, err := c.Request("GET", "Pricing%20Rule/"+encoded, nil)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].(map[string]interface{}); ok {
+		printf("\n%sPricing Rule: %s%s\n", Cyan, name, Reset)
+		printf("  Title: %v\n", data["title"])
+		fmt.Printf("  %s\n", c.describePricingRule(data))
+		if from, ok := data["valid_from"].(string); ok && from != "" {
+			printf("  Valid From: %s\n", from)
+		}
+		if upto, ok := data["valid_upto"].(string); ok && upto != "" {
+			printf("  Valid Until: %s\n", upto)
+		}
+
+		for _, table := range []struct{ key, field string }{{"items", "item_code"}, {"item_groups", "item_group"}} {
+			if rows, ok := data[table.key].([]interface{}); ok && len(rows) > 0 {
+				printf("\n  %sApplies to:%s\n", Yellow, Reset)
+				for _, row := range rows {
+					if rm, ok := row.(map[string]interface{}); ok {
+						fmt.Printf("    - %v\n", rm[table.field])
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Client) pricingRuleCreate(title string, opts pricingRuleOptions) error {
+	printf("%sCreating pricing rule: %s%s\n", Blue, title, Reset)
+
+	company, err := c.GetCompany()
+	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{
+		"title":                     title,
+		"company":                   company,
+		"selling":                   1,
+		"price_or_product_discount": "Price",
+		"valid_from":                c.Today(),
+		"min_qty":                   opts.minQty,
+	}
+
+	if opts.item != "" {
+		body["apply_on"] = "Item Code"
+		body["items"] = []map[string]interface{}{{"item_code": opts.item}}
+	} else {
+		body["apply_on"] = "Item Group"
+		body["item_groups"] = []map[string]interface{}{{"item_group": opts.group}}
+	}
+
+	if opts.rate > 0 {
+		body["rate_or_discount"] = "Rate"
+		body["rate"] = opts.rate
+	} else {
+		body["rate_or_discount"] = "Discount Percentage"
+		body["discount_percentage"] = opts.discount
+	}
+
+	if opts.customer != "" {
+		body["applicable_for"] = "Customer"
+		body["customer"] = opts.customer
+	} else if opts.customerGroup != "" {
+		body["applicable_for"] = "Customer Group"
+		body["customer_group"] = opts.customerGroup
+	}
+
+	result, err := c.Request("POST", "Pricing%20Rule", body)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].(map[string]interface{}); ok {
+		printf("%s✓ Pricing Rule created: %s%s\n", Green, data["name"], Reset)
+		fmt.Printf("  %s\n", c.describePricingRule(data))
+	}
+	return nil
+}
+
+func (c *Client) pricingRuleDisable(name string) error {
+	printf("%sDisabling pricing rule: %s%s\n", Blue, name, Reset)
+
+	encoded := url.PathEscape(name)
+	_, err := c.Request("PUT", "Pricing%20Rule/"+encoded, map[string]interface{}{"disable": 1})
+	if err != nil {
+		return err
+	}
+
+	printf("%s✓ Pricing Rule disabled: %s%s\n", Green, name, Reset)
+	return nil
+}
+
+// CmdPrice handles price commands
+func (c *Client) CmdPrice(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli price <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: resolve"))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli price resolve CPU-I7 --customer=\"Acme Corp\" --qty=50"))
+		fmt.Println(T("  erp-cli price resolve CPU-I7 --price-list=\"Wholesale\""))
+		return nil
+	}
+
+	switch args[0] {
+	case "resolve":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli price resolve <item> [--customer=X] [--qty=N] [--price-list=X]")
+		}
+		customer, priceList := "", ""
+		qty := 1.0
+		for _, arg := range args[2:] {
+			if len(arg) > 11 && arg[:11] == "--customer=" {
+				customer = arg[11:]
+			}
+			if len(arg) > 6 && arg[:6] == "--qty=" {
+				q, err := strconv.ParseFloat(arg[6:], 64)
+				if err != nil {
+					return errorf("invalid quantity: %s", arg[6:])
+				}
+				qty = q
+			}
+			if len(arg) > 13 && arg[:13] == "--price-list=" {
+				priceList = arg[13:]
+			}
+		}
+		return c.priceResolve(args[1], customer, qty, priceList)
+	default:
+		return errorf("unknown price subcommand: %s", args[0])
+	}
+}
+
+// sellingPriceList returns the price list to quote from: the customer's default,
+// then ERP_DEFAULT_SELLING_PRICE_LIST, then the Selling Settings default, then
+// "Standard Selling"
+func (c *Client) sellingPriceList(customer string) string {
+	if customer != "" {
+		result, err := c.Request("GET", "Customer/"+url.PathEscape(customer), nil)
+		if err == nil {
+			if data, ok := result["data"].(map[string]interface{}); ok {
+				if pl, ok := data["default_price_list"].(string); ok && pl != "" {
+					return pl
+				}
+			}
+		}
+	}
+
+	if c.Config.DefaultSellingPriceList != "" {
+		return c.Config.DefaultSellingPriceList
+	}
+	result, err := c.Request("GET", "Selling%20Settings/Selling%20Settings", nil)
+	if err == nil {
+		if data, ok := result["data"].(map[string]interface{}); ok {
+			if pl, ok := data["selling_price_list"].(string); ok && pl != "" {
+				return pl
+			}
+		}
+	}
+	return "Standard Selling"
+}
+
+// resolvedPrice is the pricing engine's answer for an item
+type resolvedPrice struct {
+	listRate    float64
+	rate        float64 // Effective rate after discounts
+	discountPct float64
+	discountAmt float64
+	rules       string // Applied pricing rule(s)
+}
+
+// priceResolve asks ERPNext's pricing engine for the effective rate of an item
+func (c *Client) priceResolve(itemCode, customer string, qty float64, priceList string) error {
+	printf("%sResolving price for: %s%s\n", Blue, itemCode, Reset)
+
+	if priceList == "" {
+		priceList = c.sellingPriceList(customer)
+	}
+
+	price, err := c.resolvePrice(itemCode, customer, qty, priceList)
+	if err != nil {
+		return err
+	}
+
+	printf("\n%sPrice: %s%s\n", Cyan, itemCode, Reset)
+	if customer != "" {
+		printf("  Customer: %s\n", customer)
+	}
+	printf("  Quantity: %.0f\n", qty)
+	printf("  Price List: %s\n", priceList)
+	printf("  List Rate: %s\n", c.FormatCurrency(price.listRate))
+	if price.discountPct > 0 {
+		printf("  Discount: %.2f%%\n", price.discountPct)
+	}
+	if price.discountAmt > 0 {
+		printf("  Discount Amount: %s\n", c.FormatCurrency(price.discountAmt))
+	}
+	if price.rules != "" {
+		printf("  Pricing Rules: %s\n", price.rules)
+	}
+	printf("  %sEffective Rate: %s%s\n", Green, c.FormatCurrency(price.rate), Reset)
+	printf("  Line Total: %s\n", c.FormatCurrency(price.rate*qty))
+	return nil
+}
+
+// resolvePrice runs the pricing engine (price list, pricing rules, customer
+// discounts) for a Sales Order line
+func (c *Client) resolvePrice(itemCode, customer string, qty float64, priceList string) (resolvedPrice, error) {
+	company, err := c.GetCompany()
+	if err != nil {
+		return resolvedPrice{}, err
+	}
+	currency, _ := c.GetCurrency()
+
+	args := map[string]interface{}{
+		"item_code":           itemCode,
+		"qty":                 qty,
+		"company":             company,
+		"doctype":             "Sales Order",
+		"transaction_date":    c.Today(),
+		"price_list":          priceList,
+		"selling_price_list":  priceList,
+		"currency":            currency.Code,
+		"price_list_currency": currency.Code,
+		"conversion_rate":     1,
+		"plc_conversion_rate": 1,
+	}
+	if customer != "" {
+		args["customer"] = customer
+	}
+
+	result, err := c.CallMethod("POST", "erpnext.stock.get_item_details.get_item_details", map[string]interface{}{"args": args})
+	if err != nil {
+		return resolvedPrice{}, err
+	}
+
+	details, ok := result["message"].(map[string]interface{})
+	if !ok {
+		return resolvedPrice{}, errorf("unexpected response from pricing engine")
+	}
+
+	price := resolvedPrice{}
+	price.listRate, _ = details["price_list_rate"].(float64)
+	price.rate, _ = details["rate"].(float64)
+	price.discountPct, _ = details["discount_percentage"].(float64)
+	price.discountAmt, _ = details["discount_amount"].(float64)
+
+	// Some versions return only the discount; derive the rate from it
+	if price.rate == 0 && price.listRate > 0 {
+		price.rate = price.listRate*(1-price.discountPct/100) - price.discountAmt
+	}
+
+	if rules, ok := details["pricing_rules"].(string); ok && rules != "" {
+		price.rules = rules
+	} else if rule, ok := details["pricing_rule"].(string); ok && rule != "" {
+		price.rules = rule
+	}
+	return price, nil
+}
+
+// buyingPriceList returns ERP_DEFAULT_BUYING_PRICE_LIST, the Buying Settings
+// default price list, or "Standard Buying"
+func (c *Client) buyingPriceList() string {
+	if c.Config.DefaultBuyingPriceList != "" {
+		return c.Config.DefaultBuyingPriceList
+	}
+	result, err := c.Request("GET", "Buying%20Settings/Buying%20Settings", nil)
+	if err == nil {
+		if data, ok := result["data"].(map[string]interface{}); ok {
+			if pl, ok := data["buying_price_list"].(string); ok && pl != "" {
+				return pl
+			}
+		}
+	}
+	return "Standard Buying"
+}
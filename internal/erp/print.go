@@ -0,0 +1,288 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// printOptions holds flags for the print command
+type printOptions struct {
+	width   int    // Line width in characters (default: 80)
+	output  string // File to write instead of stdout
+	lp      bool   // Send to the line printer with lp
+	printer string // lp destination (default: the system default)
+}
+
+func parsePrintOptions(args []string) (printOptions, error) {
+	opts := printOptions{width: 80}
+	for i, arg := range args {
+		switch {
+		case len(arg) > 8 && arg[:8] == "--width=":
+			width, err := strconv.Atoi(arg[8:])
+			if err != nil || width < 32 {
+				return opts, errorf("invalid --width (at least 32): %s", arg[8:])
+			}
+			opts.width = width
+		case arg == "-o" && i+1 < len(args):
+			opts.output = args[i+1]
+		case arg == "--lp":
+			opts.lp = true
+		case len(arg) > 5 && arg[:5] == "--lp=":
+			opts.lp = true
+			opts.printer = arg[5:]
+		}
+	}
+	return opts, nil
+}
+
+// CmdPrint renders a document as plain text for the terminal, a file or a
+// line printer
+func (c *Client) CmdPrint(args []string) error {
+	if len(args) < 2 {
+		fmt.Println(T("Usage: erp-cli print <doctype> <name> [--width=N] [-o file.txt] [--lp[=printer]]"))
+		fmt.Println()
+		fmt.Println(T("Prints a document as plain text: header, party, items, taxes and totals,"))
+		fmt.Println(T("80 columns wide by default (--width=42 or 48 for receipt printers). Use the"))
+		fmt.Println(T("global --ascii for printers without Unicode line drawing. The doctype may"))
+		fmt.Println(T("be a full name or a command name (si, dn, so, ...)."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli print dn MAT-DN-2025-00001"))
+		fmt.Println(T("  erp-cli print si ACC-SINV-2025-00001 --width=42 --ascii"))
+		fmt.Println(T("  erp-cli print dn MAT-DN-2025-00001 --lp=warehouse"))
+		fmt.Println(T("  erp-cli print quotation QTN-00001 -o quote.txt"))
+		return nil
+	}
+
+	opts, err := parsePrintOptions(args[2:])
+	if err != nil {
+		return err
+	}
+	doctype := resolveDoctype(args[0])
+
+	result, err := c.Request("GET", strings.ReplaceAll(doctype, " ", "%20")+"/"+url.PathEscape(args[1]), nil)
+	if err != nil {
+		return err
+	}
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return errorf("%s not found: %s", doctype, args[1])
+	}
+
+	text := asciiText(c.renderPlainDoc(doctype, data, opts.width))
+
+	switch {
+	case opts.lp:
+		lpArgs := []string{}
+		if opts.printer != "" {
+			lpArgs = append(lpArgs, "-d", opts.printer)
+		}
+		cmd := exec.Command("lp", lpArgs...)
+		cmd.Stdin = strings.NewReader(text)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			return errorf("lp failed: %w", err)
+		}
+		printf("%s✓ Sent to %s: %s%s\n", Green, printerName(opts.printer), args[1], Reset)
+	case opts.output != "":
+		if err := os.WriteFile(opts.output, []byte(text), 0644); err != nil {
+			return errorf("failed to write %s: %w", opts.output, err)
+		}
+		printf("%s✓ Saved: %s%s\n", Green, opts.output, Reset)
+	default:
+		fmt.Print(text)
+	}
+	return nil
+}
+
+func printerName(printer string) string {
+	if printer == "" {
+		return T("the default printer")
+	}
+	return printer
+}
+
+// renderPlainDoc lays a document out in plain text lines of the given
+// width. Amounts are plain numbers with the currency in the header, so
+// receipt printers without the currency symbol print them too.
+func (c *Client) renderPlainDoc(doctype string, data map[string]interface{}, width int) string {
+	var b strings.Builder
+	line := func(s string) {
+		b.WriteString(fitText(s, width) + "\n")
+	}
+	rule := func(glyph string) {
+		b.WriteString(strings.Repeat(glyph, width) + "\n")
+	}
+
+	line(centerText(stringField(data, "company"), width))
+	line(centerText(strings.ToUpper(doctype), width))
+	rule("═")
+
+	date := stringField(data, "posting_date")
+	if date == "" {
+		date = stringField(data, "transaction_date")
+	}
+	line(spreadText("No: "+stringField(data, "name"), "Date: "+date, width))
+	for _, field := range []string{"customer_name", "supplier_name", "party_name", "title"} {
+		if party := stringField(data, field); party != "" {
+			line(party)
+			break
+		}
+	}
+	for _, field := range []string{"shipping_address", "address_display"} {
+		if address := htmlToText(stringField(data, field)); address != "" {
+			for _, l := range strings.Split(address, "\n") {
+				line("  " + l)
+			}
+			break
+		}
+	}
+	if currency := stringField(data, "currency"); currency != "" {
+		line(tf("Amounts in %s", currency))
+	}
+
+	rule("─")
+	narrow := width < 48
+	nameWidth := width - 31
+	if narrow {
+		line(spreadText("Item", "Qty x Rate = Amount", width))
+	} else {
+		line(fmt.Sprintf("%-*s %8s %10s %10s", nameWidth, "Item", "Qty", "Rate", "Amount"))
+	}
+	rule("─")
+
+	items, _ := data["items"].([]interface{})
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		code := stringField(m, "item_code")
+		qty, _ := m["qty"].(float64)
+		rate, _ := m["rate"].(float64)
+		amount, _ := m["amount"].(float64)
+		if narrow {
+			line(code)
+			line(padLeft(fmt.Sprintf("%s x %.2f = %.2f", formatQty(qty), rate, amount), width))
+		} else {
+			line(fmt.Sprintf("%-*s %8s %10.2f %10.2f", nameWidth, fitText(code, nameWidth), formatQty(qty), rate, amount))
+		}
+		if name := stringField(m, "item_name"); name != "" && name != code {
+			line("  " + name)
+		}
+		if uom := stringField(m, "uom"); uom != "" && (doctype == "Delivery Note" || doctype == "Purchase Receipt") {
+			detail := "  " + formatQty(qty) + " " + uom
+			if warehouse := stringField(m, "warehouse"); warehouse != "" {
+				detail += " from " + warehouse
+			}
+			line(detail)
+		}
+		for _, field := range []string{"batch_no", "serial_no"} {
+			if value := strings.TrimSpace(stringField(m, field)); value != "" {
+				line("  " + strings.ReplaceAll(value, "\n", ", "))
+			}
+		}
+	}
+	rule("─")
+
+	total := func(label string, amount float64) {
+		line(padLeft(fmt.Sprintf("%s %12.2f", label, amount), width))
+	}
+	if net, ok := data["net_total"].(float64); ok {
+		total("Net Total", net)
+	}
+	if discount, _ := data["discount_amount"].(float64); discount > 0 {
+		total("Discount", -discount)
+	}
+	taxes, _ := data["taxes"].([]interface{})
+	for _, t := range taxes {
+		if m, ok := t.(map[string]interface{}); ok {
+			amount, _ := m["tax_amount"].(float64)
+			description := stringField(m, "description")
+			if description == "" {
+				description = stringField(m, "account_head")
+			}
+			total(fitText(description, width-14), amount)
+		}
+	}
+	if grand, ok := data["grand_total"].(float64); ok {
+		rounded, _ := data["rounded_total"].(float64)
+		if rounded != 0 && rounded != grand {
+			total("Grand Total", grand)
+			grand = rounded
+		}
+		total("TOTAL", grand)
+	}
+	rule("═")
+
+	for _, field := range []string{"instructions", "remarks"} {
+		if remarks := strings.TrimSpace(stringField(data, field)); remarks != "" && remarks != "No Remarks" {
+			for _, l := range wrapText(remarks, width) {
+				line(l)
+			}
+		}
+	}
+	if terms := htmlToText(stringField(data, "terms")); terms != "" {
+		for _, l := range wrapText(terms, width) {
+			line(l)
+		}
+	}
+	line(centerText("Printed "+c.Today(), width))
+	return b.String()
+}
+
+// fitText cuts s to width characters
+func fitText(s string, width int) string {
+	if utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	return string([]rune(s)[:width])
+}
+
+// centerText centers s in width characters
+func centerText(s string, width int) string {
+	s = fitText(s, width)
+	return strings.Repeat(" ", (width-utf8.RuneCountInString(s))/2) + s
+}
+
+// padLeft right-aligns s in width characters
+func padLeft(s string, width int) string {
+	s = fitText(s, width)
+	return strings.Repeat(" ", width-utf8.RuneCountInString(s)) + s
+}
+
+// spreadText puts left and right at the two ends of a line
+func spreadText(left, right string, width int) string {
+	gap := width - utf8.RuneCountInString(left) - utf8.RuneCountInString(right)
+	if gap < 1 {
+		gap = 1
+	}
+	return left + strings.Repeat(" ", gap) + right
+}
+
+// wrapText breaks text into lines of at most width characters at spaces
+func wrapText(text string, width int) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		current := ""
+		for _, word := range strings.Fields(paragraph) {
+			switch {
+			case current == "":
+				current = word
+			case utf8.RuneCountInString(current)+1+utf8.RuneCountInString(word) <= width:
+				current += " " + word
+			default:
+				lines = append(lines, current)
+				current = word
+			}
+		}
+		lines = append(lines, current)
+	}
+	return lines
+}
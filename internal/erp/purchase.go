@@ -8,7 +8,6 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
-	"time"
 )
 
 // PurchaseOrderItem represents an item in a Purchase Order
@@ -54,17 +53,20 @@ type PurchaseInvoice struct {
 // CmdPO handles Purchase Order commands
 func (c *Client) CmdPO(args []string) error {
 	if len(args) == 0 {
-		fmt.Println("Usage: erp-cli po <subcommand> [args...]")
-		fmt.Println("Subcommands: list, get, create, add-item, submit, cancel")
+		fmt.Println(T("Usage: erp-cli po <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, create, add-item, submit, cancel, pdf"))
 		fmt.Println()
-		fmt.Println("Examples:")
-		fmt.Println("  erp-cli po list")
-		fmt.Println("  erp-cli po list --supplier=\"Intel\" --status=Draft")
-		fmt.Println("  erp-cli po get PUR-ORD-2025-00001")
-		fmt.Println("  erp-cli po create \"Intel Corporation\"")
-		fmt.Println("  erp-cli po add-item PUR-ORD-2025-00001 CPU-I7 10 --rate=450")
-		fmt.Println("  erp-cli po submit PUR-ORD-2025-00001")
-		fmt.Println("  erp-cli po cancel PUR-ORD-2025-00001")
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli po list"))
+		fmt.Println(T("  erp-cli po list --supplier=\"Intel\" --status=Draft"))
+		fmt.Println(T("  erp-cli po get PUR-ORD-2025-00001"))
+		fmt.Println(T("  erp-cli po create \"Intel Corporation\""))
+		fmt.Println(T("  erp-cli po create \"Intel Corporation\" --blanket=MFG-BLR-2026-00001 --items=CPU-I7:50,CPU-I5:20"))
+		fmt.Println(T("  erp-cli po add-item PUR-ORD-2025-00001 CPU-I7 10 --rate=450"))
+		fmt.Println(T("  erp-cli po submit PUR-ORD-2025-00001"))
+		fmt.Println(T("  erp-cli po cancel PUR-ORD-2025-00001"))
+		fmt.Println(T("  erp-cli po cancel PUR-ORD-2025-00001 --cascade   (cancel linked PR/PI/payments first)"))
+		fmt.Println(T("  erp-cli po pdf PUR-ORD-2025-00001 -o po.pdf"))
 		return nil
 	}
 
@@ -74,21 +76,36 @@ func (c *Client) CmdPO(args []string) error {
 		return c.poList(opts)
 	case "get":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli po get <name>")
+			return errorf("usage: erp-cli po get <name> [--template=file.tmpl]")
+		}
+		if tmpl := parseTemplateFlag(args[2:]); tmpl != "" {
+			return c.renderDocTemplate("Purchase Order", args[1], tmpl)
 		}
 		return c.poGet(args[1])
 	case "create":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli po create <supplier>")
+			return errorf("usage: erp-cli po create <supplier> [--blanket=X [--items=CODE:QTY,...]] [--currency=X [--exchange-rate=N]] [--transaction-date=YYYY-MM-DD] [--terms=X] [--remarks=\"...\"] [--letterhead=X]")
+		}
+		blanket, picks, err := parseBlanketFlags(args[2:])
+		if err != nil {
+			return err
+		}
+		cur, err := parseCurrencyFlags(args[2:])
+		if err != nil {
+			return err
+		}
+		date, err := transactionDate(args[2:])
+		if err != nil {
+			return err
 		}
-		return c.poCreate(args[1])
+		return c.poCreate(args[1], blanket, picks, cur, date, parseTermsFlags(args[2:]))
 	case "add-item":
 		if len(args) < 4 {
-			return fmt.Errorf("usage: erp-cli po add-item <po_name> <item_code> <qty> [--rate=X]")
+			return errorf("usage: erp-cli po add-item <po_name> <item_code> <qty> [--rate=X]")
 		}
 		qty, err := strconv.ParseFloat(args[3], 64)
 		if err != nil {
-			return fmt.Errorf("invalid quantity: %s", args[3])
+			return errorf("invalid quantity: %s", args[3])
 		}
 		rate := 0.0
 		for _, arg := range args[4:] {
@@ -99,22 +116,29 @@ func (c *Client) CmdPO(args []string) error {
 		return c.poAddItem(args[1], args[2], qty, rate)
 	case "submit":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli po submit <name>")
+			return errorf("usage: erp-cli po submit <name>")
 		}
 		return c.poSubmit(args[1])
 	case "cancel":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli po cancel <name>")
+			return errorf("usage: erp-cli po cancel <name> [--cascade] [--yes]")
+		}
+		return c.poCancel(args[1], parseCancelOptions(args[2:]))
+	case "pdf":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli po pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]")
 		}
-		return c.poCancel(args[1])
+		return c.docPDF("Purchase Order", args[1], parsePDFOptions(args[2:]))
 	default:
-		return fmt.Errorf("unknown po subcommand: %s", args[0])
+		return errorf("unknown po subcommand: %s", args[0])
 	}
 }
 
 type poListOptions struct {
 	supplier string
 	status   string
+	xlsx     string // Output file for --xlsx
+	template string // --template file
 }
 
 func parsePOListOptions(args []string) poListOptions {
@@ -126,13 +150,17 @@ func parsePOListOptions(args []string) poListOptions {
 		if len(arg) > 9 && arg[:9] == "--status=" {
 			opts.status = arg[9:]
 		}
+		if path, ok := parseXLSXFlag(arg, "purchase-orders.xlsx"); ok {
+			opts.xlsx = path
+		}
+		if len(arg) > 11 && arg[:11] == "--template=" {
+			opts.template = arg[11:]
+		}
 	}
 	return opts
 }
 
 func (c *Client) poList(opts poListOptions) error {
-	fmt.Printf("%sFetching purchase orders...%s\n", Blue, Reset)
-
 	// Build filters
 	filters := [][]interface{}{}
 	if opts.supplier != "" {
@@ -142,7 +170,15 @@ func (c *Client) poList(opts poListOptions) error {
 		filters = append(filters, []interface{}{"status", "=", opts.status})
 	}
 
-	endpoint := "Purchase%20Order?limit_page_length=0&fields=[\"name\",\"supplier\",\"transaction_date\",\"status\",\"grand_total\",\"docstatus\"]&order_by=creation%20desc"
+	if opts.xlsx != "" {
+		return c.exportDocsXLSX("Purchase Order", filters, opts.xlsx)
+	}
+	if opts.template != "" {
+		return c.renderListTemplate("Purchase Order", filters, opts.template)
+	}
+	printf("%sFetching purchase orders...%s\n", Blue, Reset)
+
+	endpoint := "Purchase%20Order?" + c.pageLimit(0) + "&fields=[\"name\",\"supplier\",\"transaction_date\",\"status\",\"grand_total\",\"docstatus\"]&order_by=creation%20desc"
 	if len(filters) > 0 {
 		encoded, err := encodeFilters(filters)
 		if err != nil {
@@ -158,11 +194,12 @@ func (c *Client) poList(opts poListOptions) error {
 
 	if data, ok := result["data"].([]interface{}); ok {
 		if len(data) == 0 {
-			fmt.Printf("%sNo purchase orders found%s\n", Yellow, Reset)
+			printf("%sNo purchase orders found%s\n", Yellow, Reset)
 			return nil
 		}
 
-		fmt.Printf("\n%sPurchase Orders (%d):%s\n", Cyan, len(data), Reset)
+		printf("\n%sPurchase Orders (%d):%s\n", Cyan, len(data), Reset)
+		c.printPageNote(len(data))
 		for _, item := range data {
 			if m, ok := item.(map[string]interface{}); ok {
 				name := m["name"]
@@ -179,7 +216,7 @@ func (c *Client) poList(opts poListOptions) error {
 				}
 
 				fmt.Printf("  %s - %s\n", name, supplier)
-				fmt.Printf("    Date: %s | Status: %s%s%s | Total: %s\n",
+				printf("    Date: %s | Status: %s%s%s | Total: %s\n",
 					date, statusColor, status, Reset, c.FormatCurrency(total))
 			}
 		}
@@ -188,7 +225,7 @@ func (c *Client) poList(opts poListOptions) error {
 }
 
 func (c *Client) poGet(name string) error {
-	fmt.Printf("%sFetching purchase order: %s%s\n", Blue, name, Reset)
+	printf("%sFetching purchase order: %s%s\n", Blue, name, Reset)
 
 	encoded := url.PathEscape(name)
 	result, err := c.Request("GET", "Purchase%20Order/"+encoded, nil)
@@ -197,48 +234,70 @@ func (c *Client) poGet(name string) error {
 	}
 
 	if data, ok := result["data"].(map[string]interface{}); ok {
-		fmt.Printf("\n%sPurchase Order: %s%s\n", Cyan, name, Reset)
+		printf("\n%sPurchase Order: %s%s\n", Cyan, name, Reset)
 
 		// Basic info
-		fmt.Printf("  Supplier: %s\n", data["supplier"])
-		fmt.Printf("  Date: %s\n", data["transaction_date"])
-		fmt.Printf("  Status: %s\n", data["status"])
-		grandTotal, _ := data["grand_total"].(float64)
-		fmt.Printf("  Total: %s\n", c.FormatCurrency(grandTotal))
+		printf("  Supplier: %s\n", data["supplier"])
+		printf("  Date: %s\n", data["transaction_date"])
+		printf("  Status: %s\n", data["status"])
+		printf("  Total: %s\n", c.docTotal(data))
 
 		// Items
 		if items, ok := data["items"].([]interface{}); ok && len(items) > 0 {
-			fmt.Printf("\n  %sItems:%s\n", Yellow, Reset)
+			printf("\n  %sItems:%s\n", Yellow, Reset)
 			for _, item := range items {
 				if m, ok := item.(map[string]interface{}); ok {
 					itemCode := m["item_code"]
 					qty, _ := m["qty"].(float64)
 					rate, _ := m["rate"].(float64)
 					amount, _ := m["amount"].(float64)
-					fmt.Printf("    - %s: %.0f x %s = %s\n", itemCode, qty, c.FormatCurrency(rate), c.FormatCurrency(amount))
+					fmt.Printf("    - %s: %.0f x %s = %s\n", itemCode, qty, c.formatDoc(data, rate), c.formatDoc(data, amount))
 				}
 			}
 		}
+
+		printDocTerms(data)
+		c.printActivity("Purchase Order", name)
 	}
 	return nil
 }
 
-func (c *Client) poCreate(supplier string) error {
-	fmt.Printf("%sCreating purchase order for: %s%s\n", Blue, supplier, Reset)
+func (c *Client) poCreate(supplier, blanket string, picks []bomComponent, cur docCurrency, date string, terms docTerms) error {
+	printf("%sCreating purchase order for: %s%s\n", Blue, supplier, Reset)
 
 	company, err := c.GetCompany()
 	if err != nil {
 		return err
 	}
 
-	today := time.Now().Format("2006-01-02")
+	date, err = c.documentDate(date)
+	if err != nil {
+		return err
+	}
+
+	items := []map[string]interface{}{}
+	if blanket != "" {
+		if items, err = c.blanketOrderItems(blanket, "Purchasing", supplier, picks); err != nil {
+			return err
+		}
+		for _, item := range items {
+			item["schedule_date"] = date
+		}
+	}
 
 	body := map[string]interface{}{
 		"supplier":         supplier,
-		"transaction_date": today,
-		"schedule_date":    today,
+		"transaction_date": date,
+		"schedule_date":    date,
 		"company":          company,
-		"items":            []interface{}{},
+		"items":            items,
+	}
+	if cur, err = c.applyCurrency(body, cur, date); err != nil {
+		return err
+	}
+
+	if terms, err = c.applyTerms(body, "Purchase Order", terms, false); err != nil {
+		return err
 	}
 
 	result, err := c.Request("POST", "Purchase%20Order", body)
@@ -248,18 +307,26 @@ func (c *Client) poCreate(supplier string) error {
 
 	if data, ok := result["data"].(map[string]interface{}); ok {
 		poName := data["name"]
-		fmt.Printf("%s✓ Purchase Order created: %s%s\n", Green, poName, Reset)
-		fmt.Printf("  Status: Draft\n")
-		fmt.Printf("  Use 'erp-cli po add-item %s <item> <qty>' to add items\n", poName)
+		printf("%s✓ Purchase Order created: %s%s\n", Green, poName, Reset)
+		printf("  Status: Draft\n")
+		c.printCurrency(cur)
+		printTerms(terms)
+		c.finishRemarks("Purchase Order", stringField(data, "name"), terms)
+		if blanket != "" {
+			c.printBlanketRows(blanket, items)
+			printf("  Use 'erp-cli po submit %s' to submit\n", poName)
+			return nil
+		}
+		printf("  Use 'erp-cli po add-item %s <item> <qty>' to add items\n", poName)
 	}
 
 	return nil
 }
 
 func (c *Client) poAddItem(poName, itemCode string, qty, rate float64) error {
-	fmt.Printf("%sAdding item to PO: %s%s\n", Blue, poName, Reset)
-	fmt.Printf("  Item: %s\n", itemCode)
-	fmt.Printf("  Quantity: %.0f\n", qty)
+	printf("%sAdding item to PO: %s%s\n", Blue, poName, Reset)
+	printf("  Item: %s\n", itemCode)
+	printf("  Quantity: %.0f\n", qty)
 
 	// Get current PO
 	encoded := url.PathEscape(poName)
@@ -270,13 +337,13 @@ func (c *Client) poAddItem(poName, itemCode string, qty, rate float64) error {
 
 	data, ok := result["data"].(map[string]interface{})
 	if !ok {
-		return fmt.Errorf("purchase order not found")
+		return errorf("purchase order not found")
 	}
 
 	// Check if draft
 	docStatus, _ := data["docstatus"].(float64)
 	if docStatus != 0 {
-		return fmt.Errorf("cannot add items to submitted/cancelled PO")
+		return errorf("cannot add items to submitted/cancelled PO")
 	}
 
 	// Get existing items
@@ -297,7 +364,7 @@ func (c *Client) poAddItem(poName, itemCode string, qty, rate float64) error {
 	}
 	if rate > 0 {
 		newItem["rate"] = rate
-		fmt.Printf("  Rate: %s\n", c.FormatCurrency(rate))
+		printf("  Rate: %s\n", c.FormatCurrency(rate))
 	}
 	existingItems = append(existingItems, newItem)
 
@@ -306,52 +373,53 @@ func (c *Client) poAddItem(poName, itemCode string, qty, rate float64) error {
 		"items": existingItems,
 	}
 
-	_, err = c.Request("PUT", "Purchase%20Order/"+encoded, body)
+	err = c.updateDraft("Purchase Order", poName, data, body)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("%s✓ Item added to PO: %s%s\n", Green, poName, Reset)
+	printf("%s✓ Item added to PO: %s%s\n", Green, poName, Reset)
 	return nil
 }
 
 func (c *Client) poSubmit(name string) error {
-	fmt.Printf("%sSubmitting purchase order: %s%s\n", Blue, name, Reset)
+	printf("%sSubmitting purchase order: %s%s\n", Blue, name, Reset)
 
 	err := c.submitDocument("Purchase Order", name)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("%s✓ Purchase Order submitted: %s%s\n", Green, name, Reset)
+	printf("%s✓ Purchase Order submitted: %s%s\n", Green, name, Reset)
 	return nil
 }
 
-func (c *Client) poCancel(name string) error {
-	fmt.Printf("%sCancelling purchase order: %s%s\n", Blue, name, Reset)
+func (c *Client) poCancel(name string, opts cancelOptions) error {
+	printf("%sCancelling purchase order: %s%s\n", Blue, name, Reset)
 
-	err := c.cancelDocument("Purchase Order", name)
+	err := c.cancelWithChain("Purchase Order", name, opts)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("%s✓ Purchase Order cancelled: %s%s\n", Green, name, Reset)
+	printf("%s✓ Purchase Order cancelled: %s%s\n", Green, name, Reset)
 	return nil
 }
 
 // CmdPI handles Purchase Invoice commands
 func (c *Client) CmdPI(args []string) error {
 	if len(args) == 0 {
-		fmt.Println("Usage: erp-cli pi <subcommand> [args...]")
-		fmt.Println("Subcommands: list, get, create-from-po, submit, cancel")
+		fmt.Println(T("Usage: erp-cli pi <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, create-from-po, submit, cancel, pdf"))
 		fmt.Println()
-		fmt.Println("Examples:")
-		fmt.Println("  erp-cli pi list")
-		fmt.Println("  erp-cli pi list --supplier=\"Intel\" --status=Draft")
-		fmt.Println("  erp-cli pi get ACC-PINV-2025-00001")
-		fmt.Println("  erp-cli pi create-from-po PUR-ORD-2025-00001")
-		fmt.Println("  erp-cli pi submit ACC-PINV-2025-00001")
-		fmt.Println("  erp-cli pi cancel ACC-PINV-2025-00001")
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli pi list"))
+		fmt.Println(T("  erp-cli pi list --supplier=\"Intel\" --status=Draft"))
+		fmt.Println(T("  erp-cli pi get ACC-PINV-2025-00001"))
+		fmt.Println(T("  erp-cli pi create-from-po PUR-ORD-2025-00001"))
+		fmt.Println(T("  erp-cli pi submit ACC-PINV-2025-00001"))
+		fmt.Println(T("  erp-cli pi cancel ACC-PINV-2025-00001"))
+		fmt.Println(T("  erp-cli pi pdf ACC-PINV-2025-00001"))
 		return nil
 	}
 
@@ -361,32 +429,50 @@ func (c *Client) CmdPI(args []string) error {
 		return c.piList(opts)
 	case "get":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli pi get <name>")
+			return errorf("usage: erp-cli pi get <name> [--template=file.tmpl]")
+		}
+		if tmpl := parseTemplateFlag(args[2:]); tmpl != "" {
+			return c.renderDocTemplate("Purchase Invoice", args[1], tmpl)
 		}
 		return c.piGet(args[1])
 	case "create-from-po":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli pi create-from-po <po_name>")
+			return errorf("usage: erp-cli pi create-from-po <po_name> [--currency=X] [--exchange-rate=N] [--terms=X] [--remarks=\"...\"] [--letterhead=X]")
 		}
-		return c.piCreateFromPO(args[1])
+		posting, err := parsePostingOptions(args[2:])
+		if err != nil {
+			return err
+		}
+		cur, err := parseCurrencyFlags(args[2:])
+		if err != nil {
+			return err
+		}
+		return c.piCreateFromPO(args[1], posting, cur, parseTermsFlags(args[2:]))
 	case "submit":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli pi submit <name>")
+			return errorf("usage: erp-cli pi submit <name>")
 		}
 		return c.piSubmit(args[1])
 	case "cancel":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli pi cancel <name>")
+			return errorf("usage: erp-cli pi cancel <name> [--cascade] [--yes]")
 		}
-		return c.piCancel(args[1])
+		return c.piCancel(args[1], parseCancelOptions(args[2:]))
+	case "pdf":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli pi pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]")
+		}
+		return c.docPDF("Purchase Invoice", args[1], parsePDFOptions(args[2:]))
 	default:
-		return fmt.Errorf("unknown pi subcommand: %s", args[0])
+		return errorf("unknown pi subcommand: %s", args[0])
 	}
 }
 
 type piListOptions struct {
 	supplier string
 	status   string
+	xlsx     string // Output file for --xlsx
+	template string // --template file
 }
 
 func parsePIListOptions(args []string) piListOptions {
@@ -398,13 +484,17 @@ func parsePIListOptions(args []string) piListOptions {
 		if len(arg) > 9 && arg[:9] == "--status=" {
 			opts.status = arg[9:]
 		}
+		if path, ok := parseXLSXFlag(arg, "purchase-invoices.xlsx"); ok {
+			opts.xlsx = path
+		}
+		if len(arg) > 11 && arg[:11] == "--template=" {
+			opts.template = arg[11:]
+		}
 	}
 	return opts
 }
 
 func (c *Client) piList(opts piListOptions) error {
-	fmt.Printf("%sFetching purchase invoices...%s\n", Blue, Reset)
-
 	// Build filters
 	filters := [][]interface{}{}
 	if opts.supplier != "" {
@@ -414,7 +504,15 @@ func (c *Client) piList(opts piListOptions) error {
 		filters = append(filters, []interface{}{"status", "=", opts.status})
 	}
 
-	endpoint := "Purchase%20Invoice?limit_page_length=0&fields=[\"name\",\"supplier\",\"posting_date\",\"status\",\"grand_total\",\"docstatus\"]&order_by=creation%20desc"
+	if opts.xlsx != "" {
+		return c.exportDocsXLSX("Purchase Invoice", filters, opts.xlsx)
+	}
+	if opts.template != "" {
+		return c.renderListTemplate("Purchase Invoice", filters, opts.template)
+	}
+	printf("%sFetching purchase invoices...%s\n", Blue, Reset)
+
+	endpoint := "Purchase%20Invoice?" + c.pageLimit(0) + "&fields=[\"name\",\"supplier\",\"posting_date\",\"status\",\"grand_total\",\"docstatus\"]&order_by=creation%20desc"
 	if len(filters) > 0 {
 		encoded, err := encodeFilters(filters)
 		if err != nil {
@@ -430,11 +528,12 @@ func (c *Client) piList(opts piListOptions) error {
 
 	if data, ok := result["data"].([]interface{}); ok {
 		if len(data) == 0 {
-			fmt.Printf("%sNo purchase invoices found%s\n", Yellow, Reset)
+			printf("%sNo purchase invoices found%s\n", Yellow, Reset)
 			return nil
 		}
 
-		fmt.Printf("\n%sPurchase Invoices (%d):%s\n", Cyan, len(data), Reset)
+		printf("\n%sPurchase Invoices (%d):%s\n", Cyan, len(data), Reset)
+		c.printPageNote(len(data))
 		for _, item := range data {
 			if m, ok := item.(map[string]interface{}); ok {
 				name := m["name"]
@@ -451,7 +550,7 @@ func (c *Client) piList(opts piListOptions) error {
 				}
 
 				fmt.Printf("  %s - %s\n", name, supplier)
-				fmt.Printf("    Date: %s | Status: %s%s%s | Total: %s\n",
+				printf("    Date: %s | Status: %s%s%s | Total: %s\n",
 					date, statusColor, status, Reset, c.FormatCurrency(total))
 			}
 		}
@@ -460,7 +559,7 @@ func (c *Client) piList(opts piListOptions) error {
 }
 
 func (c *Client) piGet(name string) error {
-	fmt.Printf("%sFetching purchase invoice: %s%s\n", Blue, name, Reset)
+	printf("%sFetching purchase invoice: %s%s\n", Blue, name, Reset)
 
 	encoded := url.PathEscape(name)
 	result, err := c.Request("GET", "Purchase%20Invoice/"+encoded, nil)
@@ -469,18 +568,17 @@ func (c *Client) piGet(name string) error {
 	}
 
 	if data, ok := result["data"].(map[string]interface{}); ok {
-		fmt.Printf("\n%sPurchase Invoice: %s%s\n", Cyan, name, Reset)
+		printf("\n%sPurchase Invoice: %s%s\n", Cyan, name, Reset)
 
 		// Basic info
-		fmt.Printf("  Supplier: %s\n", data["supplier"])
-		fmt.Printf("  Date: %s\n", data["posting_date"])
-		fmt.Printf("  Status: %s\n", data["status"])
-		grandTotal, _ := data["grand_total"].(float64)
-		fmt.Printf("  Total: %s\n", c.FormatCurrency(grandTotal))
+		printf("  Supplier: %s\n", data["supplier"])
+		printf("  Date: %s\n", data["posting_date"])
+		printf("  Status: %s\n", data["status"])
+		printf("  Total: %s\n", c.docTotal(data))
 
 		// Items
 		if items, ok := data["items"].([]interface{}); ok && len(items) > 0 {
-			fmt.Printf("\n  %sItems:%s\n", Yellow, Reset)
+			printf("\n  %sItems:%s\n", Yellow, Reset)
 			for _, item := range items {
 				if m, ok := item.(map[string]interface{}); ok {
 					itemCode := m["item_code"]
@@ -490,44 +588,80 @@ func (c *Client) piGet(name string) error {
 					po := m["purchase_order"]
 					poStr := ""
 					if po != nil && po != "" {
-						poStr = fmt.Sprintf(" (PO: %s)", po)
+						poStr = tf(" (PO: %s)", po)
 					}
-					fmt.Printf("    - %s: %.0f x %s = %s%s\n", itemCode, qty, c.FormatCurrency(rate), c.FormatCurrency(amount), poStr)
+					fmt.Printf("    - %s: %.0f x %s = %s%s\n", itemCode, qty, c.formatDoc(data, rate), c.formatDoc(data, amount), poStr)
 				}
 			}
 		}
+
+		printDocTerms(data)
+		c.printActivity("Purchase Invoice", name)
 	}
 	return nil
 }
 
-func (c *Client) piCreateFromPO(poName string) error {
-	fmt.Printf("%sCreating purchase invoice from PO: %s%s\n", Blue, poName, Reset)
+func (c *Client) piCreateFromPO(poName string, posting postingOptions, cur docCurrency, terms docTerms) error {
+	printf("%sCreating purchase invoice from PO: %s%s\n", Blue, poName, Reset)
+	c.printPosting(posting)
+
+	body, err := c.piFromPO(poName, posting)
+	if err != nil {
+		return err
+	}
+	if cur, err = c.overrideCurrency(body, cur); err != nil {
+		return err
+	}
+
+	if terms, err = c.applyTerms(body, "Purchase Invoice", terms, false); err != nil {
+		return err
+	}
+
+	result, err := c.Request("POST", "Purchase%20Invoice", body)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].(map[string]interface{}); ok {
+		piName := data["name"]
+		printf("%s✓ Purchase Invoice created: %s%s\n", Green, piName, Reset)
+		printf("  From PO: %s\n", poName)
+		printf("  Items: %d\n", len(body["items"].([]map[string]interface{})))
+		c.printCurrency(cur)
+		printf("  Status: Draft\n")
+		printTerms(terms)
+		c.finishRemarks("Purchase Invoice", stringField(data, "name"), terms)
+		printf("  Use 'erp-cli pi submit %s' to submit\n", piName)
+	}
+
+	return nil
+}
 
+// piFromPO builds the body of a Purchase Invoice billing a submitted PO
+func (c *Client) piFromPO(poName string, posting postingOptions) (map[string]interface{}, error) {
 	// Get the PO
 	encoded := url.PathEscape(poName)
 	result, err := c.Request("GET", "Purchase%20Order/"+encoded, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	poData, ok := result["data"].(map[string]interface{})
 	if !ok {
-		return fmt.Errorf("purchase order not found")
+		return nil, errorf("purchase order not found")
 	}
 
 	// Check if submitted
 	docStatus, _ := poData["docstatus"].(float64)
 	if docStatus != 1 {
-		return fmt.Errorf("purchase order must be submitted first")
+		return nil, errorf("purchase order must be submitted first")
 	}
 
 	company, err := c.GetCompany()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	today := time.Now().Format("2006-01-02")
-
 	// Build invoice items from PO items
 	var invoiceItems []map[string]interface{}
 	if items, ok := poData["items"].([]interface{}); ok {
@@ -545,59 +679,54 @@ func (c *Client) piCreateFromPO(poName string) error {
 	}
 
 	if len(invoiceItems) == 0 {
-		return fmt.Errorf("no items found in purchase order")
+		return nil, errorf("no items found in purchase order")
 	}
 
 	body := map[string]interface{}{
-		"supplier":     poData["supplier"],
-		"posting_date": today,
-		"company":      company,
-		"items":        invoiceItems,
-	}
-
-	result, err = c.Request("POST", "Purchase%20Invoice", body)
-	if err != nil {
-		return err
+		"supplier": poData["supplier"],
+		"company":  company,
+		"items":    invoiceItems,
 	}
-
-	if data, ok := result["data"].(map[string]interface{}); ok {
-		piName := data["name"]
-		fmt.Printf("%s✓ Purchase Invoice created: %s%s\n", Green, piName, Reset)
-		fmt.Printf("  From PO: %s\n", poName)
-		fmt.Printf("  Items: %d\n", len(invoiceItems))
-		fmt.Printf("  Status: Draft\n")
-		fmt.Printf("  Use 'erp-cli pi submit %s' to submit\n", piName)
+	if err := c.applyPosting(body, posting, true); err != nil {
+		return nil, err
 	}
-
-	return nil
+	copyOrderCurrency(body, poData)
+	copyOrderTerms(body, poData)
+	return body, nil
 }
 
 func (c *Client) piSubmit(name string) error {
-	fmt.Printf("%sSubmitting purchase invoice: %s%s\n", Blue, name, Reset)
+	printf("%sSubmitting purchase invoice: %s%s\n", Blue, name, Reset)
 
 	err := c.submitDocument("Purchase Invoice", name)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("%s✓ Purchase Invoice submitted: %s%s\n", Green, name, Reset)
+	printf("%s✓ Purchase Invoice submitted: %s%s\n", Green, name, Reset)
 	return nil
 }
 
-func (c *Client) piCancel(name string) error {
-	fmt.Printf("%sCancelling purchase invoice: %s%s\n", Blue, name, Reset)
+func (c *Client) piCancel(name string, opts cancelOptions) error {
+	printf("%sCancelling purchase invoice: %s%s\n", Blue, name, Reset)
 
-	err := c.cancelDocument("Purchase Invoice", name)
+	err := c.cancelWithChain("Purchase Invoice", name, opts)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("%s✓ Purchase Invoice cancelled: %s%s\n", Green, name, Reset)
+	printf("%s✓ Purchase Invoice cancelled: %s%s\n", Green, name, Reset)
 	return nil
 }
 
-// submitDocument submits a document using frappe.client.submit
+// submitDocument submits a document using frappe.client.submit. Every
+// submit goes through it, so it refuses receipts and deliveries whose items
+// still need an accepted Quality Inspection.
 func (c *Client) submitDocument(doctype, name string) error {
+	if err := c.checkInspections(doctype, name); err != nil {
+		return err
+	}
+
 	fullURL := fmt.Sprintf("%s/api/method/frappe.client.submit", c.ActiveURL)
 
 	body := map[string]interface{}{
@@ -630,7 +759,7 @@ func (c *Client) submitDocument(doctype, name string) error {
 	respBody, _ := io.ReadAll(resp.Body)
 	_, err = parseAPIResponse(resp.StatusCode, respBody)
 	if err != nil {
-		return fmt.Errorf("submit failed: %w", err)
+		return errorf("submit failed: %w", err)
 	}
 
 	return nil
@@ -668,7 +797,23 @@ func (c *Client) cancelDocument(doctype, name string) error {
 	respBody, _ := io.ReadAll(resp.Body)
 	_, err = parseAPIResponse(resp.StatusCode, respBody)
 	if err != nil {
-		return fmt.Errorf("cancel failed: %w", err)
+		return errorf("cancel failed: %w", err)
+	}
+
+	return nil
+}
+
+// renameDocument renames a document using frappe.client.rename_doc
+func (c *Client) renameDocument(doctype, oldName, newName string) error {
+	body := map[string]interface{}{
+		"doctype":  doctype,
+		"old_name": oldName,
+		"new_name": newName,
+	}
+
+	_, err := c.CallMethod("POST", "frappe.client.rename_doc", body)
+	if err != nil {
+		return errorf("rename failed: %w", err)
 	}
 
 	return nil
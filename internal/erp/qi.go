@@ -0,0 +1,527 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// inspectionFlags maps the documents that can require a Quality Inspection
+// to the inspection type and the Item flag that requires it
+var inspectionFlags = map[string]struct {
+	inspectionType string
+	itemFlag       string
+}{
+	"Purchase Receipt": {"Incoming", "inspection_required_before_purchase"},
+	"Delivery Note":    {"Outgoing", "inspection_required_before_delivery"},
+}
+
+// qiCreateOptions holds flags for qi create
+type qiCreateOptions struct {
+	ref      string // Purchase Receipt or Delivery Note
+	item     string
+	result   string // Accepted or Rejected
+	readings []qiReading
+	sample   float64 // Defaults to the row quantity
+	remarks  string
+	draft    bool // Leave the inspection unsubmitted
+}
+
+// qiReading is one measured parameter of an inspection
+type qiReading struct {
+	specification string
+	value         string
+}
+
+// inspectionGap is a row of a document that still needs an accepted inspection
+type inspectionGap struct {
+	itemCode   string
+	inspection string // Linked Quality Inspection, if any
+	status     string // Its status, if any
+}
+
+// CmdQI handles Quality Inspection commands
+func (c *Client) CmdQI(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli qi <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, create, submit, pending"))
+		fmt.Println()
+		fmt.Println(T("Items flagged \"inspection required\" block the submit of their Purchase"))
+		fmt.Println(T("Receipt or Delivery Note until an accepted inspection is linked to them."))
+		fmt.Println(T("create submits the inspection, which links it to the document's row;"))
+		fmt.Println(T("--readings takes Parameter:value pairs separated by commas."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli qi pending PREC-00001"))
+		fmt.Println(T("  erp-cli qi create --ref=PREC-00001 --item=CPU-I7 --result=Accepted"))
+		fmt.Println(T("  erp-cli qi create --ref=PREC-00001 --item=CPU-I7 --result=Rejected --readings=\"Voltage:1.42,Finish:Scratched\" --remarks=\"Bent pins\""))
+		fmt.Println(T("  erp-cli qi create --ref=DN-00001 --item=PC-GAMING --result=Accepted --draft"))
+		fmt.Println(T("  erp-cli qi submit MAT-QA-2026-00002"))
+		fmt.Println(T("  erp-cli qi list --ref=PREC-00001"))
+		fmt.Println(T("  erp-cli qi get MAT-QA-2026-00001"))
+		return nil
+	}
+
+	switch args[0] {
+	case "list":
+		ref, status := "", ""
+		for _, arg := range args[1:] {
+			if len(arg) > 6 && arg[:6] == "--ref=" {
+				ref = arg[6:]
+			}
+			if len(arg) > 9 && arg[:9] == "--status=" {
+				status = arg[9:]
+			}
+		}
+		return c.qiList(ref, status)
+	case "get":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli qi get <name>")
+		}
+		return c.qiGet(args[1])
+	case "create":
+		opts, err := parseQICreateOptions(args[1:])
+		if err != nil {
+			return err
+		}
+		return c.qiCreate(opts)
+	case "submit":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli qi submit <name>")
+		}
+		return c.qiSubmit(args[1])
+	case "pending":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli qi pending <receipt or delivery note>")
+		}
+		return c.qiPending(args[1])
+	default:
+		return errorf("unknown qi subcommand: %s", args[0])
+	}
+}
+
+func parseQICreateOptions(args []string) (qiCreateOptions, error) {
+	opts := qiCreateOptions{}
+	for _, arg := range args {
+		switch {
+		case len(arg) > 6 && arg[:6] == "--ref=":
+			opts.ref = arg[6:]
+		case len(arg) > 7 && arg[:7] == "--item=":
+			opts.item = arg[7:]
+		case len(arg) > 9 && arg[:9] == "--result=":
+			opts.result = arg[9:]
+		case len(arg) > 11 && arg[:11] == "--readings=":
+			for _, pair := range strings.Split(arg[11:], ",") {
+				parts := strings.SplitN(pair, ":", 2)
+				if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+					return opts, errorf("invalid reading (expected Parameter:value): %s", pair)
+				}
+				opts.readings = append(opts.readings, qiReading{strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])})
+			}
+		case len(arg) > 9 && arg[:9] == "--sample=":
+			sample, err := strconv.ParseFloat(arg[9:], 64)
+			if err != nil || sample <= 0 {
+				return opts, errorf("invalid sample size: %s", arg[9:])
+			}
+			opts.sample = sample
+		case len(arg) > 10 && arg[:10] == "--remarks=":
+			opts.remarks = arg[10:]
+		case arg == "--draft":
+			opts.draft = true
+		}
+	}
+
+	if opts.ref == "" || opts.item == "" || opts.result == "" {
+		return opts, errorf("usage: erp-cli qi create --ref=<receipt or delivery note> --item=<item_code> --result=Accepted|Rejected [--readings=\"Param:value,...\"] [--sample=N] [--remarks=X] [--draft]")
+	}
+	switch strings.ToLower(opts.result) {
+	case "accepted":
+		opts.result = "Accepted"
+	case "rejected":
+		opts.result = "Rejected"
+	default:
+		return opts, errorf("invalid result (expected Accepted or Rejected): %s", opts.result)
+	}
+	return opts, nil
+}
+
+// inspectedDocument fetches a Purchase Receipt or Delivery Note by name,
+// returning its doctype along with it
+func (c *Client) inspectedDocument(name string) (string, map[string]interface{}, error) {
+	for _, doctype := range []string{"Purchase Receipt", "Delivery Note"} {
+		result, err := c.Request("GET", url.PathEscape(doctype)+"/"+url.PathEscape(name), nil)
+		if err != nil {
+			continue
+		}
+		if data, ok := result["data"].(map[string]interface{}); ok {
+			return doctype, data, nil
+		}
+	}
+	return "", nil, errorf("no purchase receipt or delivery note named %s", name)
+}
+
+func (c *Client) qiCreate(opts qiCreateOptions) error {
+	printf("%sCreating quality inspection: %s on %s%s\n", Blue, opts.item, opts.ref, Reset)
+
+	doctype, doc, err := c.inspectedDocument(opts.ref)
+	if err != nil {
+		return err
+	}
+	if docStatus, _ := doc["docstatus"].(float64); docStatus != 0 {
+		return errorf("%s %s is already submitted: inspections are linked to drafts", doctype, opts.ref)
+	}
+
+	// Prefer a row not inspected yet; otherwise this re-inspects the item
+	var row map[string]interface{}
+	items, _ := doc["items"].([]interface{})
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok || stringField(m, "item_code") != opts.item {
+			continue
+		}
+		if row == nil || stringField(row, "quality_inspection") != "" && stringField(m, "quality_inspection") == "" {
+			row = m
+		}
+	}
+	if row == nil {
+		return errorf("%s has no row for item %s", opts.ref, opts.item)
+	}
+
+	sample := opts.sample
+	if sample == 0 {
+		sample, _ = row["qty"].(float64)
+	}
+
+	body := map[string]interface{}{
+		"inspection_type":     inspectionFlags[doctype].inspectionType,
+		"reference_type":      doctype,
+		"reference_name":      opts.ref,
+		"child_row_reference": row["name"],
+		"item_code":           opts.item,
+		"sample_size":         sample,
+		"status":              opts.result,
+		// Keep the given result instead of deriving it from the readings
+		"manual_inspection": 1,
+	}
+	if opts.remarks != "" {
+		body["remarks"] = opts.remarks
+	}
+	if len(opts.readings) > 0 {
+		var readings []map[string]interface{}
+		for _, r := range opts.readings {
+			reading := map[string]interface{}{
+				"specification": r.specification,
+				"status":        opts.result,
+			}
+			if _, err := strconv.ParseFloat(r.value, 64); err == nil {
+				reading["numeric"] = 1
+				reading["reading_1"] = r.value
+			} else {
+				reading["numeric"] = 0
+				reading["reading_value"] = r.value
+			}
+			readings = append(readings, reading)
+		}
+		body["readings"] = readings
+	}
+
+	result, err := c.Request("POST", "Quality%20Inspection", body)
+	if err != nil {
+		return err
+	}
+	data, _ := result["data"].(map[string]interface{})
+	name := stringField(data, "name")
+
+	if opts.draft {
+		printf("%s✓ Quality Inspection created: %s%s\n", Green, name, Reset)
+		printf("  Status: Draft (%s)\n", opts.result)
+		printf("  Use 'erp-cli qi submit %s' to link it to %s\n", name, opts.ref)
+		return nil
+	}
+
+	// Submitting links the inspection to the document's row
+	if err := c.submitDocument("Quality Inspection", name); err != nil {
+		return errorf("quality inspection %s created but not submitted: %w", name, err)
+	}
+
+	resultColor := Green
+	if opts.result == "Rejected" {
+		resultColor = Red
+	}
+	printf("%s✓ Quality Inspection submitted: %s%s\n", Green, name, Reset)
+	fmt.Printf("  %s %s: %s%s%s\n", opts.ref, opts.item, resultColor, opts.result, Reset)
+
+	gaps, err := c.inspectionGaps(doctype, doc)
+	if err == nil && len(gaps) == 0 {
+		printf("  All inspections done: use 'erp-cli %s submit %s' to submit\n", inspectionCommand(doctype), opts.ref)
+	}
+	return nil
+}
+
+func (c *Client) qiSubmit(name string) error {
+	printf("%sSubmitting quality inspection: %s%s\n", Blue, name, Reset)
+
+	if err := c.submitDocument("Quality Inspection", name); err != nil {
+		return err
+	}
+
+	printf("%s✓ Quality Inspection submitted: %s%s\n", Green, name, Reset)
+	return nil
+}
+
+// inspectionCommand returns the CLI command handling a doctype
+func inspectionCommand(doctype string) string {
+	if doctype == "Delivery Note" {
+		return "dn"
+	}
+	return "pr"
+}
+
+// inspectionGaps returns the rows of a draft that need an accepted
+// inspection before it can be submitted. Linked inspections are looked up
+// again so ones submitted since the document was fetched count.
+func (c *Client) inspectionGaps(doctype string, doc map[string]interface{}) ([]inspectionGap, error) {
+	flags, ok := inspectionFlags[doctype]
+	if !ok {
+		return nil, nil
+	}
+	items, _ := doc["items"].([]interface{})
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	var codes []interface{}
+	for _, item := range items {
+		if m, ok := item.(map[string]interface{}); ok {
+			codes = append(codes, stringField(m, "item_code"))
+		}
+	}
+	filters, err := encodeFilters([][]interface{}{{"name", "in", codes}, {flags.itemFlag, "=", 1}})
+	if err != nil {
+		return nil, err
+	}
+	result, err := c.Request("GET", "Item?limit_page_length=0&fields=[\"name\"]&filters="+filters, nil)
+	if err != nil {
+		return nil, err
+	}
+	required := map[string]bool{}
+	if data, ok := result["data"].([]interface{}); ok {
+		for _, row := range data {
+			if m, ok := row.(map[string]interface{}); ok {
+				required[stringField(m, "name")] = true
+			}
+		}
+	}
+	if len(required) == 0 {
+		return nil, nil
+	}
+
+	var inspections []map[string]interface{} // Oldest first
+	filters, err = encodeFilters([][]interface{}{{"reference_type", "=", doctype}, {"reference_name", "=", doc["name"]}, {"docstatus", "=", 1}})
+	if err != nil {
+		return nil, err
+	}
+	fields := url.QueryEscape(`["name","item_code","status","child_row_reference"]`)
+	result, err = c.Request("GET", "Quality%20Inspection?limit_page_length=0&order_by=creation%20asc&fields="+fields+"&filters="+filters, nil)
+	if err != nil {
+		return nil, err
+	}
+	if data, ok := result["data"].([]interface{}); ok {
+		for _, row := range data {
+			if m, ok := row.(map[string]interface{}); ok {
+				inspections = append(inspections, m)
+			}
+		}
+	}
+
+	var gaps []inspectionGap
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok || !required[stringField(m, "item_code")] {
+			continue
+		}
+		// Not every version writes the link back to the row: also match the
+		// inspection's row reference, or its item where there is none. The
+		// latest one wins, so a re-inspection replaces a rejection.
+		var qi map[string]interface{}
+		for _, candidate := range inspections {
+			ref := stringField(candidate, "child_row_reference")
+			if stringField(candidate, "name") == stringField(m, "quality_inspection") ||
+				ref == stringField(m, "name") ||
+				ref == "" && stringField(candidate, "item_code") == stringField(m, "item_code") {
+				qi = candidate
+			}
+		}
+		gap := inspectionGap{itemCode: stringField(m, "item_code")}
+		if qi != nil {
+			gap.inspection = stringField(qi, "name")
+			gap.status = stringField(qi, "status")
+		}
+		if gap.status != "Accepted" {
+			gaps = append(gaps, gap)
+		}
+	}
+	return gaps, nil
+}
+
+// checkInspections blocks the submit of a document whose items still need
+// an accepted Quality Inspection, telling how to record them
+func (c *Client) checkInspections(doctype, name string) error {
+	if _, ok := inspectionFlags[doctype]; !ok {
+		return nil
+	}
+	result, err := c.Request("GET", url.PathEscape(doctype)+"/"+url.PathEscape(name), nil)
+	if err != nil {
+		return err
+	}
+	doc, _ := result["data"].(map[string]interface{})
+	gaps, err := c.inspectionGaps(doctype, doc)
+	if err != nil || len(gaps) == 0 {
+		return err
+	}
+
+	var parts []string
+	for _, gap := range gaps {
+		switch {
+		case gap.status == "Rejected":
+			parts = append(parts, tf("%s (rejected in %s)", gap.itemCode, gap.inspection))
+		default:
+			parts = append(parts, gap.itemCode)
+		}
+	}
+	return errorf("%s needs an accepted quality inspection for: %s (erp-cli qi create --ref=%s --item=<item> --result=Accepted)",
+		name, strings.Join(parts, ", "), name)
+}
+
+// qiPending lists the rows of a document that still need an inspection
+func (c *Client) qiPending(name string) error {
+	printf("%sChecking inspections of: %s%s\n", Blue, name, Reset)
+
+	doctype, doc, err := c.inspectedDocument(name)
+	if err != nil {
+		return err
+	}
+	gaps, err := c.inspectionGaps(doctype, doc)
+	if err != nil {
+		return err
+	}
+
+	if len(gaps) == 0 {
+		printf("%s✓ No inspections pending on %s %s%s\n", Green, doctype, name, Reset)
+		return nil
+	}
+
+	printf("\n%sPending Inspections on %s %s (%d):%s\n", Cyan, doctype, name, len(gaps), Reset)
+	for _, gap := range gaps {
+		switch gap.status {
+		case "Rejected":
+			printf("  • %s %s(rejected: %s)%s\n", gap.itemCode, Red, gap.inspection, Reset)
+		case "":
+			printf("  • %s %s(not inspected)%s\n", gap.itemCode, Yellow, Reset)
+		default:
+			fmt.Printf("  • %s %s(%s: %s)%s\n", gap.itemCode, Yellow, gap.status, gap.inspection, Reset)
+		}
+	}
+	printf("\n  Record each with 'erp-cli qi create --ref=%s --item=<item> --result=Accepted'\n", name)
+	return nil
+}
+
+func (c *Client) qiList(ref, status string) error {
+	printf("%sFetching quality inspections...%s\n", Blue, Reset)
+
+	filters := [][]interface{}{}
+	if ref != "" {
+		filters = append(filters, []interface{}{"reference_name", "=", ref})
+	}
+	if status != "" {
+		filters = append(filters, []interface{}{"status", "=", status})
+	}
+
+	endpoint := "Quality%20Inspection?" + c.pageLimit(0) + "&fields=[\"name\",\"item_code\",\"reference_type\",\"reference_name\",\"status\",\"report_date\",\"docstatus\"]&order_by=creation%20desc"
+	if len(filters) > 0 {
+		encoded, err := encodeFilters(filters)
+		if err != nil {
+			return err
+		}
+		endpoint += "&filters=" + encoded
+	}
+
+	result, err := c.Request("GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	data, _ := result["data"].([]interface{})
+	if len(data) == 0 {
+		printf("%sNo quality inspections found%s\n", Yellow, Reset)
+		return nil
+	}
+
+	printf("\n%sQuality Inspections (%d):%s\n", Cyan, len(data), Reset)
+	c.printPageNote(len(data))
+	for _, row := range data {
+		m, ok := row.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		status := stringField(m, "status")
+		statusColor := Green
+		if status == "Rejected" {
+			statusColor = Red
+		}
+		if docStatus, _ := m["docstatus"].(float64); docStatus == 0 {
+			status += " (Draft)"
+			statusColor = Yellow
+		}
+		fmt.Printf("  %s - %s\n", stringField(m, "name"), stringField(m, "item_code"))
+		printf("    %s %s | Date: %s | Result: %s%s%s\n",
+			stringField(m, "reference_type"), stringField(m, "reference_name"), stringField(m, "report_date"), statusColor, status, Reset)
+	}
+	return nil
+}
+
+func (c *Client) qiGet(name string) error {
+	printf("%sFetching quality inspection: %s%s\n", Blue, name, Reset)
+
+	result, err := c.Request("GET", "Quality%20Inspection/"+url.PathEscape(name), nil)
+	if err != nil {
+		return err
+	}
+
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return errorf("quality inspection not found")
+	}
+
+	printf("\n%sQuality Inspection: %s%s\n", Cyan, name, Reset)
+	printf("  Item: %s\n", stringField(data, "item_code"))
+	printf("  Reference: %s %s\n", stringField(data, "reference_type"), stringField(data, "reference_name"))
+	printf("  Type: %s\n", stringField(data, "inspection_type"))
+	printf("  Date: %s\n", stringField(data, "report_date"))
+	sample, _ := data["sample_size"].(float64)
+	printf("  Sample Size: %g\n", sample)
+	printf("  Result: %s\n", stringField(data, "status"))
+	if by := stringField(data, "inspected_by"); by != "" {
+		printf("  Inspected By: %s\n", by)
+	}
+	if remarks := stringField(data, "remarks"); remarks != "" {
+		printf("  Remarks: %s\n", remarks)
+	}
+
+	if readings, ok := data["readings"].([]interface{}); ok && len(readings) > 0 {
+		printf("\n  %sReadings:%s\n", Yellow, Reset)
+		for _, r := range readings {
+			m, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			value := stringField(m, "reading_value")
+			if value == "" {
+				value = stringField(m, "reading_1")
+			}
+			fmt.Printf("    - %s: %s [%s]\n", stringField(m, "specification"), value, stringField(m, "status"))
+		}
+	}
+	return nil
+}
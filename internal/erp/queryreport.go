@@ -0,0 +1,402 @@
+package erp
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxReportColumnWidth caps a table column; longer cells are cut
+const maxReportColumnWidth = 30
+
+// reportColumn describes one column of a query report result
+type reportColumn struct {
+	fieldname string
+	label     string
+	fieldtype string
+}
+
+// reportSummaryItem is one headline figure a report returns next to its rows
+type reportSummaryItem struct {
+	label    string
+	value    interface{}
+	datatype string
+}
+
+// queryReportResult is the output of a script/query report. Rows are keyed
+// by column fieldname whether the server sent them as objects or as lists.
+type queryReportResult struct {
+	columns []reportColumn
+	rows    []map[string]interface{}
+	summary []reportSummaryItem
+}
+
+// queryReport runs a script/query report through frappe.desk.query_report.run
+func (c *Client) queryReport(report string, filters map[string]interface{}) (*queryReportResult, error) {
+	result, err := c.CallMethod("POST", "frappe.desk.query_report.run", map[string]interface{}{
+		"report_name": report,
+		"filters":     filters,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	message, ok := result["message"].(map[string]interface{})
+	if !ok {
+		return nil, errorf("unexpected response from report %s", report)
+	}
+
+	out := &queryReportResult{}
+	if cols, ok := message["columns"].([]interface{}); ok {
+		for _, col := range cols {
+			out.columns = append(out.columns, parseReportColumn(col))
+		}
+	}
+
+	if data, ok := message["result"].([]interface{}); ok {
+		for _, item := range data {
+			switch row := item.(type) {
+			case map[string]interface{}:
+				if len(row) > 0 {
+					out.rows = append(out.rows, row)
+				}
+			case []interface{}:
+				// Older reports return positional rows
+				m := map[string]interface{}{}
+				for i, value := range row {
+					if i < len(out.columns) {
+						m[out.columns[i].fieldname] = value
+					}
+				}
+				if len(m) > 0 {
+					out.rows = append(out.rows, m)
+				}
+			}
+		}
+	}
+
+	if items, ok := message["report_summary"].([]interface{}); ok {
+		for _, item := range items {
+			if m, ok := item.(map[string]interface{}); ok {
+				out.summary = append(out.summary, reportSummaryItem{
+					label:    stringField(m, "label"),
+					value:    m["value"],
+					datatype: stringField(m, "datatype"),
+				})
+			}
+		}
+	}
+	return out, nil
+}
+
+// parseReportColumn reads a column given as an object or in the legacy
+// "Label:Fieldtype/Options:Width" string form
+func parseReportColumn(col interface{}) reportColumn {
+	switch v := col.(type) {
+	case map[string]interface{}:
+		rc := reportColumn{
+			fieldname: stringField(v, "fieldname"),
+			label:     stringField(v, "label"),
+			fieldtype: stringField(v, "fieldtype"),
+		}
+		if rc.fieldname == "" {
+			rc.fieldname = reportFieldname(rc.label)
+		}
+		return rc
+	case string:
+		parts := strings.Split(v, ":")
+		rc := reportColumn{label: parts[0], fieldtype: "Data"}
+		if len(parts) > 1 && parts[1] != "" {
+			rc.fieldtype = strings.SplitN(parts[1], "/", 2)[0]
+		}
+		rc.fieldname = reportFieldname(rc.label)
+		return rc
+	}
+	return reportColumn{}
+}
+
+// reportFieldname derives a fieldname from a label the way Frappe does
+func reportFieldname(label string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(label)), " ", "_")
+}
+
+// runQueryReport runs a report and returns only its rows. Summary rows
+// (opening, totals) are returned as they come; blank separator rows are
+// dropped.
+func (c *Client) runQueryReport(report string, filters map[string]interface{}) ([]map[string]interface{}, error) {
+	result, err := c.queryReport(report, filters)
+	if err != nil {
+		return nil, err
+	}
+	return result.rows, nil
+}
+
+// qreportOptions holds flags for the qreport command
+type qreportOptions struct {
+	filters map[string]interface{}
+	csv     string // Output file
+	json    bool
+}
+
+// parseQReportOptions reads --filter key=value (repeatable, also
+// --filter=key=value), --csv[=file] and --json
+func parseQReportOptions(report string, args []string) (qreportOptions, error) {
+	opts := qreportOptions{filters: map[string]interface{}{}}
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		filter := ""
+		switch {
+		case arg == "--filter":
+			if i+1 >= len(args) {
+				return opts, errorf("--filter needs key=value")
+			}
+			filter = args[i+1]
+			i++
+		case len(arg) > 9 && arg[:9] == "--filter=":
+			filter = arg[9:]
+		case arg == "--csv":
+			opts.csv = reportFieldname(report) + ".csv"
+		case len(arg) > 6 && arg[:6] == "--csv=":
+			opts.csv = arg[6:]
+		case arg == "--json":
+			opts.json = true
+		default:
+			return opts, errorf("unknown argument: %s", arg)
+		}
+		if filter == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(filter, "=")
+		if !ok || key == "" {
+			return opts, errorf("invalid filter %q (expected key=value)", filter)
+		}
+		opts.filters[key] = value
+	}
+	return opts, nil
+}
+
+// CmdQReport runs an ERPNext script/query report and prints the result
+func (c *Client) CmdQReport(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli qreport <Report Name> [--filter key=value ...] [--csv[=file]] [--json]"))
+		fmt.Println(T("       erp-cli qreport --list [--module=X]"))
+		fmt.Println()
+		fmt.Println(T("The company filter is added when not given. Other required filters"))
+		fmt.Println(T("(usually from_date and to_date) depend on the report."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli qreport --list --module=Stock"))
+		fmt.Println(T("  erp-cli qreport \"Stock Ageing\" --filter to_date=2025-03-31 --filter range1=30"))
+		fmt.Println(T("  erp-cli qreport \"Sales Register\" --filter from_date=2025-01-01 --filter to_date=2025-01-31 --csv"))
+		fmt.Println(T("  erp-cli qreport \"Stock Balance\" --filter from_date=2025-01-01 --filter to_date=2025-01-31 --json"))
+		return nil
+	}
+
+	if args[0] == "--list" {
+		module := ""
+		for _, arg := range args[1:] {
+			if len(arg) > 9 && arg[:9] == "--module=" {
+				module = arg[9:]
+			}
+		}
+		return c.qreportList(module)
+	}
+
+	opts, err := parseQReportOptions(args[0], args[1:])
+	if err != nil {
+		return err
+	}
+	if _, ok := opts.filters["company"]; !ok {
+		company, err := c.GetCompany()
+		if err != nil {
+			return err
+		}
+		opts.filters["company"] = company
+	}
+
+	printf("%sRunning report: %s%s\n", Blue, args[0], Reset)
+	result, err := c.queryReport(args[0], opts.filters)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case opts.csv != "":
+		if err := writeReportCSV(opts.csv, result); err != nil {
+			return err
+		}
+		printf("%s✓ Exported %d rows to %s%s\n", Green, len(result.rows), opts.csv, Reset)
+	case opts.json:
+		jsonOut, _ := json.MarshalIndent(result.rows, "", "  ")
+		fmt.Println(string(jsonOut))
+	default:
+		c.printReportTable(args[0], result)
+	}
+	return nil
+}
+
+// qreportList lists the script and query reports the user can run
+func (c *Client) qreportList(module string) error {
+	printf("%sFetching reports...%s\n", Blue, Reset)
+
+	filters := [][]interface{}{
+		{"report_type", "in", []string{"Script Report", "Query Report"}},
+		{"disabled", "=", 0},
+	}
+	if module != "" {
+		filters = append(filters, []interface{}{"module", "=", module})
+	}
+	encoded, err := encodeFilters(filters)
+	if err != nil {
+		return err
+	}
+
+	result, err := c.Request("GET", "Report?limit_page_length=0&fields=[\"name\",\"module\",\"report_type\"]&order_by=module%20asc,name%20asc&filters="+encoded, nil)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].([]interface{}); ok {
+		if len(data) == 0 {
+			printf("%sNo reports found%s\n", Yellow, Reset)
+			return nil
+		}
+
+		printf("\n%sReports (%d):%s\n", Cyan, len(data), Reset)
+		lastModule := ""
+		for _, item := range data {
+			if m, ok := item.(map[string]interface{}); ok {
+				if mod := stringField(m, "module"); mod != lastModule {
+					fmt.Printf("  %s%s:%s\n", Yellow, mod, Reset)
+					lastModule = mod
+				}
+				fmt.Printf("    %s\n", m["name"])
+			}
+		}
+	}
+	return nil
+}
+
+// printReportTable prints the summary figures and rows as a text table
+func (c *Client) printReportTable(report string, result *queryReportResult) {
+	printf("\n%s%s (%d rows):%s\n", Cyan, report, len(result.rows), Reset)
+
+	for _, s := range result.summary {
+		fmt.Printf("  %s: %s\n", s.label, c.formatReportValue(s.value, s.datatype))
+	}
+	if len(result.summary) > 0 {
+		fmt.Println()
+	}
+
+	if len(result.rows) == 0 || len(result.columns) == 0 {
+		printf("%sNo rows%s\n", Yellow, Reset)
+		return
+	}
+
+	cells := make([][]string, len(result.rows))
+	widths := make([]int, len(result.columns))
+	for j, col := range result.columns {
+		widths[j] = utf8.RuneCountInString(col.label)
+	}
+	for i, row := range result.rows {
+		cells[i] = make([]string, len(result.columns))
+		for j, col := range result.columns {
+			cell := c.formatReportValue(row[col.fieldname], col.fieldtype)
+			cell = strings.Join(strings.Fields(cell), " ")
+			if utf8.RuneCountInString(cell) > maxReportColumnWidth {
+				cell = string([]rune(cell)[:maxReportColumnWidth-3]) + "..."
+			}
+			cells[i][j] = cell
+			if n := utf8.RuneCountInString(cell); n > widths[j] {
+				widths[j] = n
+			}
+		}
+	}
+	for j := range widths {
+		if widths[j] > maxReportColumnWidth {
+			widths[j] = maxReportColumnWidth
+		}
+	}
+
+	header := make([]string, len(result.columns))
+	for j, col := range result.columns {
+		header[j] = padCell(col.label, widths[j], isNumericType(col.fieldtype))
+	}
+	fmt.Printf("  %s%s%s\n", Yellow, strings.Join(header, "  "), Reset)
+
+	for _, row := range cells {
+		line := make([]string, len(row))
+		for j, cell := range row {
+			line[j] = padCell(cell, widths[j], isNumericType(result.columns[j].fieldtype))
+		}
+		fmt.Printf("  %s\n", strings.TrimRight(strings.Join(line, "  "), " "))
+	}
+}
+
+// isNumericType reports whether a fieldtype is right-aligned
+func isNumericType(fieldtype string) bool {
+	switch fieldtype {
+	case "Currency", "Float", "Int", "Percent":
+		return true
+	}
+	return false
+}
+
+// padCell pads s to width runes, on the left for numbers
+func padCell(s string, width int, right bool) string {
+	if len([]rune(s)) > width {
+		s = string([]rune(s)[:width])
+	}
+	pad := strings.Repeat(" ", width-utf8.RuneCountInString(s))
+	if right {
+		return pad + s
+	}
+	return s + pad
+}
+
+// writeReportCSV writes the report rows with the column labels as header.
+// Numbers are written unformatted so spreadsheets can sum them.
+func writeReportCSV(path string, result *queryReportResult) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	header := make([]string, len(result.columns))
+	for j, col := range result.columns {
+		header[j] = col.label
+	}
+	if err := writer.Write(header); err != nil {
+		return errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, row := range result.rows {
+		record := make([]string, len(result.columns))
+		for j, col := range result.columns {
+			switch value := row[col.fieldname].(type) {
+			case nil:
+			case float64:
+				// 'f' keeps large amounts out of exponent notation
+				record[j] = strconv.FormatFloat(value, 'f', -1, 64)
+			default:
+				record[j] = fmt.Sprintf("%v", value)
+			}
+		}
+		if err := writer.Write(record); err != nil {
+			return errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
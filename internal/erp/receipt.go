@@ -3,7 +3,6 @@ package erp
 import (
 	"fmt"
 	"net/url"
-	"time"
 )
 
 // PurchaseReceiptItem represents an item in a Purchase Receipt
@@ -29,16 +28,17 @@ type PurchaseReceipt struct {
 // CmdPR handles Purchase Receipt commands
 func (c *Client) CmdPR(args []string) error {
 	if len(args) == 0 {
-		fmt.Println("Usage: erp-cli pr <subcommand> [args...]")
-		fmt.Println("Subcommands: list, get, create-from-po, submit, cancel")
+		fmt.Println(T("Usage: erp-cli pr <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, create-from-po, submit, cancel, pdf"))
 		fmt.Println()
-		fmt.Println("Examples:")
-		fmt.Println("  erp-cli pr list")
-		fmt.Println("  erp-cli pr list --supplier=\"Intel\" --status=Draft")
-		fmt.Println("  erp-cli pr get PREC-00001")
-		fmt.Println("  erp-cli pr create-from-po PUR-ORD-2025-00001")
-		fmt.Println("  erp-cli pr submit PREC-00001")
-		fmt.Println("  erp-cli pr cancel PREC-00001")
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli pr list"))
+		fmt.Println(T("  erp-cli pr list --supplier=\"Intel\" --status=Draft"))
+		fmt.Println(T("  erp-cli pr get PREC-00001"))
+		fmt.Println(T("  erp-cli pr create-from-po PUR-ORD-2025-00001"))
+		fmt.Println(T("  erp-cli pr submit PREC-00001"))
+		fmt.Println(T("  erp-cli pr cancel PREC-00001"))
+		fmt.Println(T("  erp-cli pr pdf PREC-00001 -o receipt.pdf"))
 		return nil
 	}
 
@@ -48,32 +48,46 @@ func (c *Client) CmdPR(args []string) error {
 		return c.prList(opts)
 	case "get":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli pr get <name>")
+			return errorf("usage: erp-cli pr get <name> [--template=file.tmpl]")
+		}
+		if tmpl := parseTemplateFlag(args[2:]); tmpl != "" {
+			return c.renderDocTemplate("Purchase Receipt", args[1], tmpl)
 		}
 		return c.prGet(args[1])
 	case "create-from-po":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli pr create-from-po <po_name>")
+			return errorf("usage: erp-cli pr create-from-po <po_name> [--terms=X] [--remarks=\"...\"] [--letterhead=X]")
+		}
+		posting, err := parsePostingOptions(args[2:])
+		if err != nil {
+			return err
 		}
-		return c.prCreateFromPO(args[1])
+		return c.prCreateFromPO(args[1], posting, parseTermsFlags(args[2:]))
 	case "submit":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli pr submit <name>")
+			return errorf("usage: erp-cli pr submit <name>")
 		}
 		return c.prSubmit(args[1])
 	case "cancel":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli pr cancel <name>")
+			return errorf("usage: erp-cli pr cancel <name> [--cascade] [--yes]")
+		}
+		return c.prCancel(args[1], parseCancelOptions(args[2:]))
+	case "pdf":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli pr pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]")
 		}
-		return c.prCancel(args[1])
+		return c.docPDF("Purchase Receipt", args[1], parsePDFOptions(args[2:]))
 	default:
-		return fmt.Errorf("unknown pr subcommand: %s", args[0])
+		return errorf("unknown pr subcommand: %s", args[0])
 	}
 }
 
 type prListOptions struct {
 	supplier string
 	status   string
+	xlsx     string // Output file for --xlsx
+	template string // --template file
 }
 
 func parsePRListOptions(args []string) prListOptions {
@@ -85,13 +99,17 @@ func parsePRListOptions(args []string) prListOptions {
 		if len(arg) > 9 && arg[:9] == "--status=" {
 			opts.status = arg[9:]
 		}
+		if path, ok := parseXLSXFlag(arg, "purchase-receipts.xlsx"); ok {
+			opts.xlsx = path
+		}
+		if len(arg) > 11 && arg[:11] == "--template=" {
+			opts.template = arg[11:]
+		}
 	}
 	return opts
 }
 
 func (c *Client) prList(opts prListOptions) error {
-	fmt.Printf("%sFetching purchase receipts...%s\n", Blue, Reset)
-
 	filters := [][]interface{}{}
 	if opts.supplier != "" {
 		filters = append(filters, []interface{}{"supplier", "like", fmt.Sprintf("%%%s%%", opts.supplier)})
@@ -100,7 +118,15 @@ func (c *Client) prList(opts prListOptions) error {
 		filters = append(filters, []interface{}{"status", "=", opts.status})
 	}
 
-	endpoint := "Purchase%20Receipt?limit_page_length=0&fields=[\"name\",\"supplier\",\"posting_date\",\"status\",\"grand_total\",\"docstatus\"]&order_by=creation%20desc"
+	if opts.xlsx != "" {
+		return c.exportDocsXLSX("Purchase Receipt", filters, opts.xlsx)
+	}
+	if opts.template != "" {
+		return c.renderListTemplate("Purchase Receipt", filters, opts.template)
+	}
+	printf("%sFetching purchase receipts...%s\n", Blue, Reset)
+
+	endpoint := "Purchase%20Receipt?" + c.pageLimit(0) + "&fields=[\"name\",\"supplier\",\"posting_date\",\"status\",\"grand_total\",\"docstatus\"]&order_by=creation%20desc"
 	if len(filters) > 0 {
 		encoded, err := encodeFilters(filters)
 		if err != nil {
@@ -116,11 +142,12 @@ func (c *Client) prList(opts prListOptions) error {
 
 	if data, ok := result["data"].([]interface{}); ok {
 		if len(data) == 0 {
-			fmt.Printf("%sNo purchase receipts found%s\n", Yellow, Reset)
+			printf("%sNo purchase receipts found%s\n", Yellow, Reset)
 			return nil
 		}
 
-		fmt.Printf("\n%sPurchase Receipts (%d):%s\n", Cyan, len(data), Reset)
+		printf("\n%sPurchase Receipts (%d):%s\n", Cyan, len(data), Reset)
+		c.printPageNote(len(data))
 		for _, item := range data {
 			if m, ok := item.(map[string]interface{}); ok {
 				name := m["name"]
@@ -137,7 +164,7 @@ func (c *Client) prList(opts prListOptions) error {
 				}
 
 				fmt.Printf("  %s - %s\n", name, supplier)
-				fmt.Printf("    Date: %s | Status: %s%s%s | Total: %s\n",
+				printf("    Date: %s | Status: %s%s%s | Total: %s\n",
 					date, statusColor, status, Reset, c.FormatCurrency(total))
 			}
 		}
@@ -146,7 +173,7 @@ func (c *Client) prList(opts prListOptions) error {
 }
 
 func (c *Client) prGet(name string) error {
-	fmt.Printf("%sFetching purchase receipt: %s%s\n", Blue, name, Reset)
+	printf("%sFetching purchase receipt: %s%s\n", Blue, name, Reset)
 
 	encoded := url.PathEscape(name)
 	result, err := c.Request("GET", "Purchase%20Receipt/"+encoded, nil)
@@ -155,16 +182,16 @@ func (c *Client) prGet(name string) error {
 	}
 
 	if data, ok := result["data"].(map[string]interface{}); ok {
-		fmt.Printf("\n%sPurchase Receipt: %s%s\n", Cyan, name, Reset)
+		printf("\n%sPurchase Receipt: %s%s\n", Cyan, name, Reset)
 
-		fmt.Printf("  Supplier: %s\n", data["supplier"])
-		fmt.Printf("  Date: %s\n", data["posting_date"])
-		fmt.Printf("  Status: %s\n", data["status"])
+		printf("  Supplier: %s\n", data["supplier"])
+		printf("  Date: %s\n", data["posting_date"])
+		printf("  Status: %s\n", data["status"])
 		grandTotal, _ := data["grand_total"].(float64)
-		fmt.Printf("  Total: %s\n", c.FormatCurrency(grandTotal))
+		printf("  Total: %s\n", c.FormatCurrency(grandTotal))
 
 		if items, ok := data["items"].([]interface{}); ok && len(items) > 0 {
-			fmt.Printf("\n  %sItems:%s\n", Yellow, Reset)
+			printf("\n  %sItems:%s\n", Yellow, Reset)
 			for _, item := range items {
 				if m, ok := item.(map[string]interface{}); ok {
 					itemCode := m["item_code"]
@@ -174,42 +201,74 @@ func (c *Client) prGet(name string) error {
 					po := m["purchase_order"]
 					poStr := ""
 					if po != nil && po != "" {
-						poStr = fmt.Sprintf(" (PO: %s)", po)
+						poStr = tf(" (PO: %s)", po)
 					}
 					fmt.Printf("    - %s: %.0f x %s = %s%s\n", itemCode, qty, c.FormatCurrency(rate), c.FormatCurrency(amount), poStr)
 				}
 			}
 		}
+
+		printDocTerms(data)
+		c.printActivity("Purchase Receipt", name)
 	}
 	return nil
 }
 
-func (c *Client) prCreateFromPO(poName string) error {
-	fmt.Printf("%sCreating purchase receipt from PO: %s%s\n", Blue, poName, Reset)
+func (c *Client) prCreateFromPO(poName string, posting postingOptions, terms docTerms) error {
+	printf("%sCreating purchase receipt from PO: %s%s\n", Blue, poName, Reset)
+	c.printPosting(posting)
+
+	body, err := c.prFromPO(poName, posting)
+	if err != nil {
+		return err
+	}
 
+	if terms, err = c.applyTerms(body, "Purchase Receipt", terms, false); err != nil {
+		return err
+	}
+
+	result, err := c.Request("POST", "Purchase%20Receipt", body)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].(map[string]interface{}); ok {
+		prName := data["name"]
+		printf("%s✓ Purchase Receipt created: %s%s\n", Green, prName, Reset)
+		printf("  From PO: %s\n", poName)
+		printf("  Items: %d\n", len(body["items"].([]map[string]interface{})))
+		printf("  Status: Draft\n")
+		printTerms(terms)
+		c.finishRemarks("Purchase Receipt", stringField(data, "name"), terms)
+		printf("  Use 'erp-cli pr submit %s' to submit\n", prName)
+	}
+
+	return nil
+}
+
+// prFromPO builds the body of a Purchase Receipt receiving a submitted PO
+func (c *Client) prFromPO(poName string, posting postingOptions) (map[string]interface{}, error) {
 	encoded := url.PathEscape(poName)
 	result, err := c.Request("GET", "Purchase%20Order/"+encoded, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	poData, ok := result["data"].(map[string]interface{})
 	if !ok {
-		return fmt.Errorf("purchase order not found")
+		return nil, errorf("purchase order not found")
 	}
 
 	docStatus, _ := poData["docstatus"].(float64)
 	if docStatus != 1 {
-		return fmt.Errorf("purchase order must be submitted first")
+		return nil, errorf("purchase order must be submitted first")
 	}
 
 	company, err := c.GetCompany()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	today := time.Now().Format("2006-01-02")
-
 	var prItems []map[string]interface{}
 	if items, ok := poData["items"].([]interface{}); ok {
 		for _, item := range items {
@@ -226,53 +285,42 @@ func (c *Client) prCreateFromPO(poName string) error {
 	}
 
 	if len(prItems) == 0 {
-		return fmt.Errorf("no items found in purchase order")
+		return nil, errorf("no items found in purchase order")
 	}
 
 	body := map[string]interface{}{
-		"supplier":     poData["supplier"],
-		"posting_date": today,
-		"company":      company,
-		"items":        prItems,
-	}
-
-	result, err = c.Request("POST", "Purchase%20Receipt", body)
-	if err != nil {
-		return err
+		"supplier": poData["supplier"],
+		"company":  company,
+		"items":    prItems,
 	}
-
-	if data, ok := result["data"].(map[string]interface{}); ok {
-		prName := data["name"]
-		fmt.Printf("%s✓ Purchase Receipt created: %s%s\n", Green, prName, Reset)
-		fmt.Printf("  From PO: %s\n", poName)
-		fmt.Printf("  Items: %d\n", len(prItems))
-		fmt.Printf("  Status: Draft\n")
-		fmt.Printf("  Use 'erp-cli pr submit %s' to submit\n", prName)
+	if err := c.applyPosting(body, posting, true); err != nil {
+		return nil, err
 	}
-
-	return nil
+	copyOrderCurrency(body, poData)
+	copyOrderTerms(body, poData)
+	return body, nil
 }
 
 func (c *Client) prSubmit(name string) error {
-	fmt.Printf("%sSubmitting purchase receipt: %s%s\n", Blue, name, Reset)
+	printf("%sSubmitting purchase receipt: %s%s\n", Blue, name, Reset)
 
 	err := c.submitDocument("Purchase Receipt", name)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("%s✓ Purchase Receipt submitted: %s%s\n", Green, name, Reset)
+	printf("%s✓ Purchase Receipt submitted: %s%s\n", Green, name, Reset)
 	return nil
 }
 
-func (c *Client) prCancel(name string) error {
-	fmt.Printf("%sCancelling purchase receipt: %s%s\n", Blue, name, Reset)
+func (c *Client) prCancel(name string, opts cancelOptions) error {
+	printf("%sCancelling purchase receipt: %s%s\n", Blue, name, Reset)
 
-	err := c.cancelDocument("Purchase Receipt", name)
+	err := c.cancelWithChain("Purchase Receipt", name, opts)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("%s✓ Purchase Receipt cancelled: %s%s\n", Green, name, Reset)
+	printf("%s✓ Purchase Receipt cancelled: %s%s\n", Green, name, Reset)
 	return nil
 }
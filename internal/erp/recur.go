@@ -0,0 +1,183 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// autoRepeatFrequencies are the schedules Auto Repeat accepts
+var autoRepeatFrequencies = []string{"Daily", "Weekly", "Monthly", "Quarterly", "Half-yearly", "Yearly"}
+
+type recurOptions struct {
+	frequency string
+	start     string // First date, defaults to today
+	end       string // Last date, open-ended when empty
+	day       int    // Day of the month for monthly and longer schedules
+	submit    bool   // Submit the generated documents
+	email     string // Comma-separated recipients of the generated documents
+}
+
+func parseRecurOptions(args []string) (recurOptions, error) {
+	opts := recurOptions{frequency: "Monthly"}
+	for _, arg := range args {
+		switch {
+		case len(arg) > 12 && arg[:12] == "--frequency=":
+			frequency, err := normalizeFrequency(arg[12:])
+			if err != nil {
+				return opts, err
+			}
+			opts.frequency = frequency
+		case len(arg) > 8 && arg[:8] == "--start=":
+			opts.start = arg[8:]
+		case len(arg) > 6 && arg[:6] == "--end=":
+			opts.end = arg[6:]
+		case len(arg) > 6 && arg[:6] == "--day=":
+			day, err := strconv.Atoi(arg[6:])
+			if err != nil || day < 1 || day > 31 {
+				return opts, errorf("invalid day %q (use 1-31)", arg[6:])
+			}
+			opts.day = day
+		case arg == "--submit":
+			opts.submit = true
+		case len(arg) > 8 && arg[:8] == "--email=":
+			opts.email = arg[8:]
+		}
+	}
+
+	for _, date := range []string{opts.start, opts.end} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return opts, errorf("invalid date %q (use YYYY-MM-DD)", date)
+		}
+	}
+	if opts.start != "" && opts.end != "" && opts.end <= opts.start {
+		return opts, errorf("--end must be after --start")
+	}
+	return opts, nil
+}
+
+// normalizeFrequency matches a frequency case-insensitively
+func normalizeFrequency(frequency string) (string, error) {
+	for _, f := range autoRepeatFrequencies {
+		if strings.EqualFold(f, frequency) {
+			return f, nil
+		}
+	}
+	return "", errorf("invalid frequency %q (use %s)", frequency, strings.Join(autoRepeatFrequencies, ", "))
+}
+
+// recurCreate sets up an Auto Repeat that copies a document on a schedule
+func (c *Client) recurCreate(doctype, name string, opts recurOptions) error {
+	printf("%sSetting up %s repeat of %s...%s\n", Blue, strings.ToLower(opts.frequency), name, Reset)
+
+	result, err := c.Request("GET", url.PathEscape(doctype)+"/"+url.PathEscape(name), nil)
+	if err != nil {
+		return err
+	}
+	doc, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return errorf("%s not found: %s", doctype, name)
+	}
+	if existing := stringField(doc, "auto_repeat"); existing != "" {
+		return errorf("%s already repeats via %s", name, existing)
+	}
+	if docstatus, _ := doc["docstatus"].(float64); docstatus == 2 {
+		return errorf("%s is cancelled", name)
+	}
+
+	start := opts.start
+	if start == "" {
+		start = c.Today()
+	}
+	body := map[string]interface{}{
+		"reference_doctype":  doctype,
+		"reference_document": name,
+		"frequency":          opts.frequency,
+		"start_date":         start,
+	}
+	if opts.end != "" {
+		body["end_date"] = opts.end
+	}
+	if opts.day > 0 {
+		body["repeat_on_day"] = opts.day
+	}
+	if opts.submit {
+		body["submit_on_creation"] = 1
+	}
+	if opts.email != "" {
+		body["notify_by_email"] = 1
+		body["recipients"] = opts.email
+	}
+
+	result, err = c.Request("POST", "Auto%20Repeat", body)
+	if err != nil {
+		return err
+	}
+	created, _ := result["data"].(map[string]interface{})
+
+	printf("%s✓ Created Auto Repeat: %s%s\n", Green, stringField(created, "name"), Reset)
+	printf("  Frequency: %s\n", opts.frequency)
+	if next := stringField(created, "next_schedule_date"); next != "" {
+		printf("  Next: %s\n", next)
+	}
+	if opts.end != "" {
+		printf("  Until: %s\n", opts.end)
+	}
+	return nil
+}
+
+// recurList shows the Auto Repeat schedules of a doctype
+func (c *Client) recurList(doctype string, all bool) error {
+	printf("%sFetching repeat schedules...%s\n", Blue, Reset)
+
+	filters := [][]interface{}{{"reference_doctype", "=", doctype}}
+	if !all {
+		filters = append(filters, []interface{}{"status", "=", "Active"})
+	}
+	encoded, err := encodeFilters(filters)
+	if err != nil {
+		return err
+	}
+
+	result, err := c.Request("GET", "Auto%20Repeat?limit_page_length=0&fields=[\"name\",\"reference_document\",\"frequency\",\"next_schedule_date\",\"end_date\",\"status\",\"submit_on_creation\"]&order_by=next_schedule_date%20asc&filters="+encoded, nil)
+	if err != nil {
+		return err
+	}
+
+	data, _ := result["data"].([]interface{})
+	if len(data) == 0 {
+		printf("%sNo repeat schedules for %s%s\n", Yellow, doctype, Reset)
+		return nil
+	}
+
+	printf("\n%s%s Repeats (%d):%s\n", Cyan, doctype, len(data), Reset)
+	for _, item := range data {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		status := stringField(m, "status")
+		statusColor := Green
+		if status != "Active" {
+			statusColor = Yellow
+		}
+		end := stringField(m, "end_date")
+		if end == "" {
+			end = "open-ended"
+		}
+		submit := ""
+		if flag, _ := m["submit_on_creation"].(float64); flag == 1 {
+			submit = " | Auto-submit"
+		}
+
+		fmt.Printf("  %s - %s\n", stringField(m, "name"), stringField(m, "reference_document"))
+		printf("    %s | Next: %s | Until: %s | Status: %s%s%s%s\n",
+			stringField(m, "frequency"), stringField(m, "next_schedule_date"), end, statusColor, status, Reset, submit)
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"sort"
+	"strconv"
 	"sync"
 	"time"
 )
@@ -44,6 +45,15 @@ type ReportData struct {
 	TotalWarehouses int
 	TotalGroups     int
 
+	// Monthly series of the trends panel (see trends.go)
+	Trends *TrendData
+
+	// Custom query report panels by panel id
+	Custom map[string]*customPanel
+
+	// Panels fetched, in display order (see panels.go)
+	Panels []string
+
 	// Errors (for partial data display)
 	Errors []string
 }
@@ -68,52 +78,104 @@ func (c *Client) CmdReport(args []string) error {
 		return c.reportStock()
 	case "purchases":
 		return c.reportPurchases()
+	case "commissions":
+		month := c.Now().Format("2006-01")
+		for _, arg := range args[1:] {
+			if len(arg) > 8 && arg[:8] == "--month=" {
+				month = arg[8:]
+			}
+		}
+		return c.reportCommissions(month)
+	case "valuation-compare":
+		item := ""
+		threshold := 10.0
+		for i, arg := range args[1:] {
+			if arg == "--item" && i+2 < len(args) {
+				item = args[i+2]
+			}
+			if len(arg) > 7 && arg[:7] == "--item=" {
+				item = arg[7:]
+			}
+			if len(arg) > 12 && arg[:12] == "--threshold=" {
+				threshold, _ = strconv.ParseFloat(arg[12:], 64)
+			}
+		}
+		return c.reportValuationCompare(item, threshold)
+	case "snapshot":
+		return c.reportSnapshot()
+	case "diff":
+		from, to := "", ""
+		for i, arg := range args[1:] {
+			if arg == "--from" && i+2 < len(args) {
+				from = args[i+2]
+			}
+			if len(arg) > 7 && arg[:7] == "--from=" {
+				from = arg[7:]
+			}
+			if arg == "--to" && i+2 < len(args) {
+				to = args[i+2]
+			}
+			if len(arg) > 5 && arg[:5] == "--to=" {
+				to = arg[5:]
+			}
+		}
+		if from == "" {
+			return errorf("usage: erp-cli report diff --from YYYY-MM-DD [--to YYYY-MM-DD]")
+		}
+		return c.reportDiff(from, to)
+	case "ap":
+		opts, err := parseAgingOptions(args[1:], "supplier", "ap-aging-"+c.Today()+".csv")
+		if err != nil {
+			return err
+		}
+		return c.reportAP(opts)
+	case "sales":
+		opts, err := parseSalesReportOptions(args[1:])
+		if err != nil {
+			return err
+		}
+		return c.reportSales(opts)
+	case "margin":
+		last, err := parseMarginLast(args[1:])
+		if err != nil {
+			return err
+		}
+		return c.reportMargin(last)
 	default:
-		fmt.Println("Usage: erp-cli report [subcommand]")
-		fmt.Println("Subcommands:")
-		fmt.Println("  (none)      Executive dashboard (default)")
-		fmt.Println("  summary     Alias for dashboard")
-		fmt.Println("  stock       Detailed stock report")
-		fmt.Println("  purchases   Detailed purchasing report")
+		fmt.Println(T("Usage: erp-cli report [subcommand]"))
+		fmt.Println(T("Subcommands:"))
+		fmt.Println(T("  (none)      Executive dashboard (default)"))
+		fmt.Println(T("  summary     Alias for dashboard"))
+		fmt.Println(T("  stock       Detailed stock report"))
+		fmt.Println(T("  purchases   Detailed purchasing report"))
+		fmt.Println(T("  commissions [--month=YYYY-MM]"))
+		fmt.Println(T("              Invoiced totals and commission per sales person"))
+		fmt.Println(T("  valuation-compare [--item X] [--threshold=10]"))
+		fmt.Println(T("              FIFO value from the stock ledger vs current valuation"))
+		fmt.Println(T("  snapshot    Store today's key metrics (also done by the dashboard)"))
+		fmt.Println(T("  diff --from YYYY-MM-DD [--to YYYY-MM-DD]"))
+		fmt.Println(T("              Compare stored metric snapshots between two dates"))
+		fmt.Println(T("  ap [--supplier=X] [--as-of=YYYY-MM-DD] [--detail] [--csv[=file]]"))
+		fmt.Println(T("              Accounts payable aging by due date, per supplier"))
+		fmt.Println(T("  sales [--period=monthly|weekly] [--last=12]"))
+		fmt.Println(T("              Revenue per period, top customers and top items"))
+		fmt.Println(T("  margin [--last=3]"))
+		fmt.Println(T("              Estimated gross margin per month, item and customer"))
 		return nil
 	}
 }
 
 // reportSummary displays the executive dashboard
 func (c *Client) reportSummary() error {
-	fmt.Printf("%sLoading dashboard...%s\n", Blue, Reset)
+	printf("%sLoading dashboard...%s\n", Blue, Reset)
 
-	// Pre-fetch currency
-	c.GetCurrency()
+	panels, unknown := c.dashboardPanels()
+	data := c.fetchReportData(panels)
+	if err := c.recordSnapshot(data); err != nil {
+		printf("%sWarning: snapshot not saved: %s%s\n", Yellow, err, Reset)
+	}
 
-	var wg sync.WaitGroup
-	var mu sync.Mutex
-	data := &ReportData{}
-
-	wg.Add(5)
-	go func() {
-		defer wg.Done()
-		c.fetchStockMetrics(data, &mu)
-	}()
-	go func() {
-		defer wg.Done()
-		c.fetchPurchaseMetrics(data, &mu)
-	}()
-	go func() {
-		defer wg.Done()
-		c.fetchSystemMetrics(data, &mu)
-	}()
-	go func() {
-		defer wg.Done()
-		c.fetchSalesMetrics(data, &mu)
-	}()
-	go func() {
-		defer wg.Done()
-		c.fetchPaymentMetrics(data, &mu)
-	}()
-	wg.Wait()
-
-	return c.renderDashboard(data)
+	return c.renderDashboard(data, panels, unknown)
 }
 
 // fetchStockMetrics fetches stock-related metrics
@@ -434,58 +496,20 @@ func (c *Client) fetchPaymentMetrics(data *ReportData, mu *sync.Mutex) {
 }
 
 // renderDashboard displays the dashboard
-func (c *Client) renderDashboard(data *ReportData) error {
+func (c *Client) renderDashboard(data *ReportData, panels []dashboardPanel, unknown []string) error {
 	fmt.Print("\033[H\033[2J") // Clear screen
 
 	// Header
 	fmt.Println()
 	fmt.Printf("%s══════════════════════════════════════════════════════════════%s\n", Cyan, Reset)
-	fmt.Printf("%s                    ERPNEXT DASHBOARD                         %s\n", Cyan, Reset)
+	fmt.Printf("%s%-62s%s\n", Cyan, centerText(T("ERPNEXT DASHBOARD"), 62), Reset)
 	fmt.Printf("%s══════════════════════════════════════════════════════════════%s\n", Cyan, Reset)
 	fmt.Println()
 
-	// Stock Section
-	fmt.Printf("%s┌─ STOCK ─────────────────────────────────────────────────────┐%s\n", Yellow, Reset)
-	fmt.Printf("%s│%s  Items totales:        %-6d                                %s│%s\n", Yellow, Reset, data.TotalItems, Yellow, Reset)
-	fmt.Printf("%s│%s  Valor inventario:     %-15s                    %s│%s\n", Yellow, Reset, c.FormatCurrency(data.TotalStockValue), Yellow, Reset)
-	if data.ZeroStockItems > 0 {
-		fmt.Printf("%s│%s  Sin stock:            %s%-3d ⚠%s                               %s│%s\n", Yellow, Reset, Red, data.ZeroStockItems, Reset, Yellow, Reset)
-	} else {
-		fmt.Printf("%s│%s  Sin stock:            %-6d                                %s│%s\n", Yellow, Reset, data.ZeroStockItems, Yellow, Reset)
-	}
-	fmt.Printf("%s└─────────────────────────────────────────────────────────────┘%s\n", Yellow, Reset)
-	fmt.Println()
-
-	// Purchasing Section
-	fmt.Printf("%s┌─ COMPRAS ───────────────────────────────────────────────────┐%s\n", Yellow, Reset)
-	fmt.Printf("%s│%s  POs en borrador:      %-3d (%s)                     %s│%s\n", Yellow, Reset, data.DraftPOs, c.FormatCurrency(data.DraftPOValue), Yellow, Reset)
-	fmt.Printf("%s│%s  POs por recibir:      %-3d (%s)                     %s│%s\n", Yellow, Reset, data.PendingPOs, c.FormatCurrency(data.PendingPOValue), Yellow, Reset)
-	if data.UnpaidInvoices > 0 {
-		fmt.Printf("%s│%s  Facturas pendientes:  %s%-3d (%s)%s                     %s│%s\n", Yellow, Reset, Red, data.UnpaidInvoices, c.FormatCurrency(data.UnpaidValue), Reset, Yellow, Reset)
-	} else {
-		fmt.Printf("%s│%s  Facturas pendientes:  %-3d                                  %s│%s\n", Yellow, Reset, data.UnpaidInvoices, Yellow, Reset)
-	}
-	fmt.Printf("%s│%s                                                             %s│%s\n", Yellow, Reset, Yellow, Reset)
-	if len(data.TopSuppliers) > 0 {
-		fmt.Printf("%s│%s  %sTop Proveedores:%s                                           %s│%s\n", Yellow, Reset, Cyan, Reset, Yellow, Reset)
-		for i, s := range data.TopSuppliers {
-			name := s.Name
-			if len(name) > 25 {
-				name = name[:22] + "..."
-			}
-			fmt.Printf("%s│%s    %d. %-25s %3d POs                   %s│%s\n", Yellow, Reset, i+1, name, s.POCount, Yellow, Reset)
-		}
+	for _, p := range panels {
+		renderPanelBox(p.title, p.lines(c, data))
+		fmt.Println()
 	}
-	fmt.Printf("%s└─────────────────────────────────────────────────────────────┘%s\n", Yellow, Reset)
-	fmt.Println()
-
-	// System Section
-	fmt.Printf("%s┌─ SISTEMA ──────────────────────────────────────────────────┐%s\n", Yellow, Reset)
-	fmt.Printf("%s│%s  Proveedores:   %-4d                                        %s│%s\n", Yellow, Reset, data.TotalSuppliers, Yellow, Reset)
-	fmt.Printf("%s│%s  Almacenes:     %-4d                                        %s│%s\n", Yellow, Reset, data.TotalWarehouses, Yellow, Reset)
-	fmt.Printf("%s│%s  Grupos:        %-4d                                        %s│%s\n", Yellow, Reset, data.TotalGroups, Yellow, Reset)
-	fmt.Printf("%s└─────────────────────────────────────────────────────────────┘%s\n", Yellow, Reset)
-	fmt.Println()
 
 	// Footer
 	modeStr := "VPN"
@@ -496,15 +520,18 @@ func (c *Client) renderDashboard(data *ReportData) error {
 	if c.Currency != nil {
 		currencyStr = c.Currency.Code
 	}
-	timestamp := time.Now().Format("2006-01-02 15:04:05")
-	fmt.Printf("Generado: %s | Modo: %s%s%s | Divisa: %s%s%s\n", timestamp, Cyan, modeStr, Reset, Cyan, currencyStr, Reset)
+	timestamp := c.Timestamp()
+	fmt.Println(tf("Generated: %s | Mode: %s | Currency: %s", timestamp, Cyan+modeStr+Reset, Cyan+currencyStr+Reset))
 
 	// Show errors if any
-	if len(data.Errors) > 0 {
+	if len(data.Errors) > 0 || len(unknown) > 0 {
 		fmt.Println()
-		fmt.Printf("%sAdvertencias:%s\n", Yellow, Reset)
+		fmt.Printf("%s%s%s\n", Yellow, T("Warnings:"), Reset)
 		for _, err := range data.Errors {
-			fmt.Printf("  - %s\n", err)
+			fmt.Printf("  - %s\n", T(err))
+		}
+		for _, id := range unknown {
+			fmt.Printf("  - %s\n", tf("Unknown panel in ERP_DASHBOARD_PANELS: %s", id))
 		}
 	}
 
@@ -513,7 +540,7 @@ func (c *Client) renderDashboard(data *ReportData) error {
 
 // reportStock displays detailed stock report
 func (c *Client) reportStock() error {
-	fmt.Printf("%sGenerating stock report...%s\n\n", Blue, Reset)
+	printf("%sGenerating stock report...%s\n\n", Blue, Reset)
 
 	// Pre-fetch currency
 	c.GetCurrency()
@@ -525,7 +552,7 @@ func (c *Client) reportStock() error {
 	}
 
 	fmt.Printf("%s══════════════════════════════════════════════════════════════%s\n", Cyan, Reset)
-	fmt.Printf("%s                    STOCK REPORT                              %s\n", Cyan, Reset)
+	printf("%s                    STOCK REPORT                              %s\n", Cyan, Reset)
 	fmt.Printf("%s══════════════════════════════════════════════════════════════%s\n\n", Cyan, Reset)
 
 	if bins, ok := result["data"].([]interface{}); ok {
@@ -555,11 +582,11 @@ func (c *Client) reportStock() error {
 		}
 
 		// Print summary
-		fmt.Printf("%sSummary:%s\n", Yellow, Reset)
-		fmt.Printf("  Total items with stock entries: %d\n", len(itemCount))
-		fmt.Printf("  Total stock value: %s\n", c.FormatCurrency(totalValue))
-		fmt.Printf("  Total quantity: %.0f units\n", totalQty)
-		fmt.Printf("  Zero stock entries: %d\n\n", zeroStock)
+		printf("%sSummary:%s\n", Yellow, Reset)
+		printf("  Total items with stock entries: %d\n", len(itemCount))
+		printf("  Total stock value: %s\n", c.FormatCurrency(totalValue))
+		printf("  Total quantity: %.0f units\n", totalQty)
+		printf("  Zero stock entries: %d\n\n", zeroStock)
 
 		// Print by warehouse
 		warehouseData := make(map[string]float64)
@@ -576,37 +603,37 @@ func (c *Client) reportStock() error {
 			}
 		}
 
-		fmt.Printf("%sBy Warehouse:%s\n", Yellow, Reset)
+		printf("%sBy Warehouse:%s\n", Yellow, Reset)
 		for wh, value := range warehouseData {
 			qty := warehouseQty[wh]
 			fmt.Printf("  📦 %s\n", wh)
-			fmt.Printf("     Quantity: %.0f | Value: %s\n", qty, c.FormatCurrency(value))
+			printf("     Quantity: %.0f | Value: %s\n", qty, c.FormatCurrency(value))
 		}
 	}
 
-	fmt.Printf("\n%sGenerated: %s%s\n", Cyan, time.Now().Format("2006-01-02 15:04:05"), Reset)
+	printf("\n%sGenerated: %s%s\n", Cyan, c.Timestamp(), Reset)
 	return nil
 }
 
 // reportPurchases displays detailed purchasing report
 func (c *Client) reportPurchases() error {
-	fmt.Printf("%sGenerating purchasing report...%s\n\n", Blue, Reset)
+	printf("%sGenerating purchasing report...%s\n\n", Blue, Reset)
 
 	// Pre-fetch currency
 	c.GetCurrency()
 
 	fmt.Printf("%s══════════════════════════════════════════════════════════════%s\n", Cyan, Reset)
-	fmt.Printf("%s                    PURCHASING REPORT                         %s\n", Cyan, Reset)
+	printf("%s                    PURCHASING REPORT                         %s\n", Cyan, Reset)
 	fmt.Printf("%s══════════════════════════════════════════════════════════════%s\n\n", Cyan, Reset)
 
 	// Draft POs
-	fmt.Printf("%sDraft Purchase Orders:%s\n", Yellow, Reset)
+	printf("%sDraft Purchase Orders:%s\n", Yellow, Reset)
 	filter := url.QueryEscape(`[["docstatus","=",0]]`)
 	result, err := c.Request("GET", "Purchase%20Order?limit_page_length=20&filters="+filter+"&fields=[\"name\",\"supplier\",\"grand_total\",\"transaction_date\"]&order_by=creation%20desc", nil)
 	if err == nil {
 		if pos, ok := result["data"].([]interface{}); ok {
 			if len(pos) == 0 {
-				fmt.Println("  No draft POs")
+				fmt.Println(T("  No draft POs"))
 			} else {
 				for _, po := range pos {
 					if m, ok := po.(map[string]interface{}); ok {
@@ -621,13 +648,13 @@ func (c *Client) reportPurchases() error {
 	fmt.Println()
 
 	// Pending POs
-	fmt.Printf("%sPending Purchase Orders (To Receive):%s\n", Yellow, Reset)
+	printf("%sPending Purchase Orders (To Receive):%s\n", Yellow, Reset)
 	filter = url.QueryEscape(`[["docstatus","=",1],["status","in",["To Receive and Bill","To Receive"]]]`)
 	result, err = c.Request("GET", "Purchase%20Order?limit_page_length=20&filters="+filter+"&fields=[\"name\",\"supplier\",\"grand_total\",\"status\"]&order_by=creation%20desc", nil)
 	if err == nil {
 		if pos, ok := result["data"].([]interface{}); ok {
 			if len(pos) == 0 {
-				fmt.Println("  No pending POs")
+				fmt.Println(T("  No pending POs"))
 			} else {
 				totalPending := 0.0
 				for _, po := range pos {
@@ -638,20 +665,20 @@ func (c *Client) reportPurchases() error {
 							m["name"], m["supplier"], c.FormatCurrency(val), m["status"])
 					}
 				}
-				fmt.Printf("  %sTotal pending: %s%s\n", Cyan, c.FormatCurrency(totalPending), Reset)
+				printf("  %sTotal pending: %s%s\n", Cyan, c.FormatCurrency(totalPending), Reset)
 			}
 		}
 	}
 	fmt.Println()
 
 	// Unpaid Invoices
-	fmt.Printf("%sUnpaid Purchase Invoices:%s\n", Yellow, Reset)
+	printf("%sUnpaid Purchase Invoices:%s\n", Yellow, Reset)
 	filter = url.QueryEscape(`[["docstatus","=",1],["outstanding_amount",">",0]]`)
 	result, err = c.Request("GET", "Purchase%20Invoice?limit_page_length=20&filters="+filter+"&fields=[\"name\",\"supplier\",\"grand_total\",\"outstanding_amount\",\"posting_date\"]&order_by=posting_date%20desc", nil)
 	if err == nil {
 		if invoices, ok := result["data"].([]interface{}); ok {
 			if len(invoices) == 0 {
-				fmt.Println("  No unpaid invoices")
+				fmt.Println(T("  No unpaid invoices"))
 			} else {
 				totalUnpaid := 0.0
 				for _, inv := range invoices {
@@ -659,18 +686,18 @@ func (c *Client) reportPurchases() error {
 						outstanding, _ := m["outstanding_amount"].(float64)
 						grandTotal, _ := m["grand_total"].(float64)
 						totalUnpaid += outstanding
-						fmt.Printf("  - %s: %s (Outstanding: %s of %s) - %s\n",
+						printf("  - %s: %s (Outstanding: %s of %s) - %s\n",
 							m["name"], m["supplier"], c.FormatCurrency(outstanding), c.FormatCurrency(grandTotal), m["posting_date"])
 					}
 				}
-				fmt.Printf("  %sTotal outstanding: %s%s\n", Red, c.FormatCurrency(totalUnpaid), Reset)
+				printf("  %sTotal outstanding: %s%s\n", Red, c.FormatCurrency(totalUnpaid), Reset)
 			}
 		}
 	}
 	fmt.Println()
 
 	// Supplier Statistics
-	fmt.Printf("%sSupplier Statistics (by PO count):%s\n", Yellow, Reset)
+	printf("%sSupplier Statistics (by PO count):%s\n", Yellow, Reset)
 	filter = url.QueryEscape(`[["docstatus","=",1]]`)
 	result, err = c.Request("GET", "Purchase%20Order?limit_page_length=0&filters="+filter+"&fields=[\"supplier\",\"grand_total\"]", nil)
 	if err == nil {
@@ -703,14 +730,281 @@ func (c *Client) reportPurchases() error {
 
 			for i, s := range suppliers {
 				if i >= 10 {
-					fmt.Printf("  ... and %d more suppliers\n", len(suppliers)-10)
+					printf("  ... and %d more suppliers\n", len(suppliers)-10)
 					break
 				}
-				fmt.Printf("  %2d. %-30s %3d POs  %s\n", i+1, s.Name, s.POCount, c.FormatCurrency(s.Value))
+				printf("  %2d. %-30s %3d POs  %s\n", i+1, s.Name, s.POCount, c.FormatCurrency(s.Value))
+			}
+		}
+	}
+
+	printf("\n%sGenerated: %s%s\n", Cyan, c.Timestamp(), Reset)
+	return nil
+}
+
+// SalesPersonStat holds invoiced totals for a sales person
+type SalesPersonStat struct {
+	Name       string
+	Invoices   int
+	Allocated  float64
+	Incentives float64
+}
+
+// reportCommissions summarizes submitted sales invoices per sales person for a month
+func (c *Client) reportCommissions(month string) error {
+	start, err := time.Parse("2006-01", month)
+	if err != nil {
+		return errorf("invalid month: %s (expected YYYY-MM)", month)
+	}
+	end := start.AddDate(0, 1, -1)
+
+	printf("%sGenerating commissions report for %s...%s\n\n", Blue, month, Reset)
+
+	// Pre-fetch currency
+	c.GetCurrency()
+
+	filters, err := encodeFilters([][]interface{}{
+		{"docstatus", "=", 1},
+		{"posting_date", "between", []string{start.Format("2006-01-02"), end.Format("2006-01-02")}},
+	})
+	if err != nil {
+		return err
+	}
+	fields := url.QueryEscape("[\"name\",\"`tabSales Team`.sales_person\",\"`tabSales Team`.allocated_amount\",\"`tabSales Team`.incentives\"]")
+
+	result, err := c.Request("GET", "Sales%20Invoice?limit_page_length=0&filters="+filters+"&fields="+fields, nil)
+	if err != nil {
+		return err
+	}
+
+	stats := make(map[string]*SalesPersonStat)
+	invoices := make(map[string]map[string]bool)
+	if rows, ok := result["data"].([]interface{}); ok {
+		for _, row := range rows {
+			m, ok := row.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			person, _ := m["sales_person"].(string)
+			if person == "" {
+				continue
+			}
+			if stats[person] == nil {
+				stats[person] = &SalesPersonStat{Name: person}
+				invoices[person] = make(map[string]bool)
+			}
+			amount, _ := m["allocated_amount"].(float64)
+			incentives, _ := m["incentives"].(float64)
+			stats[person].Allocated += amount
+			stats[person].Incentives += incentives
+			if name, ok := m["name"].(string); ok && !invoices[person][name] {
+				invoices[person][name] = true
+				stats[person].Invoices++
+			}
+		}
+	}
+
+	if len(stats) == 0 {
+		printf("%sNo invoices with sales persons found for %s%s\n", Yellow, month, Reset)
+		return nil
+	}
+
+	var people []SalesPersonStat
+	for _, s := range stats {
+		people = append(people, *s)
+	}
+	sort.Slice(people, func(i, j int) bool {
+		return people[i].Allocated > people[j].Allocated
+	})
+
+	printf("%sCommissions (%s to %s):%s\n", Cyan, start.Format("2006-01-02"), end.Format("2006-01-02"), Reset)
+	totalAllocated, totalIncentives := 0.0, 0.0
+	for i, p := range people {
+		printf("  %2d. %-30s %3d invoices  %s  Commission: %s\n",
+			i+1, p.Name, p.Invoices, c.FormatCurrency(p.Allocated), c.FormatCurrency(p.Incentives))
+		totalAllocated += p.Allocated
+		totalIncentives += p.Incentives
+	}
+	printf("\n  %sTotal invoiced: %s | Total commission: %s%s\n",
+		Cyan, c.FormatCurrency(totalAllocated), c.FormatCurrency(totalIncentives), Reset)
+
+	printf("\n%sGenerated: %s%s\n", Cyan, c.Timestamp(), Reset)
+	return nil
+}
+
+// ValuationStat compares FIFO and booked stock value for an item in a warehouse
+type ValuationStat struct {
+	Item      string
+	Warehouse string
+	Qty       float64
+	FIFOValue float64
+	Booked    float64
+}
+
+// Deviation returns the relative difference between FIFO and booked value in percent
+func (v ValuationStat) Deviation() float64 {
+	base := v.Booked
+	if base < 0 {
+		base = -base
+	}
+	if base == 0 {
+		if v.FIFOValue == 0 {
+			return 0
+		}
+		return 100
+	}
+	diff := v.FIFOValue - v.Booked
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff / base * 100
+}
+
+// fifoLayer is a received quantity at a given rate
+type fifoLayer struct {
+	qty  float64
+	rate float64
+}
+
+// reportValuationCompare recomputes FIFO value from the stock ledger and
+// compares it with the valuation ERPNext has booked
+func (c *Client) reportValuationCompare(item string, threshold float64) error {
+	printf("%sGenerating valuation comparison...%s\n\n", Blue, Reset)
+
+	// Pre-fetch currency
+	c.GetCurrency()
+
+	conditions := [][]interface{}{{"is_cancelled", "=", 0}}
+	if item != "" {
+		conditions = append(conditions, []interface{}{"item_code", "=", item})
+	}
+	filters, err := encodeFilters(conditions)
+	if err != nil {
+		return err
+	}
+
+	result, err := c.Request("GET", "Stock%20Ledger%20Entry?limit_page_length=0&fields=[\"item_code\",\"warehouse\",\"actual_qty\",\"incoming_rate\",\"valuation_rate\",\"qty_after_transaction\",\"stock_value\"]&filters="+filters+"&order_by=posting_date%20asc,posting_time%20asc,creation%20asc", nil)
+	if err != nil {
+		return err
+	}
+
+	type key struct{ item, warehouse string }
+	queues := make(map[key][]fifoLayer)
+	stats := make(map[key]*ValuationStat)
+	var order []key
+
+	if rows, ok := result["data"].([]interface{}); ok {
+		for _, row := range rows {
+			m, ok := row.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			k := key{fmt.Sprintf("%v", m["item_code"]), fmt.Sprintf("%v", m["warehouse"])}
+			if stats[k] == nil {
+				stats[k] = &ValuationStat{Item: k.item, Warehouse: k.warehouse}
+				order = append(order, k)
+			}
+
+			qty, _ := m["actual_qty"].(float64)
+			incoming, _ := m["incoming_rate"].(float64)
+			valuation, _ := m["valuation_rate"].(float64)
+			if qty > 0 {
+				rate := incoming
+				if rate == 0 {
+					rate = valuation
+				}
+				queues[k] = receiveFIFO(queues[k], qty, rate)
+			} else if qty < 0 {
+				queues[k] = consumeFIFO(queues[k], -qty, valuation)
 			}
+
+			stats[k].Qty, _ = m["qty_after_transaction"].(float64)
+			stats[k].Booked, _ = m["stock_value"].(float64)
+		}
+	}
+
+	if len(stats) == 0 {
+		printf("%sNo stock ledger entries found%s\n", Yellow, Reset)
+		return nil
+	}
+
+	var flagged []ValuationStat
+	totalFIFO, totalBooked := 0.0, 0.0
+	for _, k := range order {
+		s := stats[k]
+		for _, layer := range queues[k] {
+			s.FIFOValue += layer.qty * layer.rate
+		}
+		totalFIFO += s.FIFOValue
+		totalBooked += s.Booked
+		if s.Deviation() > threshold && (s.FIFOValue != 0 || s.Booked != 0) {
+			flagged = append(flagged, *s)
+		}
+	}
+
+	sort.Slice(flagged, func(i, j int) bool {
+		return flagged[i].Deviation() > flagged[j].Deviation()
+	})
+
+	printf("%sValuation Comparison (%d item/warehouse pairs):%s\n", Cyan, len(order), Reset)
+	printf("  FIFO value:   %s\n", c.FormatCurrency(totalFIFO))
+	printf("  Booked value: %s\n", c.FormatCurrency(totalBooked))
+	printf("  Difference:   %s\n\n", c.FormatCurrency(totalFIFO-totalBooked))
+
+	if len(flagged) == 0 {
+		printf("%s✓ No deviations above %.0f%%%s\n", Green, threshold, Reset)
+	} else {
+		printf("%sDeviations above %.0f%% (%d):%s\n", Red, threshold, len(flagged), Reset)
+		for _, s := range flagged {
+			fmt.Printf("  %s @ %s\n", s.Item, s.Warehouse)
+			printf("    Qty: %.2f | FIFO: %s | Booked: %s | %s%.1f%%%s\n",
+				s.Qty, c.FormatCurrency(s.FIFOValue), c.FormatCurrency(s.Booked), Red, s.Deviation(), Reset)
 		}
+		printf("\n  Large deviations usually point to back-dated or mis-rated postings.\n")
 	}
 
-	fmt.Printf("\n%sGenerated: %s%s\n", Cyan, time.Now().Format("2006-01-02 15:04:05"), Reset)
+	printf("\n%sGenerated: %s%s\n", Cyan, c.Timestamp(), Reset)
 	return nil
 }
+
+// receiveFIFO adds a layer, first settling any negative stock: the receipt
+// offsets the negative layers, oldest first, and only the rest is queued
+func receiveFIFO(queue []fifoLayer, qty, rate float64) []fifoLayer {
+	for qty > 0 && len(queue) > 0 && queue[0].qty < 0 {
+		if -queue[0].qty > qty {
+			queue[0].qty += qty
+			return queue
+		}
+		qty += queue[0].qty
+		queue = queue[1:]
+	}
+	if qty > 0 {
+		queue = append(queue, fifoLayer{qty, rate})
+	}
+	return queue
+}
+
+// consumeFIFO removes qty from the oldest layers. Stock going negative is
+// kept as a negative layer at the given rate.
+func consumeFIFO(queue []fifoLayer, qty, rate float64) []fifoLayer {
+	for qty > 0 && len(queue) > 0 {
+		if queue[0].qty < 0 {
+			break
+		}
+		if queue[0].qty > qty {
+			queue[0].qty -= qty
+			return queue
+		}
+		qty -= queue[0].qty
+		queue = queue[1:]
+	}
+	if qty > 0 {
+		// Further issues below zero deepen the negative layer
+		if n := len(queue); n > 0 && queue[n-1].qty < 0 {
+			queue[n-1].qty -= qty
+		} else {
+			queue = append(queue, fifoLayer{-qty, rate})
+		}
+	}
+	return queue
+}
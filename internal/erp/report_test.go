@@ -0,0 +1,50 @@
+package erp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFIFONegativeStock(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func() []fifoLayer
+		want []fifoLayer
+	}{
+		{
+			name: "receipt after negative stock",
+			run: func() []fifoLayer {
+				q := receiveFIFO(nil, 10, 5)
+				q = consumeFIFO(q, 15, 5)
+				q = consumeFIFO(q, 3, 5)
+				return receiveFIFO(q, 10, 7)
+			},
+			want: []fifoLayer{{2, 7}},
+		},
+		{
+			name: "receipt that offsets the negative stock",
+			run: func() []fifoLayer {
+				q := consumeFIFO(nil, 4, 5)
+				return receiveFIFO(q, 4, 7)
+			},
+			want: []fifoLayer{},
+		},
+		{
+			name: "receipt short of the negative stock",
+			run: func() []fifoLayer {
+				q := consumeFIFO(nil, 6, 5)
+				return receiveFIFO(q, 4, 7)
+			},
+			want: []fifoLayer{{-2, 5}},
+		},
+	}
+	for _, tt := range tests {
+		got := tt.run()
+		if len(got) == 0 && len(tt.want) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
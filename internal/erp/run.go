@@ -0,0 +1,270 @@
+package erp
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Scripts (erp-cli run) are CLI commands, one per line, each run as its own
+// erp-cli process. Commands started by a script record the documents they
+// create in the file named by runRecordEnv, so the next lines can refer to
+// them as $LAST_NAME without parsing the output.
+
+const runRecordEnv = "ERP_CLI_RECORD"
+
+// scriptAssignment matches a variable assignment line (NAME=value)
+var scriptAssignment = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*)=(.*)$`)
+
+// CmdRun runs a script of CLI commands
+func (c *Client) CmdRun(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli run <script.erp | -> [--stop-on-error] [--log=FILE]"))
+		fmt.Println()
+		fmt.Println(T("Runs the commands of a script, one per line as typed after 'erp-cli'."))
+		fmt.Println(T("Blank lines and lines starting with # are skipped; NAME=value sets a"))
+		fmt.Println(T("variable, used as $NAME or ${NAME} in later lines (environment variables"))
+		fmt.Println(T("too). After a command that creates documents:"))
+		fmt.Println(T("  $LAST_NAME, $LAST_DOCTYPE   The last document created"))
+		fmt.Println(T("  $LAST_SALES_ORDER, ...      The last one of each doctype"))
+		fmt.Println()
+		fmt.Println(T("Options:"))
+		fmt.Println(T("  -                  Read the script from stdin"))
+		fmt.Println(T("  --stop-on-error    Stop at the first failed command (default: carry on)"))
+		fmt.Println(T("  --log=FILE         Append a transcript of commands and their output"))
+		fmt.Println()
+		fmt.Println(T("Example script:"))
+		fmt.Println(T("  customer create \"Demo Co\""))
+		fmt.Println(T("  CUSTOMER=$LAST_NAME"))
+		fmt.Println(T("  so create \"$CUSTOMER\""))
+		fmt.Println(T("  so add-item $LAST_NAME CPU-I7 2"))
+		fmt.Println(T("  so submit $LAST_SALES_ORDER"))
+		return nil
+	}
+
+	script, stopOnError, logPath := "", false, ""
+	for _, arg := range args {
+		switch {
+		case arg == "--stop-on-error":
+			stopOnError = true
+		case len(arg) > 6 && arg[:6] == "--log=":
+			logPath = arg[6:]
+		case script == "" && (arg == "-" || !strings.HasPrefix(arg, "-")):
+			script = arg
+		default:
+			return errorf("unknown argument: %s", arg)
+		}
+	}
+	if script == "" {
+		return errorf("usage: erp-cli run <script.erp | -> [--stop-on-error] [--log=FILE]")
+	}
+
+	var source io.Reader = os.Stdin
+	if script != "-" {
+		file, err := os.Open(script)
+		if err != nil {
+			return errorf("cannot open script: %w", err)
+		}
+		defer file.Close()
+		source = file
+	}
+	var lines []string
+	scanner := bufio.NewScanner(source)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return errorf("cannot read script: %w", err)
+	}
+
+	var transcript io.Writer
+	if logPath != "" {
+		logFile, err := os.OpenFile(logPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return errorf("cannot open log: %w", err)
+		}
+		defer logFile.Close()
+		transcript = logFile
+		fmt.Fprintf(transcript, "# erp-cli run %s, %s\n", script, time.Now().Format("2006-01-02 15:04:05"))
+	}
+
+	return c.runScript(lines, script == "-", stopOnError, transcript)
+}
+
+// runScript runs the lines of a script, writing a transcript when given
+func (c *Client) runScript(lines []string, fromStdin, stopOnError bool, transcript io.Writer) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return errorf("cannot find erp-cli executable: %w", err)
+	}
+	record, err := os.CreateTemp("", "erp-cli-run-*")
+	if err != nil {
+		return err
+	}
+	record.Close()
+	defer os.Remove(record.Name())
+
+	logf := func(format string, args ...interface{}) {
+		if transcript != nil {
+			fmt.Fprintf(transcript, format, args...)
+		}
+	}
+
+	vars := map[string]string{}
+	ran, failed := 0, 0
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lineNo := i + 1
+
+		words, err := splitArgs(line)
+		if err == nil {
+			words, err = expandScriptVars(words, vars)
+		}
+		if err == nil && len(words) == 1 {
+			if m := scriptAssignment.FindStringSubmatch(words[0]); m != nil {
+				vars[m[1]] = m[2]
+				logf("%s\n", line)
+				continue
+			}
+		}
+		if err == nil && len(words) > 0 && words[0] == "erp-cli" {
+			words = words[1:]
+		}
+		if err == nil && len(words) == 0 {
+			err = errorf("no command")
+		}
+		if err != nil {
+			printf("%s✗ Line %d: %s%s\n", Red, lineNo, err, Reset)
+			logf("# line %d: %s\n", lineNo, err)
+			failed++
+			if stopOnError {
+				return errorf("stopped at line %d", lineNo)
+			}
+			continue
+		}
+
+		command := "erp-cli " + strings.Join(words, " ")
+		fmt.Printf("%s$ %s%s\n", Cyan, command, Reset)
+		logf("$ %s\n", command)
+
+		os.Truncate(record.Name(), 0)
+		cmd := exec.Command(executable, words...)
+		cmd.Env = append(os.Environ(), runRecordEnv+"="+record.Name())
+		if !fromStdin {
+			cmd.Stdin = os.Stdin
+		}
+		cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+		if transcript != nil {
+			cmd.Stdout = io.MultiWriter(os.Stdout, transcript)
+			cmd.Stderr = io.MultiWriter(os.Stderr, transcript)
+		}
+		ran++
+		err = cmd.Run()
+
+		created := readRunRecord(record.Name())
+		for _, doc := range created {
+			vars["LAST_DOCTYPE"], vars["LAST_NAME"] = doc[0], doc[1]
+			vars["LAST_"+doctypeVar(doc[0])] = doc[1]
+		}
+		if err != nil {
+			printf("%s✗ Line %d failed%s\n", Red, lineNo, Reset)
+			logf("# line %d failed: %s\n", lineNo, err)
+			failed++
+			if stopOnError {
+				return errorf("stopped at line %d", lineNo)
+			}
+			continue
+		}
+		if len(created) > 0 {
+			last := created[len(created)-1]
+			logf("# created %s %s\n", last[0], last[1])
+		}
+	}
+
+	fmt.Println()
+	if failed > 0 {
+		return errorf("%d line(s) failed, %d command(s) run", failed, ran)
+	}
+	printf("%s✓ %d command(s) run%s\n", Green, ran, Reset)
+	return nil
+}
+
+// expandScriptVars replaces $NAME and ${NAME} in each argument with the
+// script's variables or the environment; an undefined one is an error
+func expandScriptVars(words []string, vars map[string]string) ([]string, error) {
+	var missing string
+	expanded := make([]string, len(words))
+	for i, word := range words {
+		expanded[i] = os.Expand(word, func(name string) string {
+			if value, ok := vars[name]; ok {
+				return value
+			}
+			if value, ok := os.LookupEnv(name); ok {
+				return value
+			}
+			if missing == "" {
+				missing = name
+			}
+			return ""
+		})
+	}
+	if missing != "" {
+		return nil, errorf("$%s is not set", missing)
+	}
+	return expanded, nil
+}
+
+// doctypeVar turns a doctype into its $LAST_ variable suffix
+// (Sales Order -> SALES_ORDER)
+func doctypeVar(doctype string) string {
+	return strings.ToUpper(strings.ReplaceAll(doctype, " ", "_"))
+}
+
+// readRunRecord returns the doctype and name of the documents a script
+// command created, in order
+func readRunRecord(path string) [][2]string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var created [][2]string
+	for _, line := range strings.Split(string(data), "\n") {
+		if doctype, name, ok := strings.Cut(line, "\t"); ok {
+			created = append(created, [2]string{doctype, name})
+		}
+	}
+	return created
+}
+
+// recordCreated notes a created document for the command history and,
+// in a command run from a script, for its $LAST_NAME
+func (c *Client) recordCreated(endpoint string, result map[string]interface{}) {
+	if strings.Contains(strings.SplitN(endpoint, "?", 2)[0], "/") {
+		return
+	}
+	data, _ := result["data"].(map[string]interface{})
+	name := stringField(data, "name")
+	if name == "" {
+		return
+	}
+	c.noteCreated(endpointDoctype(endpoint), name)
+
+	path := os.Getenv(runRecordEnv)
+	if path == "" {
+		return
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+	fmt.Fprintf(file, "%s\t%s\n", endpointDoctype(endpoint), name)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -71,17 +72,20 @@ type SalesInvoice struct {
 // CmdQuotation handles Quotation commands
 func (c *Client) CmdQuotation(args []string) error {
 	if len(args) == 0 {
-		fmt.Println("Usage: erp-cli quotation <subcommand> [args...]")
-		fmt.Println("Subcommands: list, get, create, add-item, submit, cancel")
+		fmt.Println(T("Usage: erp-cli quotation <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, create, add-item, submit, cancel, pdf"))
 		fmt.Println()
-		fmt.Println("Examples:")
-		fmt.Println("  erp-cli quotation list")
-		fmt.Println("  erp-cli quotation list --customer=\"Acme\" --status=Draft")
-		fmt.Println("  erp-cli quotation get QTN-00001")
-		fmt.Println("  erp-cli quotation create \"Acme Corp\"")
-		fmt.Println("  erp-cli quotation add-item QTN-00001 CPU-I7 10 --rate=450")
-		fmt.Println("  erp-cli quotation submit QTN-00001")
-		fmt.Println("  erp-cli quotation cancel QTN-00001")
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli quotation list"))
+		fmt.Println(T("  erp-cli quotation list --customer=\"Acme\" --status=Draft"))
+		fmt.Println(T("  erp-cli quotation get QTN-00001"))
+		fmt.Println(T("  erp-cli quotation create \"Acme Corp\""))
+		fmt.Println(T("  erp-cli quotation create \"Acme Corp\" --discount=10%"))
+		fmt.Println(T("  erp-cli quotation create \"Acme Corp\" --terms=\"Standard Sales Terms\" --letterhead=\"Acme\""))
+		fmt.Println(T("  erp-cli quotation add-item QTN-00001 CPU-I7 10 --rate=450"))
+		fmt.Println(T("  erp-cli quotation submit QTN-00001"))
+		fmt.Println(T("  erp-cli quotation cancel QTN-00001"))
+		fmt.Println(T("  erp-cli quotation pdf QTN-00001 -o quote.pdf --open"))
 		return nil
 	}
 
@@ -91,21 +95,36 @@ func (c *Client) CmdQuotation(args []string) error {
 		return c.quotationList(opts)
 	case "get":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli quotation get <name>")
+			return errorf("usage: erp-cli quotation get <name> [--template=file.tmpl]")
+		}
+		if tmpl := parseTemplateFlag(args[2:]); tmpl != "" {
+			return c.renderDocTemplate("Quotation", args[1], tmpl)
 		}
 		return c.quotationGet(args[1])
 	case "create":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli quotation create <customer>")
+			return errorf("usage: erp-cli quotation create <customer> [--discount=N%%|--discount-amount=N] [--coupon=CODE] [--currency=X [--exchange-rate=N]] [--transaction-date=YYYY-MM-DD] [--terms=X] [--remarks=\"...\"] [--letterhead=X]")
 		}
-		return c.quotationCreate(args[1])
+		discount, err := parseDiscountFlags(args[2:])
+		if err != nil {
+			return err
+		}
+		cur, err := parseCurrencyFlags(args[2:])
+		if err != nil {
+			return err
+		}
+		date, err := transactionDate(args[2:])
+		if err != nil {
+			return err
+		}
+		return c.quotationCreate(args[1], discount, cur, date, parseTermsFlags(args[2:]))
 	case "add-item":
 		if len(args) < 4 {
-			return fmt.Errorf("usage: erp-cli quotation add-item <name> <item_code> <qty> [--rate=X]")
+			return errorf("usage: erp-cli quotation add-item <name> <item_code> <qty> [--rate=X]")
 		}
 		qty, err := strconv.ParseFloat(args[3], 64)
 		if err != nil {
-			return fmt.Errorf("invalid quantity: %s", args[3])
+			return errorf("invalid quantity: %s", args[3])
 		}
 		rate := 0.0
 		for _, arg := range args[4:] {
@@ -116,22 +135,29 @@ func (c *Client) CmdQuotation(args []string) error {
 		return c.quotationAddItem(args[1], args[2], qty, rate)
 	case "submit":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli quotation submit <name>")
+			return errorf("usage: erp-cli quotation submit <name> [--notify|--no-notify]")
 		}
-		return c.quotationSubmit(args[1])
+		return c.quotationSubmit(args[1], c.notifyOnSubmit("Quotation", args[2:]))
 	case "cancel":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli quotation cancel <name>")
+			return errorf("usage: erp-cli quotation cancel <name> [--cascade] [--yes]")
+		}
+		return c.quotationCancel(args[1], parseCancelOptions(args[2:]))
+	case "pdf":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli quotation pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]")
 		}
-		return c.quotationCancel(args[1])
+		return c.docPDF("Quotation", args[1], parsePDFOptions(args[2:]))
 	default:
-		return fmt.Errorf("unknown quotation subcommand: %s", args[0])
+		return errorf("unknown quotation subcommand: %s", args[0])
 	}
 }
 
 type quotationListOptions struct {
 	customer string
 	status   string
+	xlsx     string // Output file for --xlsx
+	template string // --template file
 }
 
 func parseQuotationListOptions(args []string) quotationListOptions {
@@ -143,13 +169,17 @@ func parseQuotationListOptions(args []string) quotationListOptions {
 		if len(arg) > 9 && arg[:9] == "--status=" {
 			opts.status = arg[9:]
 		}
+		if path, ok := parseXLSXFlag(arg, "quotations.xlsx"); ok {
+			opts.xlsx = path
+		}
+		if len(arg) > 11 && arg[:11] == "--template=" {
+			opts.template = arg[11:]
+		}
 	}
 	return opts
 }
 
 func (c *Client) quotationList(opts quotationListOptions) error {
-	fmt.Printf("%sFetching quotations...%s\n", Blue, Reset)
-
 	filters := [][]interface{}{}
 	if opts.customer != "" {
 		filters = append(filters, []interface{}{"party_name", "like", fmt.Sprintf("%%%s%%", opts.customer)})
@@ -158,7 +188,15 @@ func (c *Client) quotationList(opts quotationListOptions) error {
 		filters = append(filters, []interface{}{"status", "=", opts.status})
 	}
 
-	endpoint := "Quotation?limit_page_length=0&fields=[\"name\",\"party_name\",\"transaction_date\",\"status\",\"grand_total\",\"docstatus\"]&order_by=creation%20desc"
+	if opts.xlsx != "" {
+		return c.exportDocsXLSX("Quotation", filters, opts.xlsx)
+	}
+	if opts.template != "" {
+		return c.renderListTemplate("Quotation", filters, opts.template)
+	}
+	printf("%sFetching quotations...%s\n", Blue, Reset)
+
+	endpoint := "Quotation?" + c.pageLimit(0) + "&fields=[\"name\",\"party_name\",\"transaction_date\",\"status\",\"grand_total\",\"docstatus\"]&order_by=creation%20desc"
 	if len(filters) > 0 {
 		encoded, err := encodeFilters(filters)
 		if err != nil {
@@ -174,11 +212,12 @@ func (c *Client) quotationList(opts quotationListOptions) error {
 
 	if data, ok := result["data"].([]interface{}); ok {
 		if len(data) == 0 {
-			fmt.Printf("%sNo quotations found%s\n", Yellow, Reset)
+			printf("%sNo quotations found%s\n", Yellow, Reset)
 			return nil
 		}
 
-		fmt.Printf("\n%sQuotations (%d):%s\n", Cyan, len(data), Reset)
+		printf("\n%sQuotations (%d):%s\n", Cyan, len(data), Reset)
+		c.printPageNote(len(data))
 		for _, item := range data {
 			if m, ok := item.(map[string]interface{}); ok {
 				name := m["name"]
@@ -195,7 +234,7 @@ func (c *Client) quotationList(opts quotationListOptions) error {
 				}
 
 				fmt.Printf("  %s - %s\n", name, customer)
-				fmt.Printf("    Date: %s | Status: %s%s%s | Total: %s\n",
+				printf("    Date: %s | Status: %s%s%s | Total: %s\n",
 					date, statusColor, status, Reset, c.FormatCurrency(total))
 			}
 		}
@@ -204,7 +243,7 @@ func (c *Client) quotationList(opts quotationListOptions) error {
 }
 
 func (c *Client) quotationGet(name string) error {
-	fmt.Printf("%sFetching quotation: %s%s\n", Blue, name, Reset)
+	printf("%sFetching quotation: %s%s\n", Blue, name, Reset)
 
 	encoded := url.PathEscape(name)
 	result, err := c.Request("GET", "Quotation/"+encoded, nil)
@@ -213,50 +252,67 @@ func (c *Client) quotationGet(name string) error {
 	}
 
 	if data, ok := result["data"].(map[string]interface{}); ok {
-		fmt.Printf("\n%sQuotation: %s%s\n", Cyan, name, Reset)
+		printf("\n%sQuotation: %s%s\n", Cyan, name, Reset)
 
-		fmt.Printf("  Customer: %s\n", data["party_name"])
-		fmt.Printf("  Date: %s\n", data["transaction_date"])
-		fmt.Printf("  Valid Till: %s\n", data["valid_till"])
-		fmt.Printf("  Status: %s\n", data["status"])
-		grandTotal, _ := data["grand_total"].(float64)
-		fmt.Printf("  Total: %s\n", c.FormatCurrency(grandTotal))
+		printf("  Customer: %s\n", data["party_name"])
+		printf("  Date: %s\n", data["transaction_date"])
+		printf("  Valid Till: %s\n", data["valid_till"])
+		printf("  Status: %s\n", data["status"])
+		printf("  Total: %s\n", c.docTotal(data))
+		c.printDocDiscount(data)
 
 		if items, ok := data["items"].([]interface{}); ok && len(items) > 0 {
-			fmt.Printf("\n  %sItems:%s\n", Yellow, Reset)
+			printf("\n  %sItems:%s\n", Yellow, Reset)
 			for _, item := range items {
 				if m, ok := item.(map[string]interface{}); ok {
 					itemCode := m["item_code"]
 					qty, _ := m["qty"].(float64)
 					rate, _ := m["rate"].(float64)
 					amount, _ := m["amount"].(float64)
-					fmt.Printf("    - %s: %.0f x %s = %s\n", itemCode, qty, c.FormatCurrency(rate), c.FormatCurrency(amount))
+					fmt.Printf("    - %s%s: %.0f x %s = %s\n", itemCode, customerCodeSuffix(m), qty, c.formatDoc(data, rate), c.formatDoc(data, amount))
 				}
 			}
 		}
+
+		printDocTerms(data)
+		c.printActivity("Quotation", name)
 	}
 	return nil
 }
 
-func (c *Client) quotationCreate(customer string) error {
-	fmt.Printf("%sCreating quotation for: %s%s\n", Blue, customer, Reset)
+func (c *Client) quotationCreate(customer string, discount docDiscount, cur docCurrency, date string, terms docTerms) error {
+	printf("%sCreating quotation for: %s%s\n", Blue, customer, Reset)
 
 	company, err := c.GetCompany()
 	if err != nil {
 		return err
 	}
 
-	today := time.Now().Format("2006-01-02")
-	validTill := time.Now().AddDate(0, 0, 30).Format("2006-01-02")
+	date, err = c.documentDate(date)
+	if err != nil {
+		return err
+	}
+	start, _ := time.Parse("2006-01-02", date)
+	validTill := start.AddDate(0, 0, 30).Format("2006-01-02")
 
 	body := map[string]interface{}{
 		"quotation_to":     "Customer",
 		"party_name":       customer,
-		"transaction_date": today,
+		"transaction_date": date,
 		"valid_till":       validTill,
 		"company":          company,
 		"items":            []interface{}{},
 	}
+	if err := c.applyDiscount(body, discount); err != nil {
+		return err
+	}
+	if cur, err = c.applyCurrency(body, cur, date); err != nil {
+		return err
+	}
+
+	if terms, err = c.applyTerms(body, "Quotation", terms, true); err != nil {
+		return err
+	}
 
 	result, err := c.Request("POST", "Quotation", body)
 	if err != nil {
@@ -265,20 +321,21 @@ func (c *Client) quotationCreate(customer string) error {
 
 	if data, ok := result["data"].(map[string]interface{}); ok {
 		qtnName := data["name"]
-		fmt.Printf("%s✓ Quotation created: %s%s\n", Green, qtnName, Reset)
-		fmt.Printf("  Status: Draft\n")
-		fmt.Printf("  Valid until: %s\n", validTill)
-		fmt.Printf("  Use 'erp-cli quotation add-item %s <item> <qty>' to add items\n", qtnName)
+		printf("%s✓ Quotation created: %s%s\n", Green, qtnName, Reset)
+		printf("  Status: Draft\n")
+		printf("  Valid until: %s\n", validTill)
+		c.printCurrency(cur)
+		c.printDiscount(discount)
+		printTerms(terms)
+		c.finishRemarks("Quotation", stringField(data, "name"), terms)
+		printf("  Use 'erp-cli quotation add-item %s <item> <qty>' to add items\n", qtnName)
 	}
 
 	return nil
 }
 
 func (c *Client) quotationAddItem(qtnName, itemCode string, qty, rate float64) error {
-	fmt.Printf("%sAdding item to Quotation: %s%s\n", Blue, qtnName, Reset)
-	fmt.Printf("  Item: %s\n", itemCode)
-	fmt.Printf("  Quantity: %.0f\n", qty)
-
+	printf("%sAdding item to Quotation: %s%s\n", Blue, qtnName, Reset)
 	encoded := url.PathEscape(qtnName)
 	result, err := c.Request("GET", "Quotation/"+encoded, nil)
 	if err != nil {
@@ -287,14 +344,31 @@ func (c *Client) quotationAddItem(qtnName, itemCode string, qty, rate float64) e
 
 	data, ok := result["data"].(map[string]interface{})
 	if !ok {
-		return fmt.Errorf("quotation not found")
+		return errorf("quotation not found")
 	}
 
 	docStatus, _ := data["docstatus"].(float64)
 	if docStatus != 0 {
-		return fmt.Errorf("cannot add items to submitted/cancelled quotation")
+		return errorf("cannot add items to submitted/cancelled quotation")
+	}
+
+	// Accept the customer's own item code
+	customer := ""
+	if data["quotation_to"] == "Customer" {
+		customer, _ = data["party_name"].(string)
+	}
+	itemCode, customerCode, err := c.resolveCustomerItemCode(customer, itemCode)
+	if err != nil {
+		return err
 	}
 
+	if customerCode != "" {
+		printf("  Item: %s (customer code: %s)\n", itemCode, customerCode)
+	} else {
+		printf("  Item: %s\n", itemCode)
+	}
+	printf("  Quantity: %.0f\n", qty)
+
 	var existingItems []map[string]interface{}
 	if items, ok := data["items"].([]interface{}); ok {
 		for _, item := range items {
@@ -308,9 +382,12 @@ func (c *Client) quotationAddItem(qtnName, itemCode string, qty, rate float64) e
 		"item_code": itemCode,
 		"qty":       qty,
 	}
+	if customerCode != "" {
+		newItem["customer_item_code"] = customerCode
+	}
 	if rate > 0 {
 		newItem["rate"] = rate
-		fmt.Printf("  Rate: %s\n", c.FormatCurrency(rate))
+		printf("  Rate: %s\n", c.FormatCurrency(rate))
 	}
 	existingItems = append(existingItems, newItem)
 
@@ -318,54 +395,67 @@ func (c *Client) quotationAddItem(qtnName, itemCode string, qty, rate float64) e
 		"items": existingItems,
 	}
 
-	_, err = c.Request("PUT", "Quotation/"+encoded, body)
+	err = c.updateDraft("Quotation", qtnName, data, body)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("%s✓ Item added to Quotation: %s%s\n", Green, qtnName, Reset)
+	printf("%s✓ Item added to Quotation: %s%s\n", Green, qtnName, Reset)
 	return nil
 }
 
-func (c *Client) quotationSubmit(name string) error {
-	fmt.Printf("%sSubmitting quotation: %s%s\n", Blue, name, Reset)
+func (c *Client) quotationSubmit(name string, notify bool) error {
+	printf("%sSubmitting quotation: %s%s\n", Blue, name, Reset)
 
 	err := c.submitDocument("Quotation", name)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("%s✓ Quotation submitted: %s%s\n", Green, name, Reset)
+	printf("%s✓ Quotation submitted: %s%s\n", Green, name, Reset)
+	if notify {
+		c.notifyCustomer("Quotation", name)
+	}
 	return nil
 }
 
-func (c *Client) quotationCancel(name string) error {
-	fmt.Printf("%sCancelling quotation: %s%s\n", Blue, name, Reset)
+func (c *Client) quotationCancel(name string, opts cancelOptions) error {
+	printf("%sCancelling quotation: %s%s\n", Blue, name, Reset)
 
-	err := c.cancelDocument("Quotation", name)
+	err := c.cancelWithChain("Quotation", name, opts)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("%s✓ Quotation cancelled: %s%s\n", Green, name, Reset)
+	printf("%s✓ Quotation cancelled: %s%s\n", Green, name, Reset)
 	return nil
 }
 
 // CmdSO handles Sales Order commands
 func (c *Client) CmdSO(args []string) error {
 	if len(args) == 0 {
-		fmt.Println("Usage: erp-cli so <subcommand> [args...]")
-		fmt.Println("Subcommands: list, get, create, create-from-quotation, add-item, submit, cancel")
+		fmt.Println(T("Usage: erp-cli so <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, create, create-from-quotation, add-item, submit, cancel, pdf"))
 		fmt.Println()
-		fmt.Println("Examples:")
-		fmt.Println("  erp-cli so list")
-		fmt.Println("  erp-cli so list --customer=\"Acme\" --status=Draft")
-		fmt.Println("  erp-cli so get SAL-ORD-2025-00001")
-		fmt.Println("  erp-cli so create \"Acme Corp\"")
-		fmt.Println("  erp-cli so create-from-quotation QTN-00001")
-		fmt.Println("  erp-cli so add-item SAL-ORD-2025-00001 CPU-I7 10 --rate=450")
-		fmt.Println("  erp-cli so submit SAL-ORD-2025-00001")
-		fmt.Println("  erp-cli so cancel SAL-ORD-2025-00001")
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli so list"))
+		fmt.Println(T("  erp-cli so list --customer=\"Acme\" --status=Draft"))
+		fmt.Println(T("  erp-cli so get SAL-ORD-2025-00001"))
+		fmt.Println(T("  erp-cli so create \"Acme Corp\""))
+		fmt.Println(T("  erp-cli so create \"Acme Corp\" --sales-person=\"Jane Doe:60\" --sales-person=\"John Roe:40\""))
+		fmt.Println(T("  erp-cli so create \"Acme Corp\" --discount-amount=50 --coupon=SUMMER10"))
+		fmt.Println(T("  erp-cli so create \"Acme Corp\" --shipping-rule=\"Standard Shipping\""))
+		fmt.Println(T("  erp-cli so create \"Acme Corp\" --shipping-address=\"Acme Corp-Warehouse\"   (see: erp-cli customer addresses)"))
+		fmt.Println(T("  erp-cli so create -i                 (prompt for customer and lines, with running total)"))
+		fmt.Println(T("  erp-cli so create \"Acme Corp\" -i"))
+		fmt.Println(T("  erp-cli so create \"Acme Corp\" --blanket=MFG-BLR-2026-00002 --items=PC-GAMING:10"))
+		fmt.Println(T("  erp-cli so create-from-quotation QTN-00001"))
+		fmt.Println(T("  erp-cli so add-item SAL-ORD-2025-00001 CPU-I7 10 --rate=450"))
+		fmt.Println(T("  erp-cli so add-item SAL-ORD-2025-00001 ACME-PN-778 10   (customer's item code)"))
+		fmt.Println(T("  erp-cli so submit SAL-ORD-2025-00001"))
+		fmt.Println(T("  erp-cli so cancel SAL-ORD-2025-00001"))
+		fmt.Println(T("  erp-cli so cancel SAL-ORD-2025-00001 --cascade   (cancel linked DN/SI/payments first)"))
+		fmt.Println(T("  erp-cli so pdf SAL-ORD-2025-00001"))
 		return nil
 	}
 
@@ -375,26 +465,75 @@ func (c *Client) CmdSO(args []string) error {
 		return c.soList(opts)
 	case "get":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli so get <name>")
+			return errorf("usage: erp-cli so get <name> [--template=file.tmpl]")
+		}
+		if tmpl := parseTemplateFlag(args[2:]); tmpl != "" {
+			return c.renderDocTemplate("Sales Order", args[1], tmpl)
 		}
 		return c.soGet(args[1])
 	case "create":
-		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli so create <customer>")
+		customer, interactive := "", false
+		for _, arg := range args[1:] {
+			if arg == "-i" || arg == "--interactive" {
+				interactive = true
+			} else if customer == "" && !strings.HasPrefix(arg, "--") {
+				customer = arg
+			}
 		}
-		return c.soCreate(args[1])
+		if customer == "" && !interactive {
+			return errorf("usage: erp-cli so create <customer> [--sales-person=Name[:pct]] [--blanket=X [--items=CODE:QTY,...]] [--discount=N%%|--discount-amount=N] [--coupon=CODE] [--shipping-rule=X] [--billing-address=X] [--shipping-address=X] [--currency=X [--exchange-rate=N]] [--transaction-date=YYYY-MM-DD] [--terms=X] [--remarks=\"...\"] [--letterhead=X] [-i]")
+		}
+		team, err := parseSalesTeam(args[1:])
+		if err != nil {
+			return err
+		}
+		discount, err := parseDiscountFlags(args[1:])
+		if err != nil {
+			return err
+		}
+		shipping := parseShippingRule(args[1:])
+		cur, err := parseCurrencyFlags(args[1:])
+		if err != nil {
+			return err
+		}
+		date, err := transactionDate(args[1:])
+		if err != nil {
+			return err
+		}
+		terms, addr := parseTermsFlags(args[1:]), parseAddressFlags(args[1:])
+		blanket, picks, err := parseBlanketFlags(args[1:])
+		if err != nil {
+			return err
+		}
+		if interactive {
+			if blanket != "" {
+				return errorf("--blanket cannot be combined with -i")
+			}
+			if cur.code != "" {
+				return errorf("--currency cannot be combined with -i (its rates come from the price list)")
+			}
+			return c.soCreateInteractive(customer, team, discount, shipping, date, terms, addr)
+		}
+		if blanket != "" {
+			return c.soCreateFromBlanket(customer, team, blanket, picks, discount, shipping, cur, date, terms, addr)
+		}
+		return c.soCreate(customer, team, discount, shipping, cur, date, terms, addr)
 	case "create-from-quotation":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli so create-from-quotation <quotation_name>")
+			return errorf("usage: erp-cli so create-from-quotation <quotation_name> [--transaction-date=YYYY-MM-DD] [--billing-address=X] [--shipping-address=X] [--terms=X] [--remarks=\"...\"] [--letterhead=X]")
 		}
-		return c.soCreateFromQuotation(args[1])
+		date, err := transactionDate(args[2:])
+		if err != nil {
+			return err
+		}
+		return c.soCreateFromQuotation(args[1], date, parseTermsFlags(args[2:]), parseAddressFlags(args[2:]))
 	case "add-item":
 		if len(args) < 4 {
-			return fmt.Errorf("usage: erp-cli so add-item <so_name> <item_code> <qty> [--rate=X]")
+			return errorf("usage: erp-cli so add-item <so_name> <item_code> <qty> [--rate=X]")
 		}
 		qty, err := strconv.ParseFloat(args[3], 64)
 		if err != nil {
-			return fmt.Errorf("invalid quantity: %s", args[3])
+			return errorf("invalid quantity: %s", args[3])
 		}
 		rate := 0.0
 		for _, arg := range args[4:] {
@@ -405,22 +544,35 @@ func (c *Client) CmdSO(args []string) error {
 		return c.soAddItem(args[1], args[2], qty, rate)
 	case "submit":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli so submit <name>")
+			return errorf("usage: erp-cli so submit <name> [--force] [--notify|--no-notify]")
+		}
+		force := false
+		for _, arg := range args[2:] {
+			if arg == "--force" {
+				force = true
+			}
 		}
-		return c.soSubmit(args[1])
+		return c.soSubmit(args[1], force, c.notifyOnSubmit("Sales Order", args[2:]))
 	case "cancel":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli so cancel <name>")
+			return errorf("usage: erp-cli so cancel <name> [--cascade] [--yes]")
+		}
+		return c.soCancel(args[1], parseCancelOptions(args[2:]))
+	case "pdf":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli so pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]")
 		}
-		return c.soCancel(args[1])
+		return c.docPDF("Sales Order", args[1], parsePDFOptions(args[2:]))
 	default:
-		return fmt.Errorf("unknown so subcommand: %s", args[0])
+		return errorf("unknown so subcommand: %s", args[0])
 	}
 }
 
 type soListOptions struct {
 	customer string
 	status   string
+	xlsx     string // Output file for --xlsx
+	template string // --template file
 }
 
 func parseSOListOptions(args []string) soListOptions {
@@ -432,13 +584,17 @@ func parseSOListOptions(args []string) soListOptions {
 		if len(arg) > 9 && arg[:9] == "--status=" {
 			opts.status = arg[9:]
 		}
+		if path, ok := parseXLSXFlag(arg, "sales-orders.xlsx"); ok {
+			opts.xlsx = path
+		}
+		if len(arg) > 11 && arg[:11] == "--template=" {
+			opts.template = arg[11:]
+		}
 	}
 	return opts
 }
 
 func (c *Client) soList(opts soListOptions) error {
-	fmt.Printf("%sFetching sales orders...%s\n", Blue, Reset)
-
 	filters := [][]interface{}{}
 	if opts.customer != "" {
 		filters = append(filters, []interface{}{"customer", "like", fmt.Sprintf("%%%s%%", opts.customer)})
@@ -447,7 +603,15 @@ func (c *Client) soList(opts soListOptions) error {
 		filters = append(filters, []interface{}{"status", "=", opts.status})
 	}
 
-	endpoint := "Sales%20Order?limit_page_length=0&fields=[\"name\",\"customer\",\"transaction_date\",\"status\",\"grand_total\",\"docstatus\"]&order_by=creation%20desc"
+	if opts.xlsx != "" {
+		return c.exportDocsXLSX("Sales Order", filters, opts.xlsx)
+	}
+	if opts.template != "" {
+		return c.renderListTemplate("Sales Order", filters, opts.template)
+	}
+	printf("%sFetching sales orders...%s\n", Blue, Reset)
+
+	endpoint := "Sales%20Order?" + c.pageLimit(0) + "&fields=[\"name\",\"customer\",\"transaction_date\",\"status\",\"grand_total\",\"docstatus\"]&order_by=creation%20desc"
 	if len(filters) > 0 {
 		encoded, err := encodeFilters(filters)
 		if err != nil {
@@ -463,11 +627,12 @@ func (c *Client) soList(opts soListOptions) error {
 
 	if data, ok := result["data"].([]interface{}); ok {
 		if len(data) == 0 {
-			fmt.Printf("%sNo sales orders found%s\n", Yellow, Reset)
+			printf("%sNo sales orders found%s\n", Yellow, Reset)
 			return nil
 		}
 
-		fmt.Printf("\n%sSales Orders (%d):%s\n", Cyan, len(data), Reset)
+		printf("\n%sSales Orders (%d):%s\n", Cyan, len(data), Reset)
+		c.printPageNote(len(data))
 		for _, item := range data {
 			if m, ok := item.(map[string]interface{}); ok {
 				name := m["name"]
@@ -484,7 +649,7 @@ func (c *Client) soList(opts soListOptions) error {
 				}
 
 				fmt.Printf("  %s - %s\n", name, customer)
-				fmt.Printf("    Date: %s | Status: %s%s%s | Total: %s\n",
+				printf("    Date: %s | Status: %s%s%s | Total: %s\n",
 					date, statusColor, status, Reset, c.FormatCurrency(total))
 			}
 		}
@@ -493,7 +658,7 @@ func (c *Client) soList(opts soListOptions) error {
 }
 
 func (c *Client) soGet(name string) error {
-	fmt.Printf("%sFetching sales order: %s%s\n", Blue, name, Reset)
+	printf("%sFetching sales order: %s%s\n", Blue, name, Reset)
 
 	encoded := url.PathEscape(name)
 	result, err := c.Request("GET", "Sales%20Order/"+encoded, nil)
@@ -502,48 +667,156 @@ func (c *Client) soGet(name string) error {
 	}
 
 	if data, ok := result["data"].(map[string]interface{}); ok {
-		fmt.Printf("\n%sSales Order: %s%s\n", Cyan, name, Reset)
-
-		fmt.Printf("  Customer: %s\n", data["customer"])
-		fmt.Printf("  Date: %s\n", data["transaction_date"])
-		fmt.Printf("  Delivery Date: %s\n", data["delivery_date"])
-		fmt.Printf("  Status: %s\n", data["status"])
-		grandTotal, _ := data["grand_total"].(float64)
-		fmt.Printf("  Total: %s\n", c.FormatCurrency(grandTotal))
+		printf("\n%sSales Order: %s%s\n", Cyan, name, Reset)
+
+		printf("  Customer: %s\n", data["customer"])
+		printf("  Date: %s\n", data["transaction_date"])
+		printf("  Delivery Date: %s\n", data["delivery_date"])
+		printf("  Status: %s\n", data["status"])
+		printf("  Total: %s\n", c.docTotal(data))
+		c.printDocDiscount(data)
+		if repeat := stringField(data, "auto_repeat"); repeat != "" {
+			printf("  Auto Repeat: %s\n", repeat)
+		}
+		printDocAddresses(data)
+		c.printSalesTeam(data)
 
 		if items, ok := data["items"].([]interface{}); ok && len(items) > 0 {
-			fmt.Printf("\n  %sItems:%s\n", Yellow, Reset)
+			printf("\n  %sItems:%s\n", Yellow, Reset)
 			for _, item := range items {
 				if m, ok := item.(map[string]interface{}); ok {
 					itemCode := m["item_code"]
 					qty, _ := m["qty"].(float64)
 					rate, _ := m["rate"].(float64)
 					amount, _ := m["amount"].(float64)
-					fmt.Printf("    - %s: %.0f x %s = %s\n", itemCode, qty, c.FormatCurrency(rate), c.FormatCurrency(amount))
+					fmt.Printf("    - %s%s: %.0f x %s = %s\n", itemCode, customerCodeSuffix(m), qty, c.formatDoc(data, rate), c.formatDoc(data, amount))
+					for _, line := range packedItemLines(data, m) {
+						fmt.Println(line)
+					}
 				}
 			}
 		}
+
+		c.printDocCharges(data)
+		printDocTerms(data)
+		c.printActivity("Sales Order", name)
 	}
 	return nil
 }
 
-func (c *Client) soCreate(customer string) error {
-	fmt.Printf("%sCreating sales order for: %s%s\n", Blue, customer, Reset)
+func (c *Client) soCreate(customer string, team []map[string]interface{}, discount docDiscount, shipping string, cur docCurrency, date string, terms docTerms, addr docAddresses) error {
+	printf("%sCreating sales order for: %s%s\n", Blue, customer, Reset)
 
 	company, err := c.GetCompany()
 	if err != nil {
 		return err
 	}
 
-	today := time.Now().Format("2006-01-02")
+	date, err = c.documentDate(date)
+	if err != nil {
+		return err
+	}
 
 	body := map[string]interface{}{
 		"customer":         customer,
-		"transaction_date": today,
-		"delivery_date":    today,
+		"transaction_date": date,
+		"delivery_date":    date,
 		"company":          company,
 		"items":            []interface{}{},
 	}
+	if len(team) > 0 {
+		body["sales_team"] = team
+	}
+	if err := c.applyDiscount(body, discount); err != nil {
+		return err
+	}
+	if err := c.applyShippingRule(body, shipping); err != nil {
+		return err
+	}
+	if cur, err = c.applyCurrency(body, cur, date); err != nil {
+		return err
+	}
+
+	if err := c.applyAddresses(body, addr); err != nil {
+		return err
+	}
+	if terms, err = c.applyTerms(body, "Sales Order", terms, true); err != nil {
+		return err
+	}
+
+	result, err := c.Request("POST", "Sales%20Order", body)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].(map[string]interface{}); ok {
+		soName := data["name"]
+		printf("%s✓ Sales Order created: %s%s\n", Green, soName, Reset)
+		printf("  Status: Draft\n")
+		for _, member := range team {
+			printf("  Sales Person: %s (%.0f%%)\n", member["sales_person"], member["allocated_percentage"])
+		}
+		c.printCurrency(cur)
+		c.printDiscount(discount)
+		printShippingRule(shipping)
+		printAddresses(addr)
+		printTerms(terms)
+		c.finishRemarks("Sales Order", stringField(data, "name"), terms)
+		printf("  Use 'erp-cli so add-item %s <item> <qty>' to add items\n", soName)
+	}
+
+	return nil
+}
+
+// soCreateFromBlanket creates a sales order drawing its lines from a
+// selling blanket order
+func (c *Client) soCreateFromBlanket(customer string, team []map[string]interface{}, blanket string, picks []bomComponent, discount docDiscount, shipping string, cur docCurrency, date string, terms docTerms, addr docAddresses) error {
+	printf("%sCreating sales order for: %s%s\n", Blue, customer, Reset)
+
+	company, err := c.GetCompany()
+	if err != nil {
+		return err
+	}
+
+	items, err := c.blanketOrderItems(blanket, "Selling", customer, picks)
+	if err != nil {
+		return err
+	}
+
+	date, err = c.documentDate(date)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		item["delivery_date"] = date
+	}
+
+	body := map[string]interface{}{
+		"customer":         customer,
+		"transaction_date": date,
+		"delivery_date":    date,
+		"company":          company,
+		"items":            items,
+	}
+	if len(team) > 0 {
+		body["sales_team"] = team
+	}
+	if err := c.applyDiscount(body, discount); err != nil {
+		return err
+	}
+	if err := c.applyShippingRule(body, shipping); err != nil {
+		return err
+	}
+	if cur, err = c.applyCurrency(body, cur, date); err != nil {
+		return err
+	}
+
+	if err := c.applyAddresses(body, addr); err != nil {
+		return err
+	}
+	if terms, err = c.applyTerms(body, "Sales Order", terms, true); err != nil {
+		return err
+	}
 
 	result, err := c.Request("POST", "Sales%20Order", body)
 	if err != nil {
@@ -552,16 +825,23 @@ func (c *Client) soCreate(customer string) error {
 
 	if data, ok := result["data"].(map[string]interface{}); ok {
 		soName := data["name"]
-		fmt.Printf("%s✓ Sales Order created: %s%s\n", Green, soName, Reset)
-		fmt.Printf("  Status: Draft\n")
-		fmt.Printf("  Use 'erp-cli so add-item %s <item> <qty>' to add items\n", soName)
+		printf("%s✓ Sales Order created: %s%s\n", Green, soName, Reset)
+		printf("  Status: Draft\n")
+		c.printBlanketRows(blanket, items)
+		c.printCurrency(cur)
+		c.printDiscount(discount)
+		printShippingRule(shipping)
+		printAddresses(addr)
+		printTerms(terms)
+		c.finishRemarks("Sales Order", stringField(data, "name"), terms)
+		printf("  Use 'erp-cli so submit %s' to submit\n", soName)
 	}
 
 	return nil
 }
 
-func (c *Client) soCreateFromQuotation(qtnName string) error {
-	fmt.Printf("%sCreating sales order from Quotation: %s%s\n", Blue, qtnName, Reset)
+func (c *Client) soCreateFromQuotation(qtnName, date string, terms docTerms, addr docAddresses) error {
+	printf("%sCreating sales order from Quotation: %s%s\n", Blue, qtnName, Reset)
 
 	encoded := url.PathEscape(qtnName)
 	result, err := c.Request("GET", "Quotation/"+encoded, nil)
@@ -571,12 +851,12 @@ func (c *Client) soCreateFromQuotation(qtnName string) error {
 
 	qtnData, ok := result["data"].(map[string]interface{})
 	if !ok {
-		return fmt.Errorf("quotation not found")
+		return errorf("quotation not found")
 	}
 
 	docStatus, _ := qtnData["docstatus"].(float64)
 	if docStatus != 1 {
-		return fmt.Errorf("quotation must be submitted first")
+		return errorf("quotation must be submitted first")
 	}
 
 	company, err := c.GetCompany()
@@ -584,35 +864,49 @@ func (c *Client) soCreateFromQuotation(qtnName string) error {
 		return err
 	}
 
-	today := time.Now().Format("2006-01-02")
+	date, err = c.documentDate(date)
+	if err != nil {
+		return err
+	}
 
 	var soItems []map[string]interface{}
 	if items, ok := qtnData["items"].([]interface{}); ok {
 		for _, item := range items {
 			if m, ok := item.(map[string]interface{}); ok {
 				soItems = append(soItems, map[string]interface{}{
-					"item_code":       m["item_code"],
-					"qty":             m["qty"],
-					"rate":            m["rate"],
-					"delivery_date":   today,
-					"prevdoc_docname": qtnName,
-					"quotation_item":  m["name"],
+					"item_code":          m["item_code"],
+					"customer_item_code": m["customer_item_code"],
+					"qty":                m["qty"],
+					"rate":               m["rate"],
+					"delivery_date":      date,
+					"prevdoc_docname":    qtnName,
+					"quotation_item":     m["name"],
 				})
 			}
 		}
 	}
 
 	if len(soItems) == 0 {
-		return fmt.Errorf("no items found in quotation")
+		return errorf("no items found in quotation")
 	}
 
 	body := map[string]interface{}{
 		"customer":         qtnData["party_name"],
-		"transaction_date": today,
-		"delivery_date":    today,
+		"transaction_date": date,
+		"delivery_date":    date,
 		"company":          company,
 		"items":            soItems,
 	}
+	copyOrderCurrency(body, qtnData)
+	copyOrderTerms(body, qtnData)
+	copyOrderAddresses(body, qtnData)
+
+	if err := c.applyAddresses(body, addr); err != nil {
+		return err
+	}
+	if terms, err = c.applyTerms(body, "Sales Order", terms, true); err != nil {
+		return err
+	}
 
 	result, err = c.Request("POST", "Sales%20Order", body)
 	if err != nil {
@@ -621,21 +915,21 @@ func (c *Client) soCreateFromQuotation(qtnName string) error {
 
 	if data, ok := result["data"].(map[string]interface{}); ok {
 		soName := data["name"]
-		fmt.Printf("%s✓ Sales Order created: %s%s\n", Green, soName, Reset)
-		fmt.Printf("  From Quotation: %s\n", qtnName)
-		fmt.Printf("  Items: %d\n", len(soItems))
-		fmt.Printf("  Status: Draft\n")
-		fmt.Printf("  Use 'erp-cli so submit %s' to submit\n", soName)
+		printf("%s✓ Sales Order created: %s%s\n", Green, soName, Reset)
+		printf("  From Quotation: %s\n", qtnName)
+		printf("  Items: %d\n", len(soItems))
+		printf("  Status: Draft\n")
+		printAddresses(addr)
+		printTerms(terms)
+		c.finishRemarks("Sales Order", stringField(data, "name"), terms)
+		printf("  Use 'erp-cli so submit %s' to submit\n", soName)
 	}
 
 	return nil
 }
 
 func (c *Client) soAddItem(soName, itemCode string, qty, rate float64) error {
-	fmt.Printf("%sAdding item to SO: %s%s\n", Blue, soName, Reset)
-	fmt.Printf("  Item: %s\n", itemCode)
-	fmt.Printf("  Quantity: %.0f\n", qty)
-
+	printf("%sAdding item to SO: %s%s\n", Blue, soName, Reset)
 	encoded := url.PathEscape(soName)
 	result, err := c.Request("GET", "Sales%20Order/"+encoded, nil)
 	if err != nil {
@@ -644,14 +938,28 @@ func (c *Client) soAddItem(soName, itemCode string, qty, rate float64) error {
 
 	data, ok := result["data"].(map[string]interface{})
 	if !ok {
-		return fmt.Errorf("sales order not found")
+		return errorf("sales order not found")
 	}
 
 	docStatus, _ := data["docstatus"].(float64)
 	if docStatus != 0 {
-		return fmt.Errorf("cannot add items to submitted/cancelled SO")
+		return errorf("cannot add items to submitted/cancelled SO")
 	}
 
+	// Accept the customer's own item code
+	customer, _ := data["customer"].(string)
+	itemCode, customerCode, err := c.resolveCustomerItemCode(customer, itemCode)
+	if err != nil {
+		return err
+	}
+
+	if customerCode != "" {
+		printf("  Item: %s (customer code: %s)\n", itemCode, customerCode)
+	} else {
+		printf("  Item: %s\n", itemCode)
+	}
+	printf("  Quantity: %.0f\n", qty)
+
 	var existingItems []map[string]interface{}
 	if items, ok := data["items"].([]interface{}); ok {
 		for _, item := range items {
@@ -666,9 +974,12 @@ func (c *Client) soAddItem(soName, itemCode string, qty, rate float64) error {
 		"qty":           qty,
 		"delivery_date": data["delivery_date"],
 	}
+	if customerCode != "" {
+		newItem["customer_item_code"] = customerCode
+	}
 	if rate > 0 {
 		newItem["rate"] = rate
-		fmt.Printf("  Rate: %s\n", c.FormatCurrency(rate))
+		printf("  Rate: %s\n", c.FormatCurrency(rate))
 	}
 	existingItems = append(existingItems, newItem)
 
@@ -676,52 +987,69 @@ func (c *Client) soAddItem(soName, itemCode string, qty, rate float64) error {
 		"items": existingItems,
 	}
 
-	_, err = c.Request("PUT", "Sales%20Order/"+encoded, body)
+	err = c.updateDraft("Sales Order", soName, data, body)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("%s✓ Item added to SO: %s%s\n", Green, soName, Reset)
+	printf("%s✓ Item added to SO: %s%s\n", Green, soName, Reset)
 	return nil
 }
 
-func (c *Client) soSubmit(name string) error {
-	fmt.Printf("%sSubmitting sales order: %s%s\n", Blue, name, Reset)
+func (c *Client) soSubmit(name string, force, notify bool) error {
+	printf("%sSubmitting sales order: %s%s\n", Blue, name, Reset)
+
+	if err := c.checkOrderCredit(name); err != nil {
+		if !force {
+			return err
+		}
+		printf("%sForced: %s%s\n", Yellow, err, Reset)
+	}
 
 	err := c.submitDocument("Sales Order", name)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("%s✓ Sales Order submitted: %s%s\n", Green, name, Reset)
+	printf("%s✓ Sales Order submitted: %s%s\n", Green, name, Reset)
+	if notify {
+		c.notifyCustomer("Sales Order", name)
+	}
 	return nil
 }
 
-func (c *Client) soCancel(name string) error {
-	fmt.Printf("%sCancelling sales order: %s%s\n", Blue, name, Reset)
+func (c *Client) soCancel(name string, opts cancelOptions) error {
+	printf("%sCancelling sales order: %s%s\n", Blue, name, Reset)
 
-	err := c.cancelDocument("Sales Order", name)
+	err := c.cancelWithChain("Sales Order", name, opts)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("%s✓ Sales Order cancelled: %s%s\n", Green, name, Reset)
+	printf("%s✓ Sales Order cancelled: %s%s\n", Green, name, Reset)
 	return nil
 }
 
 // CmdSI handles Sales Invoice commands
 func (c *Client) CmdSI(args []string) error {
 	if len(args) == 0 {
-		fmt.Println("Usage: erp-cli si <subcommand> [args...]")
-		fmt.Println("Subcommands: list, get, create-from-so, submit, cancel")
+		fmt.Println(T("Usage: erp-cli si <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, create-from-so, submit, cancel, pdf, recur"))
 		fmt.Println()
-		fmt.Println("Examples:")
-		fmt.Println("  erp-cli si list")
-		fmt.Println("  erp-cli si list --customer=\"Acme\" --status=Draft")
-		fmt.Println("  erp-cli si get ACC-SINV-2025-00001")
-		fmt.Println("  erp-cli si create-from-so SAL-ORD-2025-00001")
-		fmt.Println("  erp-cli si submit ACC-SINV-2025-00001")
-		fmt.Println("  erp-cli si cancel ACC-SINV-2025-00001")
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli si list"))
+		fmt.Println(T("  erp-cli si list --customer=\"Acme\" --status=Draft"))
+		fmt.Println(T("  erp-cli si get ACC-SINV-2025-00001"))
+		fmt.Println(T("  erp-cli si create-from-so SAL-ORD-2025-00001"))
+		fmt.Println(T("  erp-cli si create-from-so SAL-ORD-2025-00001 --sales-person=\"Jane Doe\""))
+		fmt.Println(T("  erp-cli si create-from-so SAL-ORD-2025-00001 --discount=5%"))
+		fmt.Println(T("  erp-cli si create-from-so SAL-ORD-2025-00001 --shipping-rule=\"Express\"   (default: the SO's rule)"))
+		fmt.Println(T("  erp-cli si submit ACC-SINV-2025-00001"))
+		fmt.Println(T("  erp-cli si cancel ACC-SINV-2025-00001"))
+		fmt.Println(T("  erp-cli si pdf ACC-SINV-2025-00001 --print-format=\"Sales Invoice Print\" --letterhead=\"Acme\""))
+		fmt.Println(T("  erp-cli si recur ACC-SINV-2025-00001 --frequency=Monthly --end=2026-12-31"))
+		fmt.Println(T("  erp-cli si recur ACC-SINV-2025-00001 --frequency=Quarterly --day=1 --submit --email=billing@acme.com"))
+		fmt.Println(T("  erp-cli si recur list"))
 		return nil
 	}
 
@@ -731,32 +1059,70 @@ func (c *Client) CmdSI(args []string) error {
 		return c.siList(opts)
 	case "get":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli si get <name>")
+			return errorf("usage: erp-cli si get <name> [--template=file.tmpl]")
+		}
+		if tmpl := parseTemplateFlag(args[2:]); tmpl != "" {
+			return c.renderDocTemplate("Sales Invoice", args[1], tmpl)
 		}
 		return c.siGet(args[1])
 	case "create-from-so":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli si create-from-so <so_name>")
+			return errorf("usage: erp-cli si create-from-so <so_name> [--sales-person=Name[:pct]] [--discount=N%%|--discount-amount=N] [--coupon=CODE] [--shipping-rule=X] [--billing-address=X] [--shipping-address=X] [--currency=X] [--exchange-rate=N] [--terms=X] [--remarks=\"...\"] [--letterhead=X]")
+		}
+		team, err := parseSalesTeam(args[2:])
+		if err != nil {
+			return err
+		}
+		posting, err := parsePostingOptions(args[2:])
+		if err != nil {
+			return err
+		}
+		discount, err := parseDiscountFlags(args[2:])
+		if err != nil {
+			return err
 		}
-		return c.siCreateFromSO(args[1])
+		cur, err := parseCurrencyFlags(args[2:])
+		if err != nil {
+			return err
+		}
+		return c.siCreateFromSO(args[1], team, posting, discount, parseShippingRule(args[2:]), cur, parseTermsFlags(args[2:]), parseAddressFlags(args[2:]))
 	case "submit":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli si submit <name>")
+			return errorf("usage: erp-cli si submit <name> [--notify|--no-notify]")
 		}
-		return c.siSubmit(args[1])
+		return c.siSubmit(args[1], c.notifyOnSubmit("Sales Invoice", args[2:]))
 	case "cancel":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli si cancel <name>")
+			return errorf("usage: erp-cli si cancel <name> [--cascade] [--yes]")
 		}
-		return c.siCancel(args[1])
+		return c.siCancel(args[1], parseCancelOptions(args[2:]))
+	case "pdf":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli si pdf <name> [-o file.pdf] [--print-format=X] [--letterhead=Y] [--open]")
+		}
+		return c.docPDF("Sales Invoice", args[1], parsePDFOptions(args[2:]))
+	case "recur":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli si recur <name> [--frequency=Monthly] [--start=YYYY-MM-DD] [--end=YYYY-MM-DD] [--day=N] [--submit] [--email=a,b]")
+		}
+		if args[1] == "list" {
+			return c.recurList("Sales Invoice", len(args) > 2 && args[2] == "--all")
+		}
+		opts, err := parseRecurOptions(args[2:])
+		if err != nil {
+			return err
+		}
+		return c.recurCreate("Sales Invoice", args[1], opts)
 	default:
-		return fmt.Errorf("unknown si subcommand: %s", args[0])
+		return errorf("unknown si subcommand: %s", args[0])
 	}
 }
 
 type siListOptions struct {
 	customer string
 	status   string
+	xlsx     string // Output file for --xlsx
+	template string // --template file
 }
 
 func parseSIListOptions(args []string) siListOptions {
@@ -768,13 +1134,17 @@ func parseSIListOptions(args []string) siListOptions {
 		if len(arg) > 9 && arg[:9] == "--status=" {
 			opts.status = arg[9:]
 		}
+		if path, ok := parseXLSXFlag(arg, "sales-invoices.xlsx"); ok {
+			opts.xlsx = path
+		}
+		if len(arg) > 11 && arg[:11] == "--template=" {
+			opts.template = arg[11:]
+		}
 	}
 	return opts
 }
 
 func (c *Client) siList(opts siListOptions) error {
-	fmt.Printf("%sFetching sales invoices...%s\n", Blue, Reset)
-
 	filters := [][]interface{}{}
 	if opts.customer != "" {
 		filters = append(filters, []interface{}{"customer", "like", fmt.Sprintf("%%%s%%", opts.customer)})
@@ -783,7 +1153,15 @@ func (c *Client) siList(opts siListOptions) error {
 		filters = append(filters, []interface{}{"status", "=", opts.status})
 	}
 
-	endpoint := "Sales%20Invoice?limit_page_length=0&fields=[\"name\",\"customer\",\"posting_date\",\"status\",\"grand_total\",\"docstatus\"]&order_by=creation%20desc"
+	if opts.xlsx != "" {
+		return c.exportDocsXLSX("Sales Invoice", filters, opts.xlsx)
+	}
+	if opts.template != "" {
+		return c.renderListTemplate("Sales Invoice", filters, opts.template)
+	}
+	printf("%sFetching sales invoices...%s\n", Blue, Reset)
+
+	endpoint := "Sales%20Invoice?" + c.pageLimit(0) + "&fields=[\"name\",\"customer\",\"posting_date\",\"status\",\"grand_total\",\"docstatus\"]&order_by=creation%20desc"
 	if len(filters) > 0 {
 		encoded, err := encodeFilters(filters)
 		if err != nil {
@@ -799,11 +1177,12 @@ func (c *Client) siList(opts siListOptions) error {
 
 	if data, ok := result["data"].([]interface{}); ok {
 		if len(data) == 0 {
-			fmt.Printf("%sNo sales invoices found%s\n", Yellow, Reset)
+			printf("%sNo sales invoices found%s\n", Yellow, Reset)
 			return nil
 		}
 
-		fmt.Printf("\n%sSales Invoices (%d):%s\n", Cyan, len(data), Reset)
+		printf("\n%sSales Invoices (%d):%s\n", Cyan, len(data), Reset)
+		c.printPageNote(len(data))
 		for _, item := range data {
 			if m, ok := item.(map[string]interface{}); ok {
 				name := m["name"]
@@ -820,7 +1199,7 @@ func (c *Client) siList(opts siListOptions) error {
 				}
 
 				fmt.Printf("  %s - %s\n", name, customer)
-				fmt.Printf("    Date: %s | Status: %s%s%s | Total: %s\n",
+				printf("    Date: %s | Status: %s%s%s | Total: %s\n",
 					date, statusColor, status, Reset, c.FormatCurrency(total))
 			}
 		}
@@ -829,7 +1208,7 @@ func (c *Client) siList(opts siListOptions) error {
 }
 
 func (c *Client) siGet(name string) error {
-	fmt.Printf("%sFetching sales invoice: %s%s\n", Blue, name, Reset)
+	printf("%sFetching sales invoice: %s%s\n", Blue, name, Reset)
 
 	encoded := url.PathEscape(name)
 	result, err := c.Request("GET", "Sales%20Invoice/"+encoded, nil)
@@ -838,16 +1217,21 @@ func (c *Client) siGet(name string) error {
 	}
 
 	if data, ok := result["data"].(map[string]interface{}); ok {
-		fmt.Printf("\n%sSales Invoice: %s%s\n", Cyan, name, Reset)
-
-		fmt.Printf("  Customer: %s\n", data["customer"])
-		fmt.Printf("  Date: %s\n", data["posting_date"])
-		fmt.Printf("  Status: %s\n", data["status"])
-		grandTotal, _ := data["grand_total"].(float64)
-		fmt.Printf("  Total: %s\n", c.FormatCurrency(grandTotal))
+		printf("\n%sSales Invoice: %s%s\n", Cyan, name, Reset)
+
+		printf("  Customer: %s\n", data["customer"])
+		printf("  Date: %s\n", data["posting_date"])
+		printf("  Status: %s\n", data["status"])
+		printf("  Total: %s\n", c.docTotal(data))
+		c.printDocDiscount(data)
+		if repeat := stringField(data, "auto_repeat"); repeat != "" {
+			printf("  Auto Repeat: %s\n", repeat)
+		}
+		printDocAddresses(data)
+		c.printSalesTeam(data)
 
 		if items, ok := data["items"].([]interface{}); ok && len(items) > 0 {
-			fmt.Printf("\n  %sItems:%s\n", Yellow, Reset)
+			printf("\n  %sItems:%s\n", Yellow, Reset)
 			for _, item := range items {
 				if m, ok := item.(map[string]interface{}); ok {
 					itemCode := m["item_code"]
@@ -857,105 +1241,271 @@ func (c *Client) siGet(name string) error {
 					so := m["sales_order"]
 					soStr := ""
 					if so != nil && so != "" {
-						soStr = fmt.Sprintf(" (SO: %s)", so)
+						soStr = tf(" (SO: %s)", so)
 					}
-					fmt.Printf("    - %s: %.0f x %s = %s%s\n", itemCode, qty, c.FormatCurrency(rate), c.FormatCurrency(amount), soStr)
+					fmt.Printf("    - %s%s: %.0f x %s = %s%s\n", itemCode, customerCodeSuffix(m), qty, c.formatDoc(data, rate), c.formatDoc(data, amount), soStr)
 				}
 			}
 		}
+
+		c.printDocCharges(data)
+		printDocTerms(data)
+		c.printActivity("Sales Invoice", name)
 	}
 	return nil
 }
 
-func (c *Client) siCreateFromSO(soName string) error {
-	fmt.Printf("%sCreating sales invoice from SO: %s%s\n", Blue, soName, Reset)
+func (c *Client) siCreateFromSO(soName string, team []map[string]interface{}, posting postingOptions, discount docDiscount, shipping string, cur docCurrency, terms docTerms, addr docAddresses) error {
+	printf("%sCreating sales invoice from SO: %s%s\n", Blue, soName, Reset)
+	c.printPosting(posting)
+
+	body, err := c.siFromSO(soName, team, posting)
+	if err != nil {
+		return err
+	}
+	if err := c.applyDiscount(body, discount); err != nil {
+		return err
+	}
+	if err := c.applyShippingRule(body, shipping); err != nil {
+		return err
+	}
+	if cur, err = c.overrideCurrency(body, cur); err != nil {
+		return err
+	}
+
+	if err := c.applyAddresses(body, addr); err != nil {
+		return err
+	}
+	if terms, err = c.applyTerms(body, "Sales Invoice", terms, true); err != nil {
+		return err
+	}
 
+	result, err := c.Request("POST", "Sales%20Invoice", body)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].(map[string]interface{}); ok {
+		siName := data["name"]
+		printf("%s✓ Sales Invoice created: %s%s\n", Green, siName, Reset)
+		printf("  From SO: %s\n", soName)
+		printf("  Items: %d\n", len(body["items"].([]map[string]interface{})))
+		team, _ := body["sales_team"].([]map[string]interface{})
+		for _, member := range team {
+			printf("  Sales Person: %s (%.0f%%)\n", member["sales_person"], member["allocated_percentage"])
+		}
+		c.printCurrency(cur)
+		c.printDiscount(discount)
+		printShippingRule(stringField(body, "shipping_rule"))
+		printf("  Status: Draft\n")
+		printAddresses(addr)
+		printTerms(terms)
+		c.finishRemarks("Sales Invoice", stringField(data, "name"), terms)
+		printf("  Use 'erp-cli si submit %s' to submit\n", siName)
+	}
+
+	return nil
+}
+
+// siFromSO builds the body of a Sales Invoice billing a submitted SO. The
+// sales team defaults to the one on the SO.
+func (c *Client) siFromSO(soName string, team []map[string]interface{}, posting postingOptions) (map[string]interface{}, error) {
 	encoded := url.PathEscape(soName)
 	result, err := c.Request("GET", "Sales%20Order/"+encoded, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	soData, ok := result["data"].(map[string]interface{})
 	if !ok {
-		return fmt.Errorf("sales order not found")
+		return nil, errorf("sales order not found")
 	}
 
 	docStatus, _ := soData["docstatus"].(float64)
 	if docStatus != 1 {
-		return fmt.Errorf("sales order must be submitted first")
+		return nil, errorf("sales order must be submitted first")
 	}
 
 	company, err := c.GetCompany()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	today := time.Now().Format("2006-01-02")
-
 	var invoiceItems []map[string]interface{}
 	if items, ok := soData["items"].([]interface{}); ok {
 		for _, item := range items {
 			if m, ok := item.(map[string]interface{}); ok {
 				invoiceItems = append(invoiceItems, map[string]interface{}{
-					"item_code":   m["item_code"],
-					"qty":         m["qty"],
-					"rate":        m["rate"],
-					"sales_order": soName,
-					"so_detail":   m["name"],
+					"item_code":          m["item_code"],
+					"customer_item_code": m["customer_item_code"],
+					"qty":                m["qty"],
+					"rate":               m["rate"],
+					"sales_order":        soName,
+					"so_detail":          m["name"],
 				})
 			}
 		}
 	}
 
 	if len(invoiceItems) == 0 {
-		return fmt.Errorf("no items found in sales order")
+		return nil, errorf("no items found in sales order")
+	}
+
+	if len(team) == 0 {
+		team = copySalesTeam(soData)
 	}
 
 	body := map[string]interface{}{
-		"customer":     soData["customer"],
-		"posting_date": today,
-		"company":      company,
-		"items":        invoiceItems,
+		"customer": soData["customer"],
+		"company":  company,
+		"items":    invoiceItems,
+	}
+	if err := c.applyPosting(body, posting, true); err != nil {
+		return nil, err
 	}
+	if len(team) > 0 {
+		body["sales_team"] = team
+	}
+	if rule := stringField(soData, "shipping_rule"); rule != "" {
+		body["shipping_rule"] = rule
+	}
+	copyOrderCurrency(body, soData)
+	copyOrderTerms(body, soData)
+	copyOrderAddresses(body, soData)
+	return body, nil
+}
+
+func (c *Client) siSubmit(name string, notify bool) error {
+	printf("%sSubmitting sales invoice: %s%s\n", Blue, name, Reset)
 
-	result, err = c.Request("POST", "Sales%20Invoice", body)
+	err := c.submitDocument("Sales Invoice", name)
 	if err != nil {
 		return err
 	}
 
-	if data, ok := result["data"].(map[string]interface{}); ok {
-		siName := data["name"]
-		fmt.Printf("%s✓ Sales Invoice created: %s%s\n", Green, siName, Reset)
-		fmt.Printf("  From SO: %s\n", soName)
-		fmt.Printf("  Items: %d\n", len(invoiceItems))
-		fmt.Printf("  Status: Draft\n")
-		fmt.Printf("  Use 'erp-cli si submit %s' to submit\n", siName)
+	printf("%s✓ Sales Invoice submitted: %s%s\n", Green, name, Reset)
+	if notify {
+		c.notifyCustomer("Sales Invoice", name)
 	}
-
 	return nil
 }
 
-func (c *Client) siSubmit(name string) error {
-	fmt.Printf("%sSubmitting sales invoice: %s%s\n", Blue, name, Reset)
+func (c *Client) siCancel(name string, opts cancelOptions) error {
+	printf("%sCancelling sales invoice: %s%s\n", Blue, name, Reset)
 
-	err := c.submitDocument("Sales Invoice", name)
+	err := c.cancelWithChain("Sales Invoice", name, opts)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("%s✓ Sales Invoice submitted: %s%s\n", Green, name, Reset)
+	printf("%s✓ Sales Invoice cancelled: %s%s\n", Green, name, Reset)
 	return nil
 }
 
-func (c *Client) siCancel(name string) error {
-	fmt.Printf("%sCancelling sales invoice: %s%s\n", Blue, name, Reset)
+// =============================================================================
+// SALES TEAM
+// =============================================================================
 
-	err := c.cancelDocument("Sales Invoice", name)
-	if err != nil {
-		return err
+// parseSalesTeam collects --sales-person=Name[:pct] flags into Sales Team rows.
+// Members without an explicit percentage share the remaining allocation equally.
+func parseSalesTeam(args []string) ([]map[string]interface{}, error) {
+	var specs []string
+	for _, arg := range args {
+		if len(arg) > 15 && arg[:15] == "--sales-person=" {
+			specs = append(specs, arg[15:])
+		}
 	}
+	return buildSalesTeam(specs)
+}
 
-	fmt.Printf("%s✓ Sales Invoice cancelled: %s%s\n", Green, name, Reset)
-	return nil
+// buildSalesTeam converts "Name[:pct]" specs into Sales Team child rows
+func buildSalesTeam(specs []string) ([]map[string]interface{}, error) {
+	if len(specs) == 0 {
+		return nil, nil
+	}
+
+	names := make([]string, len(specs))
+	pcts := make([]float64, len(specs))
+	allocated := 0.0
+	unallocated := 0
+
+	for i, spec := range specs {
+		names[i] = spec
+		pcts[i] = -1
+		if idx := strings.LastIndex(spec, ":"); idx > 0 {
+			pct, err := strconv.ParseFloat(spec[idx+1:], 64)
+			if err != nil {
+				return nil, errorf("invalid allocation for sales person: %s", spec)
+			}
+			names[i] = spec[:idx]
+			pcts[i] = pct
+			allocated += pct
+		} else {
+			unallocated++
+		}
+	}
+
+	if unallocated > 0 {
+		if allocated >= 100 {
+			return nil, errorf("no allocation left for sales persons without a percentage")
+		}
+		share := (100 - allocated) / float64(unallocated)
+		for i := range pcts {
+			if pcts[i] < 0 {
+				pcts[i] = share
+			}
+		}
+		allocated = 100
+	}
+
+	if allocated < 99.99 || allocated > 100.01 {
+		return nil, errorf("sales team allocation must total 100%% (got %.2f%%)", allocated)
+	}
+
+	var team []map[string]interface{}
+	for i, name := range names {
+		team = append(team, map[string]interface{}{
+			"sales_person":         name,
+			"allocated_percentage": pcts[i],
+		})
+	}
+	return team, nil
+}
+
+// copySalesTeam returns the sales team rows of a document for reuse on another
+func copySalesTeam(doc map[string]interface{}) []map[string]interface{} {
+	var team []map[string]interface{}
+	if rows, ok := doc["sales_team"].([]interface{}); ok {
+		for _, row := range rows {
+			if m, ok := row.(map[string]interface{}); ok {
+				team = append(team, map[string]interface{}{
+					"sales_person":         m["sales_person"],
+					"allocated_percentage": m["allocated_percentage"],
+				})
+			}
+		}
+	}
+	return team
+}
+
+// printSalesTeam prints the Sales Team section of a SO/SI
+func (c *Client) printSalesTeam(doc map[string]interface{}) {
+	rows, ok := doc["sales_team"].([]interface{})
+	if !ok || len(rows) == 0 {
+		return
+	}
+
+	printf("\n  %sSales Team:%s\n", Yellow, Reset)
+	for _, row := range rows {
+		if m, ok := row.(map[string]interface{}); ok {
+			pct, _ := m["allocated_percentage"].(float64)
+			amount, _ := m["allocated_amount"].(float64)
+			incentives, _ := m["incentives"].(float64)
+			fmt.Printf("    - %s: %.0f%% (%s", m["sales_person"], pct, c.FormatCurrency(amount))
+			if incentives > 0 {
+				printf(", commission %s", c.FormatCurrency(incentives))
+			}
+			fmt.Println(")")
+		}
+	}
 }
@@ -3,34 +3,40 @@ package erp
 import (
 	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // CmdSerial handles serial number commands
 func (c *Client) CmdSerial(args []string) error {
 	if len(args) == 0 {
-		fmt.Println("Usage: erp-cli serial <subcommand> [args...]")
-		fmt.Println("Subcommands: create, list, get, create-batch")
+		fmt.Println(T("Usage: erp-cli serial <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: create, list, get, find, history, set-warranty, create-batch"))
 		fmt.Println()
-		fmt.Println("Examples:")
-		fmt.Println("  erp-cli serial create SN-CPU-001 CPU-LGA1700-I7")
-		fmt.Println("  erp-cli serial create SN-CPU-001 CPU-LGA1700-I7 --supplier=\"Intel Dist\"")
-		fmt.Println("  erp-cli serial list CPU-LGA1700-I7")
-		fmt.Println("  erp-cli serial get SN-CPU-001")
-		fmt.Println("  erp-cli serial create-batch CPU-LGA1700-I7 SN-CPU 1 10")
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli serial create SN-CPU-001 CPU-LGA1700-I7"))
+		fmt.Println(T("  erp-cli serial create SN-CPU-001 CPU-LGA1700-I7 --supplier=\"Intel Dist\""))
+		fmt.Println(T("  erp-cli serial list CPU-LGA1700-I7"))
+		fmt.Println(T("  erp-cli serial get SN-CPU-001"))
+		fmt.Println(T("  erp-cli serial find CPU-00"))
+		fmt.Println(T("  erp-cli serial history SN-CPU-001"))
+		fmt.Println(T("  erp-cli serial set-warranty SN-CPU-001 2027-06-30"))
+		fmt.Println(T("  erp-cli serial create-batch CPU-LGA1700-I7 SN-CPU 1 10"))
 		return nil
 	}
 
 	switch args[0] {
 	case "create":
 		if len(args) < 3 {
-			return fmt.Errorf("usage: erp-cli serial create <serial_no> <item_code> [--supplier=X] [--batch=X]")
+			return errorf("usage: erp-cli serial create <serial_no> <item_code> [--supplier=X] [--batch=X]")
 		}
 		opts := parseSerialOptions(args[3:])
 		return c.serialCreate(args[1], args[2], opts)
 	case "list":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli serial list <item_code> [--warehouse=X]")
+			return errorf("usage: erp-cli serial list <item_code> [--warehouse=X]")
 		}
 		warehouse := ""
 		for _, arg := range args[2:] {
@@ -41,24 +47,39 @@ func (c *Client) CmdSerial(args []string) error {
 		return c.serialList(args[1], warehouse)
 	case "get":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli serial get <serial_no>")
+			return errorf("usage: erp-cli serial get <serial_no>")
 		}
 		return c.serialGet(args[1])
+	case "find":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli serial find <serial_no fragment>")
+		}
+		return c.serialFind(args[1])
+	case "history":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli serial history <serial_no>")
+		}
+		return c.serialHistory(args[1])
+	case "set-warranty":
+		if len(args) < 3 {
+			return errorf("usage: erp-cli serial set-warranty <serial_no> <YYYY-MM-DD>")
+		}
+		return c.serialSetWarranty(args[1], args[2])
 	case "create-batch":
 		if len(args) < 5 {
-			return fmt.Errorf("usage: erp-cli serial create-batch <item_code> <prefix> <start> <count>")
+			return errorf("usage: erp-cli serial create-batch <item_code> <prefix> <start> <count>")
 		}
 		start, err := strconv.Atoi(args[3])
 		if err != nil {
-			return fmt.Errorf("invalid start number: %s", args[3])
+			return errorf("invalid start number: %s", args[3])
 		}
 		count, err := strconv.Atoi(args[4])
 		if err != nil {
-			return fmt.Errorf("invalid count: %s", args[4])
+			return errorf("invalid count: %s", args[4])
 		}
 		return c.serialCreateBatch(args[1], args[2], start, count)
 	default:
-		return fmt.Errorf("unknown serial subcommand: %s", args[0])
+		return errorf("unknown serial subcommand: %s", args[0])
 	}
 }
 
@@ -81,8 +102,8 @@ func parseSerialOptions(args []string) serialOptions {
 }
 
 func (c *Client) serialCreate(serialNo, itemCode string, opts serialOptions) error {
-	fmt.Printf("%sCreating serial number: %s%s\n", Blue, serialNo, Reset)
-	fmt.Printf("  Item: %s\n", itemCode)
+	printf("%sCreating serial number: %s%s\n", Blue, serialNo, Reset)
+	printf("  Item: %s\n", itemCode)
 
 	body := map[string]interface{}{
 		"serial_no": serialNo,
@@ -91,12 +112,12 @@ func (c *Client) serialCreate(serialNo, itemCode string, opts serialOptions) err
 
 	if opts.supplier != "" {
 		body["supplier"] = opts.supplier
-		fmt.Printf("  Supplier: %s\n", opts.supplier)
+		printf("  Supplier: %s\n", opts.supplier)
 	}
 
 	if opts.batch != "" {
 		body["batch_no"] = opts.batch
-		fmt.Printf("  Batch: %s\n", opts.batch)
+		printf("  Batch: %s\n", opts.batch)
 	}
 
 	_, err := c.Request("POST", "Serial%20No", body)
@@ -104,12 +125,12 @@ func (c *Client) serialCreate(serialNo, itemCode string, opts serialOptions) err
 		return err
 	}
 
-	fmt.Printf("%s✓ Serial number created: %s%s\n", Green, serialNo, Reset)
+	printf("%s✓ Serial number created: %s%s\n", Green, serialNo, Reset)
 	return nil
 }
 
 func (c *Client) serialList(itemCode, warehouse string) error {
-	fmt.Printf("%sFetching serial numbers for: %s%s\n", Blue, itemCode, Reset)
+	printf("%sFetching serial numbers for: %s%s\n", Blue, itemCode, Reset)
 
 	filters := [][]interface{}{
 		{"item_code", "=", itemCode},
@@ -129,11 +150,11 @@ func (c *Client) serialList(itemCode, warehouse string) error {
 
 	if data, ok := result["data"].([]interface{}); ok {
 		if len(data) == 0 {
-			fmt.Printf("%sNo serial numbers found for: %s%s\n", Yellow, itemCode, Reset)
+			printf("%sNo serial numbers found for: %s%s\n", Yellow, itemCode, Reset)
 			return nil
 		}
 
-		fmt.Printf("\n%sSerial Numbers (%d):%s\n", Cyan, len(data), Reset)
+		printf("\n%sSerial Numbers (%d):%s\n", Cyan, len(data), Reset)
 
 		active := make([]map[string]interface{}, 0)
 		delivered := make([]map[string]interface{}, 0)
@@ -154,21 +175,21 @@ func (c *Client) serialList(itemCode, warehouse string) error {
 		}
 
 		if len(active) > 0 {
-			fmt.Printf("\n  %sActive (%d):%s\n", Green, len(active), Reset)
+			printf("\n  %sActive (%d):%s\n", Green, len(active), Reset)
 			for _, m := range active {
 				printSerialLine(m)
 			}
 		}
 
 		if len(delivered) > 0 {
-			fmt.Printf("\n  %sDelivered (%d):%s\n", Yellow, len(delivered), Reset)
+			printf("\n  %sDelivered (%d):%s\n", Yellow, len(delivered), Reset)
 			for _, m := range delivered {
 				printSerialLine(m)
 			}
 		}
 
 		if len(other) > 0 {
-			fmt.Printf("\n  %sOther (%d):%s\n", Blue, len(other), Reset)
+			printf("\n  %sOther (%d):%s\n", Blue, len(other), Reset)
 			for _, m := range other {
 				printSerialLine(m)
 			}
@@ -188,13 +209,13 @@ func printSerialLine(m map[string]interface{}) {
 		line += fmt.Sprintf(" @ %s", warehouse)
 	}
 	if purchaseDate != nil && purchaseDate != "" {
-		line += fmt.Sprintf(" (purchased: %s)", purchaseDate)
+		line += tf(" (purchased: %s)", purchaseDate)
 	}
 	fmt.Println(line)
 }
 
 func (c *Client) serialGet(serialNo string) error {
-	fmt.Printf("%sFetching serial number: %s%s\n", Blue, serialNo, Reset)
+	printf("%sFetching serial number: %s%s\n", Blue, serialNo, Reset)
 
 	encoded := url.PathEscape(serialNo)
 	result, err := c.Request("GET", "Serial%20No/"+encoded, nil)
@@ -203,7 +224,7 @@ func (c *Client) serialGet(serialNo string) error {
 	}
 
 	if data, ok := result["data"].(map[string]interface{}); ok {
-		fmt.Printf("\n%sSerial Number: %s%s\n", Cyan, serialNo, Reset)
+		printf("\n%sSerial Number: %s%s\n", Cyan, serialNo, Reset)
 
 		fields := []struct {
 			key   string
@@ -225,15 +246,225 @@ func (c *Client) serialGet(serialNo string) error {
 				fmt.Printf("  %s: %v\n", f.label, val)
 			}
 		}
+
+		if customer, doc := c.serialDeliveredTo(serialNo, data); customer != "" {
+			printf("  Delivered To: %s (%s)\n", customer, doc)
+		}
+	}
+
+	return nil
+}
+
+// serialFind lists the serial numbers containing a fragment, so a support
+// call can start from a partly read label
+func (c *Client) serialFind(fragment string) error {
+	printf("%sSearching serial numbers: %s%s\n", Blue, fragment, Reset)
+
+	filters, err := encodeFilters([][]interface{}{{"name", "like", "%" + fragment + "%"}})
+	if err != nil {
+		return err
+	}
+	result, err := c.Request("GET", "Serial%20No?filters="+filters+"&fields=[\"name\",\"item_code\",\"status\",\"warehouse\",\"warranty_expiry_date\"]&order_by=name%20asc&limit_page_length=50", nil)
+	if err != nil {
+		return err
+	}
+
+	data, _ := result["data"].([]interface{})
+	if len(data) == 0 {
+		printf("%sNo serial numbers match: %s%s\n", Yellow, fragment, Reset)
+		return nil
+	}
+
+	printf("\n%sSerial Numbers (%d):%s\n", Cyan, len(data), Reset)
+	for _, row := range data {
+		m, ok := row.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		line := fmt.Sprintf("  • %s%s%s  %s  [%s]", Green, stringField(m, "name"), Reset, stringField(m, "item_code"), stringField(m, "status"))
+		if warehouse := stringField(m, "warehouse"); warehouse != "" {
+			line += " @ " + warehouse
+		}
+		if warranty := stringField(m, "warranty_expiry_date"); warranty != "" {
+			line += " (warranty: " + warranty + ")"
+		}
+		fmt.Println(line)
+	}
+	if len(data) == 50 {
+		printf("%s  Showing the first 50: type more of the serial number%s\n", Yellow, Reset)
+	}
+	return nil
+}
+
+// serialMove is one movement of a serial number in or out of a warehouse
+type serialMove struct {
+	date        string // Posting date and time
+	voucherType string
+	voucherNo   string
+	warehouse   string
+	in          bool
+}
+
+// serialMovements returns the movements of a serial number, oldest first.
+// They come from the Stock Ledger, which lists the serial numbers of each
+// entry, and from the Serial and Batch Bundles of newer ERPNext versions.
+func (c *Client) serialMovements(serialNo string) ([]serialMove, error) {
+	var moves []serialMove
+	seen := map[string]bool{}
+	add := func(move serialMove) {
+		key := move.voucherType + "\x00" + move.voucherNo + "\x00" + move.warehouse
+		if !seen[key] {
+			seen[key] = true
+			moves = append(moves, move)
+		}
+	}
+
+	filters, err := encodeFilters([][]interface{}{{"serial_no", "like", "%" + serialNo + "%"}, {"is_cancelled", "=", 0}})
+	if err != nil {
+		return nil, err
+	}
+	fields := url.QueryEscape(`["posting_date","posting_time","voucher_type","voucher_no","warehouse","actual_qty","serial_no"]`)
+	result, err := c.Request("GET", "Stock%20Ledger%20Entry?limit_page_length=0&filters="+filters+"&fields="+fields, nil)
+	if err != nil {
+		return nil, err
+	}
+	rows, _ := result["data"].([]interface{})
+	for _, row := range rows {
+		m, ok := row.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		// The like filter also matches longer serial numbers
+		listed := false
+		for _, sn := range strings.Split(stringField(m, "serial_no"), "\n") {
+			listed = listed || strings.TrimSpace(sn) == serialNo
+		}
+		if !listed {
+			continue
+		}
+		qty, _ := m["actual_qty"].(float64)
+		add(serialMove{
+			date:        stringField(m, "posting_date") + " " + stringField(m, "posting_time"),
+			voucherType: stringField(m, "voucher_type"),
+			voucherNo:   stringField(m, "voucher_no"),
+			warehouse:   stringField(m, "warehouse"),
+			in:          qty > 0,
+		})
+	}
+
+	// Older versions have no bundles: their movements are all in the ledger
+	filters, err = encodeFilters([][]interface{}{{"Serial and Batch Entry", "serial_no", "=", serialNo}, {"docstatus", "=", 1}, {"is_cancelled", "=", 0}})
+	if err != nil {
+		return nil, err
+	}
+	fields = url.QueryEscape(`["posting_date","posting_time","voucher_type","voucher_no","warehouse","type_of_transaction"]`)
+	if result, err := c.Request("GET", "Serial%20and%20Batch%20Bundle?limit_page_length=0&filters="+filters+"&fields="+fields, nil); err == nil {
+		rows, _ := result["data"].([]interface{})
+		for _, row := range rows {
+			if m, ok := row.(map[string]interface{}); ok {
+				add(serialMove{
+					date:        stringField(m, "posting_date") + " " + stringField(m, "posting_time"),
+					voucherType: stringField(m, "voucher_type"),
+					voucherNo:   stringField(m, "voucher_no"),
+					warehouse:   stringField(m, "warehouse"),
+					in:          stringField(m, "type_of_transaction") == "Inward",
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(moves, func(i, j int) bool { return moves[i].date < moves[j].date })
+	return moves, nil
+}
+
+// serialDeliveredTo returns the customer a serial number went to and the
+// document it went with: the Serial No's own customer if the version keeps
+// one, or the customer of its last delivery
+func (c *Client) serialDeliveredTo(serialNo string, data map[string]interface{}) (string, string) {
+	if customer := stringField(data, "customer"); customer != "" {
+		return customer, stringField(data, "delivery_document_no")
+	}
+	if stringField(data, "status") != "Delivered" {
+		return "", ""
+	}
+	moves, err := c.serialMovements(serialNo)
+	if err != nil {
+		return "", ""
+	}
+	for i := len(moves) - 1; i >= 0; i-- {
+		move := moves[i]
+		if move.in || (move.voucherType != "Delivery Note" && move.voucherType != "Sales Invoice") {
+			continue
+		}
+		result, err := c.Request("GET", url.PathEscape(move.voucherType)+"/"+url.PathEscape(move.voucherNo), nil)
+		if err != nil {
+			return "", ""
+		}
+		doc, _ := result["data"].(map[string]interface{})
+		return stringField(doc, "customer"), move.voucherNo
 	}
+	return "", ""
+}
+
+// serialHistory prints where a serial number has been, oldest first
+func (c *Client) serialHistory(serialNo string) error {
+	printf("%sFetching history of serial number: %s%s\n", Blue, serialNo, Reset)
 
+	result, err := c.Request("GET", "Serial%20No/"+url.PathEscape(serialNo), nil)
+	if err != nil {
+		return err
+	}
+	data, _ := result["data"].(map[string]interface{})
+
+	moves, err := c.serialMovements(serialNo)
+	if err != nil {
+		return err
+	}
+
+	printf("\n%sSerial Number: %s%s (%s, %s)\n", Cyan, serialNo, Reset, stringField(data, "item_code"), stringField(data, "status"))
+	if len(moves) == 0 {
+		printf("%s  No stock movements recorded%s\n", Yellow, Reset)
+	}
+	for _, move := range moves {
+		direction := Green + "in " + Reset + " → "
+		if !move.in {
+			direction = Red + "out" + Reset + " ← "
+		}
+		fmt.Printf("  %s  %s%s  %s %s\n", strings.TrimSpace(move.date), direction, move.warehouse, move.voucherType, move.voucherNo)
+	}
+
+	if customer, doc := c.serialDeliveredTo(serialNo, data); customer != "" {
+		printf("\n  Delivered To: %s (%s)\n", customer, doc)
+	}
+	if warranty := stringField(data, "warranty_expiry_date"); warranty != "" {
+		printf("  Warranty Expiry: %s\n", warranty)
+	}
+	return nil
+}
+
+// serialSetWarranty sets the warranty expiry date of a serial number
+func (c *Client) serialSetWarranty(serialNo, date string) error {
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+		return errorf("invalid warranty date (expected YYYY-MM-DD): %s", date)
+	}
+	printf("%sSetting warranty of %s to %s%s\n", Blue, serialNo, date, Reset)
+
+	body := map[string]interface{}{"warranty_expiry_date": date}
+	if _, err := c.Request("PUT", "Serial%20No/"+url.PathEscape(serialNo), body); err != nil {
+		return err
+	}
+
+	printf("%s✓ Warranty expiry of %s: %s%s\n", Green, serialNo, date, Reset)
+	if date < c.Today() {
+		printf("%s  The warranty has already expired%s\n", Yellow, Reset)
+	}
 	return nil
 }
 
 func (c *Client) serialCreateBatch(itemCode, prefix string, start, count int) error {
-	fmt.Printf("%sCreating %d serial numbers...%s\n", Blue, count, Reset)
-	fmt.Printf("  Item: %s\n", itemCode)
-	fmt.Printf("  Pattern: %s%03d - %s%03d\n", prefix, start, prefix, start+count-1)
+	printf("%sCreating %d serial numbers...%s\n", Blue, count, Reset)
+	printf("  Item: %s\n", itemCode)
+	printf("  Pattern: %s%03d - %s%03d\n", prefix, start, prefix, start+count-1)
 
 	created := 0
 	failed := 0
@@ -249,13 +480,13 @@ func (c *Client) serialCreateBatch(itemCode, prefix string, start, count int) er
 		_, err := c.Request("POST", "Serial%20No", body)
 		if err != nil {
 			failed++
-			fmt.Printf("  %s✗ Failed: %s (%s)%s\n", Red, serialNo, err, Reset)
+			printf("  %s✗ Failed: %s (%s)%s\n", Red, serialNo, err, Reset)
 		} else {
 			created++
-			fmt.Printf("  %s✓ Created: %s%s\n", Green, serialNo, Reset)
+			printf("  %s✓ Created: %s%s\n", Green, serialNo, Reset)
 		}
 	}
 
-	fmt.Printf("\n%sSummary: %d created, %d failed%s\n", Cyan, created, failed, Reset)
+	printf("\n%sSummary: %d created, %d failed%s\n", Cyan, created, failed, Reset)
 	return nil
 }
@@ -0,0 +1,69 @@
+package erp
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CmdSeries handles naming series commands
+func (c *Client) CmdSeries(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli series <subcommand>"))
+		fmt.Println(T("Subcommands: list"))
+		fmt.Println()
+		fmt.Println(T("New documents take the doctype's default naming series unless created"))
+		fmt.Println(T("with --naming-series=<series>, one of those listed for the doctype."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli series list si"))
+		fmt.Println(T("  erp-cli si create-from-so SAL-ORD-2025-00001 --naming-series=SINV-BIO-.YY.-"))
+		return nil
+	}
+
+	switch args[0] {
+	case "list":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli series list <doctype>")
+		}
+		return c.seriesList(resolveDoctype(args[1]))
+	default:
+		return errorf("unknown series subcommand: %s", args[0])
+	}
+}
+
+// seriesList lists the naming series a doctype offers, marking the default
+func (c *Client) seriesList(doctype string) error {
+	fields, err := c.docFields(doctype)
+	if err != nil {
+		return err
+	}
+	field, ok := fields["naming_series"]
+	if !ok {
+		return errorf("%s is not named by series", doctype)
+	}
+
+	printf("%sNaming series for %s:%s\n", Cyan, doctype, Reset)
+	var options []string
+	for _, series := range strings.Split(stringField(field, "options"), "\n") {
+		if series = strings.TrimSpace(series); series != "" {
+			options = append(options, series)
+		}
+	}
+	if len(options) == 0 {
+		printf("%sNo naming series set up%s\n", Yellow, Reset)
+		return nil
+	}
+	// Without a default, new documents take the first series
+	defaultSeries := stringField(field, "default")
+	if defaultSeries == "" {
+		defaultSeries = options[0]
+	}
+	for _, series := range options {
+		if series == defaultSeries {
+			printf("  %s %s(default)%s\n", series, Green, Reset)
+		} else {
+			fmt.Printf("  %s\n", series)
+		}
+	}
+	return nil
+}
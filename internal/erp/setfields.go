@@ -0,0 +1,229 @@
+package erp
+
+import (
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Field sets: --set field=value on any create or update command fills
+// fields the command has no flag for, typically a site's custom fields.
+// They go into every document of the command's doctype (the doctype of the
+// first document it writes) that it creates or saves, checked against the
+// doctype's meta and typed from it. --set "Doctype:field=value" targets
+// another doctype the command writes, such as the invoice of a flow.
+// --naming-series=X is the naming_series field of the new documents.
+
+// ParseFieldSets takes the --set field=value arguments (repeatable, also
+// --set=field=value) and --naming-series out of a command line, keeping
+// them for the documents the command writes
+func (c *Client) ParseFieldSets(args []string) ([]string, error) {
+	var rest []string
+	given := false
+	for i := 0; i < len(args); i++ {
+		set := ""
+		switch {
+		case args[i] == "--set":
+			if i+1 >= len(args) {
+				return nil, errorf("--set needs field=value")
+			}
+			set = args[i+1]
+			i++
+		case len(args[i]) > 6 && args[i][:6] == "--set=":
+			set = args[i][6:]
+		case len(args[i]) > 16 && args[i][:16] == "--naming-series=":
+			set = "naming_series=" + args[i][16:]
+		default:
+			rest = append(rest, args[i])
+			continue
+		}
+
+		field, value, ok := strings.Cut(set, "=")
+		doctype := ""
+		if target, name, qualified := strings.Cut(field, ":"); qualified {
+			doctype, field = resolveDoctype(strings.TrimSpace(target)), name
+		}
+		if !ok || field == "" {
+			return nil, errorf("invalid --set %q (expected field=value)", set)
+		}
+		if c.fieldSets == nil {
+			c.fieldSets = map[string]map[string]string{}
+		}
+		if c.fieldSets[doctype] == nil {
+			c.fieldSets[doctype] = map[string]string{}
+		}
+		c.fieldSets[doctype][field] = value
+		given = true
+	}
+	if given && !writesDocuments(rest) {
+		return nil, errorf("--set and --naming-series only apply to commands that create or save documents")
+	}
+	return rest, nil
+}
+
+// writesDocuments tells whether a command creates or saves documents, and
+// so can take --set
+func writesDocuments(args []string) bool {
+	if len(args) > 0 && (args[0] == "flow" || args[0] == "import") {
+		return true
+	}
+	return offlineQueueable(args) || (len(args) > 1 && args[1] == "generate")
+}
+
+// applyFieldSets sets the --set fields on a document being created or
+// saved; an unknown field or invalid value stops the request
+func (c *Client) applyFieldSets(method, endpoint string, body interface{}) error {
+	doc, ok := body.(map[string]interface{})
+	if len(c.fieldSets) == 0 || !ok {
+		return nil
+	}
+	path, _, _ := strings.Cut(endpoint, "?")
+	// Creates POST to <Doctype>, saves PUT to <Doctype>/<name>
+	if parts := strings.Count(path, "/"); (method == "POST" && parts != 0) || (method == "PUT" && parts != 1) {
+		return nil
+	}
+	doctype := endpointDoctype(endpoint)
+
+	c.fieldSetsMu.Lock()
+	// The first document written is the command's; a field also set for
+	// its doctype by name keeps that value
+	if sets, ok := c.fieldSets[""]; ok {
+		delete(c.fieldSets, "")
+		if c.fieldSets[doctype] == nil {
+			c.fieldSets[doctype] = map[string]string{}
+		}
+		for name, value := range sets {
+			if _, ok := c.fieldSets[doctype][name]; !ok {
+				c.fieldSets[doctype][name] = value
+			}
+		}
+	}
+	sets := c.fieldSets[doctype]
+	created := c.fieldSetsCreated[doctype]
+	c.fieldSetsMu.Unlock()
+	if len(sets) == 0 {
+		return nil
+	}
+	// A document created with its series is saved without it
+	if _, ok := sets["naming_series"]; ok && method == "PUT" && !created {
+		return errorf("--naming-series only applies to new documents")
+	}
+
+	fields, err := c.setFieldsMeta(doctype)
+	if err != nil {
+		return errorf("cannot check --set fields against %s: %w", doctype, err)
+	}
+	names := make([]string, 0, len(sets))
+	for name := range sets {
+		if name != "naming_series" || method == "POST" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		field, ok := fields[name]
+		if !ok && name == "naming_series" {
+			return errorf("--naming-series: %s is not named by series", doctype)
+		}
+		if !ok {
+			return errorf("--set %s: %s has no field %q", name, doctype, name)
+		}
+		value, err := fieldValue(field, sets[name])
+		if err != nil && name == "naming_series" {
+			return errorf("--naming-series: %w (see 'erp-cli series list %s')", err, doctype)
+		}
+		if err != nil {
+			return errorf("--set %s: %w", name, err)
+		}
+		doc[name] = value
+	}
+
+	if method == "POST" {
+		c.fieldSetsMu.Lock()
+		if c.fieldSetsCreated == nil {
+			c.fieldSetsCreated = map[string]bool{}
+		}
+		c.fieldSetsCreated[doctype] = true
+		c.fieldSetsMu.Unlock()
+	}
+	return nil
+}
+
+// setFieldsMeta returns the fields of a doctype written with --set, read
+// once per run
+func (c *Client) setFieldsMeta(doctype string) (map[string]map[string]interface{}, error) {
+	c.fieldSetsMu.Lock()
+	fields, ok := c.fieldSetsMeta[doctype]
+	c.fieldSetsMu.Unlock()
+	if ok {
+		return fields, nil
+	}
+	fields, err := c.docFields(doctype)
+	if err != nil {
+		return nil, err
+	}
+	c.fieldSetsMu.Lock()
+	if c.fieldSetsMeta == nil {
+		c.fieldSetsMeta = map[string]map[string]map[string]interface{}{}
+	}
+	c.fieldSetsMeta[doctype] = fields
+	c.fieldSetsMu.Unlock()
+	return fields, nil
+}
+
+// docFields returns the fields of a doctype from its meta, by fieldname
+func (c *Client) docFields(doctype string) (map[string]map[string]interface{}, error) {
+	result, err := c.CallMethod("GET", "frappe.desk.form.load.getdoctype?doctype="+url.QueryEscape(doctype), nil)
+	if err != nil {
+		return nil, err
+	}
+	docs, _ := result["docs"].([]interface{})
+	for _, d := range docs {
+		meta, ok := d.(map[string]interface{})
+		if !ok || stringField(meta, "name") != doctype {
+			continue
+		}
+		fields := map[string]map[string]interface{}{}
+		for _, field := range docRows(meta["fields"]) {
+			fields[stringField(field, "fieldname")] = field
+		}
+		return fields, nil
+	}
+	return nil, errorf("no meta returned")
+}
+
+// fieldValue converts a --set value to its field's type
+func fieldValue(field map[string]interface{}, value string) (interface{}, error) {
+	fieldtype := stringField(field, "fieldtype")
+	switch fieldtype {
+	case "Int", "Check":
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, errorf("%s is a whole number field, got %q", fieldtype, value)
+		}
+		return n, nil
+	case "Float", "Currency", "Percent":
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, errorf("%s is a number field, got %q", fieldtype, value)
+		}
+		return n, nil
+	case "Select":
+		options := strings.Split(stringField(field, "options"), "\n")
+		for _, option := range options {
+			if option == value {
+				return value, nil
+			}
+		}
+		return nil, errorf("%q is not one of: %s", value, strings.Join(options, ", "))
+	case "Table", "Table MultiSelect", "Section Break", "Column Break", "Tab Break", "HTML", "Button", "Fold", "Heading":
+		return nil, errorf("a %s field cannot be set", fieldtype)
+	case "Date":
+		if _, err := time.Parse("2006-01-02", value); err != nil {
+			return nil, errorf("invalid date (expected YYYY-MM-DD): %s", value)
+		}
+	}
+	return value, nil
+}
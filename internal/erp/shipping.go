@@ -0,0 +1,90 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// parseShippingRule returns the --shipping-rule of so, dn and si creation
+func parseShippingRule(args []string) string {
+	rule := ""
+	for _, arg := range args {
+		if len(arg) > 16 && arg[:16] == "--shipping-rule=" {
+			rule = arg[16:]
+		}
+	}
+	return rule
+}
+
+// applyShippingRule sets the Shipping Rule of a new sales document. ERPNext
+// adds the freight line to the taxes table on every save, so charges follow
+// the lines added later.
+func (c *Client) applyShippingRule(body map[string]interface{}, rule string) error {
+	if rule == "" {
+		return nil
+	}
+	result, err := c.Request("GET", "Shipping%20Rule/"+url.PathEscape(rule), nil)
+	if err != nil {
+		return errorf("shipping rule not found: %s", rule)
+	}
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return errorf("shipping rule not found: %s", rule)
+	}
+	if disabled, _ := data["disabled"].(float64); disabled == 1 {
+		return errorf("shipping rule %s is disabled", rule)
+	}
+	if kind := stringField(data, "shipping_rule_type"); kind != "" && kind != "Selling" {
+		return errorf("shipping rule %s is for %s, not selling", rule, kind)
+	}
+	body["shipping_rule"] = rule
+	return nil
+}
+
+// printShippingRule prints the shipping rule of a new document
+func printShippingRule(rule string) {
+	if rule != "" {
+		printf("  Shipping Rule: %s (freight added on save)\n", rule)
+	}
+}
+
+// docChargeLines are the shipping rule and the taxes and charges rows
+// (freight included) of a sales document, shared by the get commands and
+// the TUI detail views
+func (c *Client) docChargeLines(data map[string]interface{}) []string {
+	var lines []string
+	if rule := stringField(data, "shipping_rule"); rule != "" {
+		lines = append(lines, tf("  Shipping Rule: %s", rule))
+	}
+	taxes, _ := data["taxes"].([]interface{})
+	if len(taxes) == 0 {
+		return lines
+	}
+	lines = append(lines, T("  Charges:"))
+	for _, t := range taxes {
+		if m, ok := t.(map[string]interface{}); ok {
+			amount, _ := m["tax_amount"].(float64)
+			description := stringField(m, "description")
+			if description == "" {
+				description = stringField(m, "account_head")
+			}
+			lines = append(lines, fmt.Sprintf("    - %s: %s", description, c.formatDoc(data, amount)))
+		}
+	}
+	if total, _ := data["total_taxes_and_charges"].(float64); total != 0 {
+		lines = append(lines, tf("    Total charges: %s", c.formatDoc(data, total)))
+	}
+	return lines
+}
+
+// printDocCharges prints the charge lines of a get command
+func (c *Client) printDocCharges(data map[string]interface{}) {
+	lines := c.docChargeLines(data)
+	if len(lines) == 0 {
+		return
+	}
+	fmt.Println()
+	for _, line := range lines {
+		fmt.Println(line)
+	}
+}
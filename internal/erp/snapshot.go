@@ -0,0 +1,210 @@
+package erp
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+const snapshotsFile = "snapshots.json"
+
+// MetricSnapshot is a dated copy of the key dashboard metrics, stored locally
+// each time the dashboard is loaded so `report diff` can compare periods.
+// Snapshots are kept per site, like the command history.
+type MetricSnapshot struct {
+	Site           string  `json:"site"`
+	Date           string  `json:"date"`
+	Taken          string  `json:"taken"`
+	StockValue     float64 `json:"stock_value"`
+	Receivables    float64 `json:"receivables"`
+	Payables       float64 `json:"payables"`
+	PendingSOs     int     `json:"pending_sos"`
+	PendingPOs     int     `json:"pending_pos"`
+	PendingPOValue float64 `json:"pending_po_value"`
+	UnpaidSIs      int     `json:"unpaid_sis"`
+	UnpaidPIs      int     `json:"unpaid_pis"`
+}
+
+// loadSnapshots returns the snapshots of the site in use
+func (c *Client) loadSnapshots() ([]MetricSnapshot, error) {
+	var all, snapshots []MetricSnapshot
+	if err := loadLocalJSON(snapshotsFile, &all); err != nil {
+		return nil, err
+	}
+	for _, s := range all {
+		if s.Site == c.Config.ERPURL {
+			snapshots = append(snapshots, s)
+		}
+	}
+	return snapshots, nil
+}
+
+// recordSnapshot stores today's metrics, replacing an earlier snapshot of the
+// same day. Incomplete data (fetch errors) is not recorded, and nothing is
+// recorded when a panel feeding the snapshot is disabled.
+func (c *Client) recordSnapshot(data *ReportData) error {
+	if !data.hasPanels(snapshotPanels) {
+		return nil
+	}
+	if len(data.Errors) > 0 {
+		return errorf("dashboard data incomplete")
+	}
+
+	var snapshots []MetricSnapshot
+	if err := loadLocalJSON(snapshotsFile, &snapshots); err != nil {
+		return err
+	}
+
+	snap := MetricSnapshot{
+		Site:           c.Config.ERPURL,
+		Date:           c.Today(),
+		Taken:          c.Timestamp(),
+		StockValue:     data.TotalStockValue,
+		Receivables:    data.TotalReceivables,
+		Payables:       data.TotalPayables,
+		PendingSOs:     data.PendingSOs,
+		PendingPOs:     data.PendingPOs,
+		PendingPOValue: data.PendingPOValue,
+		UnpaidSIs:      data.UnpaidSIs,
+		UnpaidPIs:      data.UnpaidInvoices,
+	}
+
+	replaced := false
+	for i := range snapshots {
+		if snapshots[i].Site == snap.Site && snapshots[i].Date == snap.Date {
+			snapshots[i] = snap
+			replaced = true
+		}
+	}
+	if !replaced {
+		snapshots = append(snapshots, snap)
+	}
+	sort.SliceStable(snapshots, func(i, j int) bool { return snapshots[i].Date < snapshots[j].Date })
+
+	return saveLocalJSON(snapshotsFile, snapshots)
+}
+
+// reportSnapshot takes a snapshot without rendering the dashboard (for cron)
+func (c *Client) reportSnapshot() error {
+	printf("%sTaking metrics snapshot...%s\n", Blue, Reset)
+
+	var panels []dashboardPanel
+	for _, id := range snapshotPanels {
+		p, _ := builtinPanel(id)
+		panels = append(panels, p)
+	}
+	data := c.fetchReportData(panels)
+	if err := c.recordSnapshot(data); err != nil {
+		for _, e := range data.Errors {
+			fmt.Printf("  %s%s%s\n", Red, T(e), Reset)
+		}
+		return err
+	}
+
+	printf("%s✓ Snapshot saved: %s%s\n", Green, c.Today(), Reset)
+	return nil
+}
+
+// snapshotAt returns the latest snapshot taken on or before date
+func snapshotAt(snapshots []MetricSnapshot, date string) (MetricSnapshot, bool) {
+	var found MetricSnapshot
+	ok := false
+	for _, s := range snapshots {
+		if s.Date <= date {
+			found, ok = s, true
+		}
+	}
+	return found, ok
+}
+
+// reportDiff compares the snapshots closest to two dates
+func (c *Client) reportDiff(from, to string) error {
+	for _, d := range []string{from, to} {
+		if d == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", d); err != nil {
+			return errorf("invalid date (expected YYYY-MM-DD): %s", d)
+		}
+	}
+	if to == "" {
+		to = c.Today()
+	}
+
+	snapshots, err := c.loadSnapshots()
+	if err != nil {
+		return err
+	}
+	if len(snapshots) == 0 {
+		return errorf("no snapshots stored yet; run 'erp-cli report' or 'erp-cli report snapshot' regularly")
+	}
+
+	start, ok := snapshotAt(snapshots, from)
+	if !ok {
+		return errorf("no snapshot on or before %s (oldest is %s)", from, snapshots[0].Date)
+	}
+	end, ok := snapshotAt(snapshots, to)
+	if !ok {
+		return errorf("no snapshot on or before %s (oldest is %s)", to, snapshots[0].Date)
+	}
+
+	printf("\n%sChanges %s → %s%s\n", Cyan, start.Date, end.Date, Reset)
+	if start.Date != from || end.Date != to {
+		printf("  %s(nearest snapshots to %s and %s)%s\n", Yellow, from, to, Reset)
+	}
+	fmt.Println()
+
+	money := []struct {
+		label    string
+		from, to float64
+	}{
+		{"Stock value", start.StockValue, end.StockValue},
+		{"Receivables", start.Receivables, end.Receivables},
+		{"Payables", start.Payables, end.Payables},
+		{"Open PO value", start.PendingPOValue, end.PendingPOValue},
+	}
+	for _, m := range money {
+		fmt.Printf("  %-16s %14s → %14s  %s\n", T(m.label),
+			c.FormatCurrency(m.from), c.FormatCurrency(m.to), c.formatDelta(m.from, m.to, true))
+	}
+
+	counts := []struct {
+		label    string
+		from, to int
+	}{
+		{"Open SOs", start.PendingSOs, end.PendingSOs},
+		{"Open POs", start.PendingPOs, end.PendingPOs},
+		{"Unpaid SIs", start.UnpaidSIs, end.UnpaidSIs},
+		{"Unpaid PIs", start.UnpaidPIs, end.UnpaidPIs},
+	}
+	for _, m := range counts {
+		fmt.Printf("  %-16s %14d → %14d  %s\n", T(m.label), m.from, m.to,
+			c.formatDelta(float64(m.from), float64(m.to), false))
+	}
+	return nil
+}
+
+// formatDelta renders the change between two values with its percentage
+func (c *Client) formatDelta(from, to float64, currency bool) string {
+	delta := to - from
+	if delta == 0 {
+		return "="
+	}
+
+	color, sign := Green, "+"
+	if delta < 0 {
+		color, sign = Red, "-"
+	}
+
+	amount := fmt.Sprintf("%g", math.Abs(delta))
+	if currency {
+		amount = c.FormatCurrency(math.Abs(delta))
+	}
+
+	pct := "new"
+	if from != 0 {
+		pct = fmt.Sprintf("%+.1f%%", delta/math.Abs(from)*100)
+	}
+	return fmt.Sprintf("%s%s%s (%s)%s", color, sign, amount, pct, Reset)
+}
@@ -6,27 +6,72 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
 // CmdWarehouse handles warehouse commands
 func (c *Client) CmdWarehouse(args []string) error {
 	if len(args) == 0 {
-		fmt.Println("Usage: erp-cli warehouse <subcommand> [args...]")
-		fmt.Println("Subcommands: list")
+		fmt.Println(T("Usage: erp-cli warehouse <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, tree, create, rename, disable"))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli warehouse list"))
+		fmt.Println(T("  erp-cli warehouse tree"))
+		fmt.Println(T("  erp-cli warehouse create \"Shelf A\" --parent=\"Stores - AC\""))
+		fmt.Println(T("  erp-cli warehouse create \"Zone 1\" --group"))
+		fmt.Println(T("  erp-cli warehouse rename \"Shelf A - AC\" \"Shelf B - AC\""))
+		fmt.Println(T("  erp-cli warehouse disable \"Shelf B - AC\""))
 		return nil
 	}
 
 	switch args[0] {
 	case "list":
 		return c.warehouseList()
+	case "tree":
+		return c.warehouseTree()
+	case "create":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli warehouse create <name> [--parent=X] [--group]")
+		}
+		opts := parseWarehouseOptions(args[2:])
+		return c.warehouseCreate(args[1], opts)
+	case "rename":
+		if len(args) < 3 {
+			return errorf("usage: erp-cli warehouse rename <old_name> <new_name>")
+		}
+		return c.warehouseRename(args[1], args[2])
+	case "disable":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli warehouse disable <name>")
+		}
+		return c.warehouseDisable(args[1])
 	default:
-		return fmt.Errorf("unknown warehouse subcommand: %s", args[0])
+		return errorf("unknown warehouse subcommand: %s", args[0])
 	}
 }
 
+type warehouseOptions struct {
+	parent  string
+	isGroup bool
+}
+
+func parseWarehouseOptions(args []string) warehouseOptions {
+	opts := warehouseOptions{}
+	for _, arg := range args {
+		if len(arg) > 9 && arg[:9] == "--parent=" {
+			opts.parent = arg[9:]
+		}
+		if arg == "--group" {
+			opts.isGroup = true
+		}
+	}
+	return opts
+}
+
 func (c *Client) warehouseList() error {
-	fmt.Printf("%sFetching warehouses...%s\n", Blue, Reset)
+	printf("%sFetching warehouses...%s\n", Blue, Reset)
 
 	result, err := c.Request("GET", "Warehouse?limit_page_length=0&fields=[\"name\",\"warehouse_name\",\"is_group\",\"parent_warehouse\"]", nil)
 	if err != nil {
@@ -48,7 +93,7 @@ func (c *Client) warehouseList() error {
 				}
 
 				if parent != nil && parent != "" {
-					fmt.Printf("%s %s (parent: %s)\n", prefix, name, parent)
+					printf("%s %s (parent: %s)\n", prefix, name, parent)
 				} else {
 					fmt.Printf("%s %s\n", prefix, name)
 				}
@@ -58,25 +103,143 @@ func (c *Client) warehouseList() error {
 	return nil
 }
 
+// fetchWarehouseTree fetches all warehouses and links them into a hierarchy
+func (c *Client) fetchWarehouseTree() ([]*treeNode, error) {
+	result, err := c.Request("GET", "Warehouse?limit_page_length=0&fields=[\"name\",\"is_group\",\"parent_warehouse\",\"disabled\"]", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []*treeNode
+	if data, ok := result["data"].([]interface{}); ok {
+		for _, item := range data {
+			if m, ok := item.(map[string]interface{}); ok {
+				name, _ := m["name"].(string)
+				parent, _ := m["parent_warehouse"].(string)
+				isGroup, _ := m["is_group"].(float64)
+				disabled, _ := m["disabled"].(float64)
+				nodes = append(nodes, &treeNode{
+					name:     name,
+					parent:   parent,
+					isGroup:  isGroup == 1,
+					disabled: disabled == 1,
+				})
+			}
+		}
+	}
+
+	return buildTree(nodes), nil
+}
+
+func (c *Client) warehouseTree() error {
+	printf("%sFetching warehouse hierarchy...%s\n", Blue, Reset)
+
+	roots, err := c.fetchWarehouseTree()
+	if err != nil {
+		return err
+	}
+
+	if len(roots) == 0 {
+		printf("%sNo warehouses found%s\n", Yellow, Reset)
+		return nil
+	}
+
+	fmt.Println()
+	for _, line := range flattenTree(roots) {
+		icon := "📦"
+		if line.node.isGroup {
+			icon = "📁"
+		}
+		status := ""
+		if line.node.disabled {
+			status = fmt.Sprintf(" %s[disabled]%s", Red, Reset)
+		}
+		fmt.Printf("  %s%s %s%s\n", line.prefix, icon, line.node.name, status)
+	}
+	return nil
+}
+
+func (c *Client) warehouseCreate(name string, opts warehouseOptions) error {
+	printf("%sCreating warehouse: %s%s\n", Blue, name, Reset)
+
+	company, err := c.GetCompany()
+	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{
+		"warehouse_name": name,
+		"company":        company,
+	}
+
+	if opts.parent != "" {
+		body["parent_warehouse"] = opts.parent
+		printf("  Parent: %s\n", opts.parent)
+	}
+
+	if opts.isGroup {
+		body["is_group"] = 1
+		printf("  Type: Group\n")
+	}
+
+	result, err := c.Request("POST", "Warehouse", body)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].(map[string]interface{}); ok {
+		printf("%s✓ Warehouse created: %s%s\n", Green, data["name"], Reset)
+	}
+
+	return nil
+}
+
+func (c *Client) warehouseRename(oldName, newName string) error {
+	printf("%sRenaming warehouse: %s → %s%s\n", Blue, oldName, newName, Reset)
+
+	if err := c.renameDocument("Warehouse", oldName, newName); err != nil {
+		return err
+	}
+
+	printf("%s✓ Warehouse renamed: %s%s\n", Green, newName, Reset)
+	return nil
+}
+
+func (c *Client) warehouseDisable(name string) error {
+	printf("%sDisabling warehouse: %s%s\n", Blue, name, Reset)
+
+	encoded := url.PathEscape(name)
+	_, err := c.Request("PUT", "Warehouse/"+encoded, map[string]interface{}{"disabled": 1})
+	if err != nil {
+		return err
+	}
+
+	printf("%s✓ Warehouse disabled: %s%s\n", Green, name, Reset)
+	return nil
+}
+
 // CmdStock handles stock commands
 func (c *Client) CmdStock(args []string) error {
 	if len(args) == 0 {
-		fmt.Println("Usage: erp-cli stock <subcommand> [args...]")
-		fmt.Println("Subcommands: get, receive, transfer, issue")
+		fmt.Println(T("Usage: erp-cli stock <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: get, receive, transfer, issue"))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli stock get CPU-I7-12700K"))
+		fmt.Println(T("  erp-cli stock get CPU-I7-12700K \"Stores\""))
+		fmt.Println(T("  erp-cli stock receive CPU-I7-12700K 10 \"Stores\" --rate=450"))
+		fmt.Println(T("  erp-cli stock transfer CPU-I7-12700K 5 \"Stores\" \"Dispatch\""))
+		fmt.Println(T("  erp-cli stock issue CPU-I7-12700K 2 \"Stores\""))
+		fmt.Println(T("  erp-cli stock receive CPU-I7-12700K 10 \"Stores\" --posting-date=2025-01-31 --posting-time=23:30"))
 		fmt.Println()
-		fmt.Println("Examples:")
-		fmt.Println("  erp-cli stock get CPU-I7-12700K")
-		fmt.Println("  erp-cli stock get CPU-I7-12700K \"Stores\"")
-		fmt.Println("  erp-cli stock receive CPU-I7-12700K 10 \"Stores\" --rate=450")
-		fmt.Println("  erp-cli stock transfer CPU-I7-12700K 5 \"Stores\" \"Dispatch\"")
-		fmt.Println("  erp-cli stock issue CPU-I7-12700K 2 \"Stores\"")
+		fmt.Println(T("receive and issue take ERP_DEFAULT_WAREHOUSE when the warehouse is left out."))
 		return nil
 	}
 
 	switch args[0] {
 	case "get":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli stock get <item_code> [warehouse]")
+			return errorf("usage: erp-cli stock get <item_code> [warehouse]")
 		}
 		warehouse := ""
 		if len(args) > 2 {
@@ -84,45 +247,59 @@ func (c *Client) CmdStock(args []string) error {
 		}
 		return c.stockGet(args[1], warehouse)
 	case "receive":
-		if len(args) < 4 {
-			return fmt.Errorf("usage: erp-cli stock receive <item_code> <qty> <warehouse> [--rate=X]")
+		warehouse, rest, ok := c.defaultWarehouse(args, 3)
+		if len(args) < 3 || !ok {
+			return errorf("usage: erp-cli stock receive <item_code> <qty> <warehouse> [--rate=X] [--posting-date=YYYY-MM-DD] [--posting-time=HH:MM]")
 		}
 		rate := 0.0
-		for _, arg := range args[4:] {
+		for _, arg := range rest {
 			if len(arg) > 7 && arg[:7] == "--rate=" {
 				rate, _ = strconv.ParseFloat(arg[7:], 64)
 			}
 		}
 		qty, err := strconv.ParseFloat(args[2], 64)
 		if err != nil {
-			return fmt.Errorf("invalid quantity: %s", args[2])
+			return errorf("invalid quantity: %s", args[2])
+		}
+		posting, err := parsePostingOptions(rest)
+		if err != nil {
+			return err
 		}
-		return c.stockReceive(args[1], qty, args[3], rate)
+		return c.stockReceive(args[1], qty, warehouse, rate, posting)
 	case "transfer":
 		if len(args) < 5 {
-			return fmt.Errorf("usage: erp-cli stock transfer <item_code> <qty> <from_warehouse> <to_warehouse>")
+			return errorf("usage: erp-cli stock transfer <item_code> <qty> <from_warehouse> <to_warehouse>")
 		}
 		qty, err := strconv.ParseFloat(args[2], 64)
 		if err != nil {
-			return fmt.Errorf("invalid quantity: %s", args[2])
+			return errorf("invalid quantity: %s", args[2])
 		}
-		return c.stockTransfer(args[1], qty, args[3], args[4])
+		posting, err := parsePostingOptions(args[5:])
+		if err != nil {
+			return err
+		}
+		return c.stockTransfer(args[1], qty, args[3], args[4], posting)
 	case "issue":
-		if len(args) < 4 {
-			return fmt.Errorf("usage: erp-cli stock issue <item_code> <qty> <warehouse>")
+		warehouse, rest, ok := c.defaultWarehouse(args, 3)
+		if len(args) < 3 || !ok {
+			return errorf("usage: erp-cli stock issue <item_code> <qty> <warehouse>")
 		}
 		qty, err := strconv.ParseFloat(args[2], 64)
 		if err != nil {
-			return fmt.Errorf("invalid quantity: %s", args[2])
+			return errorf("invalid quantity: %s", args[2])
+		}
+		posting, err := parsePostingOptions(rest)
+		if err != nil {
+			return err
 		}
-		return c.stockIssue(args[1], qty, args[3])
+		return c.stockIssue(args[1], qty, warehouse, posting)
 	default:
-		return fmt.Errorf("unknown stock subcommand: %s", args[0])
+		return errorf("unknown stock subcommand: %s", args[0])
 	}
 }
 
 func (c *Client) stockGet(itemCode, warehouse string) error {
-	fmt.Printf("%sFetching stock for: %s%s\n", Blue, itemCode, Reset)
+	printf("%sFetching stock for: %s%s\n", Blue, itemCode, Reset)
 
 	filters := [][]interface{}{
 		{"item_code", "=", itemCode},
@@ -142,11 +319,11 @@ func (c *Client) stockGet(itemCode, warehouse string) error {
 
 	if data, ok := result["data"].([]interface{}); ok {
 		if len(data) == 0 {
-			fmt.Printf("%sNo stock found for: %s%s\n", Yellow, itemCode, Reset)
+			printf("%sNo stock found for: %s%s\n", Yellow, itemCode, Reset)
 			return nil
 		}
 
-		fmt.Printf("\n%sStock for %s:%s\n", Cyan, itemCode, Reset)
+		printf("\n%sStock for %s:%s\n", Cyan, itemCode, Reset)
 		totalQty := 0.0
 		for _, item := range data {
 			if m, ok := item.(map[string]interface{}); ok {
@@ -158,30 +335,31 @@ func (c *Client) stockGet(itemCode, warehouse string) error {
 				totalQty += actualQty
 
 				fmt.Printf("  📦 %s\n", wh)
-				fmt.Printf("     Actual: %s%.0f%s", Green, actualQty, Reset)
+				printf("     Actual: %s%.0f%s", Green, actualQty, Reset)
 				if reservedQty > 0 {
-					fmt.Printf(" | Reserved: %s%.0f%s", Yellow, reservedQty, Reset)
+					printf(" | Reserved: %s%.0f%s", Yellow, reservedQty, Reset)
 				}
 				if orderedQty > 0 {
-					fmt.Printf(" | Ordered: %s%.0f%s", Blue, orderedQty, Reset)
+					printf(" | Ordered: %s%.0f%s", Blue, orderedQty, Reset)
 				}
 				fmt.Println()
 			}
 		}
 
 		if warehouse == "" && len(data) > 1 {
-			fmt.Printf("\n  %sTotal: %.0f%s\n", Cyan, totalQty, Reset)
+			printf("\n  %sTotal: %.0f%s\n", Cyan, totalQty, Reset)
 		}
 	}
 
 	return nil
 }
 
-func (c *Client) stockReceive(itemCode string, qty float64, warehouse string, rate float64) error {
-	fmt.Printf("%sReceiving stock...%s\n", Blue, Reset)
-	fmt.Printf("  Item: %s\n", itemCode)
-	fmt.Printf("  Quantity: %.0f\n", qty)
-	fmt.Printf("  Warehouse: %s\n", warehouse)
+func (c *Client) stockReceive(itemCode string, qty float64, warehouse string, rate float64, posting postingOptions) error {
+	printf("%sReceiving stock...%s\n", Blue, Reset)
+	printf("  Item: %s\n", itemCode)
+	printf("  Quantity: %.0f\n", qty)
+	printf("  Warehouse: %s\n", warehouse)
+	c.printPosting(posting)
 
 	company, err := c.GetCompany()
 	if err != nil {
@@ -196,7 +374,7 @@ func (c *Client) stockReceive(itemCode string, qty float64, warehouse string, ra
 
 	if rate > 0 {
 		item["basic_rate"] = rate
-		fmt.Printf("  Rate: %s\n", c.FormatCurrency(rate))
+		printf("  Rate: %s\n", c.FormatCurrency(rate))
 	}
 
 	body := map[string]interface{}{
@@ -204,6 +382,9 @@ func (c *Client) stockReceive(itemCode string, qty float64, warehouse string, ra
 		"company":          company,
 		"items":            []interface{}{item},
 	}
+	if err := c.applyPosting(body, posting, true); err != nil {
+		return err
+	}
 
 	result, err := c.Request("POST", "Stock%20Entry", body)
 	if err != nil {
@@ -212,25 +393,26 @@ func (c *Client) stockReceive(itemCode string, qty float64, warehouse string, ra
 
 	if data, ok := result["data"].(map[string]interface{}); ok {
 		entryName := data["name"]
-		fmt.Printf("%s✓ Stock Entry created: %s%s\n", Green, entryName, Reset)
+		printf("%s✓ Stock Entry created: %s%s\n", Green, entryName, Reset)
 
 		if err := c.submitStockEntry(fmt.Sprintf("%v", entryName)); err != nil {
-			fmt.Printf("%sWarning: Entry created but not submitted: %s%s\n", Yellow, err, Reset)
-			fmt.Println("  You may need to submit it manually in the ERP")
+			printf("%sWarning: Entry created but not submitted: %s%s\n", Yellow, err, Reset)
+			fmt.Println(T("  You may need to submit it manually in the ERP"))
 		} else {
-			fmt.Printf("%s✓ Stock Entry submitted%s\n", Green, Reset)
+			printf("%s✓ Stock Entry submitted%s\n", Green, Reset)
 		}
 	}
 
 	return nil
 }
 
-func (c *Client) stockTransfer(itemCode string, qty float64, fromWarehouse, toWarehouse string) error {
-	fmt.Printf("%sTransferring stock...%s\n", Blue, Reset)
-	fmt.Printf("  Item: %s\n", itemCode)
-	fmt.Printf("  Quantity: %.0f\n", qty)
-	fmt.Printf("  From: %s\n", fromWarehouse)
-	fmt.Printf("  To: %s\n", toWarehouse)
+func (c *Client) stockTransfer(itemCode string, qty float64, fromWarehouse, toWarehouse string, posting postingOptions) error {
+	printf("%sTransferring stock...%s\n", Blue, Reset)
+	printf("  Item: %s\n", itemCode)
+	printf("  Quantity: %.0f\n", qty)
+	printf("  From: %s\n", fromWarehouse)
+	printf("  To: %s\n", toWarehouse)
+	c.printPosting(posting)
 
 	company, err := c.GetCompany()
 	if err != nil {
@@ -249,6 +431,9 @@ func (c *Client) stockTransfer(itemCode string, qty float64, fromWarehouse, toWa
 			},
 		},
 	}
+	if err := c.applyPosting(body, posting, true); err != nil {
+		return err
+	}
 
 	result, err := c.Request("POST", "Stock%20Entry", body)
 	if err != nil {
@@ -257,23 +442,24 @@ func (c *Client) stockTransfer(itemCode string, qty float64, fromWarehouse, toWa
 
 	if data, ok := result["data"].(map[string]interface{}); ok {
 		entryName := data["name"]
-		fmt.Printf("%s✓ Stock Entry created: %s%s\n", Green, entryName, Reset)
+		printf("%s✓ Stock Entry created: %s%s\n", Green, entryName, Reset)
 
 		if err := c.submitStockEntry(fmt.Sprintf("%v", entryName)); err != nil {
-			fmt.Printf("%sWarning: Entry created but not submitted: %s%s\n", Yellow, err, Reset)
+			printf("%sWarning: Entry created but not submitted: %s%s\n", Yellow, err, Reset)
 		} else {
-			fmt.Printf("%s✓ Stock Entry submitted%s\n", Green, Reset)
+			printf("%s✓ Stock Entry submitted%s\n", Green, Reset)
 		}
 	}
 
 	return nil
 }
 
-func (c *Client) stockIssue(itemCode string, qty float64, warehouse string) error {
-	fmt.Printf("%sIssuing stock...%s\n", Blue, Reset)
-	fmt.Printf("  Item: %s\n", itemCode)
-	fmt.Printf("  Quantity: %.0f\n", qty)
-	fmt.Printf("  Warehouse: %s\n", warehouse)
+func (c *Client) stockIssue(itemCode string, qty float64, warehouse string, posting postingOptions) error {
+	printf("%sIssuing stock...%s\n", Blue, Reset)
+	printf("  Item: %s\n", itemCode)
+	printf("  Quantity: %.0f\n", qty)
+	printf("  Warehouse: %s\n", warehouse)
+	c.printPosting(posting)
 
 	company, err := c.GetCompany()
 	if err != nil {
@@ -291,6 +477,9 @@ func (c *Client) stockIssue(itemCode string, qty float64, warehouse string) erro
 			},
 		},
 	}
+	if err := c.applyPosting(body, posting, true); err != nil {
+		return err
+	}
 
 	result, err := c.Request("POST", "Stock%20Entry", body)
 	if err != nil {
@@ -299,12 +488,12 @@ func (c *Client) stockIssue(itemCode string, qty float64, warehouse string) erro
 
 	if data, ok := result["data"].(map[string]interface{}); ok {
 		entryName := data["name"]
-		fmt.Printf("%s✓ Stock Entry created: %s%s\n", Green, entryName, Reset)
+		printf("%s✓ Stock Entry created: %s%s\n", Green, entryName, Reset)
 
 		if err := c.submitStockEntry(fmt.Sprintf("%v", entryName)); err != nil {
-			fmt.Printf("%sWarning: Entry created but not submitted: %s%s\n", Yellow, err, Reset)
+			printf("%sWarning: Entry created but not submitted: %s%s\n", Yellow, err, Reset)
 		} else {
-			fmt.Printf("%s✓ Stock Entry submitted%s\n", Green, Reset)
+			printf("%s✓ Stock Entry submitted%s\n", Green, Reset)
 		}
 	}
 
@@ -316,6 +505,9 @@ func (c *Client) GetCompany() (string, error) {
 	if c.Config.Company != "" {
 		return c.Config.Company, nil
 	}
+	if company, ok := c.cachedLookup("company"); ok {
+		return company, nil
+	}
 
 	result, err := c.Request("GET", "Company?limit_page_length=1", nil)
 	if err != nil {
@@ -325,12 +517,13 @@ func (c *Client) GetCompany() (string, error) {
 	if data, ok := result["data"].([]interface{}); ok && len(data) > 0 {
 		if m, ok := data[0].(map[string]interface{}); ok {
 			if name, ok := m["name"].(string); ok {
+				c.storeLookup("company", name)
 				return name, nil
 			}
 		}
 	}
 
-	return "", fmt.Errorf("no company found. Set ERP_COMPANY in config")
+	return "", errorf("no company found. Set ERP_COMPANY in config")
 }
 
 func (c *Client) submitStockEntry(name string) error {
@@ -366,7 +559,7 @@ func (c *Client) submitStockEntry(name string) error {
 	respBody, _ := io.ReadAll(resp.Body)
 	_, err = parseAPIResponse(resp.StatusCode, respBody)
 	if err != nil {
-		return fmt.Errorf("submit failed: %w", err)
+		return errorf("submit failed: %w", err)
 	}
 
 	return nil
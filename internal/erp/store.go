@@ -0,0 +1,63 @@
+package erp
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// localDir returns the directory for local CLI state (~/.erp-cli), creating it if needed
+func localDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", errorf("cannot determine home directory: %w", err)
+	}
+	dir := filepath.Join(home, ".erp-cli")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", errorf("failed to create %s: %w", dir, err)
+	}
+	return dir, nil
+}
+
+// loadLocalJSON reads a JSON file from the local state directory into v.
+// A missing file leaves v untouched.
+func loadLocalJSON(name string, v interface{}) error {
+	dir, err := localDir()
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, name))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errorf("failed to read %s: %w", name, err)
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return errorf("failed to parse %s: %w", name, err)
+	}
+	return nil
+}
+
+// saveLocalJSON writes v as JSON to the local state directory
+func saveLocalJSON(name string, v interface{}) error {
+	dir, err := localDir()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Write to a temp file first so an interrupted save never truncates the data
+	path := filepath.Join(dir, name)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return errorf("failed to write %s: %w", name, err)
+	}
+	return os.Rename(tmp, path)
+}
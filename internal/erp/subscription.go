@@ -0,0 +1,368 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// subscriptionCreateOptions holds flags for subscription create
+type subscriptionCreateOptions struct {
+	plans        []bomComponent // Subscription Plans with their quantity
+	start        string         // Defaults to today
+	end          string         // Open-ended when empty
+	inAdvance    bool           // Invoice at the start of each period
+	submit       bool           // Submit the generated invoices
+	daysUntilDue int
+}
+
+// CmdSubscription handles Subscription commands
+func (c *Client) CmdSubscription(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli subscription <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, create, cancel, plans"))
+		fmt.Println()
+		fmt.Println(T("A subscription bills a customer for one or more Subscription Plans every"))
+		fmt.Println(T("period; ERPNext generates the invoices on its daily schedule. --plan is"))
+		fmt.Println(T("repeatable and takes PLAN or PLAN:QTY. Invoices are raised at the end"))
+		fmt.Println(T("of each period unless --in-advance, and left as drafts unless --submit-invoices."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli subscription plans"))
+		fmt.Println(T("  erp-cli subscription create \"Acme Corp\" --plan=\"Hosting Monthly\" --start=2026-11-01"))
+		fmt.Println(T("  erp-cli subscription create \"Acme Corp\" --plan=\"Support Seat:5\" --in-advance --submit-invoices --days-until-due=15"))
+		fmt.Println(T("  erp-cli subscription list --customer=\"Acme\" --status=Active"))
+		fmt.Println(T("  erp-cli subscription get ACC-SUB-2026-00001"))
+		fmt.Println(T("  erp-cli subscription cancel ACC-SUB-2026-00001"))
+		return nil
+	}
+
+	switch args[0] {
+	case "list":
+		var filters [][]interface{}
+		for _, arg := range args[1:] {
+			if len(arg) > 11 && arg[:11] == "--customer=" {
+				filters = append(filters, []interface{}{"party", "like", "%" + arg[11:] + "%"})
+			}
+			if len(arg) > 9 && arg[:9] == "--status=" {
+				filters = append(filters, []interface{}{"status", "=", arg[9:]})
+			}
+		}
+		return c.subscriptionList(filters)
+	case "get":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli subscription get <name>")
+		}
+		return c.subscriptionGet(args[1])
+	case "create":
+		opts := subscriptionCreateOptions{}
+		customer := ""
+		for _, arg := range args[1:] {
+			switch {
+			case len(arg) > 7 && arg[:7] == "--plan=":
+				plan, err := parseBOMComponent(arg[7:])
+				if err != nil {
+					return err
+				}
+				opts.plans = append(opts.plans, plan)
+			case len(arg) > 8 && arg[:8] == "--start=":
+				opts.start = arg[8:]
+			case len(arg) > 6 && arg[:6] == "--end=":
+				opts.end = arg[6:]
+			case arg == "--in-advance":
+				opts.inAdvance = true
+			case arg == "--submit-invoices":
+				opts.submit = true
+			case len(arg) > 17 && arg[:17] == "--days-until-due=":
+				days, err := strconv.Atoi(arg[17:])
+				if err != nil || days < 0 {
+					return errorf("invalid --days-until-due: %s", arg[17:])
+				}
+				opts.daysUntilDue = days
+			case customer == "" && !strings.HasPrefix(arg, "--"):
+				customer = arg
+			default:
+				return errorf("unknown argument: %s", arg)
+			}
+		}
+		if customer == "" || len(opts.plans) == 0 {
+			return errorf("usage: erp-cli subscription create <customer> --plan=X[:qty] [...] [--start=YYYY-MM-DD] [--end=YYYY-MM-DD] [--in-advance] [--submit-invoices] [--days-until-due=N]")
+		}
+		for _, date := range []string{opts.start, opts.end} {
+			if _, err := time.Parse("2006-01-02", date); date != "" && err != nil {
+				return errorf("invalid date (expected YYYY-MM-DD): %s", date)
+			}
+		}
+		return c.subscriptionCreate(customer, opts)
+	case "cancel":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli subscription cancel <name> [--yes]")
+		}
+		return c.subscriptionCancel(args[1], parseCancelOptions(args[2:]).yes)
+	case "plans":
+		return c.subscriptionPlans()
+	default:
+		return errorf("unknown subscription subcommand: %s", args[0])
+	}
+}
+
+func (c *Client) subscriptionCreate(customer string, opts subscriptionCreateOptions) error {
+	printf("%sCreating subscription for: %s%s\n", Blue, customer, Reset)
+
+	data, err := c.createSubscription(customer, opts)
+	if err != nil {
+		return err
+	}
+
+	printf("%s✓ Subscription created: %s%s\n", Green, stringField(data, "name"), Reset)
+	printf("  Status: %s\n", stringField(data, "status"))
+	for _, plan := range opts.plans {
+		printf("  Plan: %s x %g\n", plan.item, plan.qty)
+	}
+	if periodStart := stringField(data, "current_invoice_start"); periodStart != "" {
+		printf("  First period: %s to %s\n", periodStart, stringField(data, "current_invoice_end"))
+	}
+	when, state := T("at the end of each period"), T("left as drafts")
+	if opts.inAdvance {
+		when = T("at the start of each period")
+	}
+	if opts.submit {
+		state = T("submitted")
+	}
+	printf("  Invoices: %s, %s\n", when, state)
+	return nil
+}
+
+// createSubscription creates a customer subscription and returns the
+// created document, with its first invoicing period
+func (c *Client) createSubscription(customer string, opts subscriptionCreateOptions) (map[string]interface{}, error) {
+	company, err := c.GetCompany()
+	if err != nil {
+		return nil, err
+	}
+
+	start := opts.start
+	if start == "" {
+		start = c.Today()
+	}
+	if opts.end != "" && opts.end <= start {
+		return nil, errorf("the subscription ends (%s) before it starts (%s)", opts.end, start)
+	}
+
+	var plans []map[string]interface{}
+	for _, plan := range opts.plans {
+		plans = append(plans, map[string]interface{}{"plan": plan.item, "qty": plan.qty})
+	}
+
+	generateAt := "End of the current subscription period"
+	if opts.inAdvance {
+		generateAt = "Beginning of the current subscription period"
+	}
+	body := map[string]interface{}{
+		"party_type":          "Customer",
+		"party":               customer,
+		"company":             company,
+		"start_date":          start,
+		"plans":               plans,
+		"generate_invoice_at": generateAt,
+		"days_until_due":      opts.daysUntilDue,
+	}
+	if opts.end != "" {
+		body["end_date"] = opts.end
+	}
+	if opts.submit {
+		body["submit_invoice"] = 1
+	}
+
+	result, err := c.Request("POST", "Subscription", body)
+	if err != nil {
+		return nil, err
+	}
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return nil, errorf("unexpected response creating subscription")
+	}
+	return data, nil
+}
+
+// subscriptionCancel cancels a subscription, which stops its invoicing
+func (c *Client) subscriptionCancel(name string, yes bool) error {
+	if !yes && !confirm(tf("Cancel subscription %s? No more invoices will be generated", name)) {
+		fmt.Println(T("Aborted"))
+		return nil
+	}
+	printf("%sCancelling subscription: %s%s\n", Blue, name, Reset)
+
+	if err := c.cancelSubscription(name); err != nil {
+		return err
+	}
+
+	printf("%s✓ Subscription cancelled: %s%s\n", Green, name, Reset)
+	return nil
+}
+
+// cancelSubscription calls the server-side cancel, which also settles the
+// current period as the subscription's settings say
+func (c *Client) cancelSubscription(name string) error {
+	_, err := c.CallMethod("POST", "erpnext.accounts.doctype.subscription.subscription.cancel_subscription", map[string]interface{}{"name": name})
+	return err
+}
+
+func (c *Client) subscriptionList(filters [][]interface{}) error {
+	printf("%sFetching subscriptions...%s\n", Blue, Reset)
+
+	filters = append(filters, []interface{}{"party_type", "=", "Customer"})
+	encoded, err := encodeFilters(filters)
+	if err != nil {
+		return err
+	}
+	endpoint := "Subscription?" + c.pageLimit(0) + "&fields=[\"name\",\"party\",\"status\",\"start_date\",\"current_invoice_end\"]&order_by=creation%20desc&filters=" + encoded
+
+	result, err := c.Request("GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	data, _ := result["data"].([]interface{})
+	if len(data) == 0 {
+		printf("%sNo subscriptions found%s\n", Yellow, Reset)
+		return nil
+	}
+
+	printf("\n%sSubscriptions (%d):%s\n", Cyan, len(data), Reset)
+	c.printPageNote(len(data))
+	for _, row := range data {
+		m, ok := row.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		status := stringField(m, "status")
+		fmt.Printf("  %s - %s\n", stringField(m, "name"), stringField(m, "party"))
+		printf("    Since: %s | Status: %s%s%s | Period ends: %s\n",
+			stringField(m, "start_date"), subscriptionStatusColor(status), status, Reset, stringField(m, "current_invoice_end"))
+	}
+	return nil
+}
+
+// subscriptionStatusColor returns the colour of a subscription status
+func subscriptionStatusColor(status string) string {
+	switch status {
+	case "Active", "Trialling":
+		return Green
+	case "Past Due Date", "Unpaid":
+		return Yellow
+	case "Cancelled":
+		return Red
+	}
+	return ""
+}
+
+// subscriptionInvoices returns the invoices a subscription generated,
+// newest first
+func (c *Client) subscriptionInvoices(name string) ([]map[string]interface{}, error) {
+	filters, err := encodeFilters([][]interface{}{{"subscription", "=", name}})
+	if err != nil {
+		return nil, err
+	}
+	result, err := c.Request("GET", "Sales%20Invoice?limit_page_length=12&order_by=posting_date%20desc&fields=[\"name\",\"posting_date\",\"grand_total\",\"outstanding_amount\",\"status\"]&filters="+filters, nil)
+	if err != nil {
+		return nil, err
+	}
+	var invoices []map[string]interface{}
+	data, _ := result["data"].([]interface{})
+	for _, row := range data {
+		if m, ok := row.(map[string]interface{}); ok {
+			invoices = append(invoices, m)
+		}
+	}
+	return invoices, nil
+}
+
+func (c *Client) subscriptionGet(name string) error {
+	printf("%sFetching subscription: %s%s\n", Blue, name, Reset)
+
+	result, err := c.Request("GET", "Subscription/"+url.PathEscape(name), nil)
+	if err != nil {
+		return err
+	}
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return errorf("subscription not found")
+	}
+
+	status := stringField(data, "status")
+	printf("\n%sSubscription: %s%s\n", Cyan, name, Reset)
+	printf("  Customer: %s\n", stringField(data, "party"))
+	printf("  Status: %s%s%s\n", subscriptionStatusColor(status), status, Reset)
+	printf("  Start: %s\n", stringField(data, "start_date"))
+	if end := stringField(data, "end_date"); end != "" {
+		printf("  End: %s\n", end)
+	}
+	printf("  Current Period: %s to %s\n", stringField(data, "current_invoice_start"), stringField(data, "current_invoice_end"))
+	printf("  Invoiced At: %s\n", stringField(data, "generate_invoice_at"))
+
+	if plans, ok := data["plans"].([]interface{}); ok && len(plans) > 0 {
+		printf("\n  %sPlans:%s\n", Yellow, Reset)
+		for _, p := range plans {
+			if m, ok := p.(map[string]interface{}); ok {
+				qty, _ := m["qty"].(float64)
+				fmt.Printf("    - %s x %g\n", stringField(m, "plan"), qty)
+			}
+		}
+	}
+
+	invoices, err := c.subscriptionInvoices(name)
+	if err == nil && len(invoices) > 0 {
+		printf("\n  %sInvoices:%s\n", Yellow, Reset)
+		for _, inv := range invoices {
+			total, _ := inv["grand_total"].(float64)
+			outstanding, _ := inv["outstanding_amount"].(float64)
+			line := fmt.Sprintf("    - %s  %s  %s  [%s]", stringField(inv, "name"), stringField(inv, "posting_date"), c.FormatCurrency(total), stringField(inv, "status"))
+			if outstanding > 0 {
+				line += tf(" %s%s due%s", Yellow, c.FormatCurrency(outstanding), Reset)
+			}
+			fmt.Println(line)
+		}
+	}
+
+	c.printActivity("Subscription", name)
+	return nil
+}
+
+// subscriptionPlans lists the plans subscriptions can be made of
+func (c *Client) subscriptionPlans() error {
+	printf("%sFetching subscription plans...%s\n", Blue, Reset)
+
+	result, err := c.Request("GET", "Subscription%20Plan?limit_page_length=0&fields=[\"name\",\"item\",\"price_determination\",\"cost\",\"price_list\",\"billing_interval\",\"billing_interval_count\"]&order_by=name%20asc", nil)
+	if err != nil {
+		return err
+	}
+
+	data, _ := result["data"].([]interface{})
+	if len(data) == 0 {
+		printf("%sNo subscription plans found%s\n", Yellow, Reset)
+		return nil
+	}
+
+	printf("\n%sSubscription Plans (%d):%s\n", Cyan, len(data), Reset)
+	for _, row := range data {
+		m, ok := row.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		price := stringField(m, "price_list")
+		if stringField(m, "price_determination") == "Fixed Rate" {
+			cost, _ := m["cost"].(float64)
+			price = c.FormatCurrency(cost)
+		}
+		every, _ := m["billing_interval_count"].(float64)
+		interval := strings.ToLower(stringField(m, "billing_interval"))
+		if every > 1 {
+			interval = fmt.Sprintf("%g %ss", every, interval)
+		}
+		printf("  • %s%s%s  %s  %s per %s\n", Green, stringField(m, "name"), Reset, stringField(m, "item"), price, interval)
+	}
+	return nil
+}
@@ -18,14 +18,18 @@ type Supplier struct {
 // CmdSupplier handles supplier commands
 func (c *Client) CmdSupplier(args []string) error {
 	if len(args) == 0 {
-		fmt.Println("Usage: erp-cli supplier <subcommand> [args...]")
-		fmt.Println("Subcommands: list, get, create, delete")
+		fmt.Println(T("Usage: erp-cli supplier <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, create, set, add-address, add-contact, addresses, delete"))
 		fmt.Println()
-		fmt.Println("Examples:")
-		fmt.Println("  erp-cli supplier list")
-		fmt.Println("  erp-cli supplier get \"Intel Corporation\"")
-		fmt.Println("  erp-cli supplier create \"New Supplier\" --group=\"Services\"")
-		fmt.Println("  erp-cli supplier delete \"Old Supplier\"")
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli supplier list"))
+		fmt.Println(T("  erp-cli supplier get \"Intel Corporation\""))
+		fmt.Println(T("  erp-cli supplier create \"New Supplier\" --group=\"Services\""))
+		fmt.Println(T("  erp-cli supplier set \"Intel Corporation\" group=\"Hardware\" tax-id=ES12345678"))
+		fmt.Println(T("  erp-cli supplier add-address \"Intel Corporation\" --line1=\"Main St 1\" --city=Bilbao --country=Spain --primary"))
+		fmt.Println(T("  erp-cli supplier add-contact \"Intel Corporation\" John --last=Doe --email=john@example.com"))
+		fmt.Println(T("  erp-cli supplier addresses \"Intel Corporation\""))
+		fmt.Println(T("  erp-cli supplier delete \"Old Supplier\""))
 		return nil
 	}
 
@@ -34,22 +38,42 @@ func (c *Client) CmdSupplier(args []string) error {
 		return c.supplierList()
 	case "get":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli supplier get <name>")
+			return errorf("usage: erp-cli supplier get <name>")
 		}
 		return c.supplierGet(args[1])
 	case "create":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli supplier create <name> [--group=X] [--country=X]")
+			return errorf("usage: erp-cli supplier create <name> [--group=X] [--country=X]")
 		}
 		opts := parseSupplierOptions(args[2:])
 		return c.supplierCreate(args[1], opts)
+	case "set":
+		if len(args) < 3 {
+			return errorf("usage: erp-cli supplier set <name> <property=value> [property=value...]")
+		}
+		return c.partySet("Supplier", args[1], args[2:])
+	case "add-address":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli supplier add-address <name> --line1=X --city=X --country=X [--type=Billing|Shipping] [--line2=X] [--state=X] [--zip=X] [--email=X] [--phone=X] [--primary] [--shipping]")
+		}
+		return c.partyAddAddress("Supplier", args[1], parseAddressOptions(args[2:]))
+	case "add-contact":
+		if len(args) < 3 {
+			return errorf("usage: erp-cli supplier add-contact <name> <first_name> [--last=X] [--email=X] [--phone=X] [--mobile=X] [--primary]")
+		}
+		return c.partyAddContact("Supplier", args[1], args[2], parseContactOptions(args[3:]))
+	case "list-addresses", "addresses":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli supplier addresses <name>")
+		}
+		return c.partyListAddresses("Supplier", args[1])
 	case "delete":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli supplier delete <name>")
+			return errorf("usage: erp-cli supplier delete <name>")
 		}
 		return c.supplierDelete(args[1])
 	default:
-		return fmt.Errorf("unknown supplier subcommand: %s", args[0])
+		return errorf("unknown supplier subcommand: %s", args[0])
 	}
 }
 
@@ -72,7 +96,7 @@ func parseSupplierOptions(args []string) supplierOptions {
 }
 
 func (c *Client) supplierList() error {
-	fmt.Printf("%sFetching suppliers...%s\n", Blue, Reset)
+	printf("%sFetching suppliers...%s\n", Blue, Reset)
 
 	result, err := c.Request("GET", "Supplier?limit_page_length=0&fields=[\"name\",\"supplier_name\",\"supplier_group\",\"country\",\"disabled\"]", nil)
 	if err != nil {
@@ -81,11 +105,11 @@ func (c *Client) supplierList() error {
 
 	if data, ok := result["data"].([]interface{}); ok {
 		if len(data) == 0 {
-			fmt.Printf("%sNo suppliers found%s\n", Yellow, Reset)
+			printf("%sNo suppliers found%s\n", Yellow, Reset)
 			return nil
 		}
 
-		fmt.Printf("\n%sSuppliers (%d):%s\n", Cyan, len(data), Reset)
+		printf("\n%sSuppliers (%d):%s\n", Cyan, len(data), Reset)
 		for _, item := range data {
 			if m, ok := item.(map[string]interface{}); ok {
 				name := m["name"]
@@ -115,7 +139,7 @@ func (c *Client) supplierList() error {
 }
 
 func (c *Client) supplierGet(name string) error {
-	fmt.Printf("%sFetching supplier: %s%s\n", Blue, name, Reset)
+	printf("%sFetching supplier: %s%s\n", Blue, name, Reset)
 
 	encoded := url.PathEscape(name)
 	result, err := c.Request("GET", "Supplier/"+encoded, nil)
@@ -142,12 +166,14 @@ func (c *Client) supplierGet(name string) error {
 
 		jsonOut, _ := json.MarshalIndent(output, "", "  ")
 		fmt.Println(string(jsonOut))
+
+		return c.printPartyLinks("Supplier", name)
 	}
 	return nil
 }
 
 func (c *Client) supplierCreate(name string, opts supplierOptions) error {
-	fmt.Printf("%sCreating supplier: %s%s\n", Blue, name, Reset)
+	printf("%sCreating supplier: %s%s\n", Blue, name, Reset)
 
 	body := map[string]interface{}{
 		"supplier_name": name,
@@ -155,12 +181,12 @@ func (c *Client) supplierCreate(name string, opts supplierOptions) error {
 
 	if opts.group != "" {
 		body["supplier_group"] = opts.group
-		fmt.Printf("  Group: %s\n", opts.group)
+		printf("  Group: %s\n", opts.group)
 	}
 
 	if opts.country != "" {
 		body["country"] = opts.country
-		fmt.Printf("  Country: %s\n", opts.country)
+		printf("  Country: %s\n", opts.country)
 	}
 
 	result, err := c.Request("POST", "Supplier", body)
@@ -169,14 +195,14 @@ func (c *Client) supplierCreate(name string, opts supplierOptions) error {
 	}
 
 	if data, ok := result["data"].(map[string]interface{}); ok {
-		fmt.Printf("%s✓ Supplier created: %s%s\n", Green, data["name"], Reset)
+		printf("%s✓ Supplier created: %s%s\n", Green, data["name"], Reset)
 	}
 
 	return nil
 }
 
 func (c *Client) supplierDelete(name string) error {
-	fmt.Printf("%sDeleting supplier: %s%s\n", Blue, name, Reset)
+	printf("%sDeleting supplier: %s%s\n", Blue, name, Reset)
 
 	encoded := url.PathEscape(name)
 	_, err := c.Request("DELETE", "Supplier/"+encoded, nil)
@@ -184,6 +210,6 @@ func (c *Client) supplierDelete(name string) error {
 		return err
 	}
 
-	fmt.Printf("%s✓ Supplier deleted: %s%s\n", Green, name, Reset)
+	printf("%s✓ Supplier deleted: %s%s\n", Green, name, Reset)
 	return nil
 }
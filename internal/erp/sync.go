@@ -0,0 +1,416 @@
+package erp
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const syncCursorsFile = "sync-cursors.json"
+
+// syncCursor is where a sync stopped: the modified timestamp and name of
+// the last document emitted. Documents sharing that timestamp sort by name,
+// so none is emitted twice or skipped.
+type syncCursor struct {
+	Modified string    `json:"modified"`
+	Name     string    `json:"name"`
+	Saved    time.Time `json:"saved"`
+}
+
+// String is the cursor as --since takes it
+func (s syncCursor) String() string {
+	if s.Name == "" {
+		return s.Modified
+	}
+	return s.Modified + "|" + s.Name
+}
+
+// parseSyncSince reads a --since: a cursor printed by an earlier sync, a
+// timestamp or a date
+func parseSyncSince(since string) (syncCursor, error) {
+	modified, name := since, ""
+	if i := strings.LastIndex(since, "|"); i >= 0 {
+		modified, name = since[:i], since[i+1:]
+	}
+	for _, layout := range []string{"2006-01-02 15:04:05.999999", "2006-01-02 15:04:05", "2006-01-02T15:04:05", "2006-01-02"} {
+		if _, err := time.Parse(layout, modified); err == nil {
+			return syncCursor{Modified: strings.Replace(modified, "T", " ", 1), Name: name}, nil
+		}
+	}
+	return syncCursor{}, errorf("invalid --since %q (use YYYY-MM-DD, \"YYYY-MM-DD HH:MM:SS\" or a cursor)", since)
+}
+
+type syncOptions struct {
+	doctype string
+	since   string // Start cursor, the saved one when empty
+	format  string // jsonl or csv
+	output  string // File to write instead of stdout
+	key     string // Name the cursor is saved under (default: the doctype)
+	limit   int    // At most this many documents per run (0: all)
+	full    bool   // Fetch child tables too (one request per document)
+	noSave  bool   // Leave the saved cursor as it was
+}
+
+func parseSyncOptions(args []string) (syncOptions, error) {
+	opts := syncOptions{format: "jsonl"}
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--doctype" && i+1 < len(args):
+			opts.doctype = args[i+1]
+			i++
+		case len(arg) > 10 && arg[:10] == "--doctype=":
+			opts.doctype = arg[10:]
+		case arg == "--since" && i+1 < len(args):
+			opts.since = args[i+1]
+			i++
+		case len(arg) > 8 && arg[:8] == "--since=":
+			opts.since = arg[8:]
+		case len(arg) > 9 && arg[:9] == "--format=":
+			opts.format = arg[9:]
+		case arg == "-o" && i+1 < len(args):
+			opts.output = args[i+1]
+			i++
+		case len(arg) > 9 && arg[:9] == "--cursor=":
+			opts.key = arg[9:]
+		case len(arg) > 8 && arg[:8] == "--limit=":
+			limit, err := strconv.Atoi(arg[8:])
+			if err != nil || limit < 1 {
+				return opts, errorf("invalid --limit: %s", arg[8:])
+			}
+			opts.limit = limit
+		case arg == "--full":
+			opts.full = true
+		case arg == "--no-save":
+			opts.noSave = true
+		}
+	}
+
+	if opts.doctype == "" {
+		return opts, errorf("--doctype is required")
+	}
+	opts.doctype = resolveDoctype(opts.doctype)
+	if opts.key == "" {
+		opts.key = opts.doctype
+	}
+	if opts.format != "jsonl" && opts.format != "csv" {
+		return opts, errorf("invalid --format %q (use jsonl or csv)", opts.format)
+	}
+	return opts, nil
+}
+
+// CmdSync handles sync commands
+func (c *Client) CmdSync(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli sync <subcommand> [args]"))
+		fmt.Println()
+		fmt.Println(T("Subcommands:"))
+		fmt.Println(T("  changes --doctype=X [--since=<timestamp|cursor>] [--format=jsonl|csv] [-o file]"))
+		fmt.Println(T("          [--limit=N] [--full] [--cursor=name] [--no-save]"))
+		fmt.Println(T("                       Documents created or modified since the last sync"))
+		fmt.Println(T("  cursors              Saved cursors"))
+		fmt.Println(T("  reset <doctype|name> Forget a cursor (the next sync starts from scratch)"))
+		fmt.Println(T("  push [--dry-run] [--force]"))
+		fmt.Println(T("                       Run the commands queued with --offline"))
+		fmt.Println(T("  queue                Commands queued with --offline"))
+		fmt.Println(T("  drop <id>            Remove a queued command without running it"))
+		fmt.Println()
+		fmt.Println(T("Each document is written with _change set to created or modified. The cursor"))
+		fmt.Println(T("after the last document is saved per site and doctype (or --cursor name) once"))
+		fmt.Println(T("the output is written, so the next run picks up from there. -o appends JSONL"))
+		fmt.Println(T("(a CSV is replaced). --limit syncs in batches; --full includes child tables."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli sync changes --doctype \"Sales Invoice\" >> invoices.jsonl"))
+		fmt.Println(T("  erp-cli sync changes --doctype si --since=2025-01-01 --full -o si.jsonl"))
+		fmt.Println(T("  erp-cli sync changes --doctype Customer --format=csv --cursor=dwh -o customers.csv"))
+		fmt.Println(T("  erp-cli --offline so create --customer=Acme --item=CBL-01:10   # Queued"))
+		fmt.Println(T("  erp-cli sync push"))
+		return nil
+	}
+
+	switch args[0] {
+	case "changes":
+		opts, err := parseSyncOptions(args[1:])
+		if err != nil {
+			return err
+		}
+		return c.syncChanges(opts)
+	case "cursors":
+		return c.syncCursors()
+	case "reset":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli sync reset <doctype|name>")
+		}
+		return c.syncReset(args[1])
+	case "push":
+		dryRun, force := false, false
+		for _, arg := range args[1:] {
+			switch arg {
+			case "--dry-run":
+				dryRun = true
+			case "--force":
+				force = true
+			}
+		}
+		return c.syncPush(dryRun, force)
+	case "queue":
+		return c.syncQueue()
+	case "drop":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli sync drop <id>")
+		}
+		return c.syncDrop(args[1])
+	default:
+		return errorf("unknown sync subcommand: %s", args[0])
+	}
+}
+
+// syncChanges writes the documents modified after the cursor, oldest
+// first, and saves the cursor of the last one. Status goes to stderr when
+// documents go to stdout.
+func (c *Client) syncChanges(opts syncOptions) error {
+	status := io.Writer(os.Stderr)
+	if opts.output != "" {
+		status = os.Stdout
+	}
+
+	cursors := map[string]syncCursor{}
+	if err := loadLocalJSON(syncCursorsFile, &cursors); err != nil {
+		return err
+	}
+	cursor := cursors[c.cacheKey(opts.key)]
+	if opts.since != "" {
+		since, err := parseSyncSince(opts.since)
+		if err != nil {
+			return err
+		}
+		cursor = since
+	}
+	if cursor.Modified == "" {
+		fmt.Fprint(status, tf("%sSyncing all %s documents (no cursor yet)...%s\n", Blue, opts.doctype, Reset))
+	} else {
+		fmt.Fprint(status, tf("%sSyncing %s changes since %s...%s\n", Blue, opts.doctype, cursor, Reset))
+	}
+
+	docs, err := c.fetchSyncChanges(opts.doctype, cursor, opts.limit)
+	if err != nil {
+		return err
+	}
+	if len(docs) == 0 {
+		fmt.Fprint(status, tf("%sNo changes%s\n", Yellow, Reset))
+		return nil
+	}
+
+	next := cursor
+	for i, doc := range docs {
+		change := "modified"
+		if stringField(doc, "creation") > cursor.Modified {
+			change = "created"
+		}
+		next = syncCursor{Modified: stringField(doc, "modified"), Name: stringField(doc, "name")}
+		if opts.full {
+			result, err := c.Request("GET", url.PathEscape(opts.doctype)+"/"+url.PathEscape(next.Name), nil)
+			if err != nil {
+				return errorf("%s %s: %w (nothing saved; rerun to resume)", opts.doctype, next.Name, err)
+			}
+			if full, ok := result["data"].(map[string]interface{}); ok {
+				doc = full
+			}
+		}
+		doc["_change"] = change
+		docs[i] = doc
+	}
+
+	out := io.Writer(os.Stdout)
+	if opts.output != "" {
+		// JSONL is appended so a data warehouse can load one growing file;
+		// a CSV has its own header and columns, so it is replaced
+		flags := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+		if opts.format == "csv" {
+			flags = os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+		}
+		file, err := os.OpenFile(opts.output, flags, 0644)
+		if err != nil {
+			return errorf("failed to open %s: %w", opts.output, err)
+		}
+		defer file.Close()
+		out = file
+	}
+	if opts.format == "csv" {
+		err = writeSyncCSV(out, docs)
+	} else {
+		err = writeSyncJSONL(out, docs)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !opts.noSave {
+		next.Saved = time.Now()
+		cursors[c.cacheKey(opts.key)] = next
+		if err := saveLocalJSON(syncCursorsFile, cursors); err != nil {
+			return errorf("changes written but cursor not saved: %w", err)
+		}
+	}
+	more := ""
+	if opts.limit > 0 && len(docs) == opts.limit {
+		more = T(" (limit reached, run again for more)")
+	}
+	fmt.Fprint(status, tf("%s✓ %d change(s), cursor: %s%s%s\n", Green, len(docs), next, more, Reset))
+	return nil
+}
+
+// fetchSyncChanges returns the documents after a cursor, ordered by
+// modified and name: the rest of those at the cursor's timestamp, then the
+// ones modified later
+func (c *Client) fetchSyncChanges(doctype string, cursor syncCursor, limit int) ([]map[string]interface{}, error) {
+	var docs []map[string]interface{}
+	if cursor.Name != "" {
+		same, err := c.fetchSyncPage(doctype, [][]interface{}{{"modified", "=", cursor.Modified}, {"name", ">", cursor.Name}}, limit)
+		if err != nil {
+			return nil, err
+		}
+		docs = same
+		if limit > 0 && len(docs) >= limit {
+			return docs[:limit], nil
+		}
+	}
+
+	var filters [][]interface{}
+	if cursor.Modified != "" {
+		filters = append(filters, []interface{}{"modified", ">", cursor.Modified})
+	}
+	if limit > 0 {
+		limit -= len(docs)
+	}
+	later, err := c.fetchSyncPage(doctype, filters, limit)
+	if err != nil {
+		return nil, err
+	}
+	return append(docs, later...), nil
+}
+
+func (c *Client) fetchSyncPage(doctype string, filters [][]interface{}, limit int) ([]map[string]interface{}, error) {
+	endpoint := url.PathEscape(doctype) + "?fields=[\"*\"]&order_by=modified%20asc,name%20asc&limit_page_length=" + strconv.Itoa(limit)
+	if len(filters) > 0 {
+		encoded, err := encodeFilters(filters)
+		if err != nil {
+			return nil, err
+		}
+		endpoint += "&filters=" + encoded
+	}
+	result, err := c.Request("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	data, _ := result["data"].([]interface{})
+	var docs []map[string]interface{}
+	for _, d := range data {
+		if doc, ok := d.(map[string]interface{}); ok {
+			docs = append(docs, doc)
+		}
+	}
+	return docs, nil
+}
+
+func writeSyncJSONL(out io.Writer, docs []map[string]interface{}) error {
+	writer := bufio.NewWriter(out)
+	for _, doc := range docs {
+		line, err := json.Marshal(doc)
+		if err != nil {
+			return errorf("failed to encode %s: %w", stringField(doc, "name"), err)
+		}
+		writer.Write(line)
+		writer.WriteByte('\n')
+	}
+	return writer.Flush()
+}
+
+// writeSyncCSV writes the documents' fields as columns, name and _change
+// first. Child tables (with --full) are left out.
+func writeSyncCSV(out io.Writer, docs []map[string]interface{}) error {
+	seen := map[string]bool{"name": true, "_change": true}
+	var fields []string
+	for _, doc := range docs {
+		for key, value := range doc {
+			if _, table := value.([]interface{}); !table && !seen[key] {
+				seen[key] = true
+				fields = append(fields, key)
+			}
+		}
+	}
+	sort.Strings(fields)
+	fields = append([]string{"name", "_change"}, fields...)
+
+	writer := csv.NewWriter(out)
+	writer.Write(fields)
+	for _, doc := range docs {
+		row := make([]string, len(fields))
+		for i, field := range fields {
+			if value, ok := doc[field]; ok && value != nil {
+				row[i] = fmt.Sprint(value)
+			}
+		}
+		writer.Write(row)
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// syncCursors prints the saved cursors of the configured site
+func (c *Client) syncCursors() error {
+	cursors := map[string]syncCursor{}
+	if err := loadLocalJSON(syncCursorsFile, &cursors); err != nil {
+		return err
+	}
+	prefix := c.cacheKey("")
+	var keys []string
+	for key := range cursors {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		printf("%sNo sync cursors yet%s\n", Yellow, Reset)
+		return nil
+	}
+	sort.Strings(keys)
+
+	fmt.Printf("%-30s %-40s %s\n", T("NAME"), T("CURSOR"), T("SYNCED"))
+	fmt.Println(strings.Repeat("-", 90))
+	for _, key := range keys {
+		cursor := cursors[key]
+		fmt.Printf("%-30s %-40s %s\n", strings.TrimPrefix(key, prefix), cursor, cursor.Saved.Format("2006-01-02 15:04"))
+	}
+	return nil
+}
+
+// syncReset forgets a saved cursor
+func (c *Client) syncReset(name string) error {
+	cursors := map[string]syncCursor{}
+	if err := loadLocalJSON(syncCursorsFile, &cursors); err != nil {
+		return err
+	}
+	key := c.cacheKey(name)
+	if _, ok := cursors[key]; !ok {
+		key = c.cacheKey(resolveDoctype(name))
+	}
+	if _, ok := cursors[key]; !ok {
+		return errorf("no sync cursor named %s (see 'erp-cli sync cursors')", name)
+	}
+	delete(cursors, key)
+	if err := saveLocalJSON(syncCursorsFile, cursors); err != nil {
+		return err
+	}
+	printf("%s✓ Cursor reset: %s%s\n", Green, name, Reset)
+	return nil
+}
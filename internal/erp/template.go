@@ -0,0 +1,149 @@
+package erp
+
+import (
+	"embed"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// bundledTemplates are the --template names that need no file
+//
+//go:embed templates/*.tmpl
+var bundledTemplates embed.FS
+
+// parseTemplateFlag returns the --template of a get command
+func parseTemplateFlag(args []string) string {
+	tmpl := ""
+	for _, arg := range args {
+		if len(arg) > 11 && arg[:11] == "--template=" {
+			tmpl = arg[11:]
+		}
+	}
+	return tmpl
+}
+
+// bundledTemplateNames lists the bundled templates
+func bundledTemplateNames() []string {
+	entries, _ := bundledTemplates.ReadDir("templates")
+	var names []string
+	for _, entry := range entries {
+		names = append(names, strings.TrimSuffix(entry.Name(), ".tmpl"))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// loadTemplate parses a template file, or a bundled template by name when
+// no such file exists
+func (c *Client) loadTemplate(nameOrPath string) (*template.Template, error) {
+	text, err := os.ReadFile(nameOrPath)
+	if err != nil {
+		bundled, bundledErr := bundledTemplates.ReadFile("templates/" + nameOrPath + ".tmpl")
+		if bundledErr != nil {
+			return nil, errorf("template not found: %s (bundled: %s)", nameOrPath, strings.Join(bundledTemplateNames(), ", "))
+		}
+		text = bundled
+	}
+	tmpl, err := template.New(path.Base(nameOrPath)).Funcs(c.templateFuncs()).Parse(string(text))
+	if err != nil {
+		return nil, errorf("template %s: %w", nameOrPath, err)
+	}
+	return tmpl, nil
+}
+
+// templateFuncs are the helpers templates can call besides the built-in
+// ones (index, len, printf, ...)
+func (c *Client) templateFuncs() template.FuncMap {
+	return template.FuncMap{
+		// money formats an amount in a currency, the company's when empty:
+		// {{money .grand_total .currency}}
+		"money": func(amount, code interface{}) string {
+			value, _ := amount.(float64)
+			currency, _ := code.(string)
+			return c.FormatCurrencyIn(value, currency)
+		},
+		"num": func(amount interface{}) string {
+			value, _ := amount.(float64)
+			return fmt.Sprintf("%.2f", value)
+		},
+		"qty": func(amount interface{}) string {
+			value, _ := amount.(float64)
+			return formatQty(value)
+		},
+		// text turns HTML fields (terms, addresses) into plain text
+		"text": func(value interface{}) string {
+			s, _ := value.(string)
+			return htmlToText(s)
+		},
+		"default": func(fallback, value interface{}) interface{} {
+			if value == nil || value == "" {
+				return fallback
+			}
+			return value
+		},
+		"upper": strings.ToUpper,
+		"lower": strings.ToLower,
+		// pad and rpad left- and right-align a value in n characters
+		"pad": func(n int, value interface{}) string {
+			return fmt.Sprintf("%-*s", n, fitText(fmt.Sprint(value), n))
+		},
+		"rpad": func(n int, value interface{}) string {
+			return padLeft(fmt.Sprint(value), n)
+		},
+		"wrap": func(n int, value interface{}) string {
+			return strings.Join(wrapText(fmt.Sprint(value), n), "\n")
+		},
+		"today": c.Today,
+	}
+}
+
+// renderTemplate writes data through a template to stdout
+func (c *Client) renderTemplate(nameOrPath string, data interface{}) error {
+	tmpl, err := c.loadTemplate(nameOrPath)
+	if err != nil {
+		return err
+	}
+	if err := tmpl.Execute(os.Stdout, data); err != nil {
+		return errorf("template %s: %w", nameOrPath, err)
+	}
+	return nil
+}
+
+// renderDocTemplate renders a document with a template, the document map
+// (child tables included) being the template's dot
+func (c *Client) renderDocTemplate(doctype, name, tmpl string) error {
+	result, err := c.Request("GET", strings.ReplaceAll(doctype, " ", "%20")+"/"+url.PathEscape(name), nil)
+	if err != nil {
+		return err
+	}
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return errorf("%s not found: %s", strings.ToLower(doctype), name)
+	}
+	return c.renderTemplate(tmpl, data)
+}
+
+// renderListTemplate renders the documents matching filters with a
+// template, the list of document maps (all fields, no child tables) being
+// the template's dot
+func (c *Client) renderListTemplate(doctype string, filters [][]interface{}, tmpl string) error {
+	endpoint := strings.ReplaceAll(doctype, " ", "%20") + "?" + c.pageLimit(0) + "&fields=[\"*\"]&order_by=creation%20desc"
+	if len(filters) > 0 {
+		encoded, err := encodeFilters(filters)
+		if err != nil {
+			return err
+		}
+		endpoint += "&filters=" + encoded
+	}
+	result, err := c.Request("GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	data, _ := result["data"].([]interface{})
+	return c.renderTemplate(tmpl, uniqueDocs(data))
+}
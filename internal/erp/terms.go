@@ -0,0 +1,196 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// docTerms is the --terms, --remarks and --letterhead of document creation
+type docTerms struct {
+	terms      string // Terms and Conditions name, the company default when empty
+	remarks    string
+	letterhead string
+}
+
+func parseTermsFlags(args []string) docTerms {
+	t := docTerms{}
+	for _, arg := range args {
+		switch {
+		case len(arg) > 8 && arg[:8] == "--terms=":
+			t.terms = arg[8:]
+		case len(arg) > 10 && arg[:10] == "--remarks=":
+			t.remarks = arg[10:]
+		case len(arg) > 13 && arg[:13] == "--letterhead=":
+			t.letterhead = arg[13:]
+		}
+	}
+	return t
+}
+
+// remarkFields are the fields documents keep remarks in. Remarks on other
+// doctypes (quotations and orders) go to the timeline as a comment.
+var remarkFields = map[string]string{
+	"Sales Invoice":    "remarks",
+	"Purchase Invoice": "remarks",
+	"Delivery Note":    "instructions",
+	"Purchase Receipt": "instructions",
+}
+
+// applyTerms sets the terms, letter head and remarks of a new document.
+// The web form fills the company's default terms in; the API does not, so
+// they are looked up here when no --terms is given and the document has
+// none from its order. The terms text is copied as the web form does.
+func (c *Client) applyTerms(body map[string]interface{}, doctype string, t docTerms, selling bool) (docTerms, error) {
+	if t.terms == "" && stringField(body, "tc_name") == "" {
+		t.terms = c.defaultTerms(selling)
+	}
+	if t.terms != "" {
+		text, err := c.termsText(t.terms, selling)
+		if err != nil {
+			return t, err
+		}
+		body["tc_name"] = t.terms
+		body["terms"] = text
+	}
+
+	if t.letterhead != "" {
+		result, err := c.Request("GET", "Letter%20Head/"+url.PathEscape(t.letterhead), nil)
+		if err != nil {
+			return t, errorf("letter head not found: %s", t.letterhead)
+		}
+		if data, ok := result["data"].(map[string]interface{}); ok {
+			if disabled, _ := data["disabled"].(float64); disabled == 1 {
+				return t, errorf("letter head %s is disabled", t.letterhead)
+			}
+		}
+		body["letter_head"] = t.letterhead
+	}
+
+	if field, ok := remarkFields[doctype]; ok && t.remarks != "" {
+		body[field] = t.remarks
+	}
+	return t, nil
+}
+
+// termsText returns the text of a Terms and Conditions, failing when it is
+// disabled or not meant for selling (or buying) documents
+func (c *Client) termsText(name string, selling bool) (string, error) {
+	result, err := c.Request("GET", "Terms%20and%20Conditions/"+url.PathEscape(name), nil)
+	if err != nil {
+		return "", errorf("terms and conditions not found: %s", name)
+	}
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return "", errorf("terms and conditions not found: %s", name)
+	}
+	if disabled, _ := data["disabled"].(float64); disabled == 1 {
+		return "", errorf("terms and conditions %s are disabled", name)
+	}
+	use := "selling"
+	if !selling {
+		use = "buying"
+	}
+	if applies, ok := data[use].(float64); ok && applies == 0 {
+		return "", errorf("terms and conditions %s are not for %s", name, use)
+	}
+	return stringField(data, "terms"), nil
+}
+
+// defaultTerms returns the company's default selling or buying terms
+func (c *Client) defaultTerms(selling bool) string {
+	company, err := c.GetCompany()
+	if err != nil {
+		return ""
+	}
+	field := "default_selling_terms"
+	if !selling {
+		field = "default_buying_terms"
+	}
+	result, err := c.CallMethod("GET", "frappe.client.get_value?doctype=Company&fieldname="+field+"&filters="+url.QueryEscape(company), nil)
+	if err != nil {
+		return ""
+	}
+	message, _ := result["message"].(map[string]interface{})
+	return stringField(message, field)
+}
+
+// copyOrderTerms copies the terms and letter head of the order a document
+// is made from, as ERPNext's own mapping does
+func copyOrderTerms(body, order map[string]interface{}) {
+	for _, field := range []string{"tc_name", "terms", "letter_head"} {
+		if value := stringField(order, field); value != "" {
+			body[field] = value
+		}
+	}
+}
+
+// finishRemarks leaves the remarks of a doctype without a remarks field as
+// a comment on the new document. The document stands without it, so a
+// failure is only reported.
+func (c *Client) finishRemarks(doctype, name string, t docTerms) {
+	if err := c.commentRemarks(doctype, name, t); err != nil {
+		printf("  %s⚠ Remarks not saved: %s%s\n", Yellow, err, Reset)
+	}
+}
+
+// commentRemarks adds the remarks of a doctype without a remarks field to
+// the new document's timeline
+func (c *Client) commentRemarks(doctype, name string, t docTerms) error {
+	if _, ok := remarkFields[doctype]; ok || t.remarks == "" {
+		return nil
+	}
+	return c.addComment(doctype, name, t.remarks)
+}
+
+// printTerms prints the terms, letter head and remarks of a new document
+func printTerms(t docTerms) {
+	if t.terms != "" {
+		printf("  Terms: %s\n", t.terms)
+	}
+	if t.letterhead != "" {
+		printf("  Letter Head: %s\n", t.letterhead)
+	}
+	if t.remarks != "" {
+		printf("  Remarks: %s\n", firstLine(t.remarks))
+	}
+}
+
+// docTermsLines are the letter head, remarks and terms of a document,
+// shared by the get commands and the TUI detail views
+func docTermsLines(data map[string]interface{}) []string {
+	var lines []string
+	if letterhead := stringField(data, "letter_head"); letterhead != "" {
+		lines = append(lines, tf("  Letter Head: %s", letterhead))
+	}
+	for _, field := range []string{"remarks", "instructions"} {
+		if remarks := strings.TrimSpace(stringField(data, field)); remarks != "" && remarks != "No Remarks" {
+			lines = append(lines, tf("  Remarks: %s", remarks))
+		}
+	}
+	text := htmlToText(stringField(data, "terms"))
+	if text == "" {
+		return lines
+	}
+	label := T("  Terms:")
+	if name := stringField(data, "tc_name"); name != "" {
+		label += " " + name
+	}
+	lines = append(lines, label)
+	for _, line := range strings.Split(text, "\n") {
+		lines = append(lines, "    "+line)
+	}
+	return lines
+}
+
+// printDocTerms prints the terms lines of a get command
+func printDocTerms(data map[string]interface{}) {
+	lines := docTermsLines(data)
+	if len(lines) == 0 {
+		return
+	}
+	fmt.Println()
+	for _, line := range lines {
+		fmt.Println(line)
+	}
+}
@@ -0,0 +1,237 @@
+package erp
+
+import (
+	"bufio"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// ============================================================================
+// THEMES AND PLAIN OUTPUT (ERP_THEME, --no-color, --ascii)
+// ============================================================================
+
+// theme is the TUI palette. Colors are hex ("#7D56F4") or ANSI numbers
+// ("5"); empty means the terminal's own color.
+type theme struct {
+	accent    string // Titles, selection and borders
+	title     string // Title text on the accent
+	statusFg  string
+	statusBg  string
+	good      string
+	warn      string
+	bad       string
+	badgeText string // Text on good, bad and accent badges
+	warnText  string // Text on warn badges
+	muted     string // Help lines
+	faint     string // Breadcrumbs, credits and hints
+	border    string // rounded, normal, thick, double or ascii
+	reverse   bool   // Show the selection reversed instead of colored
+}
+
+// builtinThemes are the themes ERP_THEME can name
+var builtinThemes = map[string]theme{
+	"default": {
+		accent: "#7D56F4", title: "#FAFAFA", statusFg: "#FFFDF5", statusBg: "#333333",
+		good: "#04B575", warn: "#FF9500", bad: "#FF4444", badgeText: "#FFF", warnText: "#000",
+		muted: "#626262", faint: "#888888", border: "rounded",
+	},
+	// Darker colors that stay readable on a white background
+	"light": {
+		accent: "#5A3FC0", title: "#FFFFFF", statusFg: "#000000", statusBg: "#DDDDDD",
+		good: "#00703C", warn: "#A35200", bad: "#C00000", badgeText: "#FFF", warnText: "#FFF",
+		muted: "#4A4A4A", faint: "#5E5E5E", border: "rounded",
+	},
+	// The terminal's own text color, the 16 basic colors and a reversed
+	// selection, readable on any background and on Windows conhost
+	"high-contrast": {
+		accent: "", title: "", statusFg: "", statusBg: "",
+		good: "2", warn: "3", bad: "1", badgeText: "15", warnText: "0",
+		muted: "", faint: "", border: "thick", reverse: true,
+	},
+}
+
+// themeBorders are the border styles a theme can use
+var themeBorders = map[string]lipgloss.Border{
+	"rounded": lipgloss.RoundedBorder(),
+	"normal":  lipgloss.NormalBorder(),
+	"thick":   lipgloss.ThickBorder(),
+	"double":  lipgloss.DoubleBorder(),
+	"ascii":   lipgloss.ASCIIBorder(),
+}
+
+// asciiMode replaces box drawing, arrows and blocks with ASCII (--ascii)
+var asciiMode bool
+
+// ConfigureOutput sets up plain output before any command runs. Colors are
+// also left out when NO_COLOR is set, TERM is dumb or stdout is not a
+// terminal; a dumb terminal gets ASCII too.
+func ConfigureOutput(noColor, ascii bool) {
+	dumb := os.Getenv("TERM") == "dumb"
+	if info, err := os.Stdout.Stat(); err == nil && info.Mode()&os.ModeCharDevice == 0 {
+		noColor = true
+	}
+	if noColor || dumb || os.Getenv("NO_COLOR") != "" {
+		Red, Green, Yellow, Blue, Cyan, Reset = "", "", "", "", "", ""
+		lipgloss.SetColorProfile(termenv.Ascii)
+	}
+	asciiMode = ascii || dumb
+}
+
+// loadTheme returns a built-in theme by name, or reads a theme file. Theme
+// files hold key=value lines like the config; base=<theme> starts from a
+// built-in theme, the default one otherwise.
+func loadTheme(nameOrPath string) (theme, error) {
+	if nameOrPath == "" {
+		return builtinThemes["default"], nil
+	}
+	if t, ok := builtinThemes[nameOrPath]; ok {
+		return t, nil
+	}
+
+	file, err := os.Open(nameOrPath)
+	if err != nil {
+		return theme{}, errorf("unknown theme %q (use default, light, high-contrast or a theme file)", nameOrPath)
+	}
+	defer file.Close()
+
+	t := builtinThemes["default"]
+	colors := map[string]*string{
+		"accent": &t.accent, "title": &t.title, "status-fg": &t.statusFg, "status-bg": &t.statusBg,
+		"good": &t.good, "warn": &t.warn, "bad": &t.bad, "badge-text": &t.badgeText,
+		"warn-text": &t.warnText, "muted": &t.muted, "faint": &t.faint,
+	}
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(text, "=")
+		if !ok {
+			return theme{}, errorf("%s:%d: expected key=value", nameOrPath, line)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.Trim(strings.TrimSpace(value), "\"'")
+
+		switch {
+		case key == "base":
+			base, ok := builtinThemes[value]
+			if !ok {
+				return theme{}, errorf("%s:%d: unknown base theme %q", nameOrPath, line, value)
+			}
+			t = base
+		case key == "border":
+			if _, ok := themeBorders[value]; !ok {
+				return theme{}, errorf("%s:%d: unknown border %q (use rounded, normal, thick, double or ascii)", nameOrPath, line, value)
+			}
+			t.border = value
+		case key == "reverse":
+			t.reverse = value == "1" || value == "true"
+		case colors[key] != nil:
+			*colors[key] = value
+		default:
+			return theme{}, errorf("%s:%d: unknown key %q", nameOrPath, line, key)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return theme{}, err
+	}
+	return t, nil
+}
+
+// themeColor turns a theme color into a lipgloss color
+func themeColor(c string) lipgloss.TerminalColor {
+	if c == "" {
+		return lipgloss.NoColor{}
+	}
+	return lipgloss.Color(c)
+}
+
+// asciiReplacer maps the glyphs of the TUI to ASCII of the same width
+var asciiReplacer = strings.NewReplacer(
+	"•", "|", "·", ".", "…", ".", "═", "=", "─", "-", "━", "-", "│", "|", "┃", "|",
+	"┌", "+", "┐", "+", "└", "+", "┘", "+", "╭", "+", "╮", "+", "╰", "+", "╯", "+",
+	"┏", "+", "┓", "+", "┗", "+", "┛", "+",
+	"↑", "^", "↓", "v", "←", "<", "→", ">", "▸", ">", "●", "*", "✓", "+", "✗", "x",
+	"≥", ">", "≤", "<", "█", "#", "░", ".", "▏", "|",
+	"▁", "_", "▂", ".", "▃", "-", "▄", "~", "▅", "=", "▆", "+", "▇", "*",
+)
+
+// asciiText returns s with its glyphs replaced in ASCII mode
+func asciiText(s string) string {
+	if !asciiMode {
+		return s
+	}
+	return asciiReplacer.Replace(s)
+}
+
+// filledStyle is text on a colored background, or reversed when the theme
+// leaves the background to the terminal
+func filledStyle(fg, bg string) lipgloss.Style {
+	if bg == "" {
+		return lipgloss.NewStyle().Reverse(true)
+	}
+	return lipgloss.NewStyle().Foreground(themeColor(fg)).Background(themeColor(bg))
+}
+
+// applyTheme sets the TUI and setup wizard styles from a theme
+func applyTheme(t theme) {
+	border := themeBorders[t.border]
+	if asciiMode || t.border == "" {
+		border = themeBorders["ascii"]
+	}
+	accent := lipgloss.NewStyle().Foreground(themeColor(t.accent))
+	if t.reverse {
+		accent = lipgloss.NewStyle().Reverse(true)
+	}
+
+	titleStyle = filledStyle(t.title, t.accent).Bold(true).Padding(0, 1)
+	statusBarStyle = filledStyle(t.statusFg, t.statusBg).Padding(0, 1)
+	vpnStyle = lipgloss.NewStyle().Foreground(themeColor(t.good)).Bold(true)
+	internetStyle = lipgloss.NewStyle().Foreground(themeColor(t.warn)).Bold(true)
+	errorStyle = lipgloss.NewStyle().Foreground(themeColor(t.bad)).Bold(true)
+	successStyle = lipgloss.NewStyle().Foreground(themeColor(t.good)).Bold(true)
+	helpStyle = lipgloss.NewStyle().Foreground(themeColor(t.muted))
+	creditStyle = lipgloss.NewStyle().Foreground(themeColor(t.faint)).Italic(true)
+	selectedStyle = accent.Bold(true)
+	selectedDescStyle = accent
+	spinnerStyle = lipgloss.NewStyle().Foreground(themeColor(t.accent))
+	boxStyle = lipgloss.NewStyle().Border(border).BorderForeground(themeColor(t.accent)).Padding(1, 2)
+	breadcrumbStyle = lipgloss.NewStyle().Foreground(themeColor(t.faint))
+
+	draftBadge = filledStyle(t.warnText, t.warn).Padding(0, 1)
+	submittedBadge = filledStyle(t.badgeText, t.good).Padding(0, 1)
+	cancelledBadge = filledStyle(t.badgeText, t.bad).Padding(0, 1)
+	paidBadge = filledStyle(t.badgeText, t.good).Padding(0, 1)
+	unpaidBadge = filledStyle(t.warnText, t.warn).Padding(0, 1)
+	pendingBadge = filledStyle(t.badgeText, t.accent).Padding(0, 1)
+	notificationSuccess = filledStyle(t.badgeText, t.good).Padding(0, 1).Bold(true)
+	notificationError = filledStyle(t.badgeText, t.bad).Padding(0, 1).Bold(true)
+
+	setupTitleStyle = titleStyle.MarginBottom(1)
+	setupBoxStyle = boxStyle.Width(60)
+	setupLabelStyle = selectedStyle
+	setupHintStyle = creditStyle
+	setupSuccessStyle = successStyle
+	setupErrorStyle = errorStyle
+	setupHelpStyle = helpStyle
+}
+
+func init() {
+	applyTheme(builtinThemes["default"])
+}
+
+// newSpinner returns the loading spinner, in ASCII when asked for
+func newSpinner() spinner.Model {
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	if asciiMode {
+		s.Spinner = spinner.Line
+	}
+	s.Style = spinnerStyle
+	return s
+}
@@ -0,0 +1,535 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Time logs are added to the employee's draft Timesheet for the week (Monday
+// to Sunday), creating one when there is none, so a week is submitted as a
+// single document. Each log starts where the previous one of that day ended.
+
+// timesheetDayStart is when the first log of a day starts
+const timesheetDayStart = "09:00:00"
+
+// timesheetOptions holds flags for timesheet add/week/submit
+type timesheetOptions struct {
+	activity    string
+	date        string // YYYY-MM-DD, default today
+	description string
+}
+
+func parseTimesheetOptions(args []string) (timesheetOptions, error) {
+	opts := timesheetOptions{}
+	for _, arg := range args {
+		if len(arg) > 11 && arg[:11] == "--activity=" {
+			opts.activity = arg[11:]
+		}
+		if len(arg) > 7 && arg[:7] == "--date=" {
+			if _, err := time.Parse("2006-01-02", arg[7:]); err != nil {
+				return opts, errorf("invalid date (expected YYYY-MM-DD): %s", arg[7:])
+			}
+			opts.date = arg[7:]
+		}
+		if len(arg) > 7 && arg[:7] == "--desc=" {
+			opts.description = arg[7:]
+		}
+	}
+	return opts, nil
+}
+
+// CmdTimesheet handles Timesheet commands for the API user's employee
+func (c *Client) CmdTimesheet(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli timesheet <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: add, week, list, get, submit"))
+		fmt.Println()
+		fmt.Println(T("Time is logged against the employee linked to your API user."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli timesheet add \"Website Redesign\" 2.5"))
+		fmt.Println(T("  erp-cli timesheet add PROJ-0001 4 --activity=Development --desc=\"API work\""))
+		fmt.Println(T("  erp-cli timesheet add PROJ-0001 1 --date=2025-01-06"))
+		fmt.Println(T("  erp-cli timesheet week"))
+		fmt.Println(T("  erp-cli timesheet week --date=2025-01-06"))
+		fmt.Println(T("  erp-cli timesheet list"))
+		fmt.Println(T("  erp-cli timesheet get TS-2025-00001"))
+		fmt.Println(T("  erp-cli timesheet submit                 # This week's draft"))
+		fmt.Println(T("  erp-cli timesheet submit TS-2025-00001"))
+		return nil
+	}
+
+	switch args[0] {
+	case "add":
+		if len(args) < 3 {
+			return errorf("usage: erp-cli timesheet add <project> <hours> [--activity=X] [--date=YYYY-MM-DD] [--desc=X]")
+		}
+		hours, err := strconv.ParseFloat(args[2], 64)
+		if err != nil || hours <= 0 {
+			return errorf("invalid hours: %s", args[2])
+		}
+		opts, err := parseTimesheetOptions(args[3:])
+		if err != nil {
+			return err
+		}
+		return c.timesheetAdd(args[1], hours, opts)
+	case "week":
+		opts, err := parseTimesheetOptions(args[1:])
+		if err != nil {
+			return err
+		}
+		return c.timesheetWeek(opts.date)
+	case "list":
+		return c.timesheetList()
+	case "get":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli timesheet get <name>")
+		}
+		return c.timesheetGet(args[1])
+	case "submit":
+		name := ""
+		if len(args) > 1 && !strings.HasPrefix(args[1], "--") {
+			name = args[1]
+		}
+		opts, err := parseTimesheetOptions(args[1:])
+		if err != nil {
+			return err
+		}
+		return c.timesheetSubmit(name, opts.date)
+	default:
+		return errorf("unknown timesheet subcommand: %s", args[0])
+	}
+}
+
+// weekStart returns the Monday of the week containing date (YYYY-MM-DD)
+func weekStart(date string) (time.Time, error) {
+	day, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return time.Time{}, errorf("invalid date (expected YYYY-MM-DD): %s", date)
+	}
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset), nil
+}
+
+// fetchWeekTimesheets returns the employee's draft and submitted Timesheets
+// overlapping the week starting on monday, with their time logs
+func (c *Client) fetchWeekTimesheets(employee string, monday time.Time) ([]map[string]interface{}, error) {
+	filters, err := encodeFilters([][]interface{}{
+		{"employee", "=", employee},
+		{"docstatus", "<", 2},
+		{"start_date", "<=", monday.AddDate(0, 0, 6).Format("2006-01-02")},
+		{"end_date", ">=", monday.Format("2006-01-02")},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.Request("GET", "Timesheet?limit_page_length=0&fields=[\"name\"]&order_by=creation%20asc&filters="+filters, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var sheets []map[string]interface{}
+	data, _ := result["data"].([]interface{})
+	for _, entry := range data {
+		m, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := m["name"].(string)
+		doc, err := c.Request("GET", "Timesheet/"+url.PathEscape(name), nil)
+		if err != nil {
+			return nil, err
+		}
+		if sheet, ok := doc["data"].(map[string]interface{}); ok {
+			sheets = append(sheets, sheet)
+		}
+	}
+	return sheets, nil
+}
+
+// parseLogTime parses a time log datetime ("2006-01-02 15:04:05[.ffffff]")
+func parseLogTime(v interface{}) (time.Time, bool) {
+	s, _ := v.(string)
+	if len(s) < 19 {
+		return time.Time{}, false
+	}
+	t, err := time.Parse("2006-01-02 15:04:05", s[:19])
+	return t, err == nil
+}
+
+// nextLogStart returns when a new log on date should start: after the last
+// log of that day in any of the week's timesheets, or at timesheetDayStart
+func nextLogStart(sheets []map[string]interface{}, date string) time.Time {
+	start, _ := time.Parse("2006-01-02 15:04:05", date+" "+timesheetDayStart)
+	for _, sheet := range sheets {
+		logs, _ := sheet["time_logs"].([]interface{})
+		for _, l := range logs {
+			log, ok := l.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			end, ok := parseLogTime(log["to_time"])
+			if ok && end.Format("2006-01-02") == date && end.After(start) {
+				start = end
+			}
+		}
+	}
+	return start
+}
+
+func (c *Client) timesheetAdd(project string, hours float64, opts timesheetOptions) error {
+	date := opts.date
+	if date == "" {
+		date = c.Today()
+	}
+	monday, err := weekStart(date)
+	if err != nil {
+		return err
+	}
+
+	emp, err := c.currentEmployee()
+	if err != nil {
+		return err
+	}
+	employee, _ := emp["name"].(string)
+
+	printf("%sLogging %.2fh on %s for %s (%s)...%s\n", Blue, hours, project, date, emp["employee_name"], Reset)
+
+	sheets, err := c.fetchWeekTimesheets(employee, monday)
+	if err != nil {
+		return err
+	}
+
+	from := nextLogStart(sheets, date)
+	to := from.Add(time.Duration(hours * float64(time.Hour)))
+	timeLog := map[string]interface{}{
+		"project":   project,
+		"hours":     hours,
+		"from_time": from.Format("2006-01-02 15:04:05"),
+		"to_time":   to.Format("2006-01-02 15:04:05"),
+	}
+	if opts.activity != "" {
+		timeLog["activity_type"] = opts.activity
+	}
+	if opts.description != "" {
+		timeLog["description"] = opts.description
+	}
+
+	var draft map[string]interface{}
+	for _, sheet := range sheets {
+		if docStatus, _ := sheet["docstatus"].(float64); docStatus == 0 {
+			draft = sheet
+			break
+		}
+	}
+
+	if draft == nil {
+		company, _ := emp["company"].(string)
+		if company == "" {
+			if company, err = c.GetCompany(); err != nil {
+				return err
+			}
+		}
+		body := map[string]interface{}{
+			"employee":  employee,
+			"company":   company,
+			"time_logs": []interface{}{timeLog},
+		}
+		result, err := c.Request("POST", "Timesheet", body)
+		if err != nil {
+			return err
+		}
+		if data, ok := result["data"].(map[string]interface{}); ok {
+			printf("%s✓ Timesheet created: %s%s\n", Green, data["name"], Reset)
+		}
+	} else {
+		name, _ := draft["name"].(string)
+		logs, _ := draft["time_logs"].([]interface{})
+		body := map[string]interface{}{
+			"time_logs": append(logs, timeLog),
+		}
+		if err := c.updateDraft("Timesheet", name, draft, body); err != nil {
+			return err
+		}
+		printf("%s✓ Added to timesheet: %s%s\n", Green, name, Reset)
+	}
+
+	fmt.Printf("  %s %s-%s\n", date, from.Format("15:04"), to.Format("15:04"))
+	return nil
+}
+
+// timesheetRow is one project/activity line of the week grid
+type timesheetRow struct {
+	label string
+	hours [7]float64
+}
+
+// timesheetGrid holds a week of logged hours per project/activity and day
+type timesheetGrid struct {
+	monday    time.Time
+	rows      []timesheetRow
+	totals    [7]float64
+	drafts    []string // Draft timesheets of the week
+	submitted []string
+}
+
+// buildTimesheetGrid sums the time logs of sheets into a week grid
+func buildTimesheetGrid(monday time.Time, sheets []map[string]interface{}) *timesheetGrid {
+	grid := &timesheetGrid{monday: monday}
+	rows := map[string]*timesheetRow{}
+
+	for _, sheet := range sheets {
+		name, _ := sheet["name"].(string)
+		if docStatus, _ := sheet["docstatus"].(float64); docStatus == 0 {
+			grid.drafts = append(grid.drafts, name)
+		} else {
+			grid.submitted = append(grid.submitted, name)
+		}
+
+		logs, _ := sheet["time_logs"].([]interface{})
+		for _, l := range logs {
+			log, ok := l.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			from, ok := parseLogTime(log["from_time"])
+			if !ok {
+				continue
+			}
+			day := int(from.Sub(monday).Hours() / 24)
+			if day < 0 || day > 6 {
+				continue
+			}
+
+			label, _ := log["project"].(string)
+			if label == "" {
+				label = T("(no project)")
+			}
+			if activity, _ := log["activity_type"].(string); activity != "" {
+				label += " / " + activity
+			}
+
+			row, ok := rows[label]
+			if !ok {
+				row = &timesheetRow{label: label}
+				rows[label] = row
+			}
+			hours, _ := log["hours"].(float64)
+			row.hours[day] += hours
+			grid.totals[day] += hours
+		}
+	}
+
+	for _, row := range rows {
+		grid.rows = append(grid.rows, *row)
+	}
+	sort.Slice(grid.rows, func(i, j int) bool { return grid.rows[i].label < grid.rows[j].label })
+	return grid
+}
+
+// lines renders the grid as text: one column per day plus a total
+func (g *timesheetGrid) lines() []string {
+	labelWidth := 20
+	for _, row := range g.rows {
+		if len(row.label) > labelWidth {
+			labelWidth = len(row.label)
+		}
+	}
+	if labelWidth > 40 {
+		labelWidth = 40
+	}
+
+	var lines []string
+	header := fmt.Sprintf("%-*s", labelWidth, "Project")
+	for i := 0; i < 7; i++ {
+		header += fmt.Sprintf(" %6s", g.monday.AddDate(0, 0, i).Format("Mon 2"))
+	}
+	lines = append(lines, header+"  Total")
+
+	formatRow := func(label string, hours [7]float64) string {
+		if len(label) > labelWidth {
+			label = label[:labelWidth-1] + "…"
+		}
+		line := fmt.Sprintf("%-*s", labelWidth, label)
+		total := 0.0
+		for _, h := range hours {
+			if h == 0 {
+				line += fmt.Sprintf(" %6s", "·")
+			} else {
+				line += fmt.Sprintf(" %6.2f", h)
+			}
+			total += h
+		}
+		return line + fmt.Sprintf("  %5.2f", total)
+	}
+
+	for _, row := range g.rows {
+		lines = append(lines, formatRow(row.label, row.hours))
+	}
+	lines = append(lines, strings.Repeat("─", labelWidth+7*7+7))
+	lines = append(lines, formatRow("Total", g.totals))
+	return lines
+}
+
+// loadTimesheetGrid fetches the current employee's week containing date
+func (c *Client) loadTimesheetGrid(date string) (*timesheetGrid, map[string]interface{}, error) {
+	monday, err := weekStart(date)
+	if err != nil {
+		return nil, nil, err
+	}
+	emp, err := c.currentEmployee()
+	if err != nil {
+		return nil, nil, err
+	}
+	employee, _ := emp["name"].(string)
+	sheets, err := c.fetchWeekTimesheets(employee, monday)
+	if err != nil {
+		return nil, nil, err
+	}
+	return buildTimesheetGrid(monday, sheets), emp, nil
+}
+
+func (c *Client) timesheetWeek(date string) error {
+	if date == "" {
+		date = c.Today()
+	}
+	printf("%sFetching timesheets...%s\n", Blue, Reset)
+
+	grid, emp, err := c.loadTimesheetGrid(date)
+	if err != nil {
+		return err
+	}
+
+	printf("\n%sWeek of %s - %s%s\n", Cyan, grid.monday.Format("2006-01-02"), emp["employee_name"], Reset)
+	if len(grid.rows) == 0 {
+		printf("%sNo time logged this week%s\n", Yellow, Reset)
+		return nil
+	}
+	for _, line := range grid.lines() {
+		fmt.Printf("  %s\n", line)
+	}
+
+	fmt.Println()
+	if len(grid.drafts) > 0 {
+		printf("  Draft: %s%s%s (submit with: erp-cli timesheet submit)\n", Yellow, strings.Join(grid.drafts, ", "), Reset)
+	}
+	if len(grid.submitted) > 0 {
+		printf("  Submitted: %s%s%s\n", Green, strings.Join(grid.submitted, ", "), Reset)
+	}
+	return nil
+}
+
+func (c *Client) timesheetList() error {
+	printf("%sFetching timesheets...%s\n", Blue, Reset)
+
+	emp, err := c.currentEmployee()
+	if err != nil {
+		return err
+	}
+
+	filters, err := encodeFilters([][]interface{}{{"employee", "=", emp["name"]}})
+	if err != nil {
+		return err
+	}
+	result, err := c.Request("GET", "Timesheet?"+c.pageLimit(0)+"&fields=[\"name\",\"start_date\",\"end_date\",\"total_hours\",\"status\",\"docstatus\"]&order_by=start_date%20desc&filters="+filters, nil)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].([]interface{}); ok {
+		if len(data) == 0 {
+			printf("%sNo timesheets found%s\n", Yellow, Reset)
+			return nil
+		}
+
+		printf("\n%sTimesheets (%d):%s\n", Cyan, len(data), Reset)
+		c.printPageNote(len(data))
+		for _, item := range data {
+			if m, ok := item.(map[string]interface{}); ok {
+				status := m["status"]
+				statusColor := Yellow
+				if status == "Submitted" || status == "Completed" || status == "Billed" {
+					statusColor = Green
+				} else if status == "Cancelled" {
+					statusColor = Red
+				}
+				hours, _ := m["total_hours"].(float64)
+				printf("  %s - %s to %s | %.2fh | %s%s%s\n",
+					m["name"], m["start_date"], m["end_date"], hours, statusColor, status, Reset)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Client) timesheetGet(name string) error {
+	printf("%sFetching timesheet: %s%s\n", Blue, name, Reset)
+
+	result, err := c.Request("GET", "Timesheet/"+url.PathEscape(name), nil)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].(map[string]interface{}); ok {
+		printf("\n%sTimesheet: %s%s\n", Cyan, name, Reset)
+		printf("  Employee: %s (%s)\n", data["employee_name"], data["employee"])
+		printf("  Period: %s to %s\n", data["start_date"], data["end_date"])
+		printf("  Status: %s\n", data["status"])
+		hours, _ := data["total_hours"].(float64)
+		printf("  Total hours: %.2f\n", hours)
+
+		if logs, ok := data["time_logs"].([]interface{}); ok && len(logs) > 0 {
+			printf("\n  %sTime Logs:%s\n", Yellow, Reset)
+			for _, l := range logs {
+				if log, ok := l.(map[string]interface{}); ok {
+					from, _ := parseLogTime(log["from_time"])
+					to, _ := parseLogTime(log["to_time"])
+					h, _ := log["hours"].(float64)
+					line := fmt.Sprintf("    - %s %s-%s %.2fh %s", from.Format("2006-01-02"), from.Format("15:04"), to.Format("15:04"), h, stringField(log, "project"))
+					if activity := stringField(log, "activity_type"); activity != "" {
+						line += " / " + activity
+					}
+					if desc := stringField(log, "description"); desc != "" {
+						line += ": " + desc
+					}
+					fmt.Println(line)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// timesheetSubmit submits a timesheet, or the draft(s) of the week containing date
+func (c *Client) timesheetSubmit(name, date string) error {
+	names := []string{name}
+	if name == "" {
+		if date == "" {
+			date = c.Today()
+		}
+		printf("%sFetching timesheets...%s\n", Blue, Reset)
+		grid, _, err := c.loadTimesheetGrid(date)
+		if err != nil {
+			return err
+		}
+		if len(grid.drafts) == 0 {
+			printf("%sNo draft timesheet for the week of %s%s\n", Yellow, grid.monday.Format("2006-01-02"), Reset)
+			return nil
+		}
+		names = grid.drafts
+	}
+
+	for _, n := range names {
+		printf("%sSubmitting timesheet: %s%s\n", Blue, n, Reset)
+		if err := c.submitDocument("Timesheet", n); err != nil {
+			return err
+		}
+		printf("%s✓ Timesheet submitted: %s%s\n", Green, n, Reset)
+	}
+	return nil
+}
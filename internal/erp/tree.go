@@ -0,0 +1,93 @@
+package erp
+
+import "sort"
+
+// treeNode is a node in a parent/child hierarchy (warehouses, item groups)
+type treeNode struct {
+	name     string
+	parent   string
+	isGroup  bool
+	disabled bool
+	children []*treeNode
+}
+
+// treeLine is a single rendered row of a tree
+type treeLine struct {
+	prefix string // Box-drawing prefix, e.g. "│   ├── "
+	node   *treeNode
+}
+
+// buildTree links nodes to their parents and returns the roots sorted by name.
+// Nodes whose parent is not in the set are treated as roots.
+func buildTree(nodes []*treeNode) []*treeNode {
+	byName := make(map[string]*treeNode, len(nodes))
+	for _, n := range nodes {
+		byName[n.name] = n
+	}
+
+	var roots []*treeNode
+	for _, n := range nodes {
+		if p, ok := byName[n.parent]; ok && n.parent != n.name {
+			p.children = append(p.children, n)
+		} else {
+			roots = append(roots, n)
+		}
+	}
+
+	sortTree(roots)
+	return roots
+}
+
+func sortTree(nodes []*treeNode) {
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].name < nodes[j].name
+	})
+	for _, n := range nodes {
+		sortTree(n.children)
+	}
+}
+
+// flattenTree walks the tree depth-first and returns one line per node
+func flattenTree(roots []*treeNode) []treeLine {
+	var lines []treeLine
+	var walk func(nodes []*treeNode, indent string)
+	walk = func(nodes []*treeNode, indent string) {
+		for i, n := range nodes {
+			last := i == len(nodes)-1
+			branch, childIndent := "├── ", "│   "
+			if last {
+				branch, childIndent = "└── ", "    "
+			}
+			lines = append(lines, treeLine{prefix: indent + branch, node: n})
+			walk(n.children, indent+childIndent)
+		}
+	}
+
+	for _, root := range roots {
+		lines = append(lines, treeLine{node: root})
+		walk(root.children, "")
+	}
+	return lines
+}
+
+// findTreeNode returns the node with the given name, or nil if not found
+func findTreeNode(roots []*treeNode, name string) *treeNode {
+	for _, n := range roots {
+		if n.name == name {
+			return n
+		}
+		if found := findTreeNode(n.children, name); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// subtreeNames returns the names of a node and all its descendants
+func subtreeNames(n *treeNode) []string {
+	names := []string{n.name}
+	for _, child := range n.children {
+		names = append(names, subtreeNames(child)...)
+	}
+	return names
+}
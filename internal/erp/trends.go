@@ -0,0 +1,233 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// trendMonths is how many months the trends panel covers, this one included
+const trendMonths = 12
+
+// trendTopCustomers is how many customers the trends bar chart ranks
+const trendTopCustomers = 5
+
+// trendBarWidth is the length of the longest top-customer bar
+const trendBarWidth = 20
+
+// sparkBlocks are the sparkline levels, lowest first
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// TrendData holds the monthly series of the trends panel, oldest month first
+type TrendData struct {
+	Months       []string // YYYY-MM
+	Sales        []float64
+	Purchases    []float64
+	StockValue   []float64 // At each month end; now for this month
+	TopCustomers []rankedAmount
+}
+
+// fetchTrendMetrics fetches the monthly sales, purchases and stock value of
+// the last trendMonths months. Totals are summed here from the documents,
+// like the other panels do.
+func (c *Client) fetchTrendMetrics(data *ReportData, mu *sync.Mutex) {
+	now := c.Now()
+	first := time.Date(now.Year(), now.Month()-trendMonths+1, 1, 0, 0, 0, 0, now.Location())
+	trends := &TrendData{
+		Sales:      make([]float64, trendMonths),
+		Purchases:  make([]float64, trendMonths),
+		StockValue: make([]float64, trendMonths),
+	}
+	index := make(map[string]int, trendMonths)
+	for i := 0; i < trendMonths; i++ {
+		month := first.AddDate(0, i, 0).Format("2006-01")
+		trends.Months = append(trends.Months, month)
+		index[month] = i
+	}
+	since := first.Format("2006-01-02")
+	var errs []string
+
+	// Sales, and the customers they went to
+	customers := make(map[string]float64)
+	rows, err := c.trendRows("Sales Invoice", since, "base_grand_total", "customer")
+	if err == nil {
+		for _, row := range rows {
+			if i, ok := index[monthOf(stringField(row, "posting_date"))]; ok {
+				value, _ := row["base_grand_total"].(float64)
+				trends.Sales[i] += value
+				customers[stringField(row, "customer")] += value
+			}
+		}
+	} else {
+		errs = append(errs, "Failed to fetch sales trend")
+	}
+	trends.TopCustomers = topAmounts(customers, trendTopCustomers)
+
+	// Purchases
+	rows, err = c.trendRows("Purchase Invoice", since, "base_grand_total")
+	if err == nil {
+		for _, row := range rows {
+			if i, ok := index[monthOf(stringField(row, "posting_date"))]; ok {
+				value, _ := row["base_grand_total"].(float64)
+				trends.Purchases[i] += value
+			}
+		}
+	} else {
+		errs = append(errs, "Failed to fetch purchase trend")
+	}
+
+	// Stock value: today's value from Bin, walked back month by month
+	// through the ledger's value changes
+	if err := c.fetchStockTrend(trends, since, index); err != nil {
+		errs = append(errs, "Failed to fetch stock value trend")
+	}
+
+	mu.Lock()
+	data.Trends = trends
+	data.Errors = append(data.Errors, errs...)
+	mu.Unlock()
+}
+
+// trendRows fetches the submitted documents posted since the date
+func (c *Client) trendRows(doctype, since string, fields ...string) ([]map[string]interface{}, error) {
+	filters, err := encodeFilters([][]interface{}{
+		{"docstatus", "=", 1},
+		{"posting_date", ">=", since},
+	})
+	if err != nil {
+		return nil, err
+	}
+	fields = append([]string{"posting_date"}, fields...)
+	endpoint := fmt.Sprintf("%s?limit_page_length=0&fields=[\"%s\"]&filters=%s",
+		url.PathEscape(doctype), strings.Join(fields, "\",\""), filters)
+	result, err := c.Request("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []map[string]interface{}
+	data, _ := result["data"].([]interface{})
+	for _, row := range data {
+		if m, ok := row.(map[string]interface{}); ok {
+			rows = append(rows, m)
+		}
+	}
+	return rows, nil
+}
+
+// fetchStockTrend fills the stock value at each month end
+func (c *Client) fetchStockTrend(trends *TrendData, since string, index map[string]int) error {
+	result, err := c.Request("GET", "Bin?limit_page_length=0&fields=[\"stock_value\"]", nil)
+	if err != nil {
+		return err
+	}
+	current := 0.0
+	bins, _ := result["data"].([]interface{})
+	for _, bin := range bins {
+		if m, ok := bin.(map[string]interface{}); ok {
+			value, _ := m["stock_value"].(float64)
+			current += value
+		}
+	}
+
+	filters, err := encodeFilters([][]interface{}{
+		{"is_cancelled", "=", 0},
+		{"posting_date", ">=", since},
+	})
+	if err != nil {
+		return err
+	}
+	result, err = c.Request("GET", "Stock%20Ledger%20Entry?limit_page_length=0&fields=[\"posting_date\",\"stock_value_difference\"]&filters="+filters, nil)
+	if err != nil {
+		return err
+	}
+	changes := make([]float64, len(trends.Months))
+	entries, _ := result["data"].([]interface{})
+	for _, entry := range entries {
+		if m, ok := entry.(map[string]interface{}); ok {
+			if i, ok := index[monthOf(stringField(m, "posting_date"))]; ok {
+				value, _ := m["stock_value_difference"].(float64)
+				changes[i] += value
+			}
+		}
+	}
+
+	// A month ends at today's value less everything that changed after it
+	value := current
+	for i := len(trends.Months) - 1; i >= 0; i-- {
+		trends.StockValue[i] = value
+		value -= changes[i]
+	}
+	return nil
+}
+
+// monthOf returns the YYYY-MM of a YYYY-MM-DD date
+func monthOf(date string) string {
+	if len(date) < 7 {
+		return ""
+	}
+	return date[:7]
+}
+
+// sparkline draws values as one line of block characters scaled from zero
+// to the largest value
+func sparkline(values []float64) string {
+	top := 0.0
+	for _, v := range values {
+		if v > top {
+			top = v
+		}
+	}
+	var b strings.Builder
+	for _, v := range values {
+		level := 0
+		if top > 0 && v > 0 {
+			level = int(v / top * float64(len(sparkBlocks)-1))
+		}
+		b.WriteRune(sparkBlocks[level])
+	}
+	return asciiText(b.String())
+}
+
+// trendChange describes the last value against the one before it
+func trendChange(values []float64) string {
+	if len(values) < 2 || values[len(values)-2] == 0 {
+		return ""
+	}
+	prev, last := values[len(values)-2], values[len(values)-1]
+	return fmt.Sprintf(" (%+.0f%%)", (last-prev)/prev*100)
+}
+
+func trendsPanelLines(c *Client, data *ReportData) []panelLine {
+	t := data.Trends
+	if t == nil || len(t.Months) == 0 {
+		return []panelLine{{label: T("No trend data")}}
+	}
+
+	first, _ := time.Parse("2006-01", t.Months[0])
+	last, _ := time.Parse("2006-01", t.Months[len(t.Months)-1])
+	series := func(values []float64) string {
+		return fmt.Sprintf("%s %s%s", sparkline(values), c.FormatCurrency(values[len(values)-1]), trendChange(values))
+	}
+
+	lines := []panelLine{
+		{label: fmt.Sprintf("%s → %s", first.Format("Jan 2006"), last.Format("Jan 2006"))},
+		{label: T("Sales"), value: series(t.Sales)},
+		{label: T("Purchases"), value: series(t.Purchases)},
+		{label: T("Stock Value"), value: series(t.StockValue)},
+	}
+
+	if len(t.TopCustomers) > 0 {
+		lines = append(lines, panelLine{}, panelLine{label: tf("Top Customers (%d months):", len(t.Months))})
+		top := t.TopCustomers[0].amount
+		for i, r := range t.TopCustomers {
+			lines = append(lines, panelLine{
+				label: fmt.Sprintf("  %d. %s", i+1, truncate(r.name, 14)),
+				value: fmt.Sprintf("%-*s %s", trendBarWidth, scaledBar(r.amount, top, trendBarWidth), c.FormatCurrency(r.amount)),
+			})
+		}
+	}
+	return lines
+}
@@ -21,96 +21,32 @@ const (
 	Year    = "2026"
 )
 
-// Styles
+// Styles, set from the theme by applyTheme
 var (
-	titleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("#FAFAFA")).
-			Background(lipgloss.Color("#7D56F4")).
-			Padding(0, 1)
-
-	statusBarStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FFFDF5")).
-			Background(lipgloss.Color("#333333")).
-			Padding(0, 1)
-
-	vpnStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#04B575")).
-			Bold(true)
-
-	internetStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FF9500")).
-			Bold(true)
-
-	errorStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FF0000")).
-			Bold(true)
-
-	successStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#04B575")).
-			Bold(true)
-
-	helpStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#626262"))
-
-	creditStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#888888")).
-			Italic(true)
-
-	selectedStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#7D56F4")).
-			Bold(true)
-
-	boxStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("#7D56F4")).
-			Padding(1, 2)
+	titleStyle        lipgloss.Style
+	statusBarStyle    lipgloss.Style
+	vpnStyle          lipgloss.Style
+	internetStyle     lipgloss.Style
+	errorStyle        lipgloss.Style
+	successStyle      lipgloss.Style
+	helpStyle         lipgloss.Style
+	creditStyle       lipgloss.Style
+	selectedStyle     lipgloss.Style
+	selectedDescStyle lipgloss.Style
+	spinnerStyle      lipgloss.Style
+	boxStyle          lipgloss.Style
+	breadcrumbStyle   lipgloss.Style
 
 	// Badge styles for status indicators
-	draftBadge = lipgloss.NewStyle().
-			Background(lipgloss.Color("#FFA500")).
-			Foreground(lipgloss.Color("#000")).
-			Padding(0, 1)
-
-	submittedBadge = lipgloss.NewStyle().
-			Background(lipgloss.Color("#04B575")).
-			Foreground(lipgloss.Color("#FFF")).
-			Padding(0, 1)
-
-	cancelledBadge = lipgloss.NewStyle().
-			Background(lipgloss.Color("#FF4444")).
-			Foreground(lipgloss.Color("#FFF")).
-			Padding(0, 1)
-
-	paidBadge = lipgloss.NewStyle().
-			Background(lipgloss.Color("#04B575")).
-			Foreground(lipgloss.Color("#FFF")).
-			Padding(0, 1)
-
-	unpaidBadge = lipgloss.NewStyle().
-			Background(lipgloss.Color("#FF9500")).
-			Foreground(lipgloss.Color("#000")).
-			Padding(0, 1)
-
-	pendingBadge = lipgloss.NewStyle().
-			Background(lipgloss.Color("#7D56F4")).
-			Foreground(lipgloss.Color("#FFF")).
-			Padding(0, 1)
-
-	notificationSuccess = lipgloss.NewStyle().
-				Background(lipgloss.Color("#04B575")).
-				Foreground(lipgloss.Color("#FFF")).
-				Padding(0, 1).
-				Bold(true)
-
-	notificationError = lipgloss.NewStyle().
-				Background(lipgloss.Color("#FF4444")).
-				Foreground(lipgloss.Color("#FFF")).
-				Padding(0, 1).
-				Bold(true)
-
-	breadcrumbStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#888888"))
+	draftBadge     lipgloss.Style
+	submittedBadge lipgloss.Style
+	cancelledBadge lipgloss.Style
+	paidBadge      lipgloss.Style
+	unpaidBadge    lipgloss.Style
+	pendingBadge   lipgloss.Style
+
+	notificationSuccess lipgloss.Style
+	notificationError   lipgloss.Style
 )
 
 // View represents different screens
@@ -124,6 +60,8 @@ const (
 	ViewSalesMenu
 	ViewPurchasingMenu
 	ViewPaymentsMenu
+	ViewManufacturingMenu
+	ViewHRMenu
 	// Inventory views
 	ViewAttributes
 	ViewItems
@@ -196,7 +134,31 @@ const (
 	ViewCreateAttrText
 	ViewCreateAttrNumeric
 	ViewCreateAttrSelect
-	ViewCreatePIFromPO // Create Purchase Invoice from PO detail
+	ViewCreatePIFromPO   // Create Purchase Invoice from PO detail
+	ViewInbox            // Quick-capture notes
+	ViewTimesheet        // Week grid of logged hours
+	ViewGenerateVariants // Attribute matrix of a template
+	// Manufacturing views
+	ViewWorkOrders
+	ViewWODetail
+	ViewCreateWO
+	ViewBOMs
+	ViewBOMDetail
+	ViewAttachFile     // Upload a file to a transaction detail view
+	ViewHistory        // Version history of a transaction detail view
+	ViewEditLines      // Editable items table of a draft SO/PO/Quotation
+	ViewListFilter     // Filter form of a paged list
+	ViewEditAttrValues // Values (or range) of an item attribute
+	// Subscription views
+	ViewSubscriptions
+	ViewSubscriptionDetail
+	ViewCreateSubscription
+	// HR views
+	ViewEmployees
+	ViewEmployeeDetail
+	ViewExpenseClaims
+	ViewExpenseClaimDetail
+	ViewCreateExpenseClaim
 )
 
 // MenuItem for the main menu
@@ -206,19 +168,23 @@ type MenuItem struct {
 	view        View
 }
 
-func (i MenuItem) Title() string       { return i.title }
-func (i MenuItem) Description() string { return i.description }
-func (i MenuItem) FilterValue() string { return i.title }
+func (i MenuItem) Title() string       { return T(i.title) }
+func (i MenuItem) Description() string { return T(i.description) }
+func (i MenuItem) FilterValue() string { return T(i.title) }
 
 // ListItem for resource lists
 type ListItem struct {
-	name    string
-	details string
-	amount  float64 // For totals in footer
-	status  string  // For status counts
+	name      string
+	details   string
+	amount    float64 // For totals in footer
+	status    string  // For status counts
+	prefix    string  // Tree indentation for hierarchical lists
+	date      string  // Columns of the paged transaction lists
+	party     string
+	docstatus int // 0 draft, 1 submitted, 2 cancelled (paged lists)
 }
 
-func (i ListItem) Title() string       { return i.name }
+func (i ListItem) Title() string       { return i.prefix + i.name }
 func (i ListItem) Description() string { return i.details }
 func (i ListItem) FilterValue() string { return i.name }
 
@@ -267,6 +233,65 @@ type Model struct {
 	// v1.8.0: List improvements
 	sortOrder int        // 0=date desc, 1=date asc, 2=name, 3=total
 	listItems []ListItem // Store items for totals calculation
+	noteID    int        // Inbox note being converted by the current form
+	// Timesheet week grid
+	timesheetDate     string // Any date in the shown week (empty = this week)
+	timesheetGrid     *timesheetGrid
+	timesheetEmployee string
+	// Variant generation from a template's attribute matrix
+	variantMatrix *variantMatrix
+	variantCursor int
+	variantPicked map[string]bool // Attribute value keys, see variantPickKey
+	// Variants of the template in the item detail view
+	templateVariants []templateVariant
+	// Create template wizard, kept while an attribute is created inline
+	templateWizard *templateWizard
+	// Attribute type chooser cursor
+	attrTypeCursor int
+	// Last PDF saved from a detail view, offered for opening
+	pdfPath string
+	// Attachments and activity of the document in a transaction detail view
+	docExtras *documentExtras
+	// Typeahead dropdown of the focused link input in a form
+	suggest suggestState
+	// Command palette shown over the current view (ctrl+p)
+	palette *commandPalette
+	// Items table being edited in ViewEditLines
+	lineEdit *lineEditor
+	// Paging and server-side search of the transaction lists
+	listPage      int
+	listMore      bool // A next page exists
+	listQuery     string
+	listFilter    listFilter
+	listSearching bool // The search input is open
+	searchInput   textinput.Model
+	// Rows picked with space in the paged lists, by name, and the batch
+	// action running on them
+	selected map[string]ListItem
+	batch    *batchRun
+	// Status board layout of the paged lists (b) and its focused card
+	boardMode bool
+	boardCol  int
+	boardRow  int
+	// Rebound action keys (key.<view>.<action> in the config)
+	keymap keymap
+	// Document shown beside the paged list on wide terminals
+	preview *listPreview
+	// When the list or dashboard was last loaded, shown with auto-refresh on
+	refreshedAt time.Time
+	// Results of the session's actions (ctrl+n)
+	actionLog *notificationLog
+	// Last delete or cancel, reversed with u
+	lastUndo *undoAction
+	// Inline errors of the open form's inputs
+	fieldErrors fieldErrors
+	// Saved draft offered when a form opens, the save debounce, and whether
+	// the open form was sent, so its inputs are no longer a draft
+	draftOffer *formDraft
+	draftSeq   int
+	draftDone  bool
+	// Documents created lately, listed under the main menu
+	recent []createdDoc
 }
 
 // Messages
@@ -314,14 +339,18 @@ func NewTUI(client *Client) Model {
 		MenuItem{"Dashboard", "Executive summary & KPIs", ViewDashboard},
 		MenuItem{"Inventory", "Items, Templates, Groups, Brands, Attributes", ViewInventoryMenu},
 		MenuItem{"Stock", "Warehouses, Stock Levels, Serial Numbers", ViewStockMenu},
-		MenuItem{"Sales", "Customers, Quotations, Orders, Invoices, Delivery", ViewSalesMenu},
+		MenuItem{"Sales", "Customers, Quotations, Orders, Invoices, Delivery, Subscriptions", ViewSalesMenu},
 		MenuItem{"Purchasing", "Suppliers, POs, Invoices, Receipts", ViewPurchasingMenu},
 		MenuItem{"Payments", "Receive & Pay invoices", ViewPaymentsMenu},
+		MenuItem{"Manufacturing", "Work Orders, BOMs", ViewManufacturingMenu},
+		MenuItem{"HR", "Employees, Expense Claims", ViewHRMenu},
+		MenuItem{"Inbox", "Quick notes to turn into documents", ViewInbox},
+		MenuItem{"Timesheet", "Hours logged this week", ViewTimesheet},
 	}
 
 	delegate := list.NewDefaultDelegate()
 	delegate.Styles.SelectedTitle = selectedStyle
-	delegate.Styles.SelectedDesc = lipgloss.NewStyle().Foreground(lipgloss.Color("#7D56F4"))
+	delegate.Styles.SelectedDesc = selectedDescStyle
 
 	mainMenu := list.New(menuItems, delegate, 0, 0)
 	mainMenu.Title = client.Config.Brand
@@ -329,30 +358,88 @@ func NewTUI(client *Client) Model {
 	mainMenu.SetFilteringEnabled(false)
 	mainMenu.Styles.Title = titleStyle
 
-	// Initialize spinner
-	s := spinner.New()
-	s.Spinner = spinner.Dot
-	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("#7D56F4"))
-
-	return Model{
+	m := Model{
 		client:      client,
 		view:        ViewMain,
 		mainMenu:    mainMenu,
 		loading:     true,
 		formData:    make(map[string]string),
-		spinner:     s,
+		spinner:     newSpinner(),
 		breadcrumbs: []string{"Main"},
+		selected:    make(map[string]ListItem),
+		preview:     &listPreview{cache: make(map[string]previewMsg)},
+		actionLog:   &notificationLog{},
+		recent:      client.recentCreated(recentCreatedCount),
+	}
+
+	var warnings []string
+	m.keymap, warnings = loadKeymap(client.Config.KeyBindings)
+	if len(warnings) > 0 {
+		m.message = T("Ignored key bindings: ") + strings.Join(warnings, "; ")
+		m.messageType = "error"
+	}
+	return m
+}
+
+// subMenuItems returns the entries of a category submenu
+func subMenuItems(v View) []list.Item {
+	switch v {
+	case ViewInventoryMenu:
+		return []list.Item{
+			MenuItem{"Items", "View all items", ViewItems},
+			MenuItem{"Templates", "Item templates with variants", ViewTemplates},
+			MenuItem{"Groups", "Item groups hierarchy", ViewGroups},
+			MenuItem{"Brands", "Brand management", ViewBrands},
+			MenuItem{"Attributes", "Item attributes for variants", ViewAttributes},
+		}
+	case ViewStockMenu:
+		return []list.Item{
+			MenuItem{"Warehouses", "View all warehouses", ViewWarehouses},
+			MenuItem{"Stock Levels", "Current stock & operations", ViewStock},
+			MenuItem{"Serial Numbers", "Track serialized items", ViewSerials},
+		}
+	case ViewSalesMenu:
+		return []list.Item{
+			MenuItem{"Customers", "Customer management", ViewCustomers},
+			MenuItem{"Quotations", "Sales quotations", ViewQuotations},
+			MenuItem{"Sales Orders", "SO workflow", ViewSalesOrders},
+			MenuItem{"Sales Invoices", "Customer invoices", ViewSalesInvoices},
+			MenuItem{"Delivery Notes", "Shipments from SO", ViewDeliveryNotes},
+			MenuItem{"Subscriptions", "Recurring service billing", ViewSubscriptions},
+		}
+	case ViewPurchasingMenu:
+		return []list.Item{
+			MenuItem{"Suppliers", "Supplier management", ViewSuppliers},
+			MenuItem{"Purchase Orders", "PO workflow", ViewPurchaseOrders},
+			MenuItem{"Purchase Invoices", "Supplier invoices", ViewPurchaseInvoices},
+			MenuItem{"Purchase Receipts", "Goods received", ViewPurchaseReceipts},
+		}
+	case ViewPaymentsMenu:
+		return []list.Item{
+			MenuItem{"All Payments", "View all payment entries", ViewPayments},
+		}
+	case ViewManufacturingMenu:
+		return []list.Item{
+			MenuItem{"Work Orders", "Start and finish production", ViewWorkOrders},
+			MenuItem{"BOMs", "Bills of materials", ViewBOMs},
+		}
+	case ViewHRMenu:
+		return []list.Item{
+			MenuItem{"Employees", "Active employees", ViewEmployees},
+			MenuItem{"Expense Claims", "Your expense claims", ViewExpenseClaims},
+		}
 	}
+	return nil
 }
 
 // createSubMenu creates a submenu for a category
 func (m *Model) createSubMenu(title string, items []list.Item) {
 	delegate := list.NewDefaultDelegate()
 	delegate.Styles.SelectedTitle = selectedStyle
-	delegate.Styles.SelectedDesc = lipgloss.NewStyle().Foreground(lipgloss.Color("#7D56F4"))
+	delegate.Styles.SelectedDesc = selectedDescStyle
 
 	m.subMenu = list.New(items, delegate, m.width-4, m.height-8)
-	m.subMenu.Title = title
+	m.subMenu.Title = T(title)
 	m.subMenu.SetShowStatusBar(false)
 	m.subMenu.SetFilteringEnabled(false)
 	m.subMenu.Styles.Title = titleStyle
@@ -362,6 +449,7 @@ func (m Model) Init() tea.Cmd {
 	return tea.Batch(
 		m.detectConnection(),
 		m.spinner.Tick,
+		m.scheduleRefresh(),
 	)
 }
 
@@ -436,21 +524,21 @@ func (m Model) loadItems(templatesOnly bool) tea.Cmd {
 	}
 }
 
+// loadGroups fetches all item groups as an indented tree
 func (m Model) loadGroups() tea.Cmd {
 	return func() tea.Msg {
-		result, err := m.client.Request("GET", "Item%20Group?limit_page_length=0", nil)
+		roots, err := m.client.fetchGroupTree()
 		if err != nil {
 			return errorMsg{err}
 		}
 
 		var items []ListItem
-		if data, ok := result["data"].([]interface{}); ok {
-			for _, item := range data {
-				if im, ok := item.(map[string]interface{}); ok {
-					name := fmt.Sprintf("%v", im["name"])
-					items = append(items, ListItem{name: name, details: "Group"})
-				}
+		for _, line := range flattenTree(roots) {
+			detail := "Item Group"
+			if line.node.isGroup {
+				detail = tf("Group (%d)", len(line.node.children))
 			}
+			items = append(items, ListItem{name: line.node.name, details: detail, prefix: line.prefix})
 		}
 		return dataLoadedMsg{items}
 	}
@@ -487,7 +575,7 @@ func (m Model) loadItemDetail(code string) tea.Cmd {
 		if data, ok := result["data"].(map[string]interface{}); ok {
 			return itemDetailMsg{data}
 		}
-		return errorMsg{fmt.Errorf("no data found")}
+		return errorMsg{errorf("no data found")}
 	}
 }
 
@@ -502,7 +590,7 @@ func (m Model) loadAttrDetail(name string) tea.Cmd {
 		if data, ok := result["data"].(map[string]interface{}); ok {
 			return itemDetailMsg{data}
 		}
-		return errorMsg{fmt.Errorf("no data found")}
+		return errorMsg{errorf("no data found")}
 	}
 }
 
@@ -525,16 +613,119 @@ func (m Model) deleteItem(itemType, name string) tea.Cmd {
 		if err != nil {
 			return errorMsg{err}
 		}
-		return actionDoneMsg{fmt.Sprintf("Deleted: %s", name)}
+		return actionDoneMsg{tf("Deleted: %s", name)}
 	}
 }
 
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	result, cmd := m.update(msg)
+	next, ok := result.(Model)
+	if !ok {
+		return result, cmd
+	}
+
+	// Forms and confirmations the user has no permission for stay closed
+	if next.view != m.view {
+		if err := next.viewPermissionError(); err != nil {
+			m.message = err.Error()
+			m.messageType = "error"
+			return m, nil
+		}
+	}
+
+	// A form that closes keeps its inputs as a draft, unless it was sent;
+	// one that opens offers its draft
+	if next.view != m.view {
+		if !next.draftDone {
+			m.saveDraft()
+		}
+		next.draftOffer = nil
+		if isFormView(next.view) {
+			next.draftDone = false
+			next.offerDraft()
+		}
+	}
+
+	// Back at the main menu, its recently created documents are reloaded
+	if next.view == ViewMain && m.view != ViewMain {
+		next.recent = next.client.recentCreated(recentCreatedCount)
+		next.mainMenu.SetSize(next.width-4, next.height-8-next.recentHeight())
+	}
+
+	// The split layout's preview follows the list cursor, however it moved
+	if preview := next.followPreview(); preview != nil {
+		return next, tea.Batch(cmd, preview)
+	}
+	return next, cmd
+}
+
+func (m Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		m.message = ""
 		m.messageType = ""
 
+		// Keys wait while a batch action runs
+		if m.batch != nil {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			return m, nil
+		}
+		if m.palette != nil {
+			return m.updatePalette(msg)
+		}
+		if msg.String() == "ctrl+p" {
+			return m.openPalette()
+		}
+		if m.actionLog.open {
+			return m.updateNotificationLog(msg)
+		}
+		if msg.String() == "ctrl+n" {
+			return m.openNotificationLog()
+		}
+
+		if m.draftOffer != nil && isFormView(m.view) {
+			return m.handleDraftOffer(msg.String())
+		}
+
+		// Forms take every key but ctrl+c, so typed letters are not shortcuts
+		if isFormView(m.view) && msg.String() != "ctrl+c" {
+			return m, m.updateFormInputs(msg)
+		}
+		if m.view == ViewEditLines && msg.String() != "ctrl+c" {
+			return m.handleLineEditKeys(msg)
+		}
+		if m.view == ViewCreateTemplate && m.templateWizard != nil {
+			return m.handleTemplateWizardKeys(msg)
+		}
+		if m.view == ViewCreateAttr {
+			return m.handleAttrTypeKeys(msg.String())
+		}
+		if m.listSearching && m.isListView() {
+			return m.updateListSearch(msg)
+		}
+
+		// Rebound keys stand in for the default key of their action
+		key, ok := m.remapKey(msg.String())
+		if !ok {
+			return m, nil
+		}
+		if key != msg.String() {
+			msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+		}
+		if m.isListView() && !m.loading {
+			if m.boardMode {
+				m.syncBoardSelection()
+				if m.handleBoardKeys(msg.String()) {
+					return m, nil
+				}
+			}
+			if result, cmd, ok := m.handleBatchKeys(msg.String()); ok {
+				return result, cmd
+			}
+		}
+
 		switch msg.String() {
 		case "ctrl+c":
 			return m, tea.Quit
@@ -553,10 +744,18 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 
 		case "esc":
+			// Clear an active search or filter before leaving a paged list
+			if m.isListView() && (m.listQuery != "" || !m.listFilter.empty()) {
+				m.listQuery = ""
+				m.listFilter = listFilter{}
+				m.listPage = 0
+				return m.refreshCurrentView()
+			}
 			switch m.view {
 			case ViewMain:
 				// Do nothing at main
-			case ViewInventoryMenu, ViewStockMenu, ViewSalesMenu, ViewPurchasingMenu, ViewPaymentsMenu:
+			case ViewInventoryMenu, ViewStockMenu, ViewSalesMenu, ViewPurchasingMenu, ViewPaymentsMenu,
+				ViewManufacturingMenu, ViewHRMenu:
 				// Go back from submenu to main
 				m.view = ViewMain
 				m.breadcrumbs = []string{"Main"}
@@ -635,21 +834,52 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if len(m.breadcrumbs) > 2 {
 					m.breadcrumbs = m.breadcrumbs[:2]
 				}
+			case ViewWODetail:
+				m.view = ViewWorkOrders
+				if len(m.breadcrumbs) > 2 {
+					m.breadcrumbs = m.breadcrumbs[:2]
+				}
+			case ViewBOMDetail:
+				m.view = ViewBOMs
+				if len(m.breadcrumbs) > 2 {
+					m.breadcrumbs = m.breadcrumbs[:2]
+				}
+			case ViewSubscriptionDetail:
+				m.view = ViewSubscriptions
+				if len(m.breadcrumbs) > 2 {
+					m.breadcrumbs = m.breadcrumbs[:2]
+				}
+			case ViewEmployeeDetail:
+				m.view = ViewEmployees
+				if len(m.breadcrumbs) > 2 {
+					m.breadcrumbs = m.breadcrumbs[:2]
+				}
+			case ViewExpenseClaimDetail:
+				m.view = ViewExpenseClaims
+				if len(m.breadcrumbs) > 2 {
+					m.breadcrumbs = m.breadcrumbs[:2]
+				}
 			case ViewCreateSupplier, ViewCreateSerial, ViewStockReceive,
 				ViewStockTransfer, ViewStockIssue, ViewCreatePO,
 				ViewAddPOItem, ViewCreatePI, ViewCreatePR,
 				ViewCreateCustomer, ViewCreateQuotation, ViewAddQuotationItem,
 				ViewCreateSO, ViewCreateSOFromQuotation, ViewAddSOItem, ViewCreateSalesInvoice,
 				ViewCreateDN, ViewCreatePayment,
-				ViewCreateGroup, ViewCreateBrand, ViewCreateWarehouse, ViewCreateVariant,
-				ViewCreateAttrText, ViewCreateAttrNumeric, ViewCreateAttrSelect,
-				ViewCreatePIFromPO:
+				ViewCreateItem, ViewCreateGroup, ViewCreateBrand, ViewCreateWarehouse, ViewCreateVariant,
+				ViewCreateAttrText, ViewCreateAttrNumeric, ViewCreateAttrSelect, ViewEditAttrValues,
+				ViewCreatePIFromPO, ViewCreateWO, ViewCreateSubscription, ViewCreateExpenseClaim, ViewAttachFile:
 				// Form views go back to their parent
 				if m.prevView != 0 {
 					m.view = m.prevView
 				} else {
 					m.view = ViewMain
 				}
+			case ViewHistory:
+				m.view = m.prevView
+			case ViewGenerateVariants:
+				// Template details are only reachable from the template list
+				m.view = ViewItemDetail
+				m.prevView = ViewTemplates
 			case ViewConfirmDelete, ViewConfirmAction:
 				m.view = m.prevView
 			// Inventory views go back to Inventory submenu
@@ -661,7 +891,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.view = ViewStockMenu
 				m.breadcrumbs = []string{"Main", "Stock"}
 			// Sales views go back to Sales submenu
-			case ViewCustomers, ViewQuotations, ViewSalesOrders, ViewSalesInvoices, ViewDeliveryNotes:
+			case ViewCustomers, ViewQuotations, ViewSalesOrders, ViewSalesInvoices, ViewDeliveryNotes,
+				ViewSubscriptions:
 				m.view = ViewSalesMenu
 				m.breadcrumbs = []string{"Main", "Sales"}
 			// Purchasing views go back to Purchasing submenu
@@ -672,6 +903,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case ViewPayments:
 				m.view = ViewPaymentsMenu
 				m.breadcrumbs = []string{"Main", "Payments"}
+			// Manufacturing views go back to Manufacturing submenu
+			case ViewWorkOrders, ViewBOMs:
+				m.view = ViewManufacturingMenu
+				m.breadcrumbs = []string{"Main", "Manufacturing"}
+			// HR views go back to HR submenu
+			case ViewEmployees, ViewExpenseClaims:
+				m.view = ViewHRMenu
+				m.breadcrumbs = []string{"Main", "HR"}
 			default:
 				m.view = ViewMain
 				m.breadcrumbs = []string{"Main"}
@@ -681,12 +920,51 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "enter":
 			return m.handleEnter()
 
+		case "/":
+			// Search the paged lists on the server
+			if m.isListView() && !m.loading {
+				return m.startListSearch()
+			}
+
+		case "b":
+			// Toggle the status board layout of the paged lists
+			if m.isListView() && !m.loading {
+				m.boardMode = !m.boardMode
+				m.boardCol, m.boardRow = 0, 0
+				if m.boardMode {
+					m.syncBoardSelection()
+				}
+				return m, nil
+			}
+
+		case "]", "[":
+			// Page through the paged lists
+			if m.isListView() && !m.loading {
+				if msg.String() == "]" {
+					return m.turnListPage(1)
+				}
+				return m.turnListPage(-1)
+			}
+
+		case "e":
+			// Edit the lines of a draft SO/PO/Quotation
+			if m.view == ViewSODetail || m.view == ViewPODetail || m.view == ViewQuotationDetail {
+				return m.startLineEdit()
+			}
+			// Edit the values, or the range, of an item attribute
+			if m.view == ViewAttrDetail && m.itemData != nil && !m.loading {
+				m.initEditAttrForm()
+				m.prevView = m.view
+				m.view = ViewEditAttrValues
+				return m, nil
+			}
+
 		case "d":
 			if m.view != ViewMain && m.view != ViewConfirmDelete && m.view != ViewConfirmAction {
 				// Handle delete for list views
 				switch m.view {
 				case ViewAttributes, ViewItems, ViewTemplates, ViewGroups, ViewBrands,
-					ViewSuppliers, ViewSerials, ViewCustomers:
+					ViewSuppliers, ViewSerials, ViewCustomers, ViewInbox:
 					if item, ok := m.currentList.SelectedItem().(ListItem); ok {
 						m.selectedItem = item.name
 						m.prevView = m.view
@@ -703,10 +981,20 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "y":
 			if m.view == ViewConfirmDelete {
 				m.view = m.prevView
-				return m, m.handleDeleteForView()
+				return m, m.deleteWithUndo(m.handleDeleteForView())
 			}
 			if m.view == ViewConfirmAction {
-				return m, m.handleConfirmAction(true)
+				action := m.confirmAction
+				return m, m.cancelWithUndo(action, m.handleConfirmAction(true))
+			}
+			fallthrough
+
+		case "Y", "w":
+			// Handle 'y' for copying the name, 'Y' for copying a field and
+			// 'w' for opening the document in the browser in detail views
+			result, cmd := m.handleShareKeys(msg.String())
+			if cmd != nil {
+				return result, cmd
 			}
 
 		case "n":
@@ -734,6 +1022,18 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if cmd != nil {
 				return result, cmd
 			}
+			result, cmd = m.handleManufacturingKeys("n")
+			if cmd != nil {
+				return result, cmd
+			}
+			result, cmd = m.handleSubscriptionKeys("n")
+			if cmd != nil {
+				return result, cmd
+			}
+			result, cmd = m.handleHRKeys("n")
+			if cmd != nil {
+				return result, cmd
+			}
 
 		case "r":
 			// Handle 'r' for receive in stock views
@@ -792,6 +1092,55 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if cmd != nil {
 				return result, cmd
 			}
+			result, cmd = m.handleManufacturingKeys("s")
+			if cmd != nil {
+				return result, cmd
+			}
+			if m.view == ViewTimesheet {
+				return m.handleTimesheetKeys("s")
+			}
+			if m.view == ViewExpenseClaimDetail {
+				return m.handleHRKeys("s")
+			}
+
+		case "f", "F":
+			// Handle 'f' for the filter form and 'F' for the saved filters
+			// in the paged lists
+			if m.isListView() && !m.loading {
+				if msg.String() == "f" {
+					return m.openListFilter()
+				}
+				return m.cycleSavedFilter()
+			}
+			// Handle 'f' for finishing a work order
+			result, cmd := m.handleManufacturingKeys("f")
+			if cmd != nil {
+				return result, cmd
+			}
+
+		case "P", "u", "h":
+			// Handle 'P' for saving the PDF, 'u' for uploading an
+			// attachment and 'h' for the history in transaction detail views
+			result, cmd := m.handleDocumentKeys(msg.String())
+			if cmd != nil {
+				return result, cmd
+			}
+			if msg.String() == m.undoKey() {
+				return m.undoLast()
+			}
+
+		case "U":
+			// Handle 'U' for undo where 'u' attaches files
+			if msg.String() == m.undoKey() {
+				return m.undoLast()
+			}
+
+		case "left", "right":
+			// Handle week navigation in the timesheet grid
+			result, cmd := m.handleTimesheetKeys(msg.String())
+			if cmd != nil {
+				return result, cmd
+			}
 
 		case "x":
 			// Handle 'x' for cancel in PO/PI/SO/SI/Quotation detail
@@ -803,6 +1152,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if cmd != nil {
 				return result, cmd
 			}
+			result, cmd = m.handleSubscriptionKeys("x")
+			if cmd != nil {
+				return result, cmd
+			}
 
 		case "p":
 			// Handle 'p' for payments in invoice detail views
@@ -824,6 +1177,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Handle 'o' for sorting in list views
 			if m.isListView() {
 				m.sortOrder = (m.sortOrder + 1) % 4
+				m.listPage = 0
 				return m.refreshCurrentView()
 			}
 
@@ -840,8 +1194,24 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					}
 				}
 			}
+
+		case "g":
+			// Handle 'g' for generating variants from the attribute matrix
+			if m.view == ViewItemDetail && m.itemData != nil {
+				if hasVariants, ok := m.itemData["has_variants"].(float64); ok && hasVariants == 1 {
+					m.view = ViewGenerateVariants
+					m.variantMatrix = nil
+					m.variantCursor = 0
+					m.variantPicked = map[string]bool{}
+					m.loading = true
+					return m, m.loadTemplateMatrix()
+				}
+			}
 		}
 
+	case tea.MouseMsg:
+		return m.handleMouse(msg)
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
@@ -849,9 +1219,19 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		h := msg.Height - 8
 		w := msg.Width - 4
 
-		m.mainMenu.SetSize(w, h)
+		m.mainMenu.SetSize(w, h-m.recentHeight())
 		if m.currentList.Items() != nil {
-			m.currentList.SetSize(w, h)
+			if m.isListView() {
+				// Room for the column titles, and for the preview when split
+				if m.splitWidth() {
+					m.currentList.SetSize(splitListWidth, h-2)
+				} else {
+					m.currentList.SetSize(w, h-2)
+				}
+				m.currentList.SetDelegate(columnDelegate{m.client, m.selected, m.splitWidth()})
+			} else {
+				m.currentList.SetSize(w, h)
+			}
 		}
 
 		// Set up viewport for dashboard
@@ -865,7 +1245,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.loading = false
 		m.client.Mode = msg.mode
 		m.client.ActiveURL = msg.url
-		return m, nil
+		return m, m.prefetchPermissions()
 
 	case errorMsg:
 		m.loading = false
@@ -875,6 +1255,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case dataLoadedMsg:
 		m.loading = false
+		m.refreshedAt = time.Now()
 		items := make([]list.Item, len(msg.items))
 		for i, item := range msg.items {
 			items[i] = item
@@ -893,18 +1274,95 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.setListTitle()
 		return m, nil
 
+	case listPageMsg:
+		m.loading = false
+		m.refreshedAt = time.Now()
+		m.setPagedList(msg)
+		if m.boardMode {
+			m.syncBoardSelection()
+		}
+		return m, nil
+
+	case batchProgressMsg:
+		return m.batchProgress(msg)
+
+	case batchDoneMsg:
+		return m.finishBatch()
+
+	case listFilterMsg:
+		return m.applyListFilter(msg)
+
+	case previewTickMsg:
+		return m, m.loadPreview(msg)
+
+	case undoableMsg:
+		return m.keepUndo(msg)
+
+	case refreshTickMsg:
+		return m.backgroundRefresh()
+
+	case refreshedMsg:
+		return m.applyRefresh(msg)
+
+	case previewMsg:
+		m.preview.cache[msg.name] = msg
+		return m, nil
+
 	case itemDetailMsg:
 		m.loading = false
 		m.itemData = msg.data
+		m.docExtras = nil
+		m.templateVariants = nil
+		return m, tea.Batch(m.loadDocumentExtras(), m.loadTemplateVariants())
+
+	case templateVariantsMsg:
+		if msg.template == m.selectedItem {
+			m.templateVariants = msg.variants
+		}
+		return m, nil
+
+	case historyLoadedMsg:
+		m.loading = false
+		if m.viewportReady {
+			m.viewport.SetContent(m.renderHistoryContent(msg.versions))
+			m.viewport.GotoTop()
+		}
+		return m, nil
+
+	case documentExtrasMsg:
+		if msg.name == m.selectedItem {
+			m.docExtras = msg.extras
+		}
 		return m, nil
 
+	case variantMatrixMsg:
+		m.loading = false
+		m.variantMatrix = msg.matrix
+		return m, nil
+
+	case templateWizardMsg:
+		m.loading = false
+		m.applyTemplateWizard(msg)
+		return m, nil
+
+	case timesheetLoadedMsg:
+		m.loading = false
+		m.timesheetGrid = msg.grid
+		m.timesheetEmployee = msg.employee
+		return m, nil
+
+	case pdfSavedMsg:
+		return m.pdfSaved(msg.path)
+
 	case actionDoneMsg:
 		m.message = msg.message
 		m.messageType = "success"
-		return m.refreshCurrentView()
+		m.actionLog.record(true, msg.message)
+		return m.autoRefresh()
 
 	case dashboardLoadedMsg:
 		m.loading = false
+		m.refreshedAt = time.Now()
 		m.dashboardData = msg.data
 		// Update viewport content with dashboard
 		if m.viewportReady {
@@ -920,12 +1378,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case formSubmittedMsg:
 		m.loading = false
+		m.actionLog.record(msg.success, msg.message)
 		if msg.success {
+			m.clearFieldErrors()
+			m.dropDraft(m.draftKey())
+			m.draftDone = m.draftKey() != ""
 			m.notification = msg.message
 			m.notificationType = "success"
 			m.showNotification = true
 			// Auto-dismiss notification after 3 seconds
-			refreshModel, refreshCmd := m.refreshCurrentView()
+			refreshModel, refreshCmd := m.autoRefresh()
 			m = refreshModel.(Model)
 			return m, tea.Batch(
 				refreshCmd,
@@ -938,6 +1400,34 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.messageType = "error"
 		return m, nil
 
+	case paletteDocsMsg:
+		if m.palette != nil {
+			m.palette.loadingDocs = false
+			m.palette.entries = append(m.palette.entries, msg.entries...)
+			m.palette.filter()
+		}
+		return m, nil
+
+	case paletteOpenMsg:
+		return m.finishPaletteJump(msg.entry)
+
+	case suggestTickMsg:
+		return m, m.searchSuggestions(msg)
+
+	case suggestionsMsg:
+		m.showSuggestions(msg)
+		return m, nil
+
+	case draftTickMsg:
+		if msg.seq == m.draftSeq && isFormView(m.view) {
+			m.saveDraft()
+		}
+		return m, nil
+
+	case linkCheckedMsg:
+		m.applyLinkCheck(msg)
+		return m, nil
+
 	case clearNotificationMsg:
 		m.showNotification = false
 		m.notification = ""
@@ -953,26 +1443,32 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch m.view {
 	case ViewMain:
 		m.mainMenu, cmd = m.mainMenu.Update(msg)
-	case ViewInventoryMenu, ViewStockMenu, ViewSalesMenu, ViewPurchasingMenu, ViewPaymentsMenu:
+	case ViewInventoryMenu, ViewStockMenu, ViewSalesMenu, ViewPurchasingMenu, ViewPaymentsMenu,
+		ViewManufacturingMenu, ViewHRMenu:
 		m.subMenu, cmd = m.subMenu.Update(msg)
-	case ViewDashboard:
+	case ViewDashboard, ViewHistory:
 		// Viewport handles scrolling
 		m.viewport, cmd = m.viewport.Update(msg)
 	case ViewAttributes, ViewItems, ViewTemplates, ViewGroups, ViewBrands,
 		ViewWarehouses, ViewStock, ViewSerials, ViewSuppliers,
 		ViewPurchaseOrders, ViewPurchaseInvoices, ViewPurchaseReceipts,
 		ViewCustomers, ViewQuotations, ViewSalesOrders, ViewSalesInvoices, ViewDeliveryNotes,
-		ViewPayments:
+		ViewPayments, ViewInbox, ViewWorkOrders, ViewBOMs, ViewSubscriptions,
+		ViewEmployees, ViewExpenseClaims:
 		m.currentList, cmd = m.currentList.Update(msg)
 	case ViewCreateSupplier, ViewCreateSerial, ViewStockReceive, ViewStockTransfer, ViewStockIssue,
 		ViewCreatePO, ViewAddPOItem, ViewCreatePI, ViewCreatePR,
 		ViewCreateCustomer, ViewCreateQuotation, ViewAddQuotationItem,
 		ViewCreateSO, ViewCreateSOFromQuotation, ViewAddSOItem, ViewCreateSalesInvoice,
 		ViewCreateDN, ViewCreatePayment,
-		ViewCreateGroup, ViewCreateBrand, ViewCreateWarehouse, ViewCreateVariant,
-		ViewCreateAttrText, ViewCreateAttrNumeric, ViewCreateAttrSelect,
-		ViewCreatePIFromPO:
+		ViewCreateItem, ViewCreateGroup, ViewCreateBrand, ViewCreateWarehouse, ViewCreateVariant,
+		ViewCreateAttrText, ViewCreateAttrNumeric, ViewCreateAttrSelect, ViewEditAttrValues,
+		ViewCreatePIFromPO, ViewCreateWO, ViewCreateSubscription, ViewCreateExpenseClaim, ViewAttachFile:
 		cmd = m.updateFormInputs(msg)
+	case ViewGenerateVariants:
+		if key, ok := msg.(tea.KeyMsg); ok {
+			return m.handleVariantMatrixKeys(key.String())
+		}
 	}
 
 	return m, cmd
@@ -980,6 +1476,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 func (m Model) handleEnter() (tea.Model, tea.Cmd) {
 	switch m.view {
+	case ViewGenerateVariants:
+		return m.handleVariantMatrixKeys("enter")
 	case ViewMain:
 		if item, ok := m.mainMenu.SelectedItem().(MenuItem); ok {
 			m.view = item.view
@@ -989,53 +1487,31 @@ func (m Model) handleEnter() (tea.Model, tea.Cmd) {
 			case ViewDashboard:
 				m.loading = true
 				return m, m.loadDashboard()
-			case ViewInventoryMenu:
-				m.createSubMenu("Inventory", []list.Item{
-					MenuItem{"Items", "View all items", ViewItems},
-					MenuItem{"Templates", "Item templates with variants", ViewTemplates},
-					MenuItem{"Groups", "Item groups hierarchy", ViewGroups},
-					MenuItem{"Brands", "Brand management", ViewBrands},
-					MenuItem{"Attributes", "Item attributes for variants", ViewAttributes},
-				})
-				return m, nil
-			case ViewStockMenu:
-				m.createSubMenu("Stock", []list.Item{
-					MenuItem{"Warehouses", "View all warehouses", ViewWarehouses},
-					MenuItem{"Stock Levels", "Current stock & operations", ViewStock},
-					MenuItem{"Serial Numbers", "Track serialized items", ViewSerials},
-				})
-				return m, nil
-			case ViewSalesMenu:
-				m.createSubMenu("Sales", []list.Item{
-					MenuItem{"Customers", "Customer management", ViewCustomers},
-					MenuItem{"Quotations", "Sales quotations", ViewQuotations},
-					MenuItem{"Sales Orders", "SO workflow", ViewSalesOrders},
-					MenuItem{"Sales Invoices", "Customer invoices", ViewSalesInvoices},
-					MenuItem{"Delivery Notes", "Shipments from SO", ViewDeliveryNotes},
-				})
-				return m, nil
-			case ViewPurchasingMenu:
-				m.createSubMenu("Purchasing", []list.Item{
-					MenuItem{"Suppliers", "Supplier management", ViewSuppliers},
-					MenuItem{"Purchase Orders", "PO workflow", ViewPurchaseOrders},
-					MenuItem{"Purchase Invoices", "Supplier invoices", ViewPurchaseInvoices},
-					MenuItem{"Purchase Receipts", "Goods received", ViewPurchaseReceipts},
-				})
-				return m, nil
-			case ViewPaymentsMenu:
-				m.createSubMenu("Payments", []list.Item{
-					MenuItem{"All Payments", "View all payment entries", ViewPayments},
-				})
+			case ViewInbox:
+				m.loading = true
+				return m, m.loadInbox()
+			case ViewTimesheet:
+				m.loading = true
+				m.timesheetDate = ""
+				return m, m.loadTimesheetWeek()
+			case ViewInventoryMenu, ViewStockMenu, ViewSalesMenu, ViewPurchasingMenu, ViewPaymentsMenu,
+				ViewManufacturingMenu, ViewHRMenu:
+				m.createSubMenu(item.title, subMenuItems(item.view))
 				return m, nil
 			}
 		}
 
 	// Handle submenu selections
-	case ViewInventoryMenu, ViewStockMenu, ViewSalesMenu, ViewPurchasingMenu, ViewPaymentsMenu:
+	case ViewInventoryMenu, ViewStockMenu, ViewSalesMenu, ViewPurchasingMenu, ViewPaymentsMenu,
+		ViewManufacturingMenu, ViewHRMenu:
 		if item, ok := m.subMenu.SelectedItem().(MenuItem); ok {
 			m.view = item.view
 			m.loading = true
 			m.breadcrumbs = append(m.breadcrumbs[:2], item.title)
+			m.listPage = 0
+			m.listQuery = ""
+			m.listFilter = listFilter{}
+			m.clearSelection()
 
 			switch item.view {
 			case ViewAttributes:
@@ -1056,27 +1532,29 @@ func (m Model) handleEnter() (tea.Model, tea.Cmd) {
 				return m, m.loadSerials("")
 			case ViewSuppliers:
 				return m, m.loadSuppliers()
-			case ViewPurchaseOrders:
-				return m, m.loadPurchaseOrders()
-			case ViewPurchaseInvoices:
-				return m, m.loadPurchaseInvoices()
 			case ViewCustomers:
 				return m, m.loadCustomers()
-			case ViewQuotations:
-				return m, m.loadQuotations()
-			case ViewSalesOrders:
-				return m, m.loadSalesOrders()
-			case ViewSalesInvoices:
-				return m, m.loadSalesInvoices()
-			case ViewDeliveryNotes:
-				return m, m.loadDeliveryNotes()
-			case ViewPurchaseReceipts:
-				return m, m.loadPurchaseReceipts()
-			case ViewPayments:
-				return m, m.loadPayments()
+			case ViewQuotations, ViewSalesOrders, ViewSalesInvoices, ViewDeliveryNotes,
+				ViewPurchaseOrders, ViewPurchaseInvoices, ViewPurchaseReceipts, ViewPayments:
+				return m, m.loadListPage()
+			case ViewWorkOrders:
+				return m, m.loadWorkOrders()
+			case ViewBOMs:
+				return m, m.loadBOMs()
+			case ViewSubscriptions:
+				return m, m.loadSubscriptions()
+			case ViewEmployees:
+				return m, m.loadEmployees()
+			case ViewExpenseClaims:
+				return m, m.loadExpenseClaims()
 			}
 		}
 
+	case ViewInbox:
+		if item, ok := m.currentList.SelectedItem().(ListItem); ok {
+			return m.convertNote(item.name)
+		}
+
 	case ViewAttributes:
 		if item, ok := m.currentList.SelectedItem().(ListItem); ok {
 			m.selectedItem = item.name
@@ -1203,11 +1681,77 @@ func (m Model) handleEnter() (tea.Model, tea.Cmd) {
 			m.breadcrumbs = append(m.breadcrumbs, item.name)
 			return m, m.loadPaymentDetail(item.name)
 		}
+
+	case ViewWorkOrders:
+		if item, ok := m.currentList.SelectedItem().(ListItem); ok {
+			m.selectedItem = item.name
+			m.view = ViewWODetail
+			m.loading = true
+			m.breadcrumbs = append(m.breadcrumbs, item.name)
+			return m, m.loadWODetail(item.name)
+		}
+
+	case ViewBOMs:
+		if item, ok := m.currentList.SelectedItem().(ListItem); ok {
+			m.selectedItem = item.name
+			m.view = ViewBOMDetail
+			m.loading = true
+			m.breadcrumbs = append(m.breadcrumbs, item.name)
+			return m, m.loadBOMDetail(item.name)
+		}
+
+	case ViewSubscriptions:
+		if item, ok := m.currentList.SelectedItem().(ListItem); ok {
+			m.selectedItem = item.name
+			m.view = ViewSubscriptionDetail
+			m.loading = true
+			m.breadcrumbs = append(m.breadcrumbs, item.name)
+			return m, m.loadSubscriptionDetail(item.name)
+		}
+
+	case ViewEmployees:
+		if item, ok := m.currentList.SelectedItem().(ListItem); ok {
+			m.selectedItem = item.name
+			m.view = ViewEmployeeDetail
+			m.loading = true
+			m.breadcrumbs = append(m.breadcrumbs, item.name)
+			return m, m.loadEmployeeDetail(item.name)
+		}
+
+	case ViewExpenseClaims:
+		if item, ok := m.currentList.SelectedItem().(ListItem); ok {
+			m.selectedItem = item.name
+			m.view = ViewExpenseClaimDetail
+			m.loading = true
+			m.breadcrumbs = append(m.breadcrumbs, item.name)
+			return m, m.loadExpenseClaimDetail(item.name)
+		}
 	}
 
 	return m, nil
 }
 
+// autoRefresh reloads the current view after a change. Low-bandwidth mode
+// leaves the loaded data as is (press r to reload) except after adding an
+// item, where the refresh is what returns to the document.
+func (m Model) autoRefresh() (tea.Model, tea.Cmd) {
+	if m.client.LowBandwidth() {
+		switch m.view {
+		case ViewAddPOItem, ViewAddQuotationItem, ViewAddSOItem, ViewAttachFile, ViewEditLines,
+			ViewCreateTemplate, ViewEditAttrValues:
+		case ViewCreateAttrText, ViewCreateAttrNumeric, ViewCreateAttrSelect:
+			if m.prevView != ViewCreateTemplate {
+				m.loading = false
+				return m, nil
+			}
+		default:
+			m.loading = false
+			return m, nil
+		}
+	}
+	return m.refreshCurrentView()
+}
+
 func (m Model) refreshCurrentView() (tea.Model, tea.Cmd) {
 	m.loading = true
 	switch m.view {
@@ -1231,47 +1775,122 @@ func (m Model) refreshCurrentView() (tea.Model, tea.Cmd) {
 		return m, m.loadSerials("")
 	case ViewSuppliers:
 		return m, m.loadSuppliers()
-	case ViewPurchaseOrders:
-		return m, m.loadPurchaseOrders()
-	case ViewPurchaseInvoices:
-		return m, m.loadPurchaseInvoices()
 	case ViewCustomers:
 		return m, m.loadCustomers()
-	case ViewQuotations:
-		return m, m.loadQuotations()
-	case ViewSalesOrders:
-		return m, m.loadSalesOrders()
-	case ViewSalesInvoices:
-		return m, m.loadSalesInvoices()
-	case ViewDeliveryNotes:
-		return m, m.loadDeliveryNotes()
-	case ViewPurchaseReceipts:
-		return m, m.loadPurchaseReceipts()
-	case ViewPayments:
-		return m, m.loadPayments()
+	case ViewQuotations, ViewSalesOrders, ViewSalesInvoices, ViewDeliveryNotes,
+		ViewPurchaseOrders, ViewPurchaseInvoices, ViewPurchaseReceipts, ViewPayments:
+		return m, m.loadListPage()
+	case ViewInbox:
+		return m, m.loadInbox()
+	case ViewTimesheet:
+		return m, m.loadTimesheetWeek()
+	case ViewGenerateVariants:
+		return m, m.loadTemplateMatrix()
+	case ViewItemDetail:
+		return m, m.loadItemDetail(m.selectedItem)
+	case ViewWorkOrders:
+		return m, m.loadWorkOrders()
+	case ViewWODetail:
+		return m, m.loadWODetail(m.selectedItem)
+	case ViewBOMs:
+		return m, m.loadBOMs()
+	case ViewBOMDetail:
+		return m, m.loadBOMDetail(m.selectedItem)
+	case ViewSubscriptions:
+		return m, m.loadSubscriptions()
+	case ViewSubscriptionDetail:
+		return m, m.loadSubscriptionDetail(m.selectedItem)
+	case ViewEmployees:
+		return m, m.loadEmployees()
+	case ViewEmployeeDetail:
+		return m, m.loadEmployeeDetail(m.selectedItem)
+	case ViewExpenseClaims:
+		return m, m.loadExpenseClaims()
+	case ViewExpenseClaimDetail:
+		return m, m.loadExpenseClaimDetail(m.selectedItem)
+	case ViewQuotationDetail:
+		return m, m.loadQuotationDetail(m.selectedItem)
+	case ViewSODetail:
+		return m, m.loadSODetail(m.selectedItem)
+	case ViewSIDetail:
+		return m, m.loadSIDetail(m.selectedItem)
+	case ViewDNDetail:
+		return m, m.loadDNDetail(m.selectedItem)
+	case ViewPODetail:
+		return m, m.loadPODetail(m.selectedItem)
+	case ViewPIDetail:
+		return m, m.loadPIDetail(m.selectedItem)
+	case ViewPRDetail:
+		return m, m.loadPRDetail(m.selectedItem)
+	case ViewPaymentDetail:
+		return m, m.loadPaymentDetail(m.selectedItem)
+	case ViewHistory:
+		return m, m.loadHistory(detailDoctypes[m.prevView], m.selectedItem)
+	// After an upload, back to the document the file was attached to
+	case ViewAttachFile:
+		m.view = m.prevView
+		return m.refreshCurrentView()
+	// After adding an item, go back to the reloaded document so the next
+	// edit starts from its latest version
+	case ViewAddPOItem:
+		m.view = ViewPODetail
+		return m, m.loadPODetail(m.selectedItem)
+	case ViewAddQuotationItem:
+		m.view = ViewQuotationDetail
+		return m, m.loadQuotationDetail(m.selectedItem)
+	case ViewAddSOItem:
+		m.view = ViewSODetail
+		return m, m.loadSODetail(m.selectedItem)
+	case ViewEditLines:
+		m.view = m.prevView
+		m.lineEdit = nil
+		return m.refreshCurrentView()
+	// After editing an attribute's values, back to the reloaded attribute
+	case ViewEditAttrValues:
+		m.view = ViewAttrDetail
+		return m, m.loadAttrDetail(m.selectedItem)
+	// A created template shows up in the template list
+	case ViewCreateTemplate:
+		m.view = ViewTemplates
+		m.templateWizard = nil
+		return m, m.loadItems(true)
+	// An attribute created from the template wizard is picked there
+	case ViewCreateAttrText, ViewCreateAttrNumeric, ViewCreateAttrSelect:
+		if m.prevView == ViewCreateTemplate && m.templateWizard != nil {
+			m.view = ViewCreateTemplate
+			return m, m.loadTemplateWizard()
+		}
 	}
 	return m, nil
 }
 
 func (m Model) View() string {
 	if m.width == 0 {
-		return "Loading..."
+		return T("Loading...")
 	}
 
 	var content string
 
 	switch m.view {
 	case ViewMain:
-		content = m.mainMenu.View()
-	case ViewInventoryMenu, ViewStockMenu, ViewSalesMenu, ViewPurchasingMenu, ViewPaymentsMenu:
+		content = m.mainMenu.View() + m.renderRecent()
+	case ViewInventoryMenu, ViewStockMenu, ViewSalesMenu, ViewPurchasingMenu, ViewPaymentsMenu,
+		ViewManufacturingMenu, ViewHRMenu:
 		content = m.subMenu.View()
 	case ViewAttributes, ViewItems, ViewTemplates, ViewGroups, ViewBrands,
 		ViewWarehouses, ViewStock, ViewSerials, ViewSuppliers,
 		ViewPurchaseOrders, ViewPurchaseInvoices, ViewPurchaseReceipts,
 		ViewCustomers, ViewQuotations, ViewSalesOrders, ViewSalesInvoices, ViewDeliveryNotes,
-		ViewPayments:
+		ViewPayments, ViewInbox, ViewWorkOrders, ViewBOMs, ViewSubscriptions,
+		ViewEmployees, ViewExpenseClaims:
 		if m.loading {
-			content = fmt.Sprintf("\n  %s Loading...", m.spinner.View())
+			content = fmt.Sprintf("\n  %s %s", m.spinner.View(), T("Loading..."))
+		} else if m.isListView() && m.boardMode {
+			content = m.renderBoard()
+		} else if m.splitPane() {
+			content = m.renderSplit()
+		} else if m.isListView() {
+			content = m.renderPagedList()
 		} else {
 			content = m.currentList.View() + m.renderListFooter()
 		}
@@ -1281,6 +1900,14 @@ func (m Model) View() string {
 		content = m.renderConfirmDelete()
 	case ViewDashboard:
 		content = m.renderDashboard()
+	case ViewTimesheet:
+		content = m.renderTimesheetWeek()
+	case ViewGenerateVariants:
+		content = m.renderVariantMatrix()
+	case ViewCreateTemplate:
+		if m.templateWizard != nil {
+			content = m.renderTemplateWizard()
+		}
 	case ViewStockDetail:
 		content = m.renderStockDetail()
 	case ViewSerialDetail:
@@ -1348,6 +1975,8 @@ func (m Model) View() string {
 	case ViewCreatePayment:
 		content = m.renderCreatePayment()
 	// CRUD views for master data
+	case ViewCreateItem:
+		content = m.renderCreateItem()
 	case ViewCreateGroup:
 		content = m.renderCreateGroup()
 	case ViewCreateBrand:
@@ -1356,10 +1985,51 @@ func (m Model) View() string {
 		content = m.renderCreateWarehouse()
 	case ViewCreateVariant:
 		content = m.renderCreateVariant()
+	case ViewCreateAttr:
+		content = m.renderAttrTypeChooser()
 	case ViewCreateAttrText, ViewCreateAttrNumeric, ViewCreateAttrSelect:
 		content = m.renderCreateAttr()
+	case ViewEditAttrValues:
+		content = m.renderEditAttr()
 	case ViewCreatePIFromPO:
 		content = m.renderCreatePIFromPO()
+	// Manufacturing views
+	case ViewWODetail:
+		content = m.renderWODetail()
+	case ViewCreateWO:
+		content = m.renderCreateWO()
+	case ViewBOMDetail:
+		content = m.renderBOMDetail()
+	case ViewSubscriptionDetail:
+		content = m.renderSubscriptionDetail()
+	case ViewCreateSubscription:
+		content = m.renderCreateSubscription()
+	case ViewEmployeeDetail:
+		content = m.renderEmployeeDetail()
+	case ViewExpenseClaimDetail:
+		content = m.renderExpenseClaimDetail()
+	case ViewCreateExpenseClaim:
+		content = m.renderCreateExpenseClaim()
+	case ViewAttachFile:
+		content = m.renderAttachFile()
+	case ViewHistory:
+		content = m.renderHistory()
+	case ViewEditLines:
+		content = m.renderEditLines()
+	case ViewListFilter:
+		content = m.renderListFilter()
+	}
+	if _, ok := detailDoctypes[m.view]; ok {
+		content += m.renderDocumentExtras()
+	}
+	if m.palette != nil {
+		content = m.renderPalette()
+	}
+	if m.actionLog.open {
+		content = m.renderNotificationLog()
+	}
+	if m.draftOffer != nil && isFormView(m.view) {
+		content = m.renderDraftOffer()
 	}
 
 	var b strings.Builder
@@ -1389,7 +2059,7 @@ func (m Model) View() string {
 	if m.message != "" {
 		b.WriteString("\n\n")
 		if m.messageType == "error" {
-			b.WriteString(errorStyle.Render("Error: " + m.message))
+			b.WriteString(errorStyle.Render(T("Error") + ": " + m.message))
 		} else if m.messageType == "success" {
 			b.WriteString(successStyle.Render("✓ " + m.message))
 		}
@@ -1403,18 +2073,24 @@ func (m Model) View() string {
 	b.WriteString("\n")
 	b.WriteString(m.renderCredits())
 
-	return b.String()
+	return asciiText(b.String())
 }
 
 func (m Model) renderStatusBar() string {
 	var mode string
 	if m.client.Mode == "vpn" {
-		mode = vpnStyle.Render("● VPN")
+		mode = vpnStyle.Render(T("● VPN"))
 	} else {
-		mode = internetStyle.Render("● Internet")
+		mode = internetStyle.Render(T("● Internet"))
 	}
 
 	status := fmt.Sprintf(" %s | %s | %s ", m.client.Config.Brand, mode, m.client.ActiveURL)
+	if m.refreshInterval() > 0 && m.refreshable() && !m.refreshedAt.IsZero() {
+		status += "| " + tf("refreshed %s", m.refreshedAt.Format("15:04:05")) + " "
+	}
+	if m.actionLog.unread > 0 {
+		status += "| " + tf("%d new (ctrl+n)", m.actionLog.unread) + " "
+	}
 	return statusBarStyle.Render(status)
 }
 
@@ -1422,20 +2098,40 @@ func (m Model) renderBreadcrumbs() string {
 	if len(m.breadcrumbs) == 0 {
 		return ""
 	}
-	return breadcrumbStyle.Render("  " + strings.Join(m.breadcrumbs, " > "))
+	crumbs := make([]string, len(m.breadcrumbs))
+	for i, crumb := range m.breadcrumbs {
+		crumbs[i] = T(crumb)
+	}
+	return breadcrumbStyle.Render("  " + strings.Join(crumbs, " > "))
 }
 
 func (m Model) renderHelp() string {
+	if m.actionLog.open {
+		return helpStyle.Render(trHelp("↑/↓/pgup/pgdn: scroll • esc: close"))
+	}
+	if m.draftOffer != nil && isFormView(m.view) {
+		return helpStyle.Render(trHelp("y: resume draft • n: start empty"))
+	}
+	if m.palette != nil {
+		if m.palette.fields {
+			return helpStyle.Render(trHelp("type to search • ↑/↓: select • enter: copy • esc: close"))
+		}
+		return helpStyle.Render(trHelp("type to search • ↑/↓: select • enter: go • esc: close"))
+	}
+
 	var help string
 	switch m.view {
 	case ViewMain:
-		help = "↑/↓: navigate • enter: select • q: quit"
-	case ViewInventoryMenu, ViewStockMenu, ViewSalesMenu, ViewPurchasingMenu, ViewPaymentsMenu:
+		help = "↑/↓: navigate • enter: select • ctrl+p: go to • q: quit"
+	case ViewInventoryMenu, ViewStockMenu, ViewSalesMenu, ViewPurchasingMenu, ViewPaymentsMenu,
+		ViewManufacturingMenu, ViewHRMenu:
 		help = "↑/↓: navigate • enter: select • esc: back"
 	case ViewAttributes:
 		help = "↑/↓: navigate • enter: view detail • n: new • d: delete • r: refresh • /: search • esc: back"
-	case ViewItems, ViewTemplates:
-		help = "↑/↓: navigate • enter: view detail • d: delete • r: refresh • /: search • esc: back"
+	case ViewItems:
+		help = "↑/↓: navigate • enter: view detail • n: new • d: delete • r: refresh • /: search • esc: back"
+	case ViewTemplates:
+		help = "↑/↓: navigate • enter: view detail • n: new • d: delete • r: refresh • /: search • esc: back"
 	case ViewGroups:
 		help = "↑/↓: navigate • enter: view detail • n: new • d: delete • r: refresh • /: search • esc: back"
 	case ViewBrands:
@@ -1449,52 +2145,91 @@ func (m Model) renderHelp() string {
 	case ViewSuppliers:
 		help = "↑/↓: navigate • enter: detail • n: new • d: delete • /: search • esc: back"
 	case ViewPurchaseOrders:
-		help = "↑/↓: navigate • enter: detail • n: new PO • o: sort • /: search • esc: back"
+		help = "↑/↓: navigate • enter: detail • n: new PO • space: select • o: sort • /: search • f: filter • b: board • [ ]: page • esc: back"
 	case ViewPurchaseInvoices:
-		help = "↑/↓: navigate • enter: detail • o: sort • /: search • esc: back"
+		help = "↑/↓: navigate • enter: detail • space: select • o: sort • /: search • f: filter • b: board • [ ]: page • esc: back"
 	case ViewAttrDetail:
-		help = "esc: back • d: delete"
+		help = "esc: back • e: edit values • d: delete"
 	case ViewItemDetail:
-		help = "esc: back • d: delete • v: create variant (templates only)"
+		help = "esc: back • d: delete • v: create variant • g: generate variants (templates only)"
 	case ViewStockDetail:
 		help = "esc: back • r: receive • t: transfer • i: issue"
 	case ViewSerialDetail, ViewSupplierDetail:
 		help = "esc: back • d: delete"
 	case ViewPIDetail:
-		help = "esc: back • s: submit • x: cancel • p: create payment"
+		help = "esc: back • s: submit • x: cancel • p: create payment • P: save PDF • u: attach file • h: history"
 	// Sales views
 	case ViewCustomers:
 		help = "↑/↓: navigate • enter: detail • n: new • d: delete • /: search • esc: back"
 	case ViewQuotations:
-		help = "↑/↓: navigate • enter: detail • n: new • o: sort • /: search • esc: back"
+		help = "↑/↓: navigate • enter: detail • n: new • space: select • o: sort • /: search • f: filter • b: board • [ ]: page • esc: back"
 	case ViewSalesOrders:
-		help = "↑/↓: navigate • enter: detail • n: new • q: from quotation • o: sort • /: search • esc: back"
+		help = "↑/↓: navigate • enter: detail • n: new • q: from quotation • space: select • o: sort • /: search • f: filter • b: board • [ ]: page • esc: back"
 	case ViewSalesInvoices:
-		help = "↑/↓: navigate • enter: detail • n: new • o: sort • /: search • esc: back"
+		help = "↑/↓: navigate • enter: detail • n: new • space: select • o: sort • /: search • f: filter • b: board • [ ]: page • esc: back"
 	case ViewCustomerDetail:
 		help = "esc: back • d: delete"
 	case ViewQuotationDetail:
-		help = "esc: back • a: add item • s: submit • x: cancel • o: create SO"
+		help = "esc: back • a: add item • e: edit lines • s: submit • x: cancel • o: create SO • P: save PDF • u: attach file • h: history"
 	case ViewSODetail:
-		help = "esc: back • a: add item • s: submit • x: cancel • i: create invoice • r: create DN"
+		help = "esc: back • a: add item • e: edit lines • s: submit • x: cancel • i: create invoice • r: create DN • P: save PDF • u: attach file • h: history"
 	case ViewSIDetail:
-		help = "esc: back • s: submit • x: cancel • p: create payment"
+		help = "esc: back • s: submit • x: cancel • p: create payment • P: save PDF • u: attach file • h: history"
 	case ViewDeliveryNotes:
-		help = "↑/↓: navigate • enter: detail • n: new from SO • o: sort • /: search • esc: back"
+		help = "↑/↓: navigate • enter: detail • n: new from SO • space: select • o: sort • /: search • f: filter • b: board • [ ]: page • esc: back"
 	case ViewDNDetail:
-		help = "esc: back • s: submit • x: cancel"
+		help = "esc: back • s: submit • x: cancel • P: save PDF • u: attach file • h: history"
 	case ViewPurchaseReceipts:
-		help = "↑/↓: navigate • enter: detail • n: new from PO • o: sort • /: search • esc: back"
+		help = "↑/↓: navigate • enter: detail • n: new from PO • space: select • o: sort • /: search • f: filter • b: board • [ ]: page • esc: back"
 	case ViewPRDetail:
-		help = "esc: back • s: submit • x: cancel"
+		help = "esc: back • s: submit • x: cancel • P: save PDF • u: attach file • h: history"
 	case ViewPayments:
-		help = "↑/↓: navigate • enter: detail • o: sort • /: search • esc: back"
+		help = "↑/↓: navigate • enter: detail • space: select • o: sort • /: search • f: filter • b: board • [ ]: page • esc: back"
 	case ViewPaymentDetail:
-		help = "esc: back • s: submit • x: cancel"
+		help = "esc: back • s: submit • x: cancel • P: save PDF • u: attach file • h: history"
 	case ViewPODetail:
-		help = "esc: back • a: add item • s: submit • x: cancel • i: create invoice • r: create PR"
+		help = "esc: back • a: add item • e: edit lines • s: submit • x: cancel • i: create invoice • r: create PR • P: save PDF • u: attach file • h: history"
+	case ViewInbox:
+		help = "↑/↓: navigate • enter: convert to document • d: delete • r: refresh • /: search • esc: back"
+	case ViewWorkOrders:
+		help = "↑/↓: navigate • enter: detail • n: new • r: refresh • /: search • esc: back"
+	case ViewWODetail:
+		help = "esc: back • s: start (transfer materials) • f: finish (manufacture) • r: refresh"
+	case ViewBOMs:
+		help = "↑/↓: navigate • enter: detail • r: refresh • /: search • esc: back"
+	case ViewBOMDetail:
+		help = "esc: back • s: submit • n: new work order"
+	case ViewSubscriptions:
+		help = "↑/↓: navigate • enter: detail • n: new • r: refresh • /: search • esc: back"
+	case ViewSubscriptionDetail:
+		help = "esc: back • x: cancel • r: refresh"
+	case ViewEmployees:
+		help = "↑/↓: navigate • enter: detail • r: refresh • /: search • esc: back"
+	case ViewEmployeeDetail:
+		help = "esc: back • r: refresh"
+	case ViewExpenseClaims:
+		help = "↑/↓: navigate • enter: detail • n: new • r: refresh • /: search • esc: back"
+	case ViewExpenseClaimDetail:
+		help = "esc: back • s: submit • P: save PDF • u: attach file • h: history"
+	case ViewTimesheet:
+		help = "←/→: previous/next week • s: submit week • r: refresh • esc: back"
+	case ViewGenerateVariants:
+		help = "↑/↓: navigate • space: pick value • enter: create variants • r: reload • esc: back"
+	case ViewCreateTemplate:
+		if m.templateWizard != nil {
+			help = m.templateWizardHelp()
+		}
+	case ViewCreateAttr:
+		help = "↑/↓: navigate • enter: select • t/n/s: text, numeric, select • esc: cancel"
 	case ViewDashboard:
 		help = "↑/↓/pgup/pgdn: scroll • r: refresh • esc: back"
+	case ViewHistory:
+		help = "↑/↓/pgup/pgdn: scroll • r: refresh • esc: back to document"
+	case ViewEditLines:
+		help = "↑/↓: select line • enter: edit • x: remove line • s: save • esc: discard"
+		if m.lineEdit != nil && m.lineEdit.editing {
+			help = "tab: next field • enter: apply • esc: cancel"
+		}
 	case ViewConfirmDelete, ViewConfirmAction:
 		help = "y: confirm • n: cancel"
 	case ViewCreateSupplier, ViewCreateSerial, ViewStockReceive, ViewStockTransfer,
@@ -1502,16 +2237,27 @@ func (m Model) renderHelp() string {
 		ViewCreateCustomer, ViewCreateQuotation, ViewAddQuotationItem,
 		ViewCreateSO, ViewCreateSOFromQuotation, ViewAddSOItem, ViewCreateSalesInvoice,
 		ViewCreateDN, ViewCreatePayment,
-		ViewCreateGroup, ViewCreateBrand, ViewCreateWarehouse, ViewCreateVariant,
-		ViewCreateAttrText, ViewCreateAttrNumeric, ViewCreateAttrSelect,
-		ViewCreatePIFromPO:
+		ViewCreateItem, ViewCreateGroup, ViewCreateBrand, ViewCreateWarehouse, ViewCreateVariant,
+		ViewCreateAttrText, ViewCreateAttrNumeric, ViewCreateAttrSelect, ViewEditAttrValues,
+		ViewCreatePIFromPO, ViewCreateWO, ViewCreateSubscription, ViewCreateExpenseClaim, ViewAttachFile:
 		help = "tab: next field • enter: submit • esc: cancel"
+	case ViewListFilter:
+		help = "tab: next field • enter: apply • esc: cancel"
+	}
+	if _, ok := shareDoctypes[m.view]; ok {
+		help += " • y: copy name • Y: copy field • w: open in browser"
 	}
-	return helpStyle.Render(help)
+	if m.lastUndo != nil && !isFormView(m.view) && m.view != ViewConfirmDelete && m.view != ViewConfirmAction {
+		help += tf(" • %s: undo %s", m.undoKey(), m.lastUndo.kind)
+	}
+	if m.boardMode && m.isListView() {
+		help = "←/→: column • ↑/↓: card • enter: detail • space: select • f: filter • [ ]: page • b: list • esc: back"
+	}
+	return helpStyle.Render(trHelp(m.applyKeymap(help)))
 }
 
 func (m Model) renderCredits() string {
-	return creditStyle.Render(fmt.Sprintf("Created by %s in %s • v%s", Author, Year, Version))
+	return creditStyle.Render(tf("Created by %s in %s • v%s", Author, Year, Version))
 }
 
 func (m Model) renderDetail() string {
@@ -1520,32 +2266,32 @@ func (m Model) renderDetail() string {
 	}
 
 	if m.itemData == nil {
-		return "\n  No data"
+		return "\n  " + T("No data")
 	}
 
 	var b strings.Builder
 
 	if m.view == ViewAttrDetail {
-		b.WriteString(titleStyle.Render(" Attribute: "+m.selectedItem) + "\n\n")
+		b.WriteString(titleStyle.Render(T(" Attribute: ")+m.selectedItem) + "\n\n")
 
 		if name, ok := m.itemData["attribute_name"]; ok {
-			b.WriteString(fmt.Sprintf("  Name: %v\n", name))
+			b.WriteString(tf("  Name: %v\n", name))
 		}
 		if numeric, ok := m.itemData["numeric_values"]; ok {
 			if numeric == float64(1) {
-				b.WriteString("  Type: Numeric\n")
-				b.WriteString(fmt.Sprintf("  Range: %v - %v (step: %v)\n",
+				b.WriteString(T("  Type: Numeric\n"))
+				b.WriteString(tf("  Range: %v - %v (step: %v)\n",
 					m.itemData["from_range"], m.itemData["to_range"], m.itemData["increment"]))
 			} else {
-				b.WriteString("  Type: List/Text\n")
+				b.WriteString(T("  Type: List/Text\n"))
 			}
 		}
 
 		if values, ok := m.itemData["item_attribute_values"].([]interface{}); ok && len(values) > 0 {
-			b.WriteString(fmt.Sprintf("\n  Values (%d):\n", len(values)))
+			b.WriteString(tf("\n  Values (%d):\n", len(values)))
 			for i, v := range values {
 				if i >= 10 {
-					b.WriteString(fmt.Sprintf("  ... and %d more\n", len(values)-10))
+					b.WriteString(tf("  ... and %d more\n", len(values)-10))
 					break
 				}
 				if vm, ok := v.(map[string]interface{}); ok {
@@ -1554,10 +2300,10 @@ func (m Model) renderDetail() string {
 			}
 		}
 	} else {
-		b.WriteString(titleStyle.Render(" Item: "+m.selectedItem) + "\n\n")
+		b.WriteString(titleStyle.Render(T(" Item: ")+m.selectedItem) + "\n\n")
 
 		fields := []string{"item_code", "item_name", "item_group", "stock_uom"}
-		labels := []string{"Code", "Name", "Group", "UoM"}
+		labels := []string{T("Code"), T("Name"), T("Group"), T("UoM")}
 
 		for i, field := range fields {
 			if val, ok := m.itemData[field]; ok {
@@ -1566,24 +2312,25 @@ func (m Model) renderDetail() string {
 		}
 
 		if hv, ok := m.itemData["has_variants"]; ok && hv == float64(1) {
-			b.WriteString("  Type: Template (with variants)\n")
+			b.WriteString(T("  Type: Template (with variants)\n"))
 		}
 
 		if attrs, ok := m.itemData["attributes"].([]interface{}); ok && len(attrs) > 0 {
-			b.WriteString(fmt.Sprintf("\n  Attributes (%d):\n", len(attrs)))
+			b.WriteString(tf("\n  Attributes (%d):\n", len(attrs)))
 			for _, a := range attrs {
 				if am, ok := a.(map[string]interface{}); ok {
 					b.WriteString(fmt.Sprintf("    • %v\n", am["attribute"]))
 				}
 			}
 		}
+		b.WriteString(m.renderTemplateVariants())
 	}
 
 	return boxStyle.Render(b.String())
 }
 
 func (m Model) renderConfirmDelete() string {
-	content := fmt.Sprintf(`
+	content := tf(`
   Delete "%s"?
 
   This action cannot be undone.
@@ -1596,7 +2343,14 @@ func (m Model) renderConfirmDelete() string {
 
 // RunTUI starts the TUI
 func RunTUI(client *Client) error {
-	p := tea.NewProgram(NewTUI(client), tea.WithAltScreen())
-	_, err := p.Run()
+	t, err := loadTheme(client.Config.Theme)
+	if err != nil {
+		return err
+	}
+	applyTheme(t)
+	client.tuiHistory = true
+
+	p := tea.NewProgram(NewTUI(client), tea.WithAltScreen(), tea.WithMouseCellMotion())
+	_, err = p.Run()
 	return err
 }
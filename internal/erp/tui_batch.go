@@ -0,0 +1,306 @@
+package erp
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ============================================================================
+// MULTI-SELECT AND BATCH ACTIONS (space selects rows of the paged lists)
+// ============================================================================
+
+// batchAction is an action applied to every selected document
+type batchAction struct {
+	name    string // For the confirm action and the messages
+	verb    string
+	doing   string
+	done    string
+	skip    string // Why a selected document is left out
+	applies func(docstatus int) bool
+}
+
+// batchActions are the batch actions by key, picking the documents each one
+// applies to the same way the bulk command does
+var batchActions = map[string]batchAction{
+	"s": {"submit", "Submit", "Submitting", "Submitted", "not drafts", func(ds int) bool { return ds == 0 }},
+	"x": {"cancel", "Cancel", "Cancelling", "Cancelled", "not submitted", func(ds int) bool { return ds == 1 }},
+	"d": {"delete", "Delete", "Deleting", "Deleted", "submitted", func(ds int) bool { return ds != 1 }},
+}
+
+// batchRun is a batch action in progress
+type batchRun struct {
+	action   batchAction
+	doctype  string
+	total    int
+	finished int
+	failures []batchProgressMsg
+	progress chan batchProgressMsg
+}
+
+type batchProgressMsg struct {
+	name string
+	err  error
+}
+
+type batchDoneMsg struct{}
+
+// handleBatchKeys handles selection and batch keys in the paged lists. ok is
+// false when the key is not for them.
+func (m Model) handleBatchKeys(key string) (tea.Model, tea.Cmd, bool) {
+	switch key {
+	case " ":
+		if item, ok := m.currentList.SelectedItem().(ListItem); ok {
+			if _, picked := m.selected[item.name]; picked {
+				delete(m.selected, item.name)
+			} else {
+				m.selected[item.name] = item
+			}
+			m.currentList.CursorDown()
+		}
+		return m, nil, true
+
+	case "ctrl+a":
+		// Select the whole page, or clear it when it is all selected already
+		all := true
+		for _, item := range m.listItems {
+			if _, picked := m.selected[item.name]; !picked {
+				all = false
+			}
+		}
+		for _, item := range m.listItems {
+			if all {
+				delete(m.selected, item.name)
+			} else {
+				m.selected[item.name] = item
+			}
+		}
+		return m, nil, true
+
+	case "esc":
+		if len(m.selected) == 0 {
+			return m, nil, false
+		}
+		m.clearSelection()
+		return m, nil, true
+
+	case "w":
+		return m, m.exportSelection(), true
+
+	case "s", "x", "d":
+		if len(m.selected) == 0 {
+			return m, nil, false
+		}
+		action := batchActions[key]
+		targets := m.batchTargets(action)
+		if len(targets) == 0 {
+			m.message = tf("None of the %d selected can be %s (%s)", len(m.selected), strings.ToLower(T(action.done)), T(action.skip))
+			m.messageType = "error"
+			return m, nil, true
+		}
+
+		m.confirmAction = "batch_" + action.name
+		m.confirmMsg = tf("%s %d %s documents?", T(action.verb), len(targets), listSources[m.view].doctype)
+		if skipped := len(m.selected) - len(targets); skipped > 0 {
+			m.confirmMsg += tf(" (%d selected skipped: %s)", skipped, T(action.skip))
+		}
+		m.prevView = m.view
+		m.view = ViewConfirmAction
+		return m, nil, true
+	}
+	return m, nil, false
+}
+
+// clearSelection empties the selection in place, so the list delegate keeps
+// seeing the same map
+func (m *Model) clearSelection() {
+	for name := range m.selected {
+		delete(m.selected, name)
+	}
+}
+
+// batchTargets returns the selected documents the action applies to, by name
+func (m Model) batchTargets(action batchAction) []string {
+	var names []string
+	for name, item := range m.selected {
+		if action.applies(item.docstatus) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// startBatch runs a confirmed batch action with bulkWorkers requests in
+// flight. Every finished document is reported as a batchProgressMsg.
+func (m *Model) startBatch(name string) tea.Cmd {
+	var action batchAction
+	for _, a := range batchActions {
+		if a.name == name {
+			action = a
+		}
+	}
+	doctype := listSources[m.view].doctype
+	names := m.batchTargets(action)
+
+	var run func(name string) error
+	switch action.name {
+	case "submit":
+		notify := m.client.notifyOnSubmit(doctype, nil)
+		run = func(name string) error { return m.client.submitNotifying(doctype, name, notify) }
+	case "cancel":
+		run = func(name string) error { return m.client.cancelDocument(doctype, name) }
+	case "delete":
+		run = func(name string) error {
+			_, err := m.client.Request("DELETE", url.PathEscape(doctype)+"/"+url.PathEscape(name), nil)
+			return err
+		}
+	default:
+		return nil
+	}
+
+	// Buffered so the workers never wait on the screen
+	progress := make(chan batchProgressMsg, len(names))
+	m.batch = &batchRun{action: action, doctype: doctype, total: len(names), progress: progress}
+	m.loading = false
+
+	go func() {
+		runBulk(names, func(name string, err error) {
+			progress <- batchProgressMsg{name, err}
+		}, run)
+		close(progress)
+	}()
+	return waitBatch(progress)
+}
+
+// waitBatch waits for the next document of a batch to finish
+func waitBatch(progress chan batchProgressMsg) tea.Cmd {
+	return func() tea.Msg {
+		msg, ok := <-progress
+		if !ok {
+			return batchDoneMsg{}
+		}
+		return msg
+	}
+}
+
+// batchProgress counts a finished document and waits for the next one
+func (m Model) batchProgress(msg batchProgressMsg) (tea.Model, tea.Cmd) {
+	if m.batch == nil {
+		return m, nil
+	}
+	m.batch.finished++
+	if msg.err != nil {
+		m.batch.failures = append(m.batch.failures, msg)
+	}
+	return m, waitBatch(m.batch.progress)
+}
+
+// finishBatch reports the outcome and reloads the list. The failed documents
+// stay selected so the action can be retried on them.
+func (m Model) finishBatch() (tea.Model, tea.Cmd) {
+	run := m.batch
+	m.batch = nil
+	if run == nil {
+		return m, nil
+	}
+
+	failed := make(map[string]bool)
+	var failures []string
+	for _, failure := range run.failures {
+		failed[failure.name] = true
+		failures = append(failures, fmt.Sprintf("%s: %s", failure.name, failure.err))
+	}
+	for name := range m.selected {
+		if !failed[name] {
+			delete(m.selected, name)
+		}
+	}
+
+	m.notification = tf("%s %d of %d %s documents", T(run.action.done), run.total-len(run.failures), run.total, run.doctype)
+	m.notificationType = "success"
+	m.showNotification = true
+	m.actionLog.record(len(run.failures) == 0, m.notification)
+	if run.action.name != "submit" {
+		m.lastUndo = &undoAction{kind: run.action.name, reason: tf("batch %s of %d documents cannot be undone", run.action.name, run.total)}
+	}
+	for _, failure := range failures {
+		m.actionLog.record(false, failure)
+	}
+	if len(run.failures) > 0 {
+		m.notificationType = "error"
+		m.message = tf("%d failed: %s", len(run.failures), strings.Join(failures, "; "))
+		m.messageType = "error"
+	}
+
+	refreshModel, refreshCmd := m.refreshCurrentView()
+	return refreshModel, tea.Batch(
+		refreshCmd,
+		tea.Tick(3*time.Second, func(time.Time) tea.Msg {
+			return clearNotificationMsg{}
+		}),
+	)
+}
+
+// exportSelection writes the selected rows, or the whole page when nothing
+// is selected, to a CSV file in the current directory
+func (m Model) exportSelection() tea.Cmd {
+	rows := make([]ListItem, 0, len(m.selected))
+	for _, item := range m.selected {
+		rows = append(rows, item)
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].name < rows[j].name })
+	if len(rows) == 0 {
+		rows = m.listItems
+	}
+	doctype := listSources[m.view].doctype
+	path := strings.ToLower(strings.ReplaceAll(doctype, " ", "-")) + "-" + m.client.Today() + ".csv"
+
+	return func() tea.Msg {
+		if len(rows) == 0 {
+			return formSubmittedMsg{false, T("Nothing to export")}
+		}
+		file, err := os.Create(path)
+		if err != nil {
+			return formSubmittedMsg{false, tf("failed to create file: %s", err)}
+		}
+		defer file.Close()
+
+		writer := csv.NewWriter(file)
+		writer.Write([]string{"name", "date", "party", "status", "total"})
+		for _, row := range rows {
+			writer.Write([]string{row.name, row.date, row.party, row.status, fmt.Sprintf("%.2f", row.amount)})
+		}
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return formSubmittedMsg{false, tf("failed to write %s: %s", path, err)}
+		}
+		return formSubmittedMsg{true, tf("Exported %d rows to %s", len(rows), path)}
+	}
+}
+
+// renderBatchStatus renders the selection hints or the progress of a
+// running batch below a paged list
+func (m Model) renderBatchStatus() string {
+	if run := m.batch; run != nil {
+		const width = 30
+		filled := width * run.finished / run.total
+		bar := strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
+		status := fmt.Sprintf("%s %s %d/%d", T(run.action.doing), bar, run.finished, run.total)
+		if len(run.failures) > 0 {
+			status += tf(" • %d failed", len(run.failures))
+		}
+		return "\n\n  " + selectedStyle.Render(status)
+	}
+	if len(m.selected) == 0 {
+		return ""
+	}
+	return "\n" + selectedStyle.Render(tf(" %d selected", len(m.selected))) +
+		helpStyle.Render(trHelp(m.applyKeymap(" • s: submit • x: cancel • d: delete • w: export CSV • esc: clear")))
+}
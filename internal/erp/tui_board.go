@@ -0,0 +1,241 @@
+package erp
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ============================================================================
+// STATUS BOARD (b toggles the paged lists between rows and status columns)
+// ============================================================================
+
+// boardColumn is a column of the board and the statuses it gathers
+type boardColumn struct {
+	title    string
+	statuses []string
+}
+
+// boardColumns are the board columns of each paged list, by doctype.
+// Documents in any other status land in an "Other" column.
+var boardColumns = map[string][]boardColumn{
+	"Quotation": {
+		{"Draft", []string{"Draft"}},
+		{"Open", []string{"Open", "Replied", "Partially Ordered"}},
+		{"Ordered", []string{"Ordered"}},
+		{"Lost", []string{"Lost", "Expired"}},
+	},
+	"Sales Order": {
+		{"Draft", []string{"Draft"}},
+		{"To Deliver", []string{"To Deliver and Bill", "To Deliver", "On Hold"}},
+		{"To Bill", []string{"To Bill"}},
+		{"Completed", []string{"Completed", "Closed"}},
+	},
+	"Sales Invoice": {
+		{"Draft", []string{"Draft"}},
+		{"Unpaid", []string{"Unpaid", "Partly Paid"}},
+		{"Overdue", []string{"Overdue"}},
+		{"Paid", []string{"Paid", "Return", "Credit Note Issued"}},
+	},
+	"Delivery Note": {
+		{"Draft", []string{"Draft"}},
+		{"To Bill", []string{"To Bill"}},
+		{"Completed", []string{"Completed", "Closed", "Return Issued"}},
+	},
+	"Purchase Order": {
+		{"Draft", []string{"Draft"}},
+		{"To Receive", []string{"To Receive and Bill", "To Receive", "On Hold"}},
+		{"To Bill", []string{"To Bill"}},
+		{"Completed", []string{"Completed", "Closed", "Delivered"}},
+	},
+	"Purchase Invoice": {
+		{"Draft", []string{"Draft"}},
+		{"Unpaid", []string{"Unpaid", "Partly Paid"}},
+		{"Overdue", []string{"Overdue"}},
+		{"Paid", []string{"Paid", "Return", "Debit Note Issued"}},
+	},
+	"Purchase Receipt": {
+		{"Draft", []string{"Draft"}},
+		{"To Bill", []string{"To Bill"}},
+		{"Completed", []string{"Completed", "Closed", "Return Issued"}},
+	},
+	"Payment Entry": {
+		{"Draft", []string{"Draft"}},
+		{"Submitted", []string{"Submitted"}},
+	},
+}
+
+// boardMinWidth is the narrowest a board column gets
+const boardMinWidth = 18
+
+// boardLane is a board column with its documents, as indexes into listItems
+type boardLane struct {
+	title string
+	items []int
+	total float64
+}
+
+// boardLanes sorts the loaded page into the columns of the current list.
+// Cancelled documents are left out; the Other column only shows when used.
+func (m Model) boardLanes() []boardLane {
+	columns := boardColumns[listSources[m.view].doctype]
+	lanes := make([]boardLane, len(columns)+1)
+	column := make(map[string]int)
+	for i, c := range columns {
+		lanes[i].title = c.title
+		for _, status := range c.statuses {
+			column[status] = i
+		}
+	}
+	lanes[len(columns)].title = "Other"
+
+	for i, item := range m.listItems {
+		if item.status == "Cancelled" {
+			continue
+		}
+		lane, ok := column[item.status]
+		if !ok {
+			lane = len(columns)
+		}
+		lanes[lane].items = append(lanes[lane].items, i)
+		lanes[lane].total += item.amount
+	}
+
+	if len(lanes[len(columns)].items) == 0 {
+		lanes = lanes[:len(columns)]
+	}
+	return lanes
+}
+
+// clampBoard keeps the focused card inside the board
+func (m *Model) clampBoard(lanes []boardLane) {
+	if m.boardCol >= len(lanes) {
+		m.boardCol = len(lanes) - 1
+	}
+	if m.boardCol < 0 {
+		m.boardCol = 0
+	}
+	if len(lanes) == 0 {
+		m.boardRow = 0
+		return
+	}
+	if m.boardRow >= len(lanes[m.boardCol].items) {
+		m.boardRow = len(lanes[m.boardCol].items) - 1
+	}
+	if m.boardRow < 0 {
+		m.boardRow = 0
+	}
+}
+
+// syncBoardSelection points the list cursor at the focused card, so enter,
+// space and the batch keys act on it
+func (m *Model) syncBoardSelection() {
+	lanes := m.boardLanes()
+	m.clampBoard(lanes)
+	if len(lanes) > 0 && len(lanes[m.boardCol].items) > 0 {
+		m.currentList.Select(lanes[m.boardCol].items[m.boardRow])
+	}
+}
+
+// handleBoardKeys moves the focus over the board and reports whether the
+// key was for it
+func (m *Model) handleBoardKeys(key string) bool {
+	lanes := m.boardLanes()
+	switch key {
+	case "left", "h":
+		m.boardCol--
+	case "right", "l":
+		m.boardCol++
+	case "up", "k":
+		m.boardRow--
+	case "down", "j":
+		m.boardRow++
+	default:
+		return false
+	}
+	// The row is kept when moving sideways, up to the column's last card
+	m.clampBoard(lanes)
+	m.syncBoardSelection()
+	return true
+}
+
+// boardSize returns the width of a column and how many cards fit in it
+func (m Model) boardSize(lanes int) (width, visible int) {
+	width = (m.width - 4) / lanes
+	if width < boardMinWidth {
+		width = boardMinWidth
+	}
+
+	// Cards take 4 lines; leave room for the title, headers and footer
+	visible = (m.height - 16) / 4
+	if visible < 1 {
+		visible = 1
+	}
+	return width, visible
+}
+
+// boardStart returns the first card shown in a column. The focused column
+// scrolls so its card stays in view.
+func (m Model) boardStart(col, visible int) int {
+	if col == m.boardCol && m.boardRow >= visible {
+		return m.boardRow - visible + 1
+	}
+	return 0
+}
+
+// renderBoard renders the loaded page as status columns of cards
+func (m Model) renderBoard() string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(m.currentList.Title) + "\n\n")
+
+	lanes := m.boardLanes()
+	if len(m.listItems) == 0 || len(lanes) == 0 {
+		b.WriteString(helpStyle.Render(trHelp("  No documents")) + "\n")
+		b.WriteString(m.renderListStatus())
+		return b.String()
+	}
+
+	width, visible := m.boardSize(len(lanes))
+	inner := width - 3
+
+	columns := make([]string, len(lanes))
+	for col, lane := range lanes {
+		var c strings.Builder
+		header := fmt.Sprintf("%s (%d)", lane.title, len(lane.items))
+		c.WriteString(selectedStyle.Render(truncate(header, inner)) + "\n")
+		c.WriteString(helpStyle.Render(truncate(m.client.FormatCurrency(lane.total), inner)) + "\n")
+		c.WriteString(helpStyle.Render(strings.Repeat("─", inner)) + "\n")
+
+		start := m.boardStart(col, visible)
+		if start > 0 {
+			c.WriteString(helpStyle.Render(tf("↑ %d more", start)) + "\n")
+		}
+		end := start + visible
+		if end > len(lane.items) {
+			end = len(lane.items)
+		}
+		for row := start; row < end; row++ {
+			item := m.listItems[lane.items[row]]
+			mark := " "
+			if _, picked := m.selected[item.name]; picked {
+				mark = "●"
+			}
+			card := fmt.Sprintf("%s%s\n %s\n %s", mark, truncate(item.name, inner-1),
+				truncate(item.party, inner-1), truncate(m.client.FormatCurrency(item.amount), inner-1))
+			if col == m.boardCol && row == m.boardRow {
+				card = selectedStyle.Render(card)
+			}
+			c.WriteString(card + "\n\n")
+		}
+		if end < len(lane.items) {
+			c.WriteString(helpStyle.Render(tf("↓ %d more", len(lane.items)-end)) + "\n")
+		}
+
+		columns[col] = lipgloss.NewStyle().Width(width).PaddingRight(1).Render(c.String())
+	}
+
+	b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, columns...))
+	b.WriteString(m.renderListStatus())
+	return b.String()
+}
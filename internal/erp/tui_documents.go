@@ -0,0 +1,287 @@
+package erp
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ============================================================================
+// DOCUMENT ACTIONS (shared by the transaction detail views)
+// ============================================================================
+
+// detailDoctypes maps the transaction detail views to their doctype
+var detailDoctypes = map[View]string{
+	ViewQuotationDetail:    "Quotation",
+	ViewSODetail:           "Sales Order",
+	ViewSIDetail:           "Sales Invoice",
+	ViewDNDetail:           "Delivery Note",
+	ViewPODetail:           "Purchase Order",
+	ViewPIDetail:           "Purchase Invoice",
+	ViewPRDetail:           "Purchase Receipt",
+	ViewPaymentDetail:      "Payment Entry",
+	ViewExpenseClaimDetail: "Expense Claim",
+}
+
+type pdfSavedMsg struct {
+	path string
+}
+
+type historyLoadedMsg struct {
+	versions []docVersion
+}
+
+// maxDetailComments is how many of the latest comments a detail view shows
+const maxDetailComments = 5
+
+// documentExtras is what transaction detail views show below the document
+type documentExtras struct {
+	attachments []attachment
+	assignments []docAssignment
+	comments    []docComment
+}
+
+type documentExtrasMsg struct {
+	name   string // Document the extras belong to
+	extras *documentExtras
+}
+
+// loadDocumentExtras fetches the attachments, assignments and comments of
+// the document in a transaction detail view. Low-bandwidth mode skips
+// these extra requests.
+func (m Model) loadDocumentExtras() tea.Cmd {
+	doctype, ok := detailDoctypes[m.view]
+	if !ok || m.client.LowBandwidth() {
+		return nil
+	}
+	name := m.selectedItem
+	return func() tea.Msg {
+		extras := &documentExtras{}
+		var err error
+		if extras.attachments, err = m.client.fetchAttachments(doctype, name); err != nil {
+			return errorMsg{err}
+		}
+		if extras.assignments, err = m.client.fetchAssignments(doctype, name); err != nil {
+			return errorMsg{err}
+		}
+		if extras.comments, err = m.client.fetchComments(doctype, name); err != nil {
+			return errorMsg{err}
+		}
+		return documentExtrasMsg{name, extras}
+	}
+}
+
+// renderDocumentExtras renders the attachments and activity of a detail view
+func (m Model) renderDocumentExtras() string {
+	if m.loading || m.itemData == nil || m.docExtras == nil {
+		return ""
+	}
+	extras := m.docExtras
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("  %s\n", selectedStyle.Render(tf("Attachments (%d):", len(extras.attachments)))))
+	if len(extras.attachments) == 0 {
+		b.WriteString(helpStyle.Render(trHelp("    None - press u to upload a file")) + "\n")
+	}
+	for _, f := range extras.attachments {
+		line := fmt.Sprintf("    - %s (%s)", f.fileName, formatFileSize(f.size))
+		if f.private {
+			line += helpStyle.Render(trHelp(" private"))
+		}
+		b.WriteString(line + "\n")
+	}
+
+	if len(extras.assignments) > 0 {
+		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render(T("Assigned to:"))))
+		for _, a := range extras.assignments {
+			b.WriteString(fmt.Sprintf("    - %s\n", assignmentLine(a)))
+		}
+	}
+
+	if len(extras.comments) > 0 {
+		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render(tf("Comments (%d):", len(extras.comments)))))
+		comments := extras.comments
+		if len(comments) > maxDetailComments {
+			b.WriteString(helpStyle.Render(tf("    %d older comments not shown", len(comments)-maxDetailComments)) + "\n")
+			comments = comments[len(comments)-maxDetailComments:]
+		}
+		for _, cm := range comments {
+			created := cm.created
+			if len(created) > 16 {
+				created = created[:16]
+			}
+			b.WriteString(helpStyle.Render(fmt.Sprintf("    %s %s", created, cm.by)) + "\n")
+			b.WriteString(fmt.Sprintf("      %s\n", firstLine(cm.content)))
+		}
+	}
+	return "\n" + boxStyle.Render(b.String())
+}
+
+// initAttachFileForm initializes the upload form of a detail view
+func (m *Model) initAttachFileForm() {
+	m.inputs = make([]textinput.Model, 1)
+
+	m.inputs[0] = textinput.New()
+	m.inputs[0].Placeholder = T("Path of the file to attach")
+	m.inputs[0].Focus()
+
+	m.focusIndex = 0
+}
+
+// renderAttachFile renders the upload form
+func (m Model) renderAttachFile() string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(tf(" Attach to %s %s ", detailDoctypes[m.prevView], m.selectedItem)) + "\n\n")
+
+	b.WriteString(T("  File:\n"))
+	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputs[0].View()))
+
+	b.WriteString(helpStyle.Render(trHelp("  Files are uploaded as private attachments")))
+
+	return boxStyle.Render(b.String())
+}
+
+// submitAttachFile uploads the file of the form to the document
+func (m Model) submitAttachFile() tea.Cmd {
+	doctype := detailDoctypes[m.prevView]
+	name := m.selectedItem
+	return func() tea.Msg {
+		path := strings.TrimSpace(m.inputs[0].Value())
+		if path == "" {
+			return formSubmittedMsg{false, T("File is required")}
+		}
+		if strings.HasPrefix(path, "~/") {
+			if home, err := os.UserHomeDir(); err == nil {
+				path = filepath.Join(home, path[2:])
+			}
+		}
+
+		if _, err := m.client.uploadFile(path, doctype, name, true); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		return formSubmittedMsg{true, tf("Attached %s", filepath.Base(path))}
+	}
+}
+
+// loadHistory fetches the versions of a document for the history view
+func (m Model) loadHistory(doctype, name string) tea.Cmd {
+	return func() tea.Msg {
+		versions, err := m.client.fetchVersions(doctype, name, defaultHistoryLimit)
+		if err != nil {
+			return errorMsg{err}
+		}
+		return historyLoadedMsg{versions}
+	}
+}
+
+// renderHistoryContent returns the version history for the viewport
+func (m Model) renderHistoryContent(versions []docVersion) string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(tf(" History: %s %s ", detailDoctypes[m.prevView], m.selectedItem)) + "\n\n")
+
+	if len(versions) == 0 {
+		b.WriteString(T("  No changes recorded (Track Changes may be off for this doctype)\n"))
+		return b.String()
+	}
+	for _, v := range versions {
+		b.WriteString(fmt.Sprintf("  %s  %s\n", selectedStyle.Render(v.created), helpStyle.Render(v.by)))
+		for _, line := range v.lines {
+			b.WriteString(fmt.Sprintf("    %s\n", line))
+		}
+		b.WriteString("\n")
+	}
+	if len(versions) == defaultHistoryLimit {
+		b.WriteString(helpStyle.Render(tf("  Latest %d changes - see erp-cli history for more", defaultHistoryLimit)) + "\n")
+	}
+	return b.String()
+}
+
+// renderHistory renders the history view with its scrollable viewport
+func (m Model) renderHistory() string {
+	if m.loading {
+		return tf("\n  %s Loading history...", m.spinner.View())
+	}
+	if !m.viewportReady {
+		return "\n  Initializing..."
+	}
+
+	var b strings.Builder
+	b.WriteString(m.viewport.View())
+	b.WriteString("\n")
+	if m.viewport.TotalLineCount() > m.viewport.VisibleLineCount() {
+		b.WriteString(helpStyle.Render(tf("  ↑↓ scroll • %.0f%% ", m.viewport.ScrollPercent()*100)))
+	}
+	return b.String()
+}
+
+// savePDF writes the PDF of a document to the current directory
+func (m Model) savePDF(doctype, name string) tea.Cmd {
+	return func() tea.Msg {
+		path, err := m.client.savePDF(doctype, name, pdfOptions{})
+		if err != nil {
+			return errorMsg{err}
+		}
+		return pdfSavedMsg{path}
+	}
+}
+
+// openPDF opens a saved PDF with the desktop's viewer
+func (m Model) openPDF(path string) tea.Cmd {
+	return func() tea.Msg {
+		if err := openFile(path); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		return formSubmittedMsg{true, tf("Opened %s", path)}
+	}
+}
+
+// pdfSaved reports the saved file and offers to open it
+func (m Model) pdfSaved(path string) (tea.Model, tea.Cmd) {
+	m.loading = false
+	m.pdfPath = path
+	m.notification = tf("Saved %s", path)
+	m.actionLog.record(true, m.notification)
+	m.notificationType = "success"
+	m.showNotification = true
+
+	m.confirmAction = "open_pdf"
+	m.confirmMsg = tf("Saved %s. Open it?", path)
+	m.prevView = m.view
+	m.view = ViewConfirmAction
+	return m, tea.Tick(3*time.Second, func(time.Time) tea.Msg {
+		return clearNotificationMsg{}
+	})
+}
+
+// handleDocumentKeys handles keyboard shortcuts shared by transaction
+// detail views
+func (m *Model) handleDocumentKeys(key string) (tea.Model, tea.Cmd) {
+	doctype, ok := detailDoctypes[m.view]
+	if !ok || m.itemData == nil {
+		return m, nil
+	}
+
+	switch key {
+	case "P":
+		m.loading = true
+		return m, m.savePDF(doctype, m.selectedItem)
+	case "h":
+		m.loading = true
+		m.prevView = m.view
+		m.view = ViewHistory
+		return m, m.loadHistory(doctype, m.selectedItem)
+	case "u":
+		m.initAttachFileForm()
+		m.prevView = m.view
+		m.view = ViewAttachFile
+		// A command stops the key from reaching the new form's input
+		return m, textinput.Blink
+	}
+
+	return m, nil
+}
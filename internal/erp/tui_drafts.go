@@ -0,0 +1,178 @@
+package erp
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ============================================================================
+// FORM DRAFTS (inputs kept in ~/.erp-cli/drafts.json until the form is sent)
+// ============================================================================
+
+const draftsFile = "drafts.json"
+
+// draftDelay is how long typing must pause before the draft is saved
+const draftDelay = time.Second
+
+// draftForms are the forms whose inputs are kept as drafts, by the name of
+// their draft. Forms adding to a document keep one draft per document.
+var draftForms = map[View]string{
+	ViewCreateSupplier:        "supplier",
+	ViewCreateCustomer:        "customer",
+	ViewCreateItem:            "item",
+	ViewCreateSerial:          "serial",
+	ViewStockReceive:          "stock-receive",
+	ViewStockTransfer:         "stock-transfer",
+	ViewStockIssue:            "stock-issue",
+	ViewCreatePO:              "purchase-order",
+	ViewAddPOItem:             "purchase-order-item",
+	ViewCreateQuotation:       "quotation",
+	ViewAddQuotationItem:      "quotation-item",
+	ViewCreateSO:              "sales-order",
+	ViewAddSOItem:             "sales-order-item",
+	ViewCreatePayment:         "payment",
+	ViewCreateWO:              "work-order",
+	ViewCreateSubscription:    "subscription",
+	ViewCreateExpenseClaim:    "expense-claim",
+	ViewCreateAttrText:        "attribute-text",
+	ViewCreateAttrNumeric:     "attribute-numeric",
+	ViewCreateAttrSelect:      "attribute-select",
+	ViewCreateSOFromQuotation: "sales-order-from-quotation",
+}
+
+// formDraft is the saved inputs of a form
+type formDraft struct {
+	Values []string `json:"values"`
+	Saved  string   `json:"saved"`
+}
+
+type draftTickMsg struct {
+	seq int
+}
+
+// draftKey names the draft of the open form, "" for forms without drafts
+func (m Model) draftKey() string {
+	name, ok := draftForms[m.view]
+	if !ok {
+		return ""
+	}
+	switch m.view {
+	case ViewAddPOItem, ViewAddQuotationItem, ViewAddSOItem:
+		return name + ":" + m.selectedItem
+	}
+	return name
+}
+
+// loadDrafts reads the drafts file; a broken one counts as empty
+func loadDrafts() map[string]formDraft {
+	drafts := map[string]formDraft{}
+	if err := loadLocalJSON(draftsFile, &drafts); err != nil || drafts == nil {
+		return map[string]formDraft{}
+	}
+	return drafts
+}
+
+// saveDraft keeps the open form's inputs, or drops its draft when they are
+// all empty
+func (m Model) saveDraft() {
+	key := m.draftKey()
+	if key == "" || m.draftOffer != nil || m.draftDone {
+		return
+	}
+	values := make([]string, len(m.inputs))
+	empty := true
+	for i, input := range m.inputs {
+		values[i] = input.Value()
+		empty = empty && strings.TrimSpace(values[i]) == ""
+	}
+
+	drafts := loadDrafts()
+	if empty {
+		delete(drafts, key)
+	} else {
+		drafts[key] = formDraft{values, time.Now().Format("2006-01-02 15:04")}
+	}
+	// A draft that cannot be written just is not kept
+	_ = saveLocalJSON(draftsFile, drafts)
+}
+
+// dropDraft forgets a form's draft, once the form was sent or the draft
+// turned down
+func (m Model) dropDraft(key string) {
+	if key == "" {
+		return
+	}
+	drafts := loadDrafts()
+	if _, ok := drafts[key]; ok {
+		delete(drafts, key)
+		_ = saveLocalJSON(draftsFile, drafts)
+	}
+}
+
+// scheduleDraft saves the draft once typing pauses
+func (m *Model) scheduleDraft() tea.Cmd {
+	if m.draftKey() == "" {
+		return nil
+	}
+	m.draftSeq++
+	seq := m.draftSeq
+	return tea.Tick(draftDelay, func(time.Time) tea.Msg { return draftTickMsg{seq} })
+}
+
+// offerDraft asks to resume the draft of a form that just opened, if it
+// differs from what the form starts with
+func (m *Model) offerDraft() {
+	key := m.draftKey()
+	if key == "" {
+		return
+	}
+	draft, ok := loadDrafts()[key]
+	if !ok || len(draft.Values) != len(m.inputs) {
+		return
+	}
+	same := true
+	for i, input := range m.inputs {
+		same = same && input.Value() == draft.Values[i]
+	}
+	if !same {
+		m.draftOffer = &draft
+	}
+}
+
+// handleDraftOffer answers the resume prompt: y fills the form with the
+// draft, n starts over and drops it
+func (m Model) handleDraftOffer(key string) (tea.Model, tea.Cmd) {
+	switch key {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "y", "enter":
+		for i, value := range m.draftOffer.Values {
+			m.inputs[i].SetValue(value)
+			m.inputs[i].CursorEnd()
+		}
+		m.draftOffer = nil
+	case "n", "esc":
+		m.draftOffer = nil
+		m.dropDraft(m.draftKey())
+	}
+	return m, nil
+}
+
+// renderDraftOffer renders the resume prompt with the draft's inputs
+func (m Model) renderDraftOffer() string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(T(" Resume draft? ")) + "\n\n")
+	b.WriteString(tf("  This form has a draft from %s:\n\n", m.draftOffer.Saved))
+	for i, value := range m.draftOffer.Values {
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+		label := m.inputs[i].Placeholder
+		b.WriteString(fmt.Sprintf("  %s %s\n", helpStyle.Render(truncate(label, 30)+":"), value))
+	}
+	b.WriteString(T("\n  [y] Resume the draft    [n] Start empty\n"))
+	return boxStyle.Render(b.String())
+}
@@ -0,0 +1,315 @@
+package erp
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ============================================================================
+// LIST FILTERS (f form on the paged lists, named filters saved to config)
+// ============================================================================
+
+// listFilter narrows a paged list on the server. Empty fields are ignored.
+type listFilter struct {
+	name   string // Saved filter it was applied from or saved as
+	status string
+	party  string
+	from   string // Date range, YYYY-MM-DD
+	to     string
+	min    string // Total range
+	max    string
+}
+
+type listFilterMsg struct {
+	filter  listFilter
+	spec    string // Config value when the filter was saved
+	saveErr error
+}
+
+// empty reports whether the filter has no conditions
+func (f listFilter) empty() bool {
+	return f.status == "" && f.party == "" && f.from == "" && f.to == "" && f.min == "" && f.max == ""
+}
+
+// validate checks the dates and amounts
+func (f listFilter) validate() error {
+	for _, date := range []string{f.from, f.to} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return errorf("invalid date %q (use YYYY-MM-DD)", date)
+		}
+	}
+	for _, amount := range []string{f.min, f.max} {
+		if amount == "" {
+			continue
+		}
+		if _, err := strconv.ParseFloat(amount, 64); err != nil {
+			return errorf("invalid amount %q", amount)
+		}
+	}
+	return nil
+}
+
+// conditions translates the filter to ERPNext filters on the list's fields
+func (f listFilter) conditions(source listSource) [][]interface{} {
+	var filters [][]interface{}
+	if f.status != "" {
+		filters = append(filters, []interface{}{"status", "=", f.status})
+	}
+	if f.party != "" {
+		filters = append(filters, []interface{}{source.partyField, "like", "%" + f.party + "%"})
+	}
+	if f.from != "" {
+		filters = append(filters, []interface{}{source.dateField, ">=", f.from})
+	}
+	if f.to != "" {
+		filters = append(filters, []interface{}{source.dateField, "<=", f.to})
+	}
+	if amount, err := strconv.ParseFloat(f.min, 64); err == nil {
+		filters = append(filters, []interface{}{source.totalField, ">=", amount})
+	}
+	if amount, err := strconv.ParseFloat(f.max, 64); err == nil {
+		filters = append(filters, []interface{}{source.totalField, "<=", amount})
+	}
+	return filters
+}
+
+// label describes the filter for the list footer
+func (f listFilter) label() string {
+	if f.name != "" {
+		return f.name
+	}
+	var parts []string
+	if f.status != "" {
+		parts = append(parts, f.status)
+	}
+	if f.party != "" {
+		parts = append(parts, "party ~ "+f.party)
+	}
+	if f.from != "" {
+		parts = append(parts, "from "+f.from)
+	}
+	if f.to != "" {
+		parts = append(parts, "to "+f.to)
+	}
+	if f.min != "" {
+		parts = append(parts, "≥ "+f.min)
+	}
+	if f.max != "" {
+		parts = append(parts, "≤ "+f.max)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// spec renders the filter as a filter.<name> config value:
+// the doctype followed by key=value pairs
+func (f listFilter) spec(doctype string) string {
+	args := []string{quoteArg(doctype)}
+	pairs := [][2]string{
+		{"status", f.status}, {"party", f.party}, {"from", f.from},
+		{"to", f.to}, {"min", f.min}, {"max", f.max},
+	}
+	for _, pair := range pairs {
+		if pair[1] != "" {
+			args = append(args, pair[0]+"="+quoteArg(pair[1]))
+		}
+	}
+	return strings.Join(args, " ")
+}
+
+// parseFilterSpec reads a filter.<name> config value back
+func parseFilterSpec(name, spec string) (string, listFilter, error) {
+	f := listFilter{name: name}
+	args, err := splitArgs(spec)
+	if err != nil {
+		return "", f, err
+	}
+	if len(args) == 0 {
+		return "", f, errorf("no doctype")
+	}
+	for _, arg := range args[1:] {
+		key, value, _ := strings.Cut(arg, "=")
+		switch key {
+		case "status":
+			f.status = value
+		case "party":
+			f.party = value
+		case "from":
+			f.from = value
+		case "to":
+			f.to = value
+		case "min":
+			f.min = value
+		case "max":
+			f.max = value
+		default:
+			return args[0], f, errorf("invalid filter %q (use status, party, from, to, min or max)", arg)
+		}
+	}
+	return args[0], f, f.validate()
+}
+
+// quoteArg quotes a value for splitArgs when it has spaces or quotes
+func quoteArg(s string) string {
+	if s != "" && !strings.ContainsAny(s, " \t'\"\\") {
+		return s
+	}
+	if !strings.Contains(s, "'") {
+		return "'" + s + "'"
+	}
+	return "\"" + strings.NewReplacer("\\", "\\\\", "\"", "\\\"").Replace(s) + "\""
+}
+
+// savedFilters returns the valid saved filters of a doctype, by name
+func (m Model) savedFilters(doctype string) []listFilter {
+	var filters []listFilter
+	for name, spec := range m.client.Config.SavedFilters {
+		if dt, f, err := parseFilterSpec(name, spec); err == nil && dt == doctype {
+			filters = append(filters, f)
+		}
+	}
+	sort.Slice(filters, func(i, j int) bool { return filters[i].name < filters[j].name })
+	return filters
+}
+
+// openListFilter opens the filter form of the current list, filled with the
+// active filter
+func (m Model) openListFilter() (tea.Model, tea.Cmd) {
+	f := m.listFilter
+	values := []string{f.status, f.party, f.from, f.to, f.min, f.max, ""}
+	placeholders := []string{T("e.g. Draft, Unpaid, Overdue"), T("Name or part of it"), T("YYYY-MM-DD"), T("YYYY-MM-DD"),
+		T("Minimum total"), T("Maximum total"), T("Name to save the filter as (optional)")}
+
+	m.inputs = make([]textinput.Model, len(values))
+	for i := range m.inputs {
+		m.inputs[i] = textinput.New()
+		m.inputs[i].Placeholder = placeholders[i]
+		m.inputs[i].Width = 40
+		m.inputs[i].SetValue(values[i])
+	}
+	m.inputs[0].Focus()
+	m.focusIndex = 0
+
+	m.prevView = m.view
+	m.view = ViewListFilter
+	return m, textinput.Blink
+}
+
+// submitListFilter applies the form, saving it to the config when named
+func (m Model) submitListFilter() tea.Cmd {
+	values := make([]string, len(m.inputs))
+	for i := range m.inputs {
+		values[i] = strings.TrimSpace(m.inputs[i].Value())
+	}
+	doctype := listSources[m.prevView].doctype
+	config := m.client.Config
+
+	return func() tea.Msg {
+		f := listFilter{
+			status: values[0],
+			party:  values[1],
+			from:   values[2],
+			to:     values[3],
+			min:    values[4],
+			max:    values[5],
+		}
+		if err := f.validate(); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+
+		name := values[6]
+		if name == "" {
+			return listFilterMsg{filter: f}
+		}
+		if strings.ContainsAny(name, " \t=\"'") {
+			return formSubmittedMsg{false, T("Filter names cannot contain spaces, = or quotes")}
+		}
+		if f.empty() {
+			return formSubmittedMsg{false, T("Fill at least one field to save a filter")}
+		}
+		f.name = name
+		spec := f.spec(doctype)
+		return listFilterMsg{f, spec, config.SaveSetting("filter."+name, spec)}
+	}
+}
+
+// applyListFilter shows the list from its first page with the new filter
+func (m Model) applyListFilter(msg listFilterMsg) (tea.Model, tea.Cmd) {
+	m.loading = false
+	m.view = m.prevView
+	m.listFilter = msg.filter
+	m.listPage = 0
+
+	if msg.spec != "" {
+		if msg.saveErr != nil {
+			m.message = tf("Filter applied but not saved: %s", msg.saveErr)
+			m.messageType = "error"
+		} else {
+			if m.client.Config.SavedFilters == nil {
+				m.client.Config.SavedFilters = map[string]string{}
+			}
+			m.client.Config.SavedFilters[msg.filter.name] = msg.spec
+			m.message = tf("Filter saved as %s", msg.filter.name)
+			m.messageType = "success"
+		}
+	}
+	return m.refreshCurrentView()
+}
+
+// cycleSavedFilter applies the next saved filter of the current list, and
+// no filter after the last one
+func (m Model) cycleSavedFilter() (tea.Model, tea.Cmd) {
+	doctype := listSources[m.view].doctype
+	saved := m.savedFilters(doctype)
+	if len(saved) == 0 {
+		m.message = tf("No saved filters for %s (save one from the f form)", doctype)
+		m.messageType = "error"
+		return m, nil
+	}
+
+	next := 0
+	for i, f := range saved {
+		if f.name == m.listFilter.name {
+			next = i + 1
+		}
+	}
+	if next < len(saved) {
+		m.listFilter = saved[next]
+	} else {
+		m.listFilter = listFilter{}
+	}
+	m.listPage = 0
+	return m.refreshCurrentView()
+}
+
+// renderListFilter renders the filter form
+func (m Model) renderListFilter() string {
+	doctype := listSources[m.prevView].doctype
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(tf(" Filter %s ", doctype)) + "\n\n")
+
+	labels := []string{T("Status:"), T("Party:"), T("From date:"), T("To date:"), T("Min total:"), T("Max total:"), T("Save as:")}
+	for i := range m.inputs {
+		b.WriteString(fmt.Sprintf("  %s\n", labels[i]))
+		b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(i)))
+	}
+
+	if saved := m.savedFilters(doctype); len(saved) > 0 {
+		names := make([]string, len(saved))
+		for i, f := range saved {
+			names[i] = f.name
+		}
+		b.WriteString(helpStyle.Render(tf("  Saved: %s (F in the list cycles them)", strings.Join(names, ", "))) + "\n")
+	}
+	b.WriteString(helpStyle.Render(trHelp("  Empty fields are ignored")))
+
+	return boxStyle.Render(b.String())
+}
@@ -3,32 +3,48 @@ package erp
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 // updateFormInputs handles form input updates
 func (m *Model) updateFormInputs(msg tea.Msg) tea.Cmd {
 	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if cmd, ok := m.handleSuggestKeys(keyMsg.String()); ok {
+			return cmd
+		}
+
 		switch keyMsg.String() {
 		case "tab", "down":
+			m.closeSuggestions()
+			check := m.leaveField(m.focusIndex)
 			m.focusIndex++
 			if m.focusIndex >= len(m.inputs) {
 				m.focusIndex = 0
 			}
-			return m.updateFocus()
+			return tea.Batch(check, m.updateFocus())
 
 		case "shift+tab", "up":
+			m.closeSuggestions()
+			check := m.leaveField(m.focusIndex)
 			m.focusIndex--
 			if m.focusIndex < 0 {
 				m.focusIndex = len(m.inputs) - 1
 			}
-			return m.updateFocus()
+			return tea.Batch(check, m.updateFocus())
 
 		case "enter":
-			return m.submitCurrentForm()
+			m.closeSuggestions()
+			if !m.checkForm() {
+				return nil
+			}
+			return m.checkFormLinks(m.submitCurrentForm())
 
 		case "esc":
+			m.closeSuggestions()
+			m.clearFieldErrors()
 			m.view = m.prevView
 			if m.prevView == ViewMain {
 				m.view = ViewMain
@@ -37,16 +53,163 @@ func (m *Model) updateFormInputs(msg tea.Msg) tea.Cmd {
 		}
 	}
 
-	// Update the focused input
+	// Update the focused input, looking up suggestions when a link changed
 	if m.focusIndex < len(m.inputs) {
 		var cmd tea.Cmd
+		before := m.inputs[m.focusIndex].Value()
 		m.inputs[m.focusIndex], cmd = m.inputs[m.focusIndex].Update(msg)
+		if m.inputs[m.focusIndex].Value() != before {
+			m.setFieldError(m.focusIndex, "")
+			return tea.Batch(cmd, m.scheduleSuggestions(), m.scheduleDraft())
+		}
 		return cmd
 	}
 
 	return nil
 }
 
+// isFormView reports whether a view is a textinput form
+func isFormView(v View) bool {
+	switch v {
+	case ViewCreateSupplier, ViewCreateSerial, ViewStockReceive, ViewStockTransfer, ViewStockIssue,
+		ViewCreatePO, ViewAddPOItem, ViewCreatePI, ViewCreatePR,
+		ViewCreateCustomer, ViewCreateQuotation, ViewAddQuotationItem,
+		ViewCreateSO, ViewCreateSOFromQuotation, ViewAddSOItem, ViewCreateSalesInvoice,
+		ViewCreateDN, ViewCreatePayment,
+		ViewCreateItem, ViewCreateGroup, ViewCreateBrand, ViewCreateWarehouse, ViewCreateVariant,
+		ViewCreateAttrText, ViewCreateAttrNumeric, ViewCreateAttrSelect, ViewEditAttrValues,
+		ViewCreatePIFromPO, ViewCreateWO, ViewCreateSubscription, ViewCreateExpenseClaim, ViewAttachFile, ViewListFilter:
+		return true
+	}
+	return false
+}
+
+// newDateInput is the document date input of create forms, last in the
+// form; blank means today
+func newDateInput() textinput.Model {
+	input := textinput.New()
+	input.Placeholder = T("YYYY-MM-DD (default today)")
+	return input
+}
+
+// renderDateInput renders the document date input of a create form
+func (m Model) renderDateInput(i int) string {
+	return tf("  Date:\n  %s\n\n", m.inputView(i))
+}
+
+// formPosting returns the posting date of a create form as the CLI's
+// --posting-date
+func (m Model) formPosting(i int) postingOptions {
+	return postingOptions{date: strings.TrimSpace(m.inputs[i].Value())}
+}
+
+// formDate returns the transaction date of a create form, checked as the
+// CLI's --transaction-date: today when blank
+func (m Model) formDate(i int) (string, error) {
+	date := strings.TrimSpace(m.inputs[i].Value())
+	if date != "" {
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return "", errorf("invalid transaction date (expected YYYY-MM-DD): %s", date)
+		}
+	}
+	return m.client.documentDate(date)
+}
+
+// docOption is an optional input of a create form standing for one of the
+// CLI's creation flags. Options follow the date input at the end of the
+// form and are sent as their flag, so the CLI's parsers check them.
+type docOption struct {
+	label       string
+	placeholder string
+	flag        string // e.g. "--coupon="
+	link        string // Doctype the value names, if any
+	kind        string // Checked kind of input (see formFields), if any
+}
+
+// The options of the create forms
+var (
+	optDiscount       = docOption{"Discount %", "Discount % on the grand total (optional)", "--discount=", "", fieldAmount}
+	optDiscountAmount = docOption{"Discount amount", "Discount amount on the grand total (optional)", "--discount-amount=", "", fieldAmount}
+	optCoupon         = docOption{"Coupon", "Coupon code (optional)", "--coupon=", "", ""}
+	optShippingRule   = docOption{"Shipping rule", "Shipping rule (optional)", "--shipping-rule=", "Shipping Rule", ""}
+	optCurrency       = docOption{"Currency", "Currency (optional, e.g. EUR)", "--currency=", "Currency", ""}
+	optExchangeRate   = docOption{"Exchange rate", "Exchange rate (optional, else Currency Exchange)", "--exchange-rate=", "", fieldQty}
+	optTerms          = docOption{"Terms", "Terms and Conditions (optional)", "--terms=", "Terms and Conditions", ""}
+	optRemarks        = docOption{"Remarks", "Remarks (optional)", "--remarks=", "", ""}
+	optLetterhead     = docOption{"Letter head", "Letter head (optional)", "--letterhead=", "Letter Head", ""}
+	optBilling        = docOption{"Billing address", "Billing address (optional, one of the customer's)", "--billing-address=", "Address", ""}
+	optShipping       = docOption{"Shipping address", "Shipping address (optional, one of the customer's)", "--shipping-address=", "Address", ""}
+)
+
+// formOptions maps the create forms to their options
+var formOptions = map[View][]docOption{
+	ViewCreateQuotation:       {optDiscount, optDiscountAmount, optCoupon, optCurrency, optExchangeRate, optTerms, optRemarks, optLetterhead},
+	ViewCreateSO:              {optDiscount, optDiscountAmount, optCoupon, optShippingRule, optCurrency, optExchangeRate, optBilling, optShipping, optTerms, optRemarks, optLetterhead},
+	ViewCreateSOFromQuotation: {optBilling, optShipping, optTerms, optRemarks, optLetterhead},
+	ViewCreateSalesInvoice:    {optDiscount, optDiscountAmount, optCoupon, optShippingRule, optCurrency, optExchangeRate, optBilling, optShipping, optTerms, optRemarks, optLetterhead},
+	ViewCreateDN:              {optShippingRule, optBilling, optShipping, optTerms, optRemarks, optLetterhead},
+	ViewCreatePO:              {optCurrency, optExchangeRate, optTerms, optRemarks, optLetterhead},
+	ViewCreatePI:              {optCurrency, optExchangeRate, optTerms, optRemarks, optLetterhead},
+	ViewCreatePIFromPO:        {optCurrency, optExchangeRate, optTerms, optRemarks, optLetterhead},
+	ViewCreatePR:              {optTerms, optRemarks, optLetterhead},
+	ViewCreatePayment:         {optCurrency, optExchangeRate},
+}
+
+// addOptionInputs appends the inputs of a form's options
+func (m *Model) addOptionInputs(view View) {
+	for _, opt := range formOptions[view] {
+		input := textinput.New()
+		input.Placeholder = T(opt.placeholder)
+		m.inputs = append(m.inputs, input)
+	}
+}
+
+// formOption returns the option an input of the open form stands for
+func (m Model) formOption(i int) (docOption, bool) {
+	options := formOptions[m.view]
+	j := i - (len(m.inputs) - len(options))
+	if j < 0 || j >= len(options) {
+		return docOption{}, false
+	}
+	return options[j], true
+}
+
+// renderOptionInputs renders the options of the open form, one a line
+func (m Model) renderOptionInputs() string {
+	options := formOptions[m.view]
+	if len(options) == 0 {
+		return ""
+	}
+	from := len(m.inputs) - len(options)
+	var b strings.Builder
+	for j, opt := range options {
+		b.WriteString(fmt.Sprintf("  %-18s %s\n", T(opt.label)+":", m.inputView(from+j)))
+	}
+	return b.String() + "\n"
+}
+
+// optionArgs returns the filled options of the open form as CLI flags
+func (m Model) optionArgs() []string {
+	options := formOptions[m.view]
+	from := len(m.inputs) - len(options)
+	var args []string
+	for j, opt := range options {
+		if value := strings.TrimSpace(m.inputs[from+j].Value()); value != "" {
+			args = append(args, opt.flag+value)
+		}
+	}
+	return args
+}
+
+// formRemarks adds a form's remarks to the new document as the CLI does,
+// returning a note for the form's message when they could not be saved
+func (m Model) formRemarks(doctype string, data map[string]interface{}, t docTerms) string {
+	if err := m.client.commentRemarks(doctype, stringField(data, "name"), t); err != nil {
+		return tf(" (remarks not saved: %s)", err)
+	}
+	return ""
+}
+
 // updateFocus updates which input has focus
 func (m *Model) updateFocus() tea.Cmd {
 	for i := range m.inputs {
@@ -120,6 +283,9 @@ func (m *Model) submitCurrentForm() tea.Cmd {
 		m.prevView = ViewPayments
 		return m.submitCreatePayment()
 	// CRUD forms for master data
+	case ViewCreateItem:
+		m.prevView = ViewItems
+		return m.submitCreateItem()
 	case ViewCreateGroup:
 		m.prevView = ViewGroups
 		return m.submitCreateGroup()
@@ -133,11 +299,31 @@ func (m *Model) submitCurrentForm() tea.Cmd {
 		m.prevView = ViewTemplates
 		return m.submitCreateVariant()
 	case ViewCreateAttrText, ViewCreateAttrNumeric, ViewCreateAttrSelect:
-		m.prevView = ViewAttributes
+		// Attributes created from the template wizard go back to it
+		if m.prevView != ViewCreateTemplate {
+			m.prevView = ViewAttributes
+		}
 		return m.submitCreateAttr()
+	case ViewEditAttrValues:
+		return m.submitEditAttr()
 	case ViewCreatePIFromPO:
 		m.prevView = ViewPurchaseInvoices
 		return m.submitCreatePIFromPO()
+	case ViewCreateWO:
+		m.prevView = ViewWorkOrders
+		return m.submitCreateWO()
+	case ViewCreateSubscription:
+		m.prevView = ViewSubscriptions
+		return m.submitCreateSubscription()
+	case ViewCreateExpenseClaim:
+		m.prevView = ViewExpenseClaims
+		return m.submitCreateExpenseClaim()
+	case ViewAttachFile:
+		// prevView stays on the detail view the file goes to
+		return m.submitAttachFile()
+	case ViewListFilter:
+		// prevView stays on the list being filtered
+		return m.submitListFilter()
 	}
 
 	return nil
@@ -145,7 +331,7 @@ func (m *Model) submitCurrentForm() tea.Cmd {
 
 // renderConfirmAction renders the confirm action dialog
 func (m Model) renderConfirmAction() string {
-	content := fmt.Sprintf(`
+	content := tf(`
   %s
 
   This action may be irreversible.
@@ -203,6 +389,29 @@ func (m *Model) handleConfirmAction(confirmed bool) tea.Cmd {
 		return m.submitPayment(m.selectedItem)
 	case "cancel_payment":
 		return m.cancelPayment(m.selectedItem)
+	// Timesheet actions
+	case "submit_timesheet":
+		return m.submitTimesheets(m.timesheetGrid.drafts)
+	// Manufacturing actions
+	case "start_wo":
+		return m.woStep(m.selectedItem, purposeTransfer)
+	case "finish_wo":
+		return m.woStep(m.selectedItem, purposeManufacture)
+	case "submit_bom":
+		return m.submitBOM(m.selectedItem)
+	case "cancel_subscription":
+		return m.cancelSubscription(m.selectedItem)
+	case "submit_expense":
+		return m.submitExpenseClaim(m.selectedItem)
+	case "batch_submit", "batch_cancel", "batch_delete":
+		return m.startBatch(strings.TrimPrefix(m.confirmAction, "batch_"))
+	case "open_pdf":
+		return m.openPDF(m.pdfPath)
+	case "generate_variants":
+		// Back to the template, reloaded once the variants exist
+		m.view = ViewItemDetail
+		m.prevView = ViewTemplates
+		return m.generateVariants()
 	}
 
 	return nil
@@ -227,6 +436,8 @@ func (m *Model) handleDeleteForView() tea.Cmd {
 		return m.deleteSerial(m.selectedItem)
 	case ViewCustomers:
 		return m.deleteCustomer(m.selectedItem)
+	case ViewInbox:
+		return m.deleteInboxNote(m.selectedItem)
 	}
 	return nil
 }
@@ -271,6 +482,18 @@ func (m *Model) setListTitle() {
 		title = "Purchase Receipts"
 	case ViewPayments:
 		title = "Payments"
+	case ViewInbox:
+		title = "Inbox"
+	case ViewWorkOrders:
+		title = "Work Orders"
+	case ViewBOMs:
+		title = "BOMs"
+	case ViewSubscriptions:
+		title = "Subscriptions"
+	case ViewEmployees:
+		title = "Employees"
+	case ViewExpenseClaims:
+		title = "Expense Claims"
 	}
 
 	// Add sort order indicator for list views that support it
@@ -296,7 +519,7 @@ func renderStatusBadge(status string) string {
 		return cancelledBadge.Render(status)
 	case "unpaid":
 		return unpaidBadge.Render(status)
-	case "pending", "to receive", "to receive and bill", "open", "to deliver", "to bill", "to deliver and bill":
+	case "pending", "not started", "in process", "to receive", "to receive and bill", "open", "to deliver", "to bill", "to deliver and bill":
 		return pendingBadge.Render(status)
 	default:
 		return helpStyle.Render(status)
@@ -322,16 +545,16 @@ func (m Model) renderListFooter() string {
 
 	// Build footer
 	var parts []string
-	parts = append(parts, fmt.Sprintf("%d items", len(m.listItems)))
-	parts = append(parts, fmt.Sprintf("Total: %s", m.client.FormatCurrency(totalAmount)))
+	parts = append(parts, tf("%d items", len(m.listItems)))
+	parts = append(parts, tf("Total: %s", m.client.FormatCurrency(totalAmount)))
 
 	// Add most relevant status count (Draft or Unpaid)
 	if count, ok := statusCounts["Draft"]; ok && count > 0 {
-		parts = append(parts, fmt.Sprintf("%d draft", count))
+		parts = append(parts, tf("%d draft", count))
 	} else if count, ok := statusCounts["Unpaid"]; ok && count > 0 {
-		parts = append(parts, fmt.Sprintf("%d unpaid", count))
+		parts = append(parts, tf("%d unpaid", count))
 	} else if count, ok := statusCounts["To Receive and Bill"]; ok && count > 0 {
-		parts = append(parts, fmt.Sprintf("%d pending", count))
+		parts = append(parts, tf("%d pending", count))
 	}
 
 	footer := strings.Join(parts, " │ ")
@@ -353,4 +576,3 @@ func (m Model) getSortOrderLabel() string {
 		return ""
 	}
 }
-
@@ -0,0 +1,295 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ============================================================================
+// EMPLOYEES AND EXPENSE CLAIMS
+// ============================================================================
+
+// loadEmployees fetches the active employees for the list view
+func (m Model) loadEmployees() tea.Cmd {
+	return func() tea.Msg {
+		result, err := m.client.Request("GET", "Employee?"+m.client.pageLimit(0)+"&fields=[\"name\",\"employee_name\",\"designation\",\"department\"]&filters=%5B%5B%22status%22%2C%22%3D%22%2C%22Active%22%5D%5D&order_by=employee_name%20asc", nil)
+		if err != nil {
+			return errorMsg{err}
+		}
+
+		var items []ListItem
+		if data, ok := result["data"].([]interface{}); ok {
+			for _, item := range data {
+				if im, ok := item.(map[string]interface{}); ok {
+					name := fmt.Sprintf("%v", im["name"])
+					var details []string
+					for _, field := range []string{"employee_name", "designation", "department"} {
+						if value := stringField(im, field); value != "" {
+							details = append(details, value)
+						}
+					}
+					items = append(items, ListItem{name: name, details: strings.Join(details, " | ")})
+				}
+			}
+		}
+		return dataLoadedMsg{items}
+	}
+}
+
+// loadEmployeeDetail fetches an employee
+func (m Model) loadEmployeeDetail(name string) tea.Cmd {
+	return func() tea.Msg {
+		result, err := m.client.Request("GET", "Employee/"+url.PathEscape(name), nil)
+		if err != nil {
+			return errorMsg{err}
+		}
+
+		if data, ok := result["data"].(map[string]interface{}); ok {
+			return itemDetailMsg{data}
+		}
+		return errorMsg{errorf("no data found")}
+	}
+}
+
+// employeeFields are the fields the employee detail view shows, when set
+var employeeFields = []struct{ field, label string }{
+	{"status", "Status"},
+	{"company", "Company"},
+	{"department", "Department"},
+	{"designation", "Designation"},
+	{"reports_to", "Reports To"},
+	{"user_id", "User"},
+	{"company_email", "Email"},
+	{"cell_number", "Phone"},
+	{"date_of_joining", "Joined"},
+	{"expense_approver", "Expense Approver"},
+	{"leave_approver", "Leave Approver"},
+	{"holiday_list", "Holiday List"},
+	{"employment_type", "Employment Type"},
+	{"relieving_date", "Relieved"},
+}
+
+// renderEmployeeDetail renders the employee detail view
+func (m Model) renderEmployeeDetail() string {
+	if m.loading {
+		return "\n  Loading..."
+	}
+
+	if m.itemData == nil {
+		return "\n  " + T("No data")
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(T(" Employee: ")+m.selectedItem) + "\n\n")
+
+	b.WriteString(tf("  Name: %s\n", stringField(m.itemData, "employee_name")))
+	for _, f := range employeeFields {
+		if value := stringField(m.itemData, f.field); value != "" {
+			b.WriteString(fmt.Sprintf("  %s: %s\n", T(f.label), value))
+		}
+	}
+
+	return boxStyle.Render(b.String())
+}
+
+// loadExpenseClaims fetches the expense claims of the API user's employee
+func (m Model) loadExpenseClaims() tea.Cmd {
+	return func() tea.Msg {
+		emp, err := m.client.currentEmployee()
+		if err != nil {
+			return errorMsg{err}
+		}
+		filters, err := encodeFilters([][]interface{}{{"employee", "=", emp["name"]}})
+		if err != nil {
+			return errorMsg{err}
+		}
+		result, err := m.client.Request("GET", "Expense%20Claim?"+m.client.pageLimit(100)+"&fields=[\"name\",\"posting_date\",\"status\",\"approval_status\",\"total_claimed_amount\"]&order_by=creation%20desc&filters="+filters, nil)
+		if err != nil {
+			return errorMsg{err}
+		}
+
+		var items []ListItem
+		if data, ok := result["data"].([]interface{}); ok {
+			for _, item := range data {
+				if im, ok := item.(map[string]interface{}); ok {
+					name := fmt.Sprintf("%v", im["name"])
+					status, _ := im["status"].(string)
+					claimed, _ := im["total_claimed_amount"].(float64)
+
+					detail := tf("%s | %s | Approval: %s | %s", stringField(im, "posting_date"), renderStatusBadge(status),
+						stringField(im, "approval_status"), m.client.FormatCurrency(claimed))
+					items = append(items, ListItem{name: name, details: detail, amount: claimed, status: status})
+				}
+			}
+		}
+		return dataLoadedMsg{items}
+	}
+}
+
+// loadExpenseClaimDetail fetches an expense claim
+func (m Model) loadExpenseClaimDetail(name string) tea.Cmd {
+	return func() tea.Msg {
+		result, err := m.client.Request("GET", "Expense%20Claim/"+url.PathEscape(name), nil)
+		if err != nil {
+			return errorMsg{err}
+		}
+
+		if data, ok := result["data"].(map[string]interface{}); ok {
+			return itemDetailMsg{data}
+		}
+		return errorMsg{errorf("no data found")}
+	}
+}
+
+// renderExpenseClaimDetail renders the expense claim detail view
+func (m Model) renderExpenseClaimDetail() string {
+	if m.loading {
+		return "\n  Loading..."
+	}
+
+	if m.itemData == nil {
+		return "\n  " + T("No data")
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(T(" Expense Claim: ")+m.selectedItem) + "\n\n")
+
+	status, _ := m.itemData["status"].(string)
+	b.WriteString(tf("  Employee: %s (%s)\n", stringField(m.itemData, "employee_name"), stringField(m.itemData, "employee")))
+	b.WriteString(tf("  Date: %s\n", stringField(m.itemData, "posting_date")))
+	b.WriteString(tf("  Status: %s | Approval: %s\n", renderStatusBadge(status), stringField(m.itemData, "approval_status")))
+	if approver := stringField(m.itemData, "expense_approver"); approver != "" {
+		b.WriteString(tf("  Approver: %s\n", approver))
+	}
+	claimed, _ := m.itemData["total_claimed_amount"].(float64)
+	sanctioned, _ := m.itemData["total_sanctioned_amount"].(float64)
+	b.WriteString(tf("  Claimed: %s | Sanctioned: %s\n", m.client.FormatCurrency(claimed), m.client.FormatCurrency(sanctioned)))
+
+	if expenses, ok := m.itemData["expenses"].([]interface{}); ok && len(expenses) > 0 {
+		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render(T("Expenses:"))))
+		for _, e := range expenses {
+			if em, ok := e.(map[string]interface{}); ok {
+				amount, _ := em["amount"].(float64)
+				line := fmt.Sprintf("    - %s %s: %s", stringField(em, "expense_date"), stringField(em, "expense_type"), m.client.FormatCurrency(amount))
+				if desc := stringField(em, "description"); desc != "" {
+					line += " (" + desc + ")"
+				}
+				b.WriteString(line + "\n")
+			}
+		}
+	}
+
+	return boxStyle.Render(b.String())
+}
+
+// initCreateExpenseClaimForm initializes the create expense claim form
+func (m *Model) initCreateExpenseClaimForm() {
+	m.inputs = make([]textinput.Model, 4)
+
+	m.inputs[0] = textinput.New()
+	m.inputs[0].Placeholder = T("Expense Claim Type (e.g., Travel)")
+	m.inputs[0].Focus()
+
+	m.inputs[1] = textinput.New()
+	m.inputs[1].Placeholder = T("Amount")
+
+	m.inputs[2] = textinput.New()
+	m.inputs[2].Placeholder = T("Description (optional)")
+
+	m.inputs[3] = newDateInput()
+
+	m.focusIndex = 0
+}
+
+// renderCreateExpenseClaim renders the create expense claim form
+func (m Model) renderCreateExpenseClaim() string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(T(" Create Expense Claim ")) + "\n\n")
+
+	labels := []string{T("Type:"), T("Amount:"), T("Description:")}
+	for i, label := range labels {
+		b.WriteString(fmt.Sprintf("  %s\n", label))
+		b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(i)))
+	}
+	b.WriteString(m.renderDateInput(3))
+
+	b.WriteString(helpStyle.Render(trHelp("  Filed for the employee linked to your user; attach receipts with 'u'")))
+
+	return boxStyle.Render(b.String())
+}
+
+// submitCreateExpenseClaim submits the create expense claim form
+func (m Model) submitCreateExpenseClaim() tea.Cmd {
+	return func() tea.Msg {
+		expenseType := strings.TrimSpace(m.inputs[0].Value())
+		if expenseType == "" {
+			return formSubmittedMsg{false, T("Expense claim type is required")}
+		}
+		amount, err := strconv.ParseFloat(strings.TrimSpace(m.inputs[1].Value()), 64)
+		if err != nil || amount <= 0 {
+			return formSubmittedMsg{false, T("Invalid amount")}
+		}
+
+		args := []string{"--desc=" + strings.TrimSpace(m.inputs[2].Value())}
+		if date := strings.TrimSpace(m.inputs[3].Value()); date != "" {
+			args = append(args, "--date="+date)
+		}
+		opts, err := parseExpenseOptions(args)
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+
+		emp, err := m.client.currentEmployee()
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		data, err := m.client.createExpenseClaim(emp, expenseType, amount, opts)
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		return formSubmittedMsg{true, tf("Expense claim created: %s", stringField(data, "name"))}
+	}
+}
+
+// submitExpenseClaim submits an expense claim from its detail view
+func (m Model) submitExpenseClaim(name string) tea.Cmd {
+	return func() tea.Msg {
+		if err := m.client.submitExpenseClaim(name); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		return formSubmittedMsg{true, tf("Expense claim submitted: %s", name)}
+	}
+}
+
+// handleHRKeys handles keyboard shortcuts for expense claim views
+func (m *Model) handleHRKeys(key string) (tea.Model, tea.Cmd) {
+	switch m.view {
+	case ViewExpenseClaims:
+		if key == "n" {
+			m.initCreateExpenseClaimForm()
+			m.prevView = m.view
+			m.view = ViewCreateExpenseClaim
+			// A command stops the key from reaching the new form's input
+			return m, textinput.Blink
+		}
+
+	case ViewExpenseClaimDetail:
+		if m.itemData == nil {
+			break
+		}
+		if docStatus, _ := m.itemData["docstatus"].(float64); key == "s" && docStatus == 0 {
+			m.confirmAction = "submit_expense"
+			m.confirmMsg = tf("Submit Expense Claim %s?", m.selectedItem)
+			m.prevView = m.view
+			m.view = ViewConfirmAction
+			return m, nil
+		}
+	}
+
+	return m, nil
+}
@@ -0,0 +1,138 @@
+package erp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// loadInbox lists the locally stored quick-capture notes
+func (m Model) loadInbox() tea.Cmd {
+	return func() tea.Msg {
+		notes, err := loadNotes()
+		if err != nil {
+			return errorMsg{err}
+		}
+
+		var items []ListItem
+		// Newest first
+		for i := len(notes) - 1; i >= 0; i-- {
+			n := notes[i]
+			items = append(items, ListItem{
+				name:    fmt.Sprintf("#%d %s", n.ID, n.Text),
+				details: fmt.Sprintf("%s %s", n.Created, parseNoteHints(n.Text)),
+			})
+		}
+		return dataLoadedMsg{items}
+	}
+}
+
+// inboxNoteID extracts the note id from an Inbox list entry ("#3 text")
+func inboxNoteID(name string) (int, error) {
+	var id int
+	if _, err := fmt.Sscanf(name, "#%d", &id); err != nil {
+		return 0, errorf("invalid note: %s", name)
+	}
+	return id, nil
+}
+
+// convertNote opens the create form matching the note, pre-filled with its hints
+func (m Model) convertNote(name string) (tea.Model, tea.Cmd) {
+	id, err := inboxNoteID(name)
+	if err != nil {
+		m.message = err.Error()
+		m.messageType = "error"
+		return m, nil
+	}
+	note, err := findNote(id)
+	if err != nil {
+		m.message = err.Error()
+		m.messageType = "error"
+		return m, nil
+	}
+
+	hints := parseNoteHints(note.Text)
+	itemIdx := 1
+	switch hints.doctype {
+	case "Quotation":
+		m.initCreateQuotationForm()
+		m.view = ViewCreateQuotation
+	case "Purchase Order":
+		m.initCreatePOForm()
+		m.view = ViewCreatePO
+	default:
+		m.initCreateSOForm()
+		m.view = ViewCreateSO
+		itemIdx = 2
+	}
+
+	m.inputs[0].SetValue(hints.party)
+	m.inputs[itemIdx].SetValue(hints.item)
+	if hints.qty > 0 {
+		m.inputs[itemIdx+1].SetValue(strconv.FormatFloat(hints.qty, 'f', -1, 64))
+	}
+	m.noteID = id
+	m.prevView = ViewInbox
+	return m, nil
+}
+
+// deleteInboxNote removes a note from the Inbox
+func (m Model) deleteInboxNote(name string) tea.Cmd {
+	return func() tea.Msg {
+		id, err := inboxNoteID(name)
+		if err != nil {
+			return errorMsg{err}
+		}
+		if err := deleteNote(id); err != nil {
+			return errorMsg{err}
+		}
+		return actionDoneMsg{tf("Deleted note #%d", id)}
+	}
+}
+
+// formLineItem builds the optional first item row of a create form from its
+// Item and Quantity inputs (at itemIdx and itemIdx+1)
+func (m Model) formLineItem(party string, itemIdx int, customer bool) ([]interface{}, error) {
+	itemCode := strings.TrimSpace(m.inputs[itemIdx].Value())
+	if itemCode == "" {
+		return []interface{}{}, nil
+	}
+
+	qty := 1.0
+	if qtyStr := strings.TrimSpace(m.inputs[itemIdx+1].Value()); qtyStr != "" {
+		q, err := strconv.ParseFloat(qtyStr, 64)
+		if err != nil || q <= 0 {
+			return nil, errorf("invalid quantity")
+		}
+		qty = q
+	}
+
+	row := map[string]interface{}{
+		"item_code": itemCode,
+		"qty":       qty,
+	}
+	if customer {
+		code, customerCode, err := m.client.resolveCustomerItemCode(party, itemCode)
+		if err != nil {
+			return nil, err
+		}
+		row["item_code"] = code
+		if customerCode != "" {
+			row["customer_item_code"] = customerCode
+		}
+	}
+	return []interface{}{row}, nil
+}
+
+// finishNote removes the Inbox note a form was converting, once its document exists
+func (m Model) finishNote(message string) string {
+	if m.noteID == 0 {
+		return message
+	}
+	if err := deleteNote(m.noteID); err != nil {
+		return tf("%s (note #%d not removed: %s)", message, m.noteID, err)
+	}
+	return tf("%s (note #%d done)", message, m.noteID)
+}
@@ -10,6 +10,81 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 )
 
+// =============================================================================
+// CREATE ITEM
+// =============================================================================
+
+// initCreateItemForm initializes the create item form with configured defaults
+func (m *Model) initCreateItemForm() {
+	m.inputs = make([]textinput.Model, 4)
+
+	m.inputs[0] = textinput.New()
+	m.inputs[0].Placeholder = T("Item Code *")
+	m.inputs[0].Focus()
+
+	m.inputs[1] = textinput.New()
+	m.inputs[1].Placeholder = T("Item Name *")
+
+	m.inputs[2] = textinput.New()
+	m.inputs[2].Placeholder = T("Item Group *")
+	m.inputs[2].SetValue(m.client.Config.DefaultItemGroup)
+
+	m.inputs[3] = textinput.New()
+	m.inputs[3].Placeholder = T("Stock UOM")
+	m.inputs[3].SetValue(m.client.Config.DefaultUOM)
+
+	m.focusIndex = 0
+}
+
+// renderCreateItem renders the create item form
+func (m Model) renderCreateItem() string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(T(" Create Item ")) + "\n\n")
+
+	labels := []string{T("Item Code: *"), T("Item Name: *"), T("Item Group: *"), T("Stock UOM:")}
+	for i, input := range m.inputs {
+		b.WriteString(fmt.Sprintf("  %s\n", labels[i]))
+		b.WriteString(fmt.Sprintf("  %s\n\n", input.View()))
+	}
+
+	b.WriteString(helpStyle.Render(trHelp("  * Required field • defaults come from .erp-config")))
+
+	return boxStyle.Render(b.String())
+}
+
+// submitCreateItem submits the create item form
+func (m Model) submitCreateItem() tea.Cmd {
+	return func() tea.Msg {
+		code := strings.TrimSpace(m.inputs[0].Value())
+		name := strings.TrimSpace(m.inputs[1].Value())
+		group := strings.TrimSpace(m.inputs[2].Value())
+		uom := strings.TrimSpace(m.inputs[3].Value())
+
+		if code == "" || name == "" || group == "" {
+			return formSubmittedMsg{false, T("Item code, name and group are required")}
+		}
+
+		body := m.client.newItemBody()
+		body["item_code"] = code
+		body["item_name"] = name
+		body["item_group"] = group
+		if uom != "" {
+			body["stock_uom"] = uom
+		}
+
+		result, err := m.client.Request("POST", "Item", body)
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+
+		if data, ok := result["data"].(map[string]interface{}); ok {
+			return formSubmittedMsg{true, tf("Item created: %s", data["name"])}
+		}
+
+		return formSubmittedMsg{false, T("Failed to create item")}
+	}
+}
+
 // =============================================================================
 // CREATE GROUP
 // =============================================================================
@@ -19,11 +94,11 @@ func (m *Model) initCreateGroupForm() {
 	m.inputs = make([]textinput.Model, 2)
 
 	m.inputs[0] = textinput.New()
-	m.inputs[0].Placeholder = "Group Name *"
+	m.inputs[0].Placeholder = T("Group Name *")
 	m.inputs[0].Focus()
 
 	m.inputs[1] = textinput.New()
-	m.inputs[1].Placeholder = "Parent Group (optional)"
+	m.inputs[1].Placeholder = T("Parent Group (optional)")
 
 	m.focusIndex = 0
 }
@@ -31,15 +106,15 @@ func (m *Model) initCreateGroupForm() {
 // renderCreateGroup renders the create group form
 func (m Model) renderCreateGroup() string {
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Create Item Group ") + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Create Item Group ")) + "\n\n")
 
-	labels := []string{"Group Name: *", "Parent Group:"}
+	labels := []string{T("Group Name: *"), T("Parent Group:")}
 	for i, input := range m.inputs {
 		b.WriteString(fmt.Sprintf("  %s\n", labels[i]))
 		b.WriteString(fmt.Sprintf("  %s\n\n", input.View()))
 	}
 
-	b.WriteString(helpStyle.Render("  * Required field"))
+	b.WriteString(helpStyle.Render(trHelp("  * Required field")))
 
 	return boxStyle.Render(b.String())
 }
@@ -51,7 +126,7 @@ func (m Model) submitCreateGroup() tea.Cmd {
 		parent := m.inputs[1].Value()
 
 		if name == "" {
-			return formSubmittedMsg{false, "Group name is required"}
+			return formSubmittedMsg{false, T("Group name is required")}
 		}
 
 		body := map[string]interface{}{
@@ -67,10 +142,10 @@ func (m Model) submitCreateGroup() tea.Cmd {
 		}
 
 		if data, ok := result["data"].(map[string]interface{}); ok {
-			return formSubmittedMsg{true, fmt.Sprintf("Group created: %s", data["name"])}
+			return formSubmittedMsg{true, tf("Group created: %s", data["name"])}
 		}
 
-		return formSubmittedMsg{false, "Failed to create group"}
+		return formSubmittedMsg{false, T("Failed to create group")}
 	}
 }
 
@@ -83,11 +158,11 @@ func (m *Model) initCreateBrandForm() {
 	m.inputs = make([]textinput.Model, 2)
 
 	m.inputs[0] = textinput.New()
-	m.inputs[0].Placeholder = "Brand Name *"
+	m.inputs[0].Placeholder = T("Brand Name *")
 	m.inputs[0].Focus()
 
 	m.inputs[1] = textinput.New()
-	m.inputs[1].Placeholder = "Description (optional)"
+	m.inputs[1].Placeholder = T("Description (optional)")
 
 	m.focusIndex = 0
 }
@@ -95,15 +170,15 @@ func (m *Model) initCreateBrandForm() {
 // renderCreateBrand renders the create brand form
 func (m Model) renderCreateBrand() string {
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Create Brand ") + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Create Brand ")) + "\n\n")
 
-	labels := []string{"Brand Name: *", "Description:"}
+	labels := []string{T("Brand Name: *"), T("Description:")}
 	for i, input := range m.inputs {
 		b.WriteString(fmt.Sprintf("  %s\n", labels[i]))
 		b.WriteString(fmt.Sprintf("  %s\n\n", input.View()))
 	}
 
-	b.WriteString(helpStyle.Render("  * Required field"))
+	b.WriteString(helpStyle.Render(trHelp("  * Required field")))
 
 	return boxStyle.Render(b.String())
 }
@@ -115,7 +190,7 @@ func (m Model) submitCreateBrand() tea.Cmd {
 		description := m.inputs[1].Value()
 
 		if name == "" {
-			return formSubmittedMsg{false, "Brand name is required"}
+			return formSubmittedMsg{false, T("Brand name is required")}
 		}
 
 		body := map[string]interface{}{
@@ -131,10 +206,10 @@ func (m Model) submitCreateBrand() tea.Cmd {
 		}
 
 		if data, ok := result["data"].(map[string]interface{}); ok {
-			return formSubmittedMsg{true, fmt.Sprintf("Brand created: %s", data["name"])}
+			return formSubmittedMsg{true, tf("Brand created: %s", data["name"])}
 		}
 
-		return formSubmittedMsg{false, "Failed to create brand"}
+		return formSubmittedMsg{false, T("Failed to create brand")}
 	}
 }
 
@@ -147,11 +222,11 @@ func (m *Model) initCreateWarehouseForm() {
 	m.inputs = make([]textinput.Model, 2)
 
 	m.inputs[0] = textinput.New()
-	m.inputs[0].Placeholder = "Warehouse Name *"
+	m.inputs[0].Placeholder = T("Warehouse Name *")
 	m.inputs[0].Focus()
 
 	m.inputs[1] = textinput.New()
-	m.inputs[1].Placeholder = "Parent Warehouse (optional)"
+	m.inputs[1].Placeholder = T("Parent Warehouse (optional)")
 
 	m.focusIndex = 0
 }
@@ -159,15 +234,15 @@ func (m *Model) initCreateWarehouseForm() {
 // renderCreateWarehouse renders the create warehouse form
 func (m Model) renderCreateWarehouse() string {
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Create Warehouse ") + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Create Warehouse ")) + "\n\n")
 
-	labels := []string{"Warehouse Name: *", "Parent Warehouse:"}
-	for i, input := range m.inputs {
+	labels := []string{T("Warehouse Name: *"), T("Parent Warehouse:")}
+	for i := range m.inputs {
 		b.WriteString(fmt.Sprintf("  %s\n", labels[i]))
-		b.WriteString(fmt.Sprintf("  %s\n\n", input.View()))
+		b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(i)))
 	}
 
-	b.WriteString(helpStyle.Render("  * Required field"))
+	b.WriteString(helpStyle.Render(trHelp("  * Required field")))
 
 	return boxStyle.Render(b.String())
 }
@@ -179,7 +254,7 @@ func (m Model) submitCreateWarehouse() tea.Cmd {
 		parent := m.inputs[1].Value()
 
 		if name == "" {
-			return formSubmittedMsg{false, "Warehouse name is required"}
+			return formSubmittedMsg{false, T("Warehouse name is required")}
 		}
 
 		company, err := m.client.GetCompany()
@@ -201,10 +276,10 @@ func (m Model) submitCreateWarehouse() tea.Cmd {
 		}
 
 		if data, ok := result["data"].(map[string]interface{}); ok {
-			return formSubmittedMsg{true, fmt.Sprintf("Warehouse created: %s", data["name"])}
+			return formSubmittedMsg{true, tf("Warehouse created: %s", data["name"])}
 		}
 
-		return formSubmittedMsg{false, "Failed to create warehouse"}
+		return formSubmittedMsg{false, T("Failed to create warehouse")}
 	}
 }
 
@@ -228,7 +303,7 @@ func (m *Model) initCreateVariantForm() {
 		if i < len(attrs) {
 			if attr, ok := attrs[i].(map[string]interface{}); ok {
 				attrName := fmt.Sprintf("%v", attr["attribute"])
-				m.inputs[i].Placeholder = attrName + " value *"
+				m.inputs[i].Placeholder = attrName + T(" value *")
 			}
 		}
 		if i == 0 {
@@ -242,13 +317,13 @@ func (m *Model) initCreateVariantForm() {
 // renderCreateVariant renders the create variant form
 func (m Model) renderCreateVariant() string {
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Create Variant from: "+m.selectedItem) + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Create Variant from: ")+m.selectedItem) + "\n\n")
 
 	// Get template attributes
 	attrs, _ := m.itemData["attributes"].([]interface{})
 
 	if len(attrs) == 0 {
-		b.WriteString("  No attributes defined for this template\n")
+		b.WriteString(T("  No attributes defined for this template\n"))
 		return boxStyle.Render(b.String())
 	}
 
@@ -263,7 +338,7 @@ func (m Model) renderCreateVariant() string {
 		b.WriteString(fmt.Sprintf("  %s\n\n", input.View()))
 	}
 
-	b.WriteString(helpStyle.Render("  * Required fields - Enter values for each attribute"))
+	b.WriteString(helpStyle.Render(trHelp("  * Required fields - Enter values for each attribute")))
 
 	return boxStyle.Render(b.String())
 }
@@ -275,7 +350,7 @@ func (m Model) submitCreateVariant() tea.Cmd {
 		attrs, _ := m.itemData["attributes"].([]interface{})
 
 		if len(attrs) == 0 {
-			return formSubmittedMsg{false, "Template has no attributes defined"}
+			return formSubmittedMsg{false, T("Template has no attributes defined")}
 		}
 
 		// Build attributes array
@@ -290,7 +365,7 @@ func (m Model) submitCreateVariant() tea.Cmd {
 				if a, ok := attr.(map[string]interface{}); ok {
 					attrName = fmt.Sprintf("%v", a["attribute"])
 				}
-				return formSubmittedMsg{false, fmt.Sprintf("Value for %s is required", attrName)}
+				return formSubmittedMsg{false, tf("Value for %s is required", attrName)}
 			}
 
 			if a, ok := attr.(map[string]interface{}); ok {
@@ -312,13 +387,300 @@ func (m Model) submitCreateVariant() tea.Cmd {
 		}
 
 		if data, ok := result["data"].(map[string]interface{}); ok {
-			return formSubmittedMsg{true, fmt.Sprintf("Variant created: %s", data["name"])}
+			return formSubmittedMsg{true, tf("Variant created: %s", data["name"])}
 		}
 
-		return formSubmittedMsg{false, "Failed to create variant"}
+		return formSubmittedMsg{false, T("Failed to create variant")}
 	}
 }
 
+// =============================================================================
+// GENERATE VARIANTS
+// =============================================================================
+
+// variantMatrixMsg carries the attribute matrix of a template
+type variantMatrixMsg struct {
+	matrix *variantMatrix
+}
+
+// loadTemplateMatrix loads the attribute values and variants of the
+// selected template
+func (m Model) loadTemplateMatrix() tea.Cmd {
+	return func() tea.Msg {
+		matrix, err := m.client.loadVariantMatrix(m.selectedItem)
+		if err != nil {
+			return errorMsg{err}
+		}
+		return variantMatrixMsg{matrix}
+	}
+}
+
+// variantPickKey identifies one attribute value in variantPicked
+func variantPickKey(attr, value string) string {
+	return attr + "\x00" + value
+}
+
+// variantMatrixRows flattens the matrix into (attribute, value) index pairs
+// in display order
+func (m Model) variantMatrixRows() [][2]int {
+	var rows [][2]int
+	if m.variantMatrix == nil {
+		return rows
+	}
+	for i, attr := range m.variantMatrix.attrs {
+		for j := range attr.values {
+			rows = append(rows, [2]int{i, j})
+		}
+	}
+	return rows
+}
+
+// pickedVariants returns the combinations of the picked values that have no
+// variant yet, and how many picked combinations already exist
+func (m Model) pickedVariants() ([][]attributeValue, int, error) {
+	if m.variantMatrix == nil {
+		return nil, 0, nil
+	}
+	picked := *m.variantMatrix
+	picked.attrs = nil
+	for _, attr := range m.variantMatrix.attrs {
+		narrowed := variantAttribute{name: attr.name, numeric: attr.numeric}
+		for _, v := range attr.values {
+			if m.variantPicked[variantPickKey(attr.name, v.value)] {
+				narrowed.values = append(narrowed.values, v)
+			}
+		}
+		picked.attrs = append(picked.attrs, narrowed)
+	}
+
+	if err := picked.checkSize(); err != nil {
+		return nil, 0, err
+	}
+	var missing [][]attributeValue
+	existing := 0
+	for _, combo := range picked.combinations() {
+		if _, ok := picked.exists(combo); ok {
+			existing++
+		} else {
+			missing = append(missing, combo)
+		}
+	}
+	return missing, existing, nil
+}
+
+// handleVariantMatrixKeys moves the cursor, toggles values and asks to
+// create the picked variants
+func (m *Model) handleVariantMatrixKeys(key string) (tea.Model, tea.Cmd) {
+	if m.view != ViewGenerateVariants || m.loading || m.variantMatrix == nil {
+		return *m, nil
+	}
+	rows := m.variantMatrixRows()
+
+	switch key {
+	case "up", "k":
+		if m.variantCursor > 0 {
+			m.variantCursor--
+		}
+	case "down", "j":
+		if m.variantCursor < len(rows)-1 {
+			m.variantCursor++
+		}
+	case " ":
+		if m.variantCursor < len(rows) {
+			row := rows[m.variantCursor]
+			attr := m.variantMatrix.attrs[row[0]]
+			k := variantPickKey(attr.name, attr.values[row[1]].value)
+			m.variantPicked[k] = !m.variantPicked[k]
+		}
+	case "enter":
+		missing, existing, err := m.pickedVariants()
+		if err != nil {
+			m.message = err.Error()
+			m.messageType = "error"
+			return *m, nil
+		}
+		if len(missing) == 0 {
+			m.message = T("Pick at least one value of each attribute")
+			if existing > 0 {
+				m.message = T("All picked variants already exist")
+			}
+			m.messageType = "error"
+			return *m, nil
+		}
+		if len(missing) > maxGeneratedVariants {
+			m.message = tf("%d variants picked (max %d)", len(missing), maxGeneratedVariants)
+			m.messageType = "error"
+			return *m, nil
+		}
+		m.confirmAction = "generate_variants"
+		m.confirmMsg = tf("Create %d variants of %s?", len(missing), m.selectedItem)
+		m.prevView = m.view
+		m.view = ViewConfirmAction
+	}
+	return *m, nil
+}
+
+// renderVariantMatrix shows the attribute values with checkboxes and a
+// preview of the variants that would be created
+func (m Model) renderVariantMatrix() string {
+	if m.loading {
+		return tf("\n  %s Loading attributes...", m.spinner.View())
+	}
+	if m.variantMatrix == nil {
+		return "\n  " + T("No data")
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(T(" Generate Variants: ")+m.selectedItem) + "\n\n")
+
+	row := 0
+	for _, attr := range m.variantMatrix.attrs {
+		b.WriteString(fmt.Sprintf("  %s\n", attr.name))
+		for _, v := range attr.values {
+			check := "[ ]"
+			if m.variantPicked[variantPickKey(attr.name, v.value)] {
+				check = "[x]"
+			}
+			line := fmt.Sprintf("%s %s", check, v.value)
+			if v.abbr != "" && v.abbr != v.value {
+				line += helpStyle.Render(" (" + v.abbr + ")")
+			}
+			if row == m.variantCursor {
+				b.WriteString("  > " + selectedStyle.Render(line) + "\n")
+			} else {
+				b.WriteString("    " + line + "\n")
+			}
+			row++
+		}
+		b.WriteString("\n")
+	}
+
+	missing, existing, err := m.pickedVariants()
+	if err != nil {
+		b.WriteString("  " + errorStyle.Render(err.Error()) + "\n")
+		return b.String()
+	}
+	b.WriteString(tf("  New variants: %s", successStyle.Render(strconv.Itoa(len(missing)))))
+	if existing > 0 {
+		b.WriteString(tf("  (%d already exist)", existing))
+	}
+	b.WriteString("\n")
+	for i, combo := range missing {
+		if i >= 10 {
+			b.WriteString(tf("    ... and %d more\n", len(missing)-10))
+			break
+		}
+		b.WriteString(fmt.Sprintf("    + %s\n", m.variantMatrix.variantBody(combo)["item_code"]))
+	}
+	return b.String()
+}
+
+// generateVariants creates the picked variants
+func (m Model) generateVariants() tea.Cmd {
+	return func() tea.Msg {
+		missing, _, err := m.pickedVariants()
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		created, failed := 0, 0
+		firstErr := ""
+		for _, r := range m.client.createVariants(m.variantMatrix, missing) {
+			if r.err != nil {
+				failed++
+				if firstErr == "" {
+					firstErr = fmt.Sprintf("%s: %s", r.doc["item_code"], r.err)
+				}
+			} else {
+				created++
+			}
+		}
+		if failed > 0 {
+			return formSubmittedMsg{false, tf("Created %d variants, %d failed (%s)", created, failed, firstErr)}
+		}
+		return formSubmittedMsg{true, tf("Created %d variants of %s", created, m.selectedItem)}
+	}
+}
+
+// =============================================================================
+// TEMPLATE VARIANTS
+// =============================================================================
+
+// maxShownVariants caps the variants listed in a template's detail
+const maxShownVariants = 15
+
+// templateVariantsMsg carries the variants of a template
+type templateVariantsMsg struct {
+	template string
+	variants []templateVariant
+}
+
+// loadTemplateVariants loads the variants of the template shown, with their
+// attribute values and stock. Low-bandwidth mode skips them.
+func (m Model) loadTemplateVariants() tea.Cmd {
+	if hasVariants, _ := m.itemData["has_variants"].(float64); m.view != ViewItemDetail || hasVariants != 1 || m.client.LowBandwidth() {
+		return nil
+	}
+	template := m.selectedItem
+	return func() tea.Msg {
+		variants, err := m.client.fetchVariants(template)
+		if err != nil {
+			return errorMsg{err}
+		}
+		return templateVariantsMsg{template, variants}
+	}
+}
+
+// renderTemplateVariants renders the variants of a template as a table of
+// their attribute values and stock
+func (m Model) renderTemplateVariants() string {
+	if m.templateVariants == nil {
+		return ""
+	}
+	var b strings.Builder
+	if len(m.templateVariants) == 0 {
+		b.WriteString(T("\n  No variants yet • g: generate variants\n"))
+		return b.String()
+	}
+
+	var attrs []string
+	rows, _ := m.itemData["attributes"].([]interface{})
+	for _, row := range rows {
+		if am, ok := row.(map[string]interface{}); ok {
+			attrs = append(attrs, stringField(am, "attribute"))
+		}
+	}
+	codeWidth := 4
+	widths := make([]int, len(attrs))
+	for i, attr := range attrs {
+		widths[i] = min(len([]rune(attr)), 16)
+	}
+	for _, v := range m.templateVariants {
+		codeWidth = min(max(codeWidth, len([]rune(v.code))), 30)
+		for i, attr := range attrs {
+			widths[i] = max(widths[i], min(len([]rune(v.values[attr])), 16))
+		}
+	}
+
+	b.WriteString(tf("\n  Variants (%d):\n", len(m.templateVariants)))
+	header := fmt.Sprintf("    %-*s", codeWidth, "Code")
+	for i, attr := range attrs {
+		header += fmt.Sprintf("  %-*s", widths[i], truncate(attr, 16))
+	}
+	b.WriteString(helpStyle.Render(header+trHelp("  Stock")) + "\n")
+	for i, v := range m.templateVariants {
+		if i >= maxShownVariants {
+			b.WriteString(tf("    ... and %d more\n", len(m.templateVariants)-maxShownVariants))
+			break
+		}
+		line := fmt.Sprintf("    %-*s", codeWidth, truncate(v.code, 30))
+		for j, attr := range attrs {
+			line += fmt.Sprintf("  %-*s", widths[j], truncate(v.values[attr], 16))
+		}
+		b.WriteString(line + "  " + strconv.FormatFloat(v.stock, 'f', -1, 64) + "\n")
+	}
+	return b.String()
+}
+
 // =============================================================================
 // CREATE ATTRIBUTE
 // =============================================================================
@@ -331,11 +693,11 @@ func (m *Model) initCreateAttrForm(attrType string) {
 		m.inputs = make([]textinput.Model, 2)
 
 		m.inputs[0] = textinput.New()
-		m.inputs[0].Placeholder = "Attribute Name *"
+		m.inputs[0].Placeholder = T("Attribute Name *")
 		m.inputs[0].Focus()
 
 		m.inputs[1] = textinput.New()
-		m.inputs[1].Placeholder = "Values (comma separated) *"
+		m.inputs[1].Placeholder = T("Values (comma separated) *")
 
 		m.formData["attr_type"] = "text"
 
@@ -344,17 +706,17 @@ func (m *Model) initCreateAttrForm(attrType string) {
 		m.inputs = make([]textinput.Model, 4)
 
 		m.inputs[0] = textinput.New()
-		m.inputs[0].Placeholder = "Attribute Name *"
+		m.inputs[0].Placeholder = T("Attribute Name *")
 		m.inputs[0].Focus()
 
 		m.inputs[1] = textinput.New()
-		m.inputs[1].Placeholder = "From Range *"
+		m.inputs[1].Placeholder = T("From Range *")
 
 		m.inputs[2] = textinput.New()
-		m.inputs[2].Placeholder = "To Range *"
+		m.inputs[2].Placeholder = T("To Range *")
 
 		m.inputs[3] = textinput.New()
-		m.inputs[3].Placeholder = "Increment *"
+		m.inputs[3].Placeholder = T("Increment *")
 
 		m.formData["attr_type"] = "numeric"
 
@@ -363,11 +725,11 @@ func (m *Model) initCreateAttrForm(attrType string) {
 		m.inputs = make([]textinput.Model, 2)
 
 		m.inputs[0] = textinput.New()
-		m.inputs[0].Placeholder = "Attribute Name *"
+		m.inputs[0].Placeholder = T("Attribute Name *")
 		m.inputs[0].Focus()
 
 		m.inputs[1] = textinput.New()
-		m.inputs[1].Placeholder = "Values (comma separated) *"
+		m.inputs[1].Placeholder = T("Values (comma separated) *")
 
 		m.formData["attr_type"] = "select"
 	}
@@ -383,35 +745,35 @@ func (m Model) renderCreateAttr() string {
 
 	switch attrType {
 	case "text":
-		b.WriteString(titleStyle.Render(" Create Text Attribute ") + "\n\n")
-		labels := []string{"Attribute Name: *", "Values (comma separated): *"}
+		b.WriteString(titleStyle.Render(T(" Create Text Attribute ")) + "\n\n")
+		labels := []string{T("Attribute Name: *"), T("Values (comma separated): *")}
 		for i, input := range m.inputs {
 			b.WriteString(fmt.Sprintf("  %s\n", labels[i]))
 			b.WriteString(fmt.Sprintf("  %s\n\n", input.View()))
 		}
-		b.WriteString(helpStyle.Render("  Example values: Red, Blue, Green"))
+		b.WriteString(helpStyle.Render(trHelp("  Example values: Red, Blue, Green")))
 
 	case "numeric":
-		b.WriteString(titleStyle.Render(" Create Numeric Attribute ") + "\n\n")
-		labels := []string{"Attribute Name: *", "From Range: *", "To Range: *", "Increment: *"}
-		for i, input := range m.inputs {
+		b.WriteString(titleStyle.Render(T(" Create Numeric Attribute ")) + "\n\n")
+		labels := []string{T("Attribute Name: *"), T("From Range: *"), T("To Range: *"), T("Increment: *")}
+		for i := range m.inputs {
 			b.WriteString(fmt.Sprintf("  %s\n", labels[i]))
-			b.WriteString(fmt.Sprintf("  %s\n\n", input.View()))
+			b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(i)))
 		}
-		b.WriteString(helpStyle.Render("  Example: From 1, To 10, Increment 1"))
+		b.WriteString(helpStyle.Render(trHelp("  Example: From 1, To 10, Increment 1")))
 
 	case "select":
-		b.WriteString(titleStyle.Render(" Create Select Attribute ") + "\n\n")
-		labels := []string{"Attribute Name: *", "Values (comma separated): *"}
+		b.WriteString(titleStyle.Render(T(" Create Select Attribute ")) + "\n\n")
+		labels := []string{T("Attribute Name: *"), T("Values (comma separated): *")}
 		for i, input := range m.inputs {
 			b.WriteString(fmt.Sprintf("  %s\n", labels[i]))
 			b.WriteString(fmt.Sprintf("  %s\n\n", input.View()))
 		}
-		b.WriteString(helpStyle.Render("  Example values: Small, Medium, Large"))
+		b.WriteString(helpStyle.Render(trHelp("  Example values: Small, Medium, Large")))
 	}
 
 	b.WriteString("\n")
-	b.WriteString(helpStyle.Render("  * Required fields"))
+	b.WriteString(helpStyle.Render(trHelp("  * Required fields")))
 
 	return boxStyle.Render(b.String())
 }
@@ -423,7 +785,7 @@ func (m Model) submitCreateAttr() tea.Cmd {
 		name := m.inputs[0].Value()
 
 		if name == "" {
-			return formSubmittedMsg{false, "Attribute name is required"}
+			return formSubmittedMsg{false, T("Attribute name is required")}
 		}
 
 		body := map[string]interface{}{
@@ -434,7 +796,7 @@ func (m Model) submitCreateAttr() tea.Cmd {
 		case "text", "select":
 			valuesStr := m.inputs[1].Value()
 			if valuesStr == "" {
-				return formSubmittedMsg{false, "Values are required"}
+				return formSubmittedMsg{false, T("Values are required")}
 			}
 
 			// Parse comma-separated values
@@ -443,22 +805,15 @@ func (m Model) submitCreateAttr() tea.Cmd {
 			for _, v := range values {
 				v = strings.TrimSpace(v)
 				if v != "" {
-					// Generate abbreviation (first 3 chars uppercase)
-					abbr := v
-					if len(abbr) > 3 {
-						abbr = abbr[:3]
-					}
-					abbr = strings.ToUpper(abbr)
-
 					attrValues = append(attrValues, map[string]interface{}{
 						"attribute_value": v,
-						"abbr":            abbr,
+						"abbr":            valueAbbr(v),
 					})
 				}
 			}
 
 			if len(attrValues) == 0 {
-				return formSubmittedMsg{false, "At least one value is required"}
+				return formSubmittedMsg{false, T("At least one value is required")}
 			}
 
 			body["item_attribute_values"] = attrValues
@@ -469,22 +824,22 @@ func (m Model) submitCreateAttr() tea.Cmd {
 			incrStr := m.inputs[3].Value()
 
 			if fromStr == "" || toStr == "" || incrStr == "" {
-				return formSubmittedMsg{false, "All numeric fields are required"}
+				return formSubmittedMsg{false, T("All numeric fields are required")}
 			}
 
 			fromVal, err := strconv.ParseFloat(fromStr, 64)
 			if err != nil {
-				return formSubmittedMsg{false, "Invalid from range value"}
+				return formSubmittedMsg{false, T("Invalid from range value")}
 			}
 
 			toVal, err := strconv.ParseFloat(toStr, 64)
 			if err != nil {
-				return formSubmittedMsg{false, "Invalid to range value"}
+				return formSubmittedMsg{false, T("Invalid to range value")}
 			}
 
 			incr, err := strconv.ParseFloat(incrStr, 64)
 			if err != nil {
-				return formSubmittedMsg{false, "Invalid increment value"}
+				return formSubmittedMsg{false, T("Invalid increment value")}
 			}
 
 			body["numeric_values"] = 1
@@ -499,10 +854,211 @@ func (m Model) submitCreateAttr() tea.Cmd {
 		}
 
 		if data, ok := result["data"].(map[string]interface{}); ok {
-			return formSubmittedMsg{true, fmt.Sprintf("Attribute created: %s", data["name"])}
+			return formSubmittedMsg{true, tf("Attribute created: %s", data["name"])}
+		}
+
+		return formSubmittedMsg{false, T("Failed to create attribute")}
+	}
+}
+
+// valueAbbr generates the abbreviation of an attribute value (first 3 chars
+// uppercase)
+func valueAbbr(value string) string {
+	if len(value) > 3 {
+		value = value[:3]
+	}
+	return strings.ToUpper(value)
+}
+
+// attrTypes are the kinds of attribute the type chooser offers
+var attrTypes = []struct {
+	key     string
+	attr    string
+	title   string
+	example string
+	view    View
+}{
+	{"t", "text", "Text", "Values typed as a list, e.g. Red, Blue, Green", ViewCreateAttrText},
+	{"n", "numeric", "Numeric", "A range of numbers, e.g. 1 to 10 in steps of 1", ViewCreateAttrNumeric},
+	{"s", "select", "Select", "Values picked from a list, e.g. Small, Medium, Large", ViewCreateAttrSelect},
+}
+
+// openAttrTypeChooser asks for the kind of attribute to create; the form
+// goes back to where the chooser was opened from
+func (m *Model) openAttrTypeChooser() {
+	m.attrTypeCursor = 0
+	m.prevView = m.view
+	m.view = ViewCreateAttr
+}
+
+// handleAttrTypeKeys picks the kind of attribute and opens its form
+func (m Model) handleAttrTypeKeys(key string) (tea.Model, tea.Cmd) {
+	pick := -1
+	switch key {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		m.view = m.prevView
+	case "up", "k":
+		m.attrTypeCursor = max(m.attrTypeCursor-1, 0)
+	case "down", "j":
+		m.attrTypeCursor = min(m.attrTypeCursor+1, len(attrTypes)-1)
+	case "enter":
+		pick = m.attrTypeCursor
+	default:
+		for i, t := range attrTypes {
+			if key == t.key {
+				pick = i
+			}
 		}
+	}
+	if pick >= 0 {
+		m.initCreateAttrForm(attrTypes[pick].attr)
+		m.view = attrTypes[pick].view
+	}
+	return m, nil
+}
 
-		return formSubmittedMsg{false, "Failed to create attribute"}
+// renderAttrTypeChooser renders the attribute kinds to pick from
+func (m Model) renderAttrTypeChooser() string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(T(" Create Attribute ")) + "\n\n")
+	for i, t := range attrTypes {
+		line := fmt.Sprintf("[%s] %-8s", t.key, T(t.title))
+		if i == m.attrTypeCursor {
+			b.WriteString("  > " + selectedStyle.Render(line))
+		} else {
+			b.WriteString("    " + line)
+		}
+		b.WriteString(helpStyle.Render("  "+T(t.example)) + "\n")
+	}
+	return boxStyle.Render(b.String())
+}
+
+// =============================================================================
+// EDIT ATTRIBUTE VALUES
+// =============================================================================
+
+// initEditAttrForm fills the edit form with the values of the attribute
+// shown, or its range if it is numeric
+func (m *Model) initEditAttrForm() {
+	if numeric, _ := m.itemData["numeric_values"].(float64); numeric == 1 {
+		m.inputs = make([]textinput.Model, 3)
+		placeholders := []string{T("From Range *"), T("To Range *"), T("Increment *")}
+		for i, field := range []string{"from_range", "to_range", "increment"} {
+			m.inputs[i] = textinput.New()
+			m.inputs[i].Placeholder = placeholders[i]
+			if v, ok := m.itemData[field].(float64); ok {
+				m.inputs[i].SetValue(strconv.FormatFloat(v, 'f', -1, 64))
+			}
+		}
+		m.formData["attr_type"] = "numeric"
+	} else {
+		var values []string
+		rows, _ := m.itemData["item_attribute_values"].([]interface{})
+		for _, row := range rows {
+			if vm, ok := row.(map[string]interface{}); ok {
+				values = append(values, stringField(vm, "attribute_value"))
+			}
+		}
+		m.inputs = make([]textinput.Model, 1)
+		m.inputs[0] = textinput.New()
+		m.inputs[0].Placeholder = T("Values (comma separated) *")
+		m.inputs[0].SetValue(strings.Join(values, ", "))
+		m.formData["attr_type"] = "list"
+	}
+	m.inputs[0].Focus()
+	m.focusIndex = 0
+}
+
+// renderEditAttr renders the edit attribute values form
+func (m Model) renderEditAttr() string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(T(" Edit Attribute: ")+m.selectedItem+" ") + "\n\n")
+
+	if m.formData["attr_type"] == "numeric" {
+		labels := []string{T("From Range: *"), T("To Range: *"), T("Increment: *")}
+		for i := range m.inputs {
+			b.WriteString(fmt.Sprintf("  %s\n", labels[i]))
+			b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(i)))
+		}
+		b.WriteString(helpStyle.Render(trHelp("  Variants keep their values: narrowing the range does not remove them")))
+	} else {
+		b.WriteString(T("  Values (comma separated): *\n"))
+		b.WriteString(fmt.Sprintf("  %s\n\n", m.inputs[0].View()))
+		b.WriteString(helpStyle.Render(trHelp("  Add a value to create it, remove one to delete it (not if variants use it)")))
+	}
+
+	return boxStyle.Render(b.String())
+}
+
+// submitEditAttr saves the edited values or range of the attribute.
+// Values kept keep their row and abbreviation.
+func (m Model) submitEditAttr() tea.Cmd {
+	return func() tea.Msg {
+		name := m.selectedItem
+		body := map[string]interface{}{}
+		var message string
+
+		if m.formData["attr_type"] == "numeric" {
+			var r [3]float64
+			for i, label := range []string{"from range", "to range", "increment"} {
+				v, err := strconv.ParseFloat(strings.TrimSpace(m.inputs[i].Value()), 64)
+				if err != nil {
+					return formSubmittedMsg{false, tf("Invalid %s value", T(label))}
+				}
+				r[i] = v
+			}
+			if r[2] <= 0 || r[1] < r[0] {
+				return formSubmittedMsg{false, T("The range must go up, in steps above 0")}
+			}
+			body["from_range"], body["to_range"], body["increment"] = r[0], r[1], r[2]
+			message = tf("Attribute %s: range updated", name)
+		} else {
+			existing := map[string]map[string]interface{}{}
+			rows, _ := m.itemData["item_attribute_values"].([]interface{})
+			for _, row := range rows {
+				if vm, ok := row.(map[string]interface{}); ok {
+					existing[stringField(vm, "attribute_value")] = vm
+				}
+			}
+
+			var values []map[string]interface{}
+			seen := map[string]bool{}
+			added := 0
+			for _, v := range strings.Split(m.inputs[0].Value(), ",") {
+				v = strings.TrimSpace(v)
+				if v == "" || seen[v] {
+					continue
+				}
+				seen[v] = true
+				if row, ok := existing[v]; ok {
+					values = append(values, map[string]interface{}{
+						"name":            row["name"],
+						"attribute_value": v,
+						"abbr":            row["abbr"],
+					})
+					continue
+				}
+				added++
+				values = append(values, map[string]interface{}{
+					"attribute_value": v,
+					"abbr":            valueAbbr(v),
+				})
+			}
+			if len(values) == 0 {
+				return formSubmittedMsg{false, T("At least one value is required")}
+			}
+			body["item_attribute_values"] = values
+
+			removed := len(existing) - (len(values) - added)
+			message = tf("Attribute %s: %d values (%d added, %d removed)", name, len(values), added, removed)
+		}
+
+		if _, err := m.client.Request("PUT", "Item%20Attribute/"+url.PathEscape(name), body); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		return formSubmittedMsg{true, message}
 	}
 }
 
@@ -512,39 +1068,44 @@ func (m Model) submitCreateAttr() tea.Cmd {
 
 // initCreatePIFromPOForm initializes the create PI from PO form (pre-filled from current PO)
 func (m *Model) initCreatePIFromPOForm() {
-	m.inputs = make([]textinput.Model, 1)
+	m.inputs = make([]textinput.Model, 2)
 
 	m.inputs[0] = textinput.New()
-	m.inputs[0].Placeholder = "Purchase Order Name"
+	m.inputs[0].Placeholder = T("Purchase Order Name")
 	m.inputs[0].SetValue(m.selectedItem) // Pre-fill with current PO
 	m.inputs[0].Focus()
 
+	m.inputs[1] = newDateInput()
+	m.addOptionInputs(ViewCreatePIFromPO)
+
 	m.focusIndex = 0
 }
 
 // renderCreatePIFromPO renders the create PI from PO form
 func (m Model) renderCreatePIFromPO() string {
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Create Invoice from PO ") + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Create Invoice from PO ")) + "\n\n")
 
-	b.WriteString("  Purchase Order:\n")
+	b.WriteString(T("  Purchase Order:\n"))
 	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputs[0].View()))
+	b.WriteString(m.renderDateInput(1))
+	b.WriteString(m.renderOptionInputs())
 
 	// Show PO details if available
 	if m.itemData != nil {
 		if supplier, ok := m.itemData["supplier"]; ok {
-			b.WriteString(fmt.Sprintf("  Supplier: %v\n", supplier))
+			b.WriteString(tf("  Supplier: %v\n", supplier))
 		}
 		if total, ok := m.itemData["grand_total"].(float64); ok {
-			b.WriteString(fmt.Sprintf("  Total: %s\n", m.client.FormatCurrency(total)))
+			b.WriteString(tf("  Total: %s\n", m.client.FormatCurrency(total)))
 		}
 		if items, ok := m.itemData["items"].([]interface{}); ok {
-			b.WriteString(fmt.Sprintf("  Items: %d\n", len(items)))
+			b.WriteString(tf("  Items: %d\n", len(items)))
 		}
 	}
 
 	b.WriteString("\n")
-	b.WriteString(helpStyle.Render("  Press Enter to create invoice"))
+	b.WriteString(helpStyle.Render(trHelp("  Press Enter to create invoice")))
 
 	return boxStyle.Render(b.String())
 }
@@ -563,10 +1124,20 @@ func (m *Model) handleInventoryKeys(key string) (tea.Model, tea.Cmd) {
 	switch m.view {
 	case ViewAttributes:
 		if key == "n" {
-			// Show attribute type selection - default to text for now
-			m.initCreateAttrForm("text")
+			m.openAttrTypeChooser()
+			return m, nil
+		}
+
+	case ViewTemplates:
+		if key == "n" {
+			return m, m.openTemplateWizard()
+		}
+
+	case ViewItems:
+		if key == "n" {
+			m.initCreateItemForm()
 			m.prevView = m.view
-			m.view = ViewCreateAttrText
+			m.view = ViewCreateItem
 			return m, nil
 		}
 
@@ -610,7 +1181,7 @@ func (m Model) deleteGroup(name string) tea.Cmd {
 		if err != nil {
 			return errorMsg{err}
 		}
-		return actionDoneMsg{fmt.Sprintf("Deleted: %s", name)}
+		return actionDoneMsg{tf("Deleted: %s", name)}
 	}
 }
 
@@ -622,6 +1193,6 @@ func (m Model) deleteBrand(name string) tea.Cmd {
 		if err != nil {
 			return errorMsg{err}
 		}
-		return actionDoneMsg{fmt.Sprintf("Deleted: %s", name)}
+		return actionDoneMsg{tf("Deleted: %s", name)}
 	}
 }
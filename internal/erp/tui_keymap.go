@@ -0,0 +1,206 @@
+package erp
+
+import (
+	"sort"
+	"strings"
+)
+
+// ============================================================================
+// KEY BINDINGS (key.<view>.<action>="<key>" rebinds the TUI action keys)
+// ============================================================================
+
+// keyActions are the actions that can be rebound, with their default keys.
+// Actions sharing a default key share its binding in a view: rebinding
+// "receive" in the stock view also moves whatever else r does there.
+var keyActions = map[string]string{
+	"submit":       "s",
+	"cancel":       "x",
+	"new":          "n",
+	"delete":       "d",
+	"sort":         "o",
+	"order":        "o", // Sales order from a quotation
+	"add":          "a",
+	"edit":         "e",
+	"invoice":      "i",
+	"issue":        "i",
+	"payment":      "p",
+	"receive":      "r",
+	"refresh":      "r",
+	"transfer":     "t",
+	"variant":      "v",
+	"generate":     "g",
+	"finish":       "f",
+	"filter":       "f",
+	"saved-filter": "F",
+	"search":       "/",
+	"board":        "b",
+	"export":       "w",
+	"pdf":          "P",
+	"attach":       "u",
+	"history":      "h",
+	"copy-field":   "Y",
+	"browser":      "w",
+}
+
+// keymapViews are the views bindings can be scoped to, by name
+var keymapViews = map[string]View{
+	"dashboard":         ViewDashboard,
+	"items":             ViewItems,
+	"item":              ViewItemDetail,
+	"templates":         ViewTemplates,
+	"groups":            ViewGroups,
+	"brands":            ViewBrands,
+	"attributes":        ViewAttributes,
+	"warehouses":        ViewWarehouses,
+	"stock":             ViewStock,
+	"stock-detail":      ViewStockDetail,
+	"serials":           ViewSerials,
+	"serial":            ViewSerialDetail,
+	"customers":         ViewCustomers,
+	"customer":          ViewCustomerDetail,
+	"quotations":        ViewQuotations,
+	"quotation":         ViewQuotationDetail,
+	"sales-orders":      ViewSalesOrders,
+	"sales-order":       ViewSODetail,
+	"sales-invoices":    ViewSalesInvoices,
+	"sales-invoice":     ViewSIDetail,
+	"delivery-notes":    ViewDeliveryNotes,
+	"delivery-note":     ViewDNDetail,
+	"suppliers":         ViewSuppliers,
+	"supplier":          ViewSupplierDetail,
+	"purchase-orders":   ViewPurchaseOrders,
+	"purchase-order":    ViewPODetail,
+	"purchase-invoices": ViewPurchaseInvoices,
+	"purchase-invoice":  ViewPIDetail,
+	"purchase-receipts": ViewPurchaseReceipts,
+	"purchase-receipt":  ViewPRDetail,
+	"payments":          ViewPayments,
+	"payment":           ViewPaymentDetail,
+	"work-orders":       ViewWorkOrders,
+	"work-order":        ViewWODetail,
+	"boms":              ViewBOMs,
+	"bom":               ViewBOMDetail,
+	"subscriptions":     ViewSubscriptions,
+	"subscription":      ViewSubscriptionDetail,
+	"employees":         ViewEmployees,
+	"employee":          ViewEmployeeDetail,
+	"expense-claims":    ViewExpenseClaims,
+	"expense-claim":     ViewExpenseClaimDetail,
+	"inbox":             ViewInbox,
+	"timesheet":         ViewTimesheet,
+}
+
+// keymapDetails are the transaction detail views, the "details" scope
+var keymapDetails = []View{
+	ViewQuotationDetail, ViewSODetail, ViewSIDetail, ViewDNDetail,
+	ViewPODetail, ViewPIDetail, ViewPRDetail, ViewPaymentDetail,
+}
+
+// reservedKeys cannot be bound, since every view relies on them
+var reservedKeys = map[string]bool{"esc": true, "enter": true, "ctrl+c": true, "ctrl+p": true, "ctrl+n": true, "q": true, "y": true}
+
+// keymap holds the valid bindings as scope → action → key. Scopes are a
+// view name, "lists" for the paged lists, "details" for the transaction
+// detail views and "*" for every view.
+type keymap map[string]map[string]string
+
+// loadKeymap checks the configured bindings and keeps the valid ones. The
+// invalid ones are described in the returned warnings.
+func loadKeymap(bindings map[string]string) (keymap, []string) {
+	km := keymap{}
+	var warnings []string
+	for binding, key := range bindings {
+		scope, action, ok := strings.Cut(binding, ".")
+		_, known := keyActions[action]
+		_, view := keymapViews[scope]
+		switch {
+		case !ok || !known:
+			warnings = append(warnings, tf("key.%s: unknown action", binding))
+		case !view && scope != "lists" && scope != "details" && scope != "*":
+			warnings = append(warnings, tf("key.%s: unknown view %q", binding, scope))
+		case key == "":
+			warnings = append(warnings, tf("key.%s: no key", binding))
+		case reservedKeys[key]:
+			warnings = append(warnings, tf("key.%s: %s cannot be rebound", binding, key))
+		default:
+			if km[scope] == nil {
+				km[scope] = map[string]string{}
+			}
+			km[scope][action] = key
+		}
+	}
+	sort.Strings(warnings)
+	return km, warnings
+}
+
+// forView resolves the bindings of a view, by action. A view's own
+// bindings win over its group's, which win over "*".
+func (km keymap) forView(v View) map[string]string {
+	if len(km) == 0 {
+		return nil
+	}
+	scopes := []string{"*"}
+	if _, ok := listSources[v]; ok {
+		scopes = append(scopes, "lists")
+	}
+	for _, detail := range keymapDetails {
+		if v == detail {
+			scopes = append(scopes, "details")
+		}
+	}
+	for name, view := range keymapViews {
+		if view == v {
+			scopes = append(scopes, name)
+		}
+	}
+
+	bound := map[string]string{}
+	for _, scope := range scopes {
+		for action, key := range km[scope] {
+			bound[action] = key
+		}
+	}
+	return bound
+}
+
+// remapKey translates a pressed key to the default key of the action bound
+// to it in the current view. ok is false for a default key whose action was
+// moved elsewhere, so it no longer fires.
+func (m Model) remapKey(key string) (string, bool) {
+	if m.view == ViewConfirmDelete || m.view == ViewConfirmAction {
+		return key, true
+	}
+	bound := m.keymap.forView(m.view)
+	for action, k := range bound {
+		if k == key {
+			return keyActions[action], true
+		}
+	}
+	for action, k := range bound {
+		if keyActions[action] == key && k != key {
+			return "", false
+		}
+	}
+	return key, true
+}
+
+// applyKeymap rewrites the keys of a help line to the active bindings
+func (m Model) applyKeymap(help string) string {
+	bound := m.keymap.forView(m.view)
+	if len(bound) == 0 {
+		return help
+	}
+	moved := map[string]string{}
+	for action, key := range bound {
+		moved[keyActions[action]] = key
+	}
+
+	hints := strings.Split(help, " • ")
+	for i, hint := range hints {
+		key, label, ok := strings.Cut(hint, ": ")
+		if to, rebound := moved[key]; ok && rebound {
+			hints[i] = to + ": " + label
+		}
+	}
+	return strings.Join(hints, " • ")
+}
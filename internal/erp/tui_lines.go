@@ -0,0 +1,307 @@
+package erp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ============================================================================
+// LINE EDITOR (change or remove the lines of a draft SO, PO or Quotation)
+// ============================================================================
+
+// lineDateFields are the per-line date each editable doctype has
+var lineDateFields = map[string]string{
+	"Sales Order":    "delivery_date",
+	"Purchase Order": "schedule_date",
+}
+
+// lineEditor is a working copy of a draft's items table. Nothing is saved
+// until s, which PUTs the whole table.
+type lineEditor struct {
+	doctype string
+	name    string
+	loaded  map[string]interface{} // Document as loaded, for the timestamp check
+	lines   []map[string]interface{}
+	changed map[int]bool // Lines edited, by position
+	removed int
+	cursor  int
+	editing bool // The qty/rate inputs are open for the cursor line
+}
+
+// startLineEdit opens the editor on the document of the current detail view
+func (m Model) startLineEdit() (tea.Model, tea.Cmd) {
+	doctype := detailDoctypes[m.view]
+	if m.itemData == nil || doctype == "" {
+		return m, nil
+	}
+	if docStatus, _ := m.itemData["docstatus"].(float64); docStatus != 0 {
+		m.message = T("Only draft documents can be edited")
+		m.messageType = "error"
+		return m, nil
+	}
+
+	editor := &lineEditor{
+		doctype: doctype,
+		name:    m.selectedItem,
+		loaded:  m.itemData,
+		changed: make(map[int]bool),
+	}
+	if items, ok := m.itemData["items"].([]interface{}); ok {
+		for _, item := range items {
+			if im, ok := item.(map[string]interface{}); ok {
+				line := make(map[string]interface{}, len(im))
+				for k, v := range im {
+					line[k] = v
+				}
+				editor.lines = append(editor.lines, line)
+			}
+		}
+	}
+	if len(editor.lines) == 0 {
+		m.message = T("No lines to edit")
+		m.messageType = "error"
+		return m, nil
+	}
+
+	m.lineEdit = editor
+	m.prevView = m.view
+	m.view = ViewEditLines
+	return m, nil
+}
+
+// handleLineEditKeys drives the editor: the table, or the inputs of the line
+// being edited
+func (m Model) handleLineEditKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	e := m.lineEdit
+	if e.editing {
+		return m.handleLineInputKeys(msg)
+	}
+
+	switch msg.String() {
+	case "up", "k":
+		if e.cursor > 0 {
+			e.cursor--
+		}
+	case "down", "j":
+		if e.cursor < len(e.lines)-1 {
+			e.cursor++
+		}
+	case "enter", "e":
+		m.initLineInputs()
+		e.editing = true
+	case "x", "delete":
+		if len(e.lines) == 1 {
+			m.message = T("A document needs at least one line")
+			m.messageType = "error"
+			return m, nil
+		}
+		e.lines = append(e.lines[:e.cursor], e.lines[e.cursor+1:]...)
+		changed := make(map[int]bool)
+		for i := range e.changed {
+			switch {
+			case i < e.cursor:
+				changed[i] = true
+			case i > e.cursor:
+				changed[i-1] = true
+			}
+		}
+		e.changed = changed
+		e.removed++
+		if e.cursor >= len(e.lines) {
+			e.cursor = len(e.lines) - 1
+		}
+	case "s":
+		if len(e.changed) == 0 && e.removed == 0 {
+			m.message = T("Nothing changed")
+			m.messageType = "error"
+			return m, nil
+		}
+		m.loading = true
+		return m, m.saveLines()
+	case "esc":
+		m.lineEdit = nil
+		m.view = m.prevView
+	}
+	return m, nil
+}
+
+// initLineInputs fills the inputs with the cursor line's values
+func (m *Model) initLineInputs() {
+	e := m.lineEdit
+	line := e.lines[e.cursor]
+	qty, _ := line["qty"].(float64)
+	rate, _ := line["rate"].(float64)
+
+	count := 2
+	dateField := lineDateFields[e.doctype]
+	if dateField != "" {
+		count = 3
+	}
+	m.inputs = make([]textinput.Model, count)
+
+	m.inputs[0] = textinput.New()
+	m.inputs[0].Placeholder = T("Quantity")
+	m.inputs[0].SetValue(formatQty(qty))
+	m.inputs[0].Focus()
+
+	m.inputs[1] = textinput.New()
+	m.inputs[1].Placeholder = T("Rate")
+	m.inputs[1].SetValue(strconv.FormatFloat(rate, 'f', -1, 64))
+
+	if dateField != "" {
+		m.inputs[2] = textinput.New()
+		m.inputs[2].Placeholder = T("YYYY-MM-DD")
+		m.inputs[2].SetValue(stringField(line, dateField))
+	}
+
+	m.focusIndex = 0
+}
+
+// handleLineInputKeys handles the inputs of the line being edited; enter
+// applies them to the working copy
+func (m Model) handleLineInputKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	e := m.lineEdit
+	switch msg.String() {
+	case "tab", "down":
+		m.focusIndex = (m.focusIndex + 1) % len(m.inputs)
+		return m, m.updateFocus()
+	case "shift+tab", "up":
+		m.focusIndex = (m.focusIndex + len(m.inputs) - 1) % len(m.inputs)
+		return m, m.updateFocus()
+	case "esc":
+		e.editing = false
+		return m, nil
+	case "enter":
+		if err := m.applyLineInputs(); err != nil {
+			m.message = err.Error()
+			m.messageType = "error"
+			return m, nil
+		}
+		e.editing = false
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.inputs[m.focusIndex], cmd = m.inputs[m.focusIndex].Update(msg)
+	return m, cmd
+}
+
+// applyLineInputs validates the inputs and writes them into the cursor line
+func (m *Model) applyLineInputs() error {
+	e := m.lineEdit
+	line := e.lines[e.cursor]
+
+	qty, err := strconv.ParseFloat(strings.TrimSpace(m.inputs[0].Value()), 64)
+	if err != nil || qty <= 0 {
+		return errorf("invalid quantity")
+	}
+	rate, err := strconv.ParseFloat(strings.TrimSpace(m.inputs[1].Value()), 64)
+	if err != nil || rate < 0 {
+		return errorf("invalid rate")
+	}
+	date := ""
+	dateField := lineDateFields[e.doctype]
+	if dateField != "" {
+		date = strings.TrimSpace(m.inputs[2].Value())
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return errorf("invalid date %q (use YYYY-MM-DD)", date)
+		}
+	}
+
+	oldQty, _ := line["qty"].(float64)
+	oldRate, _ := line["rate"].(float64)
+	if qty != oldQty {
+		line["qty"] = qty
+		e.changed[e.cursor] = true
+	}
+	if rate != oldRate {
+		// A typed rate is the final price; a kept discount would recalculate it
+		line["rate"] = rate
+		line["discount_percentage"] = 0
+		line["discount_amount"] = 0
+		e.changed[e.cursor] = true
+	}
+	if dateField != "" && date != stringField(line, dateField) {
+		line[dateField] = date
+		e.changed[e.cursor] = true
+	}
+	return nil
+}
+
+// saveLines PUTs the edited items table. Rows keep their names, so the
+// server updates them in place and drops the removed ones.
+func (m Model) saveLines() tea.Cmd {
+	e := m.lineEdit
+	return func() tea.Msg {
+		items := make([]map[string]interface{}, len(e.lines))
+		copy(items, e.lines)
+		body := map[string]interface{}{
+			"items": items,
+		}
+		if err := m.client.updateDraft(e.doctype, e.name, e.loaded, body); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		return formSubmittedMsg{true, tf("Lines saved: %s", e.name)}
+	}
+}
+
+// renderEditLines renders the editable items table
+func (m Model) renderEditLines() string {
+	e := m.lineEdit
+	if e == nil {
+		return "\n  " + T("No data")
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(tf(" Edit Lines: %s ", e.name)) + "\n\n")
+
+	dateField := lineDateFields[e.doctype]
+	header := fmt.Sprintf("  %-3s %-22s %8s %14s %14s", "#", "Item", "Qty", "Rate", "Amount")
+	if dateField != "" {
+		header += "  Date"
+	}
+	b.WriteString(helpStyle.Render(header) + "\n")
+
+	total := 0.0
+	for i, line := range e.lines {
+		qty, _ := line["qty"].(float64)
+		rate, _ := line["rate"].(float64)
+		total += qty * rate
+
+		mark := " "
+		if e.changed[i] {
+			mark = "*"
+		}
+		row := fmt.Sprintf("%-3d %-22s %8s %14s %14s", i+1, truncate(stringField(line, "item_code"), 22),
+			formatQty(qty), m.client.FormatCurrency(rate), m.client.FormatCurrency(qty*rate))
+		if dateField != "" {
+			row += "  " + stringField(line, dateField)
+		}
+		if i == e.cursor {
+			b.WriteString(selectedStyle.Render("▸"+mark+row) + "\n")
+		} else {
+			b.WriteString(" " + mark + row + "\n")
+		}
+	}
+
+	b.WriteString(tf("\n  Total: %s (before taxes)\n", m.client.FormatCurrency(total)))
+	if len(e.changed) > 0 || e.removed > 0 {
+		b.WriteString(helpStyle.Render(tf("  %d changed, %d removed • not saved yet", len(e.changed), e.removed)) + "\n")
+	}
+
+	if e.editing {
+		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render(T("Line ")+strconv.Itoa(e.cursor+1)+": "+stringField(e.lines[e.cursor], "item_code"))))
+		labels := []string{T("Quantity:"), T("Rate:"), T("Date:")}
+		for i := range m.inputs {
+			b.WriteString(fmt.Sprintf("  %s\n", labels[i]))
+			b.WriteString(fmt.Sprintf("  %s\n\n", m.inputs[i].View()))
+		}
+	}
+
+	return boxStyle.Render(b.String())
+}
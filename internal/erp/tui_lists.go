@@ -0,0 +1,313 @@
+package erp
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ============================================================================
+// PAGED TRANSACTION LISTS (server-side paging, sorting and search)
+// ============================================================================
+
+// listPageSize is how many rows a list page fetches
+const listPageSize = 100
+
+// listSource describes how a transaction list is fetched
+type listSource struct {
+	doctype    string
+	dateField  string
+	partyField string
+	totalField string
+	detail     View // Detail view of a document
+}
+
+// listSources are the lists paged from the server, by view
+var listSources = map[View]listSource{
+	ViewQuotations:       {"Quotation", "transaction_date", "party_name", "grand_total", ViewQuotationDetail},
+	ViewSalesOrders:      {"Sales Order", "transaction_date", "customer", "grand_total", ViewSODetail},
+	ViewSalesInvoices:    {"Sales Invoice", "posting_date", "customer", "grand_total", ViewSIDetail},
+	ViewDeliveryNotes:    {"Delivery Note", "posting_date", "customer", "grand_total", ViewDNDetail},
+	ViewPurchaseOrders:   {"Purchase Order", "transaction_date", "supplier", "grand_total", ViewPODetail},
+	ViewPurchaseInvoices: {"Purchase Invoice", "posting_date", "supplier", "grand_total", ViewPIDetail},
+	ViewPurchaseReceipts: {"Purchase Receipt", "posting_date", "supplier", "grand_total", ViewPRDetail},
+	ViewPayments:         {"Payment Entry", "posting_date", "party", "paid_amount", ViewPaymentDetail},
+}
+
+// Column widths of the paged lists
+const (
+	colName   = 22
+	colDate   = 10
+	colParty  = 26
+	colStatus = 20
+	colTotal  = 14
+)
+
+type listPageMsg struct {
+	items []ListItem
+	more  bool // Another page follows
+}
+
+// pageSize is the rows per page, smaller in low-bandwidth mode
+func (m Model) pageSize() int {
+	if m.client.LowBandwidth() {
+		return lowBandwidthPageSize
+	}
+	return listPageSize
+}
+
+// orderBy maps the sort order of the list views to an order_by clause
+func (s listSource) orderBy(sortOrder int) string {
+	switch sortOrder {
+	case 1:
+		return s.dateField + " asc, creation asc"
+	case 2:
+		return "name asc"
+	case 3:
+		return s.totalField + " desc"
+	}
+	return s.dateField + " desc, creation desc"
+}
+
+// loadListPage fetches the current page of the current list, narrowed by the
+// search if one is active. One extra row tells whether a next page exists.
+func (m Model) loadListPage() tea.Cmd {
+	source, ok := listSources[m.view]
+	if !ok {
+		return nil
+	}
+	size := m.pageSize()
+	start := m.listPage * size
+	query := m.listQuery
+	filter := m.listFilter
+	sortOrder := m.sortOrder
+
+	return func() tea.Msg {
+		fields := []string{"name", source.dateField, source.partyField, "status", source.totalField, "docstatus"}
+		if source.doctype == "Payment Entry" {
+			fields = append(fields, "payment_type")
+		}
+		endpoint := fmt.Sprintf("%s?fields=[\"%s\"]&order_by=%s&limit_start=%d&limit_page_length=%d",
+			url.PathEscape(source.doctype), strings.Join(fields, "\",\""), url.QueryEscape(source.orderBy(sortOrder)), start, size+1)
+
+		if conditions := filter.conditions(source); len(conditions) > 0 {
+			filters, err := encodeFilters(conditions)
+			if err != nil {
+				return errorMsg{err}
+			}
+			endpoint += "&filters=" + filters
+		}
+		if query != "" {
+			like := "%" + query + "%"
+			orFilters, err := encodeFilters([][]interface{}{
+				{"name", "like", like},
+				{source.partyField, "like", like},
+			})
+			if err != nil {
+				return errorMsg{err}
+			}
+			endpoint += "&or_filters=" + orFilters
+		}
+
+		result, err := m.client.Request("GET", endpoint, nil)
+		if err != nil {
+			return errorMsg{err}
+		}
+
+		var items []ListItem
+		data, _ := result["data"].([]interface{})
+		for _, item := range data {
+			im, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			party := stringField(im, source.partyField)
+			if source.doctype == "Payment Entry" {
+				// ↓ money received, ↑ money paid out
+				if stringField(im, "payment_type") == "Pay" {
+					party = "↑ " + party
+				} else {
+					party = "↓ " + party
+				}
+			}
+			total, _ := im[source.totalField].(float64)
+			docStatus, _ := im["docstatus"].(float64)
+			items = append(items, ListItem{
+				name:      stringField(im, "name"),
+				date:      stringField(im, source.dateField),
+				party:     party,
+				status:    stringField(im, "status"),
+				amount:    total,
+				docstatus: int(docStatus),
+			})
+		}
+
+		more := len(items) > size
+		if more {
+			items = items[:size]
+		}
+		return listPageMsg{items, more}
+	}
+}
+
+// columnDelegate renders list rows as aligned columns on one line
+type columnDelegate struct {
+	client   *Client
+	selected map[string]ListItem // Rows picked for a batch action
+	compact  bool                // Name, status and total only (split layout)
+}
+
+func (d columnDelegate) Height() int                             { return 1 }
+func (d columnDelegate) Spacing() int                            { return 0 }
+func (d columnDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d columnDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	li, ok := item.(ListItem)
+	if !ok {
+		return
+	}
+	badge := renderStatusBadge(li.status)
+	if pad := colStatus - lipgloss.Width(badge); pad > 0 {
+		badge += strings.Repeat(" ", pad)
+	}
+	row := fmt.Sprintf("%-*s %-*s %-*s %s %*s", colName, truncate(li.name, colName), colDate, li.date,
+		colParty, truncate(li.party, colParty), badge, colTotal, d.client.FormatCurrency(li.amount))
+	if d.compact {
+		row = fmt.Sprintf("%-*s %s %*s", colName, truncate(li.name, colName), badge, colTotal, d.client.FormatCurrency(li.amount))
+	}
+
+	mark := "  "
+	if _, picked := d.selected[li.name]; picked {
+		mark = selectedStyle.Render("● ")
+	}
+	if index == m.Index() {
+		fmt.Fprint(w, selectedStyle.Render("▸ ")+mark+row)
+		return
+	}
+	fmt.Fprint(w, "  "+mark+row)
+}
+
+// setPagedList shows a loaded page in the column layout. The list's own
+// filter is off, since / searches the server instead.
+func (m *Model) setPagedList(msg listPageMsg) {
+	items := make([]list.Item, len(msg.items))
+	for i, item := range msg.items {
+		items[i] = item
+	}
+	m.listItems = msg.items
+	m.listMore = msg.more
+	m.preview.reset()
+
+	width := m.width - 4
+	if m.splitWidth() {
+		width = splitListWidth
+	}
+	m.currentList = list.New(items, columnDelegate{m.client, m.selected, m.splitWidth()}, width, m.height-10)
+	m.currentList.SetShowTitle(false)
+	m.currentList.SetShowStatusBar(false)
+	m.currentList.SetShowHelp(false)
+	m.currentList.SetFilteringEnabled(false)
+	m.setListTitle()
+}
+
+// renderListHeader renders the column titles of a paged list
+func renderListHeader(compact bool) string {
+	header := fmt.Sprintf("    %-*s %-*s %-*s %-*s %*s", colName, "Name", colDate, "Date", colParty, "Party",
+		colStatus, "Status", colTotal, "Total")
+	if compact {
+		header = fmt.Sprintf("    %-*s %-*s %*s", colName, "Name", colStatus, "Status", colTotal, "Total")
+	}
+	return helpStyle.Render(header) + "\n"
+}
+
+// renderPagedList renders a paged list with its title, column titles, the
+// search input when open, and the footer
+func (m Model) renderPagedList() string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(m.currentList.Title) + "\n\n")
+	b.WriteString(renderListHeader(m.splitWidth()))
+	if len(m.listItems) == 0 {
+		b.WriteString(helpStyle.Render(trHelp("  No documents")) + "\n")
+	} else {
+		b.WriteString(m.currentList.View())
+	}
+	b.WriteString(m.renderListFooter())
+	b.WriteString(m.renderListStatus())
+	return b.String()
+}
+
+// renderListStatus renders the page, search and filter line of a paged
+// list, the selection or batch progress, and the search input when open
+func (m Model) renderListStatus() string {
+	var b strings.Builder
+	status := []string{tf("Page %d", m.listPage+1)}
+	if !m.listFilter.empty() {
+		status = append(status, T("filter")+": "+m.listFilter.label())
+	}
+	if m.listQuery != "" {
+		status = append(status, tf("search: %q", m.listQuery))
+	}
+	if m.listQuery != "" || !m.listFilter.empty() {
+		status = append(status, trHelp("esc: clear"))
+	}
+	if m.listPage > 0 {
+		status = append(status, trHelp("[: previous page"))
+	}
+	if m.listMore {
+		status = append(status, trHelp("]: next page"))
+	}
+	b.WriteString("\n" + helpStyle.Render(" "+strings.Join(status, " • ")))
+	b.WriteString(m.renderBatchStatus())
+
+	if m.listSearching {
+		b.WriteString(T("\n\n  Search: ") + m.searchInput.View())
+	}
+	return b.String()
+}
+
+// startListSearch opens the search input of a paged list
+func (m Model) startListSearch() (tea.Model, tea.Cmd) {
+	m.searchInput = textinput.New()
+	m.searchInput.Placeholder = T("name or party")
+	m.searchInput.SetValue(m.listQuery)
+	m.searchInput.CursorEnd()
+	m.searchInput.Focus()
+	m.listSearching = true
+	return m, textinput.Blink
+}
+
+// updateListSearch handles the keys while the search input is open; enter
+// runs the search on the server from the first page
+func (m Model) updateListSearch(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		m.listSearching = false
+		return m, nil
+	case "enter":
+		m.listSearching = false
+		m.listQuery = strings.TrimSpace(m.searchInput.Value())
+		m.listPage = 0
+		return m.refreshCurrentView()
+	}
+
+	var cmd tea.Cmd
+	m.searchInput, cmd = m.searchInput.Update(msg)
+	return m, cmd
+}
+
+// turnListPage moves to the next (+1) or previous (-1) page
+func (m Model) turnListPage(delta int) (tea.Model, tea.Cmd) {
+	if delta > 0 && !m.listMore || delta < 0 && m.listPage == 0 {
+		return m, nil
+	}
+	m.listPage += delta
+	return m.refreshCurrentView()
+}
@@ -0,0 +1,336 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ============================================================================
+// WORK ORDERS
+// ============================================================================
+
+// loadWorkOrders fetches work orders for the list view
+func (m Model) loadWorkOrders() tea.Cmd {
+	return func() tea.Msg {
+		result, err := m.client.Request("GET", "Work%20Order?"+m.client.pageLimit(100)+"&fields=[\"name\",\"production_item\",\"qty\",\"produced_qty\",\"status\"]&order_by=creation%20desc", nil)
+		if err != nil {
+			return errorMsg{err}
+		}
+
+		var items []ListItem
+		if data, ok := result["data"].([]interface{}); ok {
+			for _, item := range data {
+				if im, ok := item.(map[string]interface{}); ok {
+					name := fmt.Sprintf("%v", im["name"])
+					status, _ := im["status"].(string)
+					qty, _ := im["qty"].(float64)
+					produced, _ := im["produced_qty"].(float64)
+
+					detail := fmt.Sprintf("%v | %s | %g/%g made", im["production_item"], renderStatusBadge(status), produced, qty)
+					items = append(items, ListItem{name: name, details: detail, status: status})
+				}
+			}
+		}
+		return dataLoadedMsg{items}
+	}
+}
+
+// loadWODetail fetches a work order with its required items
+func (m Model) loadWODetail(name string) tea.Cmd {
+	return func() tea.Msg {
+		data, err := m.client.fetchWorkOrder(name)
+		if err != nil {
+			return errorMsg{err}
+		}
+		return itemDetailMsg{data}
+	}
+}
+
+// renderWODetail renders the work order detail view
+func (m Model) renderWODetail() string {
+	if m.loading {
+		return "\n  Loading..."
+	}
+
+	if m.itemData == nil {
+		return "\n  " + T("No data")
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(T(" Work Order: ")+m.selectedItem) + "\n\n")
+
+	qty, _ := m.itemData["qty"].(float64)
+	transferred, _ := m.itemData["material_transferred_for_manufacturing"].(float64)
+	produced, _ := m.itemData["produced_qty"].(float64)
+	status, _ := m.itemData["status"].(string)
+
+	b.WriteString(tf("  Item: %v (%v)\n", m.itemData["production_item"], m.itemData["item_name"]))
+	b.WriteString(tf("  BOM: %v\n", m.itemData["bom_no"]))
+	b.WriteString(tf("  Status: %s\n", renderStatusBadge(status)))
+	b.WriteString(tf("  Qty: %g | Transferred: %g | Produced: %g\n", qty, transferred, produced))
+	b.WriteString(tf("  WIP Warehouse: %s\n", stringField(m.itemData, "wip_warehouse")))
+	b.WriteString(tf("  Target Warehouse: %s\n", stringField(m.itemData, "fg_warehouse")))
+
+	if items, ok := m.itemData["required_items"].([]interface{}); ok && len(items) > 0 {
+		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render(T("Required Items:"))))
+		for _, item := range items {
+			if im, ok := item.(map[string]interface{}); ok {
+				required, _ := im["required_qty"].(float64)
+				moved, _ := im["transferred_qty"].(float64)
+				consumed, _ := im["consumed_qty"].(float64)
+				b.WriteString(tf("    - %v: %g required, %g transferred, %g consumed\n", im["item_code"], required, moved, consumed))
+			}
+		}
+	}
+
+	return boxStyle.Render(b.String())
+}
+
+// woOpen reports whether the loaded work order can still move stock
+func (m Model) woOpen() bool {
+	if m.itemData == nil {
+		return false
+	}
+	switch status, _ := m.itemData["status"].(string); status {
+	case "Completed", "Cancelled", "Stopped", "Closed":
+		return false
+	}
+	return true
+}
+
+// initCreateWOForm initializes the create work order form, prefilled when
+// coming from a BOM
+func (m *Model) initCreateWOForm(item, bom string) {
+	m.inputs = make([]textinput.Model, 3)
+
+	m.inputs[0] = textinput.New()
+	m.inputs[0].Placeholder = T("Item to manufacture")
+	m.inputs[0].SetValue(item)
+	m.inputs[0].Focus()
+
+	m.inputs[1] = textinput.New()
+	m.inputs[1].Placeholder = T("Quantity")
+
+	m.inputs[2] = textinput.New()
+	m.inputs[2].Placeholder = T("BOM (leave empty for the default BOM)")
+	m.inputs[2].SetValue(bom)
+
+	m.focusIndex = 0
+	if item != "" {
+		m.focusIndex = 1
+		m.updateFocus()
+	}
+}
+
+// renderCreateWO renders the create work order form
+func (m Model) renderCreateWO() string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(T(" Create Work Order ")) + "\n\n")
+
+	labels := []string{T("Item:"), T("Quantity:"), T("BOM (optional):")}
+	for i, label := range labels {
+		b.WriteString(fmt.Sprintf("  %s\n", label))
+		b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(i)))
+	}
+
+	b.WriteString(helpStyle.Render(trHelp("  Warehouses come from Manufacturing Settings")))
+
+	return boxStyle.Render(b.String())
+}
+
+// submitCreateWO submits the create work order form
+func (m Model) submitCreateWO() tea.Cmd {
+	return func() tea.Msg {
+		item := strings.TrimSpace(m.inputs[0].Value())
+		if item == "" {
+			return formSubmittedMsg{false, T("Item is required")}
+		}
+		qty, err := strconv.ParseFloat(strings.TrimSpace(m.inputs[1].Value()), 64)
+		if err != nil || qty <= 0 {
+			return formSubmittedMsg{false, T("Invalid quantity")}
+		}
+
+		name, err := m.client.createWorkOrder(item, qty, woCreateOptions{bom: strings.TrimSpace(m.inputs[2].Value())})
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		return formSubmittedMsg{true, tf("Work Order created: %s", name)}
+	}
+}
+
+// woStep creates the transfer or manufacture Stock Entry of a work order
+func (m Model) woStep(name, purpose string) tea.Cmd {
+	return func() tea.Msg {
+		entry, err := m.client.makeWorkOrderEntry(name, purpose, 0, postingOptions{})
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		return formSubmittedMsg{true, fmt.Sprintf("%s: %s", purpose, entry)}
+	}
+}
+
+// ============================================================================
+// BOMS
+// ============================================================================
+
+// loadBOMs fetches active BOMs for the list view
+func (m Model) loadBOMs() tea.Cmd {
+	return func() tea.Msg {
+		result, err := m.client.Request("GET", "BOM?"+m.client.pageLimit(100)+"&fields=[\"name\",\"item\",\"is_default\",\"total_cost\",\"docstatus\"]&filters=%5B%5B%22is_active%22%2C%22%3D%22%2C1%5D%5D&order_by=item%20asc", nil)
+		if err != nil {
+			return errorMsg{err}
+		}
+
+		var items []ListItem
+		if data, ok := result["data"].([]interface{}); ok {
+			for _, item := range data {
+				if im, ok := item.(map[string]interface{}); ok {
+					name := fmt.Sprintf("%v", im["name"])
+					docstatus, _ := im["docstatus"].(float64)
+					status, _ := docStatusLabel(docstatus)
+					cost, _ := im["total_cost"].(float64)
+
+					detail := fmt.Sprintf("%v | %s | %s", im["item"], renderStatusBadge(status), m.client.FormatCurrency(cost))
+					if isDefault, _ := im["is_default"].(float64); isDefault == 1 {
+						detail += " | default"
+					}
+					items = append(items, ListItem{name: name, details: detail, amount: cost, status: status})
+				}
+			}
+		}
+		return dataLoadedMsg{items}
+	}
+}
+
+// loadBOMDetail fetches a BOM
+func (m Model) loadBOMDetail(name string) tea.Cmd {
+	return func() tea.Msg {
+		result, err := m.client.Request("GET", "BOM/"+url.PathEscape(name), nil)
+		if err != nil {
+			return errorMsg{err}
+		}
+
+		if data, ok := result["data"].(map[string]interface{}); ok {
+			return itemDetailMsg{data}
+		}
+		return errorMsg{errorf("no data found")}
+	}
+}
+
+// renderBOMDetail renders the BOM detail view
+func (m Model) renderBOMDetail() string {
+	if m.loading {
+		return "\n  Loading..."
+	}
+
+	if m.itemData == nil {
+		return "\n  " + T("No data")
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(T(" BOM: ")+m.selectedItem) + "\n\n")
+
+	qty, _ := m.itemData["quantity"].(float64)
+	cost, _ := m.itemData["total_cost"].(float64)
+	docstatus, _ := m.itemData["docstatus"].(float64)
+	status, _ := docStatusLabel(docstatus)
+
+	b.WriteString(tf("  Item: %v (%v)\n", m.itemData["item"], m.itemData["item_name"]))
+	b.WriteString(tf("  Quantity: %g\n", qty))
+	b.WriteString(tf("  Status: %s\n", renderStatusBadge(status)))
+	b.WriteString(tf("  Total Cost: %s\n", m.client.FormatCurrency(cost)))
+
+	if items, ok := m.itemData["items"].([]interface{}); ok && len(items) > 0 {
+		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render(T("Components:"))))
+		for _, item := range items {
+			if im, ok := item.(map[string]interface{}); ok {
+				itemQty, _ := im["qty"].(float64)
+				rate, _ := im["rate"].(float64)
+				line := fmt.Sprintf("    - %v x %g @ %s", im["item_code"], itemQty, m.client.FormatCurrency(rate))
+				if sub := stringField(im, "bom_no"); sub != "" {
+					line += helpStyle.Render(" (" + sub + ")")
+				}
+				b.WriteString(line + "\n")
+			}
+		}
+	}
+
+	return boxStyle.Render(b.String())
+}
+
+// submitBOM submits a draft BOM
+func (m Model) submitBOM(name string) tea.Cmd {
+	return func() tea.Msg {
+		if err := m.client.submitDocument("BOM", name); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		return formSubmittedMsg{true, tf("BOM submitted: %s", name)}
+	}
+}
+
+// handleManufacturingKeys handles keyboard shortcuts for manufacturing views
+func (m *Model) handleManufacturingKeys(key string) (tea.Model, tea.Cmd) {
+	switch m.view {
+	case ViewWorkOrders:
+		if key == "n" {
+			m.initCreateWOForm("", "")
+			m.prevView = m.view
+			m.view = ViewCreateWO
+			// A command stops the key from reaching the new form's input
+			return m, textinput.Blink
+		}
+
+	case ViewWODetail:
+		if !m.woOpen() {
+			break
+		}
+		switch key {
+		case "s":
+			transferred, _ := m.itemData["material_transferred_for_manufacturing"].(float64)
+			qty, _ := m.itemData["qty"].(float64)
+			if transferred < qty {
+				m.confirmAction = "start_wo"
+				m.confirmMsg = tf("Transfer materials for Work Order %s?", m.selectedItem)
+				m.prevView = m.view
+				m.view = ViewConfirmAction
+				return m, nil
+			}
+		case "f":
+			m.confirmAction = "finish_wo"
+			m.confirmMsg = tf("Finish Work Order %s (manufacture the pending quantity)?", m.selectedItem)
+			m.prevView = m.view
+			m.view = ViewConfirmAction
+			return m, nil
+		}
+
+	case ViewBOMDetail:
+		if m.itemData == nil {
+			break
+		}
+		docstatus, _ := m.itemData["docstatus"].(float64)
+		switch key {
+		case "s":
+			if docstatus == 0 {
+				m.confirmAction = "submit_bom"
+				m.confirmMsg = tf("Submit BOM %s?", m.selectedItem)
+				m.prevView = m.view
+				m.view = ViewConfirmAction
+				return m, nil
+			}
+		case "n":
+			if docstatus == 1 {
+				m.initCreateWOForm(stringField(m.itemData, "item"), m.selectedItem)
+				m.prevView = ViewWorkOrders
+				m.view = ViewCreateWO
+				return m, textinput.Blink
+			}
+		}
+	}
+
+	return m, nil
+}
@@ -0,0 +1,215 @@
+package erp
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ============================================================================
+// MOUSE (click to select and open, wheel to scroll)
+// ============================================================================
+
+// handleMouse handles clicks and the scroll wheel. A click selects a row and
+// a click on the selected row opens it, like enter; menu entries and dialog
+// buttons act on the first click.
+func (m Model) handleMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if msg.Action != tea.MouseActionPress || m.batch != nil || m.palette != nil || m.actionLog.open || m.listSearching {
+		return m, nil
+	}
+
+	if msg.Button == tea.MouseButtonWheelUp || msg.Button == tea.MouseButtonWheelDown {
+		key := "down"
+		if msg.Button == tea.MouseButtonWheelUp {
+			key = "up"
+		}
+		switch m.view {
+		case ViewDashboard, ViewHistory:
+			var cmd tea.Cmd
+			m.viewport, cmd = m.viewport.Update(msg)
+			return m, cmd
+		case ViewMain:
+			scrollList(&m.mainMenu, key)
+		case ViewInventoryMenu, ViewStockMenu, ViewSalesMenu, ViewPurchasingMenu, ViewPaymentsMenu,
+			ViewManufacturingMenu, ViewHRMenu:
+			scrollList(&m.subMenu, key)
+		case ViewAttributes, ViewItems, ViewTemplates, ViewGroups, ViewBrands,
+			ViewWarehouses, ViewStock, ViewSerials, ViewSuppliers,
+			ViewPurchaseOrders, ViewPurchaseInvoices, ViewPurchaseReceipts,
+			ViewCustomers, ViewQuotations, ViewSalesOrders, ViewSalesInvoices, ViewDeliveryNotes,
+			ViewPayments, ViewInbox, ViewWorkOrders, ViewBOMs, ViewSubscriptions,
+			ViewEmployees, ViewExpenseClaims:
+			if m.loading {
+				break
+			}
+			if m.isListView() && m.boardMode {
+				m.handleBoardKeys(key)
+			} else {
+				scrollList(&m.currentList, key)
+			}
+		}
+		return m, nil
+	}
+
+	if msg.Button != tea.MouseButtonLeft {
+		return m, nil
+	}
+	top := m.contentTop()
+	menuRow := list.NewDefaultDelegate()
+
+	switch m.view {
+	case ViewConfirmDelete, ViewConfirmAction:
+		dialog := m.renderConfirmDelete()
+		if m.view == ViewConfirmAction {
+			dialog = m.renderConfirmAction()
+		}
+		if key := confirmButtonAt(dialog, msg.X, msg.Y-top); key != "" {
+			return m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		}
+
+	case ViewMain:
+		if i, ok := listRowAt(m.mainMenu, top, msg.Y, menuRow.Height()+menuRow.Spacing()); ok {
+			m.mainMenu.Select(i)
+			return m.handleEnter()
+		}
+
+	case ViewInventoryMenu, ViewStockMenu, ViewSalesMenu, ViewPurchasingMenu, ViewPaymentsMenu,
+		ViewManufacturingMenu, ViewHRMenu:
+		if i, ok := listRowAt(m.subMenu, top, msg.Y, menuRow.Height()+menuRow.Spacing()); ok {
+			m.subMenu.Select(i)
+			return m.handleEnter()
+		}
+
+	case ViewAttributes, ViewItems, ViewTemplates, ViewGroups, ViewBrands,
+		ViewWarehouses, ViewStock, ViewSerials, ViewSuppliers,
+		ViewPurchaseOrders, ViewPurchaseInvoices, ViewPurchaseReceipts,
+		ViewCustomers, ViewQuotations, ViewSalesOrders, ViewSalesInvoices, ViewDeliveryNotes,
+		ViewPayments, ViewInbox, ViewWorkOrders, ViewBOMs, ViewSubscriptions,
+		ViewEmployees, ViewExpenseClaims:
+		if m.loading {
+			break
+		}
+		if m.isListView() && m.boardMode {
+			return m.clickBoard(msg.X, msg.Y-top)
+		}
+		if m.splitPane() && msg.X >= splitListWidth {
+			break
+		}
+
+		// Paged lists have their title, a blank line and the column titles
+		// above the rows
+		i, ok := listRowAt(m.currentList, top, msg.Y, menuRow.Height()+menuRow.Spacing())
+		if m.isListView() {
+			i, ok = listRowAt(m.currentList, top+3, msg.Y, 1)
+		}
+		if ok {
+			if i == m.currentList.Index() {
+				return m.handleEnter()
+			}
+			m.currentList.Select(i)
+		}
+	}
+	return m, nil
+}
+
+// contentTop is the screen row the content of the view starts on, below the
+// status bar, the breadcrumbs and the notification
+func (m Model) contentTop() int {
+	top := lipgloss.Height(m.renderStatusBar()) + lipgloss.Height(m.renderBreadcrumbs())
+	if m.showNotification {
+		top++
+	}
+	return top
+}
+
+// scrollList moves a list's cursor one row for the wheel
+func scrollList(l *list.Model, key string) {
+	if key == "up" {
+		l.CursorUp()
+	} else {
+		l.CursorDown()
+	}
+}
+
+// listRowAt returns the index of the item shown at screen row y of a list
+// drawn from row top, whose items take rowHeight lines each
+func listRowAt(l list.Model, top, y, rowHeight int) (int, bool) {
+	if l.ShowTitle() {
+		top += 1 + l.Styles.TitleBar.GetVerticalFrameSize()
+	}
+	if l.ShowStatusBar() {
+		top += 1 + l.Styles.StatusBar.GetVerticalFrameSize()
+	}
+	if y < top {
+		return 0, false
+	}
+	row := (y - top) / rowHeight
+	if row >= l.Paginator.PerPage {
+		return 0, false
+	}
+	index := l.Paginator.Page*l.Paginator.PerPage + row
+	if index >= len(l.VisibleItems()) {
+		return 0, false
+	}
+	return index, true
+}
+
+// clickBoard focuses the clicked card of the board, or opens it when it was
+// focused already. y is relative to the top of the board view.
+func (m Model) clickBoard(x, y int) (tea.Model, tea.Cmd) {
+	lanes := m.boardLanes()
+	if len(lanes) == 0 {
+		return m, nil
+	}
+	width, visible := m.boardSize(len(lanes))
+	col := x / width
+	if col >= len(lanes) {
+		return m, nil
+	}
+
+	// The title and a blank line, then the column's name, total and rule
+	line := y - 5
+	start := m.boardStart(col, visible)
+	if start > 0 {
+		line-- // "↑ n more"
+	}
+	if line < 0 {
+		return m, nil
+	}
+	card := line / 4
+	row := start + card
+	if card >= visible || row >= len(lanes[col].items) {
+		return m, nil
+	}
+
+	if col == m.boardCol && row == m.boardRow {
+		m.syncBoardSelection()
+		return m.handleEnter()
+	}
+	m.boardCol, m.boardRow = col, row
+	m.syncBoardSelection()
+	return m, nil
+}
+
+// confirmButtonAt returns "y" or "n" for a click on the buttons of a confirm
+// dialog, or "" when the click missed them. row is relative to the dialog.
+func confirmButtonAt(dialog string, x, row int) string {
+	lines := strings.Split(dialog, "\n")
+	if row < 0 || row >= len(lines) {
+		return ""
+	}
+	line := lines[row]
+	yes, no := strings.Index(line, "[y]"), strings.Index(line, "[n]")
+	if yes < 0 || no < 0 {
+		return ""
+	}
+	switch {
+	case x >= lipgloss.Width(line[:no]):
+		return "n"
+	case x >= lipgloss.Width(line[:yes]):
+		return "y"
+	}
+	return ""
+}
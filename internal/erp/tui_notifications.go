@@ -0,0 +1,101 @@
+package erp
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ============================================================================
+// NOTIFICATION LOG (ctrl+n: every action result of the session)
+// ============================================================================
+
+// logEntry is one action result in the notification log
+type logEntry struct {
+	at   time.Time
+	ok   bool
+	text string
+}
+
+// notificationLog keeps the results of the session's actions, newest last,
+// so the names of created documents outlive the notification
+type notificationLog struct {
+	entries []logEntry
+	unread  int  // Results since the log was last opened
+	open    bool // Shown over the current view
+	scroll  int  // First entry shown, counted from the newest
+}
+
+// record adds an action result to the log
+func (l *notificationLog) record(ok bool, text string) {
+	l.entries = append(l.entries, logEntry{time.Now(), ok, text})
+	if !l.open {
+		l.unread++
+	}
+}
+
+// logRows is how many entries fit the log box
+func (m Model) logRows() int {
+	return max(m.height-12, 3)
+}
+
+// updateNotificationLog handles the keys while the log is open
+func (m Model) updateNotificationLog(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	l := m.actionLog
+	last := max(len(l.entries)-m.logRows(), 0)
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc", "ctrl+n", "q":
+		l.open = false
+	case "down", "j":
+		l.scroll = min(l.scroll+1, last)
+	case "up", "k":
+		l.scroll = max(l.scroll-1, 0)
+	case "pgdown", " ":
+		l.scroll = min(l.scroll+m.logRows(), last)
+	case "pgup":
+		l.scroll = max(l.scroll-m.logRows(), 0)
+	case "home", "g":
+		l.scroll = 0
+	case "end", "G":
+		l.scroll = last
+	}
+	return m, nil
+}
+
+// openNotificationLog shows the log from the newest entry
+func (m Model) openNotificationLog() (tea.Model, tea.Cmd) {
+	m.actionLog.open = true
+	m.actionLog.scroll = 0
+	m.actionLog.unread = 0
+	return m, nil
+}
+
+// renderNotificationLog renders the log, newest first
+func (m Model) renderNotificationLog() string {
+	l := m.actionLog
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(tf(" Notifications (%d) ", len(l.entries))) + "\n\n")
+
+	if len(l.entries) == 0 {
+		b.WriteString(helpStyle.Render(trHelp("  Nothing yet: created, submitted, cancelled and deleted documents show up here")) + "\n")
+	}
+	width := max(m.width-24, 20)
+	shown := 0
+	for i := len(l.entries) - 1 - l.scroll; i >= 0 && shown < m.logRows(); i-- {
+		entry := l.entries[i]
+		mark := successStyle.Render("✓")
+		if !entry.ok {
+			mark = errorStyle.Render("✗")
+		}
+		b.WriteString(fmt.Sprintf("  %s %s %s\n", helpStyle.Render(entry.at.Format("15:04:05")), mark, truncate(entry.text, width)))
+		shown++
+	}
+	if older := len(l.entries) - l.scroll - shown; older > 0 {
+		b.WriteString(helpStyle.Render(tf("  … %d older", older)) + "\n")
+	}
+	return boxStyle.Render(b.String())
+}
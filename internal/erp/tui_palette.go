@@ -0,0 +1,483 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ============================================================================
+// COMMAND PALETTE (ctrl+p: jump to a view, a create form or a document)
+// ============================================================================
+
+// paletteShown is how many matches the palette lists at once
+const paletteShown = 12
+
+// paletteRecent is how many recently changed documents of each doctype the
+// palette offers
+const paletteRecent = 10
+
+// paletteAbbrevs are the short names the lists are also found by
+var paletteAbbrevs = map[View]string{
+	ViewQuotations:       "QTN",
+	ViewSalesOrders:      "SO",
+	ViewSalesInvoices:    "SI",
+	ViewDeliveryNotes:    "DN",
+	ViewPurchaseOrders:   "PO",
+	ViewPurchaseInvoices: "PI",
+	ViewPurchaseReceipts: "PR",
+	ViewWorkOrders:       "WO",
+	ViewBOMs:             "BOM",
+}
+
+// paletteCreates are the lists whose 'n' key opens a create form, in the
+// order the palette offers them
+var paletteCreates = []struct {
+	title string
+	view  View
+}{
+	{"Create Item", ViewItems},
+	{"Create Template", ViewTemplates},
+	{"Create Item Group", ViewGroups},
+	{"Create Brand", ViewBrands},
+	{"Create Attribute", ViewAttributes},
+	{"Create Warehouse", ViewWarehouses},
+	{"Create Serial Number", ViewSerials},
+	{"Create Customer", ViewCustomers},
+	{"Create Quotation", ViewQuotations},
+	{"Create Sales Order", ViewSalesOrders},
+	{"Create Delivery Note", ViewDeliveryNotes},
+	{"Create Sales Invoice", ViewSalesInvoices},
+	{"Create Supplier", ViewSuppliers},
+	{"Create Purchase Order", ViewPurchaseOrders},
+	{"Create Purchase Invoice", ViewPurchaseInvoices},
+	{"Create Purchase Receipt", ViewPurchaseReceipts},
+	{"Create Work Order", ViewWorkOrders},
+	{"Create Subscription", ViewSubscriptions},
+	{"Create Expense Claim", ViewExpenseClaims},
+}
+
+// paletteDoctypes are the doctypes whose recent documents the palette
+// offers, with the field shown next to the name
+var paletteDoctypes = []struct {
+	doctype string
+	field   string
+	list    View
+	detail  View
+}{
+	{"Sales Order", "customer", ViewSalesOrders, ViewSODetail},
+	{"Sales Invoice", "customer", ViewSalesInvoices, ViewSIDetail},
+	{"Quotation", "party_name", ViewQuotations, ViewQuotationDetail},
+	{"Delivery Note", "customer", ViewDeliveryNotes, ViewDNDetail},
+	{"Purchase Order", "supplier", ViewPurchaseOrders, ViewPODetail},
+	{"Purchase Invoice", "supplier", ViewPurchaseInvoices, ViewPIDetail},
+	{"Purchase Receipt", "supplier", ViewPurchaseReceipts, ViewPRDetail},
+	{"Payment Entry", "party", ViewPayments, ViewPaymentDetail},
+	{"Customer", "customer_name", ViewCustomers, ViewCustomerDetail},
+	{"Supplier", "supplier_name", ViewSuppliers, ViewSupplierDetail},
+	{"Item", "item_name", ViewItems, ViewItemDetail},
+	{"Work Order", "production_item", ViewWorkOrders, ViewWODetail},
+	{"BOM", "item", ViewBOMs, ViewBOMDetail},
+	{"Subscription", "party", ViewSubscriptions, ViewSubscriptionDetail},
+	{"Employee", "employee_name", ViewEmployees, ViewEmployeeDetail},
+	{"Expense Claim", "employee_name", ViewExpenseClaims, ViewExpenseClaimDetail},
+}
+
+// paletteEntry is something the palette can jump to
+type paletteEntry struct {
+	title    string // Shown and matched
+	hint     string // Shown dimmed after the title, also matched
+	keywords string // Only matched
+	view     View   // View to go to, or the list of the form or document
+	create   bool   // Open the list's create form
+	detail   View   // Detail view of the document named by title, if any
+	copy     string // Value to copy, in the field picker (Y)
+}
+
+// commandPalette is the open palette
+type commandPalette struct {
+	input       textinput.Model
+	entries     []paletteEntry
+	matches     []paletteEntry
+	cursor      int
+	loadingDocs bool
+	fields      bool // Picking a document field to copy
+}
+
+type paletteDocsMsg struct {
+	entries []paletteEntry
+}
+
+// paletteOpenMsg finishes a jump once the list it goes through has loaded
+type paletteOpenMsg struct {
+	entry paletteEntry
+}
+
+// openPalette shows the palette over the current view and starts loading
+// the recent documents
+func (m Model) openPalette() (tea.Model, tea.Cmd) {
+	input := textinput.New()
+	input.Placeholder = T("View, create action or document name")
+	input.Focus()
+
+	p := &commandPalette{input: input, entries: m.paletteViewEntries()}
+	m.palette = p
+	p.filter()
+
+	// Low-bandwidth mode keeps the palette to views and forms
+	if m.client.LowBandwidth() {
+		return m, textinput.Blink
+	}
+	p.loadingDocs = true
+	return m, tea.Batch(textinput.Blink, m.loadPaletteDocs())
+}
+
+// paletteViewEntries lists the menu views and the create forms
+func (m Model) paletteViewEntries() []paletteEntry {
+	var entries []paletteEntry
+	for _, item := range m.mainMenu.Items() {
+		menu, ok := item.(MenuItem)
+		if !ok {
+			continue
+		}
+		subItems := subMenuItems(menu.view)
+		if subItems == nil {
+			entries = append(entries, paletteEntry{title: menu.Title(), hint: menu.Description(), view: menu.view})
+			continue
+		}
+		for _, sub := range subItems {
+			if entry, ok := sub.(MenuItem); ok {
+				entries = append(entries, paletteEntry{
+					title:    entry.Title(),
+					hint:     menu.Title(),
+					keywords: paletteAbbrevs[entry.view],
+					view:     entry.view,
+				})
+			}
+		}
+	}
+	for _, create := range paletteCreates {
+		entries = append(entries, paletteEntry{
+			title:    create.title,
+			hint:     "New",
+			keywords: paletteAbbrevs[create.view],
+			view:     create.view,
+			create:   true,
+		})
+	}
+	return entries
+}
+
+// loadPaletteDocs fetches the latest changed documents of each doctype
+func (m Model) loadPaletteDocs() tea.Cmd {
+	return func() tea.Msg {
+		found := make([][]paletteEntry, len(paletteDoctypes))
+		var wg sync.WaitGroup
+		for i, dt := range paletteDoctypes {
+			wg.Add(1)
+			go func(i int, doctype, field string, list, detail View) {
+				defer wg.Done()
+				result, err := m.client.Request("GET", fmt.Sprintf("%s?fields=[\"name\",\"%s\"]&order_by=modified%%20desc&%s",
+					url.PathEscape(doctype), field, m.client.pageLimit(paletteRecent)), nil)
+				if err != nil {
+					// A doctype the user cannot read just offers nothing
+					return
+				}
+				data, _ := result["data"].([]interface{})
+				for _, item := range data {
+					doc, ok := item.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					hint := doctype
+					if value := stringField(doc, field); value != "" && value != stringField(doc, "name") {
+						hint += " · " + value
+					}
+					found[i] = append(found[i], paletteEntry{
+						title:  stringField(doc, "name"),
+						hint:   hint,
+						view:   list,
+						detail: detail,
+					})
+				}
+			}(i, dt.doctype, dt.field, dt.list, dt.detail)
+		}
+		wg.Wait()
+
+		var entries []paletteEntry
+		for _, docs := range found {
+			entries = append(entries, docs...)
+		}
+		return paletteDocsMsg{entries}
+	}
+}
+
+// filter ranks the entries against the query. Without a query the entries
+// keep their order: views, forms, then documents.
+func (p *commandPalette) filter() {
+	query := p.input.Value()
+	type scored struct {
+		entry paletteEntry
+		score int
+	}
+	var ranked []scored
+	for _, entry := range p.entries {
+		score, ok := fuzzyScore(query, entry.title+" "+entry.hint+" "+entry.keywords)
+		if ok {
+			ranked = append(ranked, scored{entry, score})
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].score > ranked[j].score
+	})
+
+	p.matches = p.matches[:0]
+	for _, r := range ranked {
+		p.matches = append(p.matches, r.entry)
+	}
+	if p.cursor >= len(p.matches) {
+		p.cursor = 0
+	}
+}
+
+// fuzzyScore matches every word of the query against the text, in any
+// order. Whole substrings score best, then letters in order with bonuses for
+// word starts and runs. ok is false when a word does not match.
+func fuzzyScore(query, text string) (int, bool) {
+	text = strings.ToLower(text)
+	total := 0
+	for _, word := range strings.Fields(strings.ToLower(query)) {
+		score, ok := wordScore(word, text)
+		if !ok {
+			return 0, false
+		}
+		total += score
+	}
+	return total, true
+}
+
+// wordScore scores one query word against the lowercased text
+func wordScore(word, text string) (int, bool) {
+	if i := strings.Index(text, word); i >= 0 {
+		score := 100 + 2*len(word)
+		if i == 0 || text[i-1] == ' ' {
+			score += 50
+		}
+		return score, true
+	}
+
+	runes := []rune(text)
+	score, pos, last := 0, 0, -2
+	for _, r := range word {
+		for pos < len(runes) && runes[pos] != r {
+			pos++
+		}
+		if pos == len(runes) {
+			return 0, false
+		}
+		switch {
+		case pos == 0 || runes[pos-1] == ' ':
+			score += 10
+		case pos == last+1:
+			score += 5
+		default:
+			score--
+		}
+		last = pos
+		pos++
+	}
+	return score, true
+}
+
+// updatePalette handles the keys while the palette is open
+func (m Model) updatePalette(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	p := m.palette
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc", "ctrl+p":
+		m.palette = nil
+		return m, nil
+	case "down", "ctrl+n":
+		if len(p.matches) > 0 {
+			p.cursor = (p.cursor + 1) % len(p.matches)
+		}
+		return m, nil
+	case "up":
+		if len(p.matches) > 0 {
+			p.cursor = (p.cursor + len(p.matches) - 1) % len(p.matches)
+		}
+		return m, nil
+	case "enter":
+		if len(p.matches) == 0 {
+			return m, nil
+		}
+		entry := p.matches[p.cursor]
+		m.palette = nil
+		if p.fields {
+			return m.copied(entry.copy, entry.hint)
+		}
+		return m.runPaletteEntry(entry)
+	}
+
+	var cmd tea.Cmd
+	before := p.input.Value()
+	p.input, cmd = p.input.Update(msg)
+	if p.input.Value() != before {
+		p.cursor = 0
+		p.filter()
+	}
+	return m, cmd
+}
+
+// runPaletteEntry goes to the entry's view. Forms and documents open once
+// their list has loaded, so esc leads back through it as usual.
+func (m Model) runPaletteEntry(entry paletteEntry) (tea.Model, tea.Cmd) {
+	next, cmd := m.goToView(entry.view)
+	m = next.(Model)
+	if !entry.create && entry.detail == 0 {
+		return m, cmd
+	}
+	return m, tea.Sequence(cmd, func() tea.Msg {
+		return paletteOpenMsg{entry}
+	})
+}
+
+// goToView walks Main → submenu → list the way the menus do, so the
+// breadcrumbs and esc behave as if the user had navigated there
+func (m Model) goToView(v View) (tea.Model, tea.Cmd) {
+	m.view = ViewMain
+	m.breadcrumbs = []string{"Main"}
+	for i, item := range m.mainMenu.Items() {
+		menu, ok := item.(MenuItem)
+		if !ok {
+			continue
+		}
+		if menu.view == v {
+			m.mainMenu.Select(i)
+			return m.handleEnter()
+		}
+		for j, sub := range subMenuItems(menu.view) {
+			if entry, ok := sub.(MenuItem); ok && entry.view == v {
+				m.mainMenu.Select(i)
+				next, _ := m.handleEnter()
+				m = next.(Model)
+				m.subMenu.Select(j)
+				return m.handleEnter()
+			}
+		}
+	}
+	return m, nil
+}
+
+// finishPaletteJump opens the form or document of a jump, unless the user
+// moved on while the list was loading
+func (m Model) finishPaletteJump(entry paletteEntry) (tea.Model, tea.Cmd) {
+	if m.view != entry.view || m.palette != nil {
+		return m, nil
+	}
+
+	if entry.create {
+		var cmd tea.Cmd
+		for _, handle := range []func(*Model, string) (tea.Model, tea.Cmd){
+			(*Model).handleInventoryKeys, (*Model).handleStockKeys, (*Model).handleSalesKeys,
+			(*Model).handlePurchasingKeys, (*Model).handleManufacturingKeys, (*Model).handleSubscriptionKeys,
+			(*Model).handleHRKeys,
+		} {
+			if _, cmd = handle(&m, "n"); m.view != entry.view {
+				break
+			}
+		}
+		m.prevView = entry.view
+		return m, cmd
+	}
+
+	m.selectedItem = entry.title
+	m.prevView = entry.view
+	m.view = entry.detail
+	m.loading = true
+	m.itemData = nil
+	m.breadcrumbs = append(m.breadcrumbs, entry.title)
+	return m, m.loadDetail(entry.detail, entry.title)
+}
+
+// loadDetail loads a document into its detail view
+func (m Model) loadDetail(v View, name string) tea.Cmd {
+	switch v {
+	case ViewSODetail:
+		return m.loadSODetail(name)
+	case ViewSIDetail:
+		return m.loadSIDetail(name)
+	case ViewQuotationDetail:
+		return m.loadQuotationDetail(name)
+	case ViewDNDetail:
+		return m.loadDNDetail(name)
+	case ViewPODetail:
+		return m.loadPODetail(name)
+	case ViewPIDetail:
+		return m.loadPIDetail(name)
+	case ViewPRDetail:
+		return m.loadPRDetail(name)
+	case ViewPaymentDetail:
+		return m.loadPaymentDetail(name)
+	case ViewCustomerDetail:
+		return m.loadCustomerDetail(name)
+	case ViewSupplierDetail:
+		return m.loadSupplierDetail(name)
+	case ViewItemDetail:
+		return m.loadItemDetail(name)
+	case ViewWODetail:
+		return m.loadWODetail(name)
+	case ViewBOMDetail:
+		return m.loadBOMDetail(name)
+	case ViewSubscriptionDetail:
+		return m.loadSubscriptionDetail(name)
+	case ViewEmployeeDetail:
+		return m.loadEmployeeDetail(name)
+	case ViewExpenseClaimDetail:
+		return m.loadExpenseClaimDetail(name)
+	}
+	return nil
+}
+
+// renderPalette renders the palette with the best matches around the cursor
+func (m Model) renderPalette() string {
+	p := m.palette
+	var b strings.Builder
+	title := " " + T("Go to") + " "
+	if p.fields {
+		title = " " + T("Copy field") + " "
+	}
+	b.WriteString(titleStyle.Render(title) + "\n\n")
+	b.WriteString("  " + p.input.View() + "\n\n")
+
+	if len(p.matches) == 0 {
+		b.WriteString(helpStyle.Render(trHelp("  No matches")) + "\n")
+	}
+	start := 0
+	if p.cursor >= paletteShown {
+		start = p.cursor - paletteShown + 1
+	}
+	for i := start; i < len(p.matches) && i < start+paletteShown; i++ {
+		entry := p.matches[i]
+		line := fmt.Sprintf("%-28s", entry.title)
+		if i == p.cursor {
+			b.WriteString(selectedStyle.Render("  ▸ "+line) + " " + helpStyle.Render(entry.hint) + "\n")
+		} else {
+			b.WriteString("    " + line + " " + helpStyle.Render(entry.hint) + "\n")
+		}
+	}
+	if len(p.matches) > start+paletteShown {
+		b.WriteString(helpStyle.Render(tf("    … %d more", len(p.matches)-start-paletteShown)) + "\n")
+	}
+	if p.loadingDocs {
+		b.WriteString("\n" + helpStyle.Render(trHelp("  Loading recent documents...")))
+	}
+
+	return boxStyle.Render(b.String())
+}
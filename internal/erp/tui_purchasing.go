@@ -5,7 +5,6 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
@@ -49,9 +48,19 @@ func (m Model) loadSupplierDetail(name string) tea.Cmd {
 		}
 
 		if data, ok := result["data"].(map[string]interface{}); ok {
+			if m.client.LowBandwidth() {
+				return itemDetailMsg{data}
+			}
+			// Linked addresses and contacts live in their own doctypes
+			if addresses, err := m.client.fetchPartyAddresses("Supplier", name); err == nil {
+				data["_addresses"] = addresses
+			}
+			if contacts, err := m.client.fetchPartyContacts("Supplier", name); err == nil {
+				data["_contacts"] = contacts
+			}
 			return itemDetailMsg{data}
 		}
-		return errorMsg{fmt.Errorf("no data found")}
+		return errorMsg{errorf("no data found")}
 	}
 }
 
@@ -62,11 +71,11 @@ func (m Model) renderSupplierDetail() string {
 	}
 
 	if m.itemData == nil {
-		return "\n  No data"
+		return "\n  " + T("No data")
 	}
 
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Supplier: "+m.selectedItem) + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Supplier: ")+m.selectedItem) + "\n\n")
 
 	fields := []struct {
 		key   string
@@ -81,14 +90,16 @@ func (m Model) renderSupplierDetail() string {
 
 	for _, f := range fields {
 		if val, ok := m.itemData[f.key]; ok && val != nil && val != "" {
-			b.WriteString(fmt.Sprintf("  %s: %v\n", f.label, val))
+			b.WriteString(fmt.Sprintf("  %s: %v\n", T(f.label), val))
 		}
 	}
 
 	if disabled, ok := m.itemData["disabled"]; ok && disabled == float64(1) {
-		b.WriteString(fmt.Sprintf("\n  %s\n", errorStyle.Render("DISABLED")))
+		b.WriteString(fmt.Sprintf("\n  %s\n", errorStyle.Render(T("DISABLED"))))
 	}
 
+	b.WriteString(m.renderPartyLinks())
+
 	return boxStyle.Render(b.String())
 }
 
@@ -97,14 +108,15 @@ func (m *Model) initCreateSupplierForm() {
 	m.inputs = make([]textinput.Model, 3)
 
 	m.inputs[0] = textinput.New()
-	m.inputs[0].Placeholder = "Supplier Name"
+	m.inputs[0].Placeholder = T("Supplier Name")
 	m.inputs[0].Focus()
 
 	m.inputs[1] = textinput.New()
-	m.inputs[1].Placeholder = "Supplier Group (optional)"
+	m.inputs[1].Placeholder = T("Supplier Group (optional)")
+	m.inputs[1].SetValue(m.client.Config.DefaultSupplierGroup)
 
 	m.inputs[2] = textinput.New()
-	m.inputs[2].Placeholder = "Country (optional)"
+	m.inputs[2].Placeholder = T("Country (optional)")
 
 	m.focusIndex = 0
 }
@@ -112,9 +124,9 @@ func (m *Model) initCreateSupplierForm() {
 // renderCreateSupplier renders the create supplier form
 func (m Model) renderCreateSupplier() string {
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Create Supplier ") + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Create Supplier ")) + "\n\n")
 
-	labels := []string{"Name:", "Group:", "Country:"}
+	labels := []string{T("Name:"), T("Group:"), T("Country:")}
 	for i, input := range m.inputs {
 		b.WriteString(fmt.Sprintf("  %s\n", labels[i]))
 		b.WriteString(fmt.Sprintf("  %s\n\n", input.View()))
@@ -131,7 +143,7 @@ func (m Model) submitCreateSupplier() tea.Cmd {
 		country := m.inputs[2].Value()
 
 		if name == "" {
-			return formSubmittedMsg{false, "Supplier name is required"}
+			return formSubmittedMsg{false, T("Supplier name is required")}
 		}
 
 		body := map[string]interface{}{
@@ -150,10 +162,10 @@ func (m Model) submitCreateSupplier() tea.Cmd {
 		}
 
 		if data, ok := result["data"].(map[string]interface{}); ok {
-			return formSubmittedMsg{true, fmt.Sprintf("Supplier created: %s", data["name"])}
+			return formSubmittedMsg{true, tf("Supplier created: %s", data["name"])}
 		}
 
-		return formSubmittedMsg{false, "Failed to create supplier"}
+		return formSubmittedMsg{false, T("Failed to create supplier")}
 	}
 }
 
@@ -165,34 +177,7 @@ func (m Model) deleteSupplier(name string) tea.Cmd {
 		if err != nil {
 			return errorMsg{err}
 		}
-		return actionDoneMsg{fmt.Sprintf("Deleted: %s", name)}
-	}
-}
-
-// loadPurchaseOrders fetches all purchase orders
-func (m Model) loadPurchaseOrders() tea.Cmd {
-	return func() tea.Msg {
-		result, err := m.client.Request("GET", "Purchase%20Order?limit_page_length=100&fields=[\"name\",\"supplier\",\"transaction_date\",\"status\",\"grand_total\",\"docstatus\"]&order_by=creation%20desc", nil)
-		if err != nil {
-			return errorMsg{err}
-		}
-
-		var items []ListItem
-		if data, ok := result["data"].([]interface{}); ok {
-			for _, item := range data {
-				if im, ok := item.(map[string]interface{}); ok {
-					name := fmt.Sprintf("%v", im["name"])
-					supplier := fmt.Sprintf("%v", im["supplier"])
-					status, _ := im["status"].(string)
-					total, _ := im["grand_total"].(float64)
-
-					statusBadge := renderStatusBadge(status)
-					detail := fmt.Sprintf("%s | %s | %s", supplier, statusBadge, m.client.FormatCurrency(total))
-					items = append(items, ListItem{name: name, details: detail, amount: total, status: status})
-				}
-			}
-		}
-		return dataLoadedMsg{items}
+		return actionDoneMsg{tf("Deleted: %s", name)}
 	}
 }
 
@@ -208,7 +193,7 @@ func (m Model) loadPODetail(name string) tea.Cmd {
 		if data, ok := result["data"].(map[string]interface{}); ok {
 			return itemDetailMsg{data}
 		}
-		return errorMsg{fmt.Errorf("no data found")}
+		return errorMsg{errorf("no data found")}
 	}
 }
 
@@ -219,15 +204,15 @@ func (m Model) renderPODetail() string {
 	}
 
 	if m.itemData == nil {
-		return "\n  No data"
+		return "\n  " + T("No data")
 	}
 
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Purchase Order: "+m.selectedItem) + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Purchase Order: ")+m.selectedItem) + "\n\n")
 
 	// Basic info
-	b.WriteString(fmt.Sprintf("  Supplier: %v\n", m.itemData["supplier"]))
-	b.WriteString(fmt.Sprintf("  Date: %v\n", m.itemData["transaction_date"]))
+	b.WriteString(tf("  Supplier: %v\n", m.itemData["supplier"]))
+	b.WriteString(tf("  Date: %v\n", m.itemData["transaction_date"]))
 
 	status, _ := m.itemData["status"].(string)
 	statusStyle := helpStyle
@@ -239,48 +224,73 @@ func (m Model) renderPODetail() string {
 	case "Cancelled":
 		statusStyle = errorStyle
 	}
-	b.WriteString(fmt.Sprintf("  Status: %s\n", statusStyle.Render(status)))
+	b.WriteString(tf("  Status: %s\n", statusStyle.Render(status)))
 
-	grandTotal, _ := m.itemData["grand_total"].(float64)
-	b.WriteString(fmt.Sprintf("  Total: %s\n", m.client.FormatCurrency(grandTotal)))
+	b.WriteString(tf("  Total: %s\n", m.client.docTotal(m.itemData)))
 
 	// Items
 	if items, ok := m.itemData["items"].([]interface{}); ok && len(items) > 0 {
-		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render("Items:")))
+		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render(T("Items:"))))
 		for _, item := range items {
 			if im, ok := item.(map[string]interface{}); ok {
 				itemCode := fmt.Sprintf("%v", im["item_code"])
 				qty, _ := im["qty"].(float64)
 				rate, _ := im["rate"].(float64)
 				amount, _ := im["amount"].(float64)
-				b.WriteString(fmt.Sprintf("    - %s: %.0f x %s = %s\n", itemCode, qty, m.client.FormatCurrency(rate), m.client.FormatCurrency(amount)))
+				b.WriteString(fmt.Sprintf("    - %s: %.0f x %s = %s\n", itemCode, qty, m.client.formatDoc(m.itemData, rate), m.client.formatDoc(m.itemData, amount)))
 			}
 		}
 	}
 
+	if lines := docTermsLines(m.itemData); len(lines) > 0 {
+		b.WriteString("\n")
+		for _, line := range lines {
+			b.WriteString(line + "\n")
+		}
+	}
+
 	return boxStyle.Render(b.String())
 }
 
 // initCreatePOForm initializes the create PO form
 func (m *Model) initCreatePOForm() {
-	m.inputs = make([]textinput.Model, 1)
+	m.inputs = make([]textinput.Model, 4)
 
 	m.inputs[0] = textinput.New()
-	m.inputs[0].Placeholder = "Supplier Name"
+	m.inputs[0].Placeholder = T("Supplier Name")
 	m.inputs[0].Focus()
 
+	m.inputs[1] = textinput.New()
+	m.inputs[1].Placeholder = T("Item Code (optional)")
+
+	m.inputs[2] = textinput.New()
+	m.inputs[2].Placeholder = T("Quantity (default 1)")
+
+	m.inputs[3] = newDateInput()
+	m.addOptionInputs(ViewCreatePO)
+
 	m.focusIndex = 0
+	m.noteID = 0
 }
 
 // renderCreatePO renders the create PO form
 func (m Model) renderCreatePO() string {
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Create Purchase Order ") + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Create Purchase Order ")) + "\n\n")
 
-	b.WriteString("  Supplier:\n")
-	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputs[0].View()))
+	b.WriteString(T("  Supplier:\n"))
+	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(0)))
+
+	b.WriteString(T("  Item:\n"))
+	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(1)))
+
+	b.WriteString(T("  Quantity:\n"))
+	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(2)))
 
-	b.WriteString(helpStyle.Render("  After creation, add items with 'a' key"))
+	b.WriteString(m.renderDateInput(3))
+	b.WriteString(m.renderOptionInputs())
+
+	b.WriteString(helpStyle.Render(trHelp("  After creation, add items with 'a' key")))
 
 	return boxStyle.Render(b.String())
 }
@@ -291,7 +301,16 @@ func (m Model) submitCreatePO() tea.Cmd {
 		supplier := m.inputs[0].Value()
 
 		if supplier == "" {
-			return formSubmittedMsg{false, "Supplier is required"}
+			return formSubmittedMsg{false, T("Supplier is required")}
+		}
+		cur, err := parseCurrencyFlags(m.optionArgs())
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+
+		items, err := m.formLineItem(supplier, 1, false)
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
 		}
 
 		company, err := m.client.GetCompany()
@@ -299,14 +318,24 @@ func (m Model) submitCreatePO() tea.Cmd {
 			return formSubmittedMsg{false, err.Error()}
 		}
 
-		today := time.Now().Format("2006-01-02")
+		date, err := m.formDate(3)
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
 
 		body := map[string]interface{}{
 			"supplier":         supplier,
-			"transaction_date": today,
-			"schedule_date":    today,
+			"transaction_date": date,
+			"schedule_date":    date,
 			"company":          company,
-			"items":            []interface{}{},
+			"items":            items,
+		}
+		if _, err := m.client.applyCurrency(body, cur, date); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		terms := parseTermsFlags(m.optionArgs())
+		if _, err := m.client.applyTerms(body, "Purchase Order", terms, false); err != nil {
+			return formSubmittedMsg{false, err.Error()}
 		}
 
 		result, err := m.client.Request("POST", "Purchase%20Order", body)
@@ -315,10 +344,10 @@ func (m Model) submitCreatePO() tea.Cmd {
 		}
 
 		if data, ok := result["data"].(map[string]interface{}); ok {
-			return formSubmittedMsg{true, fmt.Sprintf("PO created: %s", data["name"])}
+			return formSubmittedMsg{true, m.finishNote(tf("PO created: %s", data["name"]) + m.formRemarks("Purchase Order", data, terms))}
 		}
 
-		return formSubmittedMsg{false, "Failed to create PO"}
+		return formSubmittedMsg{false, T("Failed to create PO")}
 	}
 }
 
@@ -327,14 +356,14 @@ func (m *Model) initAddPOItemForm() {
 	m.inputs = make([]textinput.Model, 3)
 
 	m.inputs[0] = textinput.New()
-	m.inputs[0].Placeholder = "Item Code"
+	m.inputs[0].Placeholder = T("Item Code")
 	m.inputs[0].Focus()
 
 	m.inputs[1] = textinput.New()
-	m.inputs[1].Placeholder = "Quantity"
+	m.inputs[1].Placeholder = T("Quantity")
 
 	m.inputs[2] = textinput.New()
-	m.inputs[2].Placeholder = "Rate (optional)"
+	m.inputs[2].Placeholder = T("Rate (optional)")
 
 	m.focusIndex = 0
 }
@@ -342,12 +371,12 @@ func (m *Model) initAddPOItemForm() {
 // renderAddPOItem renders the add PO item form
 func (m Model) renderAddPOItem() string {
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Add Item to PO: "+m.selectedItem) + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Add Item to PO: ")+m.selectedItem) + "\n\n")
 
-	labels := []string{"Item Code:", "Quantity:", "Rate:"}
-	for i, input := range m.inputs {
+	labels := []string{T("Item Code:"), T("Quantity:"), T("Rate:")}
+	for i := range m.inputs {
 		b.WriteString(fmt.Sprintf("  %s\n", labels[i]))
-		b.WriteString(fmt.Sprintf("  %s\n\n", input.View()))
+		b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(i)))
 	}
 
 	return boxStyle.Render(b.String())
@@ -361,12 +390,12 @@ func (m Model) submitAddPOItem() tea.Cmd {
 		rateStr := m.inputs[2].Value()
 
 		if itemCode == "" || qtyStr == "" {
-			return formSubmittedMsg{false, "Item code and quantity are required"}
+			return formSubmittedMsg{false, T("Item code and quantity are required")}
 		}
 
 		qty, err := strconv.ParseFloat(qtyStr, 64)
 		if err != nil {
-			return formSubmittedMsg{false, "Invalid quantity"}
+			return formSubmittedMsg{false, T("Invalid quantity")}
 		}
 
 		rate := 0.0
@@ -384,13 +413,16 @@ func (m Model) submitAddPOItem() tea.Cmd {
 
 		data, ok := result["data"].(map[string]interface{})
 		if !ok {
-			return formSubmittedMsg{false, "PO not found"}
+			return formSubmittedMsg{false, T("PO not found")}
 		}
 
 		// Check if draft
 		docStatus, _ := data["docstatus"].(float64)
 		if docStatus != 0 {
-			return formSubmittedMsg{false, "Cannot add items to submitted/cancelled PO"}
+			return formSubmittedMsg{false, T("Cannot add items to submitted/cancelled PO")}
+		}
+		if err := checkUnchanged("Purchase Order", m.itemData, data); err != nil {
+			return formSubmittedMsg{false, err.Error()}
 		}
 
 		// Get existing items
@@ -419,12 +451,12 @@ func (m Model) submitAddPOItem() tea.Cmd {
 			"items": existingItems,
 		}
 
-		_, err = m.client.Request("PUT", "Purchase%20Order/"+encoded, body)
+		err = m.client.updateDraft("Purchase Order", poName, data, body)
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
 
-		return formSubmittedMsg{true, fmt.Sprintf("Item added to PO: %s", poName)}
+		return formSubmittedMsg{true, tf("Item added to PO: %s", poName)}
 	}
 }
 
@@ -435,45 +467,21 @@ func (m Model) submitPO(name string) tea.Cmd {
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
-		return formSubmittedMsg{true, fmt.Sprintf("PO submitted: %s", name)}
+		return formSubmittedMsg{true, tf("PO submitted: %s", name)}
 	}
 }
 
 // cancelPO cancels a purchase order
 func (m Model) cancelPO(name string) tea.Cmd {
 	return func() tea.Msg {
-		err := m.client.cancelDocument("Purchase Order", name)
-		if err != nil {
+		if err := m.client.checkCancelChain("Purchase Order", name); err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
-		return formSubmittedMsg{true, fmt.Sprintf("PO cancelled: %s", name)}
-	}
-}
-
-// loadPurchaseInvoices fetches all purchase invoices
-func (m Model) loadPurchaseInvoices() tea.Cmd {
-	return func() tea.Msg {
-		result, err := m.client.Request("GET", "Purchase%20Invoice?limit_page_length=100&fields=[\"name\",\"supplier\",\"posting_date\",\"status\",\"grand_total\",\"docstatus\"]&order_by=creation%20desc", nil)
+		err := m.client.cancelDocument("Purchase Order", name)
 		if err != nil {
-			return errorMsg{err}
-		}
-
-		var items []ListItem
-		if data, ok := result["data"].([]interface{}); ok {
-			for _, item := range data {
-				if im, ok := item.(map[string]interface{}); ok {
-					name := fmt.Sprintf("%v", im["name"])
-					supplier := fmt.Sprintf("%v", im["supplier"])
-					status, _ := im["status"].(string)
-					total, _ := im["grand_total"].(float64)
-
-					statusBadge := renderStatusBadge(status)
-					detail := fmt.Sprintf("%s | %s | %s", supplier, statusBadge, m.client.FormatCurrency(total))
-					items = append(items, ListItem{name: name, details: detail, amount: total, status: status})
-				}
-			}
+			return formSubmittedMsg{false, err.Error()}
 		}
-		return dataLoadedMsg{items}
+		return formSubmittedMsg{true, tf("PO cancelled: %s", name)}
 	}
 }
 
@@ -489,7 +497,7 @@ func (m Model) loadPIDetail(name string) tea.Cmd {
 		if data, ok := result["data"].(map[string]interface{}); ok {
 			return itemDetailMsg{data}
 		}
-		return errorMsg{fmt.Errorf("no data found")}
+		return errorMsg{errorf("no data found")}
 	}
 }
 
@@ -500,15 +508,15 @@ func (m Model) renderPIDetail() string {
 	}
 
 	if m.itemData == nil {
-		return "\n  No data"
+		return "\n  " + T("No data")
 	}
 
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Purchase Invoice: "+m.selectedItem) + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Purchase Invoice: ")+m.selectedItem) + "\n\n")
 
 	// Basic info
-	b.WriteString(fmt.Sprintf("  Supplier: %v\n", m.itemData["supplier"]))
-	b.WriteString(fmt.Sprintf("  Date: %v\n", m.itemData["posting_date"]))
+	b.WriteString(tf("  Supplier: %v\n", m.itemData["supplier"]))
+	b.WriteString(tf("  Date: %v\n", m.itemData["posting_date"]))
 
 	status, _ := m.itemData["status"].(string)
 	statusStyle := helpStyle
@@ -522,18 +530,17 @@ func (m Model) renderPIDetail() string {
 	case "Cancelled":
 		statusStyle = errorStyle
 	}
-	b.WriteString(fmt.Sprintf("  Status: %s\n", statusStyle.Render(status)))
+	b.WriteString(tf("  Status: %s\n", statusStyle.Render(status)))
 
-	grandTotal, _ := m.itemData["grand_total"].(float64)
-	b.WriteString(fmt.Sprintf("  Total: %s\n", m.client.FormatCurrency(grandTotal)))
+	b.WriteString(tf("  Total: %s\n", m.client.docTotal(m.itemData)))
 
 	if outstanding, ok := m.itemData["outstanding_amount"].(float64); ok && outstanding > 0 {
-		b.WriteString(fmt.Sprintf("  Outstanding: %s\n", errorStyle.Render(m.client.FormatCurrency(outstanding))))
+		b.WriteString(tf("  Outstanding: %s\n", errorStyle.Render(m.client.FormatCurrencyIn(outstanding, stringField(m.itemData, "party_account_currency")))))
 	}
 
 	// Items
 	if items, ok := m.itemData["items"].([]interface{}); ok && len(items) > 0 {
-		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render("Items:")))
+		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render(T("Items:"))))
 		for _, item := range items {
 			if im, ok := item.(map[string]interface{}); ok {
 				itemCode := fmt.Sprintf("%v", im["item_code"])
@@ -542,38 +549,51 @@ func (m Model) renderPIDetail() string {
 				amount, _ := im["amount"].(float64)
 				po := im["purchase_order"]
 
-				line := fmt.Sprintf("    - %s: %.0f x %s = %s", itemCode, qty, m.client.FormatCurrency(rate), m.client.FormatCurrency(amount))
+				line := fmt.Sprintf("    - %s: %.0f x %s = %s", itemCode, qty, m.client.formatDoc(m.itemData, rate), m.client.formatDoc(m.itemData, amount))
 				if po != nil && po != "" {
-					line += fmt.Sprintf(" (PO: %s)", po)
+					line += tf(" (PO: %s)", po)
 				}
 				b.WriteString(line + "\n")
 			}
 		}
 	}
 
+	if lines := docTermsLines(m.itemData); len(lines) > 0 {
+		b.WriteString("\n")
+		for _, line := range lines {
+			b.WriteString(line + "\n")
+		}
+	}
+
 	return boxStyle.Render(b.String())
 }
 
 // initCreatePIForm initializes the create PI from PO form
 func (m *Model) initCreatePIForm() {
-	m.inputs = make([]textinput.Model, 1)
+	m.inputs = make([]textinput.Model, 2)
 
 	m.inputs[0] = textinput.New()
-	m.inputs[0].Placeholder = "Purchase Order Name (e.g., PUR-ORD-2025-00001)"
+	m.inputs[0].Placeholder = T("Purchase Order Name (e.g., PUR-ORD-2025-00001)")
 	m.inputs[0].Focus()
 
+	m.inputs[1] = newDateInput()
+	m.addOptionInputs(ViewCreatePI)
+
 	m.focusIndex = 0
 }
 
 // renderCreatePI renders the create PI form
 func (m Model) renderCreatePI() string {
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Create Invoice from PO ") + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Create Invoice from PO ")) + "\n\n")
 
-	b.WriteString("  Purchase Order:\n")
+	b.WriteString(T("  Purchase Order:\n"))
 	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputs[0].View()))
 
-	b.WriteString(helpStyle.Render("  Enter the name of a submitted Purchase Order"))
+	b.WriteString(m.renderDateInput(1))
+	b.WriteString(m.renderOptionInputs())
+
+	b.WriteString(helpStyle.Render(trHelp("  Enter the name of a submitted Purchase Order")))
 
 	return boxStyle.Render(b.String())
 }
@@ -584,71 +604,37 @@ func (m Model) submitCreatePI() tea.Cmd {
 		poName := m.inputs[0].Value()
 
 		if poName == "" {
-			return formSubmittedMsg{false, "Purchase Order name is required"}
+			return formSubmittedMsg{false, T("Purchase Order name is required")}
 		}
 
-		// Get the PO
-		encoded := url.PathEscape(poName)
-		result, err := m.client.Request("GET", "Purchase%20Order/"+encoded, nil)
+		cur, err := parseCurrencyFlags(m.optionArgs())
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
 
-		poData, ok := result["data"].(map[string]interface{})
-		if !ok {
-			return formSubmittedMsg{false, "Purchase order not found"}
-		}
-
-		// Check if submitted
-		docStatus, _ := poData["docstatus"].(float64)
-		if docStatus != 1 {
-			return formSubmittedMsg{false, "Purchase order must be submitted first"}
-		}
-
-		company, err := m.client.GetCompany()
+		// Get the PO
+		body, err := m.client.piFromPO(poName, m.formPosting(1))
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
-
-		today := time.Now().Format("2006-01-02")
-
-		// Build invoice items from PO items
-		var invoiceItems []map[string]interface{}
-		if items, ok := poData["items"].([]interface{}); ok {
-			for _, item := range items {
-				if im, ok := item.(map[string]interface{}); ok {
-					invoiceItems = append(invoiceItems, map[string]interface{}{
-						"item_code":      im["item_code"],
-						"qty":            im["qty"],
-						"rate":           im["rate"],
-						"purchase_order": poName,
-						"po_detail":      im["name"],
-					})
-				}
-			}
-		}
-
-		if len(invoiceItems) == 0 {
-			return formSubmittedMsg{false, "No items found in purchase order"}
+		if _, err := m.client.overrideCurrency(body, cur); err != nil {
+			return formSubmittedMsg{false, err.Error()}
 		}
-
-		body := map[string]interface{}{
-			"supplier":     poData["supplier"],
-			"posting_date": today,
-			"company":      company,
-			"items":        invoiceItems,
+		terms := parseTermsFlags(m.optionArgs())
+		if _, err := m.client.applyTerms(body, "Purchase Invoice", terms, false); err != nil {
+			return formSubmittedMsg{false, err.Error()}
 		}
 
-		result, err = m.client.Request("POST", "Purchase%20Invoice", body)
+		result, err := m.client.Request("POST", "Purchase%20Invoice", body)
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
 
 		if data, ok := result["data"].(map[string]interface{}); ok {
-			return formSubmittedMsg{true, fmt.Sprintf("Invoice created: %s", data["name"])}
+			return formSubmittedMsg{true, tf("Invoice created: %s", data["name"]) + m.formRemarks("Purchase Invoice", data, terms)}
 		}
 
-		return formSubmittedMsg{false, "Failed to create invoice"}
+		return formSubmittedMsg{false, T("Failed to create invoice")}
 	}
 }
 
@@ -659,45 +645,21 @@ func (m Model) submitPI(name string) tea.Cmd {
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
-		return formSubmittedMsg{true, fmt.Sprintf("Invoice submitted: %s", name)}
+		return formSubmittedMsg{true, tf("Invoice submitted: %s", name)}
 	}
 }
 
 // cancelPI cancels a purchase invoice
 func (m Model) cancelPI(name string) tea.Cmd {
 	return func() tea.Msg {
-		err := m.client.cancelDocument("Purchase Invoice", name)
-		if err != nil {
+		if err := m.client.checkCancelChain("Purchase Invoice", name); err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
-		return formSubmittedMsg{true, fmt.Sprintf("Invoice cancelled: %s", name)}
-	}
-}
-
-// loadPurchaseReceipts fetches all purchase receipts
-func (m Model) loadPurchaseReceipts() tea.Cmd {
-	return func() tea.Msg {
-		result, err := m.client.Request("GET", "Purchase%20Receipt?limit_page_length=100&fields=[\"name\",\"supplier\",\"posting_date\",\"status\",\"grand_total\",\"docstatus\"]&order_by=creation%20desc", nil)
+		err := m.client.cancelDocument("Purchase Invoice", name)
 		if err != nil {
-			return errorMsg{err}
-		}
-
-		var items []ListItem
-		if data, ok := result["data"].([]interface{}); ok {
-			for _, item := range data {
-				if im, ok := item.(map[string]interface{}); ok {
-					name := fmt.Sprintf("%v", im["name"])
-					supplier := fmt.Sprintf("%v", im["supplier"])
-					status, _ := im["status"].(string)
-					total, _ := im["grand_total"].(float64)
-
-					statusBadge := renderStatusBadge(status)
-					detail := fmt.Sprintf("%s | %s | %s", supplier, statusBadge, m.client.FormatCurrency(total))
-					items = append(items, ListItem{name: name, details: detail, amount: total, status: status})
-				}
-			}
+			return formSubmittedMsg{false, err.Error()}
 		}
-		return dataLoadedMsg{items}
+		return formSubmittedMsg{true, tf("Invoice cancelled: %s", name)}
 	}
 }
 
@@ -713,7 +675,7 @@ func (m Model) loadPRDetail(name string) tea.Cmd {
 		if data, ok := result["data"].(map[string]interface{}); ok {
 			return itemDetailMsg{data}
 		}
-		return errorMsg{fmt.Errorf("no data found")}
+		return errorMsg{errorf("no data found")}
 	}
 }
 
@@ -724,14 +686,14 @@ func (m Model) renderPRDetail() string {
 	}
 
 	if m.itemData == nil {
-		return "\n  No data"
+		return "\n  " + T("No data")
 	}
 
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Purchase Receipt: "+m.selectedItem) + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Purchase Receipt: ")+m.selectedItem) + "\n\n")
 
-	b.WriteString(fmt.Sprintf("  Supplier: %v\n", m.itemData["supplier"]))
-	b.WriteString(fmt.Sprintf("  Date: %v\n", m.itemData["posting_date"]))
+	b.WriteString(tf("  Supplier: %v\n", m.itemData["supplier"]))
+	b.WriteString(tf("  Date: %v\n", m.itemData["posting_date"]))
 
 	status, _ := m.itemData["status"].(string)
 	statusStyle := helpStyle
@@ -743,13 +705,12 @@ func (m Model) renderPRDetail() string {
 	case "Cancelled", "Return Issued":
 		statusStyle = errorStyle
 	}
-	b.WriteString(fmt.Sprintf("  Status: %s\n", statusStyle.Render(status)))
+	b.WriteString(tf("  Status: %s\n", statusStyle.Render(status)))
 
-	grandTotal, _ := m.itemData["grand_total"].(float64)
-	b.WriteString(fmt.Sprintf("  Total: %s\n", m.client.FormatCurrency(grandTotal)))
+	b.WriteString(tf("  Total: %s\n", m.client.docTotal(m.itemData)))
 
 	if items, ok := m.itemData["items"].([]interface{}); ok && len(items) > 0 {
-		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render("Items:")))
+		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render(T("Items:"))))
 		for _, item := range items {
 			if im, ok := item.(map[string]interface{}); ok {
 				itemCode := fmt.Sprintf("%v", im["item_code"])
@@ -758,38 +719,51 @@ func (m Model) renderPRDetail() string {
 				amount, _ := im["amount"].(float64)
 				po := im["purchase_order"]
 
-				line := fmt.Sprintf("    - %s: %.0f x %s = %s", itemCode, qty, m.client.FormatCurrency(rate), m.client.FormatCurrency(amount))
+				line := fmt.Sprintf("    - %s: %.0f x %s = %s", itemCode, qty, m.client.formatDoc(m.itemData, rate), m.client.formatDoc(m.itemData, amount))
 				if po != nil && po != "" {
-					line += fmt.Sprintf(" (PO: %s)", po)
+					line += tf(" (PO: %s)", po)
 				}
 				b.WriteString(line + "\n")
 			}
 		}
 	}
 
+	if lines := docTermsLines(m.itemData); len(lines) > 0 {
+		b.WriteString("\n")
+		for _, line := range lines {
+			b.WriteString(line + "\n")
+		}
+	}
+
 	return boxStyle.Render(b.String())
 }
 
 // initCreatePRForm initializes the create PR from PO form
 func (m *Model) initCreatePRForm() {
-	m.inputs = make([]textinput.Model, 1)
+	m.inputs = make([]textinput.Model, 2)
 
 	m.inputs[0] = textinput.New()
-	m.inputs[0].Placeholder = "Purchase Order Name (e.g., PUR-ORD-2025-00001)"
+	m.inputs[0].Placeholder = T("Purchase Order Name (e.g., PUR-ORD-2025-00001)")
 	m.inputs[0].Focus()
 
+	m.inputs[1] = newDateInput()
+	m.addOptionInputs(ViewCreatePR)
+
 	m.focusIndex = 0
 }
 
 // renderCreatePR renders the create PR form
 func (m Model) renderCreatePR() string {
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Create Purchase Receipt from PO ") + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Create Purchase Receipt from PO ")) + "\n\n")
 
-	b.WriteString("  Purchase Order:\n")
+	b.WriteString(T("  Purchase Order:\n"))
 	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputs[0].View()))
 
-	b.WriteString(helpStyle.Render("  Enter the name of a submitted Purchase Order"))
+	b.WriteString(m.renderDateInput(1))
+	b.WriteString(m.renderOptionInputs())
+
+	b.WriteString(helpStyle.Render(trHelp("  Enter the name of a submitted Purchase Order")))
 
 	return boxStyle.Render(b.String())
 }
@@ -800,68 +774,28 @@ func (m Model) submitCreatePR() tea.Cmd {
 		poName := m.inputs[0].Value()
 
 		if poName == "" {
-			return formSubmittedMsg{false, "Purchase Order name is required"}
+			return formSubmittedMsg{false, T("Purchase Order name is required")}
 		}
 
-		encoded := url.PathEscape(poName)
-		result, err := m.client.Request("GET", "Purchase%20Order/"+encoded, nil)
+		body, err := m.client.prFromPO(poName, m.formPosting(1))
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
-
-		poData, ok := result["data"].(map[string]interface{})
-		if !ok {
-			return formSubmittedMsg{false, "Purchase order not found"}
-		}
-
-		docStatus, _ := poData["docstatus"].(float64)
-		if docStatus != 1 {
-			return formSubmittedMsg{false, "Purchase order must be submitted first"}
-		}
-
-		company, err := m.client.GetCompany()
-		if err != nil {
+		terms := parseTermsFlags(m.optionArgs())
+		if _, err := m.client.applyTerms(body, "Purchase Receipt", terms, false); err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
 
-		today := time.Now().Format("2006-01-02")
-
-		var prItems []map[string]interface{}
-		if items, ok := poData["items"].([]interface{}); ok {
-			for _, item := range items {
-				if im, ok := item.(map[string]interface{}); ok {
-					prItems = append(prItems, map[string]interface{}{
-						"item_code":           im["item_code"],
-						"qty":                 im["qty"],
-						"rate":                im["rate"],
-						"purchase_order":      poName,
-						"purchase_order_item": im["name"],
-					})
-				}
-			}
-		}
-
-		if len(prItems) == 0 {
-			return formSubmittedMsg{false, "No items found in purchase order"}
-		}
-
-		body := map[string]interface{}{
-			"supplier":     poData["supplier"],
-			"posting_date": today,
-			"company":      company,
-			"items":        prItems,
-		}
-
-		result, err = m.client.Request("POST", "Purchase%20Receipt", body)
+		result, err := m.client.Request("POST", "Purchase%20Receipt", body)
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
 
 		if data, ok := result["data"].(map[string]interface{}); ok {
-			return formSubmittedMsg{true, fmt.Sprintf("Purchase Receipt created: %s", data["name"])}
+			return formSubmittedMsg{true, tf("Purchase Receipt created: %s", data["name"]) + m.formRemarks("Purchase Receipt", data, terms)}
 		}
 
-		return formSubmittedMsg{false, "Failed to create purchase receipt"}
+		return formSubmittedMsg{false, T("Failed to create purchase receipt")}
 	}
 }
 
@@ -872,18 +806,21 @@ func (m Model) submitPR(name string) tea.Cmd {
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
-		return formSubmittedMsg{true, fmt.Sprintf("Purchase Receipt submitted: %s", name)}
+		return formSubmittedMsg{true, tf("Purchase Receipt submitted: %s", name)}
 	}
 }
 
 // cancelPR cancels a purchase receipt
 func (m Model) cancelPR(name string) tea.Cmd {
 	return func() tea.Msg {
+		if err := m.client.checkCancelChain("Purchase Receipt", name); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
 		err := m.client.cancelDocument("Purchase Receipt", name)
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
-		return formSubmittedMsg{true, fmt.Sprintf("Purchase Receipt cancelled: %s", name)}
+		return formSubmittedMsg{true, tf("Purchase Receipt cancelled: %s", name)}
 	}
 }
 
@@ -929,7 +866,7 @@ func (m *Model) handlePurchasingKeys(key string) (tea.Model, tea.Cmd) {
 			if m.itemData != nil {
 				if docStatus, ok := m.itemData["docstatus"].(float64); ok && docStatus == 0 {
 					m.confirmAction = "submit_po"
-					m.confirmMsg = fmt.Sprintf("Submit PO %s?", m.selectedItem)
+					m.confirmMsg = tf("Submit PO %s?", m.selectedItem)
 					m.prevView = m.view
 					m.view = ViewConfirmAction
 					return m, nil
@@ -940,7 +877,7 @@ func (m *Model) handlePurchasingKeys(key string) (tea.Model, tea.Cmd) {
 			if m.itemData != nil {
 				if docStatus, ok := m.itemData["docstatus"].(float64); ok && docStatus == 1 {
 					m.confirmAction = "cancel_po"
-					m.confirmMsg = fmt.Sprintf("Cancel PO %s?", m.selectedItem)
+					m.confirmMsg = tf("Cancel PO %s?", m.selectedItem)
 					m.prevView = m.view
 					m.view = ViewConfirmAction
 					return m, nil
@@ -983,7 +920,7 @@ func (m *Model) handlePurchasingKeys(key string) (tea.Model, tea.Cmd) {
 			if m.itemData != nil {
 				if docStatus, ok := m.itemData["docstatus"].(float64); ok && docStatus == 0 {
 					m.confirmAction = "submit_pr"
-					m.confirmMsg = fmt.Sprintf("Submit Purchase Receipt %s?", m.selectedItem)
+					m.confirmMsg = tf("Submit Purchase Receipt %s?", m.selectedItem)
 					m.prevView = m.view
 					m.view = ViewConfirmAction
 					return m, nil
@@ -994,7 +931,7 @@ func (m *Model) handlePurchasingKeys(key string) (tea.Model, tea.Cmd) {
 			if m.itemData != nil {
 				if docStatus, ok := m.itemData["docstatus"].(float64); ok && docStatus == 1 {
 					m.confirmAction = "cancel_pr"
-					m.confirmMsg = fmt.Sprintf("Cancel Purchase Receipt %s?", m.selectedItem)
+					m.confirmMsg = tf("Cancel Purchase Receipt %s?", m.selectedItem)
 					m.prevView = m.view
 					m.view = ViewConfirmAction
 					return m, nil
@@ -1017,7 +954,7 @@ func (m *Model) handlePurchasingKeys(key string) (tea.Model, tea.Cmd) {
 			if m.itemData != nil {
 				if docStatus, ok := m.itemData["docstatus"].(float64); ok && docStatus == 0 {
 					m.confirmAction = "submit_pi"
-					m.confirmMsg = fmt.Sprintf("Submit Invoice %s?", m.selectedItem)
+					m.confirmMsg = tf("Submit Invoice %s?", m.selectedItem)
 					m.prevView = m.view
 					m.view = ViewConfirmAction
 					return m, nil
@@ -1028,7 +965,7 @@ func (m *Model) handlePurchasingKeys(key string) (tea.Model, tea.Cmd) {
 			if m.itemData != nil {
 				if docStatus, ok := m.itemData["docstatus"].(float64); ok && docStatus == 1 {
 					m.confirmAction = "cancel_pi"
-					m.confirmMsg = fmt.Sprintf("Cancel Invoice %s?", m.selectedItem)
+					m.confirmMsg = tf("Cancel Invoice %s?", m.selectedItem)
 					m.prevView = m.view
 					m.view = ViewConfirmAction
 					return m, nil
@@ -0,0 +1,117 @@
+package erp
+
+import (
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ============================================================================
+// AUTO-REFRESH (ERP_TUI_REFRESH reloads lists and the dashboard periodically)
+// ============================================================================
+
+// minRefreshInterval keeps a small ERP_TUI_REFRESH from flooding the server
+const minRefreshInterval = 5 * time.Second
+
+type refreshTickMsg struct{}
+
+// refreshedMsg is the result of a background reload of view
+type refreshedMsg struct {
+	view View
+	msg  tea.Msg
+}
+
+// refreshInterval is the auto-refresh interval, zero when it is off
+func (m Model) refreshInterval() time.Duration {
+	if m.client.Config.RefreshSeconds <= 0 {
+		return 0
+	}
+	interval := time.Duration(m.client.Config.RefreshSeconds) * time.Second
+	if interval < minRefreshInterval {
+		interval = minRefreshInterval
+	}
+	return interval
+}
+
+// scheduleRefresh starts the wait for the next auto-refresh
+func (m Model) scheduleRefresh() tea.Cmd {
+	interval := m.refreshInterval()
+	if interval == 0 {
+		return nil
+	}
+	return tea.Tick(interval, func(time.Time) tea.Msg { return refreshTickMsg{} })
+}
+
+// refreshable reports whether the view is one auto-refresh reloads
+func (m Model) refreshable() bool {
+	switch m.view {
+	case ViewDashboard, ViewAttributes, ViewItems, ViewTemplates, ViewGroups, ViewBrands,
+		ViewWarehouses, ViewStock, ViewSerials, ViewSuppliers, ViewCustomers,
+		ViewQuotations, ViewSalesOrders, ViewSalesInvoices, ViewDeliveryNotes,
+		ViewPurchaseOrders, ViewPurchaseInvoices, ViewPurchaseReceipts, ViewPayments,
+		ViewInbox, ViewWorkOrders, ViewBOMs, ViewSubscriptions, ViewEmployees, ViewExpenseClaims:
+		return true
+	}
+	return false
+}
+
+// backgroundRefresh reloads the current view without the loading screen.
+// It waits while the user is busy: loading, searching, filtering, in the
+// palette or the notification log, or running a batch action.
+func (m Model) backgroundRefresh() (tea.Model, tea.Cmd) {
+	next := m.scheduleRefresh()
+	if !m.refreshable() || m.loading || m.palette != nil || m.actionLog.open || m.batch != nil || m.listSearching ||
+		m.currentList.FilterState() != list.Unfiltered {
+		return m, next
+	}
+	_, load := m.refreshCurrentView()
+	if load == nil {
+		return m, next
+	}
+	view := m.view
+	return m, tea.Batch(next, func() tea.Msg {
+		return refreshedMsg{view, load()}
+	})
+}
+
+// applyRefresh shows a background reload, keeping the cursor on the same
+// row (or at the same place if the row is gone) and the dashboard's scroll
+func (m Model) applyRefresh(msg refreshedMsg) (tea.Model, tea.Cmd) {
+	// The user moved on, or reloaded by hand, while it loaded
+	if msg.view != m.view || m.loading {
+		return m, nil
+	}
+
+	var name string
+	if item, ok := m.currentList.SelectedItem().(ListItem); ok {
+		name = item.name
+	}
+	index, offset := m.currentList.Index(), m.viewport.YOffset
+
+	result, cmd := m.update(msg.msg)
+	next, ok := result.(Model)
+	if !ok {
+		return result, cmd
+	}
+	if m.view == ViewDashboard {
+		next.viewport.SetYOffset(offset)
+		return next, cmd
+	}
+
+	if count := len(next.currentList.Items()); index >= count {
+		index = count - 1
+	}
+	next.currentList.Select(index)
+	for i, item := range next.currentList.Items() {
+		if li, ok := item.(ListItem); ok && li.name == name {
+			next.currentList.Select(i)
+			break
+		}
+	}
+	// The board keeps its focused column and row
+	if next.boardMode && next.isListView() {
+		next.syncBoardSelection()
+	}
+	return next, cmd
+}
@@ -49,9 +49,19 @@ func (m Model) loadCustomerDetail(name string) tea.Cmd {
 		}
 
 		if data, ok := result["data"].(map[string]interface{}); ok {
+			if m.client.LowBandwidth() {
+				return itemDetailMsg{data}
+			}
+			// Linked addresses and contacts live in their own doctypes
+			if addresses, err := m.client.fetchPartyAddresses("Customer", name); err == nil {
+				data["_addresses"] = addresses
+			}
+			if contacts, err := m.client.fetchPartyContacts("Customer", name); err == nil {
+				data["_contacts"] = contacts
+			}
 			return itemDetailMsg{data}
 		}
-		return errorMsg{fmt.Errorf("no data found")}
+		return errorMsg{errorf("no data found")}
 	}
 }
 
@@ -62,11 +72,11 @@ func (m Model) renderCustomerDetail() string {
 	}
 
 	if m.itemData == nil {
-		return "\n  No data"
+		return "\n  " + T("No data")
 	}
 
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Customer: "+m.selectedItem) + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Customer: ")+m.selectedItem) + "\n\n")
 
 	fields := []struct {
 		key   string
@@ -81,14 +91,16 @@ func (m Model) renderCustomerDetail() string {
 
 	for _, f := range fields {
 		if val, ok := m.itemData[f.key]; ok && val != nil && val != "" {
-			b.WriteString(fmt.Sprintf("  %s: %v\n", f.label, val))
+			b.WriteString(fmt.Sprintf("  %s: %v\n", T(f.label), val))
 		}
 	}
 
 	if disabled, ok := m.itemData["disabled"]; ok && disabled == float64(1) {
-		b.WriteString(fmt.Sprintf("\n  %s\n", errorStyle.Render("DISABLED")))
+		b.WriteString(fmt.Sprintf("\n  %s\n", errorStyle.Render(T("DISABLED"))))
 	}
 
+	b.WriteString(m.renderPartyLinks())
+
 	return boxStyle.Render(b.String())
 }
 
@@ -97,14 +109,16 @@ func (m *Model) initCreateCustomerForm() {
 	m.inputs = make([]textinput.Model, 3)
 
 	m.inputs[0] = textinput.New()
-	m.inputs[0].Placeholder = "Customer Name"
+	m.inputs[0].Placeholder = T("Customer Name")
 	m.inputs[0].Focus()
 
 	m.inputs[1] = textinput.New()
-	m.inputs[1].Placeholder = "Customer Group (optional)"
+	m.inputs[1].Placeholder = T("Customer Group (optional)")
+	m.inputs[1].SetValue(m.client.Config.DefaultCustomerGroup)
 
 	m.inputs[2] = textinput.New()
-	m.inputs[2].Placeholder = "Territory (optional)"
+	m.inputs[2].Placeholder = T("Territory (optional)")
+	m.inputs[2].SetValue(m.client.Config.DefaultTerritory)
 
 	m.focusIndex = 0
 }
@@ -112,9 +126,9 @@ func (m *Model) initCreateCustomerForm() {
 // renderCreateCustomer renders the create customer form
 func (m Model) renderCreateCustomer() string {
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Create Customer ") + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Create Customer ")) + "\n\n")
 
-	labels := []string{"Name:", "Group:", "Territory:"}
+	labels := []string{T("Name:"), T("Group:"), T("Territory:")}
 	for i, input := range m.inputs {
 		b.WriteString(fmt.Sprintf("  %s\n", labels[i]))
 		b.WriteString(fmt.Sprintf("  %s\n\n", input.View()))
@@ -131,7 +145,7 @@ func (m Model) submitCreateCustomer() tea.Cmd {
 		territory := m.inputs[2].Value()
 
 		if name == "" {
-			return formSubmittedMsg{false, "Customer name is required"}
+			return formSubmittedMsg{false, T("Customer name is required")}
 		}
 
 		body := map[string]interface{}{
@@ -150,10 +164,10 @@ func (m Model) submitCreateCustomer() tea.Cmd {
 		}
 
 		if data, ok := result["data"].(map[string]interface{}); ok {
-			return formSubmittedMsg{true, fmt.Sprintf("Customer created: %s", data["name"])}
+			return formSubmittedMsg{true, tf("Customer created: %s", data["name"])}
 		}
 
-		return formSubmittedMsg{false, "Failed to create customer"}
+		return formSubmittedMsg{false, T("Failed to create customer")}
 	}
 }
 
@@ -165,34 +179,7 @@ func (m Model) deleteCustomer(name string) tea.Cmd {
 		if err != nil {
 			return errorMsg{err}
 		}
-		return actionDoneMsg{fmt.Sprintf("Deleted: %s", name)}
-	}
-}
-
-// loadQuotations fetches all quotations
-func (m Model) loadQuotations() tea.Cmd {
-	return func() tea.Msg {
-		result, err := m.client.Request("GET", "Quotation?limit_page_length=100&fields=[\"name\",\"party_name\",\"transaction_date\",\"status\",\"grand_total\",\"docstatus\"]&order_by=creation%20desc", nil)
-		if err != nil {
-			return errorMsg{err}
-		}
-
-		var items []ListItem
-		if data, ok := result["data"].([]interface{}); ok {
-			for _, item := range data {
-				if im, ok := item.(map[string]interface{}); ok {
-					name := fmt.Sprintf("%v", im["name"])
-					customer := fmt.Sprintf("%v", im["party_name"])
-					status, _ := im["status"].(string)
-					total, _ := im["grand_total"].(float64)
-
-					statusBadge := renderStatusBadge(status)
-					detail := fmt.Sprintf("%s | %s | %s", customer, statusBadge, m.client.FormatCurrency(total))
-					items = append(items, ListItem{name: name, details: detail, amount: total, status: status})
-				}
-			}
-		}
-		return dataLoadedMsg{items}
+		return actionDoneMsg{tf("Deleted: %s", name)}
 	}
 }
 
@@ -208,7 +195,7 @@ func (m Model) loadQuotationDetail(name string) tea.Cmd {
 		if data, ok := result["data"].(map[string]interface{}); ok {
 			return itemDetailMsg{data}
 		}
-		return errorMsg{fmt.Errorf("no data found")}
+		return errorMsg{errorf("no data found")}
 	}
 }
 
@@ -219,15 +206,15 @@ func (m Model) renderQuotationDetail() string {
 	}
 
 	if m.itemData == nil {
-		return "\n  No data"
+		return "\n  " + T("No data")
 	}
 
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Quotation: "+m.selectedItem) + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Quotation: ")+m.selectedItem) + "\n\n")
 
-	b.WriteString(fmt.Sprintf("  Customer: %v\n", m.itemData["party_name"]))
-	b.WriteString(fmt.Sprintf("  Date: %v\n", m.itemData["transaction_date"]))
-	b.WriteString(fmt.Sprintf("  Valid Till: %v\n", m.itemData["valid_till"]))
+	b.WriteString(tf("  Customer: %v\n", m.itemData["party_name"]))
+	b.WriteString(tf("  Date: %v\n", m.itemData["transaction_date"]))
+	b.WriteString(tf("  Valid Till: %v\n", m.itemData["valid_till"]))
 
 	status, _ := m.itemData["status"].(string)
 	statusStyle := helpStyle
@@ -241,47 +228,75 @@ func (m Model) renderQuotationDetail() string {
 	case "Lost", "Cancelled", "Expired":
 		statusStyle = errorStyle
 	}
-	b.WriteString(fmt.Sprintf("  Status: %s\n", statusStyle.Render(status)))
+	b.WriteString(tf("  Status: %s\n", statusStyle.Render(status)))
 
-	grandTotal, _ := m.itemData["grand_total"].(float64)
-	b.WriteString(fmt.Sprintf("  Total: %s\n", m.client.FormatCurrency(grandTotal)))
+	b.WriteString(tf("  Total: %s\n", m.client.docTotal(m.itemData)))
+	for _, line := range m.client.docDiscountLines(m.itemData) {
+		b.WriteString(line + "\n")
+	}
 
 	if items, ok := m.itemData["items"].([]interface{}); ok && len(items) > 0 {
-		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render("Items:")))
+		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render(T("Items:"))))
 		for _, item := range items {
 			if im, ok := item.(map[string]interface{}); ok {
 				itemCode := fmt.Sprintf("%v", im["item_code"])
 				qty, _ := im["qty"].(float64)
 				rate, _ := im["rate"].(float64)
 				amount, _ := im["amount"].(float64)
-				b.WriteString(fmt.Sprintf("    - %s: %.0f x %s = %s\n", itemCode, qty, m.client.FormatCurrency(rate), m.client.FormatCurrency(amount)))
+				b.WriteString(fmt.Sprintf("    - %s%s: %.0f x %s = %s\n", itemCode, customerCodeSuffix(im), qty, m.client.formatDoc(m.itemData, rate), m.client.formatDoc(m.itemData, amount)))
 			}
 		}
 	}
 
+	if lines := docTermsLines(m.itemData); len(lines) > 0 {
+		b.WriteString("\n")
+		for _, line := range lines {
+			b.WriteString(line + "\n")
+		}
+	}
+
 	return boxStyle.Render(b.String())
 }
 
 // initCreateQuotationForm initializes the create quotation form
 func (m *Model) initCreateQuotationForm() {
-	m.inputs = make([]textinput.Model, 1)
+	m.inputs = make([]textinput.Model, 4)
 
 	m.inputs[0] = textinput.New()
-	m.inputs[0].Placeholder = "Customer Name"
+	m.inputs[0].Placeholder = T("Customer Name")
 	m.inputs[0].Focus()
 
+	m.inputs[1] = textinput.New()
+	m.inputs[1].Placeholder = T("Item Code (optional)")
+
+	m.inputs[2] = textinput.New()
+	m.inputs[2].Placeholder = T("Quantity (default 1)")
+
+	m.inputs[3] = newDateInput()
+	m.addOptionInputs(ViewCreateQuotation)
+
 	m.focusIndex = 0
+	m.noteID = 0
 }
 
 // renderCreateQuotation renders the create quotation form
 func (m Model) renderCreateQuotation() string {
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Create Quotation ") + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Create Quotation ")) + "\n\n")
 
-	b.WriteString("  Customer:\n")
-	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputs[0].View()))
+	b.WriteString(T("  Customer:\n"))
+	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(0)))
+
+	b.WriteString(T("  Item:\n"))
+	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(1)))
+
+	b.WriteString(T("  Quantity:\n"))
+	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(2)))
 
-	b.WriteString(helpStyle.Render("  After creation, add items with 'a' key"))
+	b.WriteString(m.renderDateInput(3))
+	b.WriteString(m.renderOptionInputs())
+
+	b.WriteString(helpStyle.Render(trHelp("  After creation, add items with 'a' key")))
 
 	return boxStyle.Render(b.String())
 }
@@ -292,7 +307,20 @@ func (m Model) submitCreateQuotation() tea.Cmd {
 		customer := m.inputs[0].Value()
 
 		if customer == "" {
-			return formSubmittedMsg{false, "Customer is required"}
+			return formSubmittedMsg{false, T("Customer is required")}
+		}
+		discount, err := parseDiscountFlags(m.optionArgs())
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		cur, err := parseCurrencyFlags(m.optionArgs())
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+
+		items, err := m.formLineItem(customer, 1, true)
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
 		}
 
 		company, err := m.client.GetCompany()
@@ -300,16 +328,30 @@ func (m Model) submitCreateQuotation() tea.Cmd {
 			return formSubmittedMsg{false, err.Error()}
 		}
 
-		today := time.Now().Format("2006-01-02")
-		validTill := time.Now().AddDate(0, 0, 30).Format("2006-01-02")
+		date, err := m.formDate(3)
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		start, _ := time.Parse("2006-01-02", date)
+		validTill := start.AddDate(0, 0, 30).Format("2006-01-02")
 
 		body := map[string]interface{}{
 			"quotation_to":     "Customer",
 			"party_name":       customer,
-			"transaction_date": today,
+			"transaction_date": date,
 			"valid_till":       validTill,
 			"company":          company,
-			"items":            []interface{}{},
+			"items":            items,
+		}
+		if err := m.client.applyDiscount(body, discount); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		if _, err := m.client.applyCurrency(body, cur, date); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		terms := parseTermsFlags(m.optionArgs())
+		if _, err := m.client.applyTerms(body, "Quotation", terms, true); err != nil {
+			return formSubmittedMsg{false, err.Error()}
 		}
 
 		result, err := m.client.Request("POST", "Quotation", body)
@@ -318,10 +360,10 @@ func (m Model) submitCreateQuotation() tea.Cmd {
 		}
 
 		if data, ok := result["data"].(map[string]interface{}); ok {
-			return formSubmittedMsg{true, fmt.Sprintf("Quotation created: %s", data["name"])}
+			return formSubmittedMsg{true, m.finishNote(tf("Quotation created: %s", data["name"]) + m.formRemarks("Quotation", data, terms))}
 		}
 
-		return formSubmittedMsg{false, "Failed to create quotation"}
+		return formSubmittedMsg{false, T("Failed to create quotation")}
 	}
 }
 
@@ -330,14 +372,14 @@ func (m *Model) initAddQuotationItemForm() {
 	m.inputs = make([]textinput.Model, 3)
 
 	m.inputs[0] = textinput.New()
-	m.inputs[0].Placeholder = "Item Code"
+	m.inputs[0].Placeholder = T("Item Code")
 	m.inputs[0].Focus()
 
 	m.inputs[1] = textinput.New()
-	m.inputs[1].Placeholder = "Quantity"
+	m.inputs[1].Placeholder = T("Quantity")
 
 	m.inputs[2] = textinput.New()
-	m.inputs[2].Placeholder = "Rate (optional)"
+	m.inputs[2].Placeholder = T("Rate (optional)")
 
 	m.focusIndex = 0
 }
@@ -345,12 +387,12 @@ func (m *Model) initAddQuotationItemForm() {
 // renderAddQuotationItem renders the add quotation item form
 func (m Model) renderAddQuotationItem() string {
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Add Item to Quotation: "+m.selectedItem) + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Add Item to Quotation: ")+m.selectedItem) + "\n\n")
 
-	labels := []string{"Item Code:", "Quantity:", "Rate:"}
-	for i, input := range m.inputs {
+	labels := []string{T("Item Code:"), T("Quantity:"), T("Rate:")}
+	for i := range m.inputs {
 		b.WriteString(fmt.Sprintf("  %s\n", labels[i]))
-		b.WriteString(fmt.Sprintf("  %s\n\n", input.View()))
+		b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(i)))
 	}
 
 	return boxStyle.Render(b.String())
@@ -364,12 +406,12 @@ func (m Model) submitAddQuotationItem() tea.Cmd {
 		rateStr := m.inputs[2].Value()
 
 		if itemCode == "" || qtyStr == "" {
-			return formSubmittedMsg{false, "Item code and quantity are required"}
+			return formSubmittedMsg{false, T("Item code and quantity are required")}
 		}
 
 		qty, err := strconv.ParseFloat(qtyStr, 64)
 		if err != nil {
-			return formSubmittedMsg{false, "Invalid quantity"}
+			return formSubmittedMsg{false, T("Invalid quantity")}
 		}
 
 		rate := 0.0
@@ -386,12 +428,24 @@ func (m Model) submitAddQuotationItem() tea.Cmd {
 
 		data, ok := result["data"].(map[string]interface{})
 		if !ok {
-			return formSubmittedMsg{false, "Quotation not found"}
+			return formSubmittedMsg{false, T("Quotation not found")}
 		}
 
 		docStatus, _ := data["docstatus"].(float64)
 		if docStatus != 0 {
-			return formSubmittedMsg{false, "Cannot add items to submitted/cancelled quotation"}
+			return formSubmittedMsg{false, T("Cannot add items to submitted/cancelled quotation")}
+		}
+		if err := checkUnchanged("Quotation", m.itemData, data); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+
+		customer := ""
+		if data["quotation_to"] == "Customer" {
+			customer, _ = data["party_name"].(string)
+		}
+		itemCode, customerCode, err := m.client.resolveCustomerItemCode(customer, itemCode)
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
 		}
 
 		var existingItems []map[string]interface{}
@@ -407,6 +461,9 @@ func (m Model) submitAddQuotationItem() tea.Cmd {
 			"item_code": itemCode,
 			"qty":       qty,
 		}
+		if customerCode != "" {
+			newItem["customer_item_code"] = customerCode
+		}
 		if rate > 0 {
 			newItem["rate"] = rate
 		}
@@ -416,12 +473,12 @@ func (m Model) submitAddQuotationItem() tea.Cmd {
 			"items": existingItems,
 		}
 
-		_, err = m.client.Request("PUT", "Quotation/"+encoded, body)
+		err = m.client.updateDraft("Quotation", qtnName, data, body)
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
 
-		return formSubmittedMsg{true, fmt.Sprintf("Item added to Quotation: %s", qtnName)}
+		return formSubmittedMsg{true, tf("Item added to Quotation: %s", qtnName)}
 	}
 }
 
@@ -432,45 +489,25 @@ func (m Model) submitQuotation(name string) tea.Cmd {
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
-		return formSubmittedMsg{true, fmt.Sprintf("Quotation submitted: %s", name)}
+		msg := tf("Quotation submitted: %s", name)
+		if m.client.notifyOnSubmit("Quotation", nil) {
+			msg += m.client.notifyNote("Quotation", name)
+		}
+		return formSubmittedMsg{true, msg}
 	}
 }
 
 // cancelQuotation cancels a quotation
 func (m Model) cancelQuotation(name string) tea.Cmd {
 	return func() tea.Msg {
-		err := m.client.cancelDocument("Quotation", name)
-		if err != nil {
+		if err := m.client.checkCancelChain("Quotation", name); err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
-		return formSubmittedMsg{true, fmt.Sprintf("Quotation cancelled: %s", name)}
-	}
-}
-
-// loadSalesOrders fetches all sales orders
-func (m Model) loadSalesOrders() tea.Cmd {
-	return func() tea.Msg {
-		result, err := m.client.Request("GET", "Sales%20Order?limit_page_length=100&fields=[\"name\",\"customer\",\"transaction_date\",\"status\",\"grand_total\",\"docstatus\"]&order_by=creation%20desc", nil)
+		err := m.client.cancelDocument("Quotation", name)
 		if err != nil {
-			return errorMsg{err}
-		}
-
-		var items []ListItem
-		if data, ok := result["data"].([]interface{}); ok {
-			for _, item := range data {
-				if im, ok := item.(map[string]interface{}); ok {
-					name := fmt.Sprintf("%v", im["name"])
-					customer := fmt.Sprintf("%v", im["customer"])
-					status, _ := im["status"].(string)
-					total, _ := im["grand_total"].(float64)
-
-					statusBadge := renderStatusBadge(status)
-					detail := fmt.Sprintf("%s | %s | %s", customer, statusBadge, m.client.FormatCurrency(total))
-					items = append(items, ListItem{name: name, details: detail, amount: total, status: status})
-				}
-			}
+			return formSubmittedMsg{false, err.Error()}
 		}
-		return dataLoadedMsg{items}
+		return formSubmittedMsg{true, tf("Quotation cancelled: %s", name)}
 	}
 }
 
@@ -486,7 +523,7 @@ func (m Model) loadSODetail(name string) tea.Cmd {
 		if data, ok := result["data"].(map[string]interface{}); ok {
 			return itemDetailMsg{data}
 		}
-		return errorMsg{fmt.Errorf("no data found")}
+		return errorMsg{errorf("no data found")}
 	}
 }
 
@@ -497,15 +534,15 @@ func (m Model) renderSODetail() string {
 	}
 
 	if m.itemData == nil {
-		return "\n  No data"
+		return "\n  " + T("No data")
 	}
 
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Sales Order: "+m.selectedItem) + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Sales Order: ")+m.selectedItem) + "\n\n")
 
-	b.WriteString(fmt.Sprintf("  Customer: %v\n", m.itemData["customer"]))
-	b.WriteString(fmt.Sprintf("  Date: %v\n", m.itemData["transaction_date"]))
-	b.WriteString(fmt.Sprintf("  Delivery Date: %v\n", m.itemData["delivery_date"]))
+	b.WriteString(tf("  Customer: %v\n", m.itemData["customer"]))
+	b.WriteString(tf("  Date: %v\n", m.itemData["transaction_date"]))
+	b.WriteString(tf("  Delivery Date: %v\n", m.itemData["delivery_date"]))
 
 	status, _ := m.itemData["status"].(string)
 	statusStyle := helpStyle
@@ -517,47 +554,111 @@ func (m Model) renderSODetail() string {
 	case "Cancelled":
 		statusStyle = errorStyle
 	}
-	b.WriteString(fmt.Sprintf("  Status: %s\n", statusStyle.Render(status)))
+	b.WriteString(tf("  Status: %s\n", statusStyle.Render(status)))
 
-	grandTotal, _ := m.itemData["grand_total"].(float64)
-	b.WriteString(fmt.Sprintf("  Total: %s\n", m.client.FormatCurrency(grandTotal)))
+	b.WriteString(tf("  Total: %s\n", m.client.docTotal(m.itemData)))
+	for _, line := range docAddressLines(m.itemData) {
+		b.WriteString(line + "\n")
+	}
+	for _, line := range m.client.docDiscountLines(m.itemData) {
+		b.WriteString(line + "\n")
+	}
+	for _, line := range m.client.docChargeLines(m.itemData) {
+		b.WriteString(line + "\n")
+	}
 
 	if items, ok := m.itemData["items"].([]interface{}); ok && len(items) > 0 {
-		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render("Items:")))
+		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render(T("Items:"))))
 		for _, item := range items {
 			if im, ok := item.(map[string]interface{}); ok {
 				itemCode := fmt.Sprintf("%v", im["item_code"])
 				qty, _ := im["qty"].(float64)
 				rate, _ := im["rate"].(float64)
 				amount, _ := im["amount"].(float64)
-				b.WriteString(fmt.Sprintf("    - %s: %.0f x %s = %s\n", itemCode, qty, m.client.FormatCurrency(rate), m.client.FormatCurrency(amount)))
+				b.WriteString(fmt.Sprintf("    - %s%s: %.0f x %s = %s\n", itemCode, customerCodeSuffix(im), qty, m.client.formatDoc(m.itemData, rate), m.client.formatDoc(m.itemData, amount)))
+				for _, line := range packedItemLines(m.itemData, im) {
+					b.WriteString(helpStyle.Render(line) + "\n")
+				}
 			}
 		}
 	}
 
+	b.WriteString(m.renderSalesTeam())
+
+	if lines := docTermsLines(m.itemData); len(lines) > 0 {
+		b.WriteString("\n")
+		for _, line := range lines {
+			b.WriteString(line + "\n")
+		}
+	}
+
 	return boxStyle.Render(b.String())
 }
 
+// renderSalesTeam renders the Sales Team section of the current SO/SI
+func (m Model) renderSalesTeam() string {
+	rows, ok := m.itemData["sales_team"].([]interface{})
+	if !ok || len(rows) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render(T("Sales Team:"))))
+	for _, row := range rows {
+		if sm, ok := row.(map[string]interface{}); ok {
+			pct, _ := sm["allocated_percentage"].(float64)
+			amount, _ := sm["allocated_amount"].(float64)
+			b.WriteString(fmt.Sprintf("    - %v: %.0f%% (%s)\n", sm["sales_person"], pct, m.client.FormatCurrency(amount)))
+		}
+	}
+	return b.String()
+}
+
 // initCreateSOForm initializes the create SO form
 func (m *Model) initCreateSOForm() {
-	m.inputs = make([]textinput.Model, 1)
+	m.inputs = make([]textinput.Model, 5)
 
 	m.inputs[0] = textinput.New()
-	m.inputs[0].Placeholder = "Customer Name"
+	m.inputs[0].Placeholder = T("Customer Name")
 	m.inputs[0].Focus()
 
+	m.inputs[1] = textinput.New()
+	m.inputs[1].Placeholder = T("Sales Person (optional, Name[:pct])")
+
+	m.inputs[2] = textinput.New()
+	m.inputs[2].Placeholder = T("Item Code (optional)")
+
+	m.inputs[3] = textinput.New()
+	m.inputs[3].Placeholder = T("Quantity (default 1)")
+
+	m.inputs[4] = newDateInput()
+	m.addOptionInputs(ViewCreateSO)
+
 	m.focusIndex = 0
+	m.noteID = 0
 }
 
 // renderCreateSO renders the create SO form
 func (m Model) renderCreateSO() string {
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Create Sales Order ") + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Create Sales Order ")) + "\n\n")
 
-	b.WriteString("  Customer:\n")
-	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputs[0].View()))
+	b.WriteString(T("  Customer:\n"))
+	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(0)))
 
-	b.WriteString(helpStyle.Render("  After creation, add items with 'a' key"))
+	b.WriteString(T("  Sales Person:\n"))
+	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(1)))
+
+	b.WriteString(T("  Item:\n"))
+	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(2)))
+
+	b.WriteString(T("  Quantity:\n"))
+	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(3)))
+
+	b.WriteString(m.renderDateInput(4))
+	b.WriteString(m.renderOptionInputs())
+
+	b.WriteString(helpStyle.Render(trHelp("  After creation, add items with 'a' key")))
 
 	return boxStyle.Render(b.String())
 }
@@ -566,9 +667,32 @@ func (m Model) renderCreateSO() string {
 func (m Model) submitCreateSO() tea.Cmd {
 	return func() tea.Msg {
 		customer := m.inputs[0].Value()
+		salesPerson := strings.TrimSpace(m.inputs[1].Value())
 
 		if customer == "" {
-			return formSubmittedMsg{false, "Customer is required"}
+			return formSubmittedMsg{false, T("Customer is required")}
+		}
+
+		var specs []string
+		if salesPerson != "" {
+			specs = append(specs, salesPerson)
+		}
+		team, err := buildSalesTeam(specs)
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		discount, err := parseDiscountFlags(m.optionArgs())
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		cur, err := parseCurrencyFlags(m.optionArgs())
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+
+		items, err := m.formLineItem(customer, 2, true)
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
 		}
 
 		company, err := m.client.GetCompany()
@@ -576,14 +700,36 @@ func (m Model) submitCreateSO() tea.Cmd {
 			return formSubmittedMsg{false, err.Error()}
 		}
 
-		today := time.Now().Format("2006-01-02")
+		date, err := m.formDate(4)
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
 
 		body := map[string]interface{}{
 			"customer":         customer,
-			"transaction_date": today,
-			"delivery_date":    today,
+			"transaction_date": date,
+			"delivery_date":    date,
 			"company":          company,
-			"items":            []interface{}{},
+			"items":            items,
+		}
+		if len(team) > 0 {
+			body["sales_team"] = team
+		}
+		if err := m.client.applyDiscount(body, discount); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		if err := m.client.applyShippingRule(body, parseShippingRule(m.optionArgs())); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		if _, err := m.client.applyCurrency(body, cur, date); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		if err := m.client.applyAddresses(body, parseAddressFlags(m.optionArgs())); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		terms := parseTermsFlags(m.optionArgs())
+		if _, err := m.client.applyTerms(body, "Sales Order", terms, true); err != nil {
+			return formSubmittedMsg{false, err.Error()}
 		}
 
 		result, err := m.client.Request("POST", "Sales%20Order", body)
@@ -592,33 +738,39 @@ func (m Model) submitCreateSO() tea.Cmd {
 		}
 
 		if data, ok := result["data"].(map[string]interface{}); ok {
-			return formSubmittedMsg{true, fmt.Sprintf("SO created: %s", data["name"])}
+			return formSubmittedMsg{true, m.finishNote(tf("SO created: %s", data["name"]) + m.formRemarks("Sales Order", data, terms))}
 		}
 
-		return formSubmittedMsg{false, "Failed to create SO"}
+		return formSubmittedMsg{false, T("Failed to create SO")}
 	}
 }
 
 // initCreateSOFromQuotationForm initializes the create SO from quotation form
 func (m *Model) initCreateSOFromQuotationForm() {
-	m.inputs = make([]textinput.Model, 1)
+	m.inputs = make([]textinput.Model, 2)
 
 	m.inputs[0] = textinput.New()
-	m.inputs[0].Placeholder = "Quotation Name (e.g., QTN-00001)"
+	m.inputs[0].Placeholder = T("Quotation Name (e.g., QTN-00001)")
 	m.inputs[0].Focus()
 
+	m.inputs[1] = newDateInput()
+	m.addOptionInputs(ViewCreateSOFromQuotation)
+
 	m.focusIndex = 0
 }
 
 // renderCreateSOFromQuotation renders the create SO from quotation form
 func (m Model) renderCreateSOFromQuotation() string {
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Create SO from Quotation ") + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Create SO from Quotation ")) + "\n\n")
 
-	b.WriteString("  Quotation:\n")
+	b.WriteString(T("  Quotation:\n"))
 	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputs[0].View()))
 
-	b.WriteString(helpStyle.Render("  Enter the name of a submitted Quotation"))
+	b.WriteString(m.renderDateInput(1))
+	b.WriteString(m.renderOptionInputs())
+
+	b.WriteString(helpStyle.Render(trHelp("  Enter the name of a submitted Quotation")))
 
 	return boxStyle.Render(b.String())
 }
@@ -629,7 +781,7 @@ func (m Model) submitCreateSOFromQuotation() tea.Cmd {
 		qtnName := m.inputs[0].Value()
 
 		if qtnName == "" {
-			return formSubmittedMsg{false, "Quotation name is required"}
+			return formSubmittedMsg{false, T("Quotation name is required")}
 		}
 
 		encoded := url.PathEscape(qtnName)
@@ -640,12 +792,12 @@ func (m Model) submitCreateSOFromQuotation() tea.Cmd {
 
 		qtnData, ok := result["data"].(map[string]interface{})
 		if !ok {
-			return formSubmittedMsg{false, "Quotation not found"}
+			return formSubmittedMsg{false, T("Quotation not found")}
 		}
 
 		docStatus, _ := qtnData["docstatus"].(float64)
 		if docStatus != 1 {
-			return formSubmittedMsg{false, "Quotation must be submitted first"}
+			return formSubmittedMsg{false, T("Quotation must be submitted first")}
 		}
 
 		company, err := m.client.GetCompany()
@@ -653,7 +805,10 @@ func (m Model) submitCreateSOFromQuotation() tea.Cmd {
 			return formSubmittedMsg{false, err.Error()}
 		}
 
-		today := time.Now().Format("2006-01-02")
+		date, err := m.formDate(1)
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
 
 		var soItems []map[string]interface{}
 		if items, ok := qtnData["items"].([]interface{}); ok {
@@ -663,7 +818,7 @@ func (m Model) submitCreateSOFromQuotation() tea.Cmd {
 						"item_code":       im["item_code"],
 						"qty":             im["qty"],
 						"rate":            im["rate"],
-						"delivery_date":   today,
+						"delivery_date":   date,
 						"prevdoc_docname": qtnName,
 						"quotation_item":  im["name"],
 					})
@@ -672,16 +827,25 @@ func (m Model) submitCreateSOFromQuotation() tea.Cmd {
 		}
 
 		if len(soItems) == 0 {
-			return formSubmittedMsg{false, "No items found in quotation"}
+			return formSubmittedMsg{false, T("No items found in quotation")}
 		}
 
 		body := map[string]interface{}{
 			"customer":         qtnData["party_name"],
-			"transaction_date": today,
-			"delivery_date":    today,
+			"transaction_date": date,
+			"delivery_date":    date,
 			"company":          company,
 			"items":            soItems,
 		}
+		copyOrderTerms(body, qtnData)
+		copyOrderAddresses(body, qtnData)
+		if err := m.client.applyAddresses(body, parseAddressFlags(m.optionArgs())); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		terms := parseTermsFlags(m.optionArgs())
+		if _, err := m.client.applyTerms(body, "Sales Order", terms, true); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
 
 		result, err = m.client.Request("POST", "Sales%20Order", body)
 		if err != nil {
@@ -689,10 +853,10 @@ func (m Model) submitCreateSOFromQuotation() tea.Cmd {
 		}
 
 		if data, ok := result["data"].(map[string]interface{}); ok {
-			return formSubmittedMsg{true, fmt.Sprintf("SO created: %s (from %s)", data["name"], qtnName)}
+			return formSubmittedMsg{true, tf("SO created: %s (from %s)", data["name"], qtnName) + m.formRemarks("Sales Order", data, terms)}
 		}
 
-		return formSubmittedMsg{false, "Failed to create SO"}
+		return formSubmittedMsg{false, T("Failed to create SO")}
 	}
 }
 
@@ -701,14 +865,14 @@ func (m *Model) initAddSOItemForm() {
 	m.inputs = make([]textinput.Model, 3)
 
 	m.inputs[0] = textinput.New()
-	m.inputs[0].Placeholder = "Item Code"
+	m.inputs[0].Placeholder = T("Item Code")
 	m.inputs[0].Focus()
 
 	m.inputs[1] = textinput.New()
-	m.inputs[1].Placeholder = "Quantity"
+	m.inputs[1].Placeholder = T("Quantity")
 
 	m.inputs[2] = textinput.New()
-	m.inputs[2].Placeholder = "Rate (optional)"
+	m.inputs[2].Placeholder = T("Rate (optional)")
 
 	m.focusIndex = 0
 }
@@ -716,12 +880,12 @@ func (m *Model) initAddSOItemForm() {
 // renderAddSOItem renders the add SO item form
 func (m Model) renderAddSOItem() string {
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Add Item to SO: "+m.selectedItem) + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Add Item to SO: ")+m.selectedItem) + "\n\n")
 
-	labels := []string{"Item Code:", "Quantity:", "Rate:"}
-	for i, input := range m.inputs {
+	labels := []string{T("Item Code:"), T("Quantity:"), T("Rate:")}
+	for i := range m.inputs {
 		b.WriteString(fmt.Sprintf("  %s\n", labels[i]))
-		b.WriteString(fmt.Sprintf("  %s\n\n", input.View()))
+		b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(i)))
 	}
 
 	return boxStyle.Render(b.String())
@@ -735,12 +899,12 @@ func (m Model) submitAddSOItem() tea.Cmd {
 		rateStr := m.inputs[2].Value()
 
 		if itemCode == "" || qtyStr == "" {
-			return formSubmittedMsg{false, "Item code and quantity are required"}
+			return formSubmittedMsg{false, T("Item code and quantity are required")}
 		}
 
 		qty, err := strconv.ParseFloat(qtyStr, 64)
 		if err != nil {
-			return formSubmittedMsg{false, "Invalid quantity"}
+			return formSubmittedMsg{false, T("Invalid quantity")}
 		}
 
 		rate := 0.0
@@ -757,12 +921,21 @@ func (m Model) submitAddSOItem() tea.Cmd {
 
 		data, ok := result["data"].(map[string]interface{})
 		if !ok {
-			return formSubmittedMsg{false, "SO not found"}
+			return formSubmittedMsg{false, T("SO not found")}
 		}
 
 		docStatus, _ := data["docstatus"].(float64)
 		if docStatus != 0 {
-			return formSubmittedMsg{false, "Cannot add items to submitted/cancelled SO"}
+			return formSubmittedMsg{false, T("Cannot add items to submitted/cancelled SO")}
+		}
+		if err := checkUnchanged("Sales Order", m.itemData, data); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+
+		customer, _ := data["customer"].(string)
+		itemCode, customerCode, err := m.client.resolveCustomerItemCode(customer, itemCode)
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
 		}
 
 		var existingItems []map[string]interface{}
@@ -779,6 +952,9 @@ func (m Model) submitAddSOItem() tea.Cmd {
 			"qty":           qty,
 			"delivery_date": data["delivery_date"],
 		}
+		if customerCode != "" {
+			newItem["customer_item_code"] = customerCode
+		}
 		if rate > 0 {
 			newItem["rate"] = rate
 		}
@@ -788,12 +964,12 @@ func (m Model) submitAddSOItem() tea.Cmd {
 			"items": existingItems,
 		}
 
-		_, err = m.client.Request("PUT", "Sales%20Order/"+encoded, body)
+		err = m.client.updateDraft("Sales Order", soName, data, body)
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
 
-		return formSubmittedMsg{true, fmt.Sprintf("Item added to SO: %s", soName)}
+		return formSubmittedMsg{true, tf("Item added to SO: %s", soName)}
 	}
 }
 
@@ -804,45 +980,25 @@ func (m Model) submitSO(name string) tea.Cmd {
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
-		return formSubmittedMsg{true, fmt.Sprintf("SO submitted: %s", name)}
+		msg := tf("SO submitted: %s", name)
+		if m.client.notifyOnSubmit("Sales Order", nil) {
+			msg += m.client.notifyNote("Sales Order", name)
+		}
+		return formSubmittedMsg{true, msg}
 	}
 }
 
 // cancelSO cancels a sales order
 func (m Model) cancelSO(name string) tea.Cmd {
 	return func() tea.Msg {
-		err := m.client.cancelDocument("Sales Order", name)
-		if err != nil {
+		if err := m.client.checkCancelChain("Sales Order", name); err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
-		return formSubmittedMsg{true, fmt.Sprintf("SO cancelled: %s", name)}
-	}
-}
-
-// loadSalesInvoices fetches all sales invoices
-func (m Model) loadSalesInvoices() tea.Cmd {
-	return func() tea.Msg {
-		result, err := m.client.Request("GET", "Sales%20Invoice?limit_page_length=100&fields=[\"name\",\"customer\",\"posting_date\",\"status\",\"grand_total\",\"docstatus\"]&order_by=creation%20desc", nil)
+		err := m.client.cancelDocument("Sales Order", name)
 		if err != nil {
-			return errorMsg{err}
-		}
-
-		var items []ListItem
-		if data, ok := result["data"].([]interface{}); ok {
-			for _, item := range data {
-				if im, ok := item.(map[string]interface{}); ok {
-					name := fmt.Sprintf("%v", im["name"])
-					customer := fmt.Sprintf("%v", im["customer"])
-					status, _ := im["status"].(string)
-					total, _ := im["grand_total"].(float64)
-
-					statusBadge := renderStatusBadge(status)
-					detail := fmt.Sprintf("%s | %s | %s", customer, statusBadge, m.client.FormatCurrency(total))
-					items = append(items, ListItem{name: name, details: detail, amount: total, status: status})
-				}
-			}
+			return formSubmittedMsg{false, err.Error()}
 		}
-		return dataLoadedMsg{items}
+		return formSubmittedMsg{true, tf("SO cancelled: %s", name)}
 	}
 }
 
@@ -858,7 +1014,7 @@ func (m Model) loadSIDetail(name string) tea.Cmd {
 		if data, ok := result["data"].(map[string]interface{}); ok {
 			return itemDetailMsg{data}
 		}
-		return errorMsg{fmt.Errorf("no data found")}
+		return errorMsg{errorf("no data found")}
 	}
 }
 
@@ -869,14 +1025,14 @@ func (m Model) renderSIDetail() string {
 	}
 
 	if m.itemData == nil {
-		return "\n  No data"
+		return "\n  " + T("No data")
 	}
 
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Sales Invoice: "+m.selectedItem) + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Sales Invoice: ")+m.selectedItem) + "\n\n")
 
-	b.WriteString(fmt.Sprintf("  Customer: %v\n", m.itemData["customer"]))
-	b.WriteString(fmt.Sprintf("  Date: %v\n", m.itemData["posting_date"]))
+	b.WriteString(tf("  Customer: %v\n", m.itemData["customer"]))
+	b.WriteString(tf("  Date: %v\n", m.itemData["posting_date"]))
 
 	status, _ := m.itemData["status"].(string)
 	statusStyle := helpStyle
@@ -890,17 +1046,25 @@ func (m Model) renderSIDetail() string {
 	case "Cancelled":
 		statusStyle = errorStyle
 	}
-	b.WriteString(fmt.Sprintf("  Status: %s\n", statusStyle.Render(status)))
+	b.WriteString(tf("  Status: %s\n", statusStyle.Render(status)))
 
-	grandTotal, _ := m.itemData["grand_total"].(float64)
-	b.WriteString(fmt.Sprintf("  Total: %s\n", m.client.FormatCurrency(grandTotal)))
+	b.WriteString(tf("  Total: %s\n", m.client.docTotal(m.itemData)))
+	for _, line := range docAddressLines(m.itemData) {
+		b.WriteString(line + "\n")
+	}
+	for _, line := range m.client.docDiscountLines(m.itemData) {
+		b.WriteString(line + "\n")
+	}
+	for _, line := range m.client.docChargeLines(m.itemData) {
+		b.WriteString(line + "\n")
+	}
 
 	if outstanding, ok := m.itemData["outstanding_amount"].(float64); ok && outstanding > 0 {
-		b.WriteString(fmt.Sprintf("  Outstanding: %s\n", errorStyle.Render(m.client.FormatCurrency(outstanding))))
+		b.WriteString(tf("  Outstanding: %s\n", errorStyle.Render(m.client.FormatCurrencyIn(outstanding, stringField(m.itemData, "party_account_currency")))))
 	}
 
 	if items, ok := m.itemData["items"].([]interface{}); ok && len(items) > 0 {
-		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render("Items:")))
+		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render(T("Items:"))))
 		for _, item := range items {
 			if im, ok := item.(map[string]interface{}); ok {
 				itemCode := fmt.Sprintf("%v", im["item_code"])
@@ -909,38 +1073,53 @@ func (m Model) renderSIDetail() string {
 				amount, _ := im["amount"].(float64)
 				so := im["sales_order"]
 
-				line := fmt.Sprintf("    - %s: %.0f x %s = %s", itemCode, qty, m.client.FormatCurrency(rate), m.client.FormatCurrency(amount))
+				line := fmt.Sprintf("    - %s%s: %.0f x %s = %s", itemCode, customerCodeSuffix(im), qty, m.client.formatDoc(m.itemData, rate), m.client.formatDoc(m.itemData, amount))
 				if so != nil && so != "" {
-					line += fmt.Sprintf(" (SO: %s)", so)
+					line += tf(" (SO: %s)", so)
 				}
 				b.WriteString(line + "\n")
 			}
 		}
 	}
 
+	b.WriteString(m.renderSalesTeam())
+
+	if lines := docTermsLines(m.itemData); len(lines) > 0 {
+		b.WriteString("\n")
+		for _, line := range lines {
+			b.WriteString(line + "\n")
+		}
+	}
+
 	return boxStyle.Render(b.String())
 }
 
 // initCreateSIForm initializes the create SI from SO form
 func (m *Model) initCreateSIForm() {
-	m.inputs = make([]textinput.Model, 1)
+	m.inputs = make([]textinput.Model, 2)
 
 	m.inputs[0] = textinput.New()
-	m.inputs[0].Placeholder = "Sales Order Name (e.g., SAL-ORD-2025-00001)"
+	m.inputs[0].Placeholder = T("Sales Order Name (e.g., SAL-ORD-2025-00001)")
 	m.inputs[0].Focus()
 
+	m.inputs[1] = newDateInput()
+	m.addOptionInputs(ViewCreateSalesInvoice)
+
 	m.focusIndex = 0
 }
 
 // renderCreateSI renders the create SI form
 func (m Model) renderCreateSI() string {
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Create Invoice from SO ") + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Create Invoice from SO ")) + "\n\n")
 
-	b.WriteString("  Sales Order:\n")
+	b.WriteString(T("  Sales Order:\n"))
 	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputs[0].View()))
 
-	b.WriteString(helpStyle.Render("  Enter the name of a submitted Sales Order"))
+	b.WriteString(m.renderDateInput(1))
+	b.WriteString(m.renderOptionInputs())
+
+	b.WriteString(helpStyle.Render(trHelp("  Enter the name of a submitted Sales Order")))
 
 	return boxStyle.Render(b.String())
 }
@@ -951,68 +1130,49 @@ func (m Model) submitCreateSI() tea.Cmd {
 		soName := m.inputs[0].Value()
 
 		if soName == "" {
-			return formSubmittedMsg{false, "Sales Order name is required"}
+			return formSubmittedMsg{false, T("Sales Order name is required")}
 		}
 
-		encoded := url.PathEscape(soName)
-		result, err := m.client.Request("GET", "Sales%20Order/"+encoded, nil)
+		discount, err := parseDiscountFlags(m.optionArgs())
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
-
-		soData, ok := result["data"].(map[string]interface{})
-		if !ok {
-			return formSubmittedMsg{false, "Sales order not found"}
-		}
-
-		docStatus, _ := soData["docstatus"].(float64)
-		if docStatus != 1 {
-			return formSubmittedMsg{false, "Sales order must be submitted first"}
+		cur, err := parseCurrencyFlags(m.optionArgs())
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
 		}
 
-		company, err := m.client.GetCompany()
+		body, err := m.client.siFromSO(soName, nil, m.formPosting(1))
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
-
-		today := time.Now().Format("2006-01-02")
-
-		var invoiceItems []map[string]interface{}
-		if items, ok := soData["items"].([]interface{}); ok {
-			for _, item := range items {
-				if im, ok := item.(map[string]interface{}); ok {
-					invoiceItems = append(invoiceItems, map[string]interface{}{
-						"item_code":   im["item_code"],
-						"qty":         im["qty"],
-						"rate":        im["rate"],
-						"sales_order": soName,
-						"so_detail":   im["name"],
-					})
-				}
-			}
+		if err := m.client.applyDiscount(body, discount); err != nil {
+			return formSubmittedMsg{false, err.Error()}
 		}
-
-		if len(invoiceItems) == 0 {
-			return formSubmittedMsg{false, "No items found in sales order"}
+		if err := m.client.applyShippingRule(body, parseShippingRule(m.optionArgs())); err != nil {
+			return formSubmittedMsg{false, err.Error()}
 		}
-
-		body := map[string]interface{}{
-			"customer":     soData["customer"],
-			"posting_date": today,
-			"company":      company,
-			"items":        invoiceItems,
+		if _, err := m.client.overrideCurrency(body, cur); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		if err := m.client.applyAddresses(body, parseAddressFlags(m.optionArgs())); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		terms := parseTermsFlags(m.optionArgs())
+		if _, err := m.client.applyTerms(body, "Sales Invoice", terms, true); err != nil {
+			return formSubmittedMsg{false, err.Error()}
 		}
 
-		result, err = m.client.Request("POST", "Sales%20Invoice", body)
+		result, err := m.client.Request("POST", "Sales%20Invoice", body)
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
 
 		if data, ok := result["data"].(map[string]interface{}); ok {
-			return formSubmittedMsg{true, fmt.Sprintf("Invoice created: %s", data["name"])}
+			return formSubmittedMsg{true, tf("Invoice created: %s", data["name"]) + m.formRemarks("Sales Invoice", data, terms)}
 		}
 
-		return formSubmittedMsg{false, "Failed to create invoice"}
+		return formSubmittedMsg{false, T("Failed to create invoice")}
 	}
 }
 
@@ -1023,45 +1183,25 @@ func (m Model) submitSI(name string) tea.Cmd {
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
-		return formSubmittedMsg{true, fmt.Sprintf("Invoice submitted: %s", name)}
+		msg := tf("Invoice submitted: %s", name)
+		if m.client.notifyOnSubmit("Sales Invoice", nil) {
+			msg += m.client.notifyNote("Sales Invoice", name)
+		}
+		return formSubmittedMsg{true, msg}
 	}
 }
 
 // cancelSI cancels a sales invoice
 func (m Model) cancelSI(name string) tea.Cmd {
 	return func() tea.Msg {
-		err := m.client.cancelDocument("Sales Invoice", name)
-		if err != nil {
+		if err := m.client.checkCancelChain("Sales Invoice", name); err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
-		return formSubmittedMsg{true, fmt.Sprintf("Invoice cancelled: %s", name)}
-	}
-}
-
-// loadDeliveryNotes fetches all delivery notes
-func (m Model) loadDeliveryNotes() tea.Cmd {
-	return func() tea.Msg {
-		result, err := m.client.Request("GET", "Delivery%20Note?limit_page_length=100&fields=[\"name\",\"customer\",\"posting_date\",\"status\",\"grand_total\",\"docstatus\"]&order_by=creation%20desc", nil)
+		err := m.client.cancelDocument("Sales Invoice", name)
 		if err != nil {
-			return errorMsg{err}
-		}
-
-		var items []ListItem
-		if data, ok := result["data"].([]interface{}); ok {
-			for _, item := range data {
-				if im, ok := item.(map[string]interface{}); ok {
-					name := fmt.Sprintf("%v", im["name"])
-					customer := fmt.Sprintf("%v", im["customer"])
-					status, _ := im["status"].(string)
-					total, _ := im["grand_total"].(float64)
-
-					statusBadge := renderStatusBadge(status)
-					detail := fmt.Sprintf("%s | %s | %s", customer, statusBadge, m.client.FormatCurrency(total))
-					items = append(items, ListItem{name: name, details: detail, amount: total, status: status})
-				}
-			}
+			return formSubmittedMsg{false, err.Error()}
 		}
-		return dataLoadedMsg{items}
+		return formSubmittedMsg{true, tf("Invoice cancelled: %s", name)}
 	}
 }
 
@@ -1077,7 +1217,7 @@ func (m Model) loadDNDetail(name string) tea.Cmd {
 		if data, ok := result["data"].(map[string]interface{}); ok {
 			return itemDetailMsg{data}
 		}
-		return errorMsg{fmt.Errorf("no data found")}
+		return errorMsg{errorf("no data found")}
 	}
 }
 
@@ -1088,14 +1228,14 @@ func (m Model) renderDNDetail() string {
 	}
 
 	if m.itemData == nil {
-		return "\n  No data"
+		return "\n  " + T("No data")
 	}
 
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Delivery Note: "+m.selectedItem) + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Delivery Note: ")+m.selectedItem) + "\n\n")
 
-	b.WriteString(fmt.Sprintf("  Customer: %v\n", m.itemData["customer"]))
-	b.WriteString(fmt.Sprintf("  Date: %v\n", m.itemData["posting_date"]))
+	b.WriteString(tf("  Customer: %v\n", m.itemData["customer"]))
+	b.WriteString(tf("  Date: %v\n", m.itemData["posting_date"]))
 
 	status, _ := m.itemData["status"].(string)
 	statusStyle := helpStyle
@@ -1107,13 +1247,18 @@ func (m Model) renderDNDetail() string {
 	case "Cancelled", "Return Issued":
 		statusStyle = errorStyle
 	}
-	b.WriteString(fmt.Sprintf("  Status: %s\n", statusStyle.Render(status)))
+	b.WriteString(tf("  Status: %s\n", statusStyle.Render(status)))
 
-	grandTotal, _ := m.itemData["grand_total"].(float64)
-	b.WriteString(fmt.Sprintf("  Total: %s\n", m.client.FormatCurrency(grandTotal)))
+	b.WriteString(tf("  Total: %s\n", m.client.docTotal(m.itemData)))
+	for _, line := range docAddressLines(m.itemData) {
+		b.WriteString(line + "\n")
+	}
+	for _, line := range m.client.docChargeLines(m.itemData) {
+		b.WriteString(line + "\n")
+	}
 
 	if items, ok := m.itemData["items"].([]interface{}); ok && len(items) > 0 {
-		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render("Items:")))
+		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render(T("Items:"))))
 		for _, item := range items {
 			if im, ok := item.(map[string]interface{}); ok {
 				itemCode := fmt.Sprintf("%v", im["item_code"])
@@ -1122,38 +1267,54 @@ func (m Model) renderDNDetail() string {
 				amount, _ := im["amount"].(float64)
 				so := im["against_sales_order"]
 
-				line := fmt.Sprintf("    - %s: %.0f x %s = %s", itemCode, qty, m.client.FormatCurrency(rate), m.client.FormatCurrency(amount))
+				line := fmt.Sprintf("    - %s: %.0f x %s = %s", itemCode, qty, m.client.formatDoc(m.itemData, rate), m.client.formatDoc(m.itemData, amount))
 				if so != nil && so != "" {
-					line += fmt.Sprintf(" (SO: %s)", so)
+					line += tf(" (SO: %s)", so)
 				}
 				b.WriteString(line + "\n")
+				for _, packed := range packedItemLines(m.itemData, im) {
+					b.WriteString(helpStyle.Render(packed) + "\n")
+				}
 			}
 		}
 	}
 
+	if lines := docTermsLines(m.itemData); len(lines) > 0 {
+		b.WriteString("\n")
+		for _, line := range lines {
+			b.WriteString(line + "\n")
+		}
+	}
+
 	return boxStyle.Render(b.String())
 }
 
 // initCreateDNForm initializes the create DN from SO form
 func (m *Model) initCreateDNForm() {
-	m.inputs = make([]textinput.Model, 1)
+	m.inputs = make([]textinput.Model, 2)
 
 	m.inputs[0] = textinput.New()
-	m.inputs[0].Placeholder = "Sales Order Name (e.g., SAL-ORD-2025-00001)"
+	m.inputs[0].Placeholder = T("Sales Order Name (e.g., SAL-ORD-2025-00001)")
 	m.inputs[0].Focus()
 
+	m.inputs[1] = newDateInput()
+	m.addOptionInputs(ViewCreateDN)
+
 	m.focusIndex = 0
 }
 
 // renderCreateDN renders the create DN form
 func (m Model) renderCreateDN() string {
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Create Delivery Note from SO ") + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Create Delivery Note from SO ")) + "\n\n")
 
-	b.WriteString("  Sales Order:\n")
+	b.WriteString(T("  Sales Order:\n"))
 	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputs[0].View()))
 
-	b.WriteString(helpStyle.Render("  Enter the name of a submitted Sales Order"))
+	b.WriteString(m.renderDateInput(1))
+	b.WriteString(m.renderOptionInputs())
+
+	b.WriteString(helpStyle.Render(trHelp("  Enter the name of a submitted Sales Order")))
 
 	return boxStyle.Render(b.String())
 }
@@ -1164,68 +1325,34 @@ func (m Model) submitCreateDN() tea.Cmd {
 		soName := m.inputs[0].Value()
 
 		if soName == "" {
-			return formSubmittedMsg{false, "Sales Order name is required"}
+			return formSubmittedMsg{false, T("Sales Order name is required")}
 		}
 
-		encoded := url.PathEscape(soName)
-		result, err := m.client.Request("GET", "Sales%20Order/"+encoded, nil)
+		body, err := m.client.dnFromSO(soName, m.formPosting(1))
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
-
-		soData, ok := result["data"].(map[string]interface{})
-		if !ok {
-			return formSubmittedMsg{false, "Sales order not found"}
-		}
-
-		docStatus, _ := soData["docstatus"].(float64)
-		if docStatus != 1 {
-			return formSubmittedMsg{false, "Sales order must be submitted first"}
-		}
-
-		company, err := m.client.GetCompany()
-		if err != nil {
+		if err := m.client.applyShippingRule(body, parseShippingRule(m.optionArgs())); err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
-
-		today := time.Now().Format("2006-01-02")
-
-		var dnItems []map[string]interface{}
-		if items, ok := soData["items"].([]interface{}); ok {
-			for _, item := range items {
-				if im, ok := item.(map[string]interface{}); ok {
-					dnItems = append(dnItems, map[string]interface{}{
-						"item_code":           im["item_code"],
-						"qty":                 im["qty"],
-						"rate":                im["rate"],
-						"against_sales_order": soName,
-						"so_detail":           im["name"],
-					})
-				}
-			}
-		}
-
-		if len(dnItems) == 0 {
-			return formSubmittedMsg{false, "No items found in sales order"}
+		if err := m.client.applyAddresses(body, parseAddressFlags(m.optionArgs())); err != nil {
+			return formSubmittedMsg{false, err.Error()}
 		}
-
-		body := map[string]interface{}{
-			"customer":     soData["customer"],
-			"posting_date": today,
-			"company":      company,
-			"items":        dnItems,
+		terms := parseTermsFlags(m.optionArgs())
+		if _, err := m.client.applyTerms(body, "Delivery Note", terms, true); err != nil {
+			return formSubmittedMsg{false, err.Error()}
 		}
 
-		result, err = m.client.Request("POST", "Delivery%20Note", body)
+		result, err := m.client.Request("POST", "Delivery%20Note", body)
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
 
 		if data, ok := result["data"].(map[string]interface{}); ok {
-			return formSubmittedMsg{true, fmt.Sprintf("Delivery Note created: %s", data["name"])}
+			return formSubmittedMsg{true, tf("Delivery Note created: %s", data["name"]) + m.formRemarks("Delivery Note", data, terms)}
 		}
 
-		return formSubmittedMsg{false, "Failed to create delivery note"}
+		return formSubmittedMsg{false, T("Failed to create delivery note")}
 	}
 }
 
@@ -1236,51 +1363,21 @@ func (m Model) submitDN(name string) tea.Cmd {
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
-		return formSubmittedMsg{true, fmt.Sprintf("Delivery Note submitted: %s", name)}
+		return formSubmittedMsg{true, tf("Delivery Note submitted: %s", name)}
 	}
 }
 
 // cancelDN cancels a delivery note
 func (m Model) cancelDN(name string) tea.Cmd {
 	return func() tea.Msg {
-		err := m.client.cancelDocument("Delivery Note", name)
-		if err != nil {
+		if err := m.client.checkCancelChain("Delivery Note", name); err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
-		return formSubmittedMsg{true, fmt.Sprintf("Delivery Note cancelled: %s", name)}
-	}
-}
-
-// loadPayments fetches all payment entries
-func (m Model) loadPayments() tea.Cmd {
-	return func() tea.Msg {
-		result, err := m.client.Request("GET", "Payment%20Entry?limit_page_length=100&fields=[\"name\",\"payment_type\",\"party_type\",\"party\",\"paid_amount\",\"posting_date\",\"status\",\"docstatus\"]&order_by=creation%20desc", nil)
+		err := m.client.cancelDocument("Delivery Note", name)
 		if err != nil {
-			return errorMsg{err}
-		}
-
-		var items []ListItem
-		if data, ok := result["data"].([]interface{}); ok {
-			for _, item := range data {
-				if im, ok := item.(map[string]interface{}); ok {
-					name := fmt.Sprintf("%v", im["name"])
-					paymentType, _ := im["payment_type"].(string)
-					party := fmt.Sprintf("%v", im["party"])
-					status, _ := im["status"].(string)
-					amount, _ := im["paid_amount"].(float64)
-
-					typeIcon := "↓"
-					if paymentType == "Pay" {
-						typeIcon = "↑"
-					}
-
-					statusBadge := renderStatusBadge(status)
-					detail := fmt.Sprintf("%s %s | %s | %s", typeIcon, party, statusBadge, m.client.FormatCurrency(amount))
-					items = append(items, ListItem{name: name, details: detail, amount: amount, status: status})
-				}
-			}
+			return formSubmittedMsg{false, err.Error()}
 		}
-		return dataLoadedMsg{items}
+		return formSubmittedMsg{true, tf("Delivery Note cancelled: %s", name)}
 	}
 }
 
@@ -1296,7 +1393,7 @@ func (m Model) loadPaymentDetail(name string) tea.Cmd {
 		if data, ok := result["data"].(map[string]interface{}); ok {
 			return itemDetailMsg{data}
 		}
-		return errorMsg{fmt.Errorf("no data found")}
+		return errorMsg{errorf("no data found")}
 	}
 }
 
@@ -1307,21 +1404,21 @@ func (m Model) renderPaymentDetail() string {
 	}
 
 	if m.itemData == nil {
-		return "\n  No data"
+		return "\n  " + T("No data")
 	}
 
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Payment Entry: "+m.selectedItem) + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Payment Entry: ")+m.selectedItem) + "\n\n")
 
 	paymentType, _ := m.itemData["payment_type"].(string)
-	typeStr := "Receive (from Customer)"
+	typeStr := T("Receive (from Customer)")
 	if paymentType == "Pay" {
-		typeStr = "Pay (to Supplier)"
+		typeStr = T("Pay (to Supplier)")
 	}
-	b.WriteString(fmt.Sprintf("  Type: %s\n", typeStr))
-	b.WriteString(fmt.Sprintf("  Party Type: %v\n", m.itemData["party_type"]))
-	b.WriteString(fmt.Sprintf("  Party: %v\n", m.itemData["party"]))
-	b.WriteString(fmt.Sprintf("  Date: %v\n", m.itemData["posting_date"]))
+	b.WriteString(tf("  Type: %s\n", typeStr))
+	b.WriteString(tf("  Party Type: %v\n", m.itemData["party_type"]))
+	b.WriteString(tf("  Party: %v\n", m.itemData["party"]))
+	b.WriteString(tf("  Date: %v\n", m.itemData["posting_date"]))
 
 	status, _ := m.itemData["status"].(string)
 	statusStyle := helpStyle
@@ -1333,23 +1430,23 @@ func (m Model) renderPaymentDetail() string {
 	case "Cancelled":
 		statusStyle = errorStyle
 	}
-	b.WriteString(fmt.Sprintf("  Status: %s\n", statusStyle.Render(status)))
+	b.WriteString(tf("  Status: %s\n", statusStyle.Render(status)))
 
 	paidAmount, _ := m.itemData["paid_amount"].(float64)
-	b.WriteString(fmt.Sprintf("  Paid Amount: %s\n", m.client.FormatCurrency(paidAmount)))
+	b.WriteString(tf("  Paid Amount: %s\n", m.client.FormatCurrency(paidAmount)))
 
 	if mop, ok := m.itemData["mode_of_payment"]; ok && mop != nil && mop != "" {
-		b.WriteString(fmt.Sprintf("  Mode of Payment: %v\n", mop))
+		b.WriteString(tf("  Mode of Payment: %v\n", mop))
 	}
 
 	if refs, ok := m.itemData["references"].([]interface{}); ok && len(refs) > 0 {
-		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render("References:")))
+		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render(T("References:"))))
 		for _, ref := range refs {
 			if r, ok := ref.(map[string]interface{}); ok {
 				refDoctype := r["reference_doctype"]
 				refName := r["reference_name"]
 				allocated, _ := r["allocated_amount"].(float64)
-				b.WriteString(fmt.Sprintf("    - %s: %s (Allocated: %s)\n", refDoctype, refName, m.client.FormatCurrency(allocated)))
+				b.WriteString(tf("    - %s: %s (Allocated: %s)\n", refDoctype, refName, m.client.FormatCurrency(allocated)))
 			}
 		}
 	}
@@ -1359,19 +1456,22 @@ func (m Model) renderPaymentDetail() string {
 
 // initCreatePaymentForm initializes the create payment form
 func (m *Model) initCreatePaymentForm(invoiceName string, paymentType string) {
-	m.inputs = make([]textinput.Model, 2)
+	m.inputs = make([]textinput.Model, 3)
 
 	m.inputs[0] = textinput.New()
 	if paymentType == "Receive" {
-		m.inputs[0].Placeholder = "Sales Invoice Name"
+		m.inputs[0].Placeholder = T("Sales Invoice Name")
 	} else {
-		m.inputs[0].Placeholder = "Purchase Invoice Name"
+		m.inputs[0].Placeholder = T("Purchase Invoice Name")
 	}
 	m.inputs[0].SetValue(invoiceName)
 	m.inputs[0].Focus()
 
 	m.inputs[1] = textinput.New()
-	m.inputs[1].Placeholder = "Amount (leave empty for full amount)"
+	m.inputs[1].Placeholder = T("Amount (leave empty for full amount)")
+
+	m.inputs[2] = newDateInput()
+	m.addOptionInputs(ViewCreatePayment)
 
 	m.focusIndex = 0
 	m.formData["payment_type"] = paymentType
@@ -1383,18 +1483,21 @@ func (m Model) renderCreatePayment() string {
 
 	paymentType := m.formData["payment_type"]
 	if paymentType == "Receive" {
-		b.WriteString(titleStyle.Render(" Create Payment (Receive) ") + "\n\n")
-		b.WriteString("  Sales Invoice:\n")
+		b.WriteString(titleStyle.Render(T(" Create Payment (Receive) ")) + "\n\n")
+		b.WriteString(T("  Sales Invoice:\n"))
 	} else {
-		b.WriteString(titleStyle.Render(" Create Payment (Pay) ") + "\n\n")
-		b.WriteString("  Purchase Invoice:\n")
+		b.WriteString(titleStyle.Render(T(" Create Payment (Pay) ")) + "\n\n")
+		b.WriteString(T("  Purchase Invoice:\n"))
 	}
 	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputs[0].View()))
 
-	b.WriteString("  Amount (optional):\n")
-	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputs[1].View()))
+	b.WriteString(T("  Amount (optional):\n"))
+	b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(1)))
+
+	b.WriteString(m.renderDateInput(2))
+	b.WriteString(m.renderOptionInputs())
 
-	b.WriteString(helpStyle.Render("  Leave amount empty to pay the full outstanding balance"))
+	b.WriteString(helpStyle.Render(trHelp("  Leave amount empty to pay the full outstanding balance")))
 
 	return boxStyle.Render(b.String())
 }
@@ -1407,7 +1510,7 @@ func (m Model) submitCreatePayment() tea.Cmd {
 		paymentType := m.formData["payment_type"]
 
 		if invoiceName == "" {
-			return formSubmittedMsg{false, "Invoice name is required"}
+			return formSubmittedMsg{false, T("Invoice name is required")}
 		}
 
 		amount := 0.0
@@ -1415,91 +1518,35 @@ func (m Model) submitCreatePayment() tea.Cmd {
 			var err error
 			amount, err = strconv.ParseFloat(amountStr, 64)
 			if err != nil {
-				return formSubmittedMsg{false, "Invalid amount"}
+				return formSubmittedMsg{false, T("Invalid amount")}
 			}
 		}
 
-		// Determine invoice type based on payment type
-		isReceive := paymentType == "Receive"
-		invoiceDoctype := "Purchase%20Invoice"
-		invoiceLabel := "Purchase invoice"
-		partyType := "Supplier"
-		partyField := "supplier"
-		refDoctype := "Purchase Invoice"
-		if isReceive {
-			invoiceDoctype = "Sales%20Invoice"
-			invoiceLabel = "Sales invoice"
-			partyType = "Customer"
-			partyField = "customer"
-			refDoctype = "Sales Invoice"
-		}
-
-		// Get the invoice
-		encoded := url.PathEscape(invoiceName)
-		result, err := m.client.Request("GET", invoiceDoctype+"/"+encoded, nil)
+		cur, err := parseCurrencyFlags(m.optionArgs())
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
 
-		invoiceData, ok := result["data"].(map[string]interface{})
-		if !ok {
-			return formSubmittedMsg{false, invoiceLabel + " not found"}
-		}
-
-		docStatus, _ := invoiceData["docstatus"].(float64)
-		if docStatus != 1 {
-			return formSubmittedMsg{false, invoiceLabel + " must be submitted first"}
-		}
-
-		outstanding, _ := invoiceData["outstanding_amount"].(float64)
-		if outstanding <= 0 {
-			return formSubmittedMsg{false, invoiceLabel + " has no outstanding amount"}
-		}
-
-		paidAmount := outstanding
-		if amount > 0 {
-			if amount > outstanding {
-				return formSubmittedMsg{false, fmt.Sprintf("Amount exceeds outstanding balance of %s", m.client.FormatCurrency(outstanding))}
-			}
-			paidAmount = amount
-		}
-
-		party, _ := invoiceData[partyField].(string)
-		grandTotal, _ := invoiceData["grand_total"].(float64)
-
-		company, err := m.client.GetCompany()
+		// Foreign-currency invoices get the payment date's exchange rate,
+		// unless one is given
+		body, err := m.client.paymentFromInvoice(invoiceName, amount, paymentType, m.formPosting(2))
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
+		if _, err := m.client.paymentCurrency(body, cur); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
 
-		body := map[string]interface{}{
-			"payment_type": paymentType,
-			"party_type":   partyType,
-			"party":        party,
-			"paid_amount":  paidAmount,
-			"posting_date": time.Now().Format("2006-01-02"),
-			"company":      company,
-			"references": []map[string]interface{}{
-				{
-					"reference_doctype":  refDoctype,
-					"reference_name":     invoiceName,
-					"total_amount":       grandTotal,
-					"outstanding_amount": outstanding,
-					"allocated_amount":   paidAmount,
-				},
-			},
-		}
-
-		result, err = m.client.Request("POST", "Payment%20Entry", body)
+		result, err := m.client.Request("POST", "Payment%20Entry", body)
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
 
 		if data, ok := result["data"].(map[string]interface{}); ok {
-			return formSubmittedMsg{true, fmt.Sprintf("Payment created: %s", data["name"])}
+			return formSubmittedMsg{true, tf("Payment created: %s", data["name"])}
 		}
 
-		return formSubmittedMsg{false, "Failed to create payment"}
+		return formSubmittedMsg{false, T("Failed to create payment")}
 	}
 }
 
@@ -1510,7 +1557,7 @@ func (m Model) submitPayment(name string) tea.Cmd {
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
-		return formSubmittedMsg{true, fmt.Sprintf("Payment submitted: %s", name)}
+		return formSubmittedMsg{true, tf("Payment submitted: %s", name)}
 	}
 }
 
@@ -1521,7 +1568,7 @@ func (m Model) cancelPayment(name string) tea.Cmd {
 		if err != nil {
 			return formSubmittedMsg{false, err.Error()}
 		}
-		return formSubmittedMsg{true, fmt.Sprintf("Payment cancelled: %s", name)}
+		return formSubmittedMsg{true, tf("Payment cancelled: %s", name)}
 	}
 }
 
@@ -1565,7 +1612,7 @@ func (m *Model) handleSalesKeys(key string) (tea.Model, tea.Cmd) {
 			if m.itemData != nil {
 				if docStatus, ok := m.itemData["docstatus"].(float64); ok && docStatus == 0 {
 					m.confirmAction = "submit_quotation"
-					m.confirmMsg = fmt.Sprintf("Submit Quotation %s?", m.selectedItem)
+					m.confirmMsg = tf("Submit Quotation %s?", m.selectedItem)
 					m.prevView = m.view
 					m.view = ViewConfirmAction
 					return m, nil
@@ -1575,7 +1622,7 @@ func (m *Model) handleSalesKeys(key string) (tea.Model, tea.Cmd) {
 			if m.itemData != nil {
 				if docStatus, ok := m.itemData["docstatus"].(float64); ok && docStatus == 1 {
 					m.confirmAction = "cancel_quotation"
-					m.confirmMsg = fmt.Sprintf("Cancel Quotation %s?", m.selectedItem)
+					m.confirmMsg = tf("Cancel Quotation %s?", m.selectedItem)
 					m.prevView = m.view
 					m.view = ViewConfirmAction
 					return m, nil
@@ -1618,7 +1665,7 @@ func (m *Model) handleSalesKeys(key string) (tea.Model, tea.Cmd) {
 			if m.itemData != nil {
 				if docStatus, ok := m.itemData["docstatus"].(float64); ok && docStatus == 0 {
 					m.confirmAction = "submit_so"
-					m.confirmMsg = fmt.Sprintf("Submit SO %s?", m.selectedItem)
+					m.confirmMsg = tf("Submit SO %s?", m.selectedItem)
 					m.prevView = m.view
 					m.view = ViewConfirmAction
 					return m, nil
@@ -1628,7 +1675,7 @@ func (m *Model) handleSalesKeys(key string) (tea.Model, tea.Cmd) {
 			if m.itemData != nil {
 				if docStatus, ok := m.itemData["docstatus"].(float64); ok && docStatus == 1 {
 					m.confirmAction = "cancel_so"
-					m.confirmMsg = fmt.Sprintf("Cancel SO %s?", m.selectedItem)
+					m.confirmMsg = tf("Cancel SO %s?", m.selectedItem)
 					m.prevView = m.view
 					m.view = ViewConfirmAction
 					return m, nil
@@ -1668,7 +1715,7 @@ func (m *Model) handleSalesKeys(key string) (tea.Model, tea.Cmd) {
 			if m.itemData != nil {
 				if docStatus, ok := m.itemData["docstatus"].(float64); ok && docStatus == 0 {
 					m.confirmAction = "submit_dn"
-					m.confirmMsg = fmt.Sprintf("Submit Delivery Note %s?", m.selectedItem)
+					m.confirmMsg = tf("Submit Delivery Note %s?", m.selectedItem)
 					m.prevView = m.view
 					m.view = ViewConfirmAction
 					return m, nil
@@ -1678,7 +1725,7 @@ func (m *Model) handleSalesKeys(key string) (tea.Model, tea.Cmd) {
 			if m.itemData != nil {
 				if docStatus, ok := m.itemData["docstatus"].(float64); ok && docStatus == 1 {
 					m.confirmAction = "cancel_dn"
-					m.confirmMsg = fmt.Sprintf("Cancel Delivery Note %s?", m.selectedItem)
+					m.confirmMsg = tf("Cancel Delivery Note %s?", m.selectedItem)
 					m.prevView = m.view
 					m.view = ViewConfirmAction
 					return m, nil
@@ -1700,7 +1747,7 @@ func (m *Model) handleSalesKeys(key string) (tea.Model, tea.Cmd) {
 			if m.itemData != nil {
 				if docStatus, ok := m.itemData["docstatus"].(float64); ok && docStatus == 0 {
 					m.confirmAction = "submit_si"
-					m.confirmMsg = fmt.Sprintf("Submit Invoice %s?", m.selectedItem)
+					m.confirmMsg = tf("Submit Invoice %s?", m.selectedItem)
 					m.prevView = m.view
 					m.view = ViewConfirmAction
 					return m, nil
@@ -1710,7 +1757,7 @@ func (m *Model) handleSalesKeys(key string) (tea.Model, tea.Cmd) {
 			if m.itemData != nil {
 				if docStatus, ok := m.itemData["docstatus"].(float64); ok && docStatus == 1 {
 					m.confirmAction = "cancel_si"
-					m.confirmMsg = fmt.Sprintf("Cancel Invoice %s?", m.selectedItem)
+					m.confirmMsg = tf("Cancel Invoice %s?", m.selectedItem)
 					m.prevView = m.view
 					m.view = ViewConfirmAction
 					return m, nil
@@ -1736,7 +1783,7 @@ func (m *Model) handleSalesKeys(key string) (tea.Model, tea.Cmd) {
 			if m.itemData != nil {
 				if docStatus, ok := m.itemData["docstatus"].(float64); ok && docStatus == 0 {
 					m.confirmAction = "submit_payment"
-					m.confirmMsg = fmt.Sprintf("Submit Payment %s?", m.selectedItem)
+					m.confirmMsg = tf("Submit Payment %s?", m.selectedItem)
 					m.prevView = m.view
 					m.view = ViewConfirmAction
 					return m, nil
@@ -1746,7 +1793,7 @@ func (m *Model) handleSalesKeys(key string) (tea.Model, tea.Cmd) {
 			if m.itemData != nil {
 				if docStatus, ok := m.itemData["docstatus"].(float64); ok && docStatus == 1 {
 					m.confirmAction = "cancel_payment"
-					m.confirmMsg = fmt.Sprintf("Cancel Payment %s?", m.selectedItem)
+					m.confirmMsg = tf("Cancel Payment %s?", m.selectedItem)
 					m.prevView = m.view
 					m.view = ViewConfirmAction
 					return m, nil
@@ -1757,3 +1804,30 @@ func (m *Model) handleSalesKeys(key string) (tea.Model, tea.Cmd) {
 
 	return m, nil
 }
+
+// renderPartyLinks renders the addresses and contacts loaded with a customer/supplier
+func (m Model) renderPartyLinks() string {
+	var b strings.Builder
+	if m.client.LowBandwidth() {
+		b.WriteString(fmt.Sprintf("\n  %s\n", helpStyle.Render(trHelp("Addresses and contacts not loaded (low-bandwidth mode)"))))
+		return b.String()
+	}
+
+	addresses, _ := m.itemData["_addresses"].([]map[string]interface{})
+	if len(addresses) == 0 {
+		b.WriteString(fmt.Sprintf("\n  %s\n", helpStyle.Render(trHelp("No addresses"))))
+	} else {
+		b.WriteString(tf("\n  Addresses (%d):\n", len(addresses)))
+		for _, a := range addresses {
+			b.WriteString(fmt.Sprintf("    • [%s] %s\n", addressLabel(a), formatAddress(a)))
+		}
+	}
+
+	if contacts, ok := m.itemData["_contacts"].([]map[string]interface{}); ok && len(contacts) > 0 {
+		b.WriteString(tf("\n  Contacts (%d):\n", len(contacts)))
+		for _, ct := range contacts {
+			b.WriteString(fmt.Sprintf("    • %s\n", formatContact(ct)))
+		}
+	}
+	return b.String()
+}
@@ -41,39 +41,15 @@ type SetupModel struct {
 	activeURL  string // URL used for connection
 }
 
-// Setup wizard styles
+// Setup wizard styles, set from the theme by applyTheme
 var (
-	setupTitleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("#FAFAFA")).
-			Background(lipgloss.Color("#7D56F4")).
-			Padding(0, 1).
-			MarginBottom(1)
-
-	setupBoxStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("#7D56F4")).
-			Padding(1, 2).
-			Width(60)
-
-	setupLabelStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#7D56F4")).
-			Bold(true)
-
-	setupHintStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#888888")).
-			Italic(true)
-
-	setupSuccessStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("#04B575")).
-				Bold(true)
-
-	setupErrorStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FF4444")).
-			Bold(true)
-
-	setupHelpStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#626262"))
+	setupTitleStyle   lipgloss.Style
+	setupBoxStyle     lipgloss.Style
+	setupLabelStyle   lipgloss.Style
+	setupHintStyle    lipgloss.Style
+	setupSuccessStyle lipgloss.Style
+	setupErrorStyle   lipgloss.Style
+	setupHelpStyle    lipgloss.Style
 )
 
 // Messages for setup wizard
@@ -97,50 +73,45 @@ func NewSetupTUI() SetupModel {
 
 	// ERP URL
 	inputs[0] = textinput.New()
-	inputs[0].Placeholder = "https://your-erp.example.com"
+	inputs[0].Placeholder = T("https://your-erp.example.com")
 	inputs[0].CharLimit = 256
 	inputs[0].Width = 50
 
 	// API Key
 	inputs[1] = textinput.New()
-	inputs[1].Placeholder = "API Key from User Settings > API Access"
+	inputs[1].Placeholder = T("API Key from User Settings > API Access")
 	inputs[1].CharLimit = 64
 	inputs[1].Width = 50
 
 	// API Secret
 	inputs[2] = textinput.New()
-	inputs[2].Placeholder = "API Secret (generated with the key)"
+	inputs[2].Placeholder = T("API Secret (generated with the key)")
 	inputs[2].CharLimit = 64
 	inputs[2].Width = 50
 	inputs[2].EchoMode = textinput.EchoPassword
 
 	// VPN URL (optional)
 	inputs[3] = textinput.New()
-	inputs[3].Placeholder = "http://192.168.1.100:8000 (optional)"
+	inputs[3].Placeholder = T("http://192.168.1.100:8000 (optional)")
 	inputs[3].CharLimit = 256
 	inputs[3].Width = 50
 
 	// Nginx Cookie (optional)
 	inputs[4] = textinput.New()
-	inputs[4].Placeholder = "Cookie value for reverse proxy (optional)"
+	inputs[4].Placeholder = T("Cookie value for reverse proxy (optional)")
 	inputs[4].CharLimit = 256
 	inputs[4].Width = 50
 
 	// Nginx Cookie Name (optional)
 	inputs[5] = textinput.New()
-	inputs[5].Placeholder = "auth_cookie (default)"
+	inputs[5].Placeholder = T("auth_cookie (default)")
 	inputs[5].CharLimit = 64
 	inputs[5].Width = 50
 
-	// Initialize spinner
-	s := spinner.New()
-	s.Spinner = spinner.Dot
-	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("#7D56F4"))
-
 	return SetupModel{
 		step:    SetupWelcome,
 		inputs:  inputs,
-		spinner: s,
+		spinner: newSpinner(),
 	}
 }
 
@@ -336,7 +307,7 @@ func validateConnection(url, apiKey, apiSecret, cookieName, cookieValue string)
 	fullURL := fmt.Sprintf("%s/api/method/frappe.auth.get_logged_user", url)
 	req, err := http.NewRequest("GET", fullURL, nil)
 	if err != nil {
-		return "", fmt.Errorf("invalid URL: %w", err)
+		return "", errorf("invalid URL: %w", err)
 	}
 
 	req.Header.Set("Authorization", fmt.Sprintf("token %s:%s", apiKey, apiSecret))
@@ -349,22 +320,22 @@ func validateConnection(url, apiKey, apiSecret, cookieName, cookieValue string)
 
 	resp, err := client.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("connection failed: %w", err)
+		return "", errorf("connection failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == 401 || resp.StatusCode == 403 {
-		return "", fmt.Errorf("authentication failed: invalid API key or secret")
+		return "", errorf("authentication failed: invalid API key or secret")
 	}
 
 	if resp.StatusCode != 200 {
-		return "", fmt.Errorf("server error: HTTP %d", resp.StatusCode)
+		return "", errorf("server error: HTTP %d", resp.StatusCode)
 	}
 
 	// Parse response to get username
 	var result map[string]interface{}
 	if err := decodeJSON(resp.Body, &result); err != nil {
-		return "", fmt.Errorf("invalid response from server")
+		return "", errorf("invalid response from server")
 	}
 
 	if msg, ok := result["message"].(string); ok && msg != "" {
@@ -447,14 +418,14 @@ func (m SetupModel) View() string {
 		content = m.renderError()
 	}
 
-	return content
+	return asciiText(content)
 }
 
 func (m SetupModel) renderWelcome() string {
 	var sb strings.Builder
 
 	sb.WriteString("\n")
-	sb.WriteString(setupTitleStyle.Render("  Welcome to ERPNext CLI  "))
+	sb.WriteString(setupTitleStyle.Render(T("  Welcome to ERPNext CLI  ")))
 	sb.WriteString("\n\n")
 
 	welcomeText := `No configuration file found.
@@ -465,8 +436,8 @@ You'll need:
   * API Key & Secret (User Settings > API Access)
 
 `
-	sb.WriteString(welcomeText)
-	sb.WriteString(setupHelpStyle.Render("[Enter] Continue    [Esc] Cancel"))
+	sb.WriteString(T(welcomeText))
+	sb.WriteString(setupHelpStyle.Render(T("[Enter] Continue    [Esc] Cancel")))
 
 	return setupBoxStyle.Render(sb.String())
 }
@@ -475,64 +446,64 @@ func (m SetupModel) renderForm() string {
 	var sb strings.Builder
 
 	sb.WriteString("\n")
-	sb.WriteString(setupTitleStyle.Render("  Setup (1/1)  "))
+	sb.WriteString(setupTitleStyle.Render(T("  Setup (1/1)  ")))
 	sb.WriteString("\n\n")
 
 	// URL field
-	sb.WriteString(setupLabelStyle.Render("ERPNext URL *"))
+	sb.WriteString(setupLabelStyle.Render(T("ERPNext URL *")))
 	sb.WriteString("\n")
 	sb.WriteString(m.inputs[0].View())
 	sb.WriteString("\n")
-	sb.WriteString(setupHintStyle.Render("Example: https://erp.mycompany.com"))
+	sb.WriteString(setupHintStyle.Render(T("Example: https://erp.mycompany.com")))
 	sb.WriteString("\n\n")
 
 	// API Key field
-	sb.WriteString(setupLabelStyle.Render("API Key *"))
+	sb.WriteString(setupLabelStyle.Render(T("API Key *")))
 	sb.WriteString("\n")
 	sb.WriteString(m.inputs[1].View())
 	sb.WriteString("\n")
-	sb.WriteString(setupHintStyle.Render("Find in: User Settings > API Access"))
+	sb.WriteString(setupHintStyle.Render(T("Find in: User Settings > API Access")))
 	sb.WriteString("\n\n")
 
 	// API Secret field
-	sb.WriteString(setupLabelStyle.Render("API Secret *"))
+	sb.WriteString(setupLabelStyle.Render(T("API Secret *")))
 	sb.WriteString("\n")
 	sb.WriteString(m.inputs[2].View())
 	sb.WriteString("\n")
-	sb.WriteString(setupHintStyle.Render("Generated with API Key"))
+	sb.WriteString(setupHintStyle.Render(T("Generated with API Key")))
 	sb.WriteString("\n\n")
 
 	// VPN URL field (optional)
-	sb.WriteString(setupLabelStyle.Render("VPN/Direct URL"))
+	sb.WriteString(setupLabelStyle.Render(T("VPN/Direct URL")))
 	sb.WriteString(" ")
-	sb.WriteString(setupHintStyle.Render("(optional)"))
+	sb.WriteString(setupHintStyle.Render(T("(optional)")))
 	sb.WriteString("\n")
 	sb.WriteString(m.inputs[3].View())
 	sb.WriteString("\n")
-	sb.WriteString(setupHintStyle.Render("Direct connection tried first"))
+	sb.WriteString(setupHintStyle.Render(T("Direct connection tried first")))
 	sb.WriteString("\n\n")
 
 	// Nginx Cookie field (optional)
-	sb.WriteString(setupLabelStyle.Render("Nginx Cookie"))
+	sb.WriteString(setupLabelStyle.Render(T("Nginx Cookie")))
 	sb.WriteString(" ")
-	sb.WriteString(setupHintStyle.Render("(optional)"))
+	sb.WriteString(setupHintStyle.Render(T("(optional)")))
 	sb.WriteString("\n")
 	sb.WriteString(m.inputs[4].View())
 	sb.WriteString("\n")
-	sb.WriteString(setupHintStyle.Render("For reverse proxy authentication"))
+	sb.WriteString(setupHintStyle.Render(T("For reverse proxy authentication")))
 	sb.WriteString("\n\n")
 
 	// Nginx Cookie Name field (optional)
-	sb.WriteString(setupLabelStyle.Render("Cookie Name"))
+	sb.WriteString(setupLabelStyle.Render(T("Cookie Name")))
 	sb.WriteString(" ")
-	sb.WriteString(setupHintStyle.Render("(optional)"))
+	sb.WriteString(setupHintStyle.Render(T("(optional)")))
 	sb.WriteString("\n")
 	sb.WriteString(m.inputs[5].View())
 	sb.WriteString("\n")
-	sb.WriteString(setupHintStyle.Render("Default: auth_cookie"))
+	sb.WriteString(setupHintStyle.Render(T("Default: auth_cookie")))
 	sb.WriteString("\n\n")
 
-	sb.WriteString(setupHelpStyle.Render("[Tab] Next field    [Enter] Submit    [Esc] Cancel"))
+	sb.WriteString(setupHelpStyle.Render(T("[Tab] Next field    [Enter] Submit    [Esc] Cancel")))
 
 	return setupBoxStyle.Render(sb.String())
 }
@@ -541,15 +512,15 @@ func (m SetupModel) renderValidating() string {
 	var sb strings.Builder
 
 	sb.WriteString("\n")
-	sb.WriteString(setupTitleStyle.Render("  Validating  "))
+	sb.WriteString(setupTitleStyle.Render(T("  Validating  ")))
 	sb.WriteString("\n\n")
 
 	sb.WriteString(m.spinner.View())
-	sb.WriteString(" Testing connection to ERPNext...")
+	sb.WriteString(T(" Testing connection to ERPNext..."))
 	sb.WriteString("\n\n")
 
-	sb.WriteString(fmt.Sprintf("URL: %s\n", m.inputs[0].Value()))
-	sb.WriteString(fmt.Sprintf("API Key: %s...\n", truncate(m.inputs[1].Value(), 8)))
+	sb.WriteString(tf("URL: %s\n", m.inputs[0].Value()))
+	sb.WriteString(tf("API Key: %s...\n", truncate(m.inputs[1].Value(), 8)))
 
 	return setupBoxStyle.Render(sb.String())
 }
@@ -558,25 +529,25 @@ func (m SetupModel) renderSuccess() string {
 	var sb strings.Builder
 
 	sb.WriteString("\n")
-	sb.WriteString(setupSuccessStyle.Render("  Setup Complete!  "))
+	sb.WriteString(setupSuccessStyle.Render(T("  Setup Complete!  ")))
 	sb.WriteString("\n\n")
 
-	sb.WriteString("Configuration saved to: ")
+	sb.WriteString(T("Configuration saved to: "))
 	sb.WriteString(setupLabelStyle.Render(".erp-config"))
 	sb.WriteString("\n\n")
 
-	sb.WriteString(fmt.Sprintf("Connected as: %s\n", setupLabelStyle.Render(m.user)))
+	sb.WriteString(tf("Connected as: %s\n", setupLabelStyle.Render(m.user)))
 
 	if m.mode == "vpn" {
-		sb.WriteString("Mode: ")
-		sb.WriteString(vpnStyle.Render("VPN direct"))
+		sb.WriteString(T("Mode: "))
+		sb.WriteString(vpnStyle.Render(T("VPN direct")))
 	} else {
-		sb.WriteString("Mode: ")
-		sb.WriteString(internetStyle.Render("Internet"))
+		sb.WriteString(T("Mode: "))
+		sb.WriteString(internetStyle.Render(T("Internet")))
 	}
 	sb.WriteString("\n\n")
 
-	sb.WriteString(setupHelpStyle.Render("[Enter] Start ERPNext CLI"))
+	sb.WriteString(setupHelpStyle.Render(T("[Enter] Start ERPNext CLI")))
 
 	return setupBoxStyle.Render(sb.String())
 }
@@ -585,19 +556,19 @@ func (m SetupModel) renderError() string {
 	var sb strings.Builder
 
 	sb.WriteString("\n")
-	sb.WriteString(setupErrorStyle.Render("  Connection Failed  "))
+	sb.WriteString(setupErrorStyle.Render(T("  Connection Failed  ")))
 	sb.WriteString("\n\n")
 
 	if m.err != nil {
-		sb.WriteString(fmt.Sprintf("Error: %s\n\n", m.err.Error()))
+		sb.WriteString(tf("Error: %s\n\n", m.err.Error()))
 	}
 
-	sb.WriteString("Please check:\n")
-	sb.WriteString("  * URL is correct and reachable\n")
-	sb.WriteString("  * API Key and Secret are valid\n")
-	sb.WriteString("  * Your ERPNext instance is running\n\n")
+	sb.WriteString(T("Please check:\n"))
+	sb.WriteString(T("  * URL is correct and reachable\n"))
+	sb.WriteString(T("  * API Key and Secret are valid\n"))
+	sb.WriteString(T("  * Your ERPNext instance is running\n\n"))
 
-	sb.WriteString(setupHelpStyle.Render("[Enter] Try again    [Esc] Cancel"))
+	sb.WriteString(setupHelpStyle.Render(T("[Enter] Try again    [Esc] Cancel")))
 
 	return setupBoxStyle.Render(sb.String())
 }
@@ -611,6 +582,8 @@ func truncate(s string, length int) string {
 
 // RunSetupTUI runs the setup wizard
 func RunSetupTUI() error {
+	// No config yet, so the wizard has the default theme
+	applyTheme(builtinThemes["default"])
 	p := tea.NewProgram(NewSetupTUI(), tea.WithAltScreen())
 	_, err := p.Run()
 	return err
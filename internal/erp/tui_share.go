@@ -0,0 +1,137 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/atotto/clipboard"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/muesli/termenv"
+)
+
+// ============================================================================
+// SHARING (y/Y copy the name or a field, w opens the document in the browser)
+// ============================================================================
+
+// shareDoctypes are the doctypes of every detail view. The stock detail is
+// the item's stock, so it shares the item.
+var shareDoctypes = map[View]string{
+	ViewAttrDetail:         "Item Attribute",
+	ViewItemDetail:         "Item",
+	ViewStockDetail:        "Item",
+	ViewSerialDetail:       "Serial No",
+	ViewSupplierDetail:     "Supplier",
+	ViewCustomerDetail:     "Customer",
+	ViewWODetail:           "Work Order",
+	ViewBOMDetail:          "BOM",
+	ViewSubscriptionDetail: "Subscription",
+	ViewEmployeeDetail:     "Employee",
+	ViewExpenseClaimDetail: "Expense Claim",
+	ViewQuotationDetail:    "Quotation",
+	ViewSODetail:           "Sales Order",
+	ViewSIDetail:           "Sales Invoice",
+	ViewDNDetail:           "Delivery Note",
+	ViewPODetail:           "Purchase Order",
+	ViewPIDetail:           "Purchase Invoice",
+	ViewPRDetail:           "Purchase Receipt",
+	ViewPaymentDetail:      "Payment Entry",
+}
+
+// documentURL is the desk URL of a document. It uses the internet URL, since
+// colleagues the link is handed to may not reach the VPN.
+func (c *Client) documentURL(doctype, name string) string {
+	base := c.Config.ERPURL
+	if base == "" {
+		base = c.ActiveURL
+	}
+	slug := strings.ReplaceAll(strings.ToLower(doctype), " ", "-")
+	return fmt.Sprintf("%s/app/%s/%s", strings.TrimRight(base, "/"), slug, url.PathEscape(name))
+}
+
+// copyText puts text on the system clipboard. Without one (over SSH, or no
+// xclip, xsel or wl-copy) it asks the terminal to copy it with OSC 52.
+func copyText(text string) string {
+	if os.Getenv("SSH_TTY") == "" {
+		if err := clipboard.WriteAll(text); err == nil {
+			return ""
+		}
+	}
+	termenv.Copy(text)
+	return T(" (through the terminal)")
+}
+
+// handleShareKeys handles y, Y and w in the detail views
+func (m *Model) handleShareKeys(key string) (tea.Model, tea.Cmd) {
+	doctype, ok := shareDoctypes[m.view]
+	if !ok || m.loading || m.selectedItem == "" {
+		return m, nil
+	}
+
+	switch key {
+	case "y":
+		return m.copied(m.selectedItem, "name")
+	case "Y":
+		return m.openFieldPicker()
+	case "w":
+		link := m.client.documentURL(doctype, m.selectedItem)
+		return m, func() tea.Msg {
+			if err := openFile(link); err != nil {
+				return formSubmittedMsg{false, err.Error()}
+			}
+			return formSubmittedMsg{true, tf("Opened %s", link)}
+		}
+	}
+	return m, nil
+}
+
+// copied copies a value and says so in the notification
+func (m *Model) copied(value, what string) (tea.Model, tea.Cmd) {
+	how := copyText(value)
+	m.notification = tf("Copied %s %s%s", what, truncate(value, 40), how)
+	m.notificationType = "success"
+	m.showNotification = true
+	return m, tea.Tick(3*time.Second, func(time.Time) tea.Msg {
+		return clearNotificationMsg{}
+	})
+}
+
+// openFieldPicker opens the palette over the document's fields, to pick the
+// one to copy
+func (m *Model) openFieldPicker() (tea.Model, tea.Cmd) {
+	input := textinput.New()
+	input.Placeholder = T("Field name or value")
+	input.Focus()
+
+	entries := []paletteEntry{{title: m.selectedItem, hint: "name", copy: m.selectedItem}}
+	fields := make([]string, 0, len(m.itemData))
+	for field := range m.itemData {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		var text string
+		switch value := m.itemData[field].(type) {
+		case string:
+			text = value
+		case float64:
+			text = strconv.FormatFloat(value, 'f', -1, 64)
+		default:
+			continue // Child tables and empty values
+		}
+		if text == "" || field == "name" || field == "doctype" {
+			continue
+		}
+		entries = append(entries, paletteEntry{title: text, hint: field, copy: text})
+	}
+
+	p := &commandPalette{input: input, entries: entries, fields: true}
+	m.palette = p
+	p.filter()
+	return m, textinput.Blink
+}
@@ -0,0 +1,148 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ============================================================================
+// SPLIT LAYOUT (wide terminals show the selected document beside the list)
+// ============================================================================
+
+// splitMinWidth is the narrowest terminal the paged lists split on
+const splitMinWidth = 140
+
+// splitListWidth is the width of the list in the split layout
+const splitListWidth = 64
+
+// previewDelay lets the cursor settle before the preview is fetched, so
+// scrolling through a page does not fetch every document on the way
+const previewDelay = 150 * time.Millisecond
+
+// listPreview holds the documents previewed beside the list, by name. It is
+// shared by the model's copies and emptied when a page loads.
+type listPreview struct {
+	want  string // Document the pane shows
+	cache map[string]previewMsg
+}
+
+type previewTickMsg struct {
+	doctype string
+	name    string
+}
+
+type previewMsg struct {
+	name string
+	data map[string]interface{}
+	err  error
+}
+
+// reset forgets the previews, so they are fetched again after a reload
+func (p *listPreview) reset() {
+	p.want = ""
+	p.cache = make(map[string]previewMsg)
+}
+
+// splitWidth reports whether the terminal is wide enough to split. The
+// preview requests are skipped in low-bandwidth mode.
+func (m Model) splitWidth() bool {
+	return m.width >= splitMinWidth && !m.client.LowBandwidth()
+}
+
+// splitPane reports whether the current view is shown split
+func (m Model) splitPane() bool {
+	return m.splitWidth() && m.isListView() && !m.boardMode
+}
+
+// followPreview points the preview at the document under the cursor and
+// fetches it once the cursor rests there
+func (m Model) followPreview() tea.Cmd {
+	if !m.splitPane() || m.loading {
+		return nil
+	}
+	item, ok := m.currentList.SelectedItem().(ListItem)
+	if !ok || item.name == m.preview.want {
+		return nil
+	}
+	m.preview.want = item.name
+	if _, cached := m.preview.cache[item.name]; cached {
+		return nil
+	}
+	tick := previewTickMsg{listSources[m.view].doctype, item.name}
+	return tea.Tick(previewDelay, func(time.Time) tea.Msg { return tick })
+}
+
+// loadPreview fetches the previewed document if the cursor is still on it
+func (m Model) loadPreview(msg previewTickMsg) tea.Cmd {
+	if msg.name != m.preview.want {
+		return nil
+	}
+	return func() tea.Msg {
+		result, err := m.client.Request("GET", url.PathEscape(msg.doctype)+"/"+url.PathEscape(msg.name), nil)
+		if err != nil {
+			return previewMsg{name: msg.name, err: err}
+		}
+		data, ok := result["data"].(map[string]interface{})
+		if !ok {
+			return previewMsg{name: msg.name, err: errorf("no data found")}
+		}
+		return previewMsg{name: msg.name, data: data}
+	}
+}
+
+// renderSplit renders the paged list with the selected document beside it
+func (m Model) renderSplit() string {
+	left := lipgloss.NewStyle().Width(splitListWidth).Render(m.renderPagedList())
+	right := lipgloss.NewStyle().
+		MaxWidth(m.width - splitListWidth - 2).
+		MaxHeight(m.height - 8).
+		Render(m.renderPreview())
+	return lipgloss.JoinHorizontal(lipgloss.Top, left, "  ", right)
+}
+
+// renderPreview renders the previewed document with its detail view
+func (m Model) renderPreview() string {
+	name := m.preview.want
+	entry, ok := m.preview.cache[name]
+	switch {
+	case name == "":
+		return helpStyle.Render(trHelp("  No document"))
+	case !ok:
+		return tf("\n  %s Loading %s...", m.spinner.View(), name)
+	case entry.err != nil:
+		return errorStyle.Render(fmt.Sprintf("  %s: %s", name, entry.err))
+	}
+
+	detail := m
+	detail.view = listSources[m.view].detail
+	detail.selectedItem = name
+	detail.itemData = entry.data
+	detail.loading = false
+
+	var b strings.Builder
+	switch detail.view {
+	case ViewQuotationDetail:
+		b.WriteString(detail.renderQuotationDetail())
+	case ViewSODetail:
+		b.WriteString(detail.renderSODetail())
+	case ViewSIDetail:
+		b.WriteString(detail.renderSIDetail())
+	case ViewDNDetail:
+		b.WriteString(detail.renderDNDetail())
+	case ViewPODetail:
+		b.WriteString(detail.renderPODetail())
+	case ViewPIDetail:
+		b.WriteString(detail.renderPIDetail())
+	case ViewPRDetail:
+		b.WriteString(detail.renderPRDetail())
+	case ViewPaymentDetail:
+		b.WriteString(detail.renderPaymentDetail())
+	}
+	b.WriteString("\n" + helpStyle.Render(trHelp("  enter: open for actions")))
+	return b.String()
+}
@@ -10,34 +10,24 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 )
 
-// loadWarehouses fetches all warehouses
+// loadWarehouses fetches all warehouses as an indented tree
 func (m Model) loadWarehouses() tea.Cmd {
 	return func() tea.Msg {
-		result, err := m.client.Request("GET", "Warehouse?limit_page_length=0&fields=[\"name\",\"warehouse_name\",\"is_group\",\"parent_warehouse\"]", nil)
+		roots, err := m.client.fetchWarehouseTree()
 		if err != nil {
 			return errorMsg{err}
 		}
 
 		var items []ListItem
-		if data, ok := result["data"].([]interface{}); ok {
-			for _, item := range data {
-				if im, ok := item.(map[string]interface{}); ok {
-					name := fmt.Sprintf("%v", im["name"])
-					isGroup, _ := im["is_group"].(float64)
-					parent := im["parent_warehouse"]
-
-					var detail string
-					if isGroup == 1 {
-						detail = "Group"
-					} else {
-						detail = "Warehouse"
-					}
-					if parent != nil && parent != "" {
-						detail += fmt.Sprintf(" (parent: %s)", parent)
-					}
-					items = append(items, ListItem{name: name, details: detail})
-				}
+		for _, line := range flattenTree(roots) {
+			detail := "Warehouse"
+			if line.node.isGroup {
+				detail = "Group"
+			}
+			if line.node.disabled {
+				detail += " [disabled]"
 			}
+			items = append(items, ListItem{name: line.node.name, details: detail, prefix: line.prefix})
 		}
 		return dataLoadedMsg{items}
 	}
@@ -102,10 +92,10 @@ func (m Model) renderStockDetail() string {
 	}
 
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Stock: "+m.selectedItem) + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Stock: ")+m.selectedItem) + "\n\n")
 
 	if len(m.listData) == 0 {
-		b.WriteString("  No stock found for this item\n")
+		b.WriteString(T("  No stock found for this item\n"))
 	} else {
 		totalQty := 0.0
 		totalValue := 0.0
@@ -121,21 +111,21 @@ func (m Model) renderStockDetail() string {
 			totalValue += stockValue
 
 			b.WriteString(fmt.Sprintf("  %s %s\n", boxStyle.BorderForeground(nil).Render(""), wh))
-			b.WriteString(fmt.Sprintf("     Actual: %.0f", actualQty))
+			b.WriteString(tf("     Actual: %.0f", actualQty))
 			if reservedQty > 0 {
-				b.WriteString(fmt.Sprintf(" | Reserved: %.0f", reservedQty))
+				b.WriteString(tf(" | Reserved: %.0f", reservedQty))
 			}
 			if orderedQty > 0 {
-				b.WriteString(fmt.Sprintf(" | Ordered: %.0f", orderedQty))
+				b.WriteString(tf(" | Ordered: %.0f", orderedQty))
 			}
 			if stockValue > 0 {
-				b.WriteString(fmt.Sprintf(" | Value: %s", m.client.FormatCurrency(stockValue)))
+				b.WriteString(tf(" | Value: %s", m.client.FormatCurrency(stockValue)))
 			}
 			b.WriteString("\n")
 		}
 
 		if len(m.listData) > 1 {
-			b.WriteString(fmt.Sprintf("\n  Total: %.0f units | Value: %s\n", totalQty, m.client.FormatCurrency(totalValue)))
+			b.WriteString(tf("\n  Total: %.0f units | Value: %s\n", totalQty, m.client.FormatCurrency(totalValue)))
 		}
 	}
 
@@ -145,7 +135,7 @@ func (m Model) renderStockDetail() string {
 // loadSerials fetches serial numbers
 func (m Model) loadSerials(itemCode string) tea.Cmd {
 	return func() tea.Msg {
-		endpoint := "Serial%20No?limit_page_length=100&fields=[\"name\",\"item_code\",\"warehouse\",\"status\"]&order_by=creation%20desc"
+		endpoint := "Serial%20No?" + m.client.pageLimit(100) + "&fields=[\"name\",\"item_code\",\"warehouse\",\"status\"]&order_by=creation%20desc"
 		if itemCode != "" {
 			filters := [][]interface{}{
 				{"item_code", "=", itemCode},
@@ -199,9 +189,15 @@ func (m Model) loadSerialDetail(serialNo string) tea.Cmd {
 		}
 
 		if data, ok := result["data"].(map[string]interface{}); ok {
+			// Tracing the delivery walks the stock ledger: skip it on slow links
+			if !m.client.LowBandwidth() {
+				if customer, doc := m.client.serialDeliveredTo(serialNo, data); customer != "" {
+					data["delivered_to"] = customer + " (" + doc + ")"
+				}
+			}
 			return itemDetailMsg{data}
 		}
-		return errorMsg{fmt.Errorf("no data found")}
+		return errorMsg{errorf("no data found")}
 	}
 }
 
@@ -212,11 +208,11 @@ func (m Model) renderSerialDetail() string {
 	}
 
 	if m.itemData == nil {
-		return "\n  No data"
+		return "\n  " + T("No data")
 	}
 
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Serial: "+m.selectedItem) + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Serial: ")+m.selectedItem) + "\n\n")
 
 	fields := []struct {
 		key   string
@@ -230,11 +226,12 @@ func (m Model) renderSerialDetail() string {
 		{"supplier", "Supplier"},
 		{"purchase_date", "Purchase Date"},
 		{"warranty_expiry_date", "Warranty Expiry"},
+		{"delivered_to", "Delivered To"},
 	}
 
 	for _, f := range fields {
 		if val, ok := m.itemData[f.key]; ok && val != nil && val != "" {
-			b.WriteString(fmt.Sprintf("  %s: %v\n", f.label, val))
+			b.WriteString(fmt.Sprintf("  %s: %v\n", T(f.label), val))
 		}
 	}
 
@@ -246,20 +243,22 @@ func (m *Model) initStockReceiveForm() {
 	m.inputs = make([]textinput.Model, 4)
 
 	m.inputs[0] = textinput.New()
-	m.inputs[0].Placeholder = "Item Code"
+	m.inputs[0].Placeholder = T("Item Code")
 	m.inputs[0].Focus()
 	m.inputs[0].SetValue(m.selectedItem)
 
 	m.inputs[1] = textinput.New()
-	m.inputs[1].Placeholder = "Quantity"
+	m.inputs[1].Placeholder = T("Quantity")
 
 	m.inputs[2] = textinput.New()
-	m.inputs[2].Placeholder = "Warehouse"
+	m.inputs[2].Placeholder = T("Warehouse")
+	m.inputs[2].SetValue(m.client.Config.DefaultWarehouse)
 
 	m.inputs[3] = textinput.New()
-	m.inputs[3].Placeholder = "Rate (optional)"
+	m.inputs[3].Placeholder = T("Rate (optional)")
 
 	m.focusIndex = 1 // Start at quantity since item is pre-filled
+	m.updateFocus()
 }
 
 // initStockTransferForm initializes the stock transfer form
@@ -267,20 +266,22 @@ func (m *Model) initStockTransferForm() {
 	m.inputs = make([]textinput.Model, 4)
 
 	m.inputs[0] = textinput.New()
-	m.inputs[0].Placeholder = "Item Code"
+	m.inputs[0].Placeholder = T("Item Code")
 	m.inputs[0].Focus()
 	m.inputs[0].SetValue(m.selectedItem)
 
 	m.inputs[1] = textinput.New()
-	m.inputs[1].Placeholder = "Quantity"
+	m.inputs[1].Placeholder = T("Quantity")
 
 	m.inputs[2] = textinput.New()
-	m.inputs[2].Placeholder = "From Warehouse"
+	m.inputs[2].Placeholder = T("From Warehouse")
+	m.inputs[2].SetValue(m.client.Config.DefaultWarehouse)
 
 	m.inputs[3] = textinput.New()
-	m.inputs[3].Placeholder = "To Warehouse"
+	m.inputs[3].Placeholder = T("To Warehouse")
 
 	m.focusIndex = 1
+	m.updateFocus()
 }
 
 // initStockIssueForm initializes the stock issue form
@@ -288,17 +289,19 @@ func (m *Model) initStockIssueForm() {
 	m.inputs = make([]textinput.Model, 3)
 
 	m.inputs[0] = textinput.New()
-	m.inputs[0].Placeholder = "Item Code"
+	m.inputs[0].Placeholder = T("Item Code")
 	m.inputs[0].Focus()
 	m.inputs[0].SetValue(m.selectedItem)
 
 	m.inputs[1] = textinput.New()
-	m.inputs[1].Placeholder = "Quantity"
+	m.inputs[1].Placeholder = T("Quantity")
 
 	m.inputs[2] = textinput.New()
-	m.inputs[2].Placeholder = "Warehouse"
+	m.inputs[2].Placeholder = T("Warehouse")
+	m.inputs[2].SetValue(m.client.Config.DefaultWarehouse)
 
 	m.focusIndex = 1
+	m.updateFocus()
 }
 
 // initCreateSerialForm initializes the create serial form
@@ -306,14 +309,14 @@ func (m *Model) initCreateSerialForm() {
 	m.inputs = make([]textinput.Model, 3)
 
 	m.inputs[0] = textinput.New()
-	m.inputs[0].Placeholder = "Serial Number"
+	m.inputs[0].Placeholder = T("Serial Number")
 	m.inputs[0].Focus()
 
 	m.inputs[1] = textinput.New()
-	m.inputs[1].Placeholder = "Item Code"
+	m.inputs[1].Placeholder = T("Item Code")
 
 	m.inputs[2] = textinput.New()
-	m.inputs[2].Placeholder = "Supplier (optional)"
+	m.inputs[2].Placeholder = T("Supplier (optional)")
 
 	m.focusIndex = 0
 }
@@ -321,12 +324,12 @@ func (m *Model) initCreateSerialForm() {
 // renderStockReceive renders the stock receive form
 func (m Model) renderStockReceive() string {
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Receive Stock ") + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Receive Stock ")) + "\n\n")
 
-	labels := []string{"Item Code:", "Quantity:", "Warehouse:", "Rate:"}
-	for i, input := range m.inputs {
+	labels := []string{T("Item Code:"), T("Quantity:"), T("Warehouse:"), T("Rate:")}
+	for i := range m.inputs {
 		b.WriteString(fmt.Sprintf("  %s\n", labels[i]))
-		b.WriteString(fmt.Sprintf("  %s\n\n", input.View()))
+		b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(i)))
 	}
 
 	return boxStyle.Render(b.String())
@@ -335,12 +338,12 @@ func (m Model) renderStockReceive() string {
 // renderStockTransfer renders the stock transfer form
 func (m Model) renderStockTransfer() string {
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Transfer Stock ") + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Transfer Stock ")) + "\n\n")
 
-	labels := []string{"Item Code:", "Quantity:", "From Warehouse:", "To Warehouse:"}
-	for i, input := range m.inputs {
+	labels := []string{T("Item Code:"), T("Quantity:"), T("From Warehouse:"), T("To Warehouse:")}
+	for i := range m.inputs {
 		b.WriteString(fmt.Sprintf("  %s\n", labels[i]))
-		b.WriteString(fmt.Sprintf("  %s\n\n", input.View()))
+		b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(i)))
 	}
 
 	return boxStyle.Render(b.String())
@@ -349,12 +352,12 @@ func (m Model) renderStockTransfer() string {
 // renderStockIssue renders the stock issue form
 func (m Model) renderStockIssue() string {
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Issue Stock ") + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Issue Stock ")) + "\n\n")
 
-	labels := []string{"Item Code:", "Quantity:", "Warehouse:"}
-	for i, input := range m.inputs {
+	labels := []string{T("Item Code:"), T("Quantity:"), T("Warehouse:")}
+	for i := range m.inputs {
 		b.WriteString(fmt.Sprintf("  %s\n", labels[i]))
-		b.WriteString(fmt.Sprintf("  %s\n\n", input.View()))
+		b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(i)))
 	}
 
 	return boxStyle.Render(b.String())
@@ -363,12 +366,12 @@ func (m Model) renderStockIssue() string {
 // renderCreateSerial renders the create serial form
 func (m Model) renderCreateSerial() string {
 	var b strings.Builder
-	b.WriteString(titleStyle.Render(" Create Serial Number ") + "\n\n")
+	b.WriteString(titleStyle.Render(T(" Create Serial Number ")) + "\n\n")
 
-	labels := []string{"Serial Number:", "Item Code:", "Supplier:"}
-	for i, input := range m.inputs {
+	labels := []string{T("Serial Number:"), T("Item Code:"), T("Supplier:")}
+	for i := range m.inputs {
 		b.WriteString(fmt.Sprintf("  %s\n", labels[i]))
-		b.WriteString(fmt.Sprintf("  %s\n\n", input.View()))
+		b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(i)))
 	}
 
 	return boxStyle.Render(b.String())
@@ -383,12 +386,12 @@ func (m Model) submitStockReceive() tea.Cmd {
 		rateStr := m.inputs[3].Value()
 
 		if itemCode == "" || qtyStr == "" || warehouse == "" {
-			return formSubmittedMsg{false, "Item, quantity and warehouse are required"}
+			return formSubmittedMsg{false, T("Item, quantity and warehouse are required")}
 		}
 
 		qty, err := strconv.ParseFloat(qtyStr, 64)
 		if err != nil {
-			return formSubmittedMsg{false, "Invalid quantity"}
+			return formSubmittedMsg{false, T("Invalid quantity")}
 		}
 
 		rate := 0.0
@@ -424,10 +427,10 @@ func (m Model) submitStockReceive() tea.Cmd {
 		if data, ok := result["data"].(map[string]interface{}); ok {
 			entryName := fmt.Sprintf("%v", data["name"])
 			m.client.submitStockEntry(entryName)
-			return formSubmittedMsg{true, fmt.Sprintf("Stock received: %s", entryName)}
+			return formSubmittedMsg{true, tf("Stock received: %s", entryName)}
 		}
 
-		return formSubmittedMsg{false, "Failed to create stock entry"}
+		return formSubmittedMsg{false, T("Failed to create stock entry")}
 	}
 }
 
@@ -440,12 +443,12 @@ func (m Model) submitStockTransfer() tea.Cmd {
 		toWarehouse := m.inputs[3].Value()
 
 		if itemCode == "" || qtyStr == "" || fromWarehouse == "" || toWarehouse == "" {
-			return formSubmittedMsg{false, "All fields are required"}
+			return formSubmittedMsg{false, T("All fields are required")}
 		}
 
 		qty, err := strconv.ParseFloat(qtyStr, 64)
 		if err != nil {
-			return formSubmittedMsg{false, "Invalid quantity"}
+			return formSubmittedMsg{false, T("Invalid quantity")}
 		}
 
 		company, err := m.client.GetCompany()
@@ -474,10 +477,10 @@ func (m Model) submitStockTransfer() tea.Cmd {
 		if data, ok := result["data"].(map[string]interface{}); ok {
 			entryName := fmt.Sprintf("%v", data["name"])
 			m.client.submitStockEntry(entryName)
-			return formSubmittedMsg{true, fmt.Sprintf("Stock transferred: %s", entryName)}
+			return formSubmittedMsg{true, tf("Stock transferred: %s", entryName)}
 		}
 
-		return formSubmittedMsg{false, "Failed to create stock entry"}
+		return formSubmittedMsg{false, T("Failed to create stock entry")}
 	}
 }
 
@@ -489,12 +492,12 @@ func (m Model) submitStockIssue() tea.Cmd {
 		warehouse := m.inputs[2].Value()
 
 		if itemCode == "" || qtyStr == "" || warehouse == "" {
-			return formSubmittedMsg{false, "All fields are required"}
+			return formSubmittedMsg{false, T("All fields are required")}
 		}
 
 		qty, err := strconv.ParseFloat(qtyStr, 64)
 		if err != nil {
-			return formSubmittedMsg{false, "Invalid quantity"}
+			return formSubmittedMsg{false, T("Invalid quantity")}
 		}
 
 		company, err := m.client.GetCompany()
@@ -522,10 +525,10 @@ func (m Model) submitStockIssue() tea.Cmd {
 		if data, ok := result["data"].(map[string]interface{}); ok {
 			entryName := fmt.Sprintf("%v", data["name"])
 			m.client.submitStockEntry(entryName)
-			return formSubmittedMsg{true, fmt.Sprintf("Stock issued: %s", entryName)}
+			return formSubmittedMsg{true, tf("Stock issued: %s", entryName)}
 		}
 
-		return formSubmittedMsg{false, "Failed to create stock entry"}
+		return formSubmittedMsg{false, T("Failed to create stock entry")}
 	}
 }
 
@@ -537,7 +540,7 @@ func (m Model) submitCreateSerial() tea.Cmd {
 		supplier := m.inputs[2].Value()
 
 		if serialNo == "" || itemCode == "" {
-			return formSubmittedMsg{false, "Serial number and item code are required"}
+			return formSubmittedMsg{false, T("Serial number and item code are required")}
 		}
 
 		body := map[string]interface{}{
@@ -553,7 +556,7 @@ func (m Model) submitCreateSerial() tea.Cmd {
 			return formSubmittedMsg{false, err.Error()}
 		}
 
-		return formSubmittedMsg{true, fmt.Sprintf("Serial number created: %s", serialNo)}
+		return formSubmittedMsg{true, tf("Serial number created: %s", serialNo)}
 	}
 }
 
@@ -565,7 +568,7 @@ func (m Model) deleteSerial(serialNo string) tea.Cmd {
 		if err != nil {
 			return errorMsg{err}
 		}
-		return actionDoneMsg{fmt.Sprintf("Deleted: %s", serialNo)}
+		return actionDoneMsg{tf("Deleted: %s", serialNo)}
 	}
 }
 
@@ -0,0 +1,208 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ============================================================================
+// SUBSCRIPTIONS
+// ============================================================================
+
+// loadSubscriptions fetches customer subscriptions for the list view
+func (m Model) loadSubscriptions() tea.Cmd {
+	return func() tea.Msg {
+		result, err := m.client.Request("GET", "Subscription?"+m.client.pageLimit(100)+"&fields=[\"name\",\"party\",\"status\",\"current_invoice_end\"]&filters=%5B%5B%22party_type%22%2C%22%3D%22%2C%22Customer%22%5D%5D&order_by=creation%20desc", nil)
+		if err != nil {
+			return errorMsg{err}
+		}
+
+		var items []ListItem
+		if data, ok := result["data"].([]interface{}); ok {
+			for _, item := range data {
+				if im, ok := item.(map[string]interface{}); ok {
+					name := fmt.Sprintf("%v", im["name"])
+					status, _ := im["status"].(string)
+
+					detail := tf("%v | %s | period ends %s", im["party"], renderStatusBadge(status), stringField(im, "current_invoice_end"))
+					items = append(items, ListItem{name: name, details: detail, status: status})
+				}
+			}
+		}
+		return dataLoadedMsg{items}
+	}
+}
+
+// loadSubscriptionDetail fetches a subscription with the invoices it
+// generated
+func (m Model) loadSubscriptionDetail(name string) tea.Cmd {
+	return func() tea.Msg {
+		result, err := m.client.Request("GET", "Subscription/"+url.PathEscape(name), nil)
+		if err != nil {
+			return errorMsg{err}
+		}
+
+		if data, ok := result["data"].(map[string]interface{}); ok {
+			if invoices, err := m.client.subscriptionInvoices(name); err == nil {
+				data["_invoices"] = invoices
+			}
+			return itemDetailMsg{data}
+		}
+		return errorMsg{errorf("no data found")}
+	}
+}
+
+// renderSubscriptionDetail renders the subscription detail view
+func (m Model) renderSubscriptionDetail() string {
+	if m.loading {
+		return "\n  Loading..."
+	}
+
+	if m.itemData == nil {
+		return "\n  " + T("No data")
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(T(" Subscription: ")+m.selectedItem) + "\n\n")
+
+	status, _ := m.itemData["status"].(string)
+	b.WriteString(tf("  Customer: %s\n", stringField(m.itemData, "party")))
+	b.WriteString(tf("  Status: %s\n", renderStatusBadge(status)))
+	b.WriteString(tf("  Start: %s\n", stringField(m.itemData, "start_date")))
+	if end := stringField(m.itemData, "end_date"); end != "" {
+		b.WriteString(tf("  End: %s\n", end))
+	}
+	b.WriteString(tf("  Current Period: %s to %s\n", stringField(m.itemData, "current_invoice_start"), stringField(m.itemData, "current_invoice_end")))
+	b.WriteString(tf("  Invoiced At: %s\n", stringField(m.itemData, "generate_invoice_at")))
+
+	if plans, ok := m.itemData["plans"].([]interface{}); ok && len(plans) > 0 {
+		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render(T("Plans:"))))
+		for _, p := range plans {
+			if pm, ok := p.(map[string]interface{}); ok {
+				qty, _ := pm["qty"].(float64)
+				b.WriteString(fmt.Sprintf("    - %s x %g\n", stringField(pm, "plan"), qty))
+			}
+		}
+	}
+
+	if invoices, ok := m.itemData["_invoices"].([]map[string]interface{}); ok && len(invoices) > 0 {
+		b.WriteString(fmt.Sprintf("\n  %s\n", selectedStyle.Render(T("Invoices:"))))
+		for _, inv := range invoices {
+			total, _ := inv["grand_total"].(float64)
+			b.WriteString(fmt.Sprintf("    - %s  %s  %s  %s\n", stringField(inv, "name"), stringField(inv, "posting_date"),
+				m.client.FormatCurrency(total), renderStatusBadge(stringField(inv, "status"))))
+		}
+	}
+
+	return boxStyle.Render(b.String())
+}
+
+// initCreateSubscriptionForm initializes the create subscription form
+func (m *Model) initCreateSubscriptionForm() {
+	m.inputs = make([]textinput.Model, 3)
+
+	m.inputs[0] = textinput.New()
+	m.inputs[0].Placeholder = T("Customer")
+	m.inputs[0].Focus()
+
+	m.inputs[1] = textinput.New()
+	m.inputs[1].Placeholder = T("Plan or PLAN:QTY, comma separated")
+
+	m.inputs[2] = textinput.New()
+	m.inputs[2].Placeholder = T("Start date YYYY-MM-DD (empty for today)")
+
+	m.focusIndex = 0
+}
+
+// renderCreateSubscription renders the create subscription form
+func (m Model) renderCreateSubscription() string {
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(T(" Create Subscription ")) + "\n\n")
+
+	labels := []string{T("Customer:"), T("Plans:"), T("Start (optional):")}
+	for i, label := range labels {
+		b.WriteString(fmt.Sprintf("  %s\n", label))
+		b.WriteString(fmt.Sprintf("  %s\n\n", m.inputView(i)))
+	}
+
+	b.WriteString(helpStyle.Render(trHelp("  Invoiced at the end of each period, as drafts")))
+
+	return boxStyle.Render(b.String())
+}
+
+// submitCreateSubscription submits the create subscription form
+func (m Model) submitCreateSubscription() tea.Cmd {
+	return func() tea.Msg {
+		customer := strings.TrimSpace(m.inputs[0].Value())
+		if customer == "" {
+			return formSubmittedMsg{false, T("Customer is required")}
+		}
+
+		opts := subscriptionCreateOptions{start: strings.TrimSpace(m.inputs[2].Value())}
+		for _, p := range strings.Split(m.inputs[1].Value(), ",") {
+			if p = strings.TrimSpace(p); p == "" {
+				continue
+			}
+			plan, err := parseBOMComponent(p)
+			if err != nil {
+				return formSubmittedMsg{false, err.Error()}
+			}
+			opts.plans = append(opts.plans, plan)
+		}
+		if len(opts.plans) == 0 {
+			return formSubmittedMsg{false, T("At least one plan is required")}
+		}
+		if _, err := time.Parse("2006-01-02", opts.start); opts.start != "" && err != nil {
+			return formSubmittedMsg{false, T("Invalid start date (expected YYYY-MM-DD)")}
+		}
+
+		data, err := m.client.createSubscription(customer, opts)
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		return formSubmittedMsg{true, tf("Subscription created: %s", stringField(data, "name"))}
+	}
+}
+
+// cancelSubscription cancels a subscription from its detail view
+func (m Model) cancelSubscription(name string) tea.Cmd {
+	return func() tea.Msg {
+		if err := m.client.cancelSubscription(name); err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		return formSubmittedMsg{true, tf("Subscription cancelled: %s", name)}
+	}
+}
+
+// handleSubscriptionKeys handles keyboard shortcuts for subscription views
+func (m *Model) handleSubscriptionKeys(key string) (tea.Model, tea.Cmd) {
+	switch m.view {
+	case ViewSubscriptions:
+		if key == "n" {
+			m.initCreateSubscriptionForm()
+			m.prevView = m.view
+			m.view = ViewCreateSubscription
+			// A command stops the key from reaching the new form's input
+			return m, textinput.Blink
+		}
+
+	case ViewSubscriptionDetail:
+		if m.itemData == nil {
+			break
+		}
+		if status, _ := m.itemData["status"].(string); key == "x" && status != "Cancelled" && status != "Completed" {
+			m.confirmAction = "cancel_subscription"
+			m.confirmMsg = tf("Cancel Subscription %s? No more invoices will be generated", m.selectedItem)
+			m.prevView = m.view
+			m.view = ViewConfirmAction
+			return m, nil
+		}
+	}
+
+	return m, nil
+}
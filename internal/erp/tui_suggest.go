@@ -0,0 +1,261 @@
+package erp
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ============================================================================
+// LINK FIELD SUGGESTIONS (typeahead for the form inputs)
+// ============================================================================
+
+// suggestDelay is how long typing must pause before a search is sent
+const suggestDelay = 250 * time.Millisecond
+
+// suggestShown is how many suggestions the dropdown lists
+const suggestShown = 6
+
+// formLinks maps the form views to their inputs that link to another
+// document, by input index
+var formLinks = map[View]map[int]string{
+	ViewCreatePO:           {0: "Supplier", 1: "Item"},
+	ViewAddPOItem:          {0: "Item"},
+	ViewCreateQuotation:    {0: "Customer", 1: "Item"},
+	ViewAddQuotationItem:   {0: "Item"},
+	ViewCreateSO:           {0: "Customer", 2: "Item"},
+	ViewAddSOItem:          {0: "Item"},
+	ViewStockReceive:       {0: "Item", 2: "Warehouse"},
+	ViewStockTransfer:      {0: "Item", 2: "Warehouse", 3: "Warehouse"},
+	ViewStockIssue:         {0: "Item", 2: "Warehouse"},
+	ViewCreateSerial:       {1: "Item", 2: "Supplier"},
+	ViewCreateWO:           {0: "Item"},
+	ViewCreateSubscription: {0: "Customer"},
+	ViewCreateExpenseClaim: {0: "Expense Claim Type"},
+	ViewCreateWarehouse:    {1: "Warehouse"},
+}
+
+// suggestState is the dropdown of the focused link input
+type suggestState struct {
+	field   int // Input the suggestions are for
+	seq     int // Bumped on every edit, so stale searches are dropped
+	matches []linkMatch
+	cursor  int
+}
+
+type suggestTickMsg struct {
+	seq int
+}
+
+type suggestionsMsg struct {
+	seq     int
+	matches []linkMatch
+}
+
+// linkDoctype returns the doctype an input of the current form links to
+func (m Model) linkDoctype(field int) string {
+	if opt, ok := m.formOption(field); ok {
+		return opt.link
+	}
+	return formLinks[m.view][field]
+}
+
+// closeSuggestions hides the dropdown and drops any pending search
+func (m *Model) closeSuggestions() {
+	m.suggest.seq++
+	m.suggest.matches = nil
+	m.suggest.cursor = 0
+}
+
+// scheduleSuggestions restarts the debounce timer after the focused input
+// changed. The search only runs if nothing was typed in the meantime.
+func (m *Model) scheduleSuggestions() tea.Cmd {
+	m.closeSuggestions()
+	if m.linkDoctype(m.focusIndex) == "" || m.client.LowBandwidth() {
+		return nil
+	}
+	if strings.TrimSpace(m.inputs[m.focusIndex].Value()) == "" {
+		return nil
+	}
+	m.suggest.field = m.focusIndex
+	seq := m.suggest.seq
+	return tea.Tick(suggestDelay, func(time.Time) tea.Msg {
+		return suggestTickMsg{seq}
+	})
+}
+
+// searchSuggestions runs the link search once typing has paused
+func (m Model) searchSuggestions(msg suggestTickMsg) tea.Cmd {
+	if msg.seq != m.suggest.seq || m.suggest.field >= len(m.inputs) {
+		return nil
+	}
+	doctype := m.linkDoctype(m.suggest.field)
+	if doctype == "" {
+		return nil
+	}
+	txt := strings.TrimSpace(m.inputs[m.suggest.field].Value())
+	if txt == "" {
+		return nil
+	}
+	return func() tea.Msg {
+		matches, err := m.client.searchLink(doctype, txt, linkSearchLimit)
+		if err != nil {
+			// A failed lookup just shows no suggestions
+			return suggestionsMsg{seq: msg.seq}
+		}
+		return suggestionsMsg{msg.seq, matches}
+	}
+}
+
+// showSuggestions opens the dropdown with the results of the latest search.
+// A lone match equal to what was typed needs no dropdown.
+func (m *Model) showSuggestions(msg suggestionsMsg) {
+	if msg.seq != m.suggest.seq || m.suggest.field != m.focusIndex {
+		return
+	}
+	if len(msg.matches) == 1 && msg.matches[0].value == strings.TrimSpace(m.inputs[m.focusIndex].Value()) {
+		return
+	}
+	m.suggest.matches = msg.matches
+	m.suggest.cursor = 0
+}
+
+// handleSuggestKeys drives the open dropdown: ↑/↓ move, tab/enter pick and
+// esc closes it. ok is false when the key is for the form instead.
+func (m *Model) handleSuggestKeys(key string) (tea.Cmd, bool) {
+	if len(m.suggest.matches) == 0 || m.suggest.field != m.focusIndex {
+		return nil, false
+	}
+	shown := len(m.suggest.matches)
+	if shown > suggestShown {
+		shown = suggestShown
+	}
+
+	switch key {
+	case "down":
+		m.suggest.cursor = (m.suggest.cursor + 1) % shown
+	case "up":
+		m.suggest.cursor = (m.suggest.cursor + shown - 1) % shown
+	case "tab", "enter":
+		m.inputs[m.focusIndex].SetValue(m.suggest.matches[m.suggest.cursor].value)
+		m.inputs[m.focusIndex].CursorEnd()
+		m.closeSuggestions()
+	case "esc":
+		m.closeSuggestions()
+	default:
+		return nil, false
+	}
+	return nil, true
+}
+
+// inputView renders a form input with its suggestion dropdown, if open
+func (m Model) inputView(i int) string {
+	view := m.inputs[i].View()
+	if err := m.fieldError(i); err != "" {
+		view += "\n" + errorStyle.Render("    ✗ "+err)
+	}
+	if m.suggest.field != i || m.focusIndex != i || len(m.suggest.matches) == 0 {
+		return view
+	}
+
+	var b strings.Builder
+	b.WriteString(view)
+	for j, match := range m.suggest.matches {
+		if j == suggestShown {
+			b.WriteString("\n" + helpStyle.Render(tf("    … %d more, keep typing", len(m.suggest.matches)-suggestShown)))
+			break
+		}
+		if j == m.suggest.cursor {
+			b.WriteString("\n" + selectedStyle.Render("  ▸ "+matchLabel(match)))
+		} else {
+			b.WriteString("\n    " + matchLabel(match))
+		}
+	}
+	return b.String()
+}
+
+// formCustomer is the customer of a sales form, whose own item codes are
+// accepted in its item input
+func (m Model) formCustomer() string {
+	switch m.view {
+	case ViewCreateSO, ViewCreateQuotation:
+		return strings.TrimSpace(m.inputs[0].Value())
+	case ViewAddSOItem:
+		return stringField(m.itemData, "customer")
+	case ViewAddQuotationItem:
+		if stringField(m.itemData, "quotation_to") == "Customer" {
+			return stringField(m.itemData, "party_name")
+		}
+	}
+	return ""
+}
+
+// checkFormLinks wraps a form's submit so every filled link input is first
+// checked to exist. The form stays open with an error otherwise.
+func (m Model) checkFormLinks(submit tea.Cmd) tea.Cmd {
+	if submit == nil {
+		return submit
+	}
+
+	links := make(map[int]string)
+	values := make(map[int]string)
+	for i := range m.inputs {
+		doctype := m.linkDoctype(i)
+		if value := strings.TrimSpace(m.inputs[i].Value()); doctype != "" && value != "" {
+			links[i] = doctype
+			values[i] = value
+		}
+	}
+	if len(values) == 0 {
+		return submit
+	}
+	customer := m.formCustomer()
+
+	return func() tea.Msg {
+		for i := 0; i < len(m.inputs); i++ {
+			value, ok := values[i]
+			if !ok {
+				continue
+			}
+			doctype := links[i]
+			exists, err := m.client.linkFound(doctype, value, customer)
+			if err != nil {
+				return formSubmittedMsg{false, err.Error()}
+			}
+			if !exists {
+				return formSubmittedMsg{false, tf("%s not found: %s", doctype, value)}
+			}
+		}
+		return submit()
+	}
+}
+
+// linkFound reports whether a link input names a document. Sales forms
+// also accept the customer's own item codes in their item inputs.
+func (c *Client) linkFound(doctype, value, customer string) (bool, error) {
+	exists, err := c.linkExists(doctype, value)
+	if err != nil || exists || doctype != "Item" || customer == "" {
+		return exists, err
+	}
+	_, customerCode, err := c.resolveCustomerItemCode(customer, value)
+	if err != nil {
+		return false, err
+	}
+	return customerCode != "", nil
+}
+
+// linkExists reports whether a document of the doctype has that name
+func (c *Client) linkExists(doctype, name string) (bool, error) {
+	filters, err := encodeFilters([][]interface{}{{"name", "=", name}})
+	if err != nil {
+		return false, err
+	}
+	result, err := c.Request("GET", url.PathEscape(doctype)+"?fields=[\"name\"]&filters="+filters, nil)
+	if err != nil {
+		return false, err
+	}
+	data, _ := result["data"].([]interface{})
+	return len(data) > 0, nil
+}
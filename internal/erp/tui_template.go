@@ -0,0 +1,467 @@
+package erp
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ============================================================================
+// CREATE TEMPLATE WIZARD (details, item group, attributes, review)
+// ============================================================================
+
+// Steps of the template wizard
+const (
+	wizardDetails = iota
+	wizardGroup
+	wizardAttributes
+	wizardReview
+)
+
+// wizardAttribute is an item attribute offered by the wizard, with how many
+// values it has
+type wizardAttribute struct {
+	name    string
+	numeric bool
+	values  int
+}
+
+// templateWizard is the state of the create template wizard. Its inputs are
+// its own, so an attribute created inline does not clear them.
+type templateWizard struct {
+	step    int
+	inputs  []textinput.Model // Code and name
+	focus   int
+	filter  textinput.Model // Types to narrow the item groups
+	groups  []string        // Item groups that can hold items
+	group   string
+	attrs   []wizardAttribute
+	picked  []string // Picked attributes, in template order
+	cursor  int
+	created map[string]bool // Attributes before an inline create, to pick the new one
+}
+
+// templateWizardMsg carries the item groups and attributes to pick from
+type templateWizardMsg struct {
+	groups []string
+	attrs  []wizardAttribute
+}
+
+// openTemplateWizard starts the wizard and loads its choices meanwhile
+func (m *Model) openTemplateWizard() tea.Cmd {
+	w := &templateWizard{inputs: make([]textinput.Model, 2), group: m.client.Config.DefaultItemGroup}
+	w.inputs[0] = textinput.New()
+	w.inputs[0].Placeholder = T("Template Code *")
+	w.inputs[0].Focus()
+	w.inputs[1] = textinput.New()
+	w.inputs[1].Placeholder = T("Template Name (defaults to the code)")
+	w.filter = textinput.New()
+	w.filter.Placeholder = T("Type to filter")
+
+	m.templateWizard = w
+	m.prevView = m.view
+	m.view = ViewCreateTemplate
+	m.loading = true
+	return m.loadTemplateWizard()
+}
+
+// loadTemplateWizard loads the item groups that can hold items and the
+// attributes with their number of values
+func (m Model) loadTemplateWizard() tea.Cmd {
+	return func() tea.Msg {
+		roots, err := m.client.fetchGroupTree()
+		if err != nil {
+			return errorMsg{err}
+		}
+		var groups []string
+		for _, line := range flattenTree(roots) {
+			if !line.node.isGroup {
+				groups = append(groups, line.node.name)
+			}
+		}
+
+		attrs, err := m.client.wizardAttributes()
+		if err != nil {
+			return errorMsg{err}
+		}
+		return templateWizardMsg{groups, attrs}
+	}
+}
+
+// wizardAttributes lists the item attributes with how many values each
+// has; numeric ones count the steps of their range
+func (c *Client) wizardAttributes() ([]wizardAttribute, error) {
+	fields := url.QueryEscape("[\"name\",\"numeric_values\",\"from_range\",\"to_range\",\"increment\",\"`tabItem Attribute Value`.attribute_value\"]")
+	result, err := c.Request("GET", "Item%20Attribute?limit_page_length=0&order_by=name%20asc&fields="+fields, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var attrs []wizardAttribute
+	index := map[string]int{}
+	rows, _ := result["data"].([]interface{})
+	for _, row := range rows {
+		am, ok := row.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := stringField(am, "name")
+		i, seen := index[name]
+		if !seen {
+			i = len(attrs)
+			index[name] = i
+			attrs = append(attrs, wizardAttribute{name: name})
+		}
+		if numeric, _ := am["numeric_values"].(float64); numeric == 1 {
+			from, _ := am["from_range"].(float64)
+			to, _ := am["to_range"].(float64)
+			step, _ := am["increment"].(float64)
+			attrs[i].numeric = true
+			if step > 0 && to >= from {
+				attrs[i].values = int(math.Floor((to-from)/step+1e-9)) + 1
+			}
+		} else if stringField(am, "attribute_value") != "" {
+			attrs[i].values++
+		}
+	}
+	return attrs, nil
+}
+
+// applyTemplateWizard fills the wizard's choices. After an inline create,
+// the new attribute is picked.
+func (m *Model) applyTemplateWizard(msg templateWizardMsg) {
+	w := m.templateWizard
+	if w == nil {
+		return
+	}
+	w.groups = msg.groups
+	w.attrs = msg.attrs
+	if w.created != nil {
+		for i, attr := range w.attrs {
+			if !w.created[attr.name] {
+				w.picked = append(w.picked, attr.name)
+				w.cursor = i
+			}
+		}
+		w.created = nil
+	}
+}
+
+// wizardGroups returns the item groups matching the filter
+func (w *templateWizard) wizardGroups() []string {
+	query := strings.ToLower(strings.TrimSpace(w.filter.Value()))
+	if query == "" {
+		return w.groups
+	}
+	var groups []string
+	for _, g := range w.groups {
+		if strings.Contains(strings.ToLower(g), query) {
+			groups = append(groups, g)
+		}
+	}
+	return groups
+}
+
+// isPicked reports whether an attribute is picked
+func (w *templateWizard) isPicked(name string) bool {
+	for _, p := range w.picked {
+		if p == name {
+			return true
+		}
+	}
+	return false
+}
+
+// toggle picks or unpicks an attribute
+func (w *templateWizard) toggle(name string) {
+	for i, p := range w.picked {
+		if p == name {
+			w.picked = append(w.picked[:i], w.picked[i+1:]...)
+			return
+		}
+	}
+	w.picked = append(w.picked, name)
+}
+
+// variantSpace is how many variants the picked attributes allow, the
+// product of their numbers of values
+func (w *templateWizard) variantSpace() int {
+	if len(w.picked) == 0 {
+		return 0
+	}
+	space := 1
+	for _, name := range w.picked {
+		for _, attr := range w.attrs {
+			if attr.name == name {
+				space *= attr.values
+			}
+		}
+	}
+	return space
+}
+
+// codeName returns the template code and name, the name defaulting to the
+// code
+func (w *templateWizard) codeName() (string, string) {
+	code := strings.TrimSpace(w.inputs[0].Value())
+	name := strings.TrimSpace(w.inputs[1].Value())
+	if name == "" {
+		name = code
+	}
+	return code, name
+}
+
+// handleTemplateWizardKeys moves through the wizard: enter goes to the next
+// step and esc to the previous one
+func (m Model) handleTemplateWizardKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	w := m.templateWizard
+	key := msg.String()
+	if key == "ctrl+c" {
+		return m, tea.Quit
+	}
+	if key == "esc" {
+		if w.step == wizardDetails {
+			m.templateWizard = nil
+			m.view = ViewTemplates
+			return m, nil
+		}
+		w.step--
+		w.cursor = 0
+		m.wizardFocus()
+		return m, nil
+	}
+	// The choices are still loading
+	if m.loading && w.step != wizardDetails {
+		return m, nil
+	}
+
+	switch w.step {
+	case wizardDetails:
+		switch key {
+		case "tab", "shift+tab", "up", "down":
+			w.focus = 1 - w.focus
+			m.wizardFocus()
+			return m, nil
+		case "enter":
+			if code, _ := w.codeName(); code == "" {
+				m.message = T("Template code is required")
+				m.messageType = "error"
+				return m, nil
+			}
+			w.step = wizardGroup
+			w.cursor = 0
+			for i, g := range w.wizardGroups() {
+				if g == w.group {
+					w.cursor = i
+				}
+			}
+			m.wizardFocus()
+			return m, nil
+		}
+		var cmd tea.Cmd
+		w.inputs[w.focus], cmd = w.inputs[w.focus].Update(msg)
+		return m, cmd
+
+	case wizardGroup:
+		groups := w.wizardGroups()
+		switch key {
+		case "up":
+			w.cursor = max(w.cursor-1, 0)
+			return m, nil
+		case "down":
+			w.cursor = min(w.cursor+1, max(len(groups)-1, 0))
+			return m, nil
+		case "enter":
+			if w.cursor >= len(groups) {
+				m.message = T("No item group matches the filter")
+				m.messageType = "error"
+				return m, nil
+			}
+			w.group = groups[w.cursor]
+			w.step = wizardAttributes
+			w.cursor = 0
+			m.wizardFocus()
+			return m, nil
+		}
+		var cmd tea.Cmd
+		before := w.filter.Value()
+		w.filter, cmd = w.filter.Update(msg)
+		if w.filter.Value() != before {
+			w.cursor = 0
+		}
+		return m, cmd
+
+	case wizardAttributes:
+		switch key {
+		case "up", "k":
+			w.cursor = max(w.cursor-1, 0)
+		case "down", "j":
+			w.cursor = min(w.cursor+1, max(len(w.attrs)-1, 0))
+		case " ":
+			if w.cursor < len(w.attrs) {
+				w.toggle(w.attrs[w.cursor].name)
+			}
+		case "n":
+			// Create an attribute and come back with it picked
+			w.created = map[string]bool{}
+			for _, attr := range w.attrs {
+				w.created[attr.name] = true
+			}
+			m.openAttrTypeChooser()
+		case "enter":
+			if len(w.picked) == 0 {
+				m.message = T("Pick at least one attribute (space)")
+				m.messageType = "error"
+				return m, nil
+			}
+			w.step = wizardReview
+		}
+		return m, nil
+
+	case wizardReview:
+		if key == "enter" {
+			m.loading = true
+			return m, m.submitTemplateWizard()
+		}
+	}
+	return m, nil
+}
+
+// wizardFocus focuses the input of the current step
+func (m *Model) wizardFocus() {
+	w := m.templateWizard
+	for i := range w.inputs {
+		if w.step == wizardDetails && i == w.focus {
+			w.inputs[i].Focus()
+		} else {
+			w.inputs[i].Blur()
+		}
+	}
+	if w.step == wizardGroup {
+		w.filter.Focus()
+	} else {
+		w.filter.Blur()
+	}
+}
+
+// submitTemplateWizard creates the template
+func (m Model) submitTemplateWizard() tea.Cmd {
+	w := m.templateWizard
+	code, name := w.codeName()
+	body := m.client.templateBody(code, name, w.group, w.picked)
+	return func() tea.Msg {
+		result, err := m.client.Request("POST", "Item", body)
+		if err != nil {
+			return formSubmittedMsg{false, err.Error()}
+		}
+		if data, ok := result["data"].(map[string]interface{}); ok {
+			return formSubmittedMsg{true, tf("Template created: %s", data["name"])}
+		}
+		return formSubmittedMsg{false, T("Failed to create template")}
+	}
+}
+
+// renderTemplateWizard renders the current step of the wizard
+func (m Model) renderTemplateWizard() string {
+	w := m.templateWizard
+	var b strings.Builder
+	steps := []string{T("Details"), T("Item Group"), T("Attributes"), T("Review")}
+	b.WriteString(titleStyle.Render(tf(" Create Template · %d/%d %s ", w.step+1, len(steps), steps[w.step])) + "\n\n")
+
+	if m.loading && w.step != wizardDetails {
+		b.WriteString(tf("  %s Loading...\n", m.spinner.View()))
+		return boxStyle.Render(b.String())
+	}
+
+	switch w.step {
+	case wizardDetails:
+		labels := []string{T("Template Code: *"), T("Template Name:")}
+		for i, input := range w.inputs {
+			b.WriteString(fmt.Sprintf("  %s\n", labels[i]))
+			b.WriteString(fmt.Sprintf("  %s\n\n", input.View()))
+		}
+		b.WriteString(helpStyle.Render(trHelp("  * Required field • next: item group, attributes, review")))
+
+	case wizardGroup:
+		b.WriteString(fmt.Sprintf("  %s\n\n", w.filter.View()))
+		groups := w.wizardGroups()
+		if len(groups) == 0 {
+			b.WriteString(helpStyle.Render(trHelp("  No item group matches")) + "\n")
+		}
+		rows := max(m.height-16, 5)
+		start := max(min(w.cursor-rows/2, len(groups)-rows), 0)
+		for i := start; i < len(groups) && i < start+rows; i++ {
+			if i == w.cursor {
+				b.WriteString("  > " + selectedStyle.Render(groups[i]) + "\n")
+			} else {
+				b.WriteString("    " + groups[i] + "\n")
+			}
+		}
+
+	case wizardAttributes:
+		if len(w.attrs) == 0 {
+			b.WriteString(helpStyle.Render(trHelp("  No attributes yet: n creates one")) + "\n")
+		}
+		rows := max(m.height-16, 5)
+		start := max(min(w.cursor-rows/2, len(w.attrs)-rows), 0)
+		for i := start; i < len(w.attrs) && i < start+rows; i++ {
+			attr := w.attrs[i]
+			check := "[ ]"
+			if w.isPicked(attr.name) {
+				check = "[x]"
+			}
+			kind := "value"
+			if attr.numeric {
+				kind = "step"
+			}
+			if attr.values != 1 {
+				kind += "s"
+			}
+			line := fmt.Sprintf("%s %s", check, attr.name) + helpStyle.Render(fmt.Sprintf(" (%d %s)", attr.values, kind))
+			if i == w.cursor {
+				b.WriteString("  > " + selectedStyle.Render(line) + "\n")
+			} else {
+				b.WriteString("    " + line + "\n")
+			}
+		}
+		b.WriteString(tf("\n  Picked: %d • Variants possible: %s\n", len(w.picked), successStyle.Render(fmt.Sprint(w.variantSpace()))))
+
+	case wizardReview:
+		code, name := w.codeName()
+		b.WriteString(tf("  Code:       %s\n", code))
+		b.WriteString(tf("  Name:       %s\n", name))
+		b.WriteString(tf("  Item Group: %s\n\n", w.group))
+		b.WriteString(T("  Attributes:\n"))
+		for _, picked := range w.picked {
+			for _, attr := range w.attrs {
+				if attr.name == picked {
+					b.WriteString(fmt.Sprintf("    • %s (%d)\n", attr.name, attr.values))
+				}
+			}
+		}
+		space := w.variantSpace()
+		b.WriteString(tf("\n  Variants possible: %s\n", successStyle.Render(fmt.Sprint(space))))
+		if space > maxGeneratedVariants {
+			b.WriteString(helpStyle.Render(tf("  More than one generate run makes (%d): generate them in parts", maxGeneratedVariants)) + "\n")
+		}
+		b.WriteString("\n" + helpStyle.Render(trHelp("  Press Enter to create the template")))
+	}
+	return boxStyle.Render(b.String())
+}
+
+// templateWizardHelp is the help line of the current wizard step
+func (m Model) templateWizardHelp() string {
+	switch m.templateWizard.step {
+	case wizardDetails:
+		return "tab: next field • enter: item group • esc: cancel"
+	case wizardGroup:
+		return "type: filter • ↑/↓: navigate • enter: pick • esc: back"
+	case wizardAttributes:
+		return "↑/↓: navigate • space: pick • n: new attribute • enter: review • esc: back"
+	}
+	return "enter: create • esc: back"
+}
@@ -0,0 +1,102 @@
+package erp
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type timesheetLoadedMsg struct {
+	grid     *timesheetGrid
+	employee string
+}
+
+// loadTimesheetWeek fetches the week grid containing m.timesheetDate
+func (m Model) loadTimesheetWeek() tea.Cmd {
+	date := m.timesheetDate
+	if date == "" {
+		date = m.client.Today()
+	}
+	return func() tea.Msg {
+		grid, emp, err := m.client.loadTimesheetGrid(date)
+		if err != nil {
+			return errorMsg{err}
+		}
+		name, _ := emp["employee_name"].(string)
+		return timesheetLoadedMsg{grid, name}
+	}
+}
+
+// submitTimesheets submits the draft timesheets of the shown week
+func (m Model) submitTimesheets(names []string) tea.Cmd {
+	return func() tea.Msg {
+		for _, name := range names {
+			if err := m.client.submitDocument("Timesheet", name); err != nil {
+				return formSubmittedMsg{false, err.Error()}
+			}
+		}
+		return formSubmittedMsg{true, tf("Timesheet submitted: %s", strings.Join(names, ", "))}
+	}
+}
+
+// handleTimesheetKeys moves between weeks and submits the week's drafts
+func (m *Model) handleTimesheetKeys(key string) (tea.Model, tea.Cmd) {
+	if m.view != ViewTimesheet || m.loading {
+		return m, nil
+	}
+
+	switch key {
+	case "left", "right":
+		if m.timesheetGrid == nil {
+			return m, nil
+		}
+		days := -7
+		if key == "right" {
+			days = 7
+		}
+		m.timesheetDate = m.timesheetGrid.monday.AddDate(0, 0, days).Format("2006-01-02")
+		m.loading = true
+		return m, m.loadTimesheetWeek()
+	case "s":
+		if m.timesheetGrid != nil && len(m.timesheetGrid.drafts) > 0 {
+			m.confirmAction = "submit_timesheet"
+			m.confirmMsg = tf("Submit timesheet %s?", strings.Join(m.timesheetGrid.drafts, ", "))
+			m.prevView = m.view
+			m.view = ViewConfirmAction
+			return m, nil
+		}
+	}
+	return m, nil
+}
+
+func (m Model) renderTimesheetWeek() string {
+	if m.loading {
+		return tf("\n  %s Loading timesheet...", m.spinner.View())
+	}
+
+	if m.timesheetGrid == nil {
+		return "\n  " + T("No data")
+	}
+
+	grid := m.timesheetGrid
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(tf(" Week of %s - %s ", grid.monday.Format("2006-01-02"), m.timesheetEmployee)) + "\n\n")
+
+	if len(grid.rows) == 0 {
+		b.WriteString("  " + helpStyle.Render(trHelp("No time logged this week (log hours with: erp-cli timesheet add <project> <hours>)")) + "\n")
+		return b.String()
+	}
+
+	for _, line := range grid.lines() {
+		b.WriteString("  " + line + "\n")
+	}
+
+	b.WriteString("\n")
+	if len(grid.drafts) > 0 {
+		b.WriteString(tf("  Draft: %s\n", internetStyle.Render(strings.Join(grid.drafts, ", "))))
+	}
+	if len(grid.submitted) > 0 {
+		b.WriteString(tf("  Submitted: %s\n", successStyle.Render(strings.Join(grid.submitted, ", "))))
+	}
+	return b.String()
+}
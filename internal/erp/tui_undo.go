@@ -0,0 +1,200 @@
+package erp
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ============================================================================
+// UNDO (u: recreate the last deleted document or amend the last cancelled one)
+// ============================================================================
+
+// undoDoctypes are the doctypes deleted from each view, by the view the
+// delete was confirmed from
+var undoDoctypes = map[View]string{
+	ViewAttributes:     "Item Attribute",
+	ViewItems:          "Item",
+	ViewTemplates:      "Item",
+	ViewGroups:         "Item Group",
+	ViewBrands:         "Brand",
+	ViewSuppliers:      "Supplier",
+	ViewSupplierDetail: "Supplier",
+	ViewSerials:        "Serial No",
+	ViewSerialDetail:   "Serial No",
+	ViewCustomers:      "Customer",
+	ViewCustomerDetail: "Customer",
+}
+
+// undoCancels are the doctypes of the cancel confirm actions
+var undoCancels = map[string]string{
+	"cancel_po":        "Purchase Order",
+	"cancel_pi":        "Purchase Invoice",
+	"cancel_quotation": "Quotation",
+	"cancel_so":        "Sales Order",
+	"cancel_si":        "Sales Invoice",
+	"cancel_dn":        "Delivery Note",
+	"cancel_pr":        "Purchase Receipt",
+	"cancel_payment":   "Payment Entry",
+}
+
+// undoAction is the last delete or cancel, and how to reverse it
+type undoAction struct {
+	kind    string // "delete" or "cancel"
+	doctype string
+	name    string
+	doc     map[string]interface{} // The deleted document, as fetched before
+	reason  string                 // Why it cannot be undone, if it cannot
+}
+
+// undoableMsg carries the result of a delete or cancel with its undo
+type undoableMsg struct {
+	undo undoAction
+	msg  tea.Msg
+}
+
+// undoKey is the undo key of the current view; transaction detail views
+// use u to attach files, so undo is U there
+func (m Model) undoKey() string {
+	if _, ok := detailDoctypes[m.view]; ok {
+		return "U"
+	}
+	return "u"
+}
+
+// deleteWithUndo runs a confirmed delete after saving the document, so u
+// can create it again
+func (m Model) deleteWithUndo(del tea.Cmd) tea.Cmd {
+	if del == nil {
+		return nil
+	}
+	name := m.selectedItem
+	doctype, ok := undoDoctypes[m.prevView]
+	return func() tea.Msg {
+		undo := undoAction{kind: "delete", doctype: doctype, name: name}
+		switch {
+		case !ok:
+			undo.reason = tf("%s cannot be restored once deleted", name)
+		default:
+			result, err := m.client.Request("GET", url.PathEscape(doctype)+"/"+url.PathEscape(name), nil)
+			undo.doc, _ = result["data"].(map[string]interface{})
+			if err != nil || undo.doc == nil {
+				undo.reason = tf("%s could not be saved before deleting it", name)
+			}
+		}
+		return undoableMsg{undo, del()}
+	}
+}
+
+// cancelWithUndo runs a confirmed cancel, so u can amend the document
+func (m Model) cancelWithUndo(action string, cancel tea.Cmd) tea.Cmd {
+	doctype, ok := undoCancels[action]
+	if !ok || cancel == nil {
+		return cancel
+	}
+	name := m.selectedItem
+	return func() tea.Msg {
+		return undoableMsg{undoAction{kind: "cancel", doctype: doctype, name: name}, cancel()}
+	}
+}
+
+// keepUndo remembers the undo of a delete or cancel that went through
+func (m Model) keepUndo(msg undoableMsg) (tea.Model, tea.Cmd) {
+	switch result := msg.msg.(type) {
+	case actionDoneMsg:
+		m.lastUndo = &msg.undo
+	case formSubmittedMsg:
+		if result.success {
+			m.lastUndo = &msg.undo
+		}
+	}
+	return m.update(msg.msg)
+}
+
+// undoLast reverses the last delete or cancel, or says why it cannot
+func (m Model) undoLast() (tea.Model, tea.Cmd) {
+	undo := m.lastUndo
+	warn := func(text string) (tea.Model, tea.Cmd) {
+		m.notification = text
+		m.notificationType = "error"
+		m.showNotification = true
+		return m, tea.Tick(3*time.Second, func(time.Time) tea.Msg {
+			return clearNotificationMsg{}
+		})
+	}
+	switch {
+	case undo == nil:
+		return warn(T("Nothing to undo"))
+	case undo.reason != "":
+		m.lastUndo = nil
+		return warn(T("Cannot undo: ") + undo.reason)
+	}
+
+	m.lastUndo = nil
+	m.loading = true
+	if undo.kind == "cancel" {
+		return m, m.amendCancelled(undo.doctype, undo.name)
+	}
+	return m, m.restoreDeleted(undo.doctype, undo.name, undo.doc)
+}
+
+// restoreDeleted creates a deleted document again. Documents named by a
+// series get a new name.
+func (m Model) restoreDeleted(doctype, name string, doc map[string]interface{}) tea.Cmd {
+	return func() tea.Msg {
+		cleanDumpDoc(doc)
+		result, err := m.client.Request("POST", url.PathEscape(doctype), doc)
+		if err != nil {
+			return formSubmittedMsg{false, tf("Cannot restore %s: %s", name, err)}
+		}
+		created, _ := result["data"].(map[string]interface{})
+		if restored := stringField(created, "name"); restored != "" && restored != name {
+			return formSubmittedMsg{true, tf("Restored %s %s as %s", doctype, name, restored)}
+		}
+		return formSubmittedMsg{true, tf("Restored %s %s", doctype, name)}
+	}
+}
+
+// amendCancelled creates the draft amendment of a cancelled document, as
+// Amend does in the desk: the cancelled one stays, the draft replaces it
+func (m Model) amendCancelled(doctype, name string) tea.Cmd {
+	return func() tea.Msg {
+		result, err := m.client.Request("GET", url.PathEscape(doctype)+"/"+url.PathEscape(name), nil)
+		if err != nil {
+			return formSubmittedMsg{false, tf("Cannot amend %s: %s", name, err)}
+		}
+		doc, ok := result["data"].(map[string]interface{})
+		if !ok {
+			return formSubmittedMsg{false, tf("Cannot amend %s: not found", name)}
+		}
+
+		noCopy, _ := m.client.noCopyFields(doctype)
+		cleanDumpDoc(doc)
+		for field := range noCopy[doctype] {
+			delete(doc, field)
+		}
+		for _, field := range []string{"name", "status"} {
+			delete(doc, field)
+		}
+		for _, value := range doc {
+			rows, _ := value.([]interface{})
+			for _, row := range rows {
+				if child, ok := row.(map[string]interface{}); ok {
+					for field := range noCopy[stringField(child, "doctype")] {
+						delete(child, field)
+					}
+				}
+			}
+		}
+		doc["amended_from"] = name
+
+		result, err = m.client.Request("POST", url.PathEscape(doctype), doc)
+		if err != nil {
+			return formSubmittedMsg{false, tf("Cannot amend %s: %s", name, err)}
+		}
+		created, _ := result["data"].(map[string]interface{})
+		return formSubmittedMsg{true, tf("Amended %s %s as draft %s", strings.ToLower(doctype), name, stringField(created, "name"))}
+	}
+}
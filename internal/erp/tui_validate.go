@@ -0,0 +1,178 @@
+package erp
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ============================================================================
+// FORM VALIDATION (checked as the user leaves a field, shown under it)
+// ============================================================================
+
+// Kinds of checked form inputs
+const (
+	fieldQty    = "qty"    // A number above zero
+	fieldAmount = "amount" // A number, zero or more
+	fieldDate   = "date"   // YYYY-MM-DD
+)
+
+// formFields maps the form views to their checked inputs, by input index.
+// Empty inputs pass: whether a field is required is up to the submit.
+var formFields = map[View]map[int]string{
+	ViewStockReceive:          {1: fieldQty, 3: fieldAmount},
+	ViewStockTransfer:         {1: fieldQty},
+	ViewStockIssue:            {1: fieldQty},
+	ViewCreatePO:              {2: fieldQty, 3: fieldDate},
+	ViewAddPOItem:             {1: fieldQty, 2: fieldAmount},
+	ViewCreateQuotation:       {2: fieldQty, 3: fieldDate},
+	ViewAddQuotationItem:      {1: fieldQty, 2: fieldAmount},
+	ViewCreateSO:              {3: fieldQty, 4: fieldDate},
+	ViewAddSOItem:             {1: fieldQty, 2: fieldAmount},
+	ViewCreateWO:              {1: fieldQty},
+	ViewCreateSOFromQuotation: {1: fieldDate},
+	ViewCreateSalesInvoice:    {1: fieldDate},
+	ViewCreateDN:              {1: fieldDate},
+	ViewCreatePI:              {1: fieldDate},
+	ViewCreatePR:              {1: fieldDate},
+	ViewCreatePIFromPO:        {1: fieldDate},
+	ViewCreateSubscription:    {2: fieldDate},
+	ViewCreateExpenseClaim:    {1: fieldQty, 3: fieldDate},
+	ViewCreatePayment:         {1: fieldQty, 2: fieldDate},
+	ViewCreateAttrNumeric:     {1: fieldAmount, 2: fieldAmount, 3: fieldQty},
+	ViewListFilter:            {2: fieldDate, 3: fieldDate, 4: fieldAmount, 5: fieldAmount},
+}
+
+// fieldErrors are the inline errors of the open form, by input index
+type fieldErrors struct {
+	view View
+	errs map[int]string
+}
+
+// linkCheckedMsg is the result of checking that a link input's document
+// exists
+type linkCheckedMsg struct {
+	view  View
+	field int
+	value string
+	err   string
+}
+
+// checkFieldValue returns what is wrong with a value of a kind of input
+func checkFieldValue(kind, value string) string {
+	if value == "" {
+		return ""
+	}
+	switch kind {
+	case fieldQty, fieldAmount:
+		n, err := strconv.ParseFloat(value, 64)
+		switch {
+		case err != nil:
+			return tf("%q is not a number", value)
+		case kind == fieldQty && n <= 0:
+			return T("must be more than 0")
+		case n < 0:
+			return T("cannot be negative")
+		}
+	case fieldDate:
+		if _, err := time.Parse("2006-01-02", value); err != nil {
+			return tf("%q is not a date (YYYY-MM-DD)", value)
+		}
+	}
+	return ""
+}
+
+// fieldKind returns the checked kind of an input of the open form, if any
+func (m Model) fieldKind(i int) (string, bool) {
+	if opt, ok := m.formOption(i); ok {
+		return opt.kind, opt.kind != ""
+	}
+	kind, ok := formFields[m.view][i]
+	return kind, ok
+}
+
+// fieldError returns the inline error of an input of the open form
+func (m Model) fieldError(i int) string {
+	if m.fieldErrors.view != m.view {
+		return ""
+	}
+	return m.fieldErrors.errs[i]
+}
+
+// setFieldError records or clears the inline error of an input
+func (m *Model) setFieldError(i int, err string) {
+	if m.fieldErrors.view != m.view || m.fieldErrors.errs == nil {
+		m.fieldErrors = fieldErrors{view: m.view, errs: map[int]string{}}
+	}
+	if err == "" {
+		delete(m.fieldErrors.errs, i)
+		return
+	}
+	m.fieldErrors.errs[i] = err
+}
+
+// clearFieldErrors forgets the inline errors, when a form closes
+func (m *Model) clearFieldErrors() {
+	m.fieldErrors = fieldErrors{}
+}
+
+// leaveField checks the input the focus is leaving. Formats are checked at
+// once; a link input's document is looked up in the background.
+func (m *Model) leaveField(i int) tea.Cmd {
+	if i < 0 || i >= len(m.inputs) {
+		return nil
+	}
+	value := strings.TrimSpace(m.inputs[i].Value())
+	if kind, ok := m.fieldKind(i); ok {
+		m.setFieldError(i, checkFieldValue(kind, value))
+		return nil
+	}
+
+	doctype := m.linkDoctype(i)
+	if doctype == "" || value == "" || m.client.LowBandwidth() {
+		return nil
+	}
+	view, customer := m.view, m.formCustomer()
+	return func() tea.Msg {
+		msg := linkCheckedMsg{view: view, field: i, value: value}
+		found, err := m.client.linkFound(doctype, value, customer)
+		switch {
+		case err != nil:
+			// An unreachable server is reported by the submit instead
+		case !found:
+			msg.err = tf("%s not found", doctype)
+		}
+		return msg
+	}
+}
+
+// applyLinkCheck shows a link check, unless the input changed meanwhile
+func (m *Model) applyLinkCheck(msg linkCheckedMsg) {
+	if msg.view != m.view || msg.field >= len(m.inputs) ||
+		strings.TrimSpace(m.inputs[msg.field].Value()) != msg.value {
+		return
+	}
+	m.setFieldError(msg.field, msg.err)
+}
+
+// checkForm checks every input before a submit. It returns false, with the
+// focus on the first wrong input, when the form cannot be sent.
+func (m *Model) checkForm() bool {
+	for i := range m.inputs {
+		if kind, ok := m.fieldKind(i); ok {
+			m.setFieldError(i, checkFieldValue(kind, strings.TrimSpace(m.inputs[i].Value())))
+		}
+	}
+	for i := range m.inputs {
+		if err := m.fieldError(i); err != "" {
+			m.focusIndex = i
+			m.updateFocus()
+			m.message = tf("Fix the field marked ✗: %s", err)
+			m.messageType = "error"
+			return false
+		}
+	}
+	return true
+}
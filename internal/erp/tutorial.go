@@ -0,0 +1,353 @@
+package erp
+
+import (
+	"bufio"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// The tutorial runs the order-to-cash flow with the regular commands, so each
+// step prints exactly what the user will see when running it themselves.
+// Document names are picked up afterwards as the newest draft for the
+// tutorial customer, since the commands only print them.
+
+// tutorialCustomer is the customer the walkthrough sells to
+const tutorialCustomer = "Tutorial Customer"
+
+// tutorial holds the state carried between walkthrough steps
+type tutorial struct {
+	c         *Client
+	reader    *bufio.Reader
+	pause     bool
+	item      string
+	group     string
+	warehouse string
+	so        string
+	dn        string
+	si        string
+	payment   string
+}
+
+// tutorialStep is one stage of the walkthrough
+type tutorialStep struct {
+	title   string
+	explain []string
+	command func(t *tutorial) string
+	run     func(t *tutorial) error
+}
+
+// CmdTutorial walks a new user through the order-to-cash flow on their site
+func (c *Client) CmdTutorial(args []string) error {
+	t := &tutorial{c: c, reader: bufio.NewReader(os.Stdin), pause: true}
+	for _, arg := range args {
+		if arg == "--yes" || arg == "-y" {
+			t.pause = false
+		}
+	}
+
+	printf("%sERPNext CLI tutorial%s\n", Cyan, Reset)
+	fmt.Println()
+	fmt.Println(T("This walks through the full order-to-cash flow: create an item, receive"))
+	fmt.Println(T("stock, sell it, deliver it, invoice it and get paid. Each step shows the"))
+	fmt.Println(T("command it runs, so you can repeat it on your own afterwards."))
+	fmt.Println()
+	printf("%sIt creates and submits real documents on %s.%s\n", Yellow, c.ActiveURL, Reset)
+	fmt.Println(T("Use a test site, not production."))
+	fmt.Println()
+	if t.pause {
+		// Read through t.reader: a second buffered reader on stdin could
+		// swallow the answers to later prompts
+		fmt.Print(T("Start the tutorial? [y/N]: "))
+		answer, _ := t.reader.ReadString('\n')
+		answer = strings.ToLower(strings.TrimSpace(answer))
+		if answer != "y" && answer != "yes" {
+			return nil
+		}
+	}
+
+	if err := t.prepare(); err != nil {
+		return err
+	}
+
+	steps := tutorialSteps()
+	for i, step := range steps {
+		printf("\n%s── Step %d/%d: %s ──%s\n", Cyan, i+1, len(steps), step.title, Reset)
+		for _, line := range step.explain {
+			fmt.Printf("  %s\n", line)
+		}
+		fmt.Printf("\n  %s$ %s%s\n", Green, step.command(t), Reset)
+
+		if !t.waitForEnter() {
+			printf("%sTutorial stopped. Documents created so far are kept.%s\n", Yellow, Reset)
+			return nil
+		}
+		if err := step.run(t); err != nil {
+			return errorf("step %d (%s) failed: %w", i+1, step.title, err)
+		}
+	}
+
+	t.summary()
+	return nil
+}
+
+// waitForEnter pauses between steps; it returns false when the user quits
+func (t *tutorial) waitForEnter() bool {
+	if !t.pause {
+		fmt.Println()
+		return true
+	}
+	fmt.Print(T("  Press Enter to run it, or q to quit: "))
+	answer, _ := t.reader.ReadString('\n')
+	fmt.Println()
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer != "q" && answer != "quit"
+}
+
+// prepare picks the item group and warehouse to use and names the item
+func (t *tutorial) prepare() error {
+	c := t.c
+	printf("%sLooking up an item group and warehouse...%s\n", Blue, Reset)
+
+	t.group = c.Config.DefaultItemGroup
+	if t.group == "" {
+		group, err := t.firstLeaf("Item%20Group", nil)
+		if err != nil {
+			return err
+		}
+		if group == "" {
+			return errorf("no item group found; create one with: erp-cli group create <name>")
+		}
+		t.group = group
+	}
+
+	company, err := c.GetCompany()
+	if err != nil {
+		return err
+	}
+	t.warehouse, err = t.firstLeaf("Warehouse", []interface{}{"company", "=", company})
+	if err != nil {
+		return err
+	}
+	if t.warehouse == "" {
+		return errorf("no warehouse found for %s", company)
+	}
+
+	// A new code per run so the tutorial can be repeated
+	t.item = "TUT-" + c.Now().Format("0102-1504")
+	// The walkthrough receives stock, whatever the configured default
+	c.Config.DefaultIsStockItem = true
+
+	printf("  Item group: %s | Warehouse: %s\n", t.group, t.warehouse)
+	return nil
+}
+
+// firstLeaf returns the first non-group record of a tree doctype
+func (t *tutorial) firstLeaf(doctype string, filter []interface{}) (string, error) {
+	filters := [][]interface{}{{"is_group", "=", 0}}
+	if filter != nil {
+		filters = append(filters, filter)
+	}
+	encoded, err := encodeFilters(filters)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := t.c.Request("GET", doctype+"?limit_page_length=1&fields=[\"name\"]&order_by=creation%20asc&filters="+encoded, nil)
+	if err != nil {
+		return "", err
+	}
+	if data, ok := result["data"].([]interface{}); ok && len(data) > 0 {
+		if m, ok := data[0].(map[string]interface{}); ok {
+			return stringField(m, "name"), nil
+		}
+	}
+	return "", nil
+}
+
+// latestDraft returns the newest draft of doctype for the tutorial customer
+func (t *tutorial) latestDraft(doctype, partyField string) (string, error) {
+	filters, err := encodeFilters([][]interface{}{
+		{partyField, "=", tutorialCustomer},
+		{"docstatus", "=", 0},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	endpoint := strings.ReplaceAll(doctype, " ", "%20") + "?limit_page_length=1&fields=[\"name\"]&order_by=creation%20desc&filters=" + filters
+	result, err := t.c.Request("GET", endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	if data, ok := result["data"].([]interface{}); ok && len(data) > 0 {
+		if m, ok := data[0].(map[string]interface{}); ok {
+			return stringField(m, "name"), nil
+		}
+	}
+	return "", errorf("could not find the new %s", doctype)
+}
+
+// customerExists reports whether the tutorial customer is already there
+func (t *tutorial) customerExists() bool {
+	_, err := t.c.Request("GET", "Customer/"+url.PathEscape(tutorialCustomer), nil)
+	return err == nil
+}
+
+func tutorialSteps() []tutorialStep {
+	return []tutorialStep{
+		{
+			title: "Create an item",
+			explain: []string{
+				"Everything you buy or sell is an Item. Stock items keep a quantity",
+				"per warehouse; the item group organises them for reports.",
+			},
+			command: func(t *tutorial) string {
+				return fmt.Sprintf("erp-cli item create %s \"Tutorial Widget\" \"%s\"", t.item, t.group)
+			},
+			run: func(t *tutorial) error {
+				return t.c.itemCreate(t.item, "Tutorial Widget", t.group)
+			},
+		},
+		{
+			title: "Receive stock",
+			explain: []string{
+				"A Material Receipt stock entry brings goods into a warehouse.",
+				"The rate sets the valuation of the stock, used later for margins.",
+			},
+			command: func(t *tutorial) string {
+				return fmt.Sprintf("erp-cli stock receive %s 10 \"%s\" --rate=25", t.item, t.warehouse)
+			},
+			run: func(t *tutorial) error {
+				return t.c.stockReceive(t.item, 10, t.warehouse, 25, postingOptions{})
+			},
+		},
+		{
+			title: "Create a customer",
+			explain: []string{
+				"Sales documents are addressed to a Customer. The tutorial reuses",
+				"\"" + tutorialCustomer + "\" when it already exists.",
+			},
+			command: func(t *tutorial) string {
+				return fmt.Sprintf("erp-cli customer create \"%s\"", tutorialCustomer)
+			},
+			run: func(t *tutorial) error {
+				if t.customerExists() {
+					printf("%s✓ Customer already exists: %s%s\n", Green, tutorialCustomer, Reset)
+					return nil
+				}
+				return t.c.customerCreate(tutorialCustomer, customerOptions{})
+			},
+		},
+		{
+			title: "Take an order",
+			explain: []string{
+				"A Sales Order records what the customer ordered. It starts as a",
+				"draft you can add lines to, and is submitted once confirmed.",
+				"Submitting checks the customer's credit limit.",
+			},
+			command: func(t *tutorial) string {
+				return fmt.Sprintf("erp-cli so create \"%s\" && erp-cli so add-item <so> %s 2 --rate=40 && erp-cli so submit <so>", tutorialCustomer, t.item)
+			},
+			run: func(t *tutorial) error {
+				if err := t.c.soCreate(tutorialCustomer, nil, docDiscount{}, "", docCurrency{}, "", docTerms{}, docAddresses{}); err != nil {
+					return err
+				}
+				so, err := t.latestDraft("Sales Order", "customer")
+				if err != nil {
+					return err
+				}
+				t.so = so
+				if err := t.c.soAddItem(so, t.item, 2, 40); err != nil {
+					return err
+				}
+				return t.c.soSubmit(so, false, false)
+			},
+		},
+		{
+			title: "Deliver the goods",
+			explain: []string{
+				"A Delivery Note ships the ordered items and takes them out of",
+				"stock. It is created from the order, so the lines are linked.",
+			},
+			command: func(t *tutorial) string {
+				return fmt.Sprintf("erp-cli dn create-from-so %s && erp-cli dn submit <dn>", t.so)
+			},
+			run: func(t *tutorial) error {
+				if err := t.c.dnCreateFromSO(t.so, postingOptions{}, "", docTerms{}, docAddresses{}); err != nil {
+					return err
+				}
+				dn, err := t.latestDraft("Delivery Note", "customer")
+				if err != nil {
+					return err
+				}
+				t.dn = dn
+				return t.c.dnSubmit(dn)
+			},
+		},
+		{
+			title: "Invoice the customer",
+			explain: []string{
+				"The Sales Invoice is what the customer owes. Once submitted it",
+				"shows up as outstanding in the receivables on the dashboard.",
+			},
+			command: func(t *tutorial) string {
+				return fmt.Sprintf("erp-cli si create-from-so %s && erp-cli si submit <si>", t.so)
+			},
+			run: func(t *tutorial) error {
+				if err := t.c.siCreateFromSO(t.so, nil, postingOptions{}, docDiscount{}, "", docCurrency{}, docTerms{}, docAddresses{}); err != nil {
+					return err
+				}
+				si, err := t.latestDraft("Sales Invoice", "customer")
+				if err != nil {
+					return err
+				}
+				t.si = si
+				return t.c.siSubmit(si, false)
+			},
+		},
+		{
+			title: "Record the payment",
+			explain: []string{
+				"A Payment Entry against the invoice settles it. Without --amount",
+				"it pays the full outstanding amount.",
+			},
+			command: func(t *tutorial) string {
+				return fmt.Sprintf("erp-cli payment receive %s && erp-cli payment submit <payment>", t.si)
+			},
+			run: func(t *tutorial) error {
+				if err := t.c.paymentReceive(t.si, 0, postingOptions{}, docCurrency{}); err != nil {
+					return err
+				}
+				payment, err := t.latestDraft("Payment Entry", "party")
+				if err != nil {
+					return err
+				}
+				t.payment = payment
+				return t.c.paymentSubmit(payment)
+			},
+		},
+	}
+}
+
+// summary lists what the tutorial created and where to go next
+func (t *tutorial) summary() {
+	printf("\n%s✓ Tutorial complete%s\n", Green, Reset)
+	fmt.Println()
+	fmt.Println(T("  Documents created:"))
+	printf("    Item:           %s\n", t.item)
+	printf("    Sales Order:    %s\n", t.so)
+	printf("    Delivery Note:  %s\n", t.dn)
+	printf("    Sales Invoice:  %s\n", t.si)
+	printf("    Payment Entry:  %s\n", t.payment)
+	fmt.Println()
+	fmt.Println(T("  Next steps:"))
+	printf("    erp-cli so get %s             # The order, now delivered and billed\n", t.so)
+	printf("    erp-cli stock get %s        # 8 left after delivering 2\n", t.item)
+	fmt.Println(T("    erp-cli report                    # Dashboard"))
+	fmt.Println(T("    erp-cli                           # Same flow in the TUI"))
+	fmt.Println()
+	fmt.Println(T("  To undo it on the test site:"))
+	printf("    erp-cli so cancel %s --cascade\n", t.so)
+}
@@ -2,56 +2,88 @@ package erp
 
 import (
 	"fmt"
+	"math"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
 // CmdVariant handles variant commands
 func (c *Client) CmdVariant(args []string) error {
 	if len(args) == 0 {
-		fmt.Println("Usage: erp-cli variant <subcommand> [args...]")
-		fmt.Println("Subcommands: create, list")
+		fmt.Println(T("Usage: erp-cli variant <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: create, generate, list, matrix"))
 		fmt.Println()
-		fmt.Println("Examples:")
-		fmt.Println("  erp-cli variant list PSU-ATX")
-		fmt.Println("  erp-cli variant create PSU-ATX PSU-EVGA-500-80G \"Brand=EVGA\" \"Wattage (W)=500\"")
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli variant list PSU-ATX"))
+		fmt.Println(T("  erp-cli variant create PSU-ATX PSU-EVGA-500-80G \"Brand=EVGA\" \"Wattage (W)=500\""))
+		fmt.Println(T("  erp-cli variant generate TSHIRT --attr Color=Red,Blue --attr Size=S,M,L --dry-run"))
+		fmt.Println(T("  erp-cli variant generate TSHIRT --yes    # Every missing combination"))
+		fmt.Println(T("  erp-cli variant matrix TSHIRT            # Which combinations exist"))
 		return nil
 	}
 
 	switch args[0] {
 	case "create":
 		if len(args) < 4 {
-			return fmt.Errorf("usage: erp-cli variant create <template> <code> <attr1=val1> [attr2=val2...]")
+			return errorf("usage: erp-cli variant create <template> <code> <attr1=val1> [attr2=val2...]")
 		}
 		return c.variantCreate(args[1], args[2], args[3:])
 	case "list":
 		if len(args) < 2 {
-			return fmt.Errorf("usage: erp-cli variant list <template>")
+			return errorf("usage: erp-cli variant list <template>")
 		}
 		return c.variantList(args[1])
+	case "generate":
+		if len(args) < 2 || strings.HasPrefix(args[1], "--") {
+			return errorf("usage: erp-cli variant generate <template> [--attr Name=v1,v2 ...] [--dry-run] [--yes]")
+		}
+		picks, err := parseVariantAttrs(args[2:])
+		if err != nil {
+			return err
+		}
+		dryRun, yes := false, false
+		for _, arg := range args[2:] {
+			if arg == "--dry-run" {
+				dryRun = true
+			}
+			if arg == "--yes" || arg == "-y" {
+				yes = true
+			}
+		}
+		return c.variantGenerate(args[1], picks, dryRun, yes)
+	case "matrix":
+		if len(args) < 2 || strings.HasPrefix(args[1], "--") {
+			return errorf("usage: erp-cli variant matrix <template> [--attr Name=v1,v2 ...]")
+		}
+		picks, err := parseVariantAttrs(args[2:])
+		if err != nil {
+			return err
+		}
+		return c.variantMatrixGrid(args[1], picks)
 	default:
-		return fmt.Errorf("unknown variant subcommand: %s", args[0])
+		return errorf("unknown variant subcommand: %s", args[0])
 	}
 }
 
 func (c *Client) variantCreate(template, code string, attrPairs []string) error {
-	fmt.Printf("%sCreating variant from template: %s%s\n", Blue, template, Reset)
-	fmt.Printf("  Variant code: %s\n", code)
+	printf("%sCreating variant from template: %s%s\n", Blue, template, Reset)
+	printf("  Variant code: %s\n", code)
 
 	encoded := url.PathEscape(template)
 	result, err := c.Request("GET", "Item/"+encoded, nil)
 	if err != nil {
-		return fmt.Errorf("failed to get template: %w", err)
+		return errorf("failed to get template: %w", err)
 	}
 
 	data, ok := result["data"].(map[string]interface{})
 	if !ok {
-		return fmt.Errorf("template not found: %s", template)
+		return errorf("template not found: %s", template)
 	}
 
 	hasVariants, _ := data["has_variants"].(float64)
 	if hasVariants != 1 {
-		return fmt.Errorf("%s is not a template (has_variants=0)", template)
+		return errorf("%s is not a template (has_variants=0)", template)
 	}
 
 	itemGroup, _ := data["item_group"].(string)
@@ -77,13 +109,13 @@ func (c *Client) variantCreate(template, code string, attrPairs []string) error
 	for _, pair := range attrPairs {
 		parts := strings.SplitN(pair, "=", 2)
 		if len(parts) != 2 {
-			return fmt.Errorf("invalid attribute format '%s'. Use 'Attribute=Value'", pair)
+			return errorf("invalid attribute format '%s'. Use 'Attribute=Value'", pair)
 		}
 		attrName := strings.TrimSpace(parts[0])
 		attrValue := strings.TrimSpace(parts[1])
 
 		if !templateAttrs[attrName] {
-			return fmt.Errorf("attribute '%s' is not defined in template '%s'", attrName, template)
+			return errorf("attribute '%s' is not defined in template '%s'", attrName, template)
 		}
 
 		providedAttrs[attrName] = attrValue
@@ -95,7 +127,7 @@ func (c *Client) variantCreate(template, code string, attrPairs []string) error
 
 	for attr := range templateAttrs {
 		if _, ok := providedAttrs[attr]; !ok {
-			return fmt.Errorf("missing required attribute: %s", attr)
+			return errorf("missing required attribute: %s", attr)
 		}
 	}
 
@@ -122,10 +154,10 @@ func (c *Client) variantCreate(template, code string, attrPairs []string) error
 		return err
 	}
 
-	fmt.Printf("%s✓ Variant created: %s%s\n", Green, code, Reset)
-	fmt.Printf("  Name: %s\n", variantName)
-	fmt.Printf("  Group: %s\n", itemGroup)
-	fmt.Printf("  Attributes:\n")
+	printf("%s✓ Variant created: %s%s\n", Green, code, Reset)
+	printf("  Name: %s\n", variantName)
+	printf("  Group: %s\n", itemGroup)
+	printf("  Attributes:\n")
 	for attr, val := range providedAttrs {
 		fmt.Printf("    • %s = %s\n", attr, val)
 	}
@@ -134,7 +166,7 @@ func (c *Client) variantCreate(template, code string, attrPairs []string) error
 }
 
 func (c *Client) variantList(template string) error {
-	fmt.Printf("%sFetching variants of: %s%s\n", Blue, template, Reset)
+	printf("%sFetching variants of: %s%s\n", Blue, template, Reset)
 
 	filter := fmt.Sprintf(`[["variant_of","=","%s"]]`, template)
 	encodedFilter := url.QueryEscape(filter)
@@ -146,11 +178,11 @@ func (c *Client) variantList(template string) error {
 
 	if data, ok := result["data"].([]interface{}); ok {
 		if len(data) == 0 {
-			fmt.Printf("%sNo variants found for template: %s%s\n", Yellow, template, Reset)
+			printf("%sNo variants found for template: %s%s\n", Yellow, template, Reset)
 			return nil
 		}
 
-		fmt.Printf("\n%sVariants (%d):%s\n", Cyan, len(data), Reset)
+		printf("\n%sVariants (%d):%s\n", Cyan, len(data), Reset)
 		for _, item := range data {
 			if m, ok := item.(map[string]interface{}); ok {
 				code := m["name"]
@@ -162,3 +194,522 @@ func (c *Client) variantList(template string) error {
 
 	return nil
 }
+
+// maxGeneratedVariants caps one variant generate run
+const maxGeneratedVariants = 500
+
+// maxVariantCombinations caps the combinations built from one matrix,
+// the variants that already exist included
+const maxVariantCombinations = 20 * maxGeneratedVariants
+
+// attributeValue is one value of an item attribute with its code suffix
+type attributeValue struct {
+	value string
+	abbr  string
+}
+
+// variantAttribute is a template attribute with the values to combine
+type variantAttribute struct {
+	name    string
+	numeric bool // Values come from a range, any number is allowed
+	values  []attributeValue
+}
+
+// variantMatrix is a template with the values of its attributes and the
+// value combinations that already have a variant. Shared by variant
+// generate and the TUI.
+type variantMatrix struct {
+	template string
+	data     map[string]interface{}
+	attrs    []variantAttribute
+	existing map[string]string // Combination key -> variant code
+}
+
+// loadVariantMatrix reads a template, the allowed values of its attributes
+// (numeric ones expanded from the template's range) and its variants
+func (c *Client) loadVariantMatrix(template string) (*variantMatrix, error) {
+	result, err := c.Request("GET", "Item/"+url.PathEscape(template), nil)
+	if err != nil {
+		return nil, errorf("failed to get template: %w", err)
+	}
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return nil, errorf("template not found: %s", template)
+	}
+	if hasVariants, _ := data["has_variants"].(float64); hasVariants != 1 {
+		return nil, errorf("%s is not a template (has_variants=0)", template)
+	}
+
+	matrix := &variantMatrix{template: template, data: data, existing: map[string]string{}}
+	rows, _ := data["attributes"].([]interface{})
+	for _, row := range rows {
+		am, ok := row.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		attr := variantAttribute{name: stringField(am, "attribute")}
+		if numeric, _ := am["numeric_values"].(float64); numeric == 1 {
+			attr.numeric = true
+			from, _ := am["from_range"].(float64)
+			to, _ := am["to_range"].(float64)
+			step, _ := am["increment"].(float64)
+			if step > 0 {
+				for v := from; v <= to+step/1e6 && len(attr.values) <= maxGeneratedVariants; v += step {
+					s := strconv.FormatFloat(v, 'f', -1, 64)
+					attr.values = append(attr.values, attributeValue{value: s, abbr: s})
+				}
+			}
+		} else {
+			attr.values, err = c.attributeValues(attr.name)
+			if err != nil {
+				return nil, err
+			}
+		}
+		matrix.attrs = append(matrix.attrs, attr)
+	}
+	if len(matrix.attrs) == 0 {
+		return nil, errorf("template %s has no attributes", template)
+	}
+
+	filters, err := encodeFilters([][]interface{}{{"variant_of", "=", template}})
+	if err != nil {
+		return nil, err
+	}
+	fields := url.QueryEscape("[\"name\",\"`tabItem Variant Attribute`.attribute\",\"`tabItem Variant Attribute`.attribute_value\"]")
+	result, err = c.Request("GET", "Item?limit_page_length=0&filters="+filters+"&fields="+fields, nil)
+	if err != nil {
+		return nil, err
+	}
+	variants := map[string]map[string]string{}
+	if list, ok := result["data"].([]interface{}); ok {
+		for _, row := range list {
+			if m, ok := row.(map[string]interface{}); ok {
+				name := stringField(m, "name")
+				if variants[name] == nil {
+					variants[name] = map[string]string{}
+				}
+				variants[name][stringField(m, "attribute")] = stringField(m, "attribute_value")
+			}
+		}
+	}
+	for name, values := range variants {
+		key := make([]string, len(matrix.attrs))
+		for i, attr := range matrix.attrs {
+			key[i] = values[attr.name]
+		}
+		matrix.existing[strings.Join(key, "\x00")] = name
+	}
+	return matrix, nil
+}
+
+// attributeValues returns the values of a non-numeric Item Attribute
+func (c *Client) attributeValues(attribute string) ([]attributeValue, error) {
+	result, err := c.Request("GET", "Item%20Attribute/"+url.PathEscape(attribute), nil)
+	if err != nil {
+		return nil, errorf("failed to get attribute %s: %w", attribute, err)
+	}
+	var values []attributeValue
+	if data, ok := result["data"].(map[string]interface{}); ok {
+		rows, _ := data["item_attribute_values"].([]interface{})
+		for _, row := range rows {
+			if m, ok := row.(map[string]interface{}); ok {
+				values = append(values, attributeValue{value: stringField(m, "attribute_value"), abbr: stringField(m, "abbr")})
+			}
+		}
+	}
+	return values, nil
+}
+
+// restrict keeps only the picked values of the given attributes. Values of
+// list attributes must exist; numeric ones accept any number.
+func (m *variantMatrix) restrict(picks map[string][]string) error {
+	for name, picked := range picks {
+		found := false
+		for i := range m.attrs {
+			attr := &m.attrs[i]
+			if attr.name != name {
+				continue
+			}
+			found = true
+
+			var values []attributeValue
+			for _, p := range picked {
+				if attr.numeric {
+					if _, err := strconv.ParseFloat(p, 64); err != nil {
+						return errorf("%s is numeric: invalid value %q", name, p)
+					}
+					values = append(values, attributeValue{value: p, abbr: p})
+					continue
+				}
+				match := false
+				for _, v := range attr.values {
+					if strings.EqualFold(v.value, p) {
+						values = append(values, v)
+						match = true
+						break
+					}
+				}
+				if !match {
+					return errorf("'%s' is not a value of attribute %s", p, name)
+				}
+			}
+			attr.values = values
+		}
+		if !found {
+			return errorf("attribute '%s' is not defined in template '%s'", name, m.template)
+		}
+	}
+	return nil
+}
+
+// size returns the number of combinations without building them; false
+// when the product overflows an int
+func (m *variantMatrix) size() (int, bool) {
+	for _, attr := range m.attrs {
+		if len(attr.values) == 0 {
+			return 0, true
+		}
+	}
+	n := 1
+	for _, attr := range m.attrs {
+		if n > math.MaxInt/len(attr.values) {
+			return 0, false
+		}
+		n *= len(attr.values)
+	}
+	return n, true
+}
+
+// checkSize refuses a matrix with more combinations than can be built
+func (m *variantMatrix) checkSize() error {
+	if n, ok := m.size(); !ok || n > maxVariantCombinations {
+		return errorf("more than %d variant combinations", maxVariantCombinations)
+	}
+	return nil
+}
+
+// combinations returns the cartesian product of the attribute values, in
+// attribute order
+func (m *variantMatrix) combinations() [][]attributeValue {
+	combos := [][]attributeValue{{}}
+	for _, attr := range m.attrs {
+		var next [][]attributeValue
+		for _, combo := range combos {
+			for _, v := range attr.values {
+				c := append(append([]attributeValue{}, combo...), v)
+				next = append(next, c)
+			}
+		}
+		combos = next
+	}
+	return combos
+}
+
+// exists returns the code of the variant with these values, if any
+func (m *variantMatrix) exists(combo []attributeValue) (string, bool) {
+	key := make([]string, len(combo))
+	for i, v := range combo {
+		key[i] = v.value
+	}
+	name, ok := m.existing[strings.Join(key, "\x00")]
+	return name, ok
+}
+
+// variantBody builds the Item of one combination. The code follows
+// ERPNext's own naming: template code and value abbreviations joined by "-".
+func (m *variantMatrix) variantBody(combo []attributeValue) map[string]interface{} {
+	code := m.template
+	name := m.template
+	var attrs []map[string]string
+	for i, v := range combo {
+		abbr := v.abbr
+		if abbr == "" {
+			abbr = v.value
+		}
+		code += "-" + abbr
+		name += " " + v.value
+		attrs = append(attrs, map[string]string{
+			"attribute":       m.attrs[i].name,
+			"attribute_value": v.value,
+		})
+	}
+
+	stockUom := stringField(m.data, "stock_uom")
+	if stockUom == "" {
+		stockUom = "Unit"
+	}
+	return map[string]interface{}{
+		"item_code":     code,
+		"item_name":     name,
+		"variant_of":    m.template,
+		"item_group":    stringField(m.data, "item_group"),
+		"is_stock_item": 1,
+		"stock_uom":     stockUom,
+		"attributes":    attrs,
+	}
+}
+
+// describeCombo shows a combination as "Attr=Value, Attr=Value"
+func (m *variantMatrix) describeCombo(combo []attributeValue) string {
+	parts := make([]string, len(combo))
+	for i, v := range combo {
+		parts[i] = m.attrs[i].name + "=" + v.value
+	}
+	return strings.Join(parts, ", ")
+}
+
+// createVariants creates the variants of the given combinations in bulk
+func (c *Client) createVariants(m *variantMatrix, combos [][]attributeValue) []bulkResult {
+	bodies := make([]map[string]interface{}, len(combos))
+	for i, combo := range combos {
+		bodies[i] = m.variantBody(combo)
+	}
+	return c.insertMany("Item", bodies)
+}
+
+// parseVariantAttrs reads --attr Name=v1,v2 (repeatable, also --attr=...)
+func parseVariantAttrs(args []string) (map[string][]string, error) {
+	picks := map[string][]string{}
+	for i := 0; i < len(args); i++ {
+		spec := ""
+		switch {
+		case args[i] == "--attr":
+			if i+1 >= len(args) {
+				return nil, errorf("--attr needs Name=value1,value2")
+			}
+			spec = args[i+1]
+			i++
+		case len(args[i]) > 7 && args[i][:7] == "--attr=":
+			spec = args[i][7:]
+		default:
+			continue
+		}
+
+		name, values, ok := strings.Cut(spec, "=")
+		if !ok || strings.TrimSpace(name) == "" || values == "" {
+			return nil, errorf("invalid --attr %q (expected Name=value1,value2)", spec)
+		}
+		for _, v := range strings.Split(values, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				picks[strings.TrimSpace(name)] = append(picks[strings.TrimSpace(name)], v)
+			}
+		}
+	}
+	return picks, nil
+}
+
+// variantGenerate creates every missing variant of the attribute matrix.
+// Attributes without --attr use all their values.
+func (c *Client) variantGenerate(template string, picks map[string][]string, dryRun, yes bool) error {
+	printf("%sComputing variants of: %s%s\n", Blue, template, Reset)
+
+	matrix, err := c.loadVariantMatrix(template)
+	if err != nil {
+		return err
+	}
+	if err := matrix.restrict(picks); err != nil {
+		return err
+	}
+
+	printf("\n%sAttributes:%s\n", Cyan, Reset)
+	for _, attr := range matrix.attrs {
+		values := make([]string, len(attr.values))
+		for i, v := range attr.values {
+			values[i] = v.value
+		}
+		fmt.Printf("  %s: %s\n", attr.name, strings.Join(values, ", "))
+	}
+
+	if err := matrix.checkSize(); err != nil {
+		return errorf("%w; narrow the values with --attr", err)
+	}
+	all := matrix.combinations()
+	var missing [][]attributeValue
+	for _, combo := range all {
+		if _, ok := matrix.exists(combo); !ok {
+			missing = append(missing, combo)
+		}
+	}
+
+	if len(missing) == 0 {
+		printf("\n%s✓ All %d variants already exist%s\n", Green, len(all), Reset)
+		return nil
+	}
+	if len(missing) > maxGeneratedVariants {
+		return errorf("%d variants to create (max %d); narrow the values with --attr", len(missing), maxGeneratedVariants)
+	}
+
+	printf("\n%sVariants to create (%d, %d already exist):%s\n", Cyan, len(missing), len(all)-len(missing), Reset)
+	for _, combo := range missing {
+		fmt.Printf("  + %s%s%s  (%s)\n", Green, matrix.variantBody(combo)["item_code"], Reset, matrix.describeCombo(combo))
+	}
+
+	if dryRun {
+		printf("\n%s[DRY RUN] Nothing created%s\n", Yellow, Reset)
+		return nil
+	}
+	if !yes && !confirm(tf("\nCreate %d variants?", len(missing))) {
+		printf("%sAborted%s\n", Yellow, Reset)
+		return nil
+	}
+
+	created, failed := 0, 0
+	for _, r := range c.createVariants(matrix, missing) {
+		if r.err != nil {
+			printf("  %s✗ Failed: %s (%s)%s\n", Red, r.doc["item_code"], r.err, Reset)
+			failed++
+		} else {
+			printf("  %s✓ Created: %s%s\n", Green, r.doc["item_code"], Reset)
+			created++
+		}
+	}
+	printf("\n%sSummary: %d created, %d failed%s\n", Cyan, created, failed, Reset)
+	return nil
+}
+
+// variantMatrixGrid prints which value combinations of a template have a
+// variant: the last attribute's values are the columns, the combinations of
+// the others the rows
+func (c *Client) variantMatrixGrid(template string, picks map[string][]string) error {
+	matrix, err := c.loadVariantMatrix(template)
+	if err != nil {
+		return err
+	}
+	if err := matrix.restrict(picks); err != nil {
+		return err
+	}
+
+	names := make([]string, len(matrix.attrs))
+	for i, attr := range matrix.attrs {
+		names[i] = attr.name
+	}
+	printf("%sCoverage of %s (%s)%s\n\n", Cyan, template, strings.Join(names, " × "), Reset)
+
+	cols := matrix.attrs[len(matrix.attrs)-1]
+	rowMatrix := *matrix
+	rowMatrix.attrs = matrix.attrs[:len(matrix.attrs)-1]
+	if err := rowMatrix.checkSize(); err != nil {
+		return errorf("%w; narrow the values with --attr", err)
+	}
+	rows := rowMatrix.combinations()
+
+	labels := make([]string, len(rows))
+	labelWidth := len(strings.Join(names[:len(names)-1], " / "))
+	for i, combo := range rows {
+		values := make([]string, len(combo))
+		for j, v := range combo {
+			values[j] = v.value
+		}
+		labels[i] = strings.Join(values, " / ")
+		labelWidth = max(labelWidth, len([]rune(labels[i])))
+	}
+
+	fmt.Printf("  %-*s", labelWidth, strings.Join(names[:len(names)-1], " / "))
+	for _, v := range cols.values {
+		fmt.Printf("  %s", v.value)
+	}
+	fmt.Println()
+
+	exist, total := 0, 0
+	for i, combo := range rows {
+		fmt.Printf("  %-*s", labelWidth, labels[i])
+		for _, v := range cols.values {
+			mark := Red + "·" + Reset
+			if _, ok := matrix.exists(append(append([]attributeValue{}, combo...), v)); ok {
+				mark = Green + "✓" + Reset
+				exist++
+			}
+			total++
+			// Centre the mark under its value
+			width := len([]rune(v.value))
+			left := (width - 1) / 2
+			fmt.Printf("  %s%s%s", strings.Repeat(" ", left), mark, strings.Repeat(" ", width-1-left))
+		}
+		fmt.Println()
+	}
+
+	printf("\n  %d of %d variants exist", exist, total)
+	if total > 0 {
+		fmt.Printf(" (%d%%)", exist*100/total)
+	}
+	fmt.Println()
+	if missing := total - exist; missing > 0 {
+		command := "erp-cli variant generate " + template
+		for _, attr := range matrix.attrs {
+			if picked, ok := picks[attr.name]; ok {
+				command += fmt.Sprintf(" --attr %q", attr.name+"="+strings.Join(picked, ","))
+			}
+		}
+		printf("  %sCreate the %d missing with: %s%s\n", Yellow, missing, command, Reset)
+	}
+	return nil
+}
+
+// templateVariant is an existing variant of a template with its attribute
+// values and stock
+type templateVariant struct {
+	code   string
+	name   string
+	values map[string]string // Attribute -> value
+	stock  float64           // Actual qty over all warehouses
+}
+
+// fetchVariants reads the variants of a template, with their attribute
+// values and their stock
+func (c *Client) fetchVariants(template string) ([]templateVariant, error) {
+	filters, err := encodeFilters([][]interface{}{{"variant_of", "=", template}})
+	if err != nil {
+		return nil, err
+	}
+	fields := url.QueryEscape("[\"name\",\"item_name\",\"`tabItem Variant Attribute`.attribute\",\"`tabItem Variant Attribute`.attribute_value\"]")
+	result, err := c.Request("GET", "Item?limit_page_length=0&order_by=name%20asc&filters="+filters+"&fields="+fields, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var variants []templateVariant
+	index := map[string]int{}
+	rows, _ := result["data"].([]interface{})
+	for _, row := range rows {
+		m, ok := row.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		code := stringField(m, "name")
+		i, seen := index[code]
+		if !seen {
+			i = len(variants)
+			index[code] = i
+			variants = append(variants, templateVariant{code: code, name: stringField(m, "item_name"), values: map[string]string{}})
+		}
+		if attr := stringField(m, "attribute"); attr != "" {
+			variants[i].values[attr] = stringField(m, "attribute_value")
+		}
+	}
+	if len(variants) == 0 {
+		return variants, nil
+	}
+
+	codes := make([]string, len(variants))
+	for i, v := range variants {
+		codes[i] = v.code
+	}
+	filters, err = encodeFilters([][]interface{}{{"item_code", "in", codes}})
+	if err != nil {
+		return nil, err
+	}
+	result, err = c.Request("GET", "Bin?limit_page_length=0&fields=[\"item_code\",\"actual_qty\"]&filters="+filters, nil)
+	if err != nil {
+		return nil, err
+	}
+	bins, _ := result["data"].([]interface{})
+	for _, row := range bins {
+		if m, ok := row.(map[string]interface{}); ok {
+			qty, _ := m["actual_qty"].(float64)
+			if i, ok := index[stringField(m, "item_code")]; ok {
+				variants[i].stock += qty
+			}
+		}
+	}
+	return variants, nil
+}
@@ -0,0 +1,355 @@
+package erp
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Stock Entry purposes ERPNext uses for the manufacturing flow
+const (
+	purposeTransfer    = "Material Transfer for Manufacture"
+	purposeManufacture = "Manufacture"
+)
+
+// woListOptions holds filters for wo list
+type woListOptions struct {
+	status string // Work Order status, e.g. "In Process"
+	item   string
+}
+
+// woCreateOptions holds flags for wo create
+type woCreateOptions struct {
+	bom          string // Defaults to the item's default BOM
+	wipWarehouse string // Defaults from Manufacturing Settings
+	fgWarehouse  string
+	submit       bool
+}
+
+// CmdWO handles Work Order commands
+func (c *Client) CmdWO(args []string) error {
+	if len(args) == 0 {
+		fmt.Println(T("Usage: erp-cli wo <subcommand> [args...]"))
+		fmt.Println(T("Subcommands: list, get, create, start, finish"))
+		fmt.Println()
+		fmt.Println(T("start moves the BOM components to the work-in-progress warehouse"))
+		fmt.Println(T("(Material Transfer for Manufacture); finish consumes them and receives"))
+		fmt.Println(T("the finished goods (Manufacture). Both submit their Stock Entry, and"))
+		fmt.Println(T("submit a draft Work Order first. --qty defaults to the pending quantity."))
+		fmt.Println()
+		fmt.Println(T("Examples:"))
+		fmt.Println(T("  erp-cli wo list --status=\"In Process\""))
+		fmt.Println(T("  erp-cli wo create PC-GAMING 5"))
+		fmt.Println(T("  erp-cli wo create PC-GAMING 5 --bom=BOM-PC-GAMING-002 --fg-warehouse=\"Finished Goods - XX\" --submit"))
+		fmt.Println(T("  erp-cli wo start MFG-WO-2026-00001"))
+		fmt.Println(T("  erp-cli wo finish MFG-WO-2026-00001 --qty=3 --posting-date=2026-03-31"))
+		fmt.Println(T("  erp-cli wo get MFG-WO-2026-00001"))
+		return nil
+	}
+
+	switch args[0] {
+	case "list":
+		opts := woListOptions{}
+		for _, arg := range args[1:] {
+			if len(arg) > 9 && arg[:9] == "--status=" {
+				opts.status = arg[9:]
+			}
+			if len(arg) > 7 && arg[:7] == "--item=" {
+				opts.item = arg[7:]
+			}
+		}
+		return c.woList(opts)
+	case "get":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli wo get <name>")
+		}
+		return c.woGet(args[1])
+	case "create":
+		opts := woCreateOptions{}
+		var positional []string
+		for _, arg := range args[1:] {
+			switch {
+			case len(arg) > 6 && arg[:6] == "--bom=":
+				opts.bom = arg[6:]
+			case len(arg) > 16 && arg[:16] == "--wip-warehouse=":
+				opts.wipWarehouse = arg[16:]
+			case len(arg) > 15 && arg[:15] == "--fg-warehouse=":
+				opts.fgWarehouse = arg[15:]
+			case arg == "--submit":
+				opts.submit = true
+			default:
+				positional = append(positional, arg)
+			}
+		}
+		if len(positional) < 2 {
+			return errorf("usage: erp-cli wo create <item_code> <qty> [--bom=X] [--wip-warehouse=X] [--fg-warehouse=X] [--submit]")
+		}
+		qty, err := strconv.ParseFloat(positional[1], 64)
+		if err != nil || qty <= 0 {
+			return errorf("invalid quantity: %s", positional[1])
+		}
+		return c.woCreate(positional[0], qty, opts)
+	case "start", "finish":
+		if len(args) < 2 {
+			return errorf("usage: erp-cli wo %s <name> [--qty=N] [--posting-date=YYYY-MM-DD]", args[0])
+		}
+		qty := 0.0
+		for _, arg := range args[2:] {
+			if len(arg) > 6 && arg[:6] == "--qty=" {
+				q, err := strconv.ParseFloat(arg[6:], 64)
+				if err != nil || q <= 0 {
+					return errorf("invalid quantity: %s", arg[6:])
+				}
+				qty = q
+			}
+		}
+		posting, err := parsePostingOptions(args[2:])
+		if err != nil {
+			return err
+		}
+		purpose := purposeTransfer
+		if args[0] == "finish" {
+			purpose = purposeManufacture
+		}
+		return c.woStockEntry(args[1], purpose, qty, posting)
+	default:
+		return errorf("unknown wo subcommand: %s", args[0])
+	}
+}
+
+func (c *Client) woList(opts woListOptions) error {
+	printf("%sFetching work orders...%s\n", Blue, Reset)
+
+	filters := [][]interface{}{}
+	if opts.status != "" {
+		filters = append(filters, []interface{}{"status", "=", opts.status})
+	}
+	if opts.item != "" {
+		filters = append(filters, []interface{}{"production_item", "=", opts.item})
+	}
+
+	endpoint := "Work%20Order?" + c.pageLimit(0) + "&fields=[\"name\",\"production_item\",\"qty\",\"produced_qty\",\"status\",\"planned_start_date\"]&order_by=creation%20desc"
+	if len(filters) > 0 {
+		encoded, err := encodeFilters(filters)
+		if err != nil {
+			return err
+		}
+		endpoint += "&filters=" + encoded
+	}
+
+	result, err := c.Request("GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+
+	if data, ok := result["data"].([]interface{}); ok {
+		if len(data) == 0 {
+			printf("%sNo work orders found%s\n", Yellow, Reset)
+			return nil
+		}
+
+		printf("\n%sWork Orders (%d):%s\n", Cyan, len(data), Reset)
+		c.printPageNote(len(data))
+		for _, item := range data {
+			if m, ok := item.(map[string]interface{}); ok {
+				qty, _ := m["qty"].(float64)
+				produced, _ := m["produced_qty"].(float64)
+				fmt.Printf("  %s - %s\n", m["name"], m["production_item"])
+				printf("    Status: %s%s%s | Produced: %g/%g | Planned: %s\n",
+					woStatusColor(stringField(m, "status")), m["status"], Reset, produced, qty, stringField(m, "planned_start_date"))
+			}
+		}
+	}
+	return nil
+}
+
+// woStatusColor picks the terminal color of a Work Order status
+func woStatusColor(status string) string {
+	switch status {
+	case "Completed":
+		return Green
+	case "Cancelled", "Stopped":
+		return Red
+	case "In Process":
+		return Cyan
+	}
+	return Yellow
+}
+
+func (c *Client) fetchWorkOrder(name string) (map[string]interface{}, error) {
+	result, err := c.Request("GET", "Work%20Order/"+url.PathEscape(name), nil)
+	if err != nil {
+		return nil, err
+	}
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return nil, errorf("work order not found: %s", name)
+	}
+	return data, nil
+}
+
+func (c *Client) woGet(name string) error {
+	printf("%sFetching work order: %s%s\n", Blue, name, Reset)
+
+	data, err := c.fetchWorkOrder(name)
+	if err != nil {
+		return err
+	}
+
+	qty, _ := data["qty"].(float64)
+	transferred, _ := data["material_transferred_for_manufacturing"].(float64)
+	produced, _ := data["produced_qty"].(float64)
+	status := stringField(data, "status")
+
+	printf("\n%sWork Order: %s%s\n", Cyan, name, Reset)
+	printf("  Item: %s (%s)\n", data["production_item"], data["item_name"])
+	printf("  BOM: %s\n", data["bom_no"])
+	printf("  Status: %s%s%s\n", woStatusColor(status), status, Reset)
+	printf("  Qty: %g | Transferred: %g | Produced: %g\n", qty, transferred, produced)
+	printf("  WIP Warehouse: %s\n", stringField(data, "wip_warehouse"))
+	printf("  Target Warehouse: %s\n", stringField(data, "fg_warehouse"))
+
+	if items, ok := data["required_items"].([]interface{}); ok && len(items) > 0 {
+		printf("\n  %sRequired Items:%s\n", Yellow, Reset)
+		for _, item := range items {
+			if m, ok := item.(map[string]interface{}); ok {
+				required, _ := m["required_qty"].(float64)
+				moved, _ := m["transferred_qty"].(float64)
+				consumed, _ := m["consumed_qty"].(float64)
+				printf("    - %s: %g required, %g transferred, %g consumed (from %s)\n",
+					m["item_code"], required, moved, consumed, stringField(m, "source_warehouse"))
+			}
+		}
+	}
+	return nil
+}
+
+// createWorkOrder inserts a Work Order for item, using its default BOM
+// unless opts names one, and returns its name
+func (c *Client) createWorkOrder(item string, qty float64, opts woCreateOptions) (string, error) {
+	bom := opts.bom
+	if bom == "" {
+		var err error
+		if bom, err = c.defaultBOM(item); err != nil {
+			return "", err
+		}
+	}
+
+	company, err := c.GetCompany()
+	if err != nil {
+		return "", err
+	}
+
+	body := map[string]interface{}{
+		"production_item": item,
+		"bom_no":          bom,
+		"qty":             qty,
+		"company":         company,
+	}
+	if opts.wipWarehouse != "" {
+		body["wip_warehouse"] = opts.wipWarehouse
+	}
+	if opts.fgWarehouse != "" {
+		body["fg_warehouse"] = opts.fgWarehouse
+	}
+
+	result, err := c.Request("POST", "Work%20Order", body)
+	if err != nil {
+		return "", err
+	}
+	data, _ := result["data"].(map[string]interface{})
+	name := stringField(data, "name")
+
+	if opts.submit {
+		if err := c.submitDocument("Work Order", name); err != nil {
+			return name, errorf("%s created but not submitted: %w", name, err)
+		}
+	}
+	return name, nil
+}
+
+func (c *Client) woCreate(item string, qty float64, opts woCreateOptions) error {
+	printf("%sCreating work order: %s x %g%s\n", Blue, item, qty, Reset)
+
+	name, err := c.createWorkOrder(item, qty, opts)
+	if err != nil {
+		return err
+	}
+
+	printf("%s✓ Work Order created: %s%s\n", Green, name, Reset)
+	if opts.submit {
+		printf("  Status: Not Started\n")
+	} else {
+		printf("  Status: Draft (wo start submits it)\n")
+	}
+	printf("  Use 'erp-cli wo start %s' to transfer the materials\n", name)
+	return nil
+}
+
+// makeWorkOrderEntry creates and submits the Stock Entry of one step of a
+// Work Order, submitting a draft Work Order first. A zero qty lets ERPNext
+// use the pending quantity.
+func (c *Client) makeWorkOrderEntry(name, purpose string, qty float64, posting postingOptions) (string, error) {
+	wo, err := c.fetchWorkOrder(name)
+	if err != nil {
+		return "", err
+	}
+	switch docstatus, _ := wo["docstatus"].(float64); docstatus {
+	case 0:
+		if err := c.submitDocument("Work Order", name); err != nil {
+			return "", errorf("failed to submit %s: %w", name, err)
+		}
+	case 2:
+		return "", errorf("work order %s is cancelled", name)
+	}
+
+	args := map[string]interface{}{
+		"work_order_id": name,
+		"purpose":       purpose,
+	}
+	if qty > 0 {
+		args["qty"] = qty
+	}
+	result, err := c.CallMethod("POST", "erpnext.manufacturing.doctype.work_order.work_order.make_stock_entry", args)
+	if err != nil {
+		return "", err
+	}
+	entry, ok := result["message"].(map[string]interface{})
+	if !ok {
+		return "", errorf("no stock entry returned for %s", name)
+	}
+	if err := c.applyPosting(entry, posting, true); err != nil {
+		return "", err
+	}
+
+	result, err = c.Request("POST", "Stock%20Entry", entry)
+	if err != nil {
+		return "", err
+	}
+	data, _ := result["data"].(map[string]interface{})
+	entryName := stringField(data, "name")
+	if err := c.submitDocument("Stock Entry", entryName); err != nil {
+		return entryName, errorf("%s created but not submitted: %w", entryName, err)
+	}
+	return entryName, nil
+}
+
+func (c *Client) woStockEntry(name, purpose string, qty float64, posting postingOptions) error {
+	if purpose == purposeManufacture {
+		printf("%sFinishing work order: %s%s\n", Blue, name, Reset)
+	} else {
+		printf("%sStarting work order: %s%s\n", Blue, name, Reset)
+	}
+	c.printPosting(posting)
+
+	entry, err := c.makeWorkOrderEntry(name, purpose, qty, posting)
+	if err != nil {
+		return err
+	}
+
+	printf("%s✓ Stock Entry submitted: %s (%s)%s\n", Green, entry, purpose, Reset)
+	if purpose == purposeTransfer {
+		printf("  Use 'erp-cli wo finish %s' once production is done\n", name)
+	}
+	return nil
+}
@@ -0,0 +1,374 @@
+package erp
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// A minimal XLSX (Office Open XML) writer. Text is written as inline
+// strings so spreadsheet apps keep leading zeros in codes; float64 and int
+// values become numeric cells. The first row of every sheet is bold.
+
+// xlsxSheet is one worksheet: a header row and data rows
+type xlsxSheet struct {
+	name   string
+	header []string
+	rows   [][]interface{}
+}
+
+// writeXLSX writes the sheets to path as a workbook
+func writeXLSX(path string, sheets []xlsxSheet) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	zw := zip.NewWriter(file)
+	add := func(name, content string) error {
+		w, err := zw.Create(name)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write([]byte(xml.Header + content))
+		return err
+	}
+
+	var overrides, workbookSheets, workbookRels strings.Builder
+	for i := range sheets {
+		n := i + 1
+		fmt.Fprintf(&overrides, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, n)
+		fmt.Fprintf(&workbookSheets, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, xmlEscape(xlsxSheetName(sheets[i].name)), n, n)
+		fmt.Fprintf(&workbookRels, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, n, n)
+	}
+	stylesID := len(sheets) + 1
+
+	files := []struct{ name, content string }{
+		{"[Content_Types].xml", `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+			`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+			`<Default Extension="xml" ContentType="application/xml"/>` +
+			`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+			`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+			overrides.String() + `</Types>`},
+		{"_rels/.rels", `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+			`</Relationships>`},
+		{"xl/workbook.xml", `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets>` + workbookSheets.String() + `</sheets></workbook>`},
+		{"xl/_rels/workbook.xml.rels", `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			workbookRels.String() +
+			fmt.Sprintf(`<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, stylesID) +
+			`</Relationships>`},
+		{"xl/styles.xml", `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+			`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+			`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+			`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+			`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+			`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/><xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs>` +
+			`</styleSheet>`},
+	}
+	for i, sheet := range sheets {
+		files = append(files, struct{ name, content string }{
+			fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), xlsxSheetXML(sheet),
+		})
+	}
+
+	for _, f := range files {
+		if err := add(f.name, f.content); err != nil {
+			return errorf("failed to write XLSX: %w", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return errorf("failed to write XLSX: %w", err)
+	}
+	return nil
+}
+
+// xlsxSheetXML renders the worksheet part of a sheet
+func xlsxSheetXML(sheet xlsxSheet) string {
+	var b strings.Builder
+	b.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+
+	header := make([]interface{}, len(sheet.header))
+	for i, h := range sheet.header {
+		header[i] = h
+	}
+	writeRow := func(r int, cells []interface{}, style string) {
+		fmt.Fprintf(&b, `<row r="%d">`, r)
+		for col, value := range cells {
+			ref := xlsxColumn(col) + strconv.Itoa(r)
+			switch v := value.(type) {
+			case nil:
+				continue
+			case float64:
+				fmt.Fprintf(&b, `<c r="%s"%s><v>%s</v></c>`, ref, style, strconv.FormatFloat(v, 'f', -1, 64))
+			case int:
+				fmt.Fprintf(&b, `<c r="%s"%s><v>%d</v></c>`, ref, style, v)
+			default:
+				text := fmt.Sprintf("%v", v)
+				if text == "" {
+					continue
+				}
+				fmt.Fprintf(&b, `<c r="%s" t="inlineStr"%s><is><t xml:space="preserve">%s</t></is></c>`, ref, style, xmlEscape(text))
+			}
+		}
+		b.WriteString(`</row>`)
+	}
+
+	writeRow(1, header, ` s="1"`)
+	for i, row := range sheet.rows {
+		writeRow(i+2, row, "")
+	}
+	b.WriteString(`</sheetData></worksheet>`)
+	return b.String()
+}
+
+// xlsxColumn converts a zero-based column index to its letters (0 = A)
+func xlsxColumn(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
+
+// xlsxSheetName strips the characters Excel forbids and caps the length
+func xlsxSheetName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) {
+			return '-'
+		}
+		return r
+	}, name)
+	if len([]rune(name)) > 31 {
+		name = string([]rune(name)[:31])
+	}
+	return name
+}
+
+func xmlEscape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// isXLSXPath reports whether an output file should be written as XLSX
+func isXLSXPath(path string) bool {
+	return strings.HasSuffix(strings.ToLower(path), ".xlsx")
+}
+
+// tableWriter writes rows to a CSV file, or to a single-sheet XLSX file
+// when the name ends in .xlsx
+type tableWriter struct {
+	path  string
+	file  *os.File
+	csv   *csv.Writer
+	sheet *xlsxSheet
+}
+
+func newTableWriter(path, sheetName string) (*tableWriter, error) {
+	if isXLSXPath(path) {
+		return &tableWriter{path: path, sheet: &xlsxSheet{name: sheetName}}, nil
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, errorf("failed to create file: %w", err)
+	}
+	return &tableWriter{path: path, file: file, csv: csv.NewWriter(file)}, nil
+}
+
+// Write adds a row; the first row written is the header
+func (w *tableWriter) Write(row []string) error {
+	if w.csv != nil {
+		return w.csv.Write(row)
+	}
+	if w.sheet.header == nil {
+		w.sheet.header = row
+		return nil
+	}
+	cells := make([]interface{}, len(row))
+	for i, v := range row {
+		cells[i] = v
+	}
+	w.sheet.rows = append(w.sheet.rows, cells)
+	return nil
+}
+
+// Close flushes the rows to disk
+func (w *tableWriter) Close() error {
+	if w.csv != nil {
+		w.csv.Flush()
+		err := w.csv.Error()
+		w.file.Close()
+		if err != nil {
+			return errorf("failed to write CSV: %w", err)
+		}
+		return nil
+	}
+	return writeXLSX(w.path, []xlsxSheet{*w.sheet})
+}
+
+// docSheetSpec lists the header and child table fields written to the two
+// sheets of a transaction export
+type docSheetSpec struct {
+	fields      []string
+	child       string // Child table doctype
+	childFields []string
+}
+
+var docItemFields = []string{"item_code", "item_name", "qty", "uom", "rate", "amount", "warehouse"}
+
+var docSheetSpecs = map[string]docSheetSpec{
+	"Quotation": {
+		fields: []string{"name", "party_name", "transaction_date", "valid_till", "status", "currency", "net_total", "grand_total"},
+		child:  "Quotation Item", childFields: docItemFields,
+	},
+	"Sales Order": {
+		fields: []string{"name", "customer", "transaction_date", "delivery_date", "status", "currency", "net_total", "grand_total"},
+		child:  "Sales Order Item", childFields: docItemFields,
+	},
+	"Sales Invoice": {
+		fields: []string{"name", "customer", "posting_date", "due_date", "status", "currency", "net_total", "grand_total", "outstanding_amount"},
+		child:  "Sales Invoice Item", childFields: docItemFields,
+	},
+	"Delivery Note": {
+		fields: []string{"name", "customer", "posting_date", "status", "currency", "net_total", "grand_total"},
+		child:  "Delivery Note Item", childFields: docItemFields,
+	},
+	"Purchase Order": {
+		fields: []string{"name", "supplier", "transaction_date", "schedule_date", "status", "currency", "net_total", "grand_total"},
+		child:  "Purchase Order Item", childFields: docItemFields,
+	},
+	"Purchase Invoice": {
+		fields: []string{"name", "supplier", "posting_date", "due_date", "status", "currency", "net_total", "grand_total", "outstanding_amount"},
+		child:  "Purchase Invoice Item", childFields: docItemFields,
+	},
+	"Purchase Receipt": {
+		fields: []string{"name", "supplier", "posting_date", "status", "currency", "net_total", "grand_total"},
+		child:  "Purchase Receipt Item", childFields: docItemFields,
+	},
+	"Payment Entry": {
+		fields: []string{"name", "payment_type", "party_type", "party", "posting_date", "mode_of_payment", "paid_amount", "reference_no", "status"},
+		child:  "Payment Entry Reference", childFields: []string{"reference_doctype", "reference_name", "total_amount", "allocated_amount"},
+	},
+	"Journal Entry": {
+		fields: []string{"name", "voucher_type", "posting_date", "total_debit", "total_credit", "cheque_no", "user_remark"},
+		child:  "Journal Entry Account", childFields: []string{"account", "party_type", "party", "debit_in_account_currency", "credit_in_account_currency"},
+	},
+}
+
+// parseXLSXFlag reads --xlsx (written to defaultName) or --xlsx=file
+func parseXLSXFlag(arg, defaultName string) (string, bool) {
+	if arg == "--xlsx" {
+		return defaultName, true
+	}
+	if len(arg) > 7 && arg[:7] == "--xlsx=" {
+		path := arg[7:]
+		if !isXLSXPath(path) {
+			path += ".xlsx"
+		}
+		return path, true
+	}
+	return "", false
+}
+
+// exportDocsXLSX writes every document matching filters to a workbook with
+// a header sheet (one row per document) and an items sheet (one row per
+// child line, keyed by the document name)
+func (c *Client) exportDocsXLSX(doctype string, filters [][]interface{}, path string) error {
+	spec, ok := docSheetSpecs[doctype]
+	if !ok {
+		return errorf("XLSX export not supported for %s", doctype)
+	}
+
+	query := "?limit_page_length=0"
+	if len(filters) > 0 {
+		encoded, err := encodeFilters(filters)
+		if err != nil {
+			return err
+		}
+		query += "&filters=" + encoded
+	}
+
+	fields, _ := json.Marshal(spec.fields)
+	result, err := c.Request("GET", url.PathEscape(doctype)+query+"&order_by=creation%20desc&fields="+url.QueryEscape(string(fields)), nil)
+	if err != nil {
+		return err
+	}
+
+	header := xlsxSheet{name: doctype, header: spec.fields}
+	var names []string
+	seen := map[string]bool{}
+	if data, ok := result["data"].([]interface{}); ok {
+		for _, item := range data {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			// Child table filters can return a document once per matching line
+			name := stringField(m, "name")
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			names = append(names, name)
+
+			row := make([]interface{}, len(spec.fields))
+			for i, f := range spec.fields {
+				row[i] = m[f]
+			}
+			header.rows = append(header.rows, row)
+		}
+	}
+
+	if len(names) == 0 {
+		printf("%sNo %s found%s\n", Yellow, doctype, Reset)
+		return nil
+	}
+
+	// Child rows come through the parent so permissions and filters match
+	childFields := []string{"name"}
+	for _, f := range spec.childFields {
+		childFields = append(childFields, "`tab"+spec.child+"`."+f)
+	}
+	fields, _ = json.Marshal(childFields)
+	order := url.QueryEscape("`tab" + spec.child + "`.idx asc")
+	result, err = c.Request("GET", url.PathEscape(doctype)+query+"&order_by="+order+"&fields="+url.QueryEscape(string(fields)), nil)
+	if err != nil {
+		return err
+	}
+
+	lines := map[string][][]interface{}{}
+	if data, ok := result["data"].([]interface{}); ok {
+		for _, item := range data {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			row := []interface{}{m["name"]}
+			for _, f := range spec.childFields {
+				row = append(row, m[f])
+			}
+			name := stringField(m, "name")
+			lines[name] = append(lines[name], row)
+		}
+	}
+
+	items := xlsxSheet{name: "Items", header: append([]string{"parent"}, spec.childFields...)}
+	for _, name := range names {
+		items.rows = append(items.rows, lines[name]...)
+	}
+
+	if err := writeXLSX(path, []xlsxSheet{header, items}); err != nil {
+		return err
+	}
+	printf("%s✓ Exported %d documents (%d lines) to %s%s\n", Green, len(names), len(items.rows), path, Reset)
+	return nil
+}